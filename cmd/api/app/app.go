@@ -16,23 +16,35 @@ import (
 	"github.com/segmentio/kafka-go"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/defaults"
 	image "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/repository"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/handlers"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/service"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/usecase"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/deletion"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/jwks"
 	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/session"
 
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
 	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
 )
 
 func Run() error {
 	config := LoadConfig()
+	if err := config.Validate(); err != nil {
+		return err
+	}
 
 	appLogger, err := logger.NewSlogLoggerWithFileAndConsole("logs/app.log", slog.LevelInfo)
 	if err != nil {
@@ -65,9 +77,18 @@ func Run() error {
 	}
 	defer finGrpcConn.Close()
 
+	ntfGrpcConn, err := grpc.NewClient(fmt.Sprintf("%s:%s", config.NotificationServiceHost, config.NotificationServicePort), dialOpts)
+	if err != nil {
+		appLogger.Error("Failed to connect to notification gRPC service", "error", err)
+		log.Fatal(err)
+		return err
+	}
+	defer ntfGrpcConn.Close()
+
 	authClient := authpb.NewAuthServiceClient(authGrpcConn)
 	bdgClient := bdgpb.NewBudgetServiceClient(bdgGrpcConn)
 	finClient := finpb.NewFinanceServiceClient(finGrpcConn)
+	ntfClient := ntfpb.NewNotificationServiceClient(ntfGrpcConn)
 
 	kafkaWriter := &kafka.Writer{
 		Addr:         kafka.TCP(fmt.Sprintf("%s:%s", config.KafkaProducerHost, config.KafkaProducerPort)),
@@ -78,6 +99,21 @@ func Run() error {
 	}
 	kafkaProducer := kafkautils.NewKafkaWriterWrapper(kafkaWriter)
 
+	// отозванные сессии приходят событиями из auth_service
+	sessionCache := session.NewCache(authClient, clock.RealClock{}, session.DefaultTTL)
+	sessionEvents := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     []string{fmt.Sprintf("%s:%s", config.KafkaProducerHost, config.KafkaProducerPort)},
+		Topic:       models.SESSIONS,
+		StartOffset: kafka.LastOffset,
+	})
+	defer sessionEvents.Close()
+	listenCtx, stopListening := context.WithCancel(context.Background())
+	defer stopListening()
+	go sessionCache.Listen(listenCtx, sessionEvents, appLogger)
+
+	// access-токены подписывает auth_service, здесь только открытые ключи
+	accessKeys := jwks.NewRemote(config.JWT.JWKSURL, nil, clock.RealClock{})
+
 	imageStorage, err := image.NewMinIOStorage(
 		fmt.Sprintf("%s:%s", config.MinIO.Endpoint, config.MinIO.Port),
 		config.MinIO.AccessKey,
@@ -89,12 +125,51 @@ func Run() error {
 		return err
 	}
 
-	serviceInstance := service.NewService(config.JWTSecret, imageStorage)
-	usecaseInstance := usecase.NewUseCase(serviceInstance, config.JWTSecret)
-	handler := handlers.NewHandler(usecaseInstance, appLogger, authClient, bdgClient, finClient, kafkaProducer)
+	serviceInstance := service.NewService(imageStorage)
+	usecaseInstance := usecase.NewUseCase(serviceInstance)
+
+	categoryPack, err := defaults.Load(config.DefaultCategories.File)
+	if err != nil {
+		appLogger.Error("Failed to load default categories, using built-in set", "error", err)
+		categoryPack = defaults.Builtin()
+	}
+
+	// иконки набора категорий по умолчанию загружаются один раз: уже загруженные пропускаются
+	go func() {
+		uploaded, err := defaults.UploadIcons(context.Background(), usecaseInstance.ImageUC, categoryPack)
+		if err != nil {
+			appLogger.Error("Failed to upload default category icons", "error", err)
+			return
+		}
+		appLogger.Info("Default category icons are ready", "uploaded", uploaded)
+	}()
+
+	// иконки набора общие для всех пользователей и при удалении аккаунта не удаляются
+	iconIDs := make([]string, 0, len(categoryPack.Icons()))
+	for _, icon := range categoryPack.Icons() {
+		iconIDs = append(iconIDs, icon.ID)
+	}
+	deletionWorkflow := deletion.NewWorkflow(authClient, finClient, bdgClient, usecaseInstance.ImageUC, kafkaProducer, iconIDs)
+	// удаление аккаунтов останавливается при завершении, прерванный шаг
+	// повторится после истечения аренды
+	workflowCtx, stopWorkflow := context.WithCancel(context.Background())
+	defer stopWorkflow()
+	workflowDone := make(chan struct{})
+	go func() {
+		defer close(workflowDone)
+		deletionWorkflow.Run(workflowCtx, deletion.DefaultInterval, appLogger)
+	}()
+
+	handler := handlers.NewHandler(usecaseInstance, appLogger, authClient, bdgClient, finClient, ntfClient, kafkaProducer)
+
+	trustedProxies, err := middleware.ParseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		return err
+	}
 
 	r := mux.NewRouter()
 
+	r.Use(middleware.ClientIPMiddleware(trustedProxies))
 	r.Use(middleware.SecurityHeadersMiddleware())
 
 	corsOrigins := config.GetCORSOrigins()
@@ -116,7 +191,7 @@ func Run() error {
 
 	public := r.PathPrefix("/api/v1").Subrouter()
 	// Временно отключен CSRF для фронтенда
-	public.Use(middleware.CSRFMiddleware(config.JWTSecret))
+	public.Use(middleware.CSRFMiddleware(config.CSRFSecret))
 
 	protected := r.PathPrefix("/api/v1").Subrouter()
 	protected.Use(middleware.MetricsMiddleware)
@@ -124,10 +199,32 @@ func Run() error {
 	protected.Use(middleware.LoggerMiddleware(appLogger))
 	protected.Use(middleware.RequestLoggerMiddleware(appLogger))
 	protected.Use(middleware.SecurityLoggerMiddleware(appLogger))
-	protected.Use(middleware.CSRFMiddleware(config.JWTSecret))
-	protected.Use(middleware.AuthMiddleware(config.JWTSecret))
-
-	handler.Register(public, protected, authClient, bdgClient, finClient, kafkaProducer)
+	protected.Use(middleware.CSRFMiddleware(config.CSRFSecret))
+	protected.Use(middleware.AuthMiddleware(accessKeys, sessionCache,
+		middleware.PersonalTokenFunc(func(ctx context.Context, token string) (int, []string, error) {
+			principal, err := authClient.AuthenticatePersonalToken(ctx, &authpb.AuthenticateTokenRequest{Token: token})
+			if status.Code(err) == codes.Unauthenticated {
+				return 0, nil, middleware.ErrPersonalTokenInvalid
+			}
+			if err != nil {
+				return 0, nil, err
+			}
+			return int(principal.GetUserId()), principal.GetScopes(), nil
+		}),
+	))
+	protected.Use(middleware.RequireTokenScope())
+	protected.Use(middleware.RequireVerifiedEmail(
+		middleware.EmailVerificationFunc(func(ctx context.Context, userID int) (bool, error) {
+			profile, err := authClient.GetProfile(ctx, &authpb.UserID{UserID: int32(userID)})
+			if err != nil {
+				return false, err
+			}
+			return profile.GetEmailVerified(), nil
+		}),
+		config.Auth.UnverifiedEmailRestrictions,
+	))
+
+	handler.Register(public, protected, authClient, bdgClient, finClient, ntfClient, kafkaProducer)
 
 	// Swagger документация
 	r.PathPrefix("/swagger/").Handler(httpSwagger.WrapHandler)
@@ -167,9 +264,15 @@ func Run() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	stopWorkflow()
 	if err := srv.Shutdown(ctx); err != nil {
 		appLogger.Error("Server forced to shutdown", "error", err)
 	}
+	select {
+	case <-workflowDone:
+	case <-ctx.Done():
+		appLogger.Error("Account deletion workflow did not stop in time")
+	}
 
 	appLogger.Info("Server exited")
 	return nil
@@ -1,28 +1,55 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 )
 
+const (
+	defaultJWTSecret  = "your-secret-key"
+	defaultCSRFSecret = "your-csrf-secret"
+)
+
 type Config struct {
-	Port               string
-	AuthServicePort    string
-	AuthServiceHost    string
-	BudgetServicePort  string
-	BudgetServiceHost  string
-	FinanceServicePort string
-	FinanceServiceHost string
-	KafkaProducerHost  string
-	KafkaProducerPort  string
-	Host               string
-	JWTSecret          string
-	LogLevel           string
-	Database           DatabaseConfig
-	HTTPS              HTTPSConfig
-	MinIO              MinIOConfig
-	ElasticSearch      ElasticSearchConfig
+	Port                    string
+	AuthServicePort         string
+	AuthServiceHost         string
+	BudgetServicePort       string
+	BudgetServiceHost       string
+	FinanceServicePort      string
+	FinanceServiceHost      string
+	NotificationServicePort string
+	NotificationServiceHost string
+	KafkaProducerHost       string
+	KafkaProducerPort       string
+	Host                    string
+	JWTSecret               string
+	CSRFSecret              string
+	JWT                     JWTConfig
+	LogLevel                string
+	Database                DatabaseConfig
+	HTTPS                   HTTPSConfig
+	MinIO                   MinIOConfig
+	ElasticSearch           ElasticSearchConfig
+	DefaultCategories       DefaultCategoriesConfig
+	Notification            NotificationConfig
+	Auth                    AuthConfig
+	// TrustedProxies адреса и подсети прокси, которым разрешено передавать
+	// адрес клиента в X-Forwarded-For и X-Real-IP
+	TrustedProxies []string
+}
+
+// JWTConfig ключи подписи access-токенов. JWTSecret остается только у
+// auth_service: промежуточные токены входа с 2FA и шифрование секретов TOTP
+type JWTConfig struct {
+	// KeysDir каталог ключей подписи access-токенов <kid>.pem (см. jwks.LoadDir)
+	KeysDir string
+	// ActiveKeyID kid для новых токенов; пустой — последний по имени приватный ключ
+	ActiveKeyID string
+	// JWKSURL откуда остальные сервисы берут открытые ключи
+	JWKSURL string
 }
 
 type DatabaseConfig struct {
@@ -54,20 +81,54 @@ type ElasticSearchConfig struct {
 	Port string
 }
 
+type DefaultCategoriesConfig struct {
+	// File пустой — используется встроенный набор
+	File string
+}
+
+type NotificationConfig struct {
+	// BudgetThresholds пороги уведомлений о бюджете через запятую: проценты и exceeded
+	BudgetThresholds string
+	// SMTPAddr пустой — письма складываются файлами в MailboxDir
+	SMTPAddr     string
+	SMTPFrom     string
+	SMTPUser     string
+	SMTPPassword string
+	MailboxDir   string
+}
+
+type AuthConfig struct {
+	// PasswordResetURL страница фронтенда, на которую ведет ссылка из письма сброса пароля
+	PasswordResetURL string
+	// EmailConfirmURL страница фронтенда для ссылок подтверждения и смены email
+	EmailConfirmURL string
+	// UnverifiedEmailRestrictions маршруты через запятую в виде "METHOD /path/{template}",
+	// недоступные пользователям с неподтвержденным email
+	UnverifiedEmailRestrictions []string
+}
+
 func LoadConfig() *Config {
 	config := &Config{
-		Port:               getEnv("PORT", "8080"),
-		AuthServicePort:    getEnv("AUTH_SERVICE_PORT", "8090"),
-		AuthServiceHost:    getEnv("AUTH_SERVICE_HOST", "auth_service"),
-		BudgetServicePort:  getEnv("BUDGET_SERVICE_PORT", "8100"),
-		BudgetServiceHost:  getEnv("BUDGET_SERVICE_HOST", "budget_service"),
-		FinanceServicePort: getEnv("FINANCE_SERVICE_PORT", "8110"),
-		FinanceServiceHost: getEnv("FINANCE_SERVICE_HOST", "finance_service"),
-		KafkaProducerHost:  getEnv("KAFKA_PRODUCER_HOST", "kafka"),
-		KafkaProducerPort:  getEnv("KAFKA_PRODUCER_PORT", "9092"),
-		Host:               getEnv("HOST", "0.0.0.0"),
-		JWTSecret:          getEnv("JWT_SECRET", "your-secret-key"),
-		LogLevel:           getEnv("LOG_LEVEL", "info"),
+		Port:                    getEnv("PORT", "8080"),
+		AuthServicePort:         getEnv("AUTH_SERVICE_PORT", "8090"),
+		AuthServiceHost:         getEnv("AUTH_SERVICE_HOST", "auth_service"),
+		BudgetServicePort:       getEnv("BUDGET_SERVICE_PORT", "8100"),
+		BudgetServiceHost:       getEnv("BUDGET_SERVICE_HOST", "budget_service"),
+		FinanceServicePort:      getEnv("FINANCE_SERVICE_PORT", "8110"),
+		FinanceServiceHost:      getEnv("FINANCE_SERVICE_HOST", "finance_service"),
+		NotificationServicePort: getEnv("NOTIFICATION_SERVICE_PORT", "8120"),
+		NotificationServiceHost: getEnv("NOTIFICATION_SERVICE_HOST", "notification_service"),
+		KafkaProducerHost:       getEnv("KAFKA_PRODUCER_HOST", "kafka"),
+		KafkaProducerPort:       getEnv("KAFKA_PRODUCER_PORT", "9092"),
+		Host:                    getEnv("HOST", "0.0.0.0"),
+		JWTSecret:               getEnv("JWT_SECRET", defaultJWTSecret),
+		CSRFSecret:              getEnv("CSRF_SECRET", defaultCSRFSecret),
+		JWT: JWTConfig{
+			KeysDir:     getEnv("JWT_KEYS_DIR", "keys/jwt"),
+			ActiveKeyID: getEnv("JWT_ACTIVE_KID", ""),
+			JWKSURL:     getEnv("JWT_JWKS_URL", "http://auth_service:8700/.well-known/jwks.json"),
+		},
+		LogLevel: getEnv("LOG_LEVEL", "info"),
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     getEnv("DB_PORT", "5432"),
@@ -93,6 +154,23 @@ func LoadConfig() *Config {
 			Port: getEnv("ELASTIC_SEARCH_PORT", "9200"),
 			Host: getEnv("ELASTIC_SEARCH_HOST", "elasticsearch"),
 		},
+		DefaultCategories: DefaultCategoriesConfig{
+			File: getEnv("DEFAULT_CATEGORIES_FILE", ""),
+		},
+		Notification: NotificationConfig{
+			BudgetThresholds: getEnv("BUDGET_ALERT_THRESHOLDS", "80,100,exceeded"),
+			SMTPAddr:         getEnv("NOTIFY_SMTP_ADDR", ""),
+			SMTPFrom:         getEnv("NOTIFY_SMTP_FROM", "noreply@vkarmane.local"),
+			SMTPUser:         getEnv("NOTIFY_SMTP_USER", ""),
+			SMTPPassword:     getEnv("NOTIFY_SMTP_PASSWORD", ""),
+			MailboxDir:       getEnv("NOTIFY_MAILBOX_DIR", "mail/outbox"),
+		},
+		Auth: AuthConfig{
+			PasswordResetURL:            getEnv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password"),
+			EmailConfirmURL:             getEnv("EMAIL_CONFIRM_URL", "http://localhost:3000/confirm-email"),
+			UnverifiedEmailRestrictions: splitList(getEnv("UNVERIFIED_EMAIL_RESTRICTIONS", "POST /api/v1/accounts/invitations")),
+		},
+		TrustedProxies: splitList(getEnv("TRUSTED_PROXIES", "")),
 	}
 
 	return config
@@ -105,6 +183,17 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// splitList разбирает список через запятую, пропуская пустые элементы
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 func (c *Config) GetDatabaseDSN() string {
 	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		c.Database.Host,
@@ -173,10 +262,24 @@ func (c *Config) GetMinIOEndpoint() string {
 	return fmt.Sprintf("%s://%s:%s", schema, c.MinIO.Endpoint, c.MinIO.Port)
 }
 
+// Validate не дает запустить production с секретами по умолчанию
+func (c *Config) Validate() error {
+	if !c.IsProduction() {
+		return nil
+	}
+	if c.JWTSecret == defaultJWTSecret {
+		return errors.New("config: JWT_SECRET must be set in production")
+	}
+	if c.CSRFSecret == defaultCSRFSecret {
+		return errors.New("config: CSRF_SECRET must be set in production")
+	}
+	return nil
+}
+
 func (c *Config) GetCSRFAuthKey() []byte {
-	csrfKey := getEnv("CSRF_AUTH_KEY", c.JWTSecret)
+	csrfKey := getEnv("CSRF_AUTH_KEY", c.CSRFSecret)
 	if len(csrfKey) < 32 {
-		return []byte(c.JWTSecret + "csrf-key-padding-to-32-chars")
+		return []byte(c.CSRFSecret + "csrf-key-padding-to-32-chars")
 	}
 	return []byte(csrfKey[:32])
 }
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"log"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service"
+)
+
+func main() {
+	if err := ntfservice.Run(); err != nil {
+		log.Fatalf("NotificationService failed to run: %v", err)
+	}
+}
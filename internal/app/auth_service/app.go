@@ -1,12 +1,20 @@
 package authservice
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/segmentio/kafka-go"
 	"google.golang.org/grpc"
 
 	config "github.com/go-park-mail-ru/2025_2_VKarmane/cmd/api/app"
@@ -15,10 +23,30 @@ import (
 	repo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/repository"
 	authusecase "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/usecase"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/jwks"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/mailer"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/pkg/interceptors"
 )
 
+// backgroundShutdownTimeout сколько при остановке ждать фоновые задачи
+const backgroundShutdownTimeout = 30 * time.Second
+
+// loadSigningKeys ключи подписи access-токенов из каталога. Вне production
+// без ключей сервис поднимается с временным ключом: токены перестанут
+// приниматься после перезапуска, но локальной разработке это не мешает.
+func loadSigningKeys(cfg *config.Config, appLogger logger.Logger) (*jwks.KeySet, error) {
+	keys, err := jwks.LoadDir(cfg.JWT.KeysDir, cfg.JWT.ActiveKeyID)
+	if err == nil || !errors.Is(err, jwks.ErrNoKeys) || cfg.IsProduction() {
+		return keys, err
+	}
+
+	appLogger.Warn("JWT signing keys are not configured, using an ephemeral key", "dir", cfg.JWT.KeysDir, "error", err)
+	return jwks.Generate(fmt.Sprintf("ephemeral-%d", time.Now().Unix()))
+}
+
 func Run() error {
 	config := config.LoadConfig()
 	clock := clock.RealClock{}
@@ -28,6 +56,18 @@ func Run() error {
 		appLogger = logger.NewSlogLogger()
 	}
 
+	if err := config.Validate(); err != nil {
+		appLogger.Error("AuthService config is invalid", "error", err)
+		return err
+	}
+
+	signingKeys, err := loadSigningKeys(config, appLogger)
+	if err != nil {
+		appLogger.Error("AuthService failed to load JWT signing keys", "error", err)
+		return err
+	}
+	appLogger.Info("JWT signing keys loaded", "active_kid", signingKeys.ActiveID())
+
 	lis, err := net.Listen("tcp", ":8090")
 	if err != nil {
 		appLogger.Error("failed to start AuthService ", "error", err)
@@ -48,6 +88,8 @@ func Run() error {
 	go func() {
 		mux := http.NewServeMux()
 		mux.Handle("/metrics", promhttp.Handler())
+		// открытые ключи для проверки access-токенов в остальных сервисах
+		mux.Handle("/.well-known/jwks.json", signingKeys)
 		appLogger.Info("Metrics server started on :10300")
 		if err := http.ListenAndServe(":8700", mux); err != nil {
 			appLogger.Error("Metrics server failed", err)
@@ -61,12 +103,71 @@ func Run() error {
 	}
 	store := repo.NewPostgresRepository(db)
 
-	uc := authusecase.NewAuthUseCase(store, config.JWTSecret, clock)
+	sessionEvents := &kafka.Writer{
+		Addr:         kafka.TCP(fmt.Sprintf("%s:%s", config.KafkaProducerHost, config.KafkaProducerPort)),
+		Topic:        models.SESSIONS,
+		Balancer:     &kafka.LeastBytes{},
+		RequiredAcks: kafka.RequireAll,
+		Async:        false,
+	}
+	defer sessionEvents.Close()
+
+	// без SMTP письма не уходят, ссылки сброса пароля остаются в памяти процесса
+	var mail mailer.Mailer = mailer.NewMemoryMailer()
+	if config.Notification.SMTPAddr != "" {
+		mail = mailer.NewSMTPMailer(
+			config.Notification.SMTPAddr,
+			config.Notification.SMTPFrom,
+			config.Notification.SMTPUser,
+			config.Notification.SMTPPassword,
+		)
+	} else {
+		appLogger.Warn("SMTP is not configured, auth emails will not be delivered")
+	}
+
+	uc := authusecase.NewAuthUseCase(
+		store,
+		authusecase.Keys{
+			Signing: signingKeys,
+			Secret:  config.JWTSecret,
+			CSRF:    config.CSRFSecret,
+		},
+		clock,
+		kafkautils.NewKafkaWriterWrapper(sessionEvents),
+		mail,
+		authusecase.Links{
+			PasswordReset: config.Auth.PasswordResetURL,
+			EmailConfirm:  config.Auth.EmailConfirmURL,
+		},
+	)
 	authService := server.NewAuthServer(uc)
 
 	authpb.RegisterAuthServiceServer(srv, authService)
 
-	srv.Serve(lis)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(lis)
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err := <-serveErr:
+		appLogger.Error("AuthService stopped serving", "error", err)
+		return err
+	case <-quit:
+	}
+	appLogger.Info("Shutting down AuthService...")
+
+	// новые запросы больше не придут, письма сброса пароля дописываются в фоне
+	srv.GracefulStop()
+	ctx, cancel := context.WithTimeout(context.Background(), backgroundShutdownTimeout)
+	defer cancel()
+	if err := uc.Close(ctx); err != nil {
+		appLogger.Error("AuthService background tasks did not finish", "error", err)
+	}
 
+	appLogger.Info("AuthService exited")
 	return nil
 }
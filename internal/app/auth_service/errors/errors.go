@@ -3,10 +3,30 @@ package errors
 import "errors"
 
 var (
-	ErrUserNotFound       = errors.New("USER_NOT_FOUND")
-	ErrUserExists         = errors.New("USER_EXISTS")
-	ErrInvalidCredentials = errors.New("INVALID_CREDENTIALS")
-	ErrLoginExists        = errors.New("LOGIN_EXISTS")
-	ErrEmailExists        = errors.New("EMAIL_EXISTS")
-	ErrForbidden          = errors.New("FORBIDDEN")
+	ErrUserNotFound         = errors.New("USER_NOT_FOUND")
+	ErrUserExists           = errors.New("USER_EXISTS")
+	ErrInvalidCredentials   = errors.New("INVALID_CREDENTIALS")
+	ErrLoginExists          = errors.New("LOGIN_EXISTS")
+	ErrEmailExists          = errors.New("EMAIL_EXISTS")
+	ErrForbidden            = errors.New("FORBIDDEN")
+	ErrTokenInvalid         = errors.New("TOKEN_INVALID")
+	ErrTokenExpired         = errors.New("TOKEN_EXPIRED")
+	ErrSessionRevoked       = errors.New("SESSION_REVOKED")
+	ErrSessionNotFound      = errors.New("SESSION_NOT_FOUND")
+	ErrAccountLocked        = errors.New("ACCOUNT_LOCKED")
+	ErrEmailAlreadyVerified = errors.New("EMAIL_ALREADY_VERIFIED")
+	ErrWeakPassword         = errors.New("WEAK_PASSWORD")
+	ErrMFANotEnabled        = errors.New("MFA_NOT_ENABLED")
+	ErrMFAAlreadyEnabled    = errors.New("MFA_ALREADY_ENABLED")
+	ErrMFACodeInvalid       = errors.New("MFA_CODE_INVALID")
+
+	ErrPersonalTokenNotFound = errors.New("PERSONAL_TOKEN_NOT_FOUND")
+	ErrInvalidScope          = errors.New("INVALID_SCOPE")
+
+	ErrAccountDeletionNotFound = errors.New("ACCOUNT_DELETION_NOT_FOUND")
+	ErrAccountDeletionStarted  = errors.New("ACCOUNT_DELETION_STARTED")
+
+	// ErrRefreshTokenReused refresh-токен уже обменян; наружу не отдается,
+	// usecase отзывает сессию и возвращает ErrSessionRevoked
+	ErrRefreshTokenReused = errors.New("REFRESH_TOKEN_REUSED")
 )
@@ -9,10 +9,26 @@ var ErrorMap = map[error]struct {
 	Code codes.Code
 	Msg  string
 }{
-	ErrLoginExists:        {Code: codes.AlreadyExists, Msg: string(models.ErrCodeLoginExists)},
-	ErrEmailExists:        {Code: codes.AlreadyExists, Msg: string(models.ErrCodeEmailExists)},
-	ErrInvalidCredentials: {Code: codes.Unauthenticated, Msg: string(models.ErrCodeInvalidCredentials)},
-	ErrUserNotFound:       {Code: codes.NotFound, Msg: string(models.ErrCodeUserNotFound)},
-	ErrForbidden:          {Code: codes.PermissionDenied, Msg: string(models.ErrCodeForbidden)},
-	ErrUserExists:         {Code: codes.AlreadyExists, Msg: string(models.ErrCodeUserExists)},
+	ErrLoginExists:          {Code: codes.AlreadyExists, Msg: string(models.ErrCodeLoginExists)},
+	ErrEmailExists:          {Code: codes.AlreadyExists, Msg: string(models.ErrCodeEmailExists)},
+	ErrInvalidCredentials:   {Code: codes.Unauthenticated, Msg: string(models.ErrCodeInvalidCredentials)},
+	ErrUserNotFound:         {Code: codes.NotFound, Msg: string(models.ErrCodeUserNotFound)},
+	ErrForbidden:            {Code: codes.PermissionDenied, Msg: string(models.ErrCodeForbidden)},
+	ErrUserExists:           {Code: codes.AlreadyExists, Msg: string(models.ErrCodeUserExists)},
+	ErrTokenInvalid:         {Code: codes.Unauthenticated, Msg: string(models.ErrCodeTokenInvalid)},
+	ErrTokenExpired:         {Code: codes.Unauthenticated, Msg: string(models.ErrCodeTokenExpired)},
+	ErrSessionRevoked:       {Code: codes.Unauthenticated, Msg: string(models.ErrCodeSessionRevoked)},
+	ErrSessionNotFound:      {Code: codes.NotFound, Msg: string(models.ErrCodeSessionNotFound)},
+	ErrAccountLocked:        {Code: codes.ResourceExhausted, Msg: string(models.ErrCodeAccountLocked)},
+	ErrEmailAlreadyVerified: {Code: codes.FailedPrecondition, Msg: string(models.ErrCodeEmailAlreadyVerified)},
+	ErrWeakPassword:         {Code: codes.InvalidArgument, Msg: string(models.ErrCodeWeakPassword)},
+	ErrMFANotEnabled:        {Code: codes.FailedPrecondition, Msg: string(models.ErrCodeMFANotEnabled)},
+	ErrMFAAlreadyEnabled:    {Code: codes.FailedPrecondition, Msg: string(models.ErrCodeMFAAlreadyEnabled)},
+	ErrMFACodeInvalid:       {Code: codes.Unauthenticated, Msg: string(models.ErrCodeMFACodeInvalid)},
+
+	ErrPersonalTokenNotFound: {Code: codes.NotFound, Msg: string(models.ErrCodePersonalTokenNotFound)},
+	ErrInvalidScope:          {Code: codes.InvalidArgument, Msg: string(models.ErrCodeInvalidScope)},
+
+	ErrAccountDeletionNotFound: {Code: codes.NotFound, Msg: string(models.ErrCodeAccountDeletionNotFound)},
+	ErrAccountDeletionStarted:  {Code: codes.FailedPrecondition, Msg: string(models.ErrCodeAccountDeletionStarted)},
 }
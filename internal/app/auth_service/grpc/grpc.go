@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -118,3 +119,487 @@ func (s *AuthServiceServer) GetCSRF(ctx context.Context, _ *emptypb.Empty) (*aut
 	}
 	return token, err
 }
+
+func (s *AuthServiceServer) ExportProfile(ctx context.Context, userID *authpb.UserID) (*authpb.User, error) {
+	user, err := s.authUC.ExportProfile(ctx, ProtoIDtoInt(userID))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to export user", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to export user, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return user, nil
+}
+
+func (s *AuthServiceServer) ImportProfile(ctx context.Context, req *authpb.ImportProfileRequest) (*authpb.ProfileResponse, error) {
+	profile, err := s.authUC.ImportProfile(ctx, ImportProfileToUser(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to import user", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to import user, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return profile, nil
+}
+
+func (s *AuthServiceServer) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.AuthResponse, error) {
+	resp, err := s.authUC.RefreshToken(ctx, req.GetRefreshToken(), ClientInfoToModel(req.GetClient()))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to refresh token", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to refresh token, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return resp, nil
+}
+
+func (s *AuthServiceServer) RevokeSession(ctx context.Context, req *authpb.SessionRequest) (*emptypb.Empty, error) {
+	userID, sessionID := SessionRequestToIDs(req)
+	if err := s.authUC.RevokeSession(ctx, userID, sessionID); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to revoke session", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to revoke session, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) CheckSession(ctx context.Context, req *authpb.SessionRequest) (*authpb.SessionStatus, error) {
+	userID, sessionID := SessionRequestToIDs(req)
+	sessionStatus, err := s.authUC.CheckSession(ctx, userID, sessionID)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		if logger != nil {
+			logger.Error("Failed to check session, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return sessionStatus, nil
+}
+
+func (s *AuthServiceServer) ListSessions(ctx context.Context, req *authpb.SessionRequest) (*authpb.SessionList, error) {
+	userID, sessionID := SessionRequestToIDs(req)
+	sessions, err := s.authUC.ListSessions(ctx, userID, sessionID)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		if logger != nil {
+			logger.Error("Failed to list sessions, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return sessions, nil
+}
+
+func (s *AuthServiceServer) RevokeOtherSessions(ctx context.Context, req *authpb.SessionRequest) (*authpb.RevokedSessions, error) {
+	userID, sessionID := SessionRequestToIDs(req)
+	revoked, err := s.authUC.RevokeOtherSessions(ctx, userID, sessionID)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		if logger != nil {
+			logger.Error("Failed to revoke other sessions, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return revoked, nil
+}
+
+func (s *AuthServiceServer) RequestPasswordReset(ctx context.Context, req *authpb.PasswordResetRequest) (*emptypb.Empty, error) {
+	if err := s.authUC.RequestPasswordReset(ctx, PasswordResetToRequest(req)); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to request password reset", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to request password reset, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) ConfirmPasswordReset(ctx context.Context, req *authpb.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := s.authUC.ConfirmPasswordReset(ctx, req.GetToken(), req.GetNewPassword()); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to confirm password reset", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to confirm password reset, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) ConfirmEmail(ctx context.Context, req *authpb.EmailTokenRequest) (*authpb.EmailConfirmation, error) {
+	res, err := s.authUC.ConfirmEmail(ctx, req.GetToken())
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to confirm email", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to confirm email, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *AuthServiceServer) ResendEmailVerification(ctx context.Context, req *authpb.UserID) (*emptypb.Empty, error) {
+	if err := s.authUC.ResendEmailVerification(ctx, int(req.GetUserID())); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to resend email verification", "error", err, "user_id", req.GetUserID())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to resend email verification, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) ChangePassword(ctx context.Context, req *authpb.ChangePasswordRequest) (*authpb.RevokedSessions, error) {
+	res, err := s.authUC.ChangePassword(ctx, ChangePasswordToRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to change password", "error", err, "user_id", req.GetUserId())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to change password, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *AuthServiceServer) EnrollMFA(ctx context.Context, req *authpb.UserID) (*authpb.MFAEnrollment, error) {
+	res, err := s.authUC.EnrollMFA(ctx, int(req.GetUserID()))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to enroll MFA", "error", err, "user_id", req.GetUserID())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to enroll MFA, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *AuthServiceServer) ConfirmMFA(ctx context.Context, req *authpb.MFACodeRequest) (*authpb.RecoveryCodes, error) {
+	res, err := s.authUC.ConfirmMFA(ctx, int(req.GetUserId()), req.GetCode())
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to confirm MFA", "error", err, "user_id", req.GetUserId())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to confirm MFA, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *AuthServiceServer) DisableMFA(ctx context.Context, req *authpb.MFACodeRequest) (*emptypb.Empty, error) {
+	if err := s.authUC.DisableMFA(ctx, int(req.GetUserId()), req.GetCode()); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to disable MFA", "error", err, "user_id", req.GetUserId())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to disable MFA, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) VerifyMFA(ctx context.Context, req *authpb.VerifyMFARequest) (*authpb.AuthResponse, error) {
+	res, err := s.authUC.VerifyMFA(ctx, VerifyMFAToRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to verify MFA", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to verify MFA, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *AuthServiceServer) CreatePersonalToken(ctx context.Context, req *authpb.CreatePersonalTokenRequest) (*authpb.CreatedPersonalToken, error) {
+	res, err := s.authUC.CreatePersonalToken(ctx, CreatePersonalTokenToRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to create personal token", "error", err, "user_id", req.GetUserId())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to create personal token, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *AuthServiceServer) ListPersonalTokens(ctx context.Context, req *authpb.UserID) (*authpb.PersonalTokenList, error) {
+	tokens, err := s.authUC.ListPersonalTokens(ctx, ProtoIDtoInt(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		if logger != nil {
+			logger.Error("Failed to list personal tokens, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return tokens, nil
+}
+
+func (s *AuthServiceServer) RevokePersonalToken(ctx context.Context, req *authpb.PersonalTokenRequest) (*emptypb.Empty, error) {
+	if err := s.authUC.RevokePersonalToken(ctx, int(req.GetUserId()), int(req.GetTokenId())); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to revoke personal token", "error", err, "user_id", req.GetUserId())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to revoke personal token, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) AuthenticatePersonalToken(ctx context.Context, req *authpb.AuthenticateTokenRequest) (*authpb.TokenPrincipal, error) {
+	principal, err := s.authUC.AuthenticatePersonalToken(ctx, req.GetToken())
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to authenticate personal token", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to authenticate personal token, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return principal, nil
+}
+
+func (s *AuthServiceServer) ScheduleAccountDeletion(ctx context.Context, req *authpb.DeleteAccountRequest) (*authpb.AccountDeletion, error) {
+	deletion, err := s.authUC.ScheduleAccountDeletion(ctx, int(req.GetUserId()), req.GetPassword(), req.GetCode())
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to schedule account deletion", "error", err, "user_id", req.GetUserId())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to schedule account deletion, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return deletion, nil
+}
+
+func (s *AuthServiceServer) CancelAccountDeletion(ctx context.Context, req *authpb.UserID) (*emptypb.Empty, error) {
+	if err := s.authUC.CancelAccountDeletion(ctx, int(req.GetUserID())); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to cancel account deletion", "error", err, "user_id", req.GetUserID())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to cancel account deletion, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) ClaimAccountDeletions(ctx context.Context, req *authpb.ClaimDeletionsRequest) (*authpb.AccountDeletionList, error) {
+	lease := time.Duration(req.GetLeaseSeconds()) * time.Second
+	deletions, err := s.authUC.ClaimAccountDeletions(ctx, int(req.GetLimit()), lease)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to claim account deletions", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to claim account deletions, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return deletions, nil
+}
+
+func (s *AuthServiceServer) AdvanceAccountDeletion(ctx context.Context, req *authpb.AdvanceDeletionRequest) (*emptypb.Empty, error) {
+	if err := s.authUC.AdvanceAccountDeletion(ctx, int(req.GetUserId()), req.GetStep(), req.GetImageIds()); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to advance account deletion", "error", err, "user_id", req.GetUserId(), "step", req.GetStep())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to advance account deletion, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) DeleteUser(ctx context.Context, req *authpb.UserID) (*emptypb.Empty, error) {
+	if err := s.authUC.DeleteUser(ctx, int(req.GetUserID())); err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to delete user", "error", err, "user_id", req.GetUserID())
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to delete user, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) FilterUsedImages(ctx context.Context, req *authpb.ImageIDs) (*authpb.ImageIDs, error) {
+	used, err := s.authUC.FilterUsedImages(ctx, req.GetIds())
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range svcerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Warn("Failed to filter used images", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to filter used images, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return used, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -122,3 +123,245 @@ func TestAuthServiceServer_GetCSRFError(t *testing.T) {
 	st, _ := status.FromError(err)
 	require.Equal(t, codes.Internal, st.Code())
 }
+
+func TestAuthServiceServer_RefreshTokenRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().RefreshToken(gomock.Any(), "stolen", gomock.Any()).Return(nil, svcerrors.ErrSessionRevoked)
+
+	resp, err := server.RefreshToken(context.Background(), &authpb.RefreshTokenRequest{RefreshToken: "stolen"})
+	require.Nil(t, resp)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.Unauthenticated, st.Code())
+	require.Equal(t, "SESSION_REVOKED", st.Message())
+}
+
+func TestAuthServiceServer_RevokeSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().RevokeSession(gomock.Any(), 1, 5).Return(nil)
+	_, err := server.RevokeSession(context.Background(), &authpb.SessionRequest{UserId: 1, SessionId: 5})
+	require.NoError(t, err)
+
+	uc.EXPECT().RevokeSession(gomock.Any(), 1, 6).Return(svcerrors.ErrSessionNotFound)
+	_, err = server.RevokeSession(context.Background(), &authpb.SessionRequest{UserId: 1, SessionId: 6})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthServiceServer_ConfirmPasswordResetInvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().ConfirmPasswordReset(gomock.Any(), "used", "new-password").Return(svcerrors.ErrTokenInvalid)
+
+	_, err := server.ConfirmPasswordReset(context.Background(), &authpb.ConfirmPasswordResetRequest{Token: "used", NewPassword: "new-password"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthServiceServer_ConfirmEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().ConfirmEmail(gomock.Any(), "token").Return(&authpb.EmailConfirmation{Status: "email_verified", Email: "ivan@example.com"}, nil)
+	res, err := server.ConfirmEmail(context.Background(), &authpb.EmailTokenRequest{Token: "token"})
+	require.NoError(t, err)
+	require.Equal(t, "email_verified", res.Status)
+
+	uc.EXPECT().ConfirmEmail(gomock.Any(), "used").Return(nil, svcerrors.ErrTokenInvalid)
+	_, err = server.ConfirmEmail(context.Background(), &authpb.EmailTokenRequest{Token: "used"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthServiceServer_ResendEmailVerificationAlreadyVerified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().ResendEmailVerification(gomock.Any(), 1).Return(svcerrors.ErrEmailAlreadyVerified)
+
+	_, err := server.ResendEmailVerification(context.Background(), &authpb.UserID{UserID: 1})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestAuthServiceServer_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	req := &authpb.ChangePasswordRequest{UserId: 1, SessionId: 5, OldPassword: "old", NewPassword: "weak"}
+	uc.EXPECT().ChangePassword(gomock.Any(), ChangePasswordToRequest(req)).Return(nil, svcerrors.ErrWeakPassword)
+	_, err := server.ChangePassword(context.Background(), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	uc.EXPECT().ChangePassword(gomock.Any(), gomock.Any()).Return(nil, svcerrors.ErrInvalidCredentials)
+	_, err = server.ChangePassword(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthServiceServer_ConfirmMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().ConfirmMFA(gomock.Any(), 1, "123456").Return(&authpb.RecoveryCodes{Codes: []string{"abcde-fghij"}}, nil)
+	res, err := server.ConfirmMFA(context.Background(), &authpb.MFACodeRequest{UserId: 1, Code: "123456"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"abcde-fghij"}, res.Codes)
+
+	uc.EXPECT().ConfirmMFA(gomock.Any(), 1, "000000").Return(nil, svcerrors.ErrMFACodeInvalid)
+	_, err = server.ConfirmMFA(context.Background(), &authpb.MFACodeRequest{UserId: 1, Code: "000000"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthServiceServer_DisableMFANotEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().DisableMFA(gomock.Any(), 1, "123456").Return(svcerrors.ErrMFANotEnabled)
+
+	_, err := server.DisableMFA(context.Background(), &authpb.MFACodeRequest{UserId: 1, Code: "123456"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestAuthServiceServer_VerifyMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	req := &authpb.VerifyMFARequest{MfaToken: "mfa", Code: "123456", Client: &authpb.ClientInfo{Ip: "10.0.0.1"}}
+	uc.EXPECT().VerifyMFA(gomock.Any(), VerifyMFAToRequest(req)).Return(&authpb.AuthResponse{Token: "access"}, nil)
+	res, err := server.VerifyMFA(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "access", res.Token)
+
+	uc.EXPECT().VerifyMFA(gomock.Any(), gomock.Any()).Return(nil, svcerrors.ErrAccountLocked)
+	_, err = server.VerifyMFA(context.Background(), req)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestAuthServiceServer_CreatePersonalTokenInvalidScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().CreatePersonalToken(gomock.Any(), authmodels.CreatePersonalTokenRequest{UserID: 1, Name: "x", Scopes: []string{"admin"}}).
+		Return(nil, svcerrors.ErrInvalidScope)
+
+	_, err := server.CreatePersonalToken(context.Background(), &authpb.CreatePersonalTokenRequest{UserId: 1, Name: "x", Scopes: []string{"admin"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthServiceServer_RevokePersonalTokenNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().RevokePersonalToken(gomock.Any(), 1, 3).Return(svcerrors.ErrPersonalTokenNotFound)
+
+	_, err := server.RevokePersonalToken(context.Background(), &authpb.PersonalTokenRequest{UserId: 1, TokenId: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthServiceServer_AuthenticatePersonalToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().AuthenticatePersonalToken(gomock.Any(), "vkp_ok").
+		Return(&authpb.TokenPrincipal{UserId: 1, Scopes: []string{"budgets:read"}}, nil)
+	res, err := server.AuthenticatePersonalToken(context.Background(), &authpb.AuthenticateTokenRequest{Token: "vkp_ok"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res.UserId)
+
+	uc.EXPECT().AuthenticatePersonalToken(gomock.Any(), "vkp_old").Return(nil, svcerrors.ErrTokenExpired)
+	_, err = server.AuthenticatePersonalToken(context.Background(), &authpb.AuthenticateTokenRequest{Token: "vkp_old"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthServiceServer_ScheduleAccountDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().ScheduleAccountDeletion(gomock.Any(), 1, "pass", "123456").Return(&authpb.AccountDeletion{UserId: 1}, nil)
+	res, err := server.ScheduleAccountDeletion(context.Background(), &authpb.DeleteAccountRequest{UserId: 1, Password: "pass", Code: "123456"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res.UserId)
+
+	uc.EXPECT().ScheduleAccountDeletion(gomock.Any(), 1, "wrong", "").Return(nil, svcerrors.ErrInvalidCredentials)
+	_, err = server.ScheduleAccountDeletion(context.Background(), &authpb.DeleteAccountRequest{UserId: 1, Password: "wrong"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthServiceServer_CancelAccountDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().CancelAccountDeletion(gomock.Any(), 1).Return(svcerrors.ErrAccountDeletionStarted)
+	_, err := server.CancelAccountDeletion(context.Background(), &authpb.UserID{UserID: 1})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	uc.EXPECT().CancelAccountDeletion(gomock.Any(), 2).Return(svcerrors.ErrAccountDeletionNotFound)
+	_, err = server.CancelAccountDeletion(context.Background(), &authpb.UserID{UserID: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthServiceServer_ClaimAccountDeletions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().ClaimAccountDeletions(gomock.Any(), 10, 5*time.Minute).Return(&authpb.AccountDeletionList{}, nil)
+	_, err := server.ClaimAccountDeletions(context.Background(), &authpb.ClaimDeletionsRequest{Limit: 10, LeaseSeconds: 300})
+	require.NoError(t, err)
+}
+
+func TestAuthServiceServer_DeleteUserInternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockAuthUseCase(ctrl)
+	server := NewAuthServer(uc)
+
+	uc.EXPECT().DeleteUser(gomock.Any(), 1).Return(errors.New("db down"))
+	_, err := server.DeleteUser(context.Background(), &authpb.UserID{UserID: 1})
+	require.Equal(t, codes.Internal, status.Code(err))
+}
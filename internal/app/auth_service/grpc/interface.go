@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	auth "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
@@ -13,4 +14,31 @@ type AuthUseCase interface {
 	GetProfile(context.Context, int) (*authpb.ProfileResponse, error)
 	UpdateProfile(context.Context, auth.UpdateProfileRequest) (*authpb.ProfileResponse, error)
 	GetCSRFToken(context.Context) (*authpb.CSRFTokenResponse, error)
+	ExportProfile(context.Context, int) (*authpb.User, error)
+	ImportProfile(context.Context, auth.User) (*authpb.ProfileResponse, error)
+	RefreshToken(context.Context, string, auth.ClientInfo) (*authpb.AuthResponse, error)
+	RevokeSession(ctx context.Context, userID, sessionID int) error
+	RevokeOtherSessions(ctx context.Context, userID, currentSessionID int) (*authpb.RevokedSessions, error)
+	ListSessions(ctx context.Context, userID, currentSessionID int) (*authpb.SessionList, error)
+	RequestPasswordReset(ctx context.Context, req auth.PasswordResetRequest) error
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string) error
+	ChangePassword(ctx context.Context, req auth.ChangePasswordRequest) (*authpb.RevokedSessions, error)
+	CheckSession(ctx context.Context, userID, sessionID int) (*authpb.SessionStatus, error)
+	ConfirmEmail(ctx context.Context, token string) (*authpb.EmailConfirmation, error)
+	ResendEmailVerification(ctx context.Context, userID int) error
+	EnrollMFA(ctx context.Context, userID int) (*authpb.MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, userID int, code string) (*authpb.RecoveryCodes, error)
+	DisableMFA(ctx context.Context, userID int, code string) error
+	VerifyMFA(ctx context.Context, req auth.VerifyMFARequest) (*authpb.AuthResponse, error)
+	CreatePersonalToken(ctx context.Context, req auth.CreatePersonalTokenRequest) (*authpb.CreatedPersonalToken, error)
+	ListPersonalTokens(ctx context.Context, userID int) (*authpb.PersonalTokenList, error)
+	RevokePersonalToken(ctx context.Context, userID, tokenID int) error
+	AuthenticatePersonalToken(ctx context.Context, token string) (*authpb.TokenPrincipal, error)
+
+	ScheduleAccountDeletion(ctx context.Context, userID int, password, code string) (*authpb.AccountDeletion, error)
+	CancelAccountDeletion(ctx context.Context, userID int) error
+	ClaimAccountDeletions(ctx context.Context, limit int, lease time.Duration) (*authpb.AccountDeletionList, error)
+	AdvanceAccountDeletion(ctx context.Context, userID int, step string, imageIDs []string) error
+	DeleteUser(ctx context.Context, userID int) error
+	FilterUsedImages(ctx context.Context, ids []string) (*authpb.ImageIDs, error)
 }
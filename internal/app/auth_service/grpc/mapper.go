@@ -10,6 +10,7 @@ func RegisterToRequest(req *authpb.RegisterRequest) authmodels.RegisterRequest {
 		Email:    req.Email,
 		Password: req.Password,
 		Login:    req.Login,
+		Client:   ClientInfoToModel(req.GetClient()),
 	}
 }
 
@@ -17,6 +18,21 @@ func LoginToRequest(req *authpb.LoginRequest) authmodels.LoginRequest {
 	return authmodels.LoginRequest{
 		Password: req.Password,
 		Login:    req.Login,
+		Client:   ClientInfoToModel(req.GetClient()),
+	}
+}
+
+func ClientInfoToModel(client *authpb.ClientInfo) authmodels.ClientInfo {
+	return authmodels.ClientInfo{
+		UserAgent: client.GetUserAgent(),
+		IP:        client.GetIp(),
+	}
+}
+
+func PasswordResetToRequest(req *authpb.PasswordResetRequest) authmodels.PasswordResetRequest {
+	return authmodels.PasswordResetRequest{
+		Email:  req.GetEmail(),
+		Client: ClientInfoToModel(req.GetClient()),
 	}
 }
 
@@ -33,3 +49,49 @@ func UpdateProfileToRequest(req *authpb.UpdateProfileRequest) authmodels.UpdateP
 func ProtoIDtoInt(id *authpb.UserID) int {
 	return int(id.UserID)
 }
+
+func ImportProfileToUser(req *authpb.ImportProfileRequest) authmodels.User {
+	profile := req.GetProfile()
+	return authmodels.User{
+		ID:           int(req.UserId),
+		FirstName:    profile.GetFirstName(),
+		LastName:     profile.GetLastName(),
+		Description:  profile.GetDescription(),
+		LogoHashedID: profile.GetLogoHashedId(),
+	}
+}
+
+func SessionRequestToIDs(req *authpb.SessionRequest) (int, int) {
+	return int(req.GetUserId()), int(req.GetSessionId())
+}
+
+func ChangePasswordToRequest(req *authpb.ChangePasswordRequest) authmodels.ChangePasswordRequest {
+	return authmodels.ChangePasswordRequest{
+		UserID:      int(req.GetUserId()),
+		SessionID:   int(req.GetSessionId()),
+		OldPassword: req.GetOldPassword(),
+		NewPassword: req.GetNewPassword(),
+		Code:        req.GetCode(),
+	}
+}
+
+func VerifyMFAToRequest(req *authpb.VerifyMFARequest) authmodels.VerifyMFARequest {
+	return authmodels.VerifyMFARequest{
+		MFAToken: req.GetMfaToken(),
+		Code:     req.GetCode(),
+		Client:   ClientInfoToModel(req.GetClient()),
+	}
+}
+
+func CreatePersonalTokenToRequest(req *authpb.CreatePersonalTokenRequest) authmodels.CreatePersonalTokenRequest {
+	res := authmodels.CreatePersonalTokenRequest{
+		UserID: int(req.GetUserId()),
+		Name:   req.GetName(),
+		Scopes: req.GetScopes(),
+	}
+	if req.GetExpiresAt() != nil {
+		expiresAt := req.GetExpiresAt().AsTime()
+		res.ExpiresAt = &expiresAt
+	}
+	return res
+}
@@ -2,8 +2,10 @@ package grpc
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
@@ -52,3 +54,14 @@ func TestUpdateProfileToRequest(t *testing.T) {
 func TestProtoIDtoInt(t *testing.T) {
 	require.Equal(t, 7, ProtoIDtoInt(&authpb.UserID{UserID: 7}))
 }
+
+func TestCreatePersonalTokenToRequest(t *testing.T) {
+	result := CreatePersonalTokenToRequest(&authpb.CreatePersonalTokenRequest{UserId: 1, Name: "import", Scopes: []string{"operations:write"}})
+	require.Equal(t, 1, result.UserID)
+	require.Nil(t, result.ExpiresAt)
+
+	expires := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	result = CreatePersonalTokenToRequest(&authpb.CreatePersonalTokenRequest{UserId: 1, ExpiresAt: timestamppb.New(expires)})
+	require.NotNil(t, result.ExpiresAt)
+	require.True(t, expires.Equal(*result.ExpiresAt))
+}
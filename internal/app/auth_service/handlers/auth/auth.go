@@ -10,7 +10,9 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
@@ -21,19 +23,45 @@ type Handler struct {
 	clock      clock.Clock
 	logger     logger.Logger
 	authClient authpb.AuthServiceClient
+	finClient  finpb.FinanceServiceClient
 }
 
-func NewHandler(clck clock.Clock, logger logger.Logger, authCLient authpb.AuthServiceClient) *Handler {
-	return &Handler{clock: clck, logger: logger, authClient: authCLient}
+func NewHandler(clck clock.Clock, logger logger.Logger, authCLient authpb.AuthServiceClient, finClient finpb.FinanceServiceClient) *Handler {
+	return &Handler{clock: clck, logger: logger, authClient: authCLient, finClient: finClient}
+}
+
+// setSessionCookies переносит токены из ответа в cookie, в теле они не возвращаются
+func setSessionCookies(w http.ResponseWriter, response *authpb.AuthResponse) {
+	isProduction := os.Getenv("ENV") == "production"
+	utils.SetAuthCookie(w, response.Token, isProduction)
+	utils.SetRefreshCookie(w, response.RefreshToken, isProduction)
+
+	response.Token = ""
+	response.RefreshToken = ""
+}
+
+// clientInfo клиент запроса для списка сессий
+func clientInfo(r *http.Request) *authpb.ClientInfo {
+	return &authpb.ClientInfo{
+		UserAgent: r.UserAgent(),
+		Ip:        middleware.GetClientIP(r),
+	}
+}
+
+func clearSessionCookies(w http.ResponseWriter) {
+	isProduction := os.Getenv("ENV") == "production"
+	utils.ClearAuthCookie(w, isProduction)
+	utils.ClearRefreshCookie(w, isProduction)
 }
 
 // Register godoc
 // @Summary Регистрация нового пользователя
-// @Description Создает нового пользователя в системе
+// @Description Создает нового пользователя в системе и набор категорий по умолчанию на языке из Accept-Language
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body models.RegisterRequest true "Данные для регистрации"
+// @Param Accept-Language header string false "Язык категорий по умолчанию (ru, en)"
 // @Success 201 {object} models.AuthResponse "Пользователь успешно создан"
 // @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST, MISSING_FIELDS, INVALID_EMAIL, INVALID_PASSWORD, INVALID_LOGIN, WEAK_PASSWORD)"
 // @Failure 409 {object} models.ErrorResponse "Конфликт (USER_EXISTS, EMAIL_EXISTS, LOGIN_EXISTS)"
@@ -53,7 +81,9 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	// response, err := h.authUC.Register(r.Context(), req)
-	response, err := h.authClient.Register(r.Context(), RegisterApiToProtoRegister(req))
+	registerReq := RegisterApiToProtoRegister(req)
+	registerReq.Client = clientInfo(r)
+	response, err := h.authClient.Register(r.Context(), registerReq)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if !ok {
@@ -78,23 +108,31 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isProduction := os.Getenv("ENV") == "production"
-	utils.SetAuthCookie(w, response.Token, isProduction)
+	// без категорий по умолчанию пользователь все равно зарегистрирован,
+	// набор можно восстановить позже через POST /categories/defaults
+	_, err = h.finClient.ProvisionDefaultCategories(r.Context(), &finpb.ProvisionDefaultCategoriesRequest{
+		UserId: response.User.GetId(),
+		Locale: r.Header.Get("Accept-Language"),
+	})
+	if err != nil && h.logger != nil {
+		h.logger.Warn("Failed to provision default categories", "user_id", response.User.GetId(), "error", err)
+	}
 
-	response.Token = ""
+	setSessionCookies(w, response)
 	httputil.Created(w, r, response)
 }
 
 // Login godoc
 // @Summary Вход в систему
-// @Description Аутентификация пользователя по логину и паролю
+// @Description Аутентификация пользователя по логину и паролю. После серии неудачных попыток вход по логину или с IP временно блокируется, каждая следующая ошибка удваивает блокировку. Если у пользователя включена двухфакторная аутентификация, вместо сессии возвращаются mfa_required и mfa_token для /auth/login/mfa
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body models.LoginRequest true "Данные для входа"
 // @Success 200 {object} models.AuthResponse "Успешный вход"
 // @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST, MISSING_FIELDS, INVALID_LOGIN, INVALID_PASSWORD)"
-// @Failure 401 {object} models.ErrorResponse "Неверные учетные данные (INVALID_CREDENTIALS, USER_NOT_FOUND, ACCOUNT_LOCKED)"
+// @Failure 401 {object} models.ErrorResponse "Неверные учетные данные (INVALID_CREDENTIALS, USER_NOT_FOUND)"
+// @Failure 429 {object} models.ErrorResponse "Вход временно заблокирован (ACCOUNT_LOCKED)"
 // @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
 // @Router /auth/login [post]
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
@@ -112,7 +150,9 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// response, err := h.authUC.Login(r.Context(), req)
-	response, err := h.authClient.Login(r.Context(), LoginApiToProtoLogin(req))
+	loginReq := LoginApiToProtoLogin(req)
+	loginReq.Client = clientInfo(r)
+	response, err := h.authClient.Login(r.Context(), loginReq)
 
 	if err != nil {
 		st, ok := status.FromError(err)
@@ -121,26 +161,32 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		switch st.Code() {
-		case codes.NotFound:
-			switch st.Message() {
-			case string(models.ErrCodeInvalidCredentials):
-				httputil.UnauthorizedError(w, r, "Неверные логин или пароль", models.ErrCodeInvalidCredentials)
-				return
-			case string(models.ErrCodeUserNotFound):
-				httputil.UnauthorizedError(w, r, "Неверные логин или пароль", models.ErrCodeUserNotFound)
-				return
-			default:
-				httputil.InternalError(w, r, "Failed to login")
-				return
+		case codes.NotFound, codes.Unauthenticated:
+			code := models.ErrorCode(st.Message())
+			if code != models.ErrCodeUserNotFound {
+				code = models.ErrCodeInvalidCredentials
+			}
+			httputil.UnauthorizedError(w, r, "Неверные логин или пароль", code)
+		case codes.ResourceExhausted:
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Слишком много неудачных попыток входа, попробуйте позже", "", "", models.ErrCodeAccountLocked,
+			), http.StatusTooManyRequests)
+		default:
+			if h.logger != nil {
+				h.logger.Error("Failed to login", "error", err)
 			}
+			httputil.InternalError(w, r, "Failed to login")
 		}
 		return
 	}
 
-	isProduction := os.Getenv("ENV") == "production"
-	utils.SetAuthCookie(w, response.Token, isProduction)
+	// с включенной 2FA сессии еще нет: клиент отправляет mfa_token и код в /auth/login/mfa
+	if response.GetMfaRequired() {
+		httputil.Success(w, r, response)
+		return
+	}
 
-	response.Token = ""
+	setSessionCookies(w, response)
 	httputil.Success(w, r, response)
 }
 
@@ -166,18 +212,75 @@ func (h *Handler) GetCSRFToken(w http.ResponseWriter, r *http.Request) {
 	httputil.Success(w, r, map[string]string{"csrf_token": token})
 }
 
+// RefreshToken godoc
+// @Summary Обновление токенов
+// @Description Обменивает refresh-токен из cookie на новую пару токенов. Refresh-токен одноразовый: повторное использование уже обменянного токена завершает сессию
+// @Tags auth
+// @Produce json
+// @Success 200 {object} models.AuthResponse "Токены обновлены"
+// @Failure 401 {object} models.ErrorResponse "Нужен повторный вход (TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED, SESSION_REVOKED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/refresh [post]
+func (h *Handler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	refreshToken, err := utils.GetRefreshCookie(r)
+	if err != nil || refreshToken == "" {
+		httputil.UnauthorizedError(w, r, "Требуется повторный вход", models.ErrCodeTokenMissing)
+		return
+	}
+
+	response, err := h.authClient.RefreshToken(r.Context(), &authpb.RefreshTokenRequest{
+		RefreshToken: refreshToken,
+		Client:       clientInfo(r),
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if ok && st.Code() == codes.Unauthenticated {
+			clearSessionCookies(w)
+			code := models.ErrorCode(st.Message())
+			httputil.UnauthorizedError(w, r, code.GetErrorMessage(), code)
+			return
+		}
+		if h.logger != nil {
+			h.logger.Error("Failed to refresh token", "error", err)
+		}
+		httputil.InternalError(w, r, "Failed to refresh token")
+		return
+	}
+
+	setSessionCookies(w, response)
+	httputil.Success(w, r, response)
+}
+
 // Logout godoc
 // @Summary Выход из системы
-// @Description Завершает сессию пользователя
+// @Description Завершает текущую сессию пользователя: ее access- и refresh-токены перестают действовать
 // @Tags auth
 // @Produce json
 // @Security ApiKeyAuth
 // @Success 200 {object} map[string]string "Успешный выход"
 // @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Не удалось завершить сессию (INTERNAL_ERROR)"
 // @Router /auth/logout [post]
 func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
 	isProduction := os.Getenv("ENV") == "production"
-	utils.ClearAuthCookie(w, isProduction)
+	clearSessionCookies(w)
 	utils.ClearCSRFCookie(w, isProduction)
+
+	userID, _ := middleware.GetUserIDFromContext(r.Context())
+	if sessionID, ok := middleware.GetSessionIDFromContext(r.Context()); ok {
+		_, err := h.authClient.RevokeSession(r.Context(), &authpb.SessionRequest{
+			UserId:    int32(userID),
+			SessionId: int32(sessionID),
+		})
+		// уже отозванная сессия — тоже успешный выход
+		if err != nil && status.Code(err) != codes.NotFound {
+			if h.logger != nil {
+				h.logger.Error("Failed to revoke session", "error", err, "user_id", userID, "session_id", sessionID)
+			}
+			httputil.InternalError(w, r, "Failed to logout")
+			return
+		}
+	}
+
 	httputil.Success(w, r, map[string]string{"message": "Logged out successfully"})
 }
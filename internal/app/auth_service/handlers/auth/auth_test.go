@@ -2,18 +2,25 @@ package auth
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestRegister_Success(t *testing.T) {
@@ -21,7 +28,8 @@ func TestRegister_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockClient := mocks.NewMockAuthServiceClient(ctrl)
-	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient)
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, mockFin)
 
 	registerReq := models.RegisterRequest{
 		Email:    "test@example.com",
@@ -41,8 +49,40 @@ func TestRegister_Success(t *testing.T) {
 			},
 		}, nil)
 
+	mockFin.
+		EXPECT().
+		ProvisionDefaultCategories(gomock.Any(), &finpb.ProvisionDefaultCategoriesRequest{UserId: 1, Locale: "en-US"}).
+		Return(&finpb.ListCategoriesResponse{}, nil)
+
 	body, _ := json.Marshal(registerReq)
 	req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewBuffer(body))
+	req.Header.Set("Accept-Language", "en-US")
+	rr := httptest.NewRecorder()
+
+	handler.Register(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+}
+
+func TestRegister_DefaultCategoriesFailureDoesNotBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, mockFin)
+
+	mockClient.
+		EXPECT().
+		Register(gomock.Any(), gomock.Any()).
+		Return(&authpb.AuthResponse{Token: "jwt-token", User: &authpb.User{Id: 1}}, nil)
+	mockFin.
+		EXPECT().
+		ProvisionDefaultCategories(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("finance unavailable"))
+
+	body, _ := json.Marshal(models.RegisterRequest{Email: "test@example.com", Login: "testuser", Password: "password123"})
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewBuffer(body))
 	rr := httptest.NewRecorder()
 
 	handler.Register(rr, req)
@@ -55,7 +95,7 @@ func TestLogin_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockClient := mocks.NewMockAuthServiceClient(ctrl)
-	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
 
 	loginReq := models.LoginRequest{
 		Login:    "testuser",
@@ -82,12 +122,43 @@ func TestLogin_Success(t *testing.T) {
 	require.Equal(t, http.StatusOK, rr.Code)
 }
 
+func TestLogin_Errors(t *testing.T) {
+	cases := map[string]struct {
+		err    error
+		status int
+		code   models.ErrorCode
+	}{
+		"wrong password": {status.Error(codes.Unauthenticated, string(models.ErrCodeInvalidCredentials)), http.StatusUnauthorized, models.ErrCodeInvalidCredentials},
+		"unknown login":  {status.Error(codes.NotFound, string(models.ErrCodeUserNotFound)), http.StatusUnauthorized, models.ErrCodeUserNotFound},
+		"locked":         {status.Error(codes.ResourceExhausted, string(models.ErrCodeAccountLocked)), http.StatusTooManyRequests, models.ErrCodeAccountLocked},
+		"internal":       {status.Error(codes.Internal, string(models.ErrCodeInternalError)), http.StatusInternalServerError, models.ErrCodeInternalError},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockAuthServiceClient(ctrl)
+			handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+			mockClient.EXPECT().Login(gomock.Any(), gomock.Any()).Return(nil, tc.err)
+
+			body, _ := json.Marshal(models.LoginRequest{Login: "testuser", Password: "password123"})
+			rr := httptest.NewRecorder()
+			handler.Login(rr, httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBuffer(body)))
+
+			require.Equal(t, tc.status, rr.Code)
+			require.Contains(t, rr.Body.String(), string(tc.code))
+		})
+	}
+}
+
 func TestLogout_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockClient := mocks.NewMockAuthServiceClient(ctrl)
-	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
 	rr := httptest.NewRecorder()
@@ -102,7 +173,7 @@ func TestRegister_ValidationError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockClient := mocks.NewMockAuthServiceClient(ctrl)
-	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
 
 	invalidReq := map[string]string{"email": "invalid"}
 	body, _ := json.Marshal(invalidReq)
@@ -120,7 +191,7 @@ func TestLogin_ValidationError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockClient := mocks.NewMockAuthServiceClient(ctrl)
-	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
 
 	invalidReq := map[string]string{"login": ""}
 
@@ -138,7 +209,7 @@ func TestRegister_InvalidJSON(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockClient := mocks.NewMockAuthServiceClient(ctrl)
-	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewBufferString("invalid json"))
 	rr := httptest.NewRecorder()
@@ -153,7 +224,7 @@ func TestLogin_InvalidJSON(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockClient := mocks.NewMockAuthServiceClient(ctrl)
-	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBufferString("invalid json"))
 	rr := httptest.NewRecorder()
@@ -168,7 +239,7 @@ func TestGetCSRFToken_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockClient := mocks.NewMockAuthServiceClient(ctrl)
-	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
 
 	mockClient.
 		EXPECT().
@@ -182,3 +253,92 @@ func TestGetCSRFToken_Success(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, rr.Code)
 }
+
+func TestRefreshToken_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.
+		EXPECT().
+		RefreshToken(gomock.Any(), &authpb.RefreshTokenRequest{
+			RefreshToken: "old",
+			Client:       &authpb.ClientInfo{UserAgent: "test-agent", Ip: "192.0.2.1"},
+		}).
+		Return(&authpb.AuthResponse{Token: "access", RefreshToken: "new", User: &authpb.User{Id: 1}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", nil)
+	req.Header.Set("User-Agent", "test-agent")
+	req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "old"})
+	rr := httptest.NewRecorder()
+
+	handler.RefreshToken(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	cookies := map[string]string{}
+	for _, c := range rr.Result().Cookies() {
+		cookies[c.Name] = c.Value
+	}
+	require.Equal(t, "access", cookies["auth_token"])
+	require.Equal(t, "new", cookies["refresh_token"])
+	require.NotContains(t, rr.Body.String(), "new")
+}
+
+func TestRefreshToken_MissingCookie(t *testing.T) {
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), nil, nil)
+
+	rr := httptest.NewRecorder()
+	handler.RefreshToken(rr, httptest.NewRequest(http.MethodPost, "/auth/refresh", nil))
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeTokenMissing))
+}
+
+func TestRefreshToken_SessionRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.
+		EXPECT().
+		RefreshToken(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unauthenticated, string(models.ErrCodeSessionRevoked)))
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", nil)
+	req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "stolen"})
+	rr := httptest.NewRecorder()
+
+	handler.RefreshToken(rr, req)
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeSessionRevoked))
+	for _, c := range rr.Result().Cookies() {
+		require.Empty(t, c.Value)
+	}
+}
+
+func TestLogout_RevokesSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.
+		EXPECT().
+		RevokeSession(gomock.Any(), &authpb.SessionRequest{UserId: 1, SessionId: 5}).
+		Return(&emptypb.Empty{}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+	ctx := context.WithValue(req.Context(), middleware.UserIDKey, 1)
+	ctx = context.WithValue(ctx, middleware.SessionIDKey, 5)
+	rr := httptest.NewRecorder()
+
+	handler.Logout(rr, req.WithContext(ctx))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
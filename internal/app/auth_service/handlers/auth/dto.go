@@ -12,3 +12,55 @@ type UserAPI struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+type SessionAPI struct {
+	ID         int       `json:"id"`
+	Device     string    `json:"device"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Current    bool      `json:"current"`
+}
+
+type SessionsAPI struct {
+	Sessions []SessionAPI `json:"sessions"`
+}
+
+type EmailConfirmationAPI struct {
+	// Status email_verified, email_change_pending или email_changed
+	Status string `json:"status"`
+	Email  string `json:"email"`
+}
+
+type MFAEnrollmentAPI struct {
+	// Secret для ручного ввода в приложение
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"`
+	// QRPNG ссылка otpauth:// в виде QR-кода, PNG в base64
+	QRPNG []byte `json:"qr_png"`
+}
+
+type RecoveryCodesAPI struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type PersonalTokenAPI struct {
+	ID     int      `json:"id"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	// ExpiresAt пустой у бессрочных токенов
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type PersonalTokensAPI struct {
+	Tokens []PersonalTokenAPI `json:"tokens"`
+}
+
+type CreatedPersonalTokenAPI struct {
+	// Token показывается один раз
+	Token string `json:"token"`
+	PersonalTokenAPI
+}
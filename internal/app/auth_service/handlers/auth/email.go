@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputil "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// ConfirmEmail godoc
+// @Summary Подтверждение email по ссылке из письма
+// @Description Применяет токен из письма: подтверждает адрес после регистрации или одну из сторон смены email. Email меняется, когда подтверждены и старый, и новый адрес
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.ConfirmEmailRequest true "Токен из письма"
+// @Success 200 {object} EmailConfirmationAPI "Результат: email_verified, email_change_pending или email_changed"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные или недействительная ссылка (INVALID_REQUEST, TOKEN_INVALID)"
+// @Failure 409 {object} models.ErrorResponse "Новый адрес уже занят (EMAIL_EXISTS)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/email/confirm [post]
+func (h *Handler) ConfirmEmail(w http.ResponseWriter, r *http.Request) {
+	var req models.ConfirmEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
+		httputil.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	res, err := h.authClient.ConfirmEmail(r.Context(), &authpb.EmailTokenRequest{Token: req.Token})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Ссылка подтверждения недействительна или устарела", "", "token", models.ErrCodeTokenInvalid,
+			), http.StatusBadRequest)
+		case codes.AlreadyExists:
+			httputil.ConflictError(w, r, "Пользователь с таким email уже существует", models.ErrCodeEmailExists)
+		default:
+			if h.logger != nil {
+				h.logger.Error("Failed to confirm email", "error", err)
+			}
+			httputil.InternalError(w, r, "Failed to confirm email")
+		}
+		return
+	}
+
+	httputil.Success(w, r, EmailConfirmationAPI{Status: res.GetStatus(), Email: res.GetEmail()})
+}
+
+// ResendEmailVerification godoc
+// @Summary Повторная отправка ссылки подтверждения email
+// @Description Отправляет новую ссылку подтверждения на текущий адрес пользователя
+// @Tags auth
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 202 {object} map[string]string "Письмо отправлено"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 409 {object} models.ErrorResponse "Email уже подтвержден (EMAIL_ALREADY_VERIFIED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/email/verify/resend [post]
+func (h *Handler) ResendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	if _, err := h.authClient.ResendEmailVerification(r.Context(), &authpb.UserID{UserID: int32(userID)}); err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			httputil.ConflictError(w, r, "Email уже подтвержден", models.ErrCodeEmailAlreadyVerified)
+			return
+		}
+		if h.logger != nil {
+			h.logger.Error("Failed to resend email verification", "error", err, "user_id", userID)
+		}
+		httputil.InternalError(w, r, "Failed to resend email verification")
+		return
+	}
+
+	httputil.JSON(w, r, map[string]string{"message": "Ссылка для подтверждения отправлена на ваш email"}, http.StatusAccepted)
+}
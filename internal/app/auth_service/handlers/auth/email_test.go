@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestConfirmEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ConfirmEmail(gomock.Any(), &authpb.EmailTokenRequest{Token: "token"}).
+		Return(&authpb.EmailConfirmation{Status: "email_changed", Email: "new@example.com"}, nil)
+
+	body, _ := json.Marshal(models.ConfirmEmailRequest{Token: "token"})
+	rr := httptest.NewRecorder()
+	handler.ConfirmEmail(rr, httptest.NewRequest(http.MethodPost, "/auth/email/confirm", bytes.NewBuffer(body)))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp EmailConfirmationAPI
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, EmailConfirmationAPI{Status: "email_changed", Email: "new@example.com"}, resp)
+}
+
+func TestConfirmEmail_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantBody models.ErrorCode
+	}{
+		{"invalid token", status.Error(codes.Unauthenticated, string(models.ErrCodeTokenInvalid)), http.StatusBadRequest, models.ErrCodeTokenInvalid},
+		{"email taken", status.Error(codes.AlreadyExists, string(models.ErrCodeEmailExists)), http.StatusConflict, models.ErrCodeEmailExists},
+		{"internal", status.Error(codes.Internal, string(models.ErrCodeInternalError)), http.StatusInternalServerError, models.ErrCodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockAuthServiceClient(ctrl)
+			handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+			mockClient.EXPECT().ConfirmEmail(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+			body, _ := json.Marshal(models.ConfirmEmailRequest{Token: "token"})
+			rr := httptest.NewRecorder()
+			handler.ConfirmEmail(rr, httptest.NewRequest(http.MethodPost, "/auth/email/confirm", bytes.NewBuffer(body)))
+
+			require.Equal(t, tt.wantCode, rr.Code)
+			require.Contains(t, rr.Body.String(), string(tt.wantBody))
+		})
+	}
+}
+
+func TestResendEmailVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ResendEmailVerification(gomock.Any(), &authpb.UserID{UserID: 1}).
+		Return(&emptypb.Empty{}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.ResendEmailVerification(rr, withSession(httptest.NewRequest(http.MethodPost, "/auth/email/verify/resend", nil), 1, 5))
+
+	require.Equal(t, http.StatusAccepted, rr.Code)
+}
+
+func TestResendEmailVerification_AlreadyVerified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ResendEmailVerification(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.FailedPrecondition, string(models.ErrCodeEmailAlreadyVerified)))
+
+	rr := httptest.NewRecorder()
+	handler.ResendEmailVerification(rr, withSession(httptest.NewRequest(http.MethodPost, "/auth/email/verify/resend", nil), 1, 5))
+
+	require.Equal(t, http.StatusConflict, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeEmailAlreadyVerified))
+}
@@ -19,3 +19,45 @@ func RegisterApiToProtoRegister(req models.RegisterRequest) *proto.RegisterReque
 		Password: req.Password,
 	}
 }
+
+func SessionsProtoToApi(list *proto.SessionList) SessionsAPI {
+	sessions := make([]SessionAPI, 0, len(list.GetSessions()))
+	for _, s := range list.GetSessions() {
+		sessions = append(sessions, SessionAPI{
+			ID:         int(s.GetId()),
+			Device:     s.GetDevice(),
+			UserAgent:  s.GetUserAgent(),
+			IP:         s.GetIp(),
+			CreatedAt:  s.GetCreatedAt().AsTime(),
+			LastSeenAt: s.GetLastSeenAt().AsTime(),
+			Current:    s.GetCurrent(),
+		})
+	}
+	return SessionsAPI{Sessions: sessions}
+}
+
+func PersonalTokenProtoToApi(token *proto.PersonalToken) PersonalTokenAPI {
+	res := PersonalTokenAPI{
+		ID:        int(token.GetId()),
+		Name:      token.GetName(),
+		Scopes:    token.GetScopes(),
+		CreatedAt: token.GetCreatedAt().AsTime(),
+	}
+	if token.GetExpiresAt() != nil {
+		expiresAt := token.GetExpiresAt().AsTime()
+		res.ExpiresAt = &expiresAt
+	}
+	if token.GetLastUsedAt() != nil {
+		lastUsedAt := token.GetLastUsedAt().AsTime()
+		res.LastUsedAt = &lastUsedAt
+	}
+	return res
+}
+
+func PersonalTokensProtoToApi(list *proto.PersonalTokenList) PersonalTokensAPI {
+	tokens := make([]PersonalTokenAPI, 0, len(list.GetTokens()))
+	for _, t := range list.GetTokens() {
+		tokens = append(tokens, PersonalTokenProtoToApi(t))
+	}
+	return PersonalTokensAPI{Tokens: tokens}
+}
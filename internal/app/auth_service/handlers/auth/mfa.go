@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputil "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+func mfaCodeError(w http.ResponseWriter, r *http.Request, httpStatus int) {
+	httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+		models.ErrCodeMFACodeInvalid.GetErrorMessage(), "", "code", models.ErrCodeMFACodeInvalid,
+	), httpStatus)
+}
+
+// mfaStateError 409 на FailedPrecondition: второй фактор уже включен или еще не включен
+func mfaStateError(w http.ResponseWriter, r *http.Request, err error) {
+	code := models.ErrorCode(status.Convert(err).Message())
+	if code != models.ErrCodeMFAAlreadyEnabled {
+		code = models.ErrCodeMFANotEnabled
+	}
+	httputil.ConflictError(w, r, code.GetErrorMessage(), code)
+}
+
+// decodeMFACode разбирает тело с кодом; при ошибке ответ уже отправлен
+func decodeMFACode(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var req models.MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.ValidationError(w, r, "Некорректный формат данных", "body")
+		return "", false
+	}
+	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
+		httputil.ValidationErrors(w, r, validationErrors)
+		return "", false
+	}
+	return req.Code, true
+}
+
+// EnrollMFA godoc
+// @Summary Подключение двухфакторной аутентификации
+// @Description Выдает новый секрет TOTP: ссылку otpauth:// и QR-код (PNG в base64) для приложения-аутентификатора. Второй фактор включается только после подтверждения кодом; повторный вызов до подтверждения заменяет секрет
+// @Tags auth
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} MFAEnrollmentAPI "Секрет, ссылка и QR-код"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 409 {object} models.ErrorResponse "Двухфакторная аутентификация уже включена (MFA_ALREADY_ENABLED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/mfa/enroll [post]
+func (h *Handler) EnrollMFA(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	res, err := h.authClient.EnrollMFA(r.Context(), &authpb.UserID{UserID: int32(userID)})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			mfaStateError(w, r, err)
+			return
+		}
+		if h.logger != nil {
+			h.logger.Error("Failed to enroll MFA", "error", err, "user_id", userID)
+		}
+		httputil.InternalError(w, r, "Failed to enroll MFA")
+		return
+	}
+
+	httputil.Success(w, r, MFAEnrollmentAPI{
+		Secret:     res.GetSecret(),
+		OtpauthURI: res.GetOtpauthUri(),
+		QRPNG:      res.GetQrPng(),
+	})
+}
+
+// ConfirmMFA godoc
+// @Summary Включение двухфакторной аутентификации
+// @Description Включает второй фактор по первому коду из приложения и возвращает резервные коды. Коды показываются один раз, каждый срабатывает однократно
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.MFACodeRequest true "Код из приложения"
+// @Success 200 {object} RecoveryCodesAPI "Резервные коды"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные или неверный код (INVALID_REQUEST, MFA_CODE_INVALID)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 409 {object} models.ErrorResponse "Подключение не начато или уже завершено (MFA_NOT_ENABLED, MFA_ALREADY_ENABLED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/mfa/confirm [post]
+func (h *Handler) ConfirmMFA(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+	code, ok := decodeMFACode(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.authClient.ConfirmMFA(r.Context(), &authpb.MFACodeRequest{UserId: int32(userID), Code: code})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			mfaCodeError(w, r, http.StatusBadRequest)
+		case codes.FailedPrecondition:
+			mfaStateError(w, r, err)
+		default:
+			if h.logger != nil {
+				h.logger.Error("Failed to confirm MFA", "error", err, "user_id", userID)
+			}
+			httputil.InternalError(w, r, "Failed to confirm MFA")
+		}
+		return
+	}
+
+	httputil.Success(w, r, RecoveryCodesAPI{RecoveryCodes: res.GetCodes()})
+}
+
+// DisableMFA godoc
+// @Summary Отключение двухфакторной аутентификации
+// @Description Выключает второй фактор; нужен действующий код из приложения или резервный код
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.MFACodeRequest true "Код из приложения или резервный код"
+// @Success 200 {object} map[string]string "Двухфакторная аутентификация отключена"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные или неверный код (INVALID_REQUEST, MFA_CODE_INVALID)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 409 {object} models.ErrorResponse "Двухфакторная аутентификация не включена (MFA_NOT_ENABLED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/mfa/disable [post]
+func (h *Handler) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+	code, ok := decodeMFACode(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.authClient.DisableMFA(r.Context(), &authpb.MFACodeRequest{UserId: int32(userID), Code: code}); err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			mfaCodeError(w, r, http.StatusBadRequest)
+		case codes.FailedPrecondition:
+			mfaStateError(w, r, err)
+		default:
+			if h.logger != nil {
+				h.logger.Error("Failed to disable MFA", "error", err, "user_id", userID)
+			}
+			httputil.InternalError(w, r, "Failed to disable MFA")
+		}
+		return
+	}
+
+	httputil.Success(w, r, map[string]string{"message": "Двухфакторная аутентификация отключена"})
+}
+
+// VerifyMFA godoc
+// @Summary Второй шаг входа
+// @Description Обменивает mfa_token из ответа /auth/login и код из приложения (или резервный код) на сессию. Неверные коды учитываются в блокировке входа наравне с неверными паролями
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.VerifyMFARequest true "Токен первого шага и код"
+// @Success 200 {object} models.AuthResponse "Успешный вход"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Неверный код или истек токен первого шага (MFA_CODE_INVALID, TOKEN_INVALID)"
+// @Failure 429 {object} models.ErrorResponse "Вход временно заблокирован (ACCOUNT_LOCKED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/login/mfa [post]
+func (h *Handler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req models.VerifyMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
+		httputil.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	response, err := h.authClient.VerifyMFA(r.Context(), &authpb.VerifyMFARequest{
+		MfaToken: req.MFAToken,
+		Code:     req.Code,
+		Client:   clientInfo(r),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			if models.ErrorCode(status.Convert(err).Message()) == models.ErrCodeMFACodeInvalid {
+				mfaCodeError(w, r, http.StatusUnauthorized)
+				return
+			}
+			httputil.UnauthorizedError(w, r, "Время на ввод кода истекло, войдите снова", models.ErrCodeTokenInvalid)
+		case codes.FailedPrecondition:
+			// второй фактор отключили между шагами входа
+			httputil.UnauthorizedError(w, r, "Войдите снова", models.ErrCodeTokenInvalid)
+		case codes.ResourceExhausted:
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Слишком много неудачных попыток входа, попробуйте позже", "", "", models.ErrCodeAccountLocked,
+			), http.StatusTooManyRequests)
+		default:
+			if h.logger != nil {
+				h.logger.Error("Failed to verify MFA", "error", err)
+			}
+			httputil.InternalError(w, r, "Failed to verify MFA")
+		}
+		return
+	}
+
+	setSessionCookies(w, response)
+	httputil.Success(w, r, response)
+}
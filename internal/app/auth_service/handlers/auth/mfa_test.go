@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestEnrollMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		EnrollMFA(gomock.Any(), &authpb.UserID{UserID: 1}).
+		Return(&authpb.MFAEnrollment{Secret: "JBSWY3DPEHPK3PXP", OtpauthUri: "otpauth://totp/x", QrPng: []byte{0x89, 'P', 'N', 'G'}}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.EnrollMFA(rr, withSession(httptest.NewRequest(http.MethodPost, "/auth/mfa/enroll", nil), 1, 5))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp MFAEnrollmentAPI
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, "otpauth://totp/x", resp.OtpauthURI)
+	require.Equal(t, []byte{0x89, 'P', 'N', 'G'}, resp.QRPNG)
+}
+
+func TestEnrollMFA_AlreadyEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		EnrollMFA(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.FailedPrecondition, string(models.ErrCodeMFAAlreadyEnabled)))
+
+	rr := httptest.NewRecorder()
+	handler.EnrollMFA(rr, withSession(httptest.NewRequest(http.MethodPost, "/auth/mfa/enroll", nil), 1, 5))
+
+	require.Equal(t, http.StatusConflict, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeMFAAlreadyEnabled))
+}
+
+func TestConfirmMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ConfirmMFA(gomock.Any(), &authpb.MFACodeRequest{UserId: 1, Code: "123456"}).
+		Return(&authpb.RecoveryCodes{Codes: []string{"abcde-fghij"}}, nil)
+
+	body, _ := json.Marshal(models.MFACodeRequest{Code: "123456"})
+	rr := httptest.NewRecorder()
+	handler.ConfirmMFA(rr, withSession(httptest.NewRequest(http.MethodPost, "/auth/mfa/confirm", bytes.NewBuffer(body)), 1, 5))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp RecoveryCodesAPI
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, []string{"abcde-fghij"}, resp.RecoveryCodes)
+}
+
+func TestDisableMFA_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantBody models.ErrorCode
+	}{
+		{"invalid code", status.Error(codes.Unauthenticated, string(models.ErrCodeMFACodeInvalid)), http.StatusBadRequest, models.ErrCodeMFACodeInvalid},
+		{"not enabled", status.Error(codes.FailedPrecondition, string(models.ErrCodeMFANotEnabled)), http.StatusConflict, models.ErrCodeMFANotEnabled},
+		{"internal", status.Error(codes.Internal, string(models.ErrCodeInternalError)), http.StatusInternalServerError, models.ErrCodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockAuthServiceClient(ctrl)
+			handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+			mockClient.EXPECT().DisableMFA(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+			body, _ := json.Marshal(models.MFACodeRequest{Code: "123456"})
+			rr := httptest.NewRecorder()
+			handler.DisableMFA(rr, withSession(httptest.NewRequest(http.MethodPost, "/auth/mfa/disable", bytes.NewBuffer(body)), 1, 5))
+
+			require.Equal(t, tt.wantCode, rr.Code)
+			require.Contains(t, rr.Body.String(), string(tt.wantBody))
+		})
+	}
+}
+
+func TestDisableMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		DisableMFA(gomock.Any(), &authpb.MFACodeRequest{UserId: 1, Code: "abcde-fghij"}).
+		Return(&emptypb.Empty{}, nil)
+
+	body, _ := json.Marshal(models.MFACodeRequest{Code: "abcde-fghij"})
+	rr := httptest.NewRecorder()
+	handler.DisableMFA(rr, withSession(httptest.NewRequest(http.MethodPost, "/auth/mfa/disable", bytes.NewBuffer(body)), 1, 5))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestLogin_MFARequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		Login(gomock.Any(), gomock.Any()).
+		Return(&authpb.AuthResponse{MfaRequired: true, MfaToken: "mfa"}, nil)
+
+	body, _ := json.Marshal(models.LoginRequest{Login: "testuser", Password: "password123"})
+	rr := httptest.NewRecorder()
+	handler.Login(rr, httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBuffer(body)))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Empty(t, rr.Result().Cookies())
+	var resp models.AuthResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.True(t, resp.MFARequired)
+	require.Equal(t, "mfa", resp.MFAToken)
+}
+
+func TestVerifyMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		VerifyMFA(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *authpb.VerifyMFARequest, _ ...interface{}) (*authpb.AuthResponse, error) {
+			require.Equal(t, "mfa", req.GetMfaToken())
+			require.Equal(t, "123456", req.GetCode())
+			require.Equal(t, "Firefox", req.GetClient().GetUserAgent())
+			return &authpb.AuthResponse{Token: "access", RefreshToken: "refresh", User: &authpb.User{Id: 1}}, nil
+		})
+
+	body, _ := json.Marshal(models.VerifyMFARequest{MFAToken: "mfa", Code: "123456"})
+	req := httptest.NewRequest(http.MethodPost, "/auth/login/mfa", bytes.NewBuffer(body))
+	req.Header.Set("User-Agent", "Firefox")
+	rr := httptest.NewRecorder()
+	handler.VerifyMFA(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Len(t, rr.Result().Cookies(), 2)
+	require.NotContains(t, rr.Body.String(), "access")
+}
+
+func TestVerifyMFA_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantBody models.ErrorCode
+	}{
+		{"invalid code", status.Error(codes.Unauthenticated, string(models.ErrCodeMFACodeInvalid)), http.StatusUnauthorized, models.ErrCodeMFACodeInvalid},
+		{"expired token", status.Error(codes.Unauthenticated, string(models.ErrCodeTokenInvalid)), http.StatusUnauthorized, models.ErrCodeTokenInvalid},
+		{"locked", status.Error(codes.ResourceExhausted, string(models.ErrCodeAccountLocked)), http.StatusTooManyRequests, models.ErrCodeAccountLocked},
+		{"internal", status.Error(codes.Internal, string(models.ErrCodeInternalError)), http.StatusInternalServerError, models.ErrCodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockAuthServiceClient(ctrl)
+			handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+			mockClient.EXPECT().VerifyMFA(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+			body, _ := json.Marshal(models.VerifyMFARequest{MFAToken: "mfa", Code: "123456"})
+			rr := httptest.NewRecorder()
+			handler.VerifyMFA(rr, httptest.NewRequest(http.MethodPost, "/auth/login/mfa", bytes.NewBuffer(body)))
+
+			require.Equal(t, tt.wantCode, rr.Code)
+			require.Contains(t, rr.Body.String(), string(tt.wantBody))
+		})
+	}
+}
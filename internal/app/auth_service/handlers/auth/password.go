@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputil "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// weakPasswordError ответ на пароль, не прошедший парольную политику;
+// reason объясняет, что именно не так
+func weakPasswordError(w http.ResponseWriter, r *http.Request, reason string) {
+	httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+		models.ErrCodeWeakPassword.GetErrorMessage(), reason, "new_password", models.ErrCodeWeakPassword,
+	), http.StatusBadRequest)
+}
+
+// ChangePassword godoc
+// @Summary Смена пароля
+// @Description Меняет пароль после проверки текущего и кода 2FA, если она включена. Неверные пароли и коды считаются неудачными попытками входа. Новый пароль проверяется парольной политикой; все сессии, кроме текущей, завершаются, а персональные токены удаляются
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.ChangePasswordRequest true "Текущий и новый пароль, код 2FA"
+// @Success 200 {object} map[string]interface{} "Пароль изменен, revoked — число завершенных сессий"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные, неверный текущий пароль или код 2FA, слабый новый пароль (INVALID_REQUEST, INVALID_PASSWORD, MFA_CODE_INVALID, WEAK_PASSWORD)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 429 {object} models.ErrorResponse "Слишком много неудачных попыток (ACCOUNT_LOCKED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/password/change [post]
+func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	userID, sessionID, ok := currentSession(r)
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	var req models.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
+		httputil.ValidationErrors(w, r, validationErrors)
+		return
+	}
+	if req.NewPassword == req.OldPassword {
+		weakPasswordError(w, r, "Новый пароль совпадает с текущим")
+		return
+	}
+	if err := utils.CheckPasswordStrength(req.NewPassword); err != nil {
+		weakPasswordError(w, r, err.Error())
+		return
+	}
+
+	res, err := h.authClient.ChangePassword(r.Context(), &authpb.ChangePasswordRequest{
+		UserId:      int32(userID),
+		SessionId:   int32(sessionID),
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+		Code:        req.Code,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			if models.ErrorCode(status.Convert(err).Message()) == models.ErrCodeMFACodeInvalid {
+				mfaCodeError(w, r, http.StatusBadRequest)
+				return
+			}
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Неверный текущий пароль", "", "old_password", models.ErrCodeInvalidPassword,
+			), http.StatusBadRequest)
+		case codes.InvalidArgument:
+			// auth_service дополнительно сверяет пароль с логином и email
+			weakPasswordError(w, r, utils.ErrPasswordPersonal.Error())
+		case codes.ResourceExhausted:
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Слишком много неудачных попыток входа, попробуйте позже", "", "", models.ErrCodeAccountLocked,
+			), http.StatusTooManyRequests)
+		default:
+			if h.logger != nil {
+				h.logger.Error("Failed to change password", "error", err, "user_id", userID)
+			}
+			httputil.InternalError(w, r, "Failed to change password")
+		}
+		return
+	}
+
+	httputil.Success(w, r, map[string]interface{}{
+		"message": "Пароль изменен",
+		"revoked": res.GetCount(),
+	})
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputil "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// RequestPasswordReset godoc
+// @Summary Запрос сброса пароля
+// @Description Отправляет на email одноразовую ссылку для смены пароля. Ответ одинаковый для зарегистрированных и неизвестных адресов. Частые запросы для одного адреса или с одного IP временно блокируются
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.PasswordResetRequest true "Email аккаунта"
+// @Success 202 {object} map[string]string "Если адрес зарегистрирован, письмо отправлено"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST, INVALID_EMAIL)"
+// @Failure 429 {object} models.ErrorResponse "Слишком много запросов сброса (ACCOUNT_LOCKED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/password/reset [post]
+func (h *Handler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req models.PasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
+		httputil.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	_, err := h.authClient.RequestPasswordReset(r.Context(), &authpb.PasswordResetRequest{Email: req.Email, Client: clientInfo(r)})
+	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Слишком много запросов сброса пароля, попробуйте позже", "", "", models.ErrCodeAccountLocked,
+			), http.StatusTooManyRequests)
+			return
+		}
+		if h.logger != nil {
+			h.logger.Error("Failed to request password reset", "error", err)
+		}
+		httputil.InternalError(w, r, "Failed to request password reset")
+		return
+	}
+
+	httputil.JSON(w, r, map[string]string{"message": "Если адрес зарегистрирован, мы отправили на него ссылку для сброса пароля"}, http.StatusAccepted)
+}
+
+// ConfirmPasswordReset godoc
+// @Summary Смена пароля по ссылке из письма
+// @Description Задает новый пароль по токену из письма. Токен одноразовый; после смены пароля все сессии пользователя завершаются, а персональные токены удаляются
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.ConfirmPasswordResetRequest true "Токен из письма и новый пароль"
+// @Success 200 {object} map[string]string "Пароль изменен"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные, слабый пароль или недействительная ссылка (INVALID_REQUEST, WEAK_PASSWORD, TOKEN_INVALID)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/password/reset/confirm [post]
+func (h *Handler) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req models.ConfirmPasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
+		httputil.ValidationErrors(w, r, validationErrors)
+		return
+	}
+	if err := utils.CheckPasswordStrength(req.NewPassword); err != nil {
+		weakPasswordError(w, r, err.Error())
+		return
+	}
+
+	_, err := h.authClient.ConfirmPasswordReset(r.Context(), &authpb.ConfirmPasswordResetRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Ссылка для сброса пароля недействительна или устарела", "", "token", models.ErrCodeTokenInvalid,
+			), http.StatusBadRequest)
+		case codes.InvalidArgument:
+			weakPasswordError(w, r, "")
+		default:
+			if h.logger != nil {
+				h.logger.Error("Failed to confirm password reset", "error", err)
+			}
+			httputil.InternalError(w, r, "Failed to reset password")
+		}
+		return
+	}
+
+	// старые cookie этого браузера тоже больше не действуют
+	clearSessionCookies(w)
+	httputil.Success(w, r, map[string]string{"message": "Пароль изменен, войдите с новым паролем"})
+}
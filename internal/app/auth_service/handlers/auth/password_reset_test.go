@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestRequestPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		RequestPasswordReset(gomock.Any(), gomock.Cond(func(req *authpb.PasswordResetRequest) bool {
+			return req.Email == "ivan@example.com" && req.Client.GetIp() != ""
+		})).
+		Return(&emptypb.Empty{}, nil)
+
+	body, _ := json.Marshal(models.PasswordResetRequest{Email: "ivan@example.com"})
+	rr := httptest.NewRecorder()
+	handler.RequestPasswordReset(rr, httptest.NewRequest(http.MethodPost, "/auth/password/reset", bytes.NewBuffer(body)))
+
+	require.Equal(t, http.StatusAccepted, rr.Code)
+}
+
+func TestRequestPasswordReset_Throttled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		RequestPasswordReset(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.ResourceExhausted, string(models.ErrCodeAccountLocked)))
+
+	body, _ := json.Marshal(models.PasswordResetRequest{Email: "ivan@example.com"})
+	rr := httptest.NewRecorder()
+	handler.RequestPasswordReset(rr, httptest.NewRequest(http.MethodPost, "/auth/password/reset", bytes.NewBuffer(body)))
+
+	require.Equal(t, http.StatusTooManyRequests, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeAccountLocked))
+}
+
+func TestRequestPasswordReset_InvalidEmail(t *testing.T) {
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), nil, nil)
+
+	body, _ := json.Marshal(models.PasswordResetRequest{Email: "not-an-email"})
+	rr := httptest.NewRecorder()
+	handler.RequestPasswordReset(rr, httptest.NewRequest(http.MethodPost, "/auth/password/reset", bytes.NewBuffer(body)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ConfirmPasswordReset(gomock.Any(), &authpb.ConfirmPasswordResetRequest{Token: "token", NewPassword: "new-password"}).
+		Return(&emptypb.Empty{}, nil)
+
+	body, _ := json.Marshal(models.ConfirmPasswordResetRequest{Token: "token", NewPassword: "new-password"})
+	rr := httptest.NewRecorder()
+	handler.ConfirmPasswordReset(rr, httptest.NewRequest(http.MethodPost, "/auth/password/reset/confirm", bytes.NewBuffer(body)))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestConfirmPasswordReset_InvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ConfirmPasswordReset(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unauthenticated, string(models.ErrCodeTokenInvalid)))
+
+	body, _ := json.Marshal(models.ConfirmPasswordResetRequest{Token: "used", NewPassword: "new-password"})
+	rr := httptest.NewRecorder()
+	handler.ConfirmPasswordReset(rr, httptest.NewRequest(http.MethodPost, "/auth/password/reset/confirm", bytes.NewBuffer(body)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeTokenInvalid))
+}
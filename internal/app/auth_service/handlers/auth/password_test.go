@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func changePasswordRequest(oldPassword, newPassword string) *http.Request {
+	body, _ := json.Marshal(models.ChangePasswordRequest{OldPassword: oldPassword, NewPassword: newPassword})
+	req := httptest.NewRequest(http.MethodPost, "/auth/password/change", bytes.NewBuffer(body))
+	return withSession(req, 1, 5)
+}
+
+func TestChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ChangePassword(gomock.Any(), &authpb.ChangePasswordRequest{UserId: 1, SessionId: 5, OldPassword: "old-password", NewPassword: "Brand-new-pass"}).
+		Return(&authpb.RevokedSessions{Count: 2}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.ChangePassword(rr, changePasswordRequest("old-password", "Brand-new-pass"))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), `"revoked":2`)
+}
+
+func TestChangePassword_WeakPassword(t *testing.T) {
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), nil, nil)
+
+	for _, password := range []string{"short", "qwerty123", "old-password"} {
+		rr := httptest.NewRecorder()
+		handler.ChangePassword(rr, changePasswordRequest("old-password", password))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code, password)
+		require.Contains(t, rr.Body.String(), string(models.ErrCodeWeakPassword), password)
+	}
+}
+
+func TestChangePassword_WrongCurrentPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ChangePassword(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unauthenticated, string(models.ErrCodeInvalidCredentials)))
+
+	rr := httptest.NewRecorder()
+	handler.ChangePassword(rr, changePasswordRequest("wrong-password", "Brand-new-pass"))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeInvalidPassword))
+}
+
+func TestChangePassword_WrongMFACode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ChangePassword(gomock.Any(), &authpb.ChangePasswordRequest{UserId: 1, SessionId: 5, OldPassword: "old-password", NewPassword: "Brand-new-pass", Code: "000000"}).
+		Return(nil, status.Error(codes.Unauthenticated, string(models.ErrCodeMFACodeInvalid)))
+
+	body, _ := json.Marshal(models.ChangePasswordRequest{OldPassword: "old-password", NewPassword: "Brand-new-pass", Code: "000000"})
+	rr := httptest.NewRecorder()
+	handler.ChangePassword(rr, withSession(httptest.NewRequest(http.MethodPost, "/auth/password/change", bytes.NewBuffer(body)), 1, 5))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeMFACodeInvalid))
+}
+
+func TestChangePassword_Locked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ChangePassword(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.ResourceExhausted, "account locked"))
+
+	rr := httptest.NewRecorder()
+	handler.ChangePassword(rr, changePasswordRequest("wrong-password", "Brand-new-pass"))
+
+	require.Equal(t, http.StatusTooManyRequests, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeAccountLocked))
+}
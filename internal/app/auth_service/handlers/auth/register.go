@@ -6,17 +6,34 @@ import (
 	"github.com/gorilla/mux"
 
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
 )
 
-func Register(publicRouter *mux.Router, protectedRouter *mux.Router, log logger.Logger, authClient authpb.AuthServiceClient) {
+func Register(publicRouter *mux.Router, protectedRouter *mux.Router, log logger.Logger, authClient authpb.AuthServiceClient, finClient finpb.FinanceServiceClient) {
 	realClock := clock.RealClock{}
-	h := NewHandler(realClock, log, authClient)
+	h := NewHandler(realClock, log, authClient, finClient)
 
 	publicRouter.HandleFunc("/auth/csrf", h.GetCSRFToken).Methods(http.MethodGet)
 	publicRouter.HandleFunc("/auth/register", h.Register).Methods(http.MethodPost)
 	publicRouter.HandleFunc("/auth/login", h.Login).Methods(http.MethodPost)
+	publicRouter.HandleFunc("/auth/login/mfa", h.VerifyMFA).Methods(http.MethodPost)
+	publicRouter.HandleFunc("/auth/refresh", h.RefreshToken).Methods(http.MethodPost)
+	publicRouter.HandleFunc("/auth/password/reset", h.RequestPasswordReset).Methods(http.MethodPost)
+	publicRouter.HandleFunc("/auth/password/reset/confirm", h.ConfirmPasswordReset).Methods(http.MethodPost)
+	publicRouter.HandleFunc("/auth/email/confirm", h.ConfirmEmail).Methods(http.MethodPost)
 
 	protectedRouter.HandleFunc("/auth/logout", h.Logout).Methods(http.MethodPost)
+	protectedRouter.HandleFunc("/auth/password/change", h.ChangePassword).Methods(http.MethodPost)
+	protectedRouter.HandleFunc("/auth/sessions", h.GetSessions).Methods(http.MethodGet)
+	protectedRouter.HandleFunc("/auth/sessions", h.RevokeOtherSessions).Methods(http.MethodDelete)
+	protectedRouter.HandleFunc("/auth/sessions/{id}", h.RevokeSession).Methods(http.MethodDelete)
+	protectedRouter.HandleFunc("/auth/email/verify/resend", h.ResendEmailVerification).Methods(http.MethodPost)
+	protectedRouter.HandleFunc("/auth/mfa/enroll", h.EnrollMFA).Methods(http.MethodPost)
+	protectedRouter.HandleFunc("/auth/mfa/confirm", h.ConfirmMFA).Methods(http.MethodPost)
+	protectedRouter.HandleFunc("/auth/mfa/disable", h.DisableMFA).Methods(http.MethodPost)
+	protectedRouter.HandleFunc("/profile/tokens", h.GetPersonalTokens).Methods(http.MethodGet)
+	protectedRouter.HandleFunc("/profile/tokens", h.CreatePersonalToken).Methods(http.MethodPost)
+	protectedRouter.HandleFunc("/profile/tokens/{id}", h.RevokePersonalToken).Methods(http.MethodDelete)
 }
@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputil "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// currentSession пользователь и сессия, от имени которых выполнен запрос
+func currentSession(r *http.Request) (int, int, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		return 0, 0, false
+	}
+	sessionID, ok := middleware.GetSessionIDFromContext(r.Context())
+	if !ok {
+		return 0, 0, false
+	}
+	return userID, sessionID, true
+}
+
+// GetSessions godoc
+// @Summary Активные сессии
+// @Description Возвращает активные сессии пользователя: устройство, IP, User-Agent, время входа и последней активности. Текущая сессия отмечена полем current
+// @Tags auth
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} SessionsAPI "Активные сессии"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/sessions [get]
+func (h *Handler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	userID, sessionID, ok := currentSession(r)
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	sessions, err := h.authClient.ListSessions(r.Context(), &authpb.SessionRequest{
+		UserId:    int32(userID),
+		SessionId: int32(sessionID),
+	})
+	if err != nil {
+		if h.logger != nil {
+			h.logger.Error("Failed to list sessions", "error", err, "user_id", userID)
+		}
+		httputil.InternalError(w, r, "Failed to list sessions")
+		return
+	}
+
+	httputil.Success(w, r, SessionsProtoToApi(sessions))
+}
+
+// RevokeSession godoc
+// @Summary Завершение сессии
+// @Description Завершает одну из сессий пользователя, например на потерянном устройстве. Завершение текущей сессии равносильно выходу
+// @Tags auth
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID сессии"
+// @Success 200 {object} map[string]string "Сессия завершена"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID сессии (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Сессия не найдена (SESSION_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/sessions/{id} [delete]
+func (h *Handler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	userID, currentID, ok := currentSession(r)
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	sessionID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || sessionID <= 0 {
+		httputil.ValidationError(w, r, "Некорректный ID сессии", "id")
+		return
+	}
+
+	_, err = h.authClient.RevokeSession(r.Context(), &authpb.SessionRequest{
+		UserId:    int32(userID),
+		SessionId: int32(sessionID),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(models.ErrCodeSessionNotFound.GetErrorMessage(), "", "", models.ErrCodeSessionNotFound), http.StatusNotFound)
+			return
+		}
+		if h.logger != nil {
+			h.logger.Error("Failed to revoke session", "error", err, "user_id", userID, "session_id", sessionID)
+		}
+		httputil.InternalError(w, r, "Failed to revoke session")
+		return
+	}
+
+	if sessionID == currentID {
+		clearSessionCookies(w)
+	}
+	httputil.Success(w, r, map[string]string{"message": "Session revoked"})
+}
+
+// RevokeOtherSessions godoc
+// @Summary Выход на всех других устройствах
+// @Description Завершает все сессии пользователя, кроме текущей
+// @Tags auth
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} map[string]int "Количество завершенных сессий"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /auth/sessions [delete]
+func (h *Handler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	userID, sessionID, ok := currentSession(r)
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	revoked, err := h.authClient.RevokeOtherSessions(r.Context(), &authpb.SessionRequest{
+		UserId:    int32(userID),
+		SessionId: int32(sessionID),
+	})
+	if err != nil {
+		if h.logger != nil {
+			h.logger.Error("Failed to revoke other sessions", "error", err, "user_id", userID)
+		}
+		httputil.InternalError(w, r, "Failed to revoke sessions")
+		return
+	}
+
+	httputil.Success(w, r, map[string]int{"revoked": int(revoked.GetCount())})
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func withSession(req *http.Request, userID, sessionID int) *http.Request {
+	ctx := context.WithValue(req.Context(), middleware.UserIDKey, userID)
+	ctx = context.WithValue(ctx, middleware.SessionIDKey, sessionID)
+	return req.WithContext(ctx)
+}
+
+func TestGetSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+	seen := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockClient.EXPECT().
+		ListSessions(gomock.Any(), &authpb.SessionRequest{UserId: 1, SessionId: 5}).
+		Return(&authpb.SessionList{Sessions: []*authpb.SessionInfo{
+			{Id: 5, Device: "Chrome, Windows", Ip: "10.0.0.1", LastSeenAt: timestamppb.New(seen), Current: true},
+		}}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.GetSessions(rr, withSession(httptest.NewRequest(http.MethodGet, "/auth/sessions", nil), 1, 5))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), `"device":"Chrome, Windows"`)
+	require.Contains(t, rr.Body.String(), `"current":true`)
+}
+
+func TestRevokeSession_Other(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		RevokeSession(gomock.Any(), &authpb.SessionRequest{UserId: 1, SessionId: 3}).
+		Return(&emptypb.Empty{}, nil)
+
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/auth/sessions/3", nil), map[string]string{"id": "3"})
+	rr := httptest.NewRecorder()
+	handler.RevokeSession(rr, withSession(req, 1, 5))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	// чужая сессия не трогает cookie текущей
+	require.Empty(t, rr.Result().Cookies())
+}
+
+func TestRevokeSession_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		RevokeSession(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeSessionNotFound)))
+
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/auth/sessions/9", nil), map[string]string{"id": "9"})
+	rr := httptest.NewRecorder()
+	handler.RevokeSession(rr, withSession(req, 1, 5))
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeSessionNotFound))
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.RealClock{}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		RevokeOtherSessions(gomock.Any(), &authpb.SessionRequest{UserId: 1, SessionId: 5}).
+		Return(&authpb.RevokedSessions{Count: 2}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.RevokeOtherSessions(rr, withSession(httptest.NewRequest(http.MethodDelete, "/auth/sessions", nil), 1, 5))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), `"revoked":2`)
+}
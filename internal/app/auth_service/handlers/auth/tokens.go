@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputil "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// GetPersonalTokens godoc
+// @Summary Список персональных токенов
+// @Description Возвращает персональные токены пользователя, включая истекшие. Сами токены не возвращаются
+// @Tags profile
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} PersonalTokensAPI "Список токенов"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /profile/tokens [get]
+func (h *Handler) GetPersonalTokens(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	list, err := h.authClient.ListPersonalTokens(r.Context(), &authpb.UserID{UserID: int32(userID)})
+	if err != nil {
+		if h.logger != nil {
+			h.logger.Error("Failed to list personal tokens", "error", err, "user_id", userID)
+		}
+		httputil.InternalError(w, r, "Failed to list personal tokens")
+		return
+	}
+
+	httputil.Success(w, r, PersonalTokensProtoToApi(list))
+}
+
+// CreatePersonalToken godoc
+// @Summary Создание персонального токена
+// @Description Выпускает токен для скриптов и интеграций. Токен передается в заголовке "Authorization: Bearer <token>" и дает доступ только к маршрутам из выбранных областей. Токен показывается один раз, в базе хранится только его хеш
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.CreatePersonalTokenRequest true "Название, области доступа и срок действия"
+// @Success 201 {object} CreatedPersonalTokenAPI "Токен и его описание"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные или неизвестная область доступа (INVALID_REQUEST, INVALID_SCOPE)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /profile/tokens [post]
+func (h *Handler) CreatePersonalToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	var req models.CreatePersonalTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
+		httputil.ValidationErrors(w, r, validationErrors)
+		return
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(h.clock.Now()) {
+		httputil.ValidationError(w, r, "Срок действия токена уже истек", "expires_at")
+		return
+	}
+
+	pbReq := &authpb.CreatePersonalTokenRequest{
+		UserId: int32(userID),
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	}
+	if req.ExpiresAt != nil {
+		pbReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+
+	created, err := h.authClient.CreatePersonalToken(r.Context(), pbReq)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+				models.ErrCodeInvalidScope.GetErrorMessage(), "", "scopes", models.ErrCodeInvalidScope,
+			), http.StatusBadRequest)
+			return
+		}
+		if h.logger != nil {
+			h.logger.Error("Failed to create personal token", "error", err, "user_id", userID)
+		}
+		httputil.InternalError(w, r, "Failed to create personal token")
+		return
+	}
+
+	httputil.Created(w, r, CreatedPersonalTokenAPI{
+		Token:            created.GetToken(),
+		PersonalTokenAPI: PersonalTokenProtoToApi(created.GetInfo()),
+	})
+}
+
+// RevokePersonalToken godoc
+// @Summary Отзыв персонального токена
+// @Description Удаляет токен; запросы с ним сразу перестают приниматься
+// @Tags profile
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID токена"
+// @Success 200 {object} map[string]string "Токен отозван"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID токена (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Токен не найден (PERSONAL_TOKEN_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /profile/tokens/{id} [delete]
+func (h *Handler) RevokePersonalToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	tokenID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || tokenID <= 0 {
+		httputil.ValidationError(w, r, "Некорректный ID токена", "id")
+		return
+	}
+
+	_, err = h.authClient.RevokePersonalToken(r.Context(), &authpb.PersonalTokenRequest{
+		UserId:  int32(userID),
+		TokenId: int32(tokenID),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			httputil.ErrorWithCode(w, r, models.NewErrorResponse(models.ErrCodePersonalTokenNotFound.GetErrorMessage(), "", "", models.ErrCodePersonalTokenNotFound), http.StatusNotFound)
+			return
+		}
+		if h.logger != nil {
+			h.logger.Error("Failed to revoke personal token", "error", err, "user_id", userID, "token_id", tokenID)
+		}
+		httputil.InternalError(w, r, "Failed to revoke personal token")
+		return
+	}
+
+	httputil.Success(w, r, map[string]string{"message": "Token revoked"})
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+var tokensNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestGetPersonalTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.FixedClock{FixedTime: tokensNow}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		ListPersonalTokens(gomock.Any(), &authpb.UserID{UserID: 1}).
+		Return(&authpb.PersonalTokenList{Tokens: []*authpb.PersonalToken{
+			{Id: 3, Name: "import", Scopes: []string{"operations:write"}, CreatedAt: timestamppb.New(tokensNow)},
+		}}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.GetPersonalTokens(rr, withSession(httptest.NewRequest(http.MethodGet, "/profile/tokens", nil), 1, 5))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), `"name":"import"`)
+	require.Contains(t, rr.Body.String(), `"expires_at":null`)
+}
+
+func TestCreatePersonalToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.FixedClock{FixedTime: tokensNow}, logger.NewSlogLogger(), mockClient, nil)
+	expires := tokensNow.Add(30 * 24 * time.Hour)
+
+	mockClient.EXPECT().
+		CreatePersonalToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, req *authpb.CreatePersonalTokenRequest, _ ...any) (*authpb.CreatedPersonalToken, error) {
+			require.Equal(t, int32(1), req.GetUserId())
+			require.Equal(t, []string{"budgets:read"}, req.GetScopes())
+			require.True(t, expires.Equal(req.GetExpiresAt().AsTime()))
+			return &authpb.CreatedPersonalToken{
+				Token: "vkp_secret",
+				Info:  &authpb.PersonalToken{Id: 3, Name: req.GetName(), Scopes: req.GetScopes(), ExpiresAt: req.GetExpiresAt()},
+			}, nil
+		})
+
+	body := `{"name":"reports","scopes":["budgets:read"],"expires_at":"` + expires.Format(time.RFC3339) + `"}`
+	rr := httptest.NewRecorder()
+	handler.CreatePersonalToken(rr, withSession(httptest.NewRequest(http.MethodPost, "/profile/tokens", strings.NewReader(body)), 1, 5))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	require.Contains(t, rr.Body.String(), `"token":"vkp_secret"`)
+	require.Contains(t, rr.Body.String(), `"id":3`)
+}
+
+func TestCreatePersonalToken_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.FixedClock{FixedTime: tokensNow}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		CreatePersonalToken(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.InvalidArgument, string(models.ErrCodeInvalidScope)))
+
+	for body, code := range map[string]string{
+		`{"name":"x","scopes":[]}`: "Scopes",
+		`{"name":"x","scopes":["budgets:read"],"expires_at":"2026-02-01T00:00:00Z"}`: "expires_at",
+		`{"name":"x","scopes":["admin"]}`:                                            string(models.ErrCodeInvalidScope),
+	} {
+		rr := httptest.NewRecorder()
+		handler.CreatePersonalToken(rr, withSession(httptest.NewRequest(http.MethodPost, "/profile/tokens", strings.NewReader(body)), 1, 5))
+		require.Equal(t, http.StatusBadRequest, rr.Code, body)
+		require.Contains(t, rr.Body.String(), code, body)
+	}
+}
+
+func TestRevokePersonalToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockAuthServiceClient(ctrl)
+	handler := NewHandler(clock.FixedClock{FixedTime: tokensNow}, logger.NewSlogLogger(), mockClient, nil)
+
+	mockClient.EXPECT().
+		RevokePersonalToken(gomock.Any(), &authpb.PersonalTokenRequest{UserId: 1, TokenId: 3}).
+		Return(&emptypb.Empty{}, nil)
+	mockClient.EXPECT().
+		RevokePersonalToken(gomock.Any(), &authpb.PersonalTokenRequest{UserId: 1, TokenId: 9}).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodePersonalTokenNotFound)))
+
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/profile/tokens/3", nil), map[string]string{"id": "3"})
+	rr := httptest.NewRecorder()
+	handler.RevokePersonalToken(rr, withSession(req, 1, 5))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	req = mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/profile/tokens/9", nil), map[string]string{"id": "9"})
+	rr = httptest.NewRecorder()
+	handler.RevokePersonalToken(rr, withSession(req, 1, 5))
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodePersonalTokenNotFound))
+}
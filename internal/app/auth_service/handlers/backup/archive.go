@@ -0,0 +1,140 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+const (
+	manifestFile = "manifest.json"
+	profileFile  = "profile.json"
+	financeFile  = "finance.json"
+	budgetsFile  = "budgets.json"
+	logosDir     = "logos/"
+
+	maxArchiveSize = 50 << 20
+)
+
+var (
+	ErrInvalidArchive     = errors.New("invalid backup archive")
+	ErrUnsupportedVersion = errors.New("unsupported backup version")
+)
+
+// Логотипы хранятся по sha256-хешу содержимого, поэтому имя файла проверяется
+// строго, чтобы исключить обход путей внутри архива.
+var logoNameRe = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+func WriteArchive(w io.Writer, b models.Backup) error {
+	zw := zip.NewWriter(w)
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{manifestFile, b.Manifest},
+		{profileFile, b.Profile},
+		{financeFile, b.Finance},
+		{budgetsFile, b.Budgets},
+	}
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", f.name, err)
+		}
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+
+	for hash, data := range b.Logos {
+		fw, err := zw.Create(logosDir + hash)
+		if err != nil {
+			return fmt.Errorf("failed to create logo %s: %w", hash, err)
+		}
+		if _, err := fw.Write(data); err != nil {
+			return fmt.Errorf("failed to write logo %s: %w", hash, err)
+		}
+	}
+
+	return zw.Close()
+}
+
+func ReadArchive(data []byte) (models.Backup, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return models.Backup{}, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	b := models.Backup{Logos: map[string][]byte{}}
+	var hasManifest bool
+	var total int64
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		content, err := readZipFile(f, maxArchiveSize-total)
+		if err != nil {
+			return models.Backup{}, err
+		}
+		total += int64(len(content))
+
+		switch {
+		case f.Name == manifestFile:
+			err = json.Unmarshal(content, &b.Manifest)
+			hasManifest = true
+		case f.Name == profileFile:
+			err = json.Unmarshal(content, &b.Profile)
+		case f.Name == financeFile:
+			err = json.Unmarshal(content, &b.Finance)
+		case f.Name == budgetsFile:
+			err = json.Unmarshal(content, &b.Budgets)
+		case strings.HasPrefix(f.Name, logosDir):
+			hash := strings.TrimPrefix(f.Name, logosDir)
+			if !logoNameRe.MatchString(hash) {
+				return models.Backup{}, fmt.Errorf("%w: unexpected logo name %q", ErrInvalidArchive, f.Name)
+			}
+			b.Logos[hash] = content
+		}
+		if err != nil {
+			return models.Backup{}, fmt.Errorf("%w: %s: %v", ErrInvalidArchive, f.Name, err)
+		}
+	}
+
+	if !hasManifest || b.Manifest.BackupID == "" {
+		return models.Backup{}, fmt.Errorf("%w: missing manifest", ErrInvalidArchive)
+	}
+	if b.Manifest.Version < 1 || b.Manifest.Version > models.BackupFormatVersion {
+		return models.Backup{}, fmt.Errorf("%w: %d", ErrUnsupportedVersion, b.Manifest.Version)
+	}
+
+	return b, nil
+}
+
+func readZipFile(f *zip.File, limit int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	defer func() { _ = rc.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("%w: archive is too large", ErrInvalidArchive)
+	}
+	return content, nil
+}
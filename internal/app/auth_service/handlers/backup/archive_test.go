@@ -0,0 +1,82 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+const testLogoHash = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func testBackup() models.Backup {
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	return models.Backup{
+		Manifest: models.BackupManifest{Version: models.BackupFormatVersion, BackupID: "abc", UserID: 1, CreatedAt: now},
+		Profile:  models.BackupProfile{FirstName: "John", Login: "john", LogoHashedID: testLogoHash},
+		Finance: models.BackupFinance{
+			Accounts:   []models.BackupAccount{{ID: 1, Name: "Card", Balance: 10, Type: "regular", CurrencyID: 1}},
+			Categories: []models.BackupCategory{{ID: 2, Name: "Food", LogoHashedID: testLogoHash}},
+			Operations: []models.BackupOperation{{ID: 3, AccountID: 1, CategoryID: 2, Type: "expense", Name: "Lunch", Sum: 5, Date: now}},
+		},
+		Budgets: []models.BackupBudget{{ID: 4, Amount: 100, CurrencyID: 1, PeriodStart: now, PeriodEnd: now.AddDate(0, 1, 0)}},
+		Logos:   map[string][]byte{testLogoHash: []byte("png")},
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteArchive(&buf, testBackup()))
+
+	b, err := ReadArchive(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, "abc", b.Manifest.BackupID)
+	require.Equal(t, "John", b.Profile.FirstName)
+	require.Len(t, b.Finance.Operations, 1)
+	require.Len(t, b.Budgets, 1)
+	require.Equal(t, []byte("png"), b.Logos[testLogoHash])
+}
+
+func TestReadArchive_NotZip(t *testing.T) {
+	_, err := ReadArchive([]byte("not a zip"))
+	require.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+func TestReadArchive_UnsupportedVersion(t *testing.T) {
+	b := testBackup()
+	b.Manifest.Version = models.BackupFormatVersion + 1
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteArchive(&buf, b))
+
+	_, err := ReadArchive(buf.Bytes())
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestReadArchive_MissingManifest(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	_, err := zw.Create(profileFile)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	_, err = ReadArchive(buf.Bytes())
+	require.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+func TestReadArchive_BadLogoName(t *testing.T) {
+	b := testBackup()
+	b.Logos = map[string][]byte{"../../etc/passwd": []byte("x")}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteArchive(&buf, b))
+
+	_, err := ReadArchive(buf.Bytes())
+	require.ErrorIs(t, err, ErrInvalidArchive)
+	require.True(t, strings.Contains(err.Error(), "unexpected logo name"))
+}
@@ -0,0 +1,305 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/category"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/operation"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/usecase"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+type Handler struct {
+	imageUC       image.ImageUseCase
+	authClient    authpb.AuthServiceClient
+	finClient     finpb.FinanceServiceClient
+	budgetClient  bdgpb.BudgetServiceClient
+	kafkaProducer kafkautils.KafkaProducer
+	clock         clock.Clock
+}
+
+func NewHandler(imageUC image.ImageUseCase, authClient authpb.AuthServiceClient, finClient finpb.FinanceServiceClient, budgetClient bdgpb.BudgetServiceClient, kafkaProducer kafkautils.KafkaProducer, clck clock.Clock) *Handler {
+	return &Handler{
+		imageUC:       imageUC,
+		authClient:    authClient,
+		finClient:     finClient,
+		budgetClient:  budgetClient,
+		kafkaProducer: kafkaProducer,
+		clock:         clck,
+	}
+}
+
+func (h *Handler) getUserID(r *http.Request) (int, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	return userID, ok
+}
+
+func newBackupID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func handleGRPCError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	st, ok := status.FromError(err)
+	if !ok {
+		httputils.InternalError(w, r, string(models.ErrCodeInternalError))
+		return
+	}
+	switch st.Code() {
+	case codes.NotFound:
+		httputils.NotFoundError(w, r, "Пользователь не найден")
+	case codes.InvalidArgument:
+		httputils.ErrorWithCode(w, r, models.NewErrorResponse(models.ErrCodeInvalidBackup.GetErrorMessage(), st.Message(), "", models.ErrCodeInvalidBackup), http.StatusBadRequest)
+	default:
+		httputils.InternalError(w, r, msg)
+	}
+}
+
+func (h *Handler) collectLogos(ctx context.Context, ids []string) map[string][]byte {
+	log := logger.FromContext(ctx)
+	logos := make(map[string][]byte)
+	for _, id := range ids {
+		if id == "" || !logoNameRe.MatchString(id) {
+			continue
+		}
+		if _, ok := logos[id]; ok {
+			continue
+		}
+		data, _, err := h.imageUC.GetImage(ctx, id)
+		if err != nil {
+			if log != nil {
+				log.Error("Failed to get image for backup", "image_id", id, "error", err)
+			}
+			// Отсутствующий логотип не должен ломать выгрузку остальных данных
+			continue
+		}
+		logos[id] = data
+	}
+	return logos
+}
+
+// Backup godoc
+// @Summary Резервная копия данных пользователя
+// @Description Возвращает zip-архив с профилем, счетами, категориями (вместе с логотипами), операциями, получателями и бюджетами пользователя
+// @Tags profile
+// @Produce application/zip
+// @Security ApiKeyAuth
+// @Success 200 {file} file "Архив резервной копии"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Пользователь не найден"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /profile/backup [get]
+func (h *Handler) Backup(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+	log := logger.FromContext(r.Context())
+
+	profile, err := h.authClient.ExportProfile(r.Context(), &authpb.UserID{UserID: int32(userID)})
+	if err != nil {
+		handleGRPCError(w, r, err, "Failed to export profile")
+		return
+	}
+
+	finData, err := h.finClient.ExportUserData(r.Context(), &finpb.UserID{UserId: int32(userID)})
+	if err != nil {
+		handleGRPCError(w, r, err, "Failed to export finance data")
+		return
+	}
+
+	budgets, err := h.budgetClient.ExportBudgets(r.Context(), &bdgpb.UserID{UserID: int32(userID)})
+	if err != nil {
+		handleGRPCError(w, r, err, "Failed to export budgets")
+		return
+	}
+
+	backupID, err := newBackupID()
+	if err != nil {
+		httputils.InternalError(w, r, "Failed to create backup")
+		return
+	}
+
+	b := models.Backup{
+		Manifest: models.BackupManifest{
+			Version:   models.BackupFormatVersion,
+			BackupID:  backupID,
+			UserID:    userID,
+			CreatedAt: h.clock.Now(),
+		},
+		Profile: ProtoUserToBackupProfile(profile),
+		Finance: ProtoExportToBackupFinance(finData),
+		Budgets: ProtoBudgetsToBackup(budgets),
+	}
+
+	logoIDs := []string{b.Profile.LogoHashedID}
+	for _, ctg := range b.Finance.Categories {
+		logoIDs = append(logoIDs, ctg.LogoHashedID)
+	}
+	for _, rcv := range b.Finance.Receivers {
+		logoIDs = append(logoIDs, rcv.LogoHashedID)
+	}
+	b.Logos = h.collectLogos(r.Context(), logoIDs)
+
+	var buf bytes.Buffer
+	if err := WriteArchive(&buf, b); err != nil {
+		if log != nil {
+			log.Error("Failed to write backup archive", "error", err)
+		}
+		httputils.InternalError(w, r, "Failed to create backup")
+		return
+	}
+
+	filename := fmt.Sprintf("vkarmane-backup-%s.zip", h.clock.Now().Format("2006-01-02"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// Restore godoc
+// @Summary Восстановление данных из резервной копии
+// @Description Восстанавливает профиль, счета, категории, операции, получателей и бюджеты из архива, полученного через /profile/backup. Повторная загрузка того же архива не создает дубликатов
+// @Tags profile
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param archive formData file true "Архив резервной копии"
+// @Success 200 {object} models.RestoreResponse "Результат восстановления"
+// @Failure 400 {object} models.ErrorResponse "Некорректный архив (INVALID_REQUEST, INVALID_BACKUP)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /profile/restore [post]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+	log := logger.FromContext(r.Context())
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxArchiveSize+(1<<20))
+	if err := r.ParseMultipartForm(10 << 20); err != nil {
+		httputils.Error(w, r, "Failed to parse multipart form", http.StatusBadRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("archive")
+	if err != nil {
+		httputils.ValidationError(w, r, "Archive file is required", "archive")
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		httputils.Error(w, r, "Failed to read archive", http.StatusBadRequest)
+		return
+	}
+
+	b, err := ReadArchive(data)
+	if err != nil {
+		details := err.Error()
+		if errors.Is(err, ErrUnsupportedVersion) {
+			details = fmt.Sprintf("supported version: %d", models.BackupFormatVersion)
+		}
+		httputils.ErrorWithCode(w, r, models.NewErrorResponse(models.ErrCodeInvalidBackup.GetErrorMessage(), details, "archive", models.ErrCodeInvalidBackup), http.StatusBadRequest)
+		return
+	}
+
+	// Изображения адресуются хешем содержимого, поэтому повторная загрузка
+	// возвращает тот же идентификатор и ссылки из архива остаются валидными.
+	logosRestored := 0
+	for hash, content := range b.Logos {
+		imageID, err := h.imageUC.UploadImage(r.Context(), bytes.NewReader(content), hash, int64(len(content)), http.DetectContentType(content))
+		if err != nil {
+			if log != nil {
+				log.Error("Failed to restore image", "image_id", hash, "error", err)
+			}
+			continue
+		}
+		if imageID != hash && log != nil {
+			log.Warn("Restored image id differs from backup", "backup_id", hash, "image_id", imageID)
+		}
+		logosRestored++
+	}
+
+	if _, err := h.authClient.ImportProfile(r.Context(), BackupProfileToImportRequest(userID, b.Profile)); err != nil {
+		handleGRPCError(w, r, err, "Failed to restore profile")
+		return
+	}
+
+	finResp, err := h.finClient.ImportUserData(r.Context(), BackupFinanceToImportRequest(userID, b.Manifest.BackupID, b.Finance))
+	if err != nil {
+		handleGRPCError(w, r, err, "Failed to restore finance data")
+		return
+	}
+
+	bdgResp, err := h.budgetClient.ImportBudgets(r.Context(), BackupBudgetsToImportRequest(userID, b.Manifest.BackupID, b.Budgets))
+	if err != nil {
+		handleGRPCError(w, r, err, "Failed to restore budgets")
+		return
+	}
+
+	h.indexRestoredOperations(r.Context(), userID, finResp.RestoredOperations)
+
+	httputils.Success(w, r, RestoreResultToResponse(b.Manifest.BackupID, finResp, bdgResp, logosRestored))
+}
+
+func (h *Handler) indexRestoredOperations(ctx context.Context, userID int, ops []*finpb.Operation) {
+	if len(ops) == 0 {
+		return
+	}
+	log := logger.FromContext(ctx)
+
+	categories := make(map[int]models.CategoryWithStats)
+	logos := make(map[int]string)
+
+	for _, op := range ops {
+		opResponse := operation.ProtoOperationToResponse(op)
+
+		ctgDTO, ok := categories[opResponse.CategoryID]
+		if !ok && opResponse.CategoryID != 0 {
+			ctg, err := h.finClient.GetCategory(ctx, category.UserAndCtegoryIDToProto(userID, opResponse.CategoryID))
+			if err == nil {
+				ctgDTO = category.CategoryWithStatsToAPI(ctg)
+				logos[opResponse.CategoryID], _ = h.imageUC.GetImageURL(ctx, ctg.Category.LogoHashedId)
+			}
+			categories[opResponse.CategoryID] = ctgDTO
+		}
+
+		searchObj := operation.OperationResponseToSearch(opResponse, ctgDTO, logos[opResponse.CategoryID])
+		searchObj.Action = models.WRITE
+
+		data, _ := searchObj.MarshalJSON()
+		if err := h.kafkaProducer.WriteMessages(ctx, kafkautils.KafkaMessage{Payload: data, Type: models.TRANSACTIONS}); err != nil {
+			if log != nil {
+				log.Error("Failed to index restored operation", "operation_id", opResponse.ID, "error", err)
+			}
+		}
+	}
+}
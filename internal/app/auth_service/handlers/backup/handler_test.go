@@ -0,0 +1,161 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+type handlerMocks struct {
+	image  *mocks.MockImageUseCase
+	auth   *mocks.MockAuthServiceClient
+	fin    *mocks.MockFinanceServiceClient
+	budget *mocks.MockBudgetServiceClient
+	kafka  *mocks.MockKafkaProducer
+}
+
+func newTestHandler(t *testing.T) (*Handler, handlerMocks) {
+	ctrl := gomock.NewController(t)
+	m := handlerMocks{
+		image:  mocks.NewMockImageUseCase(ctrl),
+		auth:   mocks.NewMockAuthServiceClient(ctrl),
+		fin:    mocks.NewMockFinanceServiceClient(ctrl),
+		budget: mocks.NewMockBudgetServiceClient(ctrl),
+		kafka:  mocks.NewMockKafkaProducer(ctrl),
+	}
+	fixedClock := clock.FixedClock{FixedTime: time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)}
+	return NewHandler(m.image, m.auth, m.fin, m.budget, m.kafka, fixedClock), m
+}
+
+func withUser(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestBackup_Unauthorized(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	rr := httptest.NewRecorder()
+	h.Backup(rr, httptest.NewRequest(http.MethodGet, "/profile/backup", nil))
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestBackup_Success(t *testing.T) {
+	h, m := newTestHandler(t)
+
+	m.auth.EXPECT().ExportProfile(gomock.Any(), gomock.Any()).
+		Return(&authpb.User{Id: 1, FirstName: "John", Login: "john"}, nil)
+	m.fin.EXPECT().ExportUserData(gomock.Any(), gomock.Any()).
+		Return(&finpb.UserDataExport{
+			Categories: []*finpb.Category{{Id: 2, Name: "Food", LogoHashedId: testLogoHash}},
+		}, nil)
+	m.budget.EXPECT().ExportBudgets(gomock.Any(), gomock.Any()).
+		Return(&bdgpb.ListBudgetsResponse{}, nil)
+	m.image.EXPECT().GetImage(gomock.Any(), testLogoHash).Return([]byte("png"), "image/png", nil)
+
+	rr := httptest.NewRecorder()
+	h.Backup(rr, withUser(httptest.NewRequest(http.MethodGet, "/profile/backup", nil)))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/zip", rr.Header().Get("Content-Type"))
+
+	b, err := ReadArchive(rr.Body.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, b.Manifest.UserID)
+	require.Len(t, b.Finance.Categories, 1)
+	require.Equal(t, []byte("png"), b.Logos[testLogoHash])
+}
+
+func TestBackup_ProfileNotFound(t *testing.T) {
+	h, m := newTestHandler(t)
+
+	m.auth.EXPECT().ExportProfile(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "not found"))
+
+	rr := httptest.NewRecorder()
+	h.Backup(rr, withUser(httptest.NewRequest(http.MethodGet, "/profile/backup", nil)))
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func archiveRequest(t *testing.T, data []byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("archive", "backup.zip")
+	require.NoError(t, err)
+	_, err = part.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/profile/restore", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return withUser(req)
+}
+
+func TestRestore_InvalidArchive(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	rr := httptest.NewRecorder()
+	h.Restore(rr, archiveRequest(t, []byte("garbage")))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var resp models.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, models.ErrCodeInvalidBackup, resp.Code)
+}
+
+func TestRestore_Success(t *testing.T) {
+	h, m := newTestHandler(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteArchive(&buf, testBackup()))
+
+	m.image.EXPECT().UploadImage(gomock.Any(), gomock.Any(), testLogoHash, int64(3), gomock.Any()).Return(testLogoHash, nil)
+	m.auth.EXPECT().ImportProfile(gomock.Any(), gomock.Any()).Return(&authpb.ProfileResponse{Id: 1}, nil)
+	m.fin.EXPECT().ImportUserData(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *finpb.ImportUserDataRequest, _ ...interface{}) (*finpb.ImportUserDataResponse, error) {
+			require.Equal(t, "abc", req.BackupId)
+			require.Len(t, req.Data.Operations, 1)
+			return &finpb.ImportUserDataResponse{
+				AccountsRestored:   1,
+				OperationsRestored: 1,
+				RestoredOperations: []*finpb.Operation{{Id: 30, AccountId: 10, CategoryId: 20}},
+			}, nil
+		})
+	m.budget.EXPECT().ImportBudgets(gomock.Any(), gomock.Any()).Return(&bdgpb.ImportBudgetsResponse{BudgetsRestored: 1}, nil)
+	m.fin.EXPECT().GetCategory(gomock.Any(), gomock.Any()).
+		Return(&finpb.CategoryWithStats{Category: &finpb.Category{Id: 20, Name: "Food", LogoHashedId: testLogoHash}}, nil)
+	m.image.EXPECT().GetImageURL(gomock.Any(), testLogoHash).Return("http://logo", nil)
+	m.kafka.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil)
+
+	rr := httptest.NewRecorder()
+	h.Restore(rr, archiveRequest(t, buf.Bytes()))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.RestoreResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, "abc", resp.BackupID)
+	require.Equal(t, 1, resp.OperationsRestored)
+	require.Equal(t, 1, resp.BudgetsRestored)
+	require.Equal(t, 1, resp.LogosRestored)
+}
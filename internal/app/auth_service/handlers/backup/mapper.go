@@ -0,0 +1,280 @@
+package backup
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func asTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func ProtoUserToBackupProfile(user *authpb.User) models.BackupProfile {
+	return models.BackupProfile{
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		Email:        user.Email,
+		Login:        user.Login,
+		Description:  user.Description,
+		LogoHashedID: user.LogoHashedId,
+		CreatedAt:    asTime(user.CreatedAt),
+	}
+}
+
+func BackupProfileToImportRequest(userID int, profile models.BackupProfile) *authpb.ImportProfileRequest {
+	return &authpb.ImportProfileRequest{
+		UserId: int32(userID),
+		Profile: &authpb.User{
+			FirstName:    profile.FirstName,
+			LastName:     profile.LastName,
+			Description:  profile.Description,
+			LogoHashedId: profile.LogoHashedID,
+		},
+	}
+}
+
+func ProtoExportToBackupFinance(data *finpb.UserDataExport) models.BackupFinance {
+	res := models.BackupFinance{
+		Accounts:   make([]models.BackupAccount, 0, len(data.Accounts)),
+		Categories: make([]models.BackupCategory, 0, len(data.Categories)),
+		Operations: make([]models.BackupOperation, 0, len(data.Operations)),
+		Receivers:  make([]models.BackupReceiver, 0, len(data.Receivers)),
+		Sharings:   make([]models.BackupSharing, 0, len(data.Sharings)),
+	}
+
+	for _, acc := range data.Accounts {
+		res.Accounts = append(res.Accounts, models.BackupAccount{
+			ID:          int(acc.Id),
+			Name:        acc.Name,
+			Description: acc.Description,
+			Balance:     acc.Balance,
+			Type:        acc.Type,
+			CurrencyID:  int(acc.CurrencyId),
+			CreatedAt:   asTime(acc.CreatedAt),
+		})
+	}
+
+	for _, ctg := range data.Categories {
+		res.Categories = append(res.Categories, models.BackupCategory{
+			ID:           int(ctg.Id),
+			ParentID:     int(ctg.ParentId),
+			Name:         ctg.Name,
+			Description:  ctg.Description,
+			LogoHashedID: ctg.LogoHashedId,
+			CreatedAt:    asTime(ctg.CreatedAt),
+		})
+	}
+
+	for _, op := range data.Operations {
+		res.Operations = append(res.Operations, models.BackupOperation{
+			ID:           int(op.Id),
+			AccountID:    int(op.AccountId),
+			AccountToID:  int(op.AccountToId),
+			Status:       op.Status,
+			CategoryID:   int(op.CategoryId),
+			CategoryName: op.CategoryName,
+			Type:         op.Type,
+			Name:         op.Name,
+			Description:  op.Description,
+			ReceiptURL:   op.ReceiptUrl,
+			Sum:          op.Sum,
+			CurrencyID:   int(op.CurrencyId),
+			CreatedAt:    asTime(op.CreatedAt),
+			Date:         asTime(op.Date),
+		})
+	}
+
+	for _, rcv := range data.Receivers {
+		res.Receivers = append(res.Receivers, models.BackupReceiver{
+			ID:           int(rcv.Id),
+			Name:         rcv.Name,
+			LogoHashedID: rcv.LogoHashedId,
+			CreatedAt:    asTime(rcv.CreatedAt),
+		})
+	}
+
+	for _, sh := range data.Sharings {
+		res.Sharings = append(res.Sharings, models.BackupSharing{
+			AccountID: int(sh.AccountId),
+			UserLogin: sh.UserLogin,
+			Role:      sh.Role,
+			CreatedAt: asTime(sh.CreatedAt),
+		})
+	}
+
+	return res
+}
+
+func BackupFinanceToImportRequest(userID int, backupID string, data models.BackupFinance) *finpb.ImportUserDataRequest {
+	export := &finpb.UserDataExport{
+		Accounts:   make([]*finpb.Account, 0, len(data.Accounts)),
+		Categories: make([]*finpb.Category, 0, len(data.Categories)),
+		Operations: make([]*finpb.Operation, 0, len(data.Operations)),
+		Receivers:  make([]*finpb.Receiver, 0, len(data.Receivers)),
+		Sharings:   make([]*finpb.SharingsResponse, 0, len(data.Sharings)),
+	}
+
+	for _, acc := range data.Accounts {
+		export.Accounts = append(export.Accounts, &finpb.Account{
+			Id:          int32(acc.ID),
+			Name:        acc.Name,
+			Description: acc.Description,
+			Balance:     acc.Balance,
+			Type:        acc.Type,
+			CurrencyId:  int32(acc.CurrencyID),
+			CreatedAt:   toTimestamp(acc.CreatedAt),
+		})
+	}
+
+	for _, ctg := range data.Categories {
+		export.Categories = append(export.Categories, &finpb.Category{
+			Id:           int32(ctg.ID),
+			UserId:       int32(userID),
+			ParentId:     int32(ctg.ParentID),
+			Name:         ctg.Name,
+			Description:  ctg.Description,
+			LogoHashedId: ctg.LogoHashedID,
+			CreatedAt:    toTimestamp(ctg.CreatedAt),
+		})
+	}
+
+	for _, op := range data.Operations {
+		export.Operations = append(export.Operations, &finpb.Operation{
+			Id:           int32(op.ID),
+			AccountId:    int32(op.AccountID),
+			AccountToId:  int32(op.AccountToID),
+			Status:       op.Status,
+			CategoryId:   int32(op.CategoryID),
+			CategoryName: op.CategoryName,
+			Type:         op.Type,
+			Name:         op.Name,
+			Description:  op.Description,
+			ReceiptUrl:   op.ReceiptURL,
+			Sum:          op.Sum,
+			CurrencyId:   int32(op.CurrencyID),
+			CreatedAt:    toTimestamp(op.CreatedAt),
+			Date:         toTimestamp(op.Date),
+		})
+	}
+
+	for _, rcv := range data.Receivers {
+		export.Receivers = append(export.Receivers, &finpb.Receiver{
+			Id:           int32(rcv.ID),
+			Name:         rcv.Name,
+			LogoHashedId: rcv.LogoHashedID,
+			CreatedAt:    toTimestamp(rcv.CreatedAt),
+		})
+	}
+
+	for _, sh := range data.Sharings {
+		export.Sharings = append(export.Sharings, &finpb.SharingsResponse{
+			AccountId: int32(sh.AccountID),
+			UserLogin: sh.UserLogin,
+			Role:      sh.Role,
+			CreatedAt: toTimestamp(sh.CreatedAt),
+		})
+	}
+
+	return &finpb.ImportUserDataRequest{
+		UserId:   int32(userID),
+		BackupId: backupID,
+		Data:     export,
+	}
+}
+
+func ProtoBudgetsToBackup(resp *bdgpb.ListBudgetsResponse) []models.BackupBudget {
+	budgets := make([]models.BackupBudget, 0, len(resp.Budgets))
+	for _, bdg := range resp.Budgets {
+		b := models.BackupBudget{
+			ID:          int(bdg.Id),
+			Amount:      bdg.Sum,
+			CurrencyID:  int(bdg.CurrencyId),
+			Description: bdg.Description,
+			CreatedAt:   asTime(bdg.CreatedAt),
+			PeriodStart: asTime(bdg.PeriodStart),
+			PeriodEnd:   asTime(bdg.PeriodEnd),
+			CarryOver:   bdg.CarryOver,
+			CarriedOver: bdg.CarriedOver,
+		}
+		if bdg.Recurrence != "none" {
+			b.Recurrence = bdg.Recurrence
+			b.RecurrenceDay = int(bdg.RecurrenceDay)
+		}
+		for _, id := range bdg.CategoryIds {
+			b.CategoryIDs = append(b.CategoryIDs, int(id))
+		}
+		for _, id := range bdg.AccountIds {
+			b.AccountIDs = append(b.AccountIDs, int(id))
+		}
+		if bdg.ClosedAt != nil {
+			closedAt := bdg.ClosedAt.AsTime()
+			b.ClosedAt = &closedAt
+		}
+		budgets = append(budgets, b)
+	}
+	return budgets
+}
+
+func BackupBudgetsToImportRequest(userID int, backupID string, budgets []models.BackupBudget) *bdgpb.ImportBudgetsRequest {
+	req := &bdgpb.ImportBudgetsRequest{
+		UserID:   int32(userID),
+		BackupId: backupID,
+		Budgets:  make([]*bdgpb.Budget, 0, len(budgets)),
+	}
+	for _, b := range budgets {
+		bdg := &bdgpb.Budget{
+			Id:            int32(b.ID),
+			UserId:        int32(userID),
+			Sum:           b.Amount,
+			CurrencyId:    int32(b.CurrencyID),
+			Description:   b.Description,
+			CreatedAt:     toTimestamp(b.CreatedAt),
+			PeriodStart:   toTimestamp(b.PeriodStart),
+			PeriodEnd:     toTimestamp(b.PeriodEnd),
+			Recurrence:    b.Recurrence,
+			RecurrenceDay: int32(b.RecurrenceDay),
+			CarryOver:     b.CarryOver,
+			CarriedOver:   b.CarriedOver,
+		}
+		for _, id := range b.CategoryIDs {
+			bdg.CategoryIds = append(bdg.CategoryIds, int32(id))
+		}
+		for _, id := range b.AccountIDs {
+			bdg.AccountIds = append(bdg.AccountIds, int32(id))
+		}
+		if b.ClosedAt != nil {
+			bdg.ClosedAt = timestamppb.New(*b.ClosedAt)
+		}
+		req.Budgets = append(req.Budgets, bdg)
+	}
+	return req
+}
+
+func RestoreResultToResponse(backupID string, fin *finpb.ImportUserDataResponse, bdg *bdgpb.ImportBudgetsResponse, logos int) models.RestoreResponse {
+	return models.RestoreResponse{
+		BackupID:           backupID,
+		ProfileRestored:    true,
+		AccountsRestored:   int(fin.AccountsRestored),
+		CategoriesRestored: int(fin.CategoriesRestored),
+		OperationsRestored: int(fin.OperationsRestored),
+		ReceiversRestored:  int(fin.ReceiversRestored),
+		BudgetsRestored:    int(bdg.BudgetsRestored),
+		LogosRestored:      logos,
+	}
+}
@@ -0,0 +1,19 @@
+package backup
+
+import (
+	"github.com/gorilla/mux"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/usecase"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+func Register(router *mux.Router, imageUC image.ImageUseCase, authClient authpb.AuthServiceClient, finClient finpb.FinanceServiceClient, budgetClient bdgpb.BudgetServiceClient, kafkaProducer kafkautils.KafkaProducer) {
+	handler := NewHandler(imageUC, authClient, finClient, budgetClient, kafkaProducer, clock.RealClock{})
+
+	router.HandleFunc("/profile/backup", handler.Backup).Methods("GET")
+	router.HandleFunc("/profile/restore", handler.Restore).Methods("POST")
+}
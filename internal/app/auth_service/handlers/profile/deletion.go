@@ -0,0 +1,125 @@
+package profile
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// exportURL выгрузка всех данных пользователя одним архивом
+const exportURL = "/api/v1/profile/backup"
+
+// DeleteProfile godoc
+// @Summary Удаление аккаунта
+// @Description Планирует удаление аккаунта после повторного ввода пароля и кода 2FA, если она включена. Неверные пароли и коды считаются неудачными попытками входа. До scheduled_at удаление можно отменить и выгрузить данные по export_url; затем удаляются профиль, категории, счета без других участников вместе с операциями, бюджеты, цели и загруженные изображения. Из совместных счетов пользователь выходит. Повторный запрос не сдвигает срок
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.DeleteProfileRequest true "Текущий пароль и код 2FA"
+// @Success 202 {object} models.AccountDeletionResponse "Удаление запланировано"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные, неверный пароль или код 2FA (INVALID_REQUEST, INVALID_PASSWORD, MFA_CODE_INVALID)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 429 {object} models.ErrorResponse "Слишком много неудачных попыток (ACCOUNT_LOCKED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /profile [delete]
+func (h *Handler) DeleteProfile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	var req models.DeleteProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if validationErrors := utils.ValidateStruct(req); len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	deletion, err := h.authClient.ScheduleAccountDeletion(r.Context(), &authpb.DeleteAccountRequest{
+		UserId:   int32(userID),
+		Password: req.Password,
+		Code:     req.Code,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			if models.ErrorCode(status.Convert(err).Message()) == models.ErrCodeMFACodeInvalid {
+				httputils.ErrorWithCode(w, r, models.NewErrorResponse(
+					models.ErrCodeMFACodeInvalid.GetErrorMessage(), "", "code", models.ErrCodeMFACodeInvalid,
+				), http.StatusBadRequest)
+				return
+			}
+			httputils.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Неверный пароль", "", "password", models.ErrCodeInvalidPassword,
+			), http.StatusBadRequest)
+		case codes.ResourceExhausted:
+			httputils.ErrorWithCode(w, r, models.NewErrorResponse(
+				"Слишком много неудачных попыток входа, попробуйте позже", "", "", models.ErrCodeAccountLocked,
+			), http.StatusTooManyRequests)
+		default:
+			if log := logger.FromContext(r.Context()); log != nil {
+				log.Error("Failed to schedule account deletion", "error", err, "user_id", userID)
+			}
+			httputils.InternalError(w, r, "Failed to schedule account deletion")
+		}
+		return
+	}
+
+	httputils.JSON(w, r, models.AccountDeletionResponse{
+		ScheduledAt: deletion.GetScheduledAt().AsTime(),
+		ExportURL:   exportURL,
+	}, http.StatusAccepted)
+}
+
+// CancelProfileDeletion godoc
+// @Summary Отмена удаления аккаунта
+// @Description Отменяет запланированное удаление аккаунта, пока оно не началось
+// @Tags profile
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 204 "Удаление отменено"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Удаление не запланировано (ACCOUNT_DELETION_NOT_FOUND)"
+// @Failure 409 {object} models.ErrorResponse "Удаление уже началось (ACCOUNT_DELETION_STARTED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR)"
+// @Router /profile/deletion [delete]
+func (h *Handler) CancelProfileDeletion(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	_, err := h.authClient.CancelAccountDeletion(r.Context(), IDtoProtoID(userID))
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			httputils.ErrorWithCode(w, r, models.NewErrorResponse(
+				models.ErrCodeAccountDeletionNotFound.GetErrorMessage(), "", "", models.ErrCodeAccountDeletionNotFound,
+			), http.StatusNotFound)
+		case codes.FailedPrecondition:
+			httputils.ConflictError(w, r, models.ErrCodeAccountDeletionStarted.GetErrorMessage(), models.ErrCodeAccountDeletionStarted)
+		default:
+			if log := logger.FromContext(r.Context()); log != nil {
+				log.Error("Failed to cancel account deletion", "error", err, "user_id", userID)
+			}
+			httputils.InternalError(w, r, "Failed to cancel account deletion")
+		}
+		return
+	}
+
+	httputils.NoContent(w, r)
+}
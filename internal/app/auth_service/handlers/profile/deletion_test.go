@@ -0,0 +1,148 @@
+package profile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func newDeletionRequest(method, target string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestDeleteProfile_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mocks.NewMockAuthServiceClient(ctrl)
+	h := NewHandler(mocks.NewMockImageUseCase(ctrl), mockAuth)
+
+	scheduledAt := time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC)
+	mockAuth.EXPECT().
+		ScheduleAccountDeletion(gomock.Any(), &authpb.DeleteAccountRequest{UserId: 1, Password: "secret"}).
+		Return(&authpb.AccountDeletion{UserId: 1, ScheduledAt: timestamppb.New(scheduledAt)}, nil)
+
+	rr := httptest.NewRecorder()
+	h.DeleteProfile(rr, newDeletionRequest(http.MethodDelete, "/profile", []byte(`{"password":"secret"}`)))
+
+	require.Equal(t, http.StatusAccepted, rr.Code)
+	var resp models.AccountDeletionResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.True(t, resp.ScheduledAt.Equal(scheduledAt))
+	require.Equal(t, exportURL, resp.ExportURL)
+}
+
+func TestDeleteProfile_WrongPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mocks.NewMockAuthServiceClient(ctrl)
+	h := NewHandler(mocks.NewMockImageUseCase(ctrl), mockAuth)
+
+	mockAuth.EXPECT().
+		ScheduleAccountDeletion(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unauthenticated, "invalid credentials"))
+
+	rr := httptest.NewRecorder()
+	h.DeleteProfile(rr, newDeletionRequest(http.MethodDelete, "/profile", []byte(`{"password":"wrong"}`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeInvalidPassword))
+}
+
+func TestDeleteProfile_WrongMFACode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mocks.NewMockAuthServiceClient(ctrl)
+	h := NewHandler(mocks.NewMockImageUseCase(ctrl), mockAuth)
+
+	mockAuth.EXPECT().
+		ScheduleAccountDeletion(gomock.Any(), &authpb.DeleteAccountRequest{UserId: 1, Password: "secret", Code: "000000"}).
+		Return(nil, status.Error(codes.Unauthenticated, string(models.ErrCodeMFACodeInvalid)))
+
+	rr := httptest.NewRecorder()
+	h.DeleteProfile(rr, newDeletionRequest(http.MethodDelete, "/profile", []byte(`{"password":"secret","code":"000000"}`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeMFACodeInvalid))
+}
+
+func TestDeleteProfile_Locked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mocks.NewMockAuthServiceClient(ctrl)
+	h := NewHandler(mocks.NewMockImageUseCase(ctrl), mockAuth)
+
+	mockAuth.EXPECT().
+		ScheduleAccountDeletion(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.ResourceExhausted, "account locked"))
+
+	rr := httptest.NewRecorder()
+	h.DeleteProfile(rr, newDeletionRequest(http.MethodDelete, "/profile", []byte(`{"password":"wrong"}`)))
+
+	require.Equal(t, http.StatusTooManyRequests, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeAccountLocked))
+}
+
+func TestDeleteProfile_MissingPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewHandler(mocks.NewMockImageUseCase(ctrl), mocks.NewMockAuthServiceClient(ctrl))
+
+	rr := httptest.NewRecorder()
+	h.DeleteProfile(rr, newDeletionRequest(http.MethodDelete, "/profile", []byte(`{}`)))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestCancelProfileDeletion(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"success", nil, http.StatusNoContent},
+		{"not scheduled", status.Error(codes.NotFound, "not found"), http.StatusNotFound},
+		{"already started", status.Error(codes.FailedPrecondition, "started"), http.StatusConflict},
+		{"internal", status.Error(codes.Internal, "boom"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuth := mocks.NewMockAuthServiceClient(ctrl)
+			h := NewHandler(mocks.NewMockImageUseCase(ctrl), mockAuth)
+
+			mockAuth.EXPECT().
+				CancelAccountDeletion(gomock.Any(), &authpb.UserID{UserID: 1}).
+				Return(&emptypb.Empty{}, tt.err)
+
+			rr := httptest.NewRecorder()
+			h.CancelProfileDeletion(rr, newDeletionRequest(http.MethodDelete, "/profile/deletion", nil))
+
+			require.Equal(t, tt.expected, rr.Code)
+		})
+	}
+}
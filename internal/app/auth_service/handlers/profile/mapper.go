@@ -13,14 +13,16 @@ func IDtoProtoID(id int) *proto.UserID {
 
 func ProtoProfileToApiProfile(req *proto.ProfileResponse) *models.ProfileResponse {
 	return &models.ProfileResponse{
-		ID:           int(req.Id),
-		FirstName:    req.FirstName,
-		LastName:     req.LastName,
-		Login:        req.Login,
-		Email:        req.Email,
-		LogoHashedID: req.LogoHashedId,
-		LogoURL:      req.LogoUrl,
-		CreatedAt:    req.CreatedAt.AsTime(),
+		ID:            int(req.Id),
+		FirstName:     req.FirstName,
+		LastName:      req.LastName,
+		Login:         req.Login,
+		Email:         req.Email,
+		LogoHashedID:  req.LogoHashedId,
+		LogoURL:       req.LogoUrl,
+		EmailVerified: req.EmailVerified,
+		PendingEmail:  req.PendingEmail,
+		CreatedAt:     req.CreatedAt.AsTime(),
 	}
 }
 
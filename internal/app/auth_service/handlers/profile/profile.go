@@ -98,7 +98,7 @@ func (h *Handler) GetProfile(w http.ResponseWriter, r *http.Request) {
 
 // UpdateProfile godoc
 // @Summary Обновление профиля пользователя
-// @Description Обновляет информацию о профиле текущего пользователя. Поддерживает multipart/form-data с опциональным полем avatar для загрузки аватарки
+// @Description Обновляет информацию о профиле текущего пользователя. Поддерживает multipart/form-data с опциональным полем avatar для загрузки аватарки. Новый email применяется только после подтверждения по ссылкам из писем, до этого он возвращается в pending_email
 // @Tags profile
 // @Accept multipart/form-data
 // @Produce json
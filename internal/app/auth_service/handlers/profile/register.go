@@ -12,4 +12,6 @@ func Register(router *mux.Router, imageUC image.ImageUseCase, authClient authpb.
 
 	router.HandleFunc("/profile", handler.GetProfile).Methods("GET")
 	router.HandleFunc("/profile/edit", handler.UpdateProfile).Methods("PUT")
+	router.HandleFunc("/profile", handler.DeleteProfile).Methods("DELETE")
+	router.HandleFunc("/profile/deletion", handler.CancelProfileDeletion).Methods("DELETE")
 }
@@ -0,0 +1,13 @@
+package auth
+
+import "time"
+
+// AccountDeletion запланированное удаление аккаунта
+type AccountDeletion struct {
+	UserID      int
+	ScheduledAt time.Time
+	// Step последний завершенный шаг, пустой — удаление еще можно отменить
+	Step     string
+	ImageIDs []string
+	Attempts int
+}
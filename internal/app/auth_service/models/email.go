@@ -0,0 +1,35 @@
+package auth
+
+import "time"
+
+// Назначение токена подтверждения email
+const (
+	EmailTokenVerify    = "verify"
+	EmailTokenChangeOld = "change_old"
+	EmailTokenChangeNew = "change_new"
+)
+
+// Результат перехода по ссылке из письма
+const (
+	EmailStatusVerified      = "email_verified"
+	EmailStatusChangePending = "email_change_pending"
+	EmailStatusChanged       = "email_changed"
+)
+
+// EmailChange заявка на смену email. Пустой OldTokenHash значит, что старый
+// адрес подтверждать не нужно (он сам не был подтвержден).
+type EmailChange struct {
+	UserID       int
+	OldEmail     string
+	NewEmail     string
+	OldTokenHash string
+	NewTokenHash string
+	ExpiresAt    time.Time
+}
+
+// EmailConfirmation чем закончилось подтверждение по токену
+type EmailConfirmation struct {
+	UserID int
+	Status string
+	Email  string
+}
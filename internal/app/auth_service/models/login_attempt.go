@@ -0,0 +1,15 @@
+package auth
+
+const (
+	LoginAttemptByLogin = "login"
+	LoginAttemptByIP    = "ip"
+	// запросы сброса пароля считаются тем же механизмом, отдельно от входа
+	PasswordResetByEmail = "reset_email"
+	PasswordResetByIP    = "reset_ip"
+)
+
+// LoginAttemptKey по чему считаются неудачные попытки входа или запросы сброса пароля
+type LoginAttemptKey struct {
+	Kind string
+	Key  string
+}
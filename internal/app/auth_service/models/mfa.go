@@ -0,0 +1,18 @@
+package auth
+
+// MFA настройки второго фактора. Secret хранится зашифрованным,
+// расшифровывает его usecase.
+type MFA struct {
+	UserID       int
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64
+}
+
+// VerifyMFARequest второй шаг входа: токен из ответа Login и код из
+// приложения-аутентификатора или резервный код
+type VerifyMFARequest struct {
+	MFAToken string
+	Code     string
+	Client   ClientInfo
+}
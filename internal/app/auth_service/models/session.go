@@ -0,0 +1,28 @@
+package auth
+
+import "time"
+
+// ClientInfo клиент, с которого открыта или продлена сессия
+type ClientInfo struct {
+	UserAgent string
+	IP        string
+}
+
+type Session struct {
+	ID         int
+	UserID     int
+	UserAgent  string
+	IP         string
+	ExpiresAt  time.Time
+	LastSeenAt time.Time
+	CreatedAt  time.Time
+}
+
+// RefreshToken состояние предъявленного refresh-токена и его сессии
+type RefreshToken struct {
+	SessionID      int
+	UserID         int
+	Used           bool
+	SessionRevoked bool
+	ExpiresAt      time.Time
+}
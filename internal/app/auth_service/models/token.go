@@ -0,0 +1,22 @@
+package auth
+
+import "time"
+
+// PersonalToken персональный токен доступа; сам токен не хранится, только хеш
+type PersonalToken struct {
+	ID     int
+	UserID int
+	Name   string
+	Scopes []string
+	// ExpiresAt nil — токен бессрочный
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+type CreatePersonalTokenRequest struct {
+	UserID    int
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
@@ -3,27 +3,35 @@ package auth
 import "time"
 
 type User struct {
-	ID           int       `json:"id"`
-	FirstName    string    `json:"first_name"`
-	LastName     string    `json:"last_name"`
-	Email        string    `json:"email"`
-	Login        string    `json:"login"`
-	Password     string    `json:"password,omitempty"`
-	Description  string    `json:"description,omitempty"`
-	LogoHashedID string    `json:"logo_hashed_id,omitempty"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID            int       `json:"id"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	Email         string    `json:"email"`
+	Login         string    `json:"login"`
+	Password      string    `json:"password,omitempty"`
+	Description   string    `json:"description,omitempty"`
+	LogoHashedID  string    `json:"logo_hashed_id,omitempty"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 type LoginRequest struct {
-	Login    string `json:"login" validate:"required,min=3,max=30"`
-	Password string `json:"password" validate:"required,min=6"`
+	Login    string     `json:"login" validate:"required,min=3,max=30"`
+	Password string     `json:"password" validate:"required,min=6"`
+	Client   ClientInfo `json:"-"`
 }
 
 type RegisterRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Login    string `json:"login" validate:"required,min=3,max=30,alphanum"`
-	Password string `json:"password" validate:"required,min=6,max=100"`
+	Email    string     `json:"email" validate:"required,email"`
+	Login    string     `json:"login" validate:"required,min=3,max=30,alphanum"`
+	Password string     `json:"password" validate:"required,min=6,max=100"`
+	Client   ClientInfo `json:"-"`
+}
+
+type PasswordResetRequest struct {
+	Email  string
+	Client ClientInfo
 }
 
 type AuthResponse struct {
@@ -38,3 +46,13 @@ type UpdateProfileRequest struct {
 	Email        string `json:"email" validate:"required,email"`
 	LogoHashedID string `json:"logo_hashed_id,omitempty"`
 }
+
+// ChangePasswordRequest смена пароля из настроек; сессия SessionID остается активной
+type ChangePasswordRequest struct {
+	UserID      int
+	SessionID   int
+	OldPassword string
+	NewPassword string
+	// Code код 2FA, если она включена
+	Code string
+}
@@ -0,0 +1,177 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+const accountDeletionColumns = "user_id, scheduled_at, step, image_ids, attempts"
+
+func scanAccountDeletion(row rowScanner) (authmodels.AccountDeletion, error) {
+	var (
+		deletion authmodels.AccountDeletion
+		imageIDs string
+	)
+	if err := row.Scan(&deletion.UserID, &deletion.ScheduledAt, &deletion.Step, &imageIDs, &deletion.Attempts); err != nil {
+		return authmodels.AccountDeletion{}, err
+	}
+	deletion.ImageIDs = strings.Fields(imageIDs)
+	return deletion, nil
+}
+
+// ScheduleAccountDeletion планирует удаление; если оно уже запланировано,
+// возвращает прежний срок
+func (r *PostgresRepository) ScheduleAccountDeletion(ctx context.Context, userID int, scheduledAt time.Time) (authmodels.AccountDeletion, error) {
+	deletion, err := scanAccountDeletion(r.db.QueryRowContext(ctx, `
+		INSERT INTO account_deletion (user_id, scheduled_at)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
+		RETURNING `+accountDeletionColumns, userID, scheduledAt))
+	if err != nil {
+		return authmodels.AccountDeletion{}, MapPgError(err)
+	}
+	return deletion, nil
+}
+
+// CancelAccountDeletion отменяет удаление, пока не завершен ни один шаг
+func (r *PostgresRepository) CancelAccountDeletion(ctx context.Context, userID int) error {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM account_deletion
+		WHERE user_id = $1 AND step = ''
+	`, userID)
+	if err != nil {
+		return MapPgError(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM account_deletion WHERE user_id = $1)
+	`, userID).Scan(&exists); err != nil {
+		return MapPgError(err)
+	}
+	if exists {
+		return serviceerrors.ErrAccountDeletionStarted
+	}
+	return serviceerrors.ErrAccountDeletionNotFound
+}
+
+// ClaimAccountDeletions выдает незавершенные удаления, срок которых
+// наступил, и до lockedUntil не выдает их другим шлюзам
+func (r *PostgresRepository) ClaimAccountDeletions(ctx context.Context, now, lockedUntil time.Time, limit int) ([]authmodels.AccountDeletion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE account_deletion
+		SET locked_until = $2, attempts = attempts + 1
+		WHERE user_id IN (
+			SELECT user_id
+			FROM account_deletion
+			WHERE completed_at IS NULL AND scheduled_at <= $1
+				AND (locked_until IS NULL OR locked_until <= $1)
+			ORDER BY scheduled_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+accountDeletionColumns, now, lockedUntil, limit)
+	if err != nil {
+		return nil, MapPgError(err)
+	}
+	defer rows.Close()
+
+	var deletions []authmodels.AccountDeletion
+	for rows.Next() {
+		deletion, err := scanAccountDeletion(rows)
+		if err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, deletion)
+	}
+	return deletions, rows.Err()
+}
+
+// AdvanceAccountDeletion сохраняет завершенный шаг. Пустой imageIDs не
+// меняет сохраненные изображения; completedAt завершает удаление.
+func (r *PostgresRepository) AdvanceAccountDeletion(ctx context.Context, userID int, step string, imageIDs []string, completedAt *time.Time) error {
+	var images any
+	if len(imageIDs) > 0 {
+		images = strings.Join(imageIDs, " ")
+	}
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE account_deletion
+		SET step = $2,
+			image_ids = COALESCE($3, image_ids),
+			completed_at = $4,
+			locked_until = CASE WHEN $4::timestamptz IS NULL THEN locked_until END
+		WHERE user_id = $1 AND completed_at IS NULL
+	`, userID, step, images, completedAt)
+	if err != nil {
+		return MapPgError(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return serviceerrors.ErrAccountDeletionNotFound
+	}
+	return nil
+}
+
+// DeleteUser удаляет пользователя; сессии, токены и 2FA удаляются каскадно.
+// Возвращает сессии, которые были активны, чтобы шлюзы сбросили их из кеша.
+func (r *PostgresRepository) DeleteUser(ctx context.Context, userID int) ([]int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	revoked, err := revokeSessions(ctx, tx, userID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM "user" WHERE _id = $1`, userID); err != nil {
+		return nil, MapPgError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
+
+// FilterUsedImages изображения из ids, которые стоят аватарами
+func (r *PostgresRepository) FilterUsedImages(ctx context.Context, ids []string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT DISTINCT logo_hashed_id
+		FROM "user"
+		WHERE logo_hashed_id = ANY($1)
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, MapPgError(err)
+	}
+	defer rows.Close()
+
+	var used []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		used = append(used, id)
+	}
+	return used, rows.Err()
+}
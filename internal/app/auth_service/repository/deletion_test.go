@@ -0,0 +1,140 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+)
+
+var accountDeletionRowColumns = []string{"user_id", "scheduled_at", "step", "image_ids", "attempts"}
+
+func TestPostgresRepository_ScheduleAccountDeletion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	scheduled := time.Now().Add(14 * 24 * time.Hour)
+	earlier := scheduled.Add(-time.Hour)
+
+	// повторный запрос не сдвигает срок
+	mock.ExpectQuery(`INSERT INTO account_deletion \(user_id, scheduled_at\)`).
+		WithArgs(1, scheduled).
+		WillReturnRows(sqlmock.NewRows(accountDeletionRowColumns).AddRow(1, earlier, "", "", 0))
+
+	deletion, err := repo.ScheduleAccountDeletion(context.Background(), 1, scheduled)
+	require.NoError(t, err)
+	require.Equal(t, earlier, deletion.ScheduledAt)
+	require.Empty(t, deletion.ImageIDs)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_CancelAccountDeletion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec(`DELETE FROM account_deletion`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.CancelAccountDeletion(context.Background(), 1))
+
+	mock.ExpectExec(`DELETE FROM account_deletion`).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT EXISTS`).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	require.ErrorIs(t, repo.CancelAccountDeletion(context.Background(), 2), serviceerrors.ErrAccountDeletionStarted)
+
+	mock.ExpectExec(`DELETE FROM account_deletion`).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT EXISTS`).WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	require.ErrorIs(t, repo.CancelAccountDeletion(context.Background(), 3), serviceerrors.ErrAccountDeletionNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ClaimAccountDeletions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(`UPDATE account_deletion\s+SET locked_until = \$2, attempts = attempts \+ 1`).
+		WithArgs(now, now.Add(time.Minute), 10).
+		WillReturnRows(sqlmock.NewRows(accountDeletionRowColumns).
+			AddRow(1, now.Add(-time.Hour), "budgets", "aa bb", 2))
+
+	deletions, err := repo.ClaimAccountDeletions(context.Background(), now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, deletions, 1)
+	require.Equal(t, "budgets", deletions[0].Step)
+	require.Equal(t, []string{"aa", "bb"}, deletions[0].ImageIDs)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_AdvanceAccountDeletion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectExec(`UPDATE account_deletion`).
+		WithArgs(1, "collect", "aa bb", nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.AdvanceAccountDeletion(context.Background(), 1, "collect", []string{"aa", "bb"}, nil))
+
+	mock.ExpectExec(`UPDATE account_deletion`).
+		WithArgs(1, "done", nil, &now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.AdvanceAccountDeletion(context.Background(), 1, "done", nil, &now))
+
+	// отмененное или уже завершенное удаление
+	mock.ExpectExec(`UPDATE account_deletion`).
+		WithArgs(2, "budgets", nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.AdvanceAccountDeletion(context.Background(), 2, "budgets", nil, nil), serviceerrors.ErrAccountDeletionNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_DeleteUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE session`).
+		WithArgs(1, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(5).AddRow(6))
+	mock.ExpectExec(`DELETE FROM "user"`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	revoked, err := repo.DeleteUser(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []int{5, 6}, revoked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_FilterUsedImages(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`SELECT DISTINCT logo_hashed_id`).
+		WithArgs(pq.Array([]string{"aa", "bb"})).
+		WillReturnRows(sqlmock.NewRows([]string{"logo_hashed_id"}).AddRow("bb"))
+
+	used, err := repo.FilterUsedImages(context.Background(), []string{"aa", "bb"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bb"}, used)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
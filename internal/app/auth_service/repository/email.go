@@ -0,0 +1,178 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+func (r *PostgresRepository) CreateEmailVerificationToken(ctx context.Context, userID int, email, tokenHash string, expiresAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO email_token (token_hash, user_id, purpose, email, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, tokenHash, userID, authmodels.EmailTokenVerify, email, expiresAt)
+	if err != nil {
+		return MapPgError(err)
+	}
+	return nil
+}
+
+// StartEmailChange заводит новую заявку на смену email вместо незавершенных
+// старых (их токены удаляются каскадом) и сохраняет токены для писем.
+func (r *PostgresRepository) StartEmailChange(ctx context.Context, change authmodels.EmailChange) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM email_change
+		WHERE user_id = $1 AND completed_at IS NULL
+	`, change.UserID); err != nil {
+		return MapPgError(err)
+	}
+
+	var changeID int
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO email_change (user_id, new_email, old_confirmed_at, expires_at)
+		VALUES ($1, $2, CASE WHEN $3 THEN NOW() END, $4)
+		RETURNING _id
+	`, change.UserID, change.NewEmail, change.OldTokenHash == "", change.ExpiresAt).Scan(&changeID); err != nil {
+		return MapPgError(err)
+	}
+
+	insertToken := `
+		INSERT INTO email_token (token_hash, user_id, purpose, email, change_id, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	if _, err := tx.ExecContext(ctx, insertToken,
+		change.NewTokenHash, change.UserID, authmodels.EmailTokenChangeNew, change.NewEmail, changeID, change.ExpiresAt,
+	); err != nil {
+		return MapPgError(err)
+	}
+	if change.OldTokenHash != "" {
+		if _, err := tx.ExecContext(ctx, insertToken,
+			change.OldTokenHash, change.UserID, authmodels.EmailTokenChangeOld, change.OldEmail, changeID, change.ExpiresAt,
+		); err != nil {
+			return MapPgError(err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetPendingEmail новый адрес из незавершенной смены email или пустая строка
+func (r *PostgresRepository) GetPendingEmail(ctx context.Context, userID int, now time.Time) (string, error) {
+	var email string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT new_email
+		FROM email_change
+		WHERE user_id = $1 AND completed_at IS NULL AND expires_at > $2
+		ORDER BY created_at DESC
+		LIMIT 1
+	`, userID, now).Scan(&email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", MapPgError(err)
+	}
+	return email, nil
+}
+
+// ConfirmEmailToken погашает токен из письма и применяет его: подтверждает
+// адрес после регистрации или одну из сторон смены email. Когда подтверждены
+// обе стороны, адрес пользователя меняется и сразу считается подтвержденным.
+// Неизвестный, использованный или истекший токен — ErrTokenInvalid.
+func (r *PostgresRepository) ConfirmEmailToken(ctx context.Context, tokenHash string, now time.Time) (authmodels.EmailConfirmation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return authmodels.EmailConfirmation{}, err
+	}
+	defer tx.Rollback()
+
+	var (
+		purpose  string
+		changeID sql.NullInt64
+		result   authmodels.EmailConfirmation
+	)
+	err = tx.QueryRowContext(ctx, `
+		UPDATE email_token
+		SET used_at = $2
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+		RETURNING user_id, purpose, email, change_id
+	`, tokenHash, now).Scan(&result.UserID, &purpose, &result.Email, &changeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return authmodels.EmailConfirmation{}, serviceerrors.ErrTokenInvalid
+	}
+	if err != nil {
+		return authmodels.EmailConfirmation{}, MapPgError(err)
+	}
+
+	if purpose == authmodels.EmailTokenVerify {
+		// адрес могли сменить после отправки письма, тогда ссылка устарела
+		res, err := tx.ExecContext(ctx, `
+			UPDATE "user"
+			SET email_verified_at = COALESCE(email_verified_at, $3)
+			WHERE _id = $1 AND email = $2
+		`, result.UserID, result.Email, now)
+		if err != nil {
+			return authmodels.EmailConfirmation{}, MapPgError(err)
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return authmodels.EmailConfirmation{}, serviceerrors.ErrTokenInvalid
+		}
+		result.Status = authmodels.EmailStatusVerified
+	} else if err := applyEmailChangeToken(ctx, tx, &result, purpose, changeID.Int64, now); err != nil {
+		return authmodels.EmailConfirmation{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return authmodels.EmailConfirmation{}, err
+	}
+	return result, nil
+}
+
+// applyEmailChangeToken отмечает подтвержденную сторону смены email и, если
+// подтверждены обе, переносит новый адрес в "user"
+func applyEmailChangeToken(ctx context.Context, tx *sql.Tx, result *authmodels.EmailConfirmation, purpose string, changeID int64, now time.Time) error {
+	var completed bool
+	err := tx.QueryRowContext(ctx, `
+		UPDATE email_change
+		SET old_confirmed_at = CASE WHEN $2 = 'change_old' THEN $3 ELSE old_confirmed_at END,
+		    new_confirmed_at = CASE WHEN $2 = 'change_new' THEN $3 ELSE new_confirmed_at END
+		WHERE _id = $1 AND completed_at IS NULL
+		RETURNING new_email, old_confirmed_at IS NOT NULL AND new_confirmed_at IS NOT NULL
+	`, changeID, purpose, now).Scan(&result.Email, &completed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return serviceerrors.ErrTokenInvalid
+	}
+	if err != nil {
+		return MapPgError(err)
+	}
+
+	result.Status = authmodels.EmailStatusChangePending
+	if completed {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE "user"
+			SET email = $2, email_verified_at = $3, updated_at = NOW()
+			WHERE _id = $1
+		`, result.UserID, result.Email, now); err != nil {
+			return MapPgError(err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE email_change
+			SET completed_at = $2
+			WHERE _id = $1
+		`, changeID, now); err != nil {
+			return MapPgError(err)
+		}
+		result.Status = authmodels.EmailStatusChanged
+	}
+	return nil
+}
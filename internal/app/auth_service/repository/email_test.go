@@ -0,0 +1,229 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+func TestPostgresRepository_StartEmailChange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	expires := time.Now().Add(time.Hour)
+	change := authmodels.EmailChange{
+		UserID:       1,
+		OldEmail:     "old@example.com",
+		NewEmail:     "new@example.com",
+		OldTokenHash: "old-hash",
+		NewTokenHash: "new-hash",
+		ExpiresAt:    expires,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM email_change`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO email_change`).
+		WithArgs(1, "new@example.com", false, expires).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(7))
+	mock.ExpectExec(`INSERT INTO email_token`).
+		WithArgs("new-hash", 1, authmodels.EmailTokenChangeNew, "new@example.com", 7, expires).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO email_token`).
+		WithArgs("old-hash", 1, authmodels.EmailTokenChangeOld, "old@example.com", 7, expires).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.StartEmailChange(context.Background(), change))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_StartEmailChange_OldUnverified(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	expires := time.Now().Add(time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM email_change`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO email_change`).
+		WithArgs(1, "new@example.com", true, expires).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(7))
+	mock.ExpectExec(`INSERT INTO email_token`).
+		WithArgs("new-hash", 1, authmodels.EmailTokenChangeNew, "new@example.com", 7, expires).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.StartEmailChange(context.Background(), authmodels.EmailChange{
+		UserID: 1, NewEmail: "new@example.com", NewTokenHash: "new-hash", ExpiresAt: expires,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_GetPendingEmail_None(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT new_email\s+FROM email_change`).
+		WithArgs(1, now).
+		WillReturnError(sql.ErrNoRows)
+
+	email, err := repo.GetPendingEmail(context.Background(), 1, now)
+	require.NoError(t, err)
+	require.Empty(t, email)
+}
+
+func TestPostgresRepository_ConfirmEmailToken_Verify(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE email_token\s+SET used_at = \$2`).
+		WithArgs("hash", now).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "purpose", "email", "change_id"}).
+			AddRow(1, authmodels.EmailTokenVerify, "ivan@example.com", nil))
+	mock.ExpectExec(`UPDATE "user"\s+SET email_verified_at`).
+		WithArgs(1, "ivan@example.com", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	res, err := repo.ConfirmEmailToken(context.Background(), "hash", now)
+	require.NoError(t, err)
+	require.Equal(t, authmodels.EmailConfirmation{UserID: 1, Status: authmodels.EmailStatusVerified, Email: "ivan@example.com"}, res)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ConfirmEmailToken_VerifyStaleEmail(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE email_token`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "purpose", "email", "change_id"}).
+			AddRow(1, authmodels.EmailTokenVerify, "old@example.com", nil))
+	mock.ExpectExec(`UPDATE "user"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err = repo.ConfirmEmailToken(context.Background(), "hash", time.Now())
+	require.ErrorIs(t, err, serviceerrors.ErrTokenInvalid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ConfirmEmailToken_ChangePending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE email_token`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "purpose", "email", "change_id"}).
+			AddRow(1, authmodels.EmailTokenChangeOld, "old@example.com", 7))
+	mock.ExpectQuery(`UPDATE email_change`).
+		WithArgs(int64(7), authmodels.EmailTokenChangeOld, now).
+		WillReturnRows(sqlmock.NewRows([]string{"new_email", "completed"}).AddRow("new@example.com", false))
+	mock.ExpectCommit()
+
+	res, err := repo.ConfirmEmailToken(context.Background(), "hash", now)
+	require.NoError(t, err)
+	require.Equal(t, authmodels.EmailStatusChangePending, res.Status)
+	require.Equal(t, "new@example.com", res.Email)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ConfirmEmailToken_ChangeCompleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE email_token`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "purpose", "email", "change_id"}).
+			AddRow(1, authmodels.EmailTokenChangeNew, "new@example.com", 7))
+	mock.ExpectQuery(`UPDATE email_change`).
+		WithArgs(int64(7), authmodels.EmailTokenChangeNew, now).
+		WillReturnRows(sqlmock.NewRows([]string{"new_email", "completed"}).AddRow("new@example.com", true))
+	mock.ExpectExec(`UPDATE "user"\s+SET email = \$2`).
+		WithArgs(1, "new@example.com", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE email_change\s+SET completed_at`).
+		WithArgs(int64(7), now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	res, err := repo.ConfirmEmailToken(context.Background(), "hash", now)
+	require.NoError(t, err)
+	require.Equal(t, authmodels.EmailStatusChanged, res.Status)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ConfirmEmailToken_NewEmailTaken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE email_token`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "purpose", "email", "change_id"}).
+			AddRow(1, authmodels.EmailTokenChangeNew, "new@example.com", 7))
+	mock.ExpectQuery(`UPDATE email_change`).
+		WillReturnRows(sqlmock.NewRows([]string{"new_email", "completed"}).AddRow("new@example.com", true))
+	mock.ExpectExec(`UPDATE "user"`).
+		WillReturnError(&pq.Error{Code: "23505", Constraint: "user_email_key"})
+	mock.ExpectRollback()
+
+	_, err = repo.ConfirmEmailToken(context.Background(), "hash", time.Now())
+	require.ErrorIs(t, err, serviceerrors.ErrEmailExists)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ConfirmEmailToken_Invalid(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE email_token`).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err = repo.ConfirmEmailToken(context.Background(), "used", time.Now())
+	require.ErrorIs(t, err, serviceerrors.ErrTokenInvalid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
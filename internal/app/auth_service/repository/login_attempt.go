@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+// GetLoginLockedUntil время окончания блокировки входа, нулевое если блокировки не было
+func (r *PostgresRepository) GetLoginLockedUntil(ctx context.Context, key authmodels.LoginAttemptKey) (time.Time, error) {
+	var lockedUntil sql.NullTime
+	err := r.db.QueryRowContext(ctx, `
+		SELECT locked_until
+		FROM login_attempt
+		WHERE kind = $1 AND key = $2
+	`, key.Kind, key.Key).Scan(&lockedUntil)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, MapPgError(err)
+	}
+	return lockedUntil.Time, nil
+}
+
+// RecordLoginFailure увеличивает счетчик неудачных попыток и возвращает его.
+// Если последняя ошибка и блокировка закончились раньше resetBefore,
+// счет начинается заново.
+func (r *PostgresRepository) RecordLoginFailure(ctx context.Context, key authmodels.LoginAttemptKey, now, resetBefore time.Time) (int, error) {
+	var failures int
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO login_attempt (kind, key, failures, last_failure_at)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT (kind, key) DO UPDATE
+		SET failures = CASE
+				WHEN GREATEST(login_attempt.last_failure_at, login_attempt.locked_until) < $4 THEN 1
+				ELSE login_attempt.failures + 1
+			END,
+			last_failure_at = $3
+		RETURNING failures
+	`, key.Kind, key.Key, now, resetBefore).Scan(&failures)
+	if err != nil {
+		return 0, MapPgError(err)
+	}
+	return failures, nil
+}
+
+func (r *PostgresRepository) LockLogin(ctx context.Context, key authmodels.LoginAttemptKey, until time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE login_attempt
+		SET locked_until = $3
+		WHERE kind = $1 AND key = $2
+	`, key.Kind, key.Key, until)
+	if err != nil {
+		return MapPgError(err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) ResetLoginFailures(ctx context.Context, key authmodels.LoginAttemptKey) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM login_attempt
+		WHERE kind = $1 AND key = $2
+	`, key.Kind, key.Key)
+	if err != nil {
+		return MapPgError(err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+func TestPostgresRepository_GetLoginLockedUntil(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	key := authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "ivan"}
+	until := time.Now().Add(time.Minute)
+
+	mock.ExpectQuery(`SELECT locked_until\s+FROM login_attempt`).
+		WithArgs("login", "ivan").
+		WillReturnRows(sqlmock.NewRows([]string{"locked_until"}).AddRow(until))
+	lockedUntil, err := repo.GetLoginLockedUntil(context.Background(), key)
+	require.NoError(t, err)
+	require.Equal(t, until, lockedUntil)
+
+	mock.ExpectQuery(`SELECT locked_until`).
+		WithArgs("login", "ivan").
+		WillReturnError(sql.ErrNoRows)
+	lockedUntil, err = repo.GetLoginLockedUntil(context.Background(), key)
+	require.NoError(t, err)
+	require.True(t, lockedUntil.IsZero())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_RecordLoginFailure(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	key := authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByIP, Key: "10.0.0.1"}
+	now := time.Now()
+	resetBefore := now.Add(-15 * time.Minute)
+
+	mock.ExpectQuery(`INSERT INTO login_attempt .* ON CONFLICT \(kind, key\) DO UPDATE`).
+		WithArgs("ip", "10.0.0.1", now, resetBefore).
+		WillReturnRows(sqlmock.NewRows([]string{"failures"}).AddRow(3))
+
+	failures, err := repo.RecordLoginFailure(context.Background(), key, now, resetBefore)
+	require.NoError(t, err)
+	require.Equal(t, 3, failures)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,139 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+// GetMFA настройки второго фактора; если подключение не начиналось — ErrMFANotEnabled
+func (r *PostgresRepository) GetMFA(ctx context.Context, userID int) (authmodels.MFA, error) {
+	mfa := authmodels.MFA{UserID: userID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT secret, enabled_at IS NOT NULL, last_used_step
+		FROM user_mfa
+		WHERE user_id = $1
+	`, userID).Scan(&mfa.Secret, &mfa.Enabled, &mfa.LastUsedStep)
+	if errors.Is(err, sql.ErrNoRows) {
+		return authmodels.MFA{}, serviceerrors.ErrMFANotEnabled
+	}
+	if err != nil {
+		return authmodels.MFA{}, MapPgError(err)
+	}
+	return mfa, nil
+}
+
+// SaveMFASecret начинает подключение заново с новым секретом.
+// Включенный второй фактор не трогается — ErrMFAAlreadyEnabled.
+func (r *PostgresRepository) SaveMFASecret(ctx context.Context, userID int, secret string) error {
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_mfa (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, created_at = NOW()
+		WHERE user_mfa.enabled_at IS NULL
+	`, userID, secret)
+	if err != nil {
+		return MapPgError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return serviceerrors.ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+// EnableMFA включает второй фактор, запоминает шаг кода, которым его
+// подтвердили, и заменяет резервные коды
+func (r *PostgresRepository) EnableMFA(ctx context.Context, userID int, step int64, codeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE user_mfa
+		SET enabled_at = NOW(), last_used_step = $2
+		WHERE user_id = $1 AND enabled_at IS NULL
+	`, userID, step)
+	if err != nil {
+		return MapPgError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return serviceerrors.ErrMFAAlreadyEnabled
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mfa_recovery_code WHERE user_id = $1`, userID); err != nil {
+		return MapPgError(err)
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO mfa_recovery_code (user_id, code_hash)
+			VALUES ($1, $2)
+		`, userID, hash); err != nil {
+			return MapPgError(err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// UseMFAStep отмечает шаг TOTP использованным. Если этот или более поздний
+// шаг уже приняли (код перехватили или отправили дважды) — ErrMFACodeInvalid.
+func (r *PostgresRepository) UseMFAStep(ctx context.Context, userID int, step int64) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE user_mfa
+		SET last_used_step = $2
+		WHERE user_id = $1 AND enabled_at IS NOT NULL AND last_used_step < $2
+	`, userID, step)
+	if err != nil {
+		return MapPgError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return serviceerrors.ErrMFACodeInvalid
+	}
+	return nil
+}
+
+// UseRecoveryCode погашает резервный код; неизвестный или
+// использованный код — ErrMFACodeInvalid
+func (r *PostgresRepository) UseRecoveryCode(ctx context.Context, userID int, codeHash string, now time.Time) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE mfa_recovery_code
+		SET used_at = $3
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`, userID, codeHash, now)
+	if err != nil {
+		return MapPgError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return serviceerrors.ErrMFACodeInvalid
+	}
+	return nil
+}
+
+// DisableMFA выключает второй фактор; резервные коды удаляются каскадом
+func (r *PostgresRepository) DisableMFA(ctx context.Context, userID int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM user_mfa WHERE user_id = $1`, userID)
+	if err != nil {
+		return MapPgError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return serviceerrors.ErrMFANotEnabled
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+func TestPostgresRepository_GetMFA(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`SELECT secret, enabled_at IS NOT NULL, last_used_step\s+FROM user_mfa`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"secret", "enabled", "last_used_step"}).AddRow("enc", true, 42))
+
+	mfa, err := repo.GetMFA(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, authmodels.MFA{UserID: 1, Secret: "enc", Enabled: true, LastUsedStep: 42}, mfa)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_GetMFA_NotEnabled(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`FROM user_mfa`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"secret", "enabled", "last_used_step"}))
+
+	_, err = repo.GetMFA(context.Background(), 1)
+	require.ErrorIs(t, err, serviceerrors.ErrMFANotEnabled)
+}
+
+func TestPostgresRepository_SaveMFASecret(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec(`INSERT INTO user_mfa .*WHERE user_mfa.enabled_at IS NULL`).
+		WithArgs(1, "enc").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.SaveMFASecret(context.Background(), 1, "enc"))
+
+	mock.ExpectExec(`INSERT INTO user_mfa`).
+		WithArgs(1, "enc").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.SaveMFASecret(context.Background(), 1, "enc"), serviceerrors.ErrMFAAlreadyEnabled)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_EnableMFA(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE user_mfa\s+SET enabled_at = NOW\(\), last_used_step = \$2`).
+		WithArgs(1, int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM mfa_recovery_code`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO mfa_recovery_code`).
+		WithArgs(1, "h1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO mfa_recovery_code`).
+		WithArgs(1, "h2").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.EnableMFA(context.Background(), 1, 100, []string{"h1", "h2"}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_EnableMFA_AlreadyEnabled(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE user_mfa`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.EnableMFA(context.Background(), 1, 100, []string{"h1"})
+	require.ErrorIs(t, err, serviceerrors.ErrMFAAlreadyEnabled)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_UseMFAStep(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec(`UPDATE user_mfa\s+SET last_used_step = \$2\s+WHERE .*last_used_step < \$2`).
+		WithArgs(1, int64(101)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.UseMFAStep(context.Background(), 1, 101))
+
+	mock.ExpectExec(`UPDATE user_mfa`).
+		WithArgs(1, int64(101)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.UseMFAStep(context.Background(), 1, 101), serviceerrors.ErrMFACodeInvalid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_UseRecoveryCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`UPDATE mfa_recovery_code\s+SET used_at = \$3`).
+		WithArgs(1, "hash", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.UseRecoveryCode(context.Background(), 1, "hash", now))
+
+	mock.ExpectExec(`UPDATE mfa_recovery_code`).
+		WithArgs(1, "hash", now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.UseRecoveryCode(context.Background(), 1, "hash", now), serviceerrors.ErrMFACodeInvalid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_DisableMFA(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec(`DELETE FROM user_mfa`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.DisableMFA(context.Background(), 1))
+
+	mock.ExpectExec(`DELETE FROM user_mfa`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.DisableMFA(context.Background(), 1), serviceerrors.ErrMFANotEnabled)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
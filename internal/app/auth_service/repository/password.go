@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+)
+
+// ChangePassword сохраняет новый хеш пароля, отзывает все сессии
+// пользователя, кроме keepSessionID, и удаляет его персональные токены.
+// Возвращает отозванные сессии.
+func (r *PostgresRepository) ChangePassword(ctx context.Context, userID int, passwordHash string, keepSessionID int) ([]int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE "user"
+		SET user_hashed_password = $2, updated_at = NOW()
+		WHERE _id = $1
+	`, userID, passwordHash)
+	if err != nil {
+		return nil, MapPgError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, serviceerrors.ErrUserNotFound
+	}
+
+	revoked, err := revokeSessions(ctx, tx, userID, keepSessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := deletePersonalTokens(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
+
+// UpdatePasswordHash пересохраняет хеш того же пароля с новыми параметрами.
+// Хеш меняется, только если пароль не успели сменить с момента входа.
+func (r *PostgresRepository) UpdatePasswordHash(ctx context.Context, userID int, oldHash, newHash string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE "user"
+		SET user_hashed_password = $3
+		WHERE _id = $1 AND user_hashed_password = $2
+	`, userID, oldHash, newHash)
+	if err != nil {
+		return MapPgError(err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+)
+
+func (r *PostgresRepository) CreatePasswordResetToken(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO password_reset_token (token_hash, user_id, expires_at)
+		VALUES ($1, $2, $3)
+	`, tokenHash, userID, expiresAt)
+	if err != nil {
+		return MapPgError(err)
+	}
+	return nil
+}
+
+// ResetPassword погашает токен сброса, меняет пароль, отзывает все сессии
+// и удаляет персональные токены пользователя в одной транзакции. Возвращает пользователя и отозванные сессии.
+// Неизвестный, использованный или истекший токен — ErrTokenInvalid.
+func (r *PostgresRepository) ResetPassword(ctx context.Context, tokenHash, passwordHash string, now time.Time) (int, []int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer tx.Rollback()
+
+	var userID int
+	err = tx.QueryRowContext(ctx, `
+		UPDATE password_reset_token
+		SET used_at = $2
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+		RETURNING user_id
+	`, tokenHash, now).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil, serviceerrors.ErrTokenInvalid
+	}
+	if err != nil {
+		return 0, nil, MapPgError(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE "user"
+		SET user_hashed_password = $2, updated_at = NOW()
+		WHERE _id = $1
+	`, userID, passwordHash); err != nil {
+		return 0, nil, MapPgError(err)
+	}
+
+	// остальные ссылки из старых писем больше не нужны
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE password_reset_token
+		SET used_at = $2
+		WHERE user_id = $1 AND used_at IS NULL
+	`, userID, now); err != nil {
+		return 0, nil, MapPgError(err)
+	}
+
+	revoked, err := revokeSessions(ctx, tx, userID, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := deletePersonalTokens(ctx, tx, userID); err != nil {
+		return 0, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, nil, err
+	}
+	return userID, revoked, nil
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+)
+
+func TestPostgresRepository_ResetPassword(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE password_reset_token\s+SET used_at = \$2\s+WHERE token_hash = \$1 AND used_at IS NULL AND expires_at > \$2`).
+		WithArgs("hash", now).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+	mock.ExpectExec(`UPDATE "user"\s+SET user_hashed_password = \$2`).
+		WithArgs(1, "argon").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE password_reset_token\s+SET used_at = \$2\s+WHERE user_id = \$1`).
+		WithArgs(1, now).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(`UPDATE session\s+SET revoked_at = NOW\(\)`).
+		WithArgs(1, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(3).AddRow(5))
+	// персональные токены перестают действовать вместе с сессиями
+	mock.ExpectExec(`DELETE FROM personal_token WHERE user_id = \$1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	userID, revoked, err := repo.ResetPassword(context.Background(), "hash", "argon", now)
+	require.NoError(t, err)
+	require.Equal(t, 1, userID)
+	require.Equal(t, []int{3, 5}, revoked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ResetPassword_InvalidToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE password_reset_token`).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, _, err = repo.ResetPassword(context.Background(), "used", "argon", time.Now())
+	require.ErrorIs(t, err, serviceerrors.ErrTokenInvalid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+)
+
+func TestPostgresRepository_ChangePassword(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "user"\s+SET user_hashed_password = \$2`).
+		WithArgs(1, "argon").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE session\s+SET revoked_at = NOW\(\)`).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(3))
+	// персональные токены перестают действовать вместе с сессиями
+	mock.ExpectExec(`DELETE FROM personal_token WHERE user_id = \$1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	revoked, err := repo.ChangePassword(context.Background(), 1, "argon", 5)
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, revoked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ChangePassword_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "user"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err = repo.ChangePassword(context.Background(), 99, "argon", 5)
+	require.ErrorIs(t, err, serviceerrors.ErrUserNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_UpdatePasswordHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec(`UPDATE "user"\s+SET user_hashed_password = \$3\s+WHERE _id = \$1 AND user_hashed_password = \$2`).
+		WithArgs(1, "old", "new").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.UpdatePasswordHash(context.Background(), 1, "old", "new"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
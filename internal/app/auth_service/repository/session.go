@@ -0,0 +1,222 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+const sessionColumns = "_id, user_id, user_agent, ip, expires_at, last_seen_at, created_at"
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSession(row rowScanner) (authmodels.Session, error) {
+	var session authmodels.Session
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.UserAgent,
+		&session.IP,
+		&session.ExpiresAt,
+		&session.LastSeenAt,
+		&session.CreatedAt,
+	)
+	return session, err
+}
+
+// CreateSession открывает сессию вместе с первым refresh-токеном
+func (r *PostgresRepository) CreateSession(ctx context.Context, userID int, tokenHash string, expiresAt time.Time, client authmodels.ClientInfo) (authmodels.Session, error) {
+	query := `
+		WITH s AS (
+			INSERT INTO session (user_id, expires_at, user_agent, ip)
+			VALUES ($1, $2, $4, $5)
+			RETURNING ` + sessionColumns + `
+		), t AS (
+			INSERT INTO session_refresh_token (token_hash, session_id)
+			SELECT $3, _id FROM s
+		)
+		SELECT ` + sessionColumns + ` FROM s
+	`
+
+	session, err := scanSession(r.db.QueryRowContext(ctx, query, userID, expiresAt, tokenHash, client.UserAgent, client.IP))
+	if err != nil {
+		return authmodels.Session{}, MapPgError(err)
+	}
+	return session, nil
+}
+
+func (r *PostgresRepository) GetRefreshToken(ctx context.Context, tokenHash string) (authmodels.RefreshToken, error) {
+	query := `
+		SELECT t.session_id, s.user_id, t.used_at IS NOT NULL, s.revoked_at IS NOT NULL, s.expires_at
+		FROM session_refresh_token t
+		JOIN session s ON s._id = t.session_id
+		WHERE t.token_hash = $1
+	`
+
+	var token authmodels.RefreshToken
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.SessionID,
+		&token.UserID,
+		&token.Used,
+		&token.SessionRevoked,
+		&token.ExpiresAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return authmodels.RefreshToken{}, serviceerrors.ErrTokenInvalid
+	}
+	if err != nil {
+		return authmodels.RefreshToken{}, MapPgError(err)
+	}
+	return token, nil
+}
+
+// RotateRefreshToken меняет refresh-токен сессии на новый, продлевает ее и
+// запоминает клиента. Если старый токен уже обменян параллельным запросом,
+// возвращает ErrRefreshTokenReused.
+func (r *PostgresRepository) RotateRefreshToken(ctx context.Context, sessionID int, oldHash, newHash string, expiresAt time.Time, client authmodels.ClientInfo) (authmodels.Session, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return authmodels.Session{}, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE session_refresh_token
+		SET used_at = NOW()
+		WHERE token_hash = $1 AND session_id = $2 AND used_at IS NULL
+	`, oldHash, sessionID)
+	if err != nil {
+		return authmodels.Session{}, MapPgError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return authmodels.Session{}, err
+	} else if n == 0 {
+		return authmodels.Session{}, serviceerrors.ErrRefreshTokenReused
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO session_refresh_token (token_hash, session_id)
+		VALUES ($1, $2)
+	`, newHash, sessionID); err != nil {
+		return authmodels.Session{}, MapPgError(err)
+	}
+
+	session, err := scanSession(tx.QueryRowContext(ctx, `
+		UPDATE session
+		SET expires_at = $2, last_seen_at = NOW(), user_agent = $3, ip = $4
+		WHERE _id = $1 AND revoked_at IS NULL
+		RETURNING `+sessionColumns,
+		sessionID, expiresAt, client.UserAgent, client.IP,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return authmodels.Session{}, serviceerrors.ErrSessionRevoked
+	}
+	if err != nil {
+		return authmodels.Session{}, MapPgError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return authmodels.Session{}, err
+	}
+	return session, nil
+}
+
+func (r *PostgresRepository) RevokeSession(ctx context.Context, userID, sessionID int) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE session
+		SET revoked_at = NOW()
+		WHERE _id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`, sessionID, userID)
+	if err != nil {
+		return MapPgError(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return serviceerrors.ErrSessionNotFound
+	}
+	return nil
+}
+
+// RevokeOtherSessions отзывает все активные сессии пользователя, кроме
+// keepSessionID, и возвращает их идентификаторы. keepSessionID = 0 отзывает все.
+func (r *PostgresRepository) RevokeOtherSessions(ctx context.Context, userID, keepSessionID int) ([]int, error) {
+	return revokeSessions(ctx, r.db, userID, keepSessionID)
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func revokeSessions(ctx context.Context, q queryer, userID, keepSessionID int) ([]int, error) {
+	rows, err := q.QueryContext(ctx, `
+		UPDATE session
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND _id <> $2 AND revoked_at IS NULL
+		RETURNING _id
+	`, userID, keepSessionID)
+	if err != nil {
+		return nil, MapPgError(err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ListActiveSessions сессии пользователя, которые не отозваны и не истекли,
+// недавно использованные первыми
+func (r *PostgresRepository) ListActiveSessions(ctx context.Context, userID int) ([]authmodels.Session, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+sessionColumns+`
+		FROM session
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		ORDER BY last_seen_at DESC, _id DESC
+	`, userID)
+	if err != nil {
+		return nil, MapPgError(err)
+	}
+	defer rows.Close()
+
+	var sessions []authmodels.Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+// IsSessionActive сессия активна, если не отозвана и не истекла
+func (r *PostgresRepository) IsSessionActive(ctx context.Context, userID, sessionID int) (bool, error) {
+	var active bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT revoked_at IS NULL AND expires_at > NOW()
+		FROM session
+		WHERE _id = $1 AND user_id = $2
+	`, sessionID, userID).Scan(&active)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, MapPgError(err)
+	}
+	return active, nil
+}
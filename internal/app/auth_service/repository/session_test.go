@@ -0,0 +1,188 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+var sessionRowColumns = []string{"_id", "user_id", "user_agent", "ip", "expires_at", "last_seen_at", "created_at"}
+
+var testClient = authmodels.ClientInfo{UserAgent: "Mozilla/5.0", IP: "10.0.0.1"}
+
+func TestPostgresRepository_CreateSession(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	expires := time.Now().Add(time.Hour)
+
+	mock.ExpectQuery(`INSERT INTO session \(user_id, expires_at, user_agent, ip\)`).
+		WithArgs(1, expires, "hash", testClient.UserAgent, testClient.IP).
+		WillReturnRows(sqlmock.NewRows(sessionRowColumns).AddRow(5, 1, testClient.UserAgent, testClient.IP, expires, time.Now(), time.Now()))
+
+	session, err := repo.CreateSession(context.Background(), 1, "hash", expires, testClient)
+	require.NoError(t, err)
+	require.Equal(t, 5, session.ID)
+	require.Equal(t, testClient.IP, session.IP)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_GetRefreshToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	expires := time.Now().Add(time.Hour)
+
+	mock.ExpectQuery(`FROM session_refresh_token t`).
+		WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows([]string{"session_id", "user_id", "used", "revoked", "expires_at"}).
+			AddRow(5, 1, true, false, expires))
+
+	token, err := repo.GetRefreshToken(context.Background(), "hash")
+	require.NoError(t, err)
+	require.True(t, token.Used)
+	require.Equal(t, 5, token.SessionID)
+
+	mock.ExpectQuery(`FROM session_refresh_token t`).
+		WithArgs("unknown").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetRefreshToken(context.Background(), "unknown")
+	require.ErrorIs(t, err, serviceerrors.ErrTokenInvalid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_RotateRefreshToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	expires := time.Now().Add(time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE session_refresh_token\s+SET used_at = NOW\(\)`).
+		WithArgs("old", 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO session_refresh_token`).
+		WithArgs("new", 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE session\s+SET expires_at = \$2, last_seen_at = NOW\(\), user_agent = \$3, ip = \$4`).
+		WithArgs(5, expires, testClient.UserAgent, testClient.IP).
+		WillReturnRows(sqlmock.NewRows(sessionRowColumns).AddRow(5, 1, testClient.UserAgent, testClient.IP, expires, time.Now(), time.Now()))
+	mock.ExpectCommit()
+
+	session, err := repo.RotateRefreshToken(context.Background(), 5, "old", "new", expires, testClient)
+	require.NoError(t, err)
+	require.Equal(t, expires, session.ExpiresAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_RotateRefreshToken_AlreadyUsed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE session_refresh_token`).
+		WithArgs("old", 5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err = repo.RotateRefreshToken(context.Background(), 5, "old", "new", time.Now(), testClient)
+	require.ErrorIs(t, err, serviceerrors.ErrRefreshTokenReused)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_RevokeSession(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec(`SET revoked_at = NOW\(\)`).
+		WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.RevokeSession(context.Background(), 1, 5))
+
+	mock.ExpectExec(`SET revoked_at = NOW\(\)`).
+		WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.RevokeSession(context.Background(), 1, 5), serviceerrors.ErrSessionNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_RevokeOtherSessions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`WHERE user_id = \$1 AND _id <> \$2 AND revoked_at IS NULL`).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(3).AddRow(4))
+
+	ids, err := repo.RevokeOtherSessions(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 4}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ListActiveSessions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM session\s+WHERE user_id = \$1 AND revoked_at IS NULL AND expires_at > NOW\(\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(sessionRowColumns).
+			AddRow(5, 1, testClient.UserAgent, testClient.IP, now, now, now).
+			AddRow(3, 1, "curl/8.0", "10.0.0.2", now, now.Add(-time.Hour), now))
+
+	sessions, err := repo.ListActiveSessions(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, "curl/8.0", sessions[1].UserAgent)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_IsSessionActive(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`SELECT revoked_at IS NULL AND expires_at > NOW\(\)`).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"active"}).AddRow(true))
+	active, err := repo.IsSessionActive(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.True(t, active)
+
+	mock.ExpectQuery(`SELECT revoked_at IS NULL`).
+		WithArgs(6, 1).
+		WillReturnError(sql.ErrNoRows)
+	active, err = repo.IsSessionActive(context.Background(), 1, 6)
+	require.NoError(t, err)
+	require.False(t, active)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
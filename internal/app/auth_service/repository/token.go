@@ -0,0 +1,139 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+const personalTokenColumns = "_id, user_id, name, scopes, expires_at, last_used_at, created_at"
+
+func scanPersonalToken(row rowScanner) (authmodels.PersonalToken, error) {
+	var (
+		token      authmodels.PersonalToken
+		scopes     string
+		expiresAt  sql.NullTime
+		lastUsedAt sql.NullTime
+	)
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&scopes,
+		&expiresAt,
+		&lastUsedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return authmodels.PersonalToken{}, err
+	}
+
+	token.Scopes = strings.Fields(scopes)
+	if expiresAt.Valid {
+		token.ExpiresAt = &expiresAt.Time
+	}
+	if lastUsedAt.Valid {
+		token.LastUsedAt = &lastUsedAt.Time
+	}
+	return token, nil
+}
+
+func (r *PostgresRepository) CreatePersonalToken(ctx context.Context, req authmodels.CreatePersonalTokenRequest, tokenHash string) (authmodels.PersonalToken, error) {
+	query := `
+		INSERT INTO personal_token (user_id, name, token_hash, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + personalTokenColumns
+
+	token, err := scanPersonalToken(r.db.QueryRowContext(ctx, query,
+		req.UserID, req.Name, tokenHash, strings.Join(req.Scopes, " "), req.ExpiresAt,
+	))
+	if err != nil {
+		return authmodels.PersonalToken{}, MapPgError(err)
+	}
+	return token, nil
+}
+
+// ListPersonalTokens токены пользователя, включая истекшие, новые первыми
+func (r *PostgresRepository) ListPersonalTokens(ctx context.Context, userID int) ([]authmodels.PersonalToken, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+personalTokenColumns+`
+		FROM personal_token
+		WHERE user_id = $1
+		ORDER BY created_at DESC, _id DESC
+	`, userID)
+	if err != nil {
+		return nil, MapPgError(err)
+	}
+	defer rows.Close()
+
+	var tokens []authmodels.PersonalToken
+	for rows.Next() {
+		token, err := scanPersonalToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, rows.Err()
+}
+
+// deletePersonalTokens удаляет все персональные токены пользователя: после смены
+// пароля доступ по ним, как и по старым сессиям, должен прекратиться.
+func deletePersonalTokens(ctx context.Context, tx *sql.Tx, userID int) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM personal_token WHERE user_id = $1`, userID); err != nil {
+		return MapPgError(err)
+	}
+	return nil
+}
+
+func (r *PostgresRepository) DeletePersonalToken(ctx context.Context, userID, tokenID int) error {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM personal_token
+		WHERE _id = $1 AND user_id = $2
+	`, tokenID, userID)
+	if err != nil {
+		return MapPgError(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return serviceerrors.ErrPersonalTokenNotFound
+	}
+	return nil
+}
+
+// GetPersonalToken токен по хешу; срок действия проверяет usecase
+func (r *PostgresRepository) GetPersonalToken(ctx context.Context, tokenHash string) (authmodels.PersonalToken, error) {
+	token, err := scanPersonalToken(r.db.QueryRowContext(ctx, `
+		SELECT `+personalTokenColumns+`
+		FROM personal_token
+		WHERE token_hash = $1
+	`, tokenHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return authmodels.PersonalToken{}, serviceerrors.ErrTokenInvalid
+	}
+	if err != nil {
+		return authmodels.PersonalToken{}, MapPgError(err)
+	}
+	return token, nil
+}
+
+// TouchPersonalToken отмечает использование токена не чаще раза в минуту
+func (r *PostgresRepository) TouchPersonalToken(ctx context.Context, tokenID int, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE personal_token
+		SET last_used_at = $2
+		WHERE _id = $1 AND (last_used_at IS NULL OR last_used_at < $2 - INTERVAL '1 minute')
+	`, tokenID, now)
+	if err != nil {
+		return MapPgError(err)
+	}
+	return nil
+}
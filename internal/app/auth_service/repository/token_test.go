@@ -0,0 +1,126 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+)
+
+var personalTokenRowColumns = []string{"_id", "user_id", "name", "scopes", "expires_at", "last_used_at", "created_at"}
+
+func TestPostgresRepository_CreatePersonalToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	expires := time.Now().Add(24 * time.Hour)
+	req := authmodels.CreatePersonalTokenRequest{
+		UserID:    1,
+		Name:      "import script",
+		Scopes:    []string{"operations:read", "operations:write"},
+		ExpiresAt: &expires,
+	}
+
+	mock.ExpectQuery(`INSERT INTO personal_token \(user_id, name, token_hash, scopes, expires_at\)`).
+		WithArgs(1, "import script", "hash", "operations:read operations:write", &expires).
+		WillReturnRows(sqlmock.NewRows(personalTokenRowColumns).
+			AddRow(3, 1, "import script", "operations:read operations:write", expires, nil, time.Now()))
+
+	token, err := repo.CreatePersonalToken(context.Background(), req, "hash")
+	require.NoError(t, err)
+	require.Equal(t, 3, token.ID)
+	require.Equal(t, []string{"operations:read", "operations:write"}, token.Scopes)
+	require.NotNil(t, token.ExpiresAt)
+	require.Nil(t, token.LastUsedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ListPersonalTokens(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	used := time.Now()
+
+	mock.ExpectQuery(`FROM personal_token`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(personalTokenRowColumns).
+			AddRow(4, 1, "reports", "budgets:read", nil, used, time.Now()).
+			AddRow(3, 1, "import", "operations:write", nil, nil, time.Now()))
+
+	tokens, err := repo.ListPersonalTokens(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Nil(t, tokens[0].ExpiresAt)
+	require.NotNil(t, tokens[0].LastUsedAt)
+	require.Equal(t, []string{"operations:write"}, tokens[1].Scopes)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_DeletePersonalToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec(`DELETE FROM personal_token`).
+		WithArgs(3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.DeletePersonalToken(context.Background(), 1, 3))
+
+	// чужой или уже удаленный токен
+	mock.ExpectExec(`DELETE FROM personal_token`).
+		WithArgs(3, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.DeletePersonalToken(context.Background(), 2, 3), serviceerrors.ErrPersonalTokenNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_GetPersonalToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`FROM personal_token`).
+		WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows(personalTokenRowColumns).
+			AddRow(3, 1, "import", "operations:write", nil, nil, time.Now()))
+
+	token, err := repo.GetPersonalToken(context.Background(), "hash")
+	require.NoError(t, err)
+	require.Equal(t, 1, token.UserID)
+
+	mock.ExpectQuery(`FROM personal_token`).
+		WithArgs("unknown").
+		WillReturnError(sql.ErrNoRows)
+	_, err = repo.GetPersonalToken(context.Background(), "unknown")
+	require.ErrorIs(t, err, serviceerrors.ErrTokenInvalid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_TouchPersonalToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectExec(`UPDATE personal_token`).
+		WithArgs(3, now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, repo.TouchPersonalToken(context.Background(), 3, now))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -112,7 +112,8 @@ func (r *PostgresRepository) GetUserByLogin(ctx context.Context, login string) (
 
 func (r *PostgresRepository) GetUserByID(ctx context.Context, id int) (authmodels.User, error) {
 	query := `
-		SELECT _id, user_name, surname, email, user_login, user_hashed_password, user_description, logo_hashed_id, created_at, updated_at
+		SELECT _id, user_name, surname, email, user_login, user_hashed_password, user_description, logo_hashed_id,
+		       email_verified_at IS NOT NULL, created_at, updated_at
 		FROM "user"
 		WHERE _id = $1
 	`
@@ -127,6 +128,37 @@ func (r *PostgresRepository) GetUserByID(ctx context.Context, id int) (authmodel
 		&user.Password,
 		&user.Description,
 		&user.LogoHashedID,
+		&user.EmailVerified,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if err != nil {
+		return authmodels.User{}, MapPgError(err)
+	}
+
+	return user, nil
+}
+
+func (r *PostgresRepository) GetUserByEmail(ctx context.Context, email string) (authmodels.User, error) {
+	query := `
+		SELECT _id, user_name, surname, email, user_login, user_hashed_password, user_description, logo_hashed_id,
+		       email_verified_at IS NOT NULL, created_at, updated_at
+		FROM "user"
+		WHERE email = $1
+	`
+
+	var user authmodels.User
+	err := r.db.QueryRowContext(ctx, query, email).Scan(
+		&user.ID,
+		&user.FirstName,
+		&user.LastName,
+		&user.Email,
+		&user.Login,
+		&user.Password,
+		&user.Description,
+		&user.LogoHashedID,
+		&user.EmailVerified,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -178,3 +210,43 @@ func (r *PostgresRepository) EditUserByID(ctx context.Context, user authmodels.U
 
 	return updatedUser, nil
 }
+
+func (r *PostgresRepository) RestoreProfile(ctx context.Context, user authmodels.User) (authmodels.User, error) {
+	query := `
+		UPDATE "user"
+		SET user_name = $1,
+		    surname = $2,
+		    user_description = NULLIF($3, ''),
+		    logo_hashed_id = COALESCE(NULLIF($4, ''), logo_hashed_id),
+		    updated_at = NOW()
+		WHERE _id = $5
+		RETURNING _id, user_name, surname, email, user_login, user_hashed_password,
+		          COALESCE(user_description, ''), logo_hashed_id, created_at, updated_at
+	`
+
+	var restored authmodels.User
+	err := r.db.QueryRowContext(ctx, query,
+		user.FirstName,
+		user.LastName,
+		user.Description,
+		user.LogoHashedID,
+		user.ID,
+	).Scan(
+		&restored.ID,
+		&restored.FirstName,
+		&restored.LastName,
+		&restored.Email,
+		&restored.Login,
+		&restored.Password,
+		&restored.Description,
+		&restored.LogoHashedID,
+		&restored.CreatedAt,
+		&restored.UpdatedAt,
+	)
+
+	if err != nil {
+		return authmodels.User{}, MapPgError(err)
+	}
+
+	return restored, nil
+}
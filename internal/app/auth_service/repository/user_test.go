@@ -94,17 +94,19 @@ func TestPostgresRepository_GetUserByID(t *testing.T) {
 	}
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT _id, user_name, surname, email, user_login, user_hashed_password, user_description, logo_hashed_id, created_at, updated_at
+		SELECT _id, user_name, surname, email, user_login, user_hashed_password, user_description, logo_hashed_id,
+		       email_verified_at IS NOT NULL, created_at, updated_at
 		FROM "user"
 		WHERE _id = $1
 	`)).
 		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{"_id", "user_name", "surname", "email", "user_login", "user_hashed_password", "user_description", "logo_hashed_id", "created_at", "updated_at"}).
-			AddRow(user.ID, user.FirstName, user.LastName, user.Email, user.Login, user.Password, "", "", time.Now(), time.Now()))
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "user_name", "surname", "email", "user_login", "user_hashed_password", "user_description", "logo_hashed_id", "email_verified", "created_at", "updated_at"}).
+			AddRow(user.ID, user.FirstName, user.LastName, user.Email, user.Login, user.Password, "", "", true, time.Now(), time.Now()))
 
 	got, err := repo.GetUserByID(context.Background(), 1)
 	require.NoError(t, err)
 	require.Equal(t, user.ID, got.ID)
+	require.True(t, got.EmailVerified)
 }
 
 func TestPostgresRepository_EditUserByID(t *testing.T) {
@@ -199,7 +201,8 @@ func TestPostgresRepository_GetUserByID_NotFound(t *testing.T) {
 	repo := NewPostgresRepository(db)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT _id, user_name, surname, email, user_login, user_hashed_password, user_description, logo_hashed_id, created_at, updated_at
+		SELECT _id, user_name, surname, email, user_login, user_hashed_password, user_description, logo_hashed_id,
+		       email_verified_at IS NOT NULL, created_at, updated_at
 		FROM "user"
 		WHERE _id = $1
 	`)).
@@ -273,3 +276,45 @@ func TestPostgresRepository_EditUserByID_EmailExists(t *testing.T) {
 	_, err = repo.EditUserByID(context.Background(), req)
 	require.ErrorIs(t, err, serviceerrors.ErrEmailExists)
 }
+
+func TestPostgresRepository_RestoreProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	user := authmodels.User{
+		ID:           1,
+		FirstName:    "John",
+		LastName:     "Doe",
+		Description:  "desc",
+		LogoHashedID: "logo123",
+	}
+
+	mock.ExpectQuery(`UPDATE "user"`).
+		WithArgs(user.FirstName, user.LastName, user.Description, user.LogoHashedID, user.ID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"_id", "user_name", "surname", "email", "user_login", "user_hashed_password",
+			"user_description", "logo_hashed_id", "created_at", "updated_at",
+		}).AddRow(1, "John", "Doe", "john@example.com", "johndoe", "hash", "desc", "logo123", time.Now(), time.Now()))
+
+	restored, err := repo.RestoreProfile(context.Background(), user)
+	require.NoError(t, err)
+	require.Equal(t, "johndoe", restored.Login)
+	require.Equal(t, "logo123", restored.LogoHashedID)
+}
+
+func TestPostgresRepository_RestoreProfile_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`UPDATE "user"`).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.RestoreProfile(context.Background(), authmodels.User{ID: 1})
+	require.ErrorIs(t, err, serviceerrors.ErrUserNotFound)
+}
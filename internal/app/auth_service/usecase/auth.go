@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"sync"
 
 	pkgerrors "github.com/pkg/errors"
 
@@ -11,19 +13,72 @@ import (
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/jwks"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/mailer"
 )
 
+// Links страницы фронтенда, на которые ведут ссылки из писем
+type Links struct {
+	PasswordReset string
+	EmailConfirm  string
+}
+
+// Keys ключи и секреты сервиса; у каждого свое назначение, чтобы утечка
+// одного не давала подделывать остальное
+type Keys struct {
+	// Signing подписывает access-токены, открытые ключи публикуются в JWKS
+	Signing *jwks.KeySet
+	// Secret промежуточные токены входа с 2FA и шифрование секретов TOTP
+	Secret string
+	// CSRF подпись CSRF-токенов
+	CSRF string
+}
+
 type UseCase struct {
-	repo      AuthRepository
-	jwtSecret string
-	clck      clock.Clock
+	repo   AuthRepository
+	keys   Keys
+	clck   clock.Clock
+	events kafkautils.KafkaProducer
+	mail   mailer.Mailer
+	links  Links
+	// background фоновые задачи, например отправка письма сброса пароля.
+	// Они не зависят от контекста запроса и отменяются только в Close
+	background     sync.WaitGroup
+	backgroundCtx  context.Context
+	stopBackground context.CancelFunc
 }
 
-func NewAuthUseCase(repo AuthRepository, secret string, clck clock.Clock) *UseCase {
+func NewAuthUseCase(repo AuthRepository, keys Keys, clck clock.Clock, events kafkautils.KafkaProducer, mail mailer.Mailer, links Links) *UseCase {
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
 	return &UseCase{
-		repo:      repo,
-		jwtSecret: secret,
-		clck:      clck,
+		repo:           repo,
+		keys:           keys,
+		clck:           clck,
+		events:         events,
+		mail:           mail,
+		links:          links,
+		backgroundCtx:  backgroundCtx,
+		stopBackground: stopBackground,
+	}
+}
+
+// Close дожидается фоновых задач. Если ctx истекает раньше, оставшиеся
+// задачи отменяются и возвращается ошибка ctx.
+func (uc *UseCase) Close(ctx context.Context) error {
+	defer uc.stopBackground()
+
+	done := make(chan struct{})
+	go func() {
+		uc.background.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
@@ -53,24 +108,39 @@ func (uc *UseCase) Register(ctx context.Context, req authmodels.RegisterRequest)
 		return nil, pkgerrors.Wrap(err, "auth.Register: failed to create user")
 	}
 
-	token, err := utils.GenerateJWT(createdUser.ID, createdUser.Login, uc.jwtSecret)
+	resp, err := uc.startSession(ctx, createdUser, req.Client)
 	if err != nil {
-		return nil, pkgerrors.Wrap(err, "auth.Register: failed to generate token")
+		if log != nil {
+			log.Error("Failed to start session", "error", err, "user_id", createdUser.ID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.Register")
 	}
 
-	return &authpb.AuthResponse{
-		Token: token,
-		User:  ModelUserToProtoUser(createdUser),
-	}, nil
+	// без письма аккаунт все равно создан: ссылку можно запросить повторно
+	if err := uc.sendEmailVerification(ctx, createdUser); err != nil && log != nil {
+		log.Error("Failed to send email verification", "error", err, "user_id", createdUser.ID)
+	}
+
+	return resp, nil
 }
 
 func (uc *UseCase) Login(ctx context.Context, req authmodels.LoginRequest) (*authpb.AuthResponse, error) {
 	log := logger.FromContext(ctx)
+	attemptKeys := loginAttemptKeys(req)
+	if err := uc.checkLoginLock(ctx, attemptKeys); err != nil {
+		return nil, err
+	}
+
 	user, err := uc.repo.GetUserByLogin(ctx, req.Login)
 	if err != nil {
 		if log != nil {
 			log.Warn("Login attempt with invalid credentials", "login", req.Login, "error", err)
 		}
+		// несуществующий логин тоже считается, иначе блокировка выдает,
+		// какие логины зарегистрированы
+		if errors.Is(err, svcerrors.ErrUserNotFound) {
+			uc.recordLoginFailure(ctx, attemptKeys)
+		}
 		return nil, pkgerrors.Wrap(err, "auth.Login: invalid credentials")
 	}
 
@@ -86,23 +156,38 @@ func (uc *UseCase) Login(ctx context.Context, req authmodels.LoginRequest) (*aut
 		if log != nil {
 			log.Warn("Login attempt with invalid password", "login", req.Login, "user_id", user.ID)
 		}
+		uc.recordLoginFailure(ctx, attemptKeys)
 		return nil, svcerrors.ErrInvalidCredentials
 	}
 
-	token, err := utils.GenerateJWT(user.ID, user.Login, uc.jwtSecret)
+	uc.rehashPassword(ctx, user, req.Password)
+
+	// с включенной 2FA счетчик ошибок сбрасывает только VerifyMFA,
+	// иначе знающий пароль перебирал бы коды без блокировки
+	challenge, err := uc.mfaChallenge(ctx, user)
 	if err != nil {
 		if log != nil {
-			log.Error("Failed to generate JWT token", "error", err, "user_id", user.ID)
+			log.Error("Failed to check MFA", "error", err, "user_id", user.ID)
 		}
-		return nil, pkgerrors.Wrap(err, "auth.Login: failed to generate token")
+		return nil, pkgerrors.Wrap(err, "auth.Login")
+	}
+	if challenge != nil {
+		return challenge, nil
 	}
 
-	user.Password = ""
+	if err := uc.repo.ResetLoginFailures(ctx, attemptKeys[0]); err != nil && log != nil {
+		log.Error("Failed to reset login failures", "error", err, "user_id", user.ID)
+	}
 
-	return &authpb.AuthResponse{
-		Token: token,
-		User:  ModelUserToProtoUser(user),
-	}, nil
+	resp, err := uc.startSession(ctx, user, req.Client)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to start session", "error", err, "user_id", user.ID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.Login")
+	}
+
+	return resp, nil
 }
 
 func (uc *UseCase) GetProfile(ctx context.Context, userID int) (*authpb.ProfileResponse, error) {
@@ -115,11 +200,37 @@ func (uc *UseCase) GetProfile(ctx context.Context, userID int) (*authpb.ProfileR
 		return nil, pkgerrors.Wrap(err, "auth.GetUserByID")
 	}
 
-	return ModelUserToProfile(user), nil
+	pendingEmail, err := uc.repo.GetPendingEmail(ctx, userID, uc.clck.Now())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.GetProfile: failed to get pending email")
+	}
+
+	profile := ModelUserToProfile(user)
+	profile.PendingEmail = pendingEmail
+	return profile, nil
 }
 
+// UpdateProfile сохраняет имя и аватар сразу, а новый email только после
+// подтверждения по ссылкам из писем (см. startEmailChange).
 func (uc *UseCase) UpdateProfile(ctx context.Context, req authmodels.UpdateProfileRequest) (*authpb.ProfileResponse, error) {
 	log := logger.FromContext(ctx)
+	current, err := uc.repo.GetUserByID(ctx, int(req.UserID))
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get user by ID", "error", err, "user_id", req.UserID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.UpdateProfile")
+	}
+
+	newEmail := req.Email
+	emailChanged := newEmail != "" && newEmail != current.Email
+	if emailChanged {
+		if err := uc.checkEmailFree(ctx, newEmail); err != nil {
+			return nil, pkgerrors.Wrap(err, "auth.UpdateProfile")
+		}
+	}
+
+	req.Email = current.Email
 	user, err := uc.repo.EditUserByID(ctx, req)
 	if err != nil {
 		if log != nil {
@@ -128,14 +239,32 @@ func (uc *UseCase) UpdateProfile(ctx context.Context, req authmodels.UpdateProfi
 		return nil, pkgerrors.Wrap(err, "auth.EditUserByID")
 	}
 
-	return ModelUserToProfile(user), nil
+	profile := ModelUserToProfile(user)
+	profile.EmailVerified = current.EmailVerified
+	if emailChanged {
+		if err := uc.startEmailChange(ctx, current, newEmail); err != nil {
+			if log != nil {
+				log.Error("Failed to start email change", "error", err, "user_id", req.UserID)
+			}
+			return nil, pkgerrors.Wrap(err, "auth.UpdateProfile")
+		}
+		profile.PendingEmail = newEmail
+		return profile, nil
+	}
+
+	pendingEmail, err := uc.repo.GetPendingEmail(ctx, user.ID, uc.clck.Now())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.UpdateProfile: failed to get pending email")
+	}
+	profile.PendingEmail = pendingEmail
+	return profile, nil
 }
 
 func (uc *UseCase) GetCSRFToken(ctx context.Context) (*authpb.CSRFTokenResponse, error) {
 	clock := clock.RealClock{}
 	log := logger.FromContext(ctx)
 
-	token, err := utils.GenerateCSRF(clock.Now(), uc.jwtSecret)
+	token, err := utils.GenerateCSRF(clock.Now(), uc.keys.CSRF)
 	if err != nil {
 		if log != nil {
 			log.Error("Failed to get CSRF-Token", "error", err)
@@ -144,3 +273,30 @@ func (uc *UseCase) GetCSRFToken(ctx context.Context) (*authpb.CSRFTokenResponse,
 	}
 	return &authpb.CSRFTokenResponse{Token: token}, nil
 }
+
+func (uc *UseCase) ExportProfile(ctx context.Context, userID int) (*authpb.User, error) {
+	log := logger.FromContext(ctx)
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to export user", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.ExportProfile")
+	}
+
+	user.Password = ""
+	return ModelUserToProtoUser(user), nil
+}
+
+func (uc *UseCase) ImportProfile(ctx context.Context, user authmodels.User) (*authpb.ProfileResponse, error) {
+	log := logger.FromContext(ctx)
+	restored, err := uc.repo.RestoreProfile(ctx, user)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to import user", "error", err, "user_id", user.ID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.ImportProfile")
+	}
+
+	return ModelUserToProfile(restored), nil
+}
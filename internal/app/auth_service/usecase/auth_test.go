@@ -11,6 +11,7 @@ import (
 	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/mailer"
 	"github.com/stretchr/testify/require"
 
 	"go.uber.org/mock/gomock"
@@ -22,12 +23,14 @@ func TestService_Register(t *testing.T) {
 
 	repo := mock.NewMockAuthRepository(ctrl)
 	fixedClock := clock.FixedClock{FixedTime: time.Now()}
-	s := NewAuthUseCase(repo, "secret", fixedClock)
+	mail := mailer.NewMemoryMailer()
+	s := NewAuthUseCase(repo, testKeys, fixedClock, nil, mail, Links{EmailConfirm: testConfirmURL})
 
 	req := authmodels.RegisterRequest{
 		Email:    "test@example.com",
 		Login:    "testuser",
 		Password: "password123",
+		Client:   authmodels.ClientInfo{UserAgent: "Mozilla/5.0", IP: "10.0.0.1"},
 	}
 
 	createdUser := authmodels.User{
@@ -44,12 +47,24 @@ func TestService_Register(t *testing.T) {
 	repo.EXPECT().
 		CreateUser(gomock.Any(), gomock.Any()).
 		Return(createdUser, nil)
+	repo.EXPECT().
+		CreateSession(gomock.Any(), createdUser.ID, gomock.Any(), fixedClock.FixedTime.Add(utils.RefreshTokenTTL), req.Client).
+		Return(authmodels.Session{ID: 5, UserID: createdUser.ID}, nil)
+	repo.EXPECT().
+		CreateEmailVerificationToken(gomock.Any(), createdUser.ID, req.Email, gomock.Any(), fixedClock.FixedTime.Add(emailTokenTTL)).
+		Return(nil)
 
 	resp, err := s.Register(context.Background(), req)
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	require.Equal(t, createdUser.ID, int(resp.User.Id))
 	require.Equal(t, createdUser.Login, resp.User.Login)
+	require.False(t, resp.User.EmailVerified)
+
+	sent := mail.Sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, req.Email, sent[0].To)
+	require.Contains(t, sent[0].Body, testConfirmURL+"?token=")
 }
 
 func TestService_Login_Success(t *testing.T) {
@@ -58,7 +73,7 @@ func TestService_Login_Success(t *testing.T) {
 
 	repo := mock.NewMockAuthRepository(ctrl)
 	fixedClock := clock.FixedClock{FixedTime: time.Now()}
-	s := NewAuthUseCase(repo, "secret", fixedClock)
+	s := NewAuthUseCase(repo, testKeys, fixedClock, nil, nil, Links{})
 
 	hashed, _ := utils.HashPassword("password123")
 	user := authmodels.User{
@@ -73,11 +88,21 @@ func TestService_Login_Success(t *testing.T) {
 		Password: "password123",
 	}
 
+	loginKey := authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "testuser"}
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), loginKey).Return(time.Time{}, nil)
 	repo.EXPECT().GetUserByLogin(gomock.Any(), "testuser").Return(user, nil)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(authmodels.MFA{}, svcerrors.ErrMFANotEnabled)
+	repo.EXPECT().ResetLoginFailures(gomock.Any(), loginKey).Return(nil)
+	repo.EXPECT().CreateSession(gomock.Any(), 1, gomock.Any(), gomock.Any(), gomock.Any()).Return(authmodels.Session{ID: 5, UserID: 1}, nil)
 
 	resp, err := s.Login(context.Background(), req)
 	require.NoError(t, err)
 	require.NotNil(t, resp)
+	require.NotEmpty(t, resp.RefreshToken)
+
+	claims, err := utils.ValidateJWT(context.Background(), resp.Token, testKeys.Signing)
+	require.NoError(t, err)
+	require.Equal(t, 5, claims.SessionID)
 	require.Equal(t, user.ID, int(resp.User.Id))
 	require.Equal(t, user.Login, resp.User.Login)
 }
@@ -88,7 +113,7 @@ func TestService_Login_InvalidPassword(t *testing.T) {
 
 	repo := mock.NewMockAuthRepository(ctrl)
 	fixedClock := clock.FixedClock{FixedTime: time.Now()}
-	s := NewAuthUseCase(repo, "secret", fixedClock)
+	s := NewAuthUseCase(repo, testKeys, fixedClock, nil, nil, Links{})
 
 	hashed, _ := utils.HashPassword("correctpassword")
 
@@ -104,7 +129,10 @@ func TestService_Login_InvalidPassword(t *testing.T) {
 		Password: "wrongpassword",
 	}
 
+	loginKey := authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "testuser"}
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), loginKey).Return(time.Time{}, nil)
 	repo.EXPECT().GetUserByLogin(gomock.Any(), "testuser").Return(user, nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), loginKey, fixedClock.FixedTime, fixedClock.FixedTime.Add(-loginFailureWindow)).Return(1, nil)
 
 	_, err := s.Login(context.Background(), req)
 	require.Error(t, err)
@@ -117,7 +145,7 @@ func TestService_GetUserByID(t *testing.T) {
 
 	repo := mock.NewMockAuthRepository(ctrl)
 	fixedClock := clock.FixedClock{FixedTime: time.Now()}
-	s := NewAuthUseCase(repo, "secret", fixedClock)
+	s := NewAuthUseCase(repo, testKeys, fixedClock, nil, nil, Links{})
 
 	user := authmodels.User{
 		ID:        1,
@@ -130,11 +158,13 @@ func TestService_GetUserByID(t *testing.T) {
 	}
 
 	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+	repo.EXPECT().GetPendingEmail(gomock.Any(), 1, fixedClock.FixedTime).Return("new@example.com", nil)
 
 	resp, err := s.GetProfile(context.Background(), 1)
 	require.NoError(t, err)
 	require.Equal(t, int32(1), resp.Id)
 	require.Equal(t, "testuser", resp.Login)
+	require.Equal(t, "new@example.com", resp.PendingEmail)
 }
 
 func TestService_EditUserByID(t *testing.T) {
@@ -143,31 +173,36 @@ func TestService_EditUserByID(t *testing.T) {
 
 	repo := mock.NewMockAuthRepository(ctrl)
 	fixedClock := clock.FixedClock{FixedTime: time.Now()}
-	s := NewAuthUseCase(repo, "secret", fixedClock)
+	s := NewAuthUseCase(repo, testKeys, fixedClock, nil, nil, Links{})
 
 	req := authmodels.UpdateProfileRequest{
 		UserID:    1,
 		FirstName: "New",
 		LastName:  "Name",
-		Email:     "new@example.com",
+		Email:     "test@example.com",
 	}
 
 	updatedUser := authmodels.User{
-		ID:        1,
-		Login:     "testuser",
-		Email:     req.Email,
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		CreatedAt: fixedClock.FixedTime,
-		UpdatedAt: fixedClock.FixedTime,
+		ID:            1,
+		Login:         "testuser",
+		Email:         req.Email,
+		FirstName:     req.FirstName,
+		LastName:      req.LastName,
+		EmailVerified: true,
+		CreatedAt:     fixedClock.FixedTime,
+		UpdatedAt:     fixedClock.FixedTime,
 	}
 
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Email: req.Email, EmailVerified: true}, nil)
 	repo.EXPECT().EditUserByID(gomock.Any(), req).Return(updatedUser, nil)
+	repo.EXPECT().GetPendingEmail(gomock.Any(), 1, fixedClock.FixedTime).Return("", nil)
 
 	resp, err := s.UpdateProfile(context.Background(), req)
 	require.NoError(t, err)
 	require.Equal(t, int32(1), resp.Id)
 	require.Equal(t, "New", resp.FirstName)
 	require.Equal(t, "Name", resp.LastName)
-	require.Equal(t, "new@example.com", resp.Email)
+	require.Equal(t, "test@example.com", resp.Email)
+	require.True(t, resp.EmailVerified)
+	require.Empty(t, resp.PendingEmail)
 }
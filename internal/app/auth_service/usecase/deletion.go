@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+// accountDeletionGrace срок, в течение которого удаление можно отменить
+// и выгрузить свои данные
+const accountDeletionGrace = 14 * 24 * time.Hour
+
+// ScheduleAccountDeletion планирует удаление аккаунта после повторного ввода
+// пароля и кода 2FA, если она включена. Повторный запрос не сдвигает уже
+// назначенный срок.
+func (uc *UseCase) ScheduleAccountDeletion(ctx context.Context, userID int, password, code string) (*authpb.AccountDeletion, error) {
+	log := logger.FromContext(ctx)
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ScheduleAccountDeletion: failed to get user")
+	}
+
+	if err := uc.reauthenticate(ctx, user, password, code); err != nil {
+		if log != nil {
+			log.Warn("Account deletion not confirmed", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.ScheduleAccountDeletion")
+	}
+
+	deletion, err := uc.repo.ScheduleAccountDeletion(ctx, userID, uc.clck.Now().Add(accountDeletionGrace))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ScheduleAccountDeletion")
+	}
+
+	if log != nil {
+		log.Info("Account deletion scheduled", "user_id", userID, "scheduled_at", deletion.ScheduledAt)
+	}
+	return AccountDeletionToProto(deletion), nil
+}
+
+func (uc *UseCase) CancelAccountDeletion(ctx context.Context, userID int) error {
+	if err := uc.repo.CancelAccountDeletion(ctx, userID); err != nil {
+		return pkgerrors.Wrap(err, "auth.CancelAccountDeletion")
+	}
+	return nil
+}
+
+// ClaimAccountDeletions выдает наступившие удаления исполнителю; пока не
+// истекла аренда, другие исполнители их не получат
+func (uc *UseCase) ClaimAccountDeletions(ctx context.Context, limit int, lease time.Duration) (*authpb.AccountDeletionList, error) {
+	now := uc.clck.Now()
+	deletions, err := uc.repo.ClaimAccountDeletions(ctx, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ClaimAccountDeletions")
+	}
+	return AccountDeletionsToProto(deletions), nil
+}
+
+// AdvanceAccountDeletion запоминает пройденный шаг, чтобы после сбоя
+// продолжить с него; шаг done завершает удаление
+func (uc *UseCase) AdvanceAccountDeletion(ctx context.Context, userID int, step string, imageIDs []string) error {
+	var completedAt *time.Time
+	if step == models.DeletionStepDone {
+		now := uc.clck.Now()
+		completedAt = &now
+	}
+	if err := uc.repo.AdvanceAccountDeletion(ctx, userID, step, imageIDs, completedAt); err != nil {
+		return pkgerrors.Wrap(err, "auth.AdvanceAccountDeletion")
+	}
+	return nil
+}
+
+// DeleteUser удаляет пользователя вместе с сессиями, токенами и 2FA.
+// Повторный вызов для уже удаленного пользователя не ошибка.
+func (uc *UseCase) DeleteUser(ctx context.Context, userID int) error {
+	revoked, err := uc.repo.DeleteUser(ctx, userID)
+	if err != nil {
+		return pkgerrors.Wrap(err, "auth.DeleteUser")
+	}
+
+	if log := logger.FromContext(ctx); log != nil {
+		log.Info("User deleted", "user_id", userID, "revoked_sessions", len(revoked))
+	}
+	if len(revoked) > 0 {
+		uc.publishSessionsRevoked(ctx, userID, revoked...)
+	}
+	return nil
+}
+
+// FilterUsedImages оставляет изображения, которые еще стоят аватарами
+func (uc *UseCase) FilterUsedImages(ctx context.Context, ids []string) (*authpb.ImageIDs, error) {
+	if len(ids) == 0 {
+		return &authpb.ImageIDs{}, nil
+	}
+	used, err := uc.repo.FilterUsedImages(ctx, ids)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.FilterUsedImages")
+	}
+	return &authpb.ImageIDs{Ids: used}, nil
+}
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+var deletionNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestScheduleAccountDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: deletionNow}, nil, nil, Links{})
+
+	hashed, _ := utils.HashPassword("my-password")
+	scheduled := deletionNow.Add(accountDeletionGrace)
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan", Password: hashed}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(authmodels.MFA{}, svcerrors.ErrMFANotEnabled)
+	repo.EXPECT().ScheduleAccountDeletion(gomock.Any(), 1, scheduled).
+		Return(authmodels.AccountDeletion{UserID: 1, ScheduledAt: scheduled}, nil)
+
+	res, err := s.ScheduleAccountDeletion(context.Background(), 1, "my-password", "")
+	require.NoError(t, err)
+	require.Equal(t, scheduled, res.GetScheduledAt().AsTime())
+	require.Empty(t, res.GetStep())
+}
+
+func TestScheduleAccountDeletion_WrongPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: deletionNow}, nil, nil, Links{})
+
+	hashed, _ := utils.HashPassword("my-password")
+	loginKey := authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "ivan"}
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan", Password: hashed}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), loginKey).Return(time.Time{}, nil)
+	// неверный пароль считается неудачной попыткой входа
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), loginKey, deletionNow, deletionNow.Add(-loginFailureWindow)).Return(1, nil)
+
+	_, err := s.ScheduleAccountDeletion(context.Background(), 1, "not-my-password", "")
+	require.ErrorIs(t, err, svcerrors.ErrInvalidCredentials)
+}
+
+func TestScheduleAccountDeletion_Locked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: deletionNow}, nil, nil, Links{})
+
+	hashed, _ := utils.HashPassword("my-password")
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan", Password: hashed}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(deletionNow.Add(time.Minute), nil)
+	repo.EXPECT().ScheduleAccountDeletion(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.ScheduleAccountDeletion(context.Background(), 1, "my-password", "")
+	require.ErrorIs(t, err, svcerrors.ErrAccountLocked)
+}
+
+func TestScheduleAccountDeletion_RequiresMFACode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	hashed, _ := utils.HashPassword("my-password")
+	scheduled := mfaNow.Add(accountDeletionGrace)
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan", Password: hashed}, nil).Times(2)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil).Times(2)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(enabledMFA(t, s, 0), nil).Times(2)
+
+	// без кода пароль не подтверждает удаление
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), gomock.Any(), mfaNow, gomock.Any()).Return(1, nil)
+	_, err := s.ScheduleAccountDeletion(context.Background(), 1, "my-password", "")
+	require.ErrorIs(t, err, svcerrors.ErrMFACodeInvalid)
+
+	repo.EXPECT().UseMFAStep(gomock.Any(), 1, gomock.Any()).Return(nil)
+	repo.EXPECT().ScheduleAccountDeletion(gomock.Any(), 1, scheduled).
+		Return(authmodels.AccountDeletion{UserID: 1, ScheduledAt: scheduled}, nil)
+	_, err = s.ScheduleAccountDeletion(context.Background(), 1, "my-password", currentCode(t))
+	require.NoError(t, err)
+}
+
+func TestAdvanceAccountDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: deletionNow}, nil, nil, Links{})
+
+	repo.EXPECT().AdvanceAccountDeletion(gomock.Any(), 1, models.DeletionStepCollect, []string{"aa"}, nil).Return(nil)
+	require.NoError(t, s.AdvanceAccountDeletion(context.Background(), 1, models.DeletionStepCollect, []string{"aa"}))
+
+	// последний шаг закрывает удаление
+	repo.EXPECT().AdvanceAccountDeletion(gomock.Any(), 1, models.DeletionStepDone, nil, &deletionNow).Return(nil)
+	require.NoError(t, s.AdvanceAccountDeletion(context.Background(), 1, models.DeletionStepDone, nil))
+
+	repo.EXPECT().AdvanceAccountDeletion(gomock.Any(), 2, models.DeletionStepBudgets, nil, nil).
+		Return(svcerrors.ErrAccountDeletionNotFound)
+	require.ErrorIs(t, s.AdvanceAccountDeletion(context.Background(), 2, models.DeletionStepBudgets, nil), svcerrors.ErrAccountDeletionNotFound)
+}
+
+func TestClaimAccountDeletions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: deletionNow}, nil, nil, Links{})
+
+	repo.EXPECT().ClaimAccountDeletions(gomock.Any(), deletionNow, deletionNow.Add(5*time.Minute), 10).
+		Return([]authmodels.AccountDeletion{{UserID: 1, Step: models.DeletionStepCollect, ImageIDs: []string{"aa"}, Attempts: 2}}, nil)
+
+	res, err := s.ClaimAccountDeletions(context.Background(), 10, 5*time.Minute)
+	require.NoError(t, err)
+	require.Len(t, res.GetDeletions(), 1)
+	require.Equal(t, []string{"aa"}, res.GetDeletions()[0].GetImageIds())
+	require.Equal(t, int32(2), res.GetDeletions()[0].GetAttempts())
+}
+
+func TestDeleteUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	events := mock.NewMockKafkaProducer(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: deletionNow}, events, nil, Links{})
+
+	repo.EXPECT().DeleteUser(gomock.Any(), 1).Return([]int{3}, nil)
+	events.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, s.DeleteUser(context.Background(), 1))
+
+	// пользователь уже удален на прошлой попытке: событий нет
+	repo.EXPECT().DeleteUser(gomock.Any(), 1).Return(nil, nil)
+	require.NoError(t, s.DeleteUser(context.Background(), 1))
+}
+
+func TestFilterUsedImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: deletionNow}, nil, nil, Links{})
+
+	res, err := s.FilterUsedImages(context.Background(), nil)
+	require.NoError(t, err)
+	require.Empty(t, res.GetIds())
+
+	repo.EXPECT().FilterUsedImages(gomock.Any(), []string{"aa", "bb"}).Return([]string{"bb"}, nil)
+	res, err = s.FilterUsedImages(context.Background(), []string{"aa", "bb"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bb"}, res.GetIds())
+}
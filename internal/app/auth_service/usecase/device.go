@@ -0,0 +1,59 @@
+package auth
+
+import "strings"
+
+const unknownDevice = "Неизвестное устройство"
+
+// порядок важен: Edge, Opera и Яндекс.Браузер тоже представляются Chrome,
+// а Chrome — Safari
+var browserMarkers = []struct{ marker, name string }{
+	{"Edg/", "Edge"},
+	{"OPR/", "Opera"},
+	{"YaBrowser/", "Яндекс.Браузер"},
+	{"Firefox/", "Firefox"},
+	{"Chrome/", "Chrome"},
+	{"Safari/", "Safari"},
+}
+
+// iOS-устройства содержат "like Mac OS X", Android — "Linux"
+var osMarkers = []struct{ marker, name string }{
+	{"iPhone", "iPhone"},
+	{"iPad", "iPad"},
+	{"Android", "Android"},
+	{"Windows", "Windows"},
+	{"Mac OS X", "macOS"},
+	{"Linux", "Linux"},
+}
+
+// describeDevice краткое описание устройства по User-Agent для списка сессий
+func describeDevice(userAgent string) string {
+	var browser, os string
+	for _, b := range browserMarkers {
+		if strings.Contains(userAgent, b.marker) {
+			browser = b.name
+			break
+		}
+	}
+	for _, o := range osMarkers {
+		if strings.Contains(userAgent, o.marker) {
+			os = o.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && os != "":
+		return browser + ", " + os
+	case browser != "":
+		return browser
+	case os != "":
+		return os
+	}
+
+	// не браузер: мобильное приложение или HTTP-клиент, показываем название продукта
+	product, _, _ := strings.Cut(userAgent, "/")
+	if product = strings.TrimSpace(product); product != "" {
+		return product
+	}
+	return unknownDevice
+}
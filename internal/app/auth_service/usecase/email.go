@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/mailer"
+)
+
+const emailTokenTTL = 24 * time.Hour
+
+// sendEmailVerification отправляет ссылку подтверждения на текущий адрес
+func (uc *UseCase) sendEmailVerification(ctx context.Context, user authmodels.User) error {
+	token, tokenHash, err := newOpaqueToken()
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to generate token")
+	}
+	if err := uc.repo.CreateEmailVerificationToken(ctx, user.ID, user.Email, tokenHash, uc.clck.Now().Add(emailTokenTTL)); err != nil {
+		return pkgerrors.Wrap(err, "failed to save token")
+	}
+
+	return uc.mail.Send(ctx, emailMail(user.Email, "Подтверждение email VKarmane",
+		"Чтобы подтвердить адрес, перейдите по ссылке:", uc.emailLink(token)))
+}
+
+// ResendEmailVerification повторно отправляет ссылку подтверждения,
+// например если первое письмо потерялось или ссылка истекла
+func (uc *UseCase) ResendEmailVerification(ctx context.Context, userID int) error {
+	log := logger.FromContext(ctx)
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return pkgerrors.Wrap(err, "auth.ResendEmailVerification: failed to get user")
+	}
+	if user.EmailVerified {
+		return svcerrors.ErrEmailAlreadyVerified
+	}
+
+	if err := uc.sendEmailVerification(ctx, user); err != nil {
+		if log != nil {
+			log.Error("Failed to send email verification", "error", err, "user_id", userID)
+		}
+		return pkgerrors.Wrap(err, "auth.ResendEmailVerification")
+	}
+	return nil
+}
+
+// checkEmailFree ErrEmailExists, если адрес уже занят другим пользователем
+func (uc *UseCase) checkEmailFree(ctx context.Context, email string) error {
+	_, err := uc.repo.GetUserByEmail(ctx, email)
+	if err == nil {
+		return svcerrors.ErrEmailExists
+	}
+	if errors.Is(err, svcerrors.ErrUserNotFound) {
+		return nil
+	}
+	return err
+}
+
+// startEmailChange заводит смену адреса на newEmail. Новый адрес подтверждается
+// всегда, старый — только если он сам был подтвержден: иначе владельцем
+// старого адреса пользователь никогда не доказывал, что является.
+func (uc *UseCase) startEmailChange(ctx context.Context, user authmodels.User, newEmail string) error {
+	newToken, newHash, err := newOpaqueToken()
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to generate token")
+	}
+	change := authmodels.EmailChange{
+		UserID:       user.ID,
+		OldEmail:     user.Email,
+		NewEmail:     newEmail,
+		NewTokenHash: newHash,
+		ExpiresAt:    uc.clck.Now().Add(emailTokenTTL),
+	}
+
+	var oldToken string
+	if user.EmailVerified {
+		oldToken, change.OldTokenHash, err = newOpaqueToken()
+		if err != nil {
+			return pkgerrors.Wrap(err, "failed to generate token")
+		}
+	}
+
+	if err := uc.repo.StartEmailChange(ctx, change); err != nil {
+		return pkgerrors.Wrap(err, "failed to save email change")
+	}
+
+	if err := uc.mail.Send(ctx, emailMail(newEmail, "Подтверждение нового email VKarmane",
+		"Этот адрес указан как новый email аккаунта VKarmane. Чтобы подтвердить его, перейдите по ссылке:",
+		uc.emailLink(newToken))); err != nil {
+		return pkgerrors.Wrap(err, "failed to send mail to new email")
+	}
+	if oldToken != "" {
+		if err := uc.mail.Send(ctx, emailMail(user.Email, "Смена email VKarmane",
+			fmt.Sprintf("Запрошена смена email аккаунта на %s. Чтобы подтвердить смену, перейдите по ссылке:", newEmail),
+			uc.emailLink(oldToken))); err != nil {
+			return pkgerrors.Wrap(err, "failed to send mail to old email")
+		}
+	}
+	return nil
+}
+
+// ConfirmEmail применяет ссылку из письма: подтверждение адреса после
+// регистрации или одну из сторон смены email
+func (uc *UseCase) ConfirmEmail(ctx context.Context, token string) (*authpb.EmailConfirmation, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.repo.ConfirmEmailToken(ctx, hashToken(token), uc.clck.Now())
+	if err != nil {
+		if log != nil {
+			log.Warn("Email confirmation rejected", "error", err)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.ConfirmEmail")
+	}
+
+	if log != nil {
+		log.Info("Email confirmed", "user_id", res.UserID, "status", res.Status)
+	}
+	return &authpb.EmailConfirmation{Status: res.Status, Email: res.Email}, nil
+}
+
+func (uc *UseCase) emailLink(token string) string {
+	return uc.links.EmailConfirm + "?token=" + url.QueryEscape(token)
+}
+
+func emailMail(to, subject, intro, link string) mailer.Mail {
+	return mailer.Mail{
+		To:      to,
+		Subject: subject,
+		Body: fmt.Sprintf(
+			"%s\n%s\n\n"+
+				"Ссылка действует %d часа и сработает один раз. "+
+				"Если вы ничего не меняли в аккаунте, просто проигнорируйте это письмо.",
+			intro, link, int(emailTokenTTL.Hours()),
+		),
+	}
+}
@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/mailer"
+)
+
+const testConfirmURL = "https://vkarmane.example/confirm-email"
+
+func TestUpdateProfile_EmailChangeNeedsBothConfirmations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	mail := mailer.NewMemoryMailer()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, mail, Links{EmailConfirm: testConfirmURL})
+
+	current := authmodels.User{ID: 1, Email: "old@example.com", EmailVerified: true}
+	req := authmodels.UpdateProfileRequest{UserID: 1, FirstName: "Иван", LastName: "Иванов", Email: "new@example.com"}
+	saved := req
+	saved.Email = current.Email
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(current, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(authmodels.User{}, svcerrors.ErrUserNotFound)
+	repo.EXPECT().EditUserByID(gomock.Any(), saved).Return(authmodels.User{ID: 1, Email: current.Email, FirstName: "Иван"}, nil)
+	repo.EXPECT().StartEmailChange(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, change authmodels.EmailChange) error {
+			require.Equal(t, "old@example.com", change.OldEmail)
+			require.Equal(t, "new@example.com", change.NewEmail)
+			require.NotEmpty(t, change.OldTokenHash)
+			require.NotEmpty(t, change.NewTokenHash)
+			require.Equal(t, now.Add(emailTokenTTL), change.ExpiresAt)
+			return nil
+		})
+
+	resp, err := s.UpdateProfile(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "old@example.com", resp.Email)
+	require.Equal(t, "new@example.com", resp.PendingEmail)
+	require.True(t, resp.EmailVerified)
+
+	sent := mail.Sent()
+	require.Len(t, sent, 2)
+	require.Equal(t, "new@example.com", sent[0].To)
+	require.Equal(t, "old@example.com", sent[1].To)
+	require.Contains(t, sent[1].Body, "new@example.com")
+}
+
+func TestUpdateProfile_EmailChangeFromUnverified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	mail := mailer.NewMemoryMailer()
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, mail, Links{EmailConfirm: testConfirmURL})
+
+	current := authmodels.User{ID: 1, Email: "typo@example.com"}
+	req := authmodels.UpdateProfileRequest{UserID: 1, FirstName: "Иван", LastName: "Иванов", Email: "new@example.com"}
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(current, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(authmodels.User{}, svcerrors.ErrUserNotFound)
+	repo.EXPECT().EditUserByID(gomock.Any(), gomock.Any()).Return(current, nil)
+	repo.EXPECT().StartEmailChange(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, change authmodels.EmailChange) error {
+			require.Empty(t, change.OldTokenHash)
+			return nil
+		})
+
+	_, err := s.UpdateProfile(context.Background(), req)
+	require.NoError(t, err)
+
+	sent := mail.Sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, "new@example.com", sent[0].To)
+}
+
+func TestUpdateProfile_EmailTaken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, nil, Links{})
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Email: "old@example.com"}, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Any(), "busy@example.com").Return(authmodels.User{ID: 2}, nil)
+
+	_, err := s.UpdateProfile(context.Background(), authmodels.UpdateProfileRequest{UserID: 1, Email: "busy@example.com"})
+	require.ErrorIs(t, err, svcerrors.ErrEmailExists)
+}
+
+func TestResendEmailVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	mail := mailer.NewMemoryMailer()
+	now := time.Now()
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, mail, Links{EmailConfirm: testConfirmURL})
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Email: "ivan@example.com"}, nil)
+	repo.EXPECT().CreateEmailVerificationToken(gomock.Any(), 1, "ivan@example.com", gomock.Any(), now.Add(emailTokenTTL)).Return(nil)
+
+	require.NoError(t, s.ResendEmailVerification(context.Background(), 1))
+	require.Len(t, mail.Sent(), 1)
+}
+
+func TestResendEmailVerification_AlreadyVerified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, nil, Links{})
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, EmailVerified: true}, nil)
+
+	err := s.ResendEmailVerification(context.Background(), 1)
+	require.ErrorIs(t, err, svcerrors.ErrEmailAlreadyVerified)
+}
+
+func TestConfirmEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	now := time.Now()
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, nil, Links{})
+
+	repo.EXPECT().ConfirmEmailToken(gomock.Any(), hashToken("token"), now).
+		Return(authmodels.EmailConfirmation{UserID: 1, Status: authmodels.EmailStatusChanged, Email: "new@example.com"}, nil)
+
+	res, err := s.ConfirmEmail(context.Background(), "token")
+	require.NoError(t, err)
+	require.Equal(t, authmodels.EmailStatusChanged, res.Status)
+	require.Equal(t, "new@example.com", res.Email)
+}
+
+func TestConfirmEmail_InvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, nil, Links{})
+
+	repo.EXPECT().ConfirmEmailToken(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(authmodels.EmailConfirmation{}, svcerrors.ErrTokenInvalid)
+
+	_, err := s.ConfirmEmail(context.Background(), "used")
+	require.ErrorIs(t, err, svcerrors.ErrTokenInvalid)
+}
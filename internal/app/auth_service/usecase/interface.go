@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
 )
@@ -10,5 +11,50 @@ type AuthRepository interface {
 	CreateUser(ctx context.Context, user authmodels.User) (authmodels.User, error)
 	GetUserByLogin(ctx context.Context, login string) (authmodels.User, error)
 	GetUserByID(ctx context.Context, id int) (authmodels.User, error)
+	GetUserByEmail(ctx context.Context, email string) (authmodels.User, error)
 	EditUserByID(ctx context.Context, req authmodels.UpdateProfileRequest) (authmodels.User, error)
+	RestoreProfile(ctx context.Context, user authmodels.User) (authmodels.User, error)
+
+	CreateSession(ctx context.Context, userID int, tokenHash string, expiresAt time.Time, client authmodels.ClientInfo) (authmodels.Session, error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (authmodels.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, sessionID int, oldHash, newHash string, expiresAt time.Time, client authmodels.ClientInfo) (authmodels.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID int) error
+	RevokeOtherSessions(ctx context.Context, userID, keepSessionID int) ([]int, error)
+	ListActiveSessions(ctx context.Context, userID int) ([]authmodels.Session, error)
+	IsSessionActive(ctx context.Context, userID, sessionID int) (bool, error)
+
+	GetLoginLockedUntil(ctx context.Context, key authmodels.LoginAttemptKey) (time.Time, error)
+	RecordLoginFailure(ctx context.Context, key authmodels.LoginAttemptKey, now, resetBefore time.Time) (int, error)
+	LockLogin(ctx context.Context, key authmodels.LoginAttemptKey, until time.Time) error
+	ResetLoginFailures(ctx context.Context, key authmodels.LoginAttemptKey) error
+
+	CreatePasswordResetToken(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error
+	ResetPassword(ctx context.Context, tokenHash, passwordHash string, now time.Time) (int, []int, error)
+	ChangePassword(ctx context.Context, userID int, passwordHash string, keepSessionID int) ([]int, error)
+	UpdatePasswordHash(ctx context.Context, userID int, oldHash, newHash string) error
+
+	CreateEmailVerificationToken(ctx context.Context, userID int, email, tokenHash string, expiresAt time.Time) error
+	StartEmailChange(ctx context.Context, change authmodels.EmailChange) error
+	GetPendingEmail(ctx context.Context, userID int, now time.Time) (string, error)
+	ConfirmEmailToken(ctx context.Context, tokenHash string, now time.Time) (authmodels.EmailConfirmation, error)
+
+	GetMFA(ctx context.Context, userID int) (authmodels.MFA, error)
+	SaveMFASecret(ctx context.Context, userID int, secret string) error
+	EnableMFA(ctx context.Context, userID int, step int64, codeHashes []string) error
+	UseMFAStep(ctx context.Context, userID int, step int64) error
+	UseRecoveryCode(ctx context.Context, userID int, codeHash string, now time.Time) error
+	DisableMFA(ctx context.Context, userID int) error
+
+	CreatePersonalToken(ctx context.Context, req authmodels.CreatePersonalTokenRequest, tokenHash string) (authmodels.PersonalToken, error)
+	ListPersonalTokens(ctx context.Context, userID int) ([]authmodels.PersonalToken, error)
+	DeletePersonalToken(ctx context.Context, userID, tokenID int) error
+	GetPersonalToken(ctx context.Context, tokenHash string) (authmodels.PersonalToken, error)
+	TouchPersonalToken(ctx context.Context, tokenID int, now time.Time) error
+
+	ScheduleAccountDeletion(ctx context.Context, userID int, scheduledAt time.Time) (authmodels.AccountDeletion, error)
+	CancelAccountDeletion(ctx context.Context, userID int) error
+	ClaimAccountDeletions(ctx context.Context, now, lockedUntil time.Time, limit int) ([]authmodels.AccountDeletion, error)
+	AdvanceAccountDeletion(ctx context.Context, userID int, step string, imageIDs []string, completedAt *time.Time) error
+	DeleteUser(ctx context.Context, userID int) ([]int, error)
+	FilterUsedImages(ctx context.Context, ids []string) ([]string, error)
 }
@@ -9,25 +9,85 @@ import (
 
 func ModelUserToProtoUser(user authmodels.User) *authpb.User {
 	return &authpb.User{
-		Id:           int32(user.ID),
-		Login:        user.Login,
-		FirstName:    user.FirstName,
-		LastName:     user.LastName,
-		Email:        user.Email,
-		Description:  user.Description,
-		LogoHashedId: user.LogoHashedID,
-		CreatedAt:    timestamppb.New(user.CreatedAt),
-		UpdatedAt:    timestamppb.New(user.UpdatedAt),
+		Id:            int32(user.ID),
+		Login:         user.Login,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Email:         user.Email,
+		Description:   user.Description,
+		LogoHashedId:  user.LogoHashedID,
+		EmailVerified: user.EmailVerified,
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+		UpdatedAt:     timestamppb.New(user.UpdatedAt),
 	}
 }
 
 func ModelUserToProfile(user authmodels.User) *authpb.ProfileResponse {
 	return &authpb.ProfileResponse{
-		Id:           int32(user.ID),
-		FirstName:    user.FirstName,
-		LastName:     user.LastName,
-		Email:        user.Email,
-		Login:        user.Login,
-		LogoHashedId: user.LogoHashedID,
+		Id:            int32(user.ID),
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Email:         user.Email,
+		Login:         user.Login,
+		LogoHashedId:  user.LogoHashedID,
+		EmailVerified: user.EmailVerified,
 	}
 }
+
+func SessionsToProto(sessions []authmodels.Session, currentSessionID int) *authpb.SessionList {
+	list := &authpb.SessionList{Sessions: make([]*authpb.SessionInfo, 0, len(sessions))}
+	for _, s := range sessions {
+		list.Sessions = append(list.Sessions, &authpb.SessionInfo{
+			Id:         int32(s.ID),
+			Device:     describeDevice(s.UserAgent),
+			UserAgent:  s.UserAgent,
+			Ip:         s.IP,
+			CreatedAt:  timestamppb.New(s.CreatedAt),
+			LastSeenAt: timestamppb.New(s.LastSeenAt),
+			Current:    s.ID == currentSessionID,
+		})
+	}
+	return list
+}
+
+func PersonalTokenToProto(token authmodels.PersonalToken) *authpb.PersonalToken {
+	res := &authpb.PersonalToken{
+		Id:        int32(token.ID),
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: timestamppb.New(token.CreatedAt),
+	}
+	if token.ExpiresAt != nil {
+		res.ExpiresAt = timestamppb.New(*token.ExpiresAt)
+	}
+	if token.LastUsedAt != nil {
+		res.LastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+	return res
+}
+
+func PersonalTokensToProto(tokens []authmodels.PersonalToken) *authpb.PersonalTokenList {
+	list := &authpb.PersonalTokenList{Tokens: make([]*authpb.PersonalToken, 0, len(tokens))}
+	for _, token := range tokens {
+		list.Tokens = append(list.Tokens, PersonalTokenToProto(token))
+	}
+	return list
+}
+
+func AccountDeletionToProto(deletion authmodels.AccountDeletion) *authpb.AccountDeletion {
+	return &authpb.AccountDeletion{
+		UserId:      int32(deletion.UserID),
+		ScheduledAt: timestamppb.New(deletion.ScheduledAt),
+		Step:        deletion.Step,
+		ImageIds:    deletion.ImageIDs,
+		Attempts:    int32(deletion.Attempts),
+	}
+}
+
+func AccountDeletionsToProto(deletions []authmodels.AccountDeletion) *authpb.AccountDeletionList {
+	list := &authpb.AccountDeletionList{Deletions: make([]*authpb.AccountDeletion, 0, len(deletions))}
+	for _, deletion := range deletions {
+		list.Deletions = append(list.Deletions, AccountDeletionToProto(deletion))
+	}
+	return list
+}
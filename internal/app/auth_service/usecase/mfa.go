@@ -0,0 +1,283 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/qr"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/totp"
+)
+
+const (
+	mfaIssuer = "VKarmane"
+	// mfaQRScale пикселей на модуль QR-кода
+	mfaQRScale        = 6
+	recoveryCodeCount = 10
+	// recoveryCodeLen символов в резервном коде, выдается как xxxxx-xxxxx
+	recoveryCodeLen = 10
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EnrollMFA выдает новый секрет TOTP в виде ссылки otpauth:// и QR-кода.
+// Второй фактор заработает только после ConfirmMFA; повторный вызов до
+// подтверждения заменяет секрет.
+func (uc *UseCase) EnrollMFA(ctx context.Context, userID int) (*authpb.MFAEnrollment, error) {
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.EnrollMFA: failed to get user")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.EnrollMFA: failed to generate secret")
+	}
+	encrypted, err := uc.encryptMFASecret(secret)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.EnrollMFA: failed to encrypt secret")
+	}
+	if err := uc.repo.SaveMFASecret(ctx, userID, encrypted); err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.EnrollMFA")
+	}
+
+	uri := totp.URI(mfaIssuer, user.Email, secret)
+	png, err := qr.PNG([]byte(uri), mfaQRScale)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.EnrollMFA: failed to render QR code")
+	}
+
+	return &authpb.MFAEnrollment{Secret: secret, OtpauthUri: uri, QrPng: png}, nil
+}
+
+// ConfirmMFA включает второй фактор по первому коду из приложения и
+// возвращает резервные коды. Они показываются один раз, хранятся только хеши.
+func (uc *UseCase) ConfirmMFA(ctx context.Context, userID int, code string) (*authpb.RecoveryCodes, error) {
+	log := logger.FromContext(ctx)
+	mfa, err := uc.repo.GetMFA(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ConfirmMFA")
+	}
+	if mfa.Enabled {
+		return nil, svcerrors.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := uc.decryptMFASecret(mfa.Secret)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ConfirmMFA: failed to decrypt secret")
+	}
+	step, ok := totp.Validate(secret, strings.TrimSpace(code), uc.clck.Now(), mfa.LastUsedStep)
+	if !ok {
+		return nil, svcerrors.ErrMFACodeInvalid
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ConfirmMFA: failed to generate recovery codes")
+	}
+	if err := uc.repo.EnableMFA(ctx, userID, step, hashes); err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ConfirmMFA")
+	}
+
+	if log != nil {
+		log.Info("MFA enabled", "user_id", userID)
+	}
+	return &authpb.RecoveryCodes{Codes: codes}, nil
+}
+
+// DisableMFA выключает второй фактор; нужен действующий код или резервный код
+func (uc *UseCase) DisableMFA(ctx context.Context, userID int, code string) error {
+	log := logger.FromContext(ctx)
+	mfa, err := uc.repo.GetMFA(ctx, userID)
+	if err != nil {
+		return pkgerrors.Wrap(err, "auth.DisableMFA")
+	}
+	if !mfa.Enabled {
+		return svcerrors.ErrMFANotEnabled
+	}
+
+	if err := uc.verifyMFACode(ctx, mfa, code); err != nil {
+		return pkgerrors.Wrap(err, "auth.DisableMFA")
+	}
+	if err := uc.repo.DisableMFA(ctx, userID); err != nil {
+		return pkgerrors.Wrap(err, "auth.DisableMFA")
+	}
+
+	if log != nil {
+		log.Info("MFA disabled", "user_id", userID)
+	}
+	return nil
+}
+
+// VerifyMFA второй шаг входа: обменивает промежуточный токен из Login и код
+// на сессию. Неверные коды считаются той же защитой от перебора, что и пароли.
+func (uc *UseCase) VerifyMFA(ctx context.Context, req authmodels.VerifyMFARequest) (*authpb.AuthResponse, error) {
+	log := logger.FromContext(ctx)
+	userID, err := utils.ValidateMFAToken(req.MFAToken, uc.clck.Now(), uc.keys.Secret)
+	if err != nil {
+		return nil, svcerrors.ErrTokenInvalid
+	}
+
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.VerifyMFA: failed to get user")
+	}
+
+	attemptKeys := loginAttemptKeys(authmodels.LoginRequest{Login: user.Login, Client: req.Client})
+	if err := uc.checkLoginLock(ctx, attemptKeys); err != nil {
+		return nil, err
+	}
+
+	mfa, err := uc.repo.GetMFA(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.VerifyMFA")
+	}
+	if !mfa.Enabled {
+		return nil, svcerrors.ErrMFANotEnabled
+	}
+
+	if err := uc.verifyMFACode(ctx, mfa, req.Code); err != nil {
+		if errors.Is(err, svcerrors.ErrMFACodeInvalid) {
+			if log != nil {
+				log.Warn("Login attempt with invalid MFA code", "user_id", userID)
+			}
+			uc.recordLoginFailure(ctx, attemptKeys)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.VerifyMFA")
+	}
+
+	if err := uc.repo.ResetLoginFailures(ctx, attemptKeys[0]); err != nil && log != nil {
+		log.Error("Failed to reset login failures", "error", err, "user_id", userID)
+	}
+
+	resp, err := uc.startSession(ctx, user, req.Client)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to start session", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.VerifyMFA")
+	}
+	return resp, nil
+}
+
+// mfaChallenge ответ Login для пользователя с включенным вторым фактором:
+// вместо сессии промежуточный токен для VerifyMFA. Nil, если 2FA выключена.
+func (uc *UseCase) mfaChallenge(ctx context.Context, user authmodels.User) (*authpb.AuthResponse, error) {
+	mfa, err := uc.repo.GetMFA(ctx, user.ID)
+	if errors.Is(err, svcerrors.ErrMFANotEnabled) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to get MFA settings")
+	}
+	if !mfa.Enabled {
+		return nil, nil
+	}
+
+	token, err := utils.GenerateMFAToken(user.ID, uc.clck.Now(), uc.keys.Secret)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to generate MFA token")
+	}
+	return &authpb.AuthResponse{MfaRequired: true, MfaToken: token}, nil
+}
+
+// verifyMFACode принимает код TOTP (каждый шаг однократно) или резервный код
+func (uc *UseCase) verifyMFACode(ctx context.Context, mfa authmodels.MFA, code string) error {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits && strings.Trim(code, "0123456789") == "" {
+		secret, err := uc.decryptMFASecret(mfa.Secret)
+		if err != nil {
+			return pkgerrors.Wrap(err, "failed to decrypt secret")
+		}
+		step, ok := totp.Validate(secret, code, uc.clck.Now(), mfa.LastUsedStep)
+		if !ok {
+			return svcerrors.ErrMFACodeInvalid
+		}
+		return uc.repo.UseMFAStep(ctx, mfa.UserID, step)
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) != recoveryCodeLen {
+		return svcerrors.ErrMFACodeInvalid
+	}
+	return uc.repo.UseRecoveryCode(ctx, mfa.UserID, hashToken(normalized), uc.clck.Now())
+}
+
+// newRecoveryCodes резервные коды для пользователя и их хеши для хранения
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	raw := make([]byte, 7) // 56 бит, в коде используются первые 50
+	for len(codes) < recoveryCodeCount {
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(raw)[:recoveryCodeLen])
+		codes = append(codes, code[:recoveryCodeLen/2]+"-"+code[recoveryCodeLen/2:])
+		hashes = append(hashes, hashToken(code))
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode код в том виде, в котором от него хранится хеш:
+// без дефисов и пробелов, в нижнем регистре
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+// mfaCipher AES-GCM с ключом из секрета JWT: секреты TOTP в базе
+// бесполезны без конфигурации сервиса
+func (uc *UseCase) mfaCipher() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(uc.keys.Secret + ":mfa-secret"))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (uc *UseCase) encryptMFASecret(secret string) (string, error) {
+	aead, err := uc.mfaCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (uc *UseCase) decryptMFASecret(encrypted string) (string, error) {
+	aead, err := uc.mfaCipher()
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted secret too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	secret, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
@@ -0,0 +1,298 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/totp"
+)
+
+const testTOTPSecret = "JBSWY3DPEHPK3PXP"
+
+var mfaNow = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// enabledMFA включенный второй фактор с testTOTPSecret, зашифрованным как в базе
+func enabledMFA(t *testing.T, s *UseCase, lastUsed int64) authmodels.MFA {
+	t.Helper()
+	encrypted, err := s.encryptMFASecret(testTOTPSecret)
+	require.NoError(t, err)
+	return authmodels.MFA{UserID: 1, Secret: encrypted, Enabled: true, LastUsedStep: lastUsed}
+}
+
+func currentCode(t *testing.T) string {
+	t.Helper()
+	code, err := totp.Code(testTOTPSecret, totp.Step(mfaNow))
+	require.NoError(t, err)
+	return code
+}
+
+func TestEnrollMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	var stored string
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Email: "ivan@example.com"}, nil)
+	repo.EXPECT().SaveMFASecret(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, secret string) error {
+			stored = secret
+			return nil
+		})
+
+	res, err := s.EnrollMFA(context.Background(), 1)
+	require.NoError(t, err)
+	require.NotEqual(t, res.Secret, stored, "секрет хранится зашифрованным")
+
+	decrypted, err := s.decryptMFASecret(stored)
+	require.NoError(t, err)
+	require.Equal(t, res.Secret, decrypted)
+
+	uri, err := url.Parse(res.OtpauthUri)
+	require.NoError(t, err)
+	require.Equal(t, res.Secret, uri.Query().Get("secret"))
+	require.Contains(t, uri.Path, "ivan@example.com")
+
+	_, err = png.Decode(bytes.NewReader(res.QrPng))
+	require.NoError(t, err)
+}
+
+func TestEnrollMFA_AlreadyEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1}, nil)
+	repo.EXPECT().SaveMFASecret(gomock.Any(), 1, gomock.Any()).Return(svcerrors.ErrMFAAlreadyEnabled)
+
+	_, err := s.EnrollMFA(context.Background(), 1)
+	require.ErrorIs(t, err, svcerrors.ErrMFAAlreadyEnabled)
+}
+
+func TestConfirmMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	pending := enabledMFA(t, s, 0)
+	pending.Enabled = false
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(pending, nil)
+
+	var hashes []string
+	repo.EXPECT().EnableMFA(gomock.Any(), 1, totp.Step(mfaNow), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, _ int64, codeHashes []string) error {
+			hashes = codeHashes
+			return nil
+		})
+
+	res, err := s.ConfirmMFA(context.Background(), 1, currentCode(t))
+	require.NoError(t, err)
+	require.Len(t, res.Codes, recoveryCodeCount)
+	require.Len(t, hashes, recoveryCodeCount)
+	for i, code := range res.Codes {
+		require.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+		require.Equal(t, hashToken(normalizeRecoveryCode(code)), hashes[i])
+	}
+}
+
+func TestConfirmMFA_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	pending := enabledMFA(t, s, 0)
+	pending.Enabled = false
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(pending, nil)
+	_, err := s.ConfirmMFA(context.Background(), 1, "000000")
+	require.ErrorIs(t, err, svcerrors.ErrMFACodeInvalid)
+
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(enabledMFA(t, s, 0), nil)
+	_, err = s.ConfirmMFA(context.Background(), 1, currentCode(t))
+	require.ErrorIs(t, err, svcerrors.ErrMFAAlreadyEnabled)
+
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(authmodels.MFA{}, svcerrors.ErrMFANotEnabled)
+	_, err = s.ConfirmMFA(context.Background(), 1, currentCode(t))
+	require.ErrorIs(t, err, svcerrors.ErrMFANotEnabled)
+}
+
+func TestDisableMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(enabledMFA(t, s, 0), nil)
+	repo.EXPECT().UseMFAStep(gomock.Any(), 1, totp.Step(mfaNow)).Return(nil)
+	repo.EXPECT().DisableMFA(gomock.Any(), 1).Return(nil)
+
+	require.NoError(t, s.DisableMFA(context.Background(), 1, currentCode(t)))
+}
+
+func TestDisableMFA_CodeReplay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	// текущий шаг уже использован при входе
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(enabledMFA(t, s, totp.Step(mfaNow)), nil)
+
+	err := s.DisableMFA(context.Background(), 1, currentCode(t))
+	require.ErrorIs(t, err, svcerrors.ErrMFACodeInvalid)
+}
+
+func TestLogin_MFARequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	hashed, _ := utils.HashPassword("password123")
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().GetUserByLogin(gomock.Any(), "ivan").Return(authmodels.User{ID: 1, Login: "ivan", Password: hashed}, nil)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(enabledMFA(t, s, 0), nil)
+
+	resp, err := s.Login(context.Background(), authmodels.LoginRequest{Login: "ivan", Password: "password123"})
+	require.NoError(t, err)
+	require.True(t, resp.MfaRequired)
+	require.Empty(t, resp.Token)
+	require.Empty(t, resp.RefreshToken)
+
+	userID, err := utils.ValidateMFAToken(resp.MfaToken, mfaNow, testKeys.Secret)
+	require.NoError(t, err)
+	require.Equal(t, 1, userID)
+}
+
+func TestVerifyMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	token, err := utils.GenerateMFAToken(1, mfaNow.Add(-time.Minute), testKeys.Secret)
+	require.NoError(t, err)
+	client := authmodels.ClientInfo{UserAgent: "Firefox", IP: "10.0.0.1"}
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan"}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil).Times(2)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(enabledMFA(t, s, 0), nil)
+	repo.EXPECT().UseMFAStep(gomock.Any(), 1, totp.Step(mfaNow)).Return(nil)
+	repo.EXPECT().ResetLoginFailures(gomock.Any(), authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "ivan"}).Return(nil)
+	repo.EXPECT().CreateSession(gomock.Any(), 1, gomock.Any(), gomock.Any(), client).Return(authmodels.Session{ID: 5, UserID: 1}, nil)
+
+	resp, err := s.VerifyMFA(context.Background(), authmodels.VerifyMFARequest{MFAToken: token, Code: currentCode(t), Client: client})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.RefreshToken)
+	require.False(t, resp.MfaRequired)
+}
+
+func TestVerifyMFA_RecoveryCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	token, err := utils.GenerateMFAToken(1, mfaNow, testKeys.Secret)
+	require.NoError(t, err)
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan"}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(enabledMFA(t, s, 0), nil)
+	repo.EXPECT().UseRecoveryCode(gomock.Any(), 1, hashToken("abcdefghij"), mfaNow).Return(nil)
+	repo.EXPECT().ResetLoginFailures(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().CreateSession(gomock.Any(), 1, gomock.Any(), gomock.Any(), gomock.Any()).Return(authmodels.Session{ID: 5, UserID: 1}, nil)
+
+	_, err = s.VerifyMFA(context.Background(), authmodels.VerifyMFARequest{MFAToken: token, Code: " ABCDE-FGHIJ "})
+	require.NoError(t, err)
+}
+
+func TestVerifyMFA_WrongCodeCountsAsFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	token, err := utils.GenerateMFAToken(1, mfaNow, testKeys.Secret)
+	require.NoError(t, err)
+	wrong := "000000"
+	if currentCode(t) == wrong {
+		wrong = "111111"
+	}
+
+	loginKey := authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "ivan"}
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan"}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), loginKey).Return(time.Time{}, nil)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(enabledMFA(t, s, 0), nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), loginKey, mfaNow, gomock.Any()).Return(1, nil)
+
+	_, err = s.VerifyMFA(context.Background(), authmodels.VerifyMFARequest{MFAToken: token, Code: wrong})
+	require.ErrorIs(t, err, svcerrors.ErrMFACodeInvalid)
+}
+
+func TestVerifyMFA_Locked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	token, err := utils.GenerateMFAToken(1, mfaNow, testKeys.Secret)
+	require.NoError(t, err)
+
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan"}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(mfaNow.Add(time.Minute), nil)
+
+	_, err = s.VerifyMFA(context.Background(), authmodels.VerifyMFARequest{MFAToken: token, Code: currentCode(t)})
+	require.ErrorIs(t, err, svcerrors.ErrAccountLocked)
+}
+
+func TestVerifyMFA_ExpiredToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: mfaNow}, nil, nil, Links{})
+
+	token, err := utils.GenerateMFAToken(1, mfaNow.Add(-utils.MFATokenTTL-time.Second), testKeys.Secret)
+	require.NoError(t, err)
+
+	_, err = s.VerifyMFA(context.Background(), authmodels.VerifyMFARequest{MFAToken: token, Code: currentCode(t)})
+	require.ErrorIs(t, err, svcerrors.ErrTokenInvalid)
+}
+
+func TestMFASecretEncryption(t *testing.T) {
+	s := NewAuthUseCase(nil, testKeys, nil, nil, nil, Links{})
+	encrypted, err := s.encryptMFASecret(testTOTPSecret)
+	require.NoError(t, err)
+	require.False(t, strings.Contains(encrypted, testTOTPSecret))
+
+	other := NewAuthUseCase(nil, Keys{Secret: "other-secret"}, nil, nil, nil, Links{})
+	_, err = other.decryptMFASecret(encrypted)
+	require.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	pkgerrors "github.com/pkg/errors"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+)
+
+// ChangePassword меняет пароль после проверки текущего и кода 2FA, если она
+// включена, завершает все остальные сессии пользователя и удаляет его
+// персональные токены: если пароль утек, чужие входы и выпущенные по нему
+// токены отвалятся.
+func (uc *UseCase) ChangePassword(ctx context.Context, req authmodels.ChangePasswordRequest) (*authpb.RevokedSessions, error) {
+	log := logger.FromContext(ctx)
+	user, err := uc.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ChangePassword: failed to get user")
+	}
+
+	if err := uc.reauthenticate(ctx, user, req.OldPassword, req.Code); err != nil {
+		if log != nil {
+			log.Warn("Password change not confirmed", "error", err, "user_id", req.UserID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.ChangePassword")
+	}
+
+	if req.NewPassword == req.OldPassword {
+		return nil, pkgerrors.Wrap(svcerrors.ErrWeakPassword, "auth.ChangePassword: new password equals current")
+	}
+	if err := utils.CheckPasswordStrength(req.NewPassword, user.Login, user.Email); err != nil {
+		return nil, pkgerrors.Wrap(svcerrors.ErrWeakPassword, err.Error())
+	}
+
+	passwordHash, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ChangePassword: failed to hash password")
+	}
+
+	revoked, err := uc.repo.ChangePassword(ctx, req.UserID, passwordHash, req.SessionID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ChangePassword")
+	}
+
+	if log != nil {
+		log.Info("Password changed", "user_id", req.UserID, "revoked_sessions", len(revoked))
+	}
+	if len(revoked) > 0 {
+		uc.publishSessionsRevoked(ctx, req.UserID, revoked...)
+	}
+	return &authpb.RevokedSessions{Count: int32(len(revoked))}, nil
+}
+
+// reauthenticate повторно проверяет пароль и код 2FA, если она включена, перед
+// действием из уже открытой сессии. Ошибки считаются в счетчике входа по логину:
+// укравший сессию не получает перебора пароля и кодов в обход блокировки.
+func (uc *UseCase) reauthenticate(ctx context.Context, user authmodels.User, password, code string) error {
+	attemptKeys := loginAttemptKeys(authmodels.LoginRequest{Login: user.Login})
+	if err := uc.checkLoginLock(ctx, attemptKeys); err != nil {
+		return err
+	}
+
+	valid, err := utils.VerifyPassword(password, user.Password)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to verify password")
+	}
+	if !valid {
+		uc.recordLoginFailure(ctx, attemptKeys)
+		return svcerrors.ErrInvalidCredentials
+	}
+
+	mfa, err := uc.repo.GetMFA(ctx, user.ID)
+	if errors.Is(err, svcerrors.ErrMFANotEnabled) {
+		return nil
+	}
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to get MFA settings")
+	}
+	if !mfa.Enabled {
+		return nil
+	}
+	if err := uc.verifyMFACode(ctx, mfa, code); err != nil {
+		if errors.Is(err, svcerrors.ErrMFACodeInvalid) {
+			uc.recordLoginFailure(ctx, attemptKeys)
+		}
+		return err
+	}
+	return nil
+}
+
+// rehashPassword пересчитывает хеш, посчитанный со старыми параметрами argon2id.
+// Пароль известен только в момент входа, поэтому обновление происходит здесь;
+// ошибка не мешает входу, хеш обновится в следующий раз.
+func (uc *UseCase) rehashPassword(ctx context.Context, user authmodels.User, password string) {
+	if !utils.NeedsRehash(user.Password) {
+		return
+	}
+
+	log := logger.FromContext(ctx)
+	newHash, err := utils.HashPassword(password)
+	if err == nil {
+		err = uc.repo.UpdatePasswordHash(ctx, user.ID, user.Password, newHash)
+	}
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to rehash password", "error", err, "user_id", user.ID)
+		}
+		return
+	}
+	if log != nil {
+		log.Info("Password hash upgraded", "user_id", user.ID)
+	}
+}
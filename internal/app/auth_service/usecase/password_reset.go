@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/mailer"
+)
+
+const passwordResetTTL = time.Hour
+
+// RequestPasswordReset отправляет на почту одноразовую ссылку сброса пароля.
+// Запросы ограничиваются по email и IP через счетчик login_attempt; каждый запрос
+// считается одинаково для известных и неизвестных адресов. Поиск пользователя
+// и отправка письма идут в фоне, поэтому ни ответ, ни время ответа не зависят
+// от того, зарегистрирован ли адрес, а ошибки только логируются.
+func (uc *UseCase) RequestPasswordReset(ctx context.Context, req authmodels.PasswordResetRequest) error {
+	attemptKeys := passwordResetAttemptKeys(req)
+	if err := uc.checkLoginLock(ctx, attemptKeys); err != nil {
+		return err
+	}
+	uc.recordLoginFailure(ctx, attemptKeys)
+
+	// запрос завершится раньше письма, из его контекста нужен только логгер
+	bgCtx := uc.backgroundCtx
+	if log := logger.FromContext(ctx); log != nil {
+		bgCtx = logger.WithLogger(bgCtx, log)
+	}
+	uc.background.Add(1)
+	go func() {
+		defer uc.background.Done()
+		uc.sendPasswordReset(bgCtx, req.Email)
+	}()
+	return nil
+}
+
+func (uc *UseCase) sendPasswordReset(ctx context.Context, email string) {
+	log := logger.FromContext(ctx)
+	user, err := uc.repo.GetUserByEmail(ctx, email)
+	if errors.Is(err, svcerrors.ErrUserNotFound) {
+		if log != nil {
+			log.Info("Password reset requested for unknown email")
+		}
+		return
+	}
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get user for password reset", "error", err)
+		}
+		return
+	}
+
+	token, tokenHash, err := newOpaqueToken()
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to generate password reset token", "error", err, "user_id", user.ID)
+		}
+		return
+	}
+	if err := uc.repo.CreatePasswordResetToken(ctx, user.ID, tokenHash, uc.clck.Now().Add(passwordResetTTL)); err != nil {
+		if log != nil {
+			log.Error("Failed to save password reset token", "error", err, "user_id", user.ID)
+		}
+		return
+	}
+
+	if err := uc.mail.Send(ctx, passwordResetMail(user.Email, uc.links.PasswordReset, token)); err != nil && log != nil {
+		log.Error("Failed to send password reset mail", "error", err, "user_id", user.ID)
+	}
+}
+
+func passwordResetMail(to, resetURL, token string) mailer.Mail {
+	link := resetURL + "?token=" + url.QueryEscape(token)
+	return mailer.Mail{
+		To:      to,
+		Subject: "Сброс пароля VKarmane",
+		Body: fmt.Sprintf(
+			"Чтобы задать новый пароль, перейдите по ссылке:\n%s\n\n"+
+				"Ссылка действует %d минут и сработает один раз. "+
+				"Если вы не запрашивали сброс, просто проигнорируйте это письмо.",
+			link, int(passwordResetTTL.Minutes()),
+		),
+	}
+}
+
+// ConfirmPasswordReset задает новый пароль по токену из письма, завершает
+// все сессии пользователя и удаляет его персональные токены: тот, кто знал
+// старый пароль, теряет доступ.
+func (uc *UseCase) ConfirmPasswordReset(ctx context.Context, token, newPassword string) error {
+	log := logger.FromContext(ctx)
+	if err := utils.CheckPasswordStrength(newPassword); err != nil {
+		return pkgerrors.Wrap(svcerrors.ErrWeakPassword, err.Error())
+	}
+
+	passwordHash, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return pkgerrors.Wrap(err, "auth.ConfirmPasswordReset: failed to hash password")
+	}
+
+	userID, revoked, err := uc.repo.ResetPassword(ctx, hashToken(token), passwordHash, uc.clck.Now())
+	if err != nil {
+		if log != nil {
+			log.Warn("Password reset rejected", "error", err)
+		}
+		return pkgerrors.Wrap(err, "auth.ConfirmPasswordReset")
+	}
+
+	if log != nil {
+		log.Info("Password reset", "user_id", userID, "revoked_sessions", len(revoked))
+	}
+	if len(revoked) > 0 {
+		uc.publishSessionsRevoked(ctx, userID, revoked...)
+	}
+	return nil
+}
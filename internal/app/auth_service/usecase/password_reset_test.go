@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/mailer"
+)
+
+const testResetURL = "https://vkarmane.example/reset-password"
+
+func TestRequestPasswordReset_SendsLink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	mail := mailer.NewMemoryMailer()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, mail, Links{PasswordReset: testResetURL})
+
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), authmodels.LoginAttemptKey{Kind: authmodels.PasswordResetByEmail, Key: "ivan@example.com"}, now, now.Add(-loginFailureWindow)).Return(1, nil)
+
+	var savedHash string
+	repo.EXPECT().GetUserByEmail(gomock.Any(), "Ivan@example.com").Return(authmodels.User{ID: 1, Email: "ivan@example.com"}, nil)
+	repo.EXPECT().CreatePasswordResetToken(gomock.Any(), 1, gomock.Any(), now.Add(passwordResetTTL)).
+		DoAndReturn(func(_ context.Context, _ int, hash string, _ time.Time) error {
+			savedHash = hash
+			return nil
+		})
+
+	require.NoError(t, s.RequestPasswordReset(context.Background(), authmodels.PasswordResetRequest{Email: "Ivan@example.com"}))
+	require.NoError(t, s.Close(context.Background()))
+
+	sent := mail.Sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, "ivan@example.com", sent[0].To)
+
+	// в письме сам токен, в базе только его хеш
+	i := strings.Index(sent[0].Body, testResetURL+"?token=")
+	require.GreaterOrEqual(t, i, 0)
+	link, err := url.Parse(strings.Fields(sent[0].Body[i:])[0])
+	require.NoError(t, err)
+	token := link.Query().Get("token")
+	require.NotEqual(t, savedHash, token)
+	require.Equal(t, savedHash, hashToken(token))
+}
+
+func TestRequestPasswordReset_UnknownEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	mail := mailer.NewMemoryMailer()
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, mail, Links{PasswordReset: testResetURL})
+
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(1, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Any(), "nobody@example.com").Return(authmodels.User{}, svcerrors.ErrUserNotFound)
+
+	require.NoError(t, s.RequestPasswordReset(context.Background(), authmodels.PasswordResetRequest{Email: "nobody@example.com"}))
+	require.NoError(t, s.Close(context.Background()))
+	require.Empty(t, mail.Sent())
+}
+
+func TestRequestPasswordReset_MailFailureNotReported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, mailer.NewMemoryMailer(), Links{PasswordReset: testResetURL})
+
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(1, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Any(), "ivan@example.com").Return(authmodels.User{ID: 1, Email: "ivan@example.com"}, nil)
+	repo.EXPECT().CreatePasswordResetToken(gomock.Any(), 1, gomock.Any(), gomock.Any()).Return(nil)
+
+	// запрос отменен раньше, чем ушло письмо: MemoryMailer вернет ошибку, если фон унаследует отмену
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, s.RequestPasswordReset(ctx, authmodels.PasswordResetRequest{Email: "ivan@example.com"}))
+	cancel()
+	require.NoError(t, s.Close(context.Background()))
+}
+
+func TestRequestPasswordReset_Throttled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, mailer.NewMemoryMailer(), Links{PasswordReset: testResetURL})
+
+	emailKey := authmodels.LoginAttemptKey{Kind: authmodels.PasswordResetByEmail, Key: "ivan@example.com"}
+	ipKey := authmodels.LoginAttemptKey{Kind: authmodels.PasswordResetByIP, Key: "10.0.0.1"}
+	req := authmodels.PasswordResetRequest{Email: "ivan@example.com", Client: authmodels.ClientInfo{IP: "10.0.0.1"}}
+
+	// запрос сверх порога блокирует адрес, следующий отклоняется без поиска пользователя
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), emailKey).Return(time.Time{}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), ipKey).Return(time.Time{}, nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), emailKey, now, now.Add(-loginFailureWindow)).Return(resetEmailLockThreshold, nil)
+	repo.EXPECT().LockLogin(gomock.Any(), emailKey, now.Add(lockBaseDelay)).Return(nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), ipKey, now, now.Add(-loginFailureWindow)).Return(1, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Any(), "ivan@example.com").Return(authmodels.User{}, svcerrors.ErrUserNotFound)
+
+	require.NoError(t, s.RequestPasswordReset(context.Background(), req))
+	require.NoError(t, s.Close(context.Background()))
+
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), emailKey).Return(now.Add(lockBaseDelay), nil)
+	require.ErrorIs(t, s.RequestPasswordReset(context.Background(), req), svcerrors.ErrAccountLocked)
+}
+
+// blockingMailer отправляет письмо, только когда отменят контекст отправки
+type blockingMailer struct {
+	started chan context.Context
+}
+
+func (m blockingMailer) Send(ctx context.Context, _ mailer.Mail) error {
+	m.started <- ctx
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestRequestPasswordReset_OutlivesRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	mail := blockingMailer{started: make(chan context.Context, 1)}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, mail, Links{PasswordReset: testResetURL})
+
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), gomock.Any(), now, now.Add(-loginFailureWindow)).Return(1, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Any(), "ivan@example.com").Return(authmodels.User{ID: 1, Email: "ivan@example.com"}, nil)
+	repo.EXPECT().CreatePasswordResetToken(gomock.Any(), 1, gomock.Any(), now.Add(passwordResetTTL)).Return(nil)
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, s.RequestPasswordReset(reqCtx, authmodels.PasswordResetRequest{Email: "ivan@example.com"}))
+	cancel()
+
+	// ответ уже ушел, но отправка письма не отменяется вместе с запросом
+	sendCtx := <-mail.started
+	require.NoError(t, sendCtx.Err())
+
+	// Close ждет письмо до своего таймаута, затем отменяет отправку
+	closeCtx, stop := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer stop()
+	require.ErrorIs(t, s.Close(closeCtx), context.DeadlineExceeded)
+	<-sendCtx.Done()
+}
+
+func TestConfirmPasswordReset_RevokesSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	events := mock.NewMockKafkaProducer(ctrl)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, events, nil, Links{PasswordReset: testResetURL})
+
+	repo.EXPECT().ResetPassword(gomock.Any(), hashToken("token"), gomock.Any(), now).
+		DoAndReturn(func(_ context.Context, _, passwordHash string, _ time.Time) (int, []int, error) {
+			valid, err := utils.VerifyPassword("new-password", passwordHash)
+			require.NoError(t, err)
+			require.True(t, valid)
+			return 1, []int{3, 5}, nil
+		})
+	events.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil)
+
+	require.NoError(t, s.ConfirmPasswordReset(context.Background(), "token", "new-password"))
+
+	repo.EXPECT().ResetPassword(gomock.Any(), hashToken("used"), gomock.Any(), now).Return(0, nil, svcerrors.ErrTokenInvalid)
+	require.ErrorIs(t, s.ConfirmPasswordReset(context.Background(), "used", "new-password"), svcerrors.ErrTokenInvalid)
+}
+
+func TestConfirmPasswordReset_WeakPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, nil, Links{})
+
+	err := s.ConfirmPasswordReset(context.Background(), "token", "12345678")
+	require.ErrorIs(t, err, svcerrors.ErrWeakPassword)
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/argon2"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	events := mock.NewMockKafkaProducer(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, events, nil, Links{})
+
+	hashed, _ := utils.HashPassword("old-password")
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan", Password: hashed}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(authmodels.MFA{}, svcerrors.ErrMFANotEnabled)
+	repo.EXPECT().ChangePassword(gomock.Any(), 1, gomock.Any(), 5).
+		DoAndReturn(func(_ context.Context, _ int, passwordHash string, _ int) ([]int, error) {
+			valid, err := utils.VerifyPassword("Brand-new-pass", passwordHash)
+			require.NoError(t, err)
+			require.True(t, valid)
+			return []int{3, 4}, nil
+		})
+	events.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil)
+
+	res, err := s.ChangePassword(context.Background(), authmodels.ChangePasswordRequest{
+		UserID: 1, SessionID: 5, OldPassword: "old-password", NewPassword: "Brand-new-pass",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), res.Count)
+}
+
+func TestChangePassword_WrongCurrentPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, nil, Links{})
+
+	hashed, _ := utils.HashPassword("old-password")
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan", Password: hashed}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "ivan"}, gomock.Any(), gomock.Any()).
+		Return(1, nil)
+
+	_, err := s.ChangePassword(context.Background(), authmodels.ChangePasswordRequest{
+		UserID: 1, OldPassword: "not-my-password", NewPassword: "Brand-new-pass",
+	})
+	require.ErrorIs(t, err, svcerrors.ErrInvalidCredentials)
+}
+
+func TestChangePassword_WeakPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, nil, Links{})
+
+	hashed, _ := utils.HashPassword("old-password")
+	user := authmodels.User{ID: 1, Login: "ivanov", Email: "ivan@example.com", Password: hashed}
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil).Times(3)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil).Times(3)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(authmodels.MFA{}, svcerrors.ErrMFANotEnabled).Times(3)
+
+	for _, password := range []string{"old-password", "qwerty123", "ivanov-2024"} {
+		_, err := s.ChangePassword(context.Background(), authmodels.ChangePasswordRequest{
+			UserID: 1, OldPassword: "old-password", NewPassword: password,
+		})
+		require.ErrorIs(t, err, svcerrors.ErrWeakPassword, password)
+	}
+}
+
+// legacyHash хеш argon2id с параметрами слабее текущих
+func legacyHash(t *testing.T, password string) string {
+	t.Helper()
+	salt := []byte("0123456789abcdef")
+	key := argon2.IDKey([]byte(password), salt, 1, 32*1024, 1, 32)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, 32*1024, 1, 1,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+func TestLogin_RehashesLegacyPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, nil, Links{})
+
+	oldHash := legacyHash(t, "password123")
+	user := authmodels.User{ID: 1, Login: "testuser", Password: oldHash}
+
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+	repo.EXPECT().GetUserByLogin(gomock.Any(), "testuser").Return(user, nil)
+	repo.EXPECT().GetMFA(gomock.Any(), 1).Return(authmodels.MFA{}, svcerrors.ErrMFANotEnabled)
+	repo.EXPECT().ResetLoginFailures(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().UpdatePasswordHash(gomock.Any(), 1, oldHash, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, _, newHash string) error {
+			require.False(t, utils.NeedsRehash(newHash))
+			valid, err := utils.VerifyPassword("password123", newHash)
+			require.NoError(t, err)
+			require.True(t, valid)
+			return nil
+		})
+	repo.EXPECT().CreateSession(gomock.Any(), 1, gomock.Any(), gomock.Any(), gomock.Any()).Return(authmodels.Session{ID: 5, UserID: 1}, nil)
+
+	_, err := s.Login(context.Background(), authmodels.LoginRequest{Login: "testuser", Password: "password123"})
+	require.NoError(t, err)
+}
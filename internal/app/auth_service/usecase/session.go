@@ -0,0 +1,187 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	pkgerrors "github.com/pkg/errors"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+const opaqueTokenBytes = 32
+
+// newOpaqueToken возвращает случайный токен для клиента и его хеш для хранения
+func newOpaqueToken() (string, string, error) {
+	b := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// startSession открывает сессию и выдает пару access/refresh токенов
+func (uc *UseCase) startSession(ctx context.Context, user authmodels.User, client authmodels.ClientInfo) (*authpb.AuthResponse, error) {
+	refreshToken, refreshHash, err := newOpaqueToken()
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to generate refresh token")
+	}
+
+	session, err := uc.repo.CreateSession(ctx, user.ID, refreshHash, uc.clck.Now().Add(utils.RefreshTokenTTL), client)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create session")
+	}
+
+	token, err := utils.GenerateJWT(user.ID, user.Login, session.ID, uc.keys.Signing)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to generate token")
+	}
+
+	user.Password = ""
+	return &authpb.AuthResponse{
+		Token:        token,
+		RefreshToken: refreshToken,
+		User:         ModelUserToProtoUser(user),
+	}, nil
+}
+
+// RefreshToken обменивает refresh-токен на новую пару токенов.
+// Повторное предъявление уже обменянного токена означает утечку:
+// сессия отзывается целиком.
+func (uc *UseCase) RefreshToken(ctx context.Context, refreshToken string, client authmodels.ClientInfo) (*authpb.AuthResponse, error) {
+	log := logger.FromContext(ctx)
+	oldHash := hashToken(refreshToken)
+
+	stored, err := uc.repo.GetRefreshToken(ctx, oldHash)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.RefreshToken")
+	}
+	if stored.SessionRevoked {
+		return nil, svcerrors.ErrSessionRevoked
+	}
+	if stored.Used {
+		if log != nil {
+			log.Warn("Refresh token reuse detected, revoking session", "user_id", stored.UserID, "session_id", stored.SessionID)
+		}
+		return nil, uc.revokeReusedSession(ctx, stored)
+	}
+	if !uc.clck.Now().Before(stored.ExpiresAt) {
+		return nil, svcerrors.ErrTokenExpired
+	}
+
+	user, err := uc.repo.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.RefreshToken: failed to get user")
+	}
+
+	newToken, newHash, err := newOpaqueToken()
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.RefreshToken: failed to generate refresh token")
+	}
+
+	session, err := uc.repo.RotateRefreshToken(ctx, stored.SessionID, oldHash, newHash, uc.clck.Now().Add(utils.RefreshTokenTTL), client)
+	if errors.Is(err, svcerrors.ErrRefreshTokenReused) {
+		if log != nil {
+			log.Warn("Concurrent refresh token reuse detected, revoking session", "user_id", stored.UserID, "session_id", stored.SessionID)
+		}
+		return nil, uc.revokeReusedSession(ctx, stored)
+	}
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.RefreshToken: failed to rotate refresh token")
+	}
+
+	token, err := utils.GenerateJWT(user.ID, user.Login, session.ID, uc.keys.Signing)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.RefreshToken: failed to generate token")
+	}
+
+	user.Password = ""
+	return &authpb.AuthResponse{
+		Token:        token,
+		RefreshToken: newToken,
+		User:         ModelUserToProtoUser(user),
+	}, nil
+}
+
+func (uc *UseCase) revokeReusedSession(ctx context.Context, stored authmodels.RefreshToken) error {
+	err := uc.repo.RevokeSession(ctx, stored.UserID, stored.SessionID)
+	if err != nil && !errors.Is(err, svcerrors.ErrSessionNotFound) {
+		return pkgerrors.Wrap(err, "auth.RefreshToken: failed to revoke session")
+	}
+	uc.publishSessionsRevoked(ctx, stored.UserID, stored.SessionID)
+	return svcerrors.ErrSessionRevoked
+}
+
+func (uc *UseCase) RevokeSession(ctx context.Context, userID, sessionID int) error {
+	log := logger.FromContext(ctx)
+	if err := uc.repo.RevokeSession(ctx, userID, sessionID); err != nil {
+		if log != nil {
+			log.Error("Failed to revoke session", "error", err, "user_id", userID, "session_id", sessionID)
+		}
+		return pkgerrors.Wrap(err, "auth.RevokeSession")
+	}
+	uc.publishSessionsRevoked(ctx, userID, sessionID)
+	return nil
+}
+
+// RevokeOtherSessions завершает все сессии пользователя, кроме текущей
+func (uc *UseCase) RevokeOtherSessions(ctx context.Context, userID, currentSessionID int) (*authpb.RevokedSessions, error) {
+	log := logger.FromContext(ctx)
+	ids, err := uc.repo.RevokeOtherSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to revoke other sessions", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "auth.RevokeOtherSessions")
+	}
+	if len(ids) > 0 {
+		uc.publishSessionsRevoked(ctx, userID, ids...)
+	}
+	return &authpb.RevokedSessions{Count: int32(len(ids))}, nil
+}
+
+func (uc *UseCase) ListSessions(ctx context.Context, userID, currentSessionID int) (*authpb.SessionList, error) {
+	sessions, err := uc.repo.ListActiveSessions(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ListSessions")
+	}
+	return SessionsToProto(sessions, currentSessionID), nil
+}
+
+func (uc *UseCase) CheckSession(ctx context.Context, userID, sessionID int) (*authpb.SessionStatus, error) {
+	active, err := uc.repo.IsSessionActive(ctx, userID, sessionID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.CheckSession")
+	}
+	return &authpb.SessionStatus{Active: active}, nil
+}
+
+// publishSessionsRevoked сообщает шлюзам об отзыве сессий. Ошибка не
+// прерывает отзыв: шлюз перепроверяет закешированные сессии по истечении TTL кеша.
+func (uc *UseCase) publishSessionsRevoked(ctx context.Context, userID int, sessionIDs ...int) {
+	log := logger.FromContext(ctx)
+	data, err := json.Marshal(models.SessionRevokedEvent{UserID: userID, SessionIDs: sessionIDs})
+	if err == nil {
+		err = uc.events.WriteMessages(ctx, kafkautils.KafkaMessage{Payload: data, Type: models.SESSION_REVOKED})
+	}
+	if err != nil && log != nil {
+		log.Error("Failed to publish session revoked event", "error", err, "user_id", userID)
+	}
+}
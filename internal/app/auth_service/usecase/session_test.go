@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/jwks"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+var testClient = authmodels.ClientInfo{UserAgent: "Mozilla/5.0", IP: "10.0.0.1"}
+
+var testKeys = func() Keys {
+	signing, err := jwks.Generate("test")
+	if err != nil {
+		panic(err)
+	}
+	return Keys{Signing: signing, Secret: "secret", CSRF: "csrf-secret"}
+}()
+
+func TestRefreshToken_Rotates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, nil, Links{})
+
+	oldHash := hashToken("old")
+	repo.EXPECT().GetRefreshToken(gomock.Any(), oldHash).
+		Return(authmodels.RefreshToken{SessionID: 5, UserID: 1, ExpiresAt: now.Add(time.Hour)}, nil)
+	repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(authmodels.User{ID: 1, Login: "ivan", Password: "hash"}, nil)
+
+	var newHash string
+	repo.EXPECT().RotateRefreshToken(gomock.Any(), 5, oldHash, gomock.Any(), now.Add(utils.RefreshTokenTTL), testClient).
+		DoAndReturn(func(_ context.Context, sessionID int, _, hash string, expiresAt time.Time, _ authmodels.ClientInfo) (authmodels.Session, error) {
+			newHash = hash
+			return authmodels.Session{ID: sessionID, UserID: 1, ExpiresAt: expiresAt}, nil
+		})
+
+	resp, err := s.RefreshToken(context.Background(), "old", testClient)
+	require.NoError(t, err)
+	require.Equal(t, newHash, hashToken(resp.RefreshToken))
+	require.NotEqual(t, oldHash, newHash)
+
+	claims, err := utils.ValidateJWT(context.Background(), resp.Token, testKeys.Signing)
+	require.NoError(t, err)
+	require.Equal(t, 5, claims.SessionID)
+}
+
+func TestRefreshToken_ReuseRevokesSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	events := mock.NewMockKafkaProducer(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, events, nil, Links{})
+
+	repo.EXPECT().GetRefreshToken(gomock.Any(), hashToken("stolen")).
+		Return(authmodels.RefreshToken{SessionID: 5, UserID: 1, Used: true, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	repo.EXPECT().RevokeSession(gomock.Any(), 1, 5).Return(nil)
+	events.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msgs ...kafkautils.KafkaMessage) error {
+			require.Len(t, msgs, 1)
+			require.Equal(t, models.SESSION_REVOKED, msgs[0].Type)
+
+			var event models.SessionRevokedEvent
+			require.NoError(t, json.Unmarshal(msgs[0].Payload, &event))
+			require.Equal(t, []int{5}, event.SessionIDs)
+			return nil
+		})
+
+	_, err := s.RefreshToken(context.Background(), "stolen", testClient)
+	require.ErrorIs(t, err, svcerrors.ErrSessionRevoked)
+}
+
+func TestRefreshToken_Rejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	now := time.Now()
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, nil, Links{})
+
+	repo.EXPECT().GetRefreshToken(gomock.Any(), hashToken("expired")).
+		Return(authmodels.RefreshToken{SessionID: 5, UserID: 1, ExpiresAt: now}, nil)
+	_, err := s.RefreshToken(context.Background(), "expired", testClient)
+	require.ErrorIs(t, err, svcerrors.ErrTokenExpired)
+
+	repo.EXPECT().GetRefreshToken(gomock.Any(), hashToken("revoked")).
+		Return(authmodels.RefreshToken{SessionID: 5, UserID: 1, SessionRevoked: true, ExpiresAt: now.Add(time.Hour)}, nil)
+	_, err = s.RefreshToken(context.Background(), "revoked", testClient)
+	require.ErrorIs(t, err, svcerrors.ErrSessionRevoked)
+}
+
+func TestRevokeSession_PublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	events := mock.NewMockKafkaProducer(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, events, nil, Links{})
+
+	repo.EXPECT().RevokeSession(gomock.Any(), 1, 5).Return(nil)
+	events.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, s.RevokeSession(context.Background(), 1, 5))
+
+	repo.EXPECT().RevokeSession(gomock.Any(), 1, 6).Return(svcerrors.ErrSessionNotFound)
+	require.ErrorIs(t, s.RevokeSession(context.Background(), 1, 6), svcerrors.ErrSessionNotFound)
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	events := mock.NewMockKafkaProducer(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, events, nil, Links{})
+
+	repo.EXPECT().RevokeOtherSessions(gomock.Any(), 1, 5).Return([]int{3, 4}, nil)
+	events.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msgs ...kafkautils.KafkaMessage) error {
+			var event models.SessionRevokedEvent
+			require.NoError(t, json.Unmarshal(msgs[0].Payload, &event))
+			require.Equal(t, []int{3, 4}, event.SessionIDs)
+			return nil
+		})
+
+	resp, err := s.RevokeOtherSessions(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.Count)
+
+	// без других сессий событие не публикуется
+	repo.EXPECT().RevokeOtherSessions(gomock.Any(), 1, 5).Return(nil, nil)
+	resp, err = s.RevokeOtherSessions(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.Zero(t, resp.Count)
+}
+
+func TestListSessions_MarksCurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: time.Now()}, nil, nil, Links{})
+
+	repo.EXPECT().ListActiveSessions(gomock.Any(), 1).Return([]authmodels.Session{
+		{ID: 5, UserID: 1, UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 Version/17.0 Mobile/15E148 Safari/604.1", IP: "10.0.0.1"},
+		{ID: 3, UserID: 1, UserAgent: "okhttp/4.12.0", IP: "10.0.0.2"},
+	}, nil)
+
+	list, err := s.ListSessions(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.Len(t, list.Sessions, 2)
+	require.True(t, list.Sessions[0].Current)
+	require.Equal(t, "Safari, iPhone", list.Sessions[0].Device)
+	require.False(t, list.Sessions[1].Current)
+	require.Equal(t, "okhttp", list.Sessions[1].Device)
+}
+
+func TestDescribeDevice(t *testing.T) {
+	cases := map[string]string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36":           "Chrome, Windows",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0": "Edge, Windows",
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36":     "Chrome, Android",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 14.2; rv:121.0) Gecko/20100101 Firefox/121.0":                                       "Firefox, macOS",
+		"curl/8.4.0": "curl",
+		"":           unknownDevice,
+	}
+	for ua, want := range cases {
+		require.Equal(t, want, describeDevice(ua), ua)
+	}
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+)
+
+const (
+	// loginFailureWindow после стольких минут без ошибок и блокировок счетчик обнуляется
+	loginFailureWindow = 15 * time.Minute
+	// с одного IP перебирают разные логины, поэтому порог выше
+	loginLockThreshold = 5
+	ipLockThreshold    = 20
+	// сброс пароля блокируется после каждого запроса сверх порога, а не после ошибок
+	resetEmailLockThreshold = 3
+	resetIPLockThreshold    = 10
+	lockBaseDelay           = 30 * time.Second
+	lockMaxDelay            = time.Hour
+)
+
+func loginAttemptKeys(req authmodels.LoginRequest) []authmodels.LoginAttemptKey {
+	keys := []authmodels.LoginAttemptKey{{Kind: authmodels.LoginAttemptByLogin, Key: req.Login}}
+	if req.Client.IP != "" {
+		keys = append(keys, authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByIP, Key: req.Client.IP})
+	}
+	return keys
+}
+
+func passwordResetAttemptKeys(req authmodels.PasswordResetRequest) []authmodels.LoginAttemptKey {
+	keys := []authmodels.LoginAttemptKey{{Kind: authmodels.PasswordResetByEmail, Key: strings.ToLower(req.Email)}}
+	if req.Client.IP != "" {
+		keys = append(keys, authmodels.LoginAttemptKey{Kind: authmodels.PasswordResetByIP, Key: req.Client.IP})
+	}
+	return keys
+}
+
+func lockThreshold(kind string) int {
+	switch kind {
+	case authmodels.LoginAttemptByIP:
+		return ipLockThreshold
+	case authmodels.PasswordResetByEmail:
+		return resetEmailLockThreshold
+	case authmodels.PasswordResetByIP:
+		return resetIPLockThreshold
+	default:
+		return loginLockThreshold
+	}
+}
+
+// lockDuration блокировка после failures ошибок: с порога 30 секунд,
+// каждая следующая ошибка удваивает, но не больше часа
+func lockDuration(failures, threshold int) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+	delay := lockBaseDelay
+	for i := threshold; i < failures; i++ {
+		delay *= 2
+		if delay >= lockMaxDelay {
+			return lockMaxDelay
+		}
+	}
+	return delay
+}
+
+// checkLoginLock возвращает ErrAccountLocked, пока действует блокировка
+// логина или IP. Ошибка хранилища не мешает входу.
+func (uc *UseCase) checkLoginLock(ctx context.Context, keys []authmodels.LoginAttemptKey) error {
+	log := logger.FromContext(ctx)
+	now := uc.clck.Now()
+	for _, key := range keys {
+		lockedUntil, err := uc.repo.GetLoginLockedUntil(ctx, key)
+		if err != nil {
+			if log != nil {
+				log.Error("Failed to check login lock", "error", err, "kind", key.Kind)
+			}
+			continue
+		}
+		if now.Before(lockedUntil) {
+			if log != nil {
+				log.Warn("Login attempt while locked", "kind", key.Kind, "key", key.Key, "locked_until", lockedUntil)
+			}
+			return svcerrors.ErrAccountLocked
+		}
+	}
+	return nil
+}
+
+func (uc *UseCase) recordLoginFailure(ctx context.Context, keys []authmodels.LoginAttemptKey) {
+	log := logger.FromContext(ctx)
+	now := uc.clck.Now()
+	for _, key := range keys {
+		failures, err := uc.repo.RecordLoginFailure(ctx, key, now, now.Add(-loginFailureWindow))
+		if err != nil {
+			if log != nil {
+				log.Error("Failed to record login failure", "error", err, "kind", key.Kind)
+			}
+			continue
+		}
+
+		delay := lockDuration(failures, lockThreshold(key.Kind))
+		if delay == 0 {
+			continue
+		}
+		if err := uc.repo.LockLogin(ctx, key, now.Add(delay)); err != nil {
+			if log != nil {
+				log.Error("Failed to lock login", "error", err, "kind", key.Kind)
+			}
+			continue
+		}
+		if log != nil {
+			log.Warn("Login locked after failed attempts", "kind", key.Kind, "key", key.Key, "failures", failures, "delay", delay.String())
+		}
+	}
+}
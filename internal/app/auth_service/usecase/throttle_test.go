@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestLockDuration(t *testing.T) {
+	require.Zero(t, lockDuration(4, loginLockThreshold))
+	require.Equal(t, 30*time.Second, lockDuration(5, loginLockThreshold))
+	require.Equal(t, time.Minute, lockDuration(6, loginLockThreshold))
+	require.Equal(t, 4*time.Minute, lockDuration(8, loginLockThreshold))
+	require.Equal(t, lockMaxDelay, lockDuration(100, loginLockThreshold))
+	require.Zero(t, lockDuration(10, ipLockThreshold))
+}
+
+func TestLogin_Locked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, nil, Links{})
+
+	req := authmodels.LoginRequest{Login: "ivan", Password: "guess", Client: authmodels.ClientInfo{IP: "10.0.0.1"}}
+
+	// IP заблокирован, пароль даже не проверяется
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "ivan"}).Return(time.Time{}, nil)
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByIP, Key: "10.0.0.1"}).Return(now.Add(time.Minute), nil)
+	repo.EXPECT().GetUserByLogin(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.Login(context.Background(), req)
+	require.ErrorIs(t, err, svcerrors.ErrAccountLocked)
+}
+
+func TestLogin_UnknownUserLocksAfterThreshold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: now}, nil, nil, Links{})
+
+	loginKey := authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByLogin, Key: "ghost"}
+	ipKey := authmodels.LoginAttemptKey{Kind: authmodels.LoginAttemptByIP, Key: "10.0.0.1"}
+	req := authmodels.LoginRequest{Login: "ghost", Password: "guess", Client: authmodels.ClientInfo{IP: "10.0.0.1"}}
+
+	repo.EXPECT().GetLoginLockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil).Times(2)
+	repo.EXPECT().GetUserByLogin(gomock.Any(), "ghost").Return(authmodels.User{}, svcerrors.ErrUserNotFound)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), loginKey, now, now.Add(-loginFailureWindow)).Return(loginLockThreshold+1, nil)
+	repo.EXPECT().LockLogin(gomock.Any(), loginKey, now.Add(time.Minute)).Return(nil)
+	repo.EXPECT().RecordLoginFailure(gomock.Any(), ipKey, now, now.Add(-loginFailureWindow)).Return(3, nil)
+
+	_, err := s.Login(context.Background(), req)
+	require.ErrorIs(t, err, svcerrors.ErrUserNotFound)
+}
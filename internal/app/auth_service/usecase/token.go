@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+// personalTokenPrefix отличает персональные токены от прочих: по нему их
+// находят сканеры утечек, а шлюз не путает их с access-токенами
+const personalTokenPrefix = "vkp_"
+
+// normalizeScopes проверяет области доступа и убирает повторы
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, svcerrors.ErrInvalidScope
+	}
+	seen := make(map[string]struct{}, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !models.IsTokenScope(scope) {
+			return nil, svcerrors.ErrInvalidScope
+		}
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		result = append(result, scope)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (uc *UseCase) CreatePersonalToken(ctx context.Context, req authmodels.CreatePersonalTokenRequest) (*authpb.CreatedPersonalToken, error) {
+	scopes, err := normalizeScopes(req.Scopes)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.CreatePersonalToken")
+	}
+	req.Scopes = scopes
+
+	raw, _, err := newOpaqueToken()
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.CreatePersonalToken: failed to generate token")
+	}
+	token := personalTokenPrefix + raw
+
+	created, err := uc.repo.CreatePersonalToken(ctx, req, hashToken(token))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.CreatePersonalToken")
+	}
+
+	return &authpb.CreatedPersonalToken{
+		Token: token,
+		Info:  PersonalTokenToProto(created),
+	}, nil
+}
+
+func (uc *UseCase) ListPersonalTokens(ctx context.Context, userID int) (*authpb.PersonalTokenList, error) {
+	tokens, err := uc.repo.ListPersonalTokens(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.ListPersonalTokens")
+	}
+	return PersonalTokensToProto(tokens), nil
+}
+
+func (uc *UseCase) RevokePersonalToken(ctx context.Context, userID, tokenID int) error {
+	if err := uc.repo.DeletePersonalToken(ctx, userID, tokenID); err != nil {
+		return pkgerrors.Wrap(err, "auth.RevokePersonalToken")
+	}
+	return nil
+}
+
+// AuthenticatePersonalToken владелец и области доступа предъявленного токена
+func (uc *UseCase) AuthenticatePersonalToken(ctx context.Context, token string) (*authpb.TokenPrincipal, error) {
+	if !strings.HasPrefix(token, personalTokenPrefix) {
+		return nil, svcerrors.ErrTokenInvalid
+	}
+
+	stored, err := uc.repo.GetPersonalToken(ctx, hashToken(token))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "auth.AuthenticatePersonalToken")
+	}
+
+	now := uc.clck.Now()
+	if stored.ExpiresAt != nil && !now.Before(*stored.ExpiresAt) {
+		return nil, svcerrors.ErrTokenExpired
+	}
+
+	// отметка об использовании не должна мешать запросу
+	if err := uc.repo.TouchPersonalToken(ctx, stored.ID, now); err != nil {
+		if log := logger.FromContext(ctx); log != nil {
+			log.Error("Failed to update personal token usage", "error", err, "token_id", stored.ID)
+		}
+	}
+
+	return &authpb.TokenPrincipal{
+		UserId: int32(stored.UserID),
+		Scopes: stored.Scopes,
+	}, nil
+}
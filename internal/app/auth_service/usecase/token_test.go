@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	svcerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/errors"
+	authmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
+	mock "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+var tokenNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestCreatePersonalToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: tokenNow}, nil, nil, Links{})
+
+	expires := tokenNow.Add(30 * 24 * time.Hour)
+	var storedHash string
+	repo.EXPECT().
+		CreatePersonalToken(gomock.Any(), authmodels.CreatePersonalTokenRequest{
+			UserID:    1,
+			Name:      "import",
+			Scopes:    []string{models.ScopeOperationsRead, models.ScopeOperationsWrite},
+			ExpiresAt: &expires,
+		}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, req authmodels.CreatePersonalTokenRequest, hash string) (authmodels.PersonalToken, error) {
+			storedHash = hash
+			return authmodels.PersonalToken{ID: 3, UserID: 1, Name: req.Name, Scopes: req.Scopes, ExpiresAt: req.ExpiresAt, CreatedAt: tokenNow}, nil
+		})
+
+	res, err := s.CreatePersonalToken(context.Background(), authmodels.CreatePersonalTokenRequest{
+		UserID:    1,
+		Name:      "import",
+		Scopes:    []string{models.ScopeOperationsWrite, models.ScopeOperationsRead, models.ScopeOperationsWrite},
+		ExpiresAt: &expires,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(res.Token, personalTokenPrefix))
+	// хранится только хеш
+	require.Equal(t, hashToken(res.Token), storedHash)
+	require.Equal(t, int32(3), res.Info.GetId())
+	require.Equal(t, expires, res.Info.GetExpiresAt().AsTime())
+	require.Nil(t, res.Info.GetLastUsedAt())
+}
+
+func TestCreatePersonalToken_InvalidScope(t *testing.T) {
+	s := NewAuthUseCase(nil, testKeys, clock.FixedClock{FixedTime: tokenNow}, nil, nil, Links{})
+
+	for _, scopes := range [][]string{nil, {"operations:read", "admin"}} {
+		_, err := s.CreatePersonalToken(context.Background(), authmodels.CreatePersonalTokenRequest{UserID: 1, Name: "x", Scopes: scopes})
+		require.ErrorIs(t, err, svcerrors.ErrInvalidScope)
+	}
+}
+
+func TestRevokePersonalToken_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: tokenNow}, nil, nil, Links{})
+
+	repo.EXPECT().DeletePersonalToken(gomock.Any(), 1, 3).Return(svcerrors.ErrPersonalTokenNotFound)
+	require.ErrorIs(t, s.RevokePersonalToken(context.Background(), 1, 3), svcerrors.ErrPersonalTokenNotFound)
+}
+
+func TestAuthenticatePersonalToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: tokenNow}, nil, nil, Links{})
+
+	token := personalTokenPrefix + "abc"
+	repo.EXPECT().GetPersonalToken(gomock.Any(), hashToken(token)).
+		Return(authmodels.PersonalToken{ID: 3, UserID: 1, Scopes: []string{models.ScopeBudgetsRead}}, nil)
+	// сбой отметки об использовании не мешает запросу
+	repo.EXPECT().TouchPersonalToken(gomock.Any(), 3, tokenNow).Return(errors.New("db down"))
+
+	principal, err := s.AuthenticatePersonalToken(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), principal.GetUserId())
+	require.Equal(t, []string{models.ScopeBudgetsRead}, principal.GetScopes())
+}
+
+func TestAuthenticatePersonalToken_Rejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockAuthRepository(ctrl)
+	s := NewAuthUseCase(repo, testKeys, clock.FixedClock{FixedTime: tokenNow}, nil, nil, Links{})
+
+	// без префикса в базу не ходим
+	_, err := s.AuthenticatePersonalToken(context.Background(), "eyJhbGciOi")
+	require.ErrorIs(t, err, svcerrors.ErrTokenInvalid)
+
+	unknown := personalTokenPrefix + "unknown"
+	repo.EXPECT().GetPersonalToken(gomock.Any(), hashToken(unknown)).Return(authmodels.PersonalToken{}, svcerrors.ErrTokenInvalid)
+	_, err = s.AuthenticatePersonalToken(context.Background(), unknown)
+	require.ErrorIs(t, err, svcerrors.ErrTokenInvalid)
+
+	expired := personalTokenPrefix + "expired"
+	repo.EXPECT().GetPersonalToken(gomock.Any(), hashToken(expired)).
+		Return(authmodels.PersonalToken{ID: 4, UserID: 1, ExpiresAt: &tokenNow}, nil)
+	_, err = s.AuthenticatePersonalToken(context.Background(), expired)
+	require.ErrorIs(t, err, svcerrors.ErrTokenExpired)
+}
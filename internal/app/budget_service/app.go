@@ -1,13 +1,17 @@
 package budgservice
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
+	"time"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	config "github.com/go-park-mail-ru/2025_2_VKarmane/cmd/api/app"
 	bdg "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/grpc"
@@ -15,11 +19,14 @@ import (
 	bdgrepo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/repository"
 	bdgsvc "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/service"
 	bdgusecase "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/usecase"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/pkg/interceptors"
 )
 
+const budgetRolloverInterval = time.Hour
+
 func Run() error {
 	config := config.LoadConfig()
 	clock := clock.RealClock{}
@@ -60,7 +67,23 @@ func Run() error {
 		return err
 	}
 	store := bdgrepo.NewPostgresRepository(db)
-	svc := bdgsvc.NewService(store, clock)
+
+	finGrpcConn, err := grpc.NewClient(
+		fmt.Sprintf("%s:%s", config.FinanceServiceHost, config.FinanceServicePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		appLogger.Error("Failed to connect to finance gRPC service", "error", err)
+		return err
+	}
+	defer finGrpcConn.Close()
+	finClient := finpb.NewFinanceServiceClient(finGrpcConn)
+
+	svc := bdgsvc.NewService(store, finClient, clock)
+
+	go svc.RunBudgetRollover(context.Background(), budgetRolloverInterval, func(err error) {
+		appLogger.Error("Failed to roll over recurring budgets", "error", err)
+	})
 
 	uc := bdgusecase.NewBudgetUseCase(svc)
 	bdgService := bdg.NewBudgetServer(uc)
@@ -7,4 +7,7 @@ var (
 	ErrBudgetExists   = errors.New("BUDGET_EXISTS")
 	ErrForbidden      = errors.New("FORBIDDEN")
 	ErrInavlidData    = errors.New("INVALID_DATA")
+
+	ErrGoalNotFound       = errors.New("GOAL_NOT_FOUND")
+	ErrContributionExists = errors.New("CONTRIBUTION_EXISTS")
 )
@@ -13,4 +13,7 @@ var ErrorMap = map[error]struct {
 	ErrBudgetNotFound: {Code: codes.NotFound, Msg: string(models.ErrCodeBudgetNotFound)},
 	ErrForbidden:      {Code: codes.PermissionDenied, Msg: string(models.ErrCodeForbidden)},
 	ErrInavlidData:    {Code: codes.InvalidArgument, Msg: string(models.ErrCodeInvalidData)},
+
+	ErrGoalNotFound:       {Code: codes.NotFound, Msg: string(models.ErrCodeGoalNotFound)},
+	ErrContributionExists: {Code: codes.AlreadyExists, Msg: string(models.ErrCodeContributionExists)},
 }
@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	budgetpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func (s *BudgetServiceServer) CreateGoal(ctx context.Context, req *budgetpb.CreateGoalRequest) (*budgetpb.Goal, error) {
+	goal, err := s.bdgUC.CreateGoal(ctx, ProtoCreateGoalRequestToModel(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to create goal", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to create goal, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return goal, nil
+}
+
+func (s *BudgetServiceServer) GetGoal(ctx context.Context, req *budgetpb.GoalRequest) (*budgetpb.Goal, error) {
+	goal, err := s.bdgUC.GetGoal(ctx, int(req.GoalId), int(req.UserId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get goal", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get goal, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return goal, nil
+}
+
+func (s *BudgetServiceServer) GetGoals(ctx context.Context, req *budgetpb.UserID) (*budgetpb.ListGoalsResponse, error) {
+	goals, err := s.bdgUC.GetGoals(ctx, ProtoIDToInt(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get goals", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get goals, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return goals, nil
+}
+
+func (s *BudgetServiceServer) UpdateGoal(ctx context.Context, req *budgetpb.UpdateGoalRequest) (*budgetpb.Goal, error) {
+	goal, err := s.bdgUC.UpdateGoal(ctx, ProtoUpdateGoalRequestToModel(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to update goal", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to update goal, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return goal, nil
+}
+
+func (s *BudgetServiceServer) DeleteGoal(ctx context.Context, req *budgetpb.GoalRequest) (*budgetpb.Goal, error) {
+	goal, err := s.bdgUC.DeleteGoal(ctx, int(req.GoalId), int(req.UserId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to delete goal", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to delete goal, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return goal, nil
+}
+
+func (s *BudgetServiceServer) AddGoalContribution(ctx context.Context, req *budgetpb.CreateContributionRequest) (*budgetpb.GoalContribution, error) {
+	contribution, err := s.bdgUC.AddGoalContribution(ctx, ProtoContributionRequestToModel(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to add goal contribution", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to add goal contribution, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return contribution, nil
+}
+
+func (s *BudgetServiceServer) GetGoalContributions(ctx context.Context, req *budgetpb.GoalRequest) (*budgetpb.ListContributionsResponse, error) {
+	contributions, err := s.bdgUC.GetGoalContributions(ctx, int(req.GoalId), int(req.UserId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get goal contributions", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get goal contributions, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return contributions, nil
+}
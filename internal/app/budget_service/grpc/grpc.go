@@ -126,3 +126,147 @@ func (s *BudgetServiceServer) DeleteBudget(ctx context.Context, req *budgetpb.Bu
 	}
 	return deletedBdg, nil
 }
+
+func (s *BudgetServiceServer) ExportBudgets(ctx context.Context, req *budgetpb.UserID) (*budgetpb.ListBudgetsResponse, error) {
+	userID := ProtoIDToInt(req)
+	bdgList, err := s.bdgUC.ExportBudgets(ctx, userID)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to export budgets", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to export budgets, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return bdgList, nil
+}
+
+func (s *BudgetServiceServer) ImportBudgets(ctx context.Context, req *budgetpb.ImportBudgetsRequest) (*budgetpb.ImportBudgetsResponse, error) {
+	res, err := s.bdgUC.ImportBudgets(ctx, ProtoImportRequestToModel(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to import budgets", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to import budgets, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *BudgetServiceServer) ReplaceBudgetCategory(ctx context.Context, req *budgetpb.ReplaceCategoryRequest) (*budgetpb.ReplaceCategoryResponse, error) {
+	res, err := s.bdgUC.ReplaceBudgetCategory(ctx, int(req.UserId), int(req.SourceId), int(req.TargetId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to replace budget category", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to replace budget category, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *BudgetServiceServer) DeleteUserData(ctx context.Context, req *budgetpb.UserID) (*budgetpb.UserDataDeletion, error) {
+	userID := ProtoIDToInt(req)
+	res, err := s.bdgUC.DeleteUserData(ctx, userID)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to delete user data", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to delete user data, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *BudgetServiceServer) FilterUsedImages(ctx context.Context, req *budgetpb.ImageIDs) (*budgetpb.ImageIDs, error) {
+	res, err := s.bdgUC.FilterUsedImages(ctx, req.GetIds())
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to filter used images", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to filter used images, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *BudgetServiceServer) GetBudgetHistory(ctx context.Context, req *budgetpb.BudgetRequest) (*budgetpb.ListBudgetsResponse, error) {
+	budgetID, userID := ProtoBudgetReqToInts(req)
+	history, err := s.bdgUC.GetBudgetHistory(ctx, budgetID, userID)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get budget history", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get budget history, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return history, nil
+}
+
+func (s *BudgetServiceServer) GetBudgetForecast(ctx context.Context, req *budgetpb.BudgetRequest) (*budgetpb.BudgetForecast, error) {
+	budgetID, userID := ProtoBudgetReqToInts(req)
+	forecast, err := s.bdgUC.GetBudgetForecast(ctx, budgetID, userID)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range bdgerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get budget forecast", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get budget forecast, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return forecast, nil
+}
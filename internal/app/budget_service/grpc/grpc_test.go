@@ -105,6 +105,42 @@ func TestBudgetServiceServer_DeleteBudgetKnownError(t *testing.T) {
 	require.Equal(t, codes.NotFound, st.Code())
 }
 
+func TestBudgetServiceServer_ReplaceBudgetCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockBudgetUseCase(ctrl)
+	server := NewBudgetServer(uc)
+
+	uc.EXPECT().ReplaceBudgetCategory(gomock.Any(), 1, 5, 7).Return(&bdgpb.ReplaceCategoryResponse{BudgetsUpdated: 2}, nil)
+	resp, err := server.ReplaceBudgetCategory(context.Background(), &bdgpb.ReplaceCategoryRequest{UserId: 1, SourceId: 5, TargetId: 7})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.BudgetsUpdated)
+
+	uc.EXPECT().ReplaceBudgetCategory(gomock.Any(), 1, 5, 5).Return(nil, bdgerrors.ErrInavlidData)
+	_, err = server.ReplaceBudgetCategory(context.Background(), &bdgpb.ReplaceCategoryRequest{UserId: 1, SourceId: 5, TargetId: 5})
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestBudgetServiceServer_DeleteUserData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockBudgetUseCase(ctrl)
+	server := NewBudgetServer(uc)
+
+	uc.EXPECT().DeleteUserData(gomock.Any(), 1).Return(&bdgpb.UserDataDeletion{BudgetsDeleted: 2}, nil)
+	resp, err := server.DeleteUserData(context.Background(), &bdgpb.UserID{UserID: 1})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.BudgetsDeleted)
+
+	uc.EXPECT().DeleteUserData(gomock.Any(), 2).Return(nil, errors.New("db down"))
+	_, err = server.DeleteUserData(context.Background(), &bdgpb.UserID{UserID: 2})
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.Internal, st.Code())
+}
+
 func TestProtoMappers(t *testing.T) {
 	now := time.Now()
 	sum := 50.0
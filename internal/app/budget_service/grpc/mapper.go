@@ -19,6 +19,15 @@ func ProtoBudgetToApi(bdg *budgpb.Budget) budgmodels.Budget {
 		UpdatedAt:   bdg.UpdatedAt.AsTime(),
 		PeriodStart: bdg.PeriodStart.AsTime(),
 		PeriodEnd:   bdg.PeriodEnd.AsTime(),
+		CategoryIDs: protoIDsToInts(bdg.CategoryIds),
+		AccountIDs:  protoIDsToInts(bdg.AccountIds),
+		Recurrence: budgmodels.Recurrence{
+			Period:    bdg.Recurrence,
+			Day:       int(bdg.RecurrenceDay),
+			CarryOver: bdg.CarryOver,
+		},
+		CarriedOver: bdg.CarriedOver,
+		SeriesID:    int(bdg.SeriesId),
 	}
 }
 
@@ -27,11 +36,26 @@ func ProtoCreateRequestToModel(req *budgpb.CreateBudgetRequest) (budgmodels.Crea
 		Amount:      req.Sum,
 		Description: req.Description,
 		CreatedAt:   req.CreatedAt.AsTime(),
-		PeriodStart: req.PeriodEnd.AsTime(),
+		PeriodStart: req.PeriodStart.AsTime(),
 		PeriodEnd:   req.PeriodEnd.AsTime(),
+		CategoryIDs: protoIDsToInts(req.CategoryIds),
+		AccountIDs:  protoIDsToInts(req.AccountIds),
+		Recurrence: budgmodels.Recurrence{
+			Period:    req.Recurrence,
+			Day:       int(req.RecurrenceDay),
+			CarryOver: req.CarryOver,
+		},
 	}, int(req.UserID)
 }
 
+func protoIDsToInts(ids []int32) []int {
+	res := make([]int, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int(id))
+	}
+	return res
+}
+
 func ProtoUpdateRequestToModel(req *budgpb.UpdateBudgetRequest) budgmodels.UpdatedBudgetRequest {
 	var periodStart *time.Time
 	if req.PeriodStart != nil {
@@ -60,3 +84,74 @@ func ProtoIDToInt(id *budgpb.UserID) int {
 func ProtoBudgetReqToInts(req *budgpb.BudgetRequest) (int, int) {
 	return int(req.UserID), int(req.BudgetID)
 }
+
+func ProtoImportRequestToModel(req *budgpb.ImportBudgetsRequest) budgmodels.ImportBudgetsRequest {
+	budgets := make([]budgmodels.Budget, 0, len(req.Budgets))
+	for _, b := range req.Budgets {
+		budget := ProtoBudgetToApi(b)
+		if b.ClosedAt != nil {
+			budget.ClosedAt = b.ClosedAt.AsTime()
+		}
+		budgets = append(budgets, budget)
+	}
+	return budgmodels.ImportBudgetsRequest{
+		UserID:   int(req.UserID),
+		BackupID: req.BackupId,
+		Budgets:  budgets,
+	}
+}
+
+func ProtoCreateGoalRequestToModel(req *budgpb.CreateGoalRequest) budgmodels.CreateGoalRequest {
+	goal := budgmodels.CreateGoalRequest{
+		UserID:       int(req.UserId),
+		Name:         req.Name,
+		Description:  req.Description,
+		TargetAmount: req.Target,
+		CurrencyID:   int(req.CurrencyId),
+		AccountID:    int(req.AccountId),
+		LogoHashedID: req.LogoHashedId,
+	}
+	if req.Deadline != nil {
+		goal.Deadline = req.Deadline.AsTime()
+	}
+	return goal
+}
+
+func ProtoUpdateGoalRequestToModel(req *budgpb.UpdateGoalRequest) budgmodels.UpdateGoalRequest {
+	var deadline *time.Time
+	if req.Deadline != nil {
+		t := req.Deadline.AsTime()
+		deadline = &t
+	}
+	var accountID *int
+	if req.AccountId != nil {
+		id := int(*req.AccountId)
+		accountID = &id
+	}
+	return budgmodels.UpdateGoalRequest{
+		UserID:        int(req.UserId),
+		GoalID:        int(req.GoalId),
+		Name:          req.Name,
+		Description:   req.Description,
+		TargetAmount:  req.Target,
+		Deadline:      deadline,
+		ClearDeadline: req.ClearDeadline,
+		AccountID:     accountID,
+		ClearAccount:  req.ClearAccount,
+		LogoHashedID:  req.LogoHashedId,
+	}
+}
+
+func ProtoContributionRequestToModel(req *budgpb.CreateContributionRequest) budgmodels.CreateContributionRequest {
+	contribution := budgmodels.CreateContributionRequest{
+		UserID:      int(req.UserId),
+		GoalID:      int(req.GoalId),
+		OperationID: int(req.OperationId),
+		Amount:      req.Amount,
+		Note:        req.Note,
+	}
+	if req.ContributedAt != nil {
+		contribution.ContributedAt = req.ContributedAt.AsTime()
+	}
+	return contribution
+}
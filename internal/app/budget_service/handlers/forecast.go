@@ -0,0 +1,64 @@
+package budget
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// GetBudgetForecast godoc
+// @Summary Прогноз расходов по бюджету
+// @Description Прогнозирует расходы на конец текущего периода по среднему дневному темпу и регулярным расходам из истории операций и возвращает ожидаемую дату превышения бюджета
+// @Tags budget
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID открытого бюджета"
+// @Success 200 {object} models.BudgetForecast "Прогноз расходов"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID бюджета (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Бюджет не найден (BUDGET_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /budgets/{id}/forecast [get]
+func (h *Handler) GetBudgetForecast(w http.ResponseWriter, r *http.Request) {
+	id, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Invalid budget ID format", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	forecast, err := h.budgetClient.GetBudgetForecast(r.Context(), IDsToBudgetRequest(id, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc GetBudgetForecast unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get budget forecast")
+			return
+		}
+		switch st.Code() {
+		case codes.NotFound, codes.PermissionDenied:
+			// чужой бюджет не отличается от несуществующего
+			httputils.NotFoundError(w, r, "Бюджет не найден")
+		default:
+			if log != nil {
+				log.Error("grpc GetBudgetForecast error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get budget forecast")
+		}
+		return
+	}
+
+	httputils.Success(w, r, BudgetForecastToAPI(forecast))
+}
@@ -0,0 +1,83 @@
+package budget
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestGetBudgetForecast_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	h := NewHandler(clock.RealClock{}, mockClient)
+
+	exceedDate := time.Date(2030, 6, 26, 0, 0, 0, 0, time.UTC)
+	mockClient.EXPECT().
+		GetBudgetForecast(gomock.Any(), &bdgpb.BudgetRequest{UserID: 1, BudgetID: 5}).
+		Return(&bdgpb.BudgetForecast{
+			BudgetId:   5,
+			Sum:        38000,
+			Actual:     33000,
+			Projected:  39500,
+			DailyPace:  300,
+			ExceedDate: timestamppb.New(exceedDate),
+			Recurring: []*bdgpb.BudgetForecastItem{
+				{Name: "Кино", CategoryId: 10, Sum: 500, Date: timestamppb.New(time.Date(2030, 6, 20, 0, 0, 0, 0, time.UTC))},
+			},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/budgets/5/forecast", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "5"})
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+
+	h.GetBudgetForecast(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.BudgetForecast
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 38000.0, resp.Planned)
+	require.Equal(t, 39500.0, resp.Projected)
+	require.True(t, resp.WillExceed)
+	require.Equal(t, exceedDate, *resp.ExceedDate)
+	require.Len(t, resp.Recurring, 1)
+	require.Equal(t, "Кино", resp.Recurring[0].Name)
+}
+
+func TestGetBudgetForecast_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	h := NewHandler(clock.RealClock{}, mockClient)
+
+	mockClient.EXPECT().
+		GetBudgetForecast(gomock.Any(), &bdgpb.BudgetRequest{UserID: 1, BudgetID: 5}).
+		Return(nil, status.Error(codes.PermissionDenied, "forbidden"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/budgets/5/forecast", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "5"})
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+
+	h.GetBudgetForecast(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
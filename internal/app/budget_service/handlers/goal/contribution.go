@@ -0,0 +1,137 @@
+package goal
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// GetGoalContributions godoc
+// @Summary Взносы в цель
+// @Description Возвращает взносы, учитываемые в прогрессе цели, начиная с последнего. Взносы за счет отмененных операций не возвращаются
+// @Tags goals
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID цели"
+// @Success 200 {object} models.GoalContributionsResponse "Взносы"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID цели (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Цель не найдена (GOAL_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /goals/{id}/contributions [get]
+func (h *Handler) GetGoalContributions(w http.ResponseWriter, r *http.Request) {
+	goalID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID цели", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	contributions, err := h.budgetClient.GetGoalContributions(r.Context(), IDsToGoalRequest(goalID, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc GetGoalContributions unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get goal contributions")
+			return
+		}
+		switch st.Code() {
+		case codes.NotFound:
+			httputils.NotFoundError(w, r, "Цель не найдена")
+		default:
+			if log != nil {
+				log.Error("grpc GetGoalContributions error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get goal contributions")
+		}
+		return
+	}
+
+	httputils.Success(w, r, ContributionsToAPI(contributions))
+}
+
+// AddGoalContribution godoc
+// @Summary Взнос в цель
+// @Description Добавляет взнос в цель без привязанного счета. Взнос за счет операции по умолчанию равен ее сумме и датируется днем операции; отрицательная сумма — снятие накоплений
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID цели"
+// @Param request body models.CreateGoalContributionRequest true "Взнос"
+// @Success 201 {object} models.GoalContribution "Добавленный взнос"
+// @Failure 400 {object} models.ErrorResponse "Некорректный взнос, операция или цель со счетом (INVALID_REQUEST, INVALID_DATA)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Цель не найдена (GOAL_NOT_FOUND)"
+// @Failure 409 {object} models.ErrorResponse "Операция уже учтена во взносах цели (CONTRIBUTION_EXISTS)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /goals/{id}/contributions [post]
+func (h *Handler) AddGoalContribution(w http.ResponseWriter, r *http.Request) {
+	goalID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID цели", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	var req models.CreateGoalContributionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	contribution, err := h.budgetClient.AddGoalContribution(r.Context(), CreateContributionRequestToProto(req, goalID, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc AddGoalContribution unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to add goal contribution")
+			return
+		}
+		switch st.Code() {
+		case codes.InvalidArgument:
+			httputils.Error(w, r, "Некорректные данные цели", http.StatusBadRequest)
+		case codes.NotFound:
+			httputils.NotFoundError(w, r, "Цель не найдена")
+		case codes.AlreadyExists:
+			httputils.ConflictError(w, r, "Операция уже учтена во взносах цели", models.ErrCodeContributionExists)
+		default:
+			if log != nil {
+				log.Error("grpc AddGoalContribution error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to add goal contribution")
+		}
+		return
+	}
+
+	httputils.Created(w, r, ContributionToAPI(contribution))
+}
@@ -0,0 +1,365 @@
+package goal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	image "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/usecase"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+type Handler struct {
+	budgetClient bdgpb.BudgetServiceClient
+	imageUC      image.ImageUseCase
+}
+
+func NewHandler(budgetClient bdgpb.BudgetServiceClient, imageUC image.ImageUseCase) *Handler {
+	return &Handler{budgetClient: budgetClient, imageUC: imageUC}
+}
+
+func (h *Handler) getUserID(r *http.Request) (int, bool) {
+	return middleware.GetUserIDFromContext(r.Context())
+}
+
+func (h *Handler) parseIDFromURL(r *http.Request, paramName string) (int, error) {
+	vars := mux.Vars(r)
+	idStr := vars[paramName]
+	return strconv.Atoi(idStr)
+}
+
+func (h *Handler) enrichGoalWithLogoURL(ctx context.Context, goal *models.Goal) {
+	if goal.LogoHashedID == "" {
+		return
+	}
+	url, err := h.imageUC.GetImageURL(ctx, goal.LogoHashedID)
+	if err != nil {
+		log := logger.FromContext(ctx)
+		if log != nil {
+			log.Error("Failed to get image URL for goal", "goal_id", goal.ID, "image_id", goal.LogoHashedID, "error", err)
+		}
+		return
+	}
+	goal.LogoURL = url
+}
+
+// logoExists проверяет, что картинка цели уже загружена через /images/upload.
+func (h *Handler) logoExists(ctx context.Context, logoHashedID string) (bool, error) {
+	if logoHashedID == "" {
+		return true, nil
+	}
+	return h.imageUC.ImageExists(ctx, logoHashedID)
+}
+
+// GetGoals godoc
+// @Summary Список целей накоплений
+// @Description Возвращает цели пользователя с прогрессом и нужным ежемесячным взносом, начиная с ближайшего срока
+// @Tags goals
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} models.GoalsResponse "Цели пользователя"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /goals [get]
+func (h *Handler) GetGoals(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	goals, err := h.budgetClient.GetGoals(r.Context(), &bdgpb.UserID{UserID: int32(userID)})
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc GetGoals unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get goals")
+			return
+		}
+		switch st.Code() {
+		default:
+			if log != nil {
+				log.Error("grpc GetGoals error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get goals")
+		}
+		return
+	}
+
+	res := GoalsToAPI(goals)
+	for i := range res.Goals {
+		h.enrichGoalWithLogoURL(r.Context(), &res.Goals[i])
+	}
+	httputils.Success(w, r, res)
+}
+
+// CreateGoal godoc
+// @Summary Создание цели накоплений
+// @Description Создает цель. Если указан счет, накоплено столько, сколько лежит на нем, иначе прогресс считается по взносам. Счет должен быть в валюте цели
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.CreateGoalRequest true "Данные цели"
+// @Success 201 {object} models.Goal "Созданная цель"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные, счет или картинка (INVALID_REQUEST, INVALID_DATA)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /goals [post]
+func (h *Handler) CreateGoal(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	var req models.CreateGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	exists, err := h.logoExists(r.Context(), req.LogoHashedID)
+	if err != nil {
+		httputils.InternalError(w, r, "failed to check goal image")
+		return
+	}
+	if !exists {
+		httputils.ValidationError(w, r, "Картинка не найдена", "logo_hashed_id")
+		return
+	}
+
+	goal, err := h.budgetClient.CreateGoal(r.Context(), CreateGoalRequestToProto(req, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc CreateGoal unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to create goal")
+			return
+		}
+		switch st.Code() {
+		case codes.InvalidArgument:
+			httputils.Error(w, r, "Некорректные данные цели", http.StatusBadRequest)
+		default:
+			if log != nil {
+				log.Error("grpc CreateGoal error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to create goal")
+		}
+		return
+	}
+
+	res := GoalToAPI(goal)
+	h.enrichGoalWithLogoURL(r.Context(), &res)
+	httputils.Created(w, r, res)
+}
+
+// GetGoal godoc
+// @Summary Получение цели накоплений
+// @Description Возвращает цель с прогрессом и нужным ежемесячным взносом
+// @Tags goals
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID цели"
+// @Success 200 {object} models.Goal "Цель"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID цели (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Цель не найдена (GOAL_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /goals/{id} [get]
+func (h *Handler) GetGoal(w http.ResponseWriter, r *http.Request) {
+	goalID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID цели", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	goal, err := h.budgetClient.GetGoal(r.Context(), IDsToGoalRequest(goalID, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc GetGoal unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get goal")
+			return
+		}
+		switch st.Code() {
+		case codes.NotFound:
+			httputils.NotFoundError(w, r, "Цель не найдена")
+		default:
+			if log != nil {
+				log.Error("grpc GetGoal error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get goal")
+		}
+		return
+	}
+
+	res := GoalToAPI(goal)
+	h.enrichGoalWithLogoURL(r.Context(), &res)
+	httputils.Success(w, r, res)
+}
+
+// UpdateGoal godoc
+// @Summary Изменение цели накоплений
+// @Description Изменяет переданные поля цели. clear_deadline снимает срок, clear_account переводит цель на ручные взносы
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID цели"
+// @Param request body models.UpdateGoalRequest true "Изменяемые поля"
+// @Success 200 {object} models.Goal "Измененная цель"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные, счет или картинка (INVALID_REQUEST, INVALID_DATA)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Цель не найдена (GOAL_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /goals/{id} [put]
+func (h *Handler) UpdateGoal(w http.ResponseWriter, r *http.Request) {
+	goalID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID цели", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	var req models.UpdateGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	if req.LogoHashedID != nil {
+		exists, err := h.logoExists(r.Context(), *req.LogoHashedID)
+		if err != nil {
+			httputils.InternalError(w, r, "failed to check goal image")
+			return
+		}
+		if !exists {
+			httputils.ValidationError(w, r, "Картинка не найдена", "logo_hashed_id")
+			return
+		}
+	}
+
+	goal, err := h.budgetClient.UpdateGoal(r.Context(), UpdateGoalRequestToProto(req, goalID, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc UpdateGoal unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to update goal")
+			return
+		}
+		switch st.Code() {
+		case codes.InvalidArgument:
+			httputils.Error(w, r, "Некорректные данные цели", http.StatusBadRequest)
+		case codes.NotFound:
+			httputils.NotFoundError(w, r, "Цель не найдена")
+		default:
+			if log != nil {
+				log.Error("grpc UpdateGoal error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to update goal")
+		}
+		return
+	}
+
+	res := GoalToAPI(goal)
+	h.enrichGoalWithLogoURL(r.Context(), &res)
+	httputils.Success(w, r, res)
+}
+
+// DeleteGoal godoc
+// @Summary Удаление цели накоплений
+// @Description Удаляет цель вместе со всеми взносами
+// @Tags goals
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID цели"
+// @Success 200 {object} models.Goal "Удаленная цель"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID цели (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Цель не найдена (GOAL_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /goals/{id} [delete]
+func (h *Handler) DeleteGoal(w http.ResponseWriter, r *http.Request) {
+	goalID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID цели", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	goal, err := h.budgetClient.DeleteGoal(r.Context(), IDsToGoalRequest(goalID, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc DeleteGoal unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to delete goal")
+			return
+		}
+		switch st.Code() {
+		case codes.NotFound:
+			httputils.NotFoundError(w, r, "Цель не найдена")
+		default:
+			if log != nil {
+				log.Error("grpc DeleteGoal error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to delete goal")
+		}
+		return
+	}
+
+	httputils.Success(w, r, GoalToAPI(goal))
+}
@@ -0,0 +1,221 @@
+package goal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func newGoalRequest(method, url string, body any, vars map[string]string) *http.Request {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+	req := httptest.NewRequest(method, url, &buf)
+	if vars != nil {
+		req = mux.SetURLVars(req, vars)
+	}
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestGetGoals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	mockImage := mocks.NewMockImageUseCase(ctrl)
+	h := NewHandler(mockClient, mockImage)
+
+	deadline := time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC)
+	mockClient.EXPECT().
+		GetGoals(gomock.Any(), &bdgpb.UserID{UserID: 1}).
+		Return(&bdgpb.ListGoalsResponse{Goals: []*bdgpb.Goal{
+			{Id: 1, Name: "Отпуск", Target: 100000, Saved: 30000, Remaining: 70000, MonthsLeft: 7, MonthlyContribution: 10000, Deadline: timestamppb.New(deadline), LogoHashedId: "img"},
+			{Id: 2, Name: "Подушка", Target: 300000, AccountId: 7},
+		}}, nil)
+	mockImage.EXPECT().GetImageURL(gomock.Any(), "img").Return("https://cdn/img", nil)
+
+	rr := httptest.NewRecorder()
+	h.GetGoals(rr, newGoalRequest(http.MethodGet, "/api/v1/goals", nil, nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.GoalsResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Goals, 2)
+	require.Equal(t, "https://cdn/img", resp.Goals[0].LogoURL)
+	require.Equal(t, deadline, *resp.Goals[0].Deadline)
+	require.Equal(t, 10000.0, resp.Goals[0].MonthlyContribution)
+	require.Nil(t, resp.Goals[1].Deadline)
+	require.Equal(t, 7, resp.Goals[1].AccountID)
+}
+
+func TestCreateGoal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	mockImage := mocks.NewMockImageUseCase(ctrl)
+	h := NewHandler(mockClient, mockImage)
+
+	mockClient.EXPECT().
+		CreateGoal(gomock.Any(), &bdgpb.CreateGoalRequest{UserId: 1, Name: "Отпуск", Target: 100000, CurrencyId: 1}).
+		Return(&bdgpb.Goal{Id: 3, Name: "Отпуск", Target: 100000, CurrencyId: 1, Remaining: 100000}, nil)
+
+	rr := httptest.NewRecorder()
+	h.CreateGoal(rr, newGoalRequest(http.MethodPost, "/api/v1/goals", models.CreateGoalRequest{
+		Name:         "Отпуск",
+		TargetAmount: 100000,
+		CurrencyID:   1,
+	}, nil))
+	require.Equal(t, http.StatusCreated, rr.Code)
+}
+
+func TestCreateGoal_Validation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	mockImage := mocks.NewMockImageUseCase(ctrl)
+	h := NewHandler(mockClient, mockImage)
+
+	rr := httptest.NewRecorder()
+	h.CreateGoal(rr, newGoalRequest(http.MethodPost, "/api/v1/goals", models.CreateGoalRequest{Name: "Отпуск", CurrencyID: 1}, nil))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	mockImage.EXPECT().ImageExists(gomock.Any(), "missing").Return(false, nil)
+	rr = httptest.NewRecorder()
+	h.CreateGoal(rr, newGoalRequest(http.MethodPost, "/api/v1/goals", models.CreateGoalRequest{
+		Name:         "Отпуск",
+		TargetAmount: 1000,
+		CurrencyID:   1,
+		LogoHashedID: "missing",
+	}, nil))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	mockClient.EXPECT().CreateGoal(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "INVALID_DATA"))
+	rr = httptest.NewRecorder()
+	h.CreateGoal(rr, newGoalRequest(http.MethodPost, "/api/v1/goals", models.CreateGoalRequest{
+		Name:         "Отпуск",
+		TargetAmount: 1000,
+		CurrencyID:   1,
+		AccountID:    9,
+	}, nil))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestUpdateGoal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl))
+
+	target := 150000.0
+	mockClient.EXPECT().
+		UpdateGoal(gomock.Any(), &bdgpb.UpdateGoalRequest{UserId: 1, GoalId: 3, Target: &target, ClearAccount: true}).
+		Return(&bdgpb.Goal{Id: 3, Target: target}, nil)
+
+	rr := httptest.NewRecorder()
+	h.UpdateGoal(rr, newGoalRequest(http.MethodPut, "/api/v1/goals/3", models.UpdateGoalRequest{
+		TargetAmount: &target,
+		ClearAccount: true,
+	}, map[string]string{"id": "3"}))
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestGetGoal_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl))
+
+	mockClient.EXPECT().
+		GetGoal(gomock.Any(), &bdgpb.GoalRequest{UserId: 1, GoalId: 3}).
+		Return(nil, status.Error(codes.NotFound, "GOAL_NOT_FOUND"))
+
+	rr := httptest.NewRecorder()
+	h.GetGoal(rr, newGoalRequest(http.MethodGet, "/api/v1/goals/3", nil, map[string]string{"id": "3"}))
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestDeleteGoal_InvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewHandler(mocks.NewMockBudgetServiceClient(ctrl), mocks.NewMockImageUseCase(ctrl))
+
+	rr := httptest.NewRecorder()
+	h.DeleteGoal(rr, newGoalRequest(http.MethodDelete, "/api/v1/goals/abc", nil, map[string]string{"id": "abc"}))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestAddGoalContribution(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl))
+
+	date := time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC)
+	mockClient.EXPECT().
+		AddGoalContribution(gomock.Any(), &bdgpb.CreateContributionRequest{UserId: 1, GoalId: 3, OperationId: 40}).
+		Return(&bdgpb.GoalContribution{Id: 10, GoalId: 3, OperationId: 40, Amount: 5000, ContributedAt: timestamppb.New(date)}, nil)
+	mockClient.EXPECT().
+		AddGoalContribution(gomock.Any(), &bdgpb.CreateContributionRequest{UserId: 1, GoalId: 3, OperationId: 40}).
+		Return(nil, status.Error(codes.AlreadyExists, "CONTRIBUTION_EXISTS"))
+
+	rr := httptest.NewRecorder()
+	h.AddGoalContribution(rr, newGoalRequest(http.MethodPost, "/api/v1/goals/3/contributions",
+		models.CreateGoalContributionRequest{OperationID: 40}, map[string]string{"id": "3"}))
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	var resp models.GoalContribution
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 5000.0, resp.Amount)
+	require.Equal(t, date, resp.ContributedAt)
+
+	rr = httptest.NewRecorder()
+	h.AddGoalContribution(rr, newGoalRequest(http.MethodPost, "/api/v1/goals/3/contributions",
+		models.CreateGoalContributionRequest{OperationID: 40}, map[string]string{"id": "3"}))
+	require.Equal(t, http.StatusConflict, rr.Code)
+}
+
+func TestGetGoalContributions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl))
+
+	mockClient.EXPECT().
+		GetGoalContributions(gomock.Any(), &bdgpb.GoalRequest{UserId: 1, GoalId: 3}).
+		Return(&bdgpb.ListContributionsResponse{Contributions: []*bdgpb.GoalContribution{
+			{Id: 10, GoalId: 3, Amount: 1500, Note: "зарплата", ContributedAt: timestamppb.Now(), CreatedAt: timestamppb.Now()},
+		}}, nil)
+
+	rr := httptest.NewRecorder()
+	h.GetGoalContributions(rr, newGoalRequest(http.MethodGet, "/api/v1/goals/3/contributions", nil, map[string]string{"id": "3"}))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.GoalContributionsResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Contributions, 1)
+	require.Equal(t, "зарплата", resp.Contributions[0].Note)
+}
@@ -0,0 +1,125 @@
+package goal
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func GoalToAPI(g *bdgpb.Goal) models.Goal {
+	goal := models.Goal{
+		ID:                  int(g.Id),
+		Name:                g.Name,
+		Description:         g.Description,
+		TargetAmount:        g.Target,
+		CurrencyID:          int(g.CurrencyId),
+		AccountID:           int(g.AccountId),
+		LogoHashedID:        g.LogoHashedId,
+		Saved:               g.Saved,
+		Remaining:           g.Remaining,
+		Percent:             g.Percent,
+		Achieved:            g.Achieved,
+		Overdue:             g.Overdue,
+		MonthsLeft:          int(g.MonthsLeft),
+		MonthlyContribution: g.MonthlyContribution,
+		CreatedAt:           g.CreatedAt.AsTime(),
+		UpdatedAt:           g.UpdatedAt.AsTime(),
+	}
+	if g.Deadline != nil {
+		deadline := g.Deadline.AsTime()
+		goal.Deadline = &deadline
+	}
+	return goal
+}
+
+func GoalsToAPI(goals *bdgpb.ListGoalsResponse) models.GoalsResponse {
+	res := models.GoalsResponse{Goals: make([]models.Goal, 0, len(goals.Goals))}
+	for _, g := range goals.Goals {
+		res.Goals = append(res.Goals, GoalToAPI(g))
+	}
+	return res
+}
+
+func CreateGoalRequestToProto(req models.CreateGoalRequest, userID int) *bdgpb.CreateGoalRequest {
+	var deadline *timestamppb.Timestamp
+	if req.Deadline != nil {
+		deadline = timestamppb.New(*req.Deadline)
+	}
+	return &bdgpb.CreateGoalRequest{
+		UserId:       int32(userID),
+		Name:         req.Name,
+		Description:  req.Description,
+		Target:       req.TargetAmount,
+		CurrencyId:   int32(req.CurrencyID),
+		Deadline:     deadline,
+		AccountId:    int32(req.AccountID),
+		LogoHashedId: req.LogoHashedID,
+	}
+}
+
+func UpdateGoalRequestToProto(req models.UpdateGoalRequest, goalID, userID int) *bdgpb.UpdateGoalRequest {
+	var deadline *timestamppb.Timestamp
+	if req.Deadline != nil {
+		deadline = timestamppb.New(*req.Deadline)
+	}
+	var accountID *int32
+	if req.AccountID != nil {
+		id := int32(*req.AccountID)
+		accountID = &id
+	}
+	return &bdgpb.UpdateGoalRequest{
+		UserId:        int32(userID),
+		GoalId:        int32(goalID),
+		Name:          req.Name,
+		Description:   req.Description,
+		Target:        req.TargetAmount,
+		Deadline:      deadline,
+		ClearDeadline: req.ClearDeadline,
+		AccountId:     accountID,
+		ClearAccount:  req.ClearAccount,
+		LogoHashedId:  req.LogoHashedID,
+	}
+}
+
+func IDsToGoalRequest(goalID, userID int) *bdgpb.GoalRequest {
+	return &bdgpb.GoalRequest{
+		UserId: int32(userID),
+		GoalId: int32(goalID),
+	}
+}
+
+func ContributionToAPI(c *bdgpb.GoalContribution) models.GoalContribution {
+	return models.GoalContribution{
+		ID:            int(c.Id),
+		GoalID:        int(c.GoalId),
+		OperationID:   int(c.OperationId),
+		Amount:        c.Amount,
+		Note:          c.Note,
+		ContributedAt: c.ContributedAt.AsTime(),
+		CreatedAt:     c.CreatedAt.AsTime(),
+	}
+}
+
+func ContributionsToAPI(list *bdgpb.ListContributionsResponse) models.GoalContributionsResponse {
+	res := models.GoalContributionsResponse{Contributions: make([]models.GoalContribution, 0, len(list.Contributions))}
+	for _, c := range list.Contributions {
+		res.Contributions = append(res.Contributions, ContributionToAPI(c))
+	}
+	return res
+}
+
+func CreateContributionRequestToProto(req models.CreateGoalContributionRequest, goalID, userID int) *bdgpb.CreateContributionRequest {
+	var contributedAt *timestamppb.Timestamp
+	if req.ContributedAt != nil {
+		contributedAt = timestamppb.New(*req.ContributedAt)
+	}
+	return &bdgpb.CreateContributionRequest{
+		UserId:        int32(userID),
+		GoalId:        int32(goalID),
+		OperationId:   int32(req.OperationID),
+		Amount:        req.Amount,
+		Note:          req.Note,
+		ContributedAt: contributedAt,
+	}
+}
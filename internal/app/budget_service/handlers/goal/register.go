@@ -0,0 +1,22 @@
+package goal
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	image "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/usecase"
+)
+
+func Register(r *mux.Router, budgetClient bdgpb.BudgetServiceClient, imageUC image.ImageUseCase) {
+	h := NewHandler(budgetClient, imageUC)
+
+	r.HandleFunc("/goals", h.GetGoals).Methods(http.MethodGet)
+	r.HandleFunc("/goals", h.CreateGoal).Methods(http.MethodPost)
+	r.HandleFunc("/goals/{id}", h.GetGoal).Methods(http.MethodGet)
+	r.HandleFunc("/goals/{id}", h.UpdateGoal).Methods(http.MethodPut)
+	r.HandleFunc("/goals/{id}", h.DeleteGoal).Methods(http.MethodDelete)
+	r.HandleFunc("/goals/{id}/contributions", h.GetGoalContributions).Methods(http.MethodGet)
+	r.HandleFunc("/goals/{id}/contributions", h.AddGoalContribution).Methods(http.MethodPost)
+}
@@ -0,0 +1,63 @@
+package budget
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// GetBudgetHistory godoc
+// @Summary История периодов бюджета
+// @Description Возвращает все периоды повторяющегося бюджета, начиная с текущего, с плановой и фактической суммой
+// @Tags budget
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID бюджета любого периода серии"
+// @Success 200 {object} models.BudgetHistoryResponse "Периоды бюджета"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID бюджета (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Бюджет не найден (BUDGET_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /budgets/{id}/history [get]
+func (h *Handler) GetBudgetHistory(w http.ResponseWriter, r *http.Request) {
+	id, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Invalid budget ID format", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	history, err := h.budgetClient.GetBudgetHistory(r.Context(), IDsToBudgetRequest(id, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc GetBudgetHistory unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get budget history")
+			return
+		}
+		switch st.Code() {
+		case codes.NotFound:
+			httputils.NotFoundError(w, r, "Бюджет не найден")
+		default:
+			if log != nil {
+				log.Error("grpc GetBudgetHistory error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to get budget history")
+		}
+		return
+	}
+
+	httputils.Success(w, r, BudgetHistoryToAPI(history))
+}
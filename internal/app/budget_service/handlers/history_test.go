@@ -0,0 +1,77 @@
+package budget
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestGetBudgetHistory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	h := NewHandler(clock.RealClock{}, mockClient)
+
+	closedAt := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockClient.EXPECT().
+		GetBudgetHistory(gomock.Any(), &bdgpb.BudgetRequest{UserID: 1, BudgetID: 8}).
+		Return(&bdgpb.ListBudgetsResponse{Budgets: []*bdgpb.Budget{
+			{Id: 8, Sum: 130, Actual: 20, CarriedOver: 30},
+			{Id: 5, Sum: 100, Actual: 70, ClosedAt: timestamppb.New(closedAt)},
+		}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/budgets/8/history", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "8"})
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+
+	h.GetBudgetHistory(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.BudgetHistoryResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Periods, 2)
+	require.Equal(t, 110.0, resp.Periods[0].Remaining)
+	require.Equal(t, 30.0, resp.Periods[0].CarriedOver)
+	require.Nil(t, resp.Periods[0].ClosedAt)
+	require.Equal(t, 100.0, resp.Periods[1].Planned)
+	require.Equal(t, 70.0, resp.Periods[1].Actual)
+	require.Equal(t, closedAt, *resp.Periods[1].ClosedAt)
+}
+
+func TestGetBudgetHistory_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockBudgetServiceClient(ctrl)
+	h := NewHandler(clock.RealClock{}, mockClient)
+
+	mockClient.EXPECT().
+		GetBudgetHistory(gomock.Any(), &bdgpb.BudgetRequest{UserID: 1, BudgetID: 8}).
+		Return(nil, status.Error(codes.NotFound, "not found"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/budgets/8/history", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "8"})
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+
+	h.GetBudgetHistory(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
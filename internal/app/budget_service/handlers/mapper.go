@@ -1,23 +1,91 @@
 package budget
 
 import (
+	"math"
+
 	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func BudgetToAPI(bdg *bdgpb.Budget) models.Budget {
+	var categories []models.BudgetCategoryProgress
+	for _, c := range bdg.Categories {
+		categories = append(categories, models.BudgetCategoryProgress{
+			CategoryID: int(c.CategoryId),
+			Actual:     c.Actual,
+		})
+	}
 	return models.Budget{
-		ID:          int(bdg.Id),
-		UserID:      int(bdg.UserId),
-		CurrencyID:  int(bdg.CurrencyId),
-		Amount:      bdg.Sum,
-		Description: bdg.Description,
-		CreatedAt:   bdg.CreatedAt.AsTime(),
-		UpdatedAt:   bdg.UpdatedAt.AsTime(),
-		PeriodStart: bdg.PeriodStart.AsTime(),
-		PeriodEnd:   bdg.PeriodEnd.AsTime(),
+		ID:            int(bdg.Id),
+		UserID:        int(bdg.UserId),
+		CurrencyID:    int(bdg.CurrencyId),
+		Amount:        bdg.Sum,
+		Actual:        bdg.Actual,
+		Description:   bdg.Description,
+		CreatedAt:     bdg.CreatedAt.AsTime(),
+		UpdatedAt:     bdg.UpdatedAt.AsTime(),
+		PeriodStart:   bdg.PeriodStart.AsTime(),
+		PeriodEnd:     bdg.PeriodEnd.AsTime(),
+		CategoryIDs:   int32sToInts(bdg.CategoryIds),
+		AccountIDs:    int32sToInts(bdg.AccountIds),
+		Categories:    categories,
+		Recurrence:    bdg.Recurrence,
+		RecurrenceDay: int(bdg.RecurrenceDay),
+		CarryOver:     bdg.CarryOver,
+		CarriedOver:   bdg.CarriedOver,
+		SeriesID:      int(bdg.SeriesId),
+	}
+}
+
+func BudgetHistoryToAPI(history *bdgpb.ListBudgetsResponse) models.BudgetHistoryResponse {
+	periods := make([]models.BudgetPeriod, 0, len(history.Budgets))
+	for _, b := range history.Budgets {
+		period := models.BudgetPeriod{
+			BudgetID:    int(b.Id),
+			PeriodStart: b.PeriodStart.AsTime(),
+			PeriodEnd:   b.PeriodEnd.AsTime(),
+			Planned:     b.Sum,
+			Actual:      b.Actual,
+			Remaining:   math.Round((b.Sum-b.Actual)*100) / 100,
+			CarriedOver: b.CarriedOver,
+		}
+		if b.ClosedAt != nil {
+			closedAt := b.ClosedAt.AsTime()
+			period.ClosedAt = &closedAt
+		}
+		periods = append(periods, period)
 	}
+	return models.BudgetHistoryResponse{Periods: periods}
+}
+
+func BudgetForecastToAPI(f *bdgpb.BudgetForecast) models.BudgetForecast {
+	recurring := make([]models.BudgetForecastItem, 0, len(f.Recurring))
+	for _, item := range f.Recurring {
+		recurring = append(recurring, models.BudgetForecastItem{
+			Name:       item.Name,
+			CategoryID: int(item.CategoryId),
+			Sum:        item.Sum,
+			Date:       item.Date.AsTime(),
+		})
+	}
+	forecast := models.BudgetForecast{
+		BudgetID:    int(f.BudgetId),
+		PeriodStart: f.PeriodStart.AsTime(),
+		PeriodEnd:   f.PeriodEnd.AsTime(),
+		AsOf:        f.AsOf.AsTime(),
+		Planned:     f.Sum,
+		Actual:      f.Actual,
+		Projected:   f.Projected,
+		DailyPace:   f.DailyPace,
+		Recurring:   recurring,
+	}
+	if f.ExceedDate != nil {
+		exceedDate := f.ExceedDate.AsTime()
+		forecast.WillExceed = true
+		forecast.ExceedDate = &exceedDate
+	}
+	return forecast
 }
 
 func BudgetsToAPI(userID int, bdgs *bdgpb.ListBudgetsResponse) []models.Budget {
@@ -37,12 +105,17 @@ func IDsToBudgetRequest(budgetID, userID int) *bdgpb.BudgetRequest {
 
 func ModelCreateReqtoProtoReq(req models.CreateBudgetRequest, userID int) *bdgpb.CreateBudgetRequest {
 	return &bdgpb.CreateBudgetRequest{
-		UserID:      int32(userID),
-		Sum:         req.Amount,
-		Description: req.Description,
-		CreatedAt:   timestamppb.New(req.CreatedAt),
-		PeriodStart: timestamppb.New(req.PeriodStart),
-		PeriodEnd:   timestamppb.New(req.PeriodEnd),
+		UserID:        int32(userID),
+		Sum:           req.Amount,
+		Description:   req.Description,
+		CreatedAt:     timestamppb.New(req.CreatedAt),
+		PeriodStart:   timestamppb.New(req.PeriodStart),
+		PeriodEnd:     timestamppb.New(req.PeriodEnd),
+		CategoryIds:   intsToInt32s(req.CategoryIDs),
+		AccountIds:    intsToInt32s(req.AccountIDs),
+		Recurrence:    req.Recurrence,
+		RecurrenceDay: int32(req.RecurrenceDay),
+		CarryOver:     req.CarryOver,
 	}
 }
 
@@ -66,3 +139,22 @@ func ModelUpdateReqtoProtoReq(req models.UpdatedBudgetRequest, budgetID, userID
 		PeriodEnd:   periodEnd,
 	}
 }
+
+func int32sToInts(ids []int32) []int {
+	if len(ids) == 0 {
+		return nil
+	}
+	res := make([]int, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int(id))
+	}
+	return res
+}
+
+func intsToInt32s(ids []int) []int32 {
+	res := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int32(id))
+	}
+	return res
+}
@@ -24,11 +24,14 @@ func TestBudgetToAPI(t *testing.T) {
 		UserId:      10,
 		CurrencyId:  30,
 		Sum:         100.50,
+		Actual:      40,
 		Description: "Test budget",
 		CreatedAt:   timestamppb.New(createdAt),
 		UpdatedAt:   timestamppb.New(updatedAt),
 		PeriodStart: timestamppb.New(periodStart),
 		PeriodEnd:   timestamppb.New(periodEnd),
+		CategoryIds: []int32{3},
+		Categories:  []*bdgpb.BudgetCategoryProgress{{CategoryId: 3, Actual: 40}},
 	}
 
 	apiBdg := BudgetToAPI(protoBdg)
@@ -37,6 +40,10 @@ func TestBudgetToAPI(t *testing.T) {
 	assert.Equal(t, 10, apiBdg.UserID)
 	assert.Equal(t, 30, apiBdg.CurrencyID)
 	assert.Equal(t, 100.50, apiBdg.Amount)
+	assert.Equal(t, 40.0, apiBdg.Actual)
+	assert.Equal(t, []int{3}, apiBdg.CategoryIDs)
+	assert.Nil(t, apiBdg.AccountIDs)
+	assert.Equal(t, []models.BudgetCategoryProgress{{CategoryID: 3, Actual: 40}}, apiBdg.Categories)
 	assert.Equal(t, "Test budget", apiBdg.Description)
 	assert.Equal(t, createdAt, apiBdg.CreatedAt)
 	assert.Equal(t, updatedAt, apiBdg.UpdatedAt)
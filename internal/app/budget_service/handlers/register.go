@@ -18,5 +18,7 @@ func Register(r *mux.Router, budgetClient bdgpb.BudgetServiceClient) {
 	r.HandleFunc("/budgets/{id}", handler.GetBudgetByID).Methods(http.MethodGet)
 	r.HandleFunc("/budgets/{id}", handler.UpdateBudget).Methods(http.MethodPut)
 	r.HandleFunc("/budgets/{id}", handler.DeleteBudget).Methods(http.MethodDelete)
+	r.HandleFunc("/budgets/{id}/history", handler.GetBudgetHistory).Methods(http.MethodGet)
+	r.HandleFunc("/budgets/{id}/forecast", handler.GetBudgetForecast).Methods(http.MethodGet)
 
 }
@@ -16,15 +16,58 @@ type Budget struct {
 	ClosedAt    time.Time `json:"closed_at"`
 	PeriodStart time.Time `json:"period_start"`
 	PeriodEnd   time.Time `json:"period_end"`
+	// Пустые CategoryIDs и AccountIDs — бюджет по всем категориям и счетам
+	CategoryIDs []int              `json:"category_ids"`
+	AccountIDs  []int              `json:"account_ids"`
+	Categories  []CategoryProgress `json:"categories"`
+	Recurrence
+	// CarriedOver часть Amount, перенесенная из остатка предыдущего периода
+	CarriedOver float64 `json:"carried_over"`
+	// SeriesID первый бюджет серии повторяющихся периодов
+	SeriesID int `json:"series_id"`
+}
+
+const (
+	RecurrenceNone    = "none"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+)
+
+// Recurrence настройка повторения бюджета.
+// Day — день недели (1 — понедельник, 7 — воскресенье) для weekly
+// и число месяца для monthly; в коротких месяцах используется последний день.
+type Recurrence struct {
+	Period    string `json:"recurrence"`
+	Day       int    `json:"recurrence_day"`
+	CarryOver bool   `json:"carry_over"`
+}
+
+func (r Recurrence) IsRecurring() bool {
+	return r.Period == RecurrenceWeekly || r.Period == RecurrenceMonthly
+}
+
+// CategoryProgress расходы по категории бюджета вместе с ее подкатегориями.
+type CategoryProgress struct {
+	CategoryID int     `json:"category_id"`
+	Actual     float64 `json:"actual"`
+}
+
+// BudgetSpending фактические расходы по операциям, попадающим в бюджет.
+// Считаются в finance_service.
+type BudgetSpending struct {
+	Actual     float64
+	ByCategory map[int]float64
 }
 
 type CreateBudgetRequest struct {
-	CategoryID  int       `json:"category_id"`
+	CategoryIDs []int     `json:"category_ids"`
+	AccountIDs  []int     `json:"account_ids"`
 	Amount      float64   `json:"sum" validate:"min=0"`
 	Description string    `json:"description,omitempty" validate:"max=80"`
 	CreatedAt   time.Time `json:"created_at"`
 	PeriodStart time.Time `json:"period_start"`
 	PeriodEnd   time.Time `json:"period_end"`
+	Recurrence
 }
 
 type UpdatedBudgetRequest struct {
@@ -35,3 +78,15 @@ type UpdatedBudgetRequest struct {
 	PeriodStart *time.Time `json:"period_start,omitempty"`
 	PeriodEnd   *time.Time `json:"period_end,omitempty"`
 }
+
+// UserDataDeletion сколько бюджетов и целей удалено вместе с аккаунтом
+type UserDataDeletion struct {
+	BudgetsDeleted int
+	GoalsDeleted   int
+}
+
+type ImportBudgetsRequest struct {
+	UserID   int
+	BackupID string
+	Budgets  []Budget
+}
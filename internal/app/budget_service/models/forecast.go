@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// DailySpending расходы бюджета за один день.
+type DailySpending struct {
+	Date time.Time
+	Sum  float64
+}
+
+// RecurringExpense регулярный расход из истории операций пользователя.
+type RecurringExpense struct {
+	Name       string
+	CategoryID int
+	AvgSum     float64
+	DayOfMonth int
+	LastDate   time.Time
+	LastSum    float64
+}
+
+// ForecastItem регулярный расход, ожидаемый до конца периода бюджета.
+type ForecastItem struct {
+	Name       string
+	CategoryID int
+	Sum        float64
+	Date       time.Time
+}
+
+// Forecast прогноз расходов бюджета на конец текущего периода на дату AsOf.
+// Нулевой ExceedDate — бюджет не будет превышен до конца периода.
+type Forecast struct {
+	BudgetID    int
+	Amount      float64
+	Actual      float64
+	Projected   float64
+	DailyPace   float64
+	ExceedDate  time.Time
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	AsOf        time.Time
+	Recurring   []ForecastItem
+}
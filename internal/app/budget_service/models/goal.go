@@ -0,0 +1,89 @@
+package models
+
+import "time"
+
+// Goal цель накоплений. Если задан AccountID, накоплено столько, сколько лежит на счете
+// (ничего, если пользователь потерял доступ к счету), иначе — сумма взносов.
+// Нулевой Deadline — цель без срока.
+type Goal struct {
+	ID           int
+	UserID       int
+	Name         string
+	Description  string
+	TargetAmount float64
+	CurrencyID   int
+	Deadline     time.Time
+	AccountID    int
+	LogoHashedID string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Saved        float64
+}
+
+// GoalProgress прогресс цели на дату AsOf.
+// MonthsLeft — сколько ежемесячных взносов осталось до срока, включая текущий месяц;
+// MonthlyContribution — какой взнос нужен каждый месяц, чтобы успеть к сроку.
+type GoalProgress struct {
+	Goal
+	Remaining           float64
+	Percent             float64
+	Achieved            bool
+	Overdue             bool
+	MonthsLeft          int
+	MonthlyContribution float64
+}
+
+// GoalContribution взнос в цель. OperationID — операция, за счет которой сделан взнос.
+type GoalContribution struct {
+	ID            int
+	GoalID        int
+	OperationID   int
+	Amount        float64
+	Note          string
+	ContributedAt time.Time
+	CreatedAt     time.Time
+}
+
+type CreateGoalRequest struct {
+	UserID       int
+	Name         string
+	Description  string
+	TargetAmount float64
+	CurrencyID   int
+	Deadline     time.Time
+	AccountID    int
+	LogoHashedID string
+}
+
+// UpdateGoalRequest изменяет только заданные поля.
+// ClearDeadline и ClearAccount снимают срок и отвязывают счет.
+type UpdateGoalRequest struct {
+	UserID        int
+	GoalID        int
+	Name          *string
+	Description   *string
+	TargetAmount  *float64
+	Deadline      *time.Time
+	ClearDeadline bool
+	AccountID     *int
+	ClearAccount  bool
+	LogoHashedID  *string
+}
+
+type CreateContributionRequest struct {
+	UserID        int
+	GoalID        int
+	OperationID   int
+	Amount        float64
+	Note          string
+	ContributedAt time.Time
+}
+
+// ContributionOperation операция пользователя, за счет которой делается взнос.
+// Нулевой CurrencyID — операция без валюты.
+type ContributionOperation struct {
+	ID         int
+	Sum        float64
+	CurrencyID int
+	Date       time.Time
+}
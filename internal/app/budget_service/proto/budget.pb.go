@@ -23,18 +23,31 @@ const (
 )
 
 type Budget struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Sum           float64                `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
-	Actual        float64                `protobuf:"fixed64,4,opt,name=actual,proto3" json:"actual,omitempty"`
-	CurrencyId    int32                  `protobuf:"varint,5,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
-	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
-	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sum         float64                `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Actual      float64                `protobuf:"fixed64,4,opt,name=actual,proto3" json:"actual,omitempty"`
+	CurrencyId  int32                  `protobuf:"varint,5,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Description string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ClosedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// empty means the budget covers all categories (accounts) of the user
+	CategoryIds []int32                   `protobuf:"varint,12,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	AccountIds  []int32                   `protobuf:"varint,13,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Categories  []*BudgetCategoryProgress `protobuf:"bytes,14,rep,name=categories,proto3" json:"categories,omitempty"`
+	// none, weekly or monthly
+	Recurrence string `protobuf:"bytes,15,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// weekday (1 is Monday) for weekly budgets, day of month for monthly ones
+	RecurrenceDay int32 `protobuf:"varint,16,opt,name=recurrence_day,json=recurrenceDay,proto3" json:"recurrence_day,omitempty"`
+	CarryOver     bool  `protobuf:"varint,17,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`
+	// part of sum carried over from the unspent rest of the previous period
+	CarriedOver float64 `protobuf:"fixed64,18,opt,name=carried_over,json=carriedOver,proto3" json:"carried_over,omitempty"`
+	// id of the first budget of the recurring series
+	SeriesId      int32 `protobuf:"varint,19,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -146,6 +159,115 @@ func (x *Budget) GetPeriodEnd() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Budget) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *Budget) GetAccountIds() []int32 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *Budget) GetCategories() []*BudgetCategoryProgress {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Budget) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *Budget) GetRecurrenceDay() int32 {
+	if x != nil {
+		return x.RecurrenceDay
+	}
+	return 0
+}
+
+func (x *Budget) GetCarryOver() bool {
+	if x != nil {
+		return x.CarryOver
+	}
+	return false
+}
+
+func (x *Budget) GetCarriedOver() float64 {
+	if x != nil {
+		return x.CarriedOver
+	}
+	return 0
+}
+
+func (x *Budget) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+// Spending of one budget category, including its subcategories.
+type BudgetCategoryProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Actual        float64                `protobuf:"fixed64,2,opt,name=actual,proto3" json:"actual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetCategoryProgress) Reset() {
+	*x = BudgetCategoryProgress{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetCategoryProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetCategoryProgress) ProtoMessage() {}
+
+func (x *BudgetCategoryProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetCategoryProgress.ProtoReflect.Descriptor instead.
+func (*BudgetCategoryProgress) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BudgetCategoryProgress) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BudgetCategoryProgress) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
 type CreateBudgetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        int32                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
@@ -154,13 +276,18 @@ type CreateBudgetRequest struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
 	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	CategoryIds   []int32                `protobuf:"varint,7,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	AccountIds    []int32                `protobuf:"varint,8,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Recurrence    string                 `protobuf:"bytes,9,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	RecurrenceDay int32                  `protobuf:"varint,10,opt,name=recurrence_day,json=recurrenceDay,proto3" json:"recurrence_day,omitempty"`
+	CarryOver     bool                   `protobuf:"varint,11,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateBudgetRequest) Reset() {
 	*x = CreateBudgetRequest{}
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[1]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -172,7 +299,7 @@ func (x *CreateBudgetRequest) String() string {
 func (*CreateBudgetRequest) ProtoMessage() {}
 
 func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[1]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +312,7 @@ func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
 func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{1}
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateBudgetRequest) GetUserID() int32 {
@@ -230,6 +357,41 @@ func (x *CreateBudgetRequest) GetPeriodEnd() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateBudgetRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CreateBudgetRequest) GetAccountIds() []int32 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *CreateBudgetRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *CreateBudgetRequest) GetRecurrenceDay() int32 {
+	if x != nil {
+		return x.RecurrenceDay
+	}
+	return 0
+}
+
+func (x *CreateBudgetRequest) GetCarryOver() bool {
+	if x != nil {
+		return x.CarryOver
+	}
+	return false
+}
+
 type UpdateBudgetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        int32                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
@@ -244,7 +406,7 @@ type UpdateBudgetRequest struct {
 
 func (x *UpdateBudgetRequest) Reset() {
 	*x = UpdateBudgetRequest{}
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[2]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -256,7 +418,7 @@ func (x *UpdateBudgetRequest) String() string {
 func (*UpdateBudgetRequest) ProtoMessage() {}
 
 func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[2]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +431,7 @@ func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBudgetRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{2}
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateBudgetRequest) GetUserID() int32 {
@@ -324,7 +486,7 @@ type BudgetRequest struct {
 
 func (x *BudgetRequest) Reset() {
 	*x = BudgetRequest{}
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[3]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +498,7 @@ func (x *BudgetRequest) String() string {
 func (*BudgetRequest) ProtoMessage() {}
 
 func (x *BudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[3]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +511,7 @@ func (x *BudgetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BudgetRequest.ProtoReflect.Descriptor instead.
 func (*BudgetRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{3}
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BudgetRequest) GetUserID() int32 {
@@ -375,7 +537,7 @@ type UserID struct {
 
 func (x *UserID) Reset() {
 	*x = UserID{}
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[4]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +549,7 @@ func (x *UserID) String() string {
 func (*UserID) ProtoMessage() {}
 
 func (x *UserID) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[4]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +562,7 @@ func (x *UserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserID.ProtoReflect.Descriptor instead.
 func (*UserID) Descriptor() ([]byte, []int) {
-	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{4}
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UserID) GetUserID() int32 {
@@ -419,7 +581,7 @@ type ListBudgetsResponse struct {
 
 func (x *ListBudgetsResponse) Reset() {
 	*x = ListBudgetsResponse{}
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[5]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -431,7 +593,7 @@ func (x *ListBudgetsResponse) String() string {
 func (*ListBudgetsResponse) ProtoMessage() {}
 
 func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[5]
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +606,7 @@ func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
 func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{5}
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListBudgetsResponse) GetBudgets() []*Budget {
@@ -454,62 +616,1432 @@ func (x *ListBudgetsResponse) GetBudgets() []*Budget {
 	return nil
 }
 
-var File_internal_app_budget_service_proto_budget_proto protoreflect.FileDescriptor
+type ImportBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int32                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	BackupId      string                 `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	Budgets       []*Budget              `protobuf:"bytes,3,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_internal_app_budget_service_proto_budget_proto_rawDesc = "" +
-	"\n" +
-	".internal/app/budget_service/proto/budget.proto\x12\x06budget\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\x03\n" +
-	"\x06Budget\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x10\n" +
-	"\x03sum\x18\x03 \x01(\x01R\x03sum\x12\x16\n" +
-	"\x06actual\x18\x04 \x01(\x01R\x06actual\x12\x1f\n" +
-	"\vcurrency_id\x18\x05 \x01(\x05R\n" +
-	"currencyId\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x129\n" +
-	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
-	"\tclosed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12=\n" +
-	"\fperiod_start\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
-	"\n" +
-	"period_end\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\"\x96\x02\n" +
-	"\x13CreateBudgetRequest\x12\x16\n" +
-	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\x12\x10\n" +
-	"\x03sum\x18\x02 \x01(\x01R\x03sum\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
-	"\n" +
-	"period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\"\xc3\x02\n" +
-	"\x13UpdateBudgetRequest\x12\x16\n" +
-	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\x12\x1a\n" +
-	"\bBudgetID\x18\x02 \x01(\x05R\bBudgetID\x12\x15\n" +
-	"\x03sum\x18\x03 \x01(\x01H\x00R\x03sum\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12B\n" +
-	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vperiodStart\x88\x01\x01\x12>\n" +
-	"\n" +
-	"period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tperiodEnd\x88\x01\x01B\x06\n" +
-	"\x04_sumB\x0e\n" +
-	"\f_descriptionB\x0f\n" +
-	"\r_period_startB\r\n" +
-	"\v_period_end\"C\n" +
-	"\rBudgetRequest\x12\x16\n" +
-	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\x12\x1a\n" +
-	"\bBudgetID\x18\x02 \x01(\x05R\bBudgetID\" \n" +
-	"\x06UserID\x12\x16\n" +
-	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\"?\n" +
-	"\x13ListBudgetsResponse\x12(\n" +
-	"\abudgets\x18\x02 \x03(\v2\x0e.budget.BudgetR\abudgets2\xb3\x02\n" +
-	"\rBudgetService\x12;\n" +
-	"\fCreateBudget\x12\x1b.budget.CreateBudgetRequest\x1a\x0e.budget.Budget\x122\n" +
-	"\tGetBudget\x12\x15.budget.BudgetRequest\x1a\x0e.budget.Budget\x12=\n" +
-	"\x0eGetListBudgets\x12\x0e.budget.UserID\x1a\x1b.budget.ListBudgetsResponse\x12;\n" +
-	"\fUpdateBudget\x12\x1b.budget.UpdateBudgetRequest\x1a\x0e.budget.Budget\x125\n" +
-	"\fDeleteBudget\x12\x15.budget.BudgetRequest\x1a\x0e.budget.BudgetBTZRgithub.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto;protob\x06proto3"
+func (x *ImportBudgetsRequest) Reset() {
+	*x = ImportBudgetsRequest{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBudgetsRequest) ProtoMessage() {}
+
+func (x *ImportBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ImportBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportBudgetsRequest) GetUserID() int32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ImportBudgetsRequest) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
+	}
+	return ""
+}
+
+func (x *ImportBudgetsRequest) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type ImportBudgetsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BudgetsRestored int32                  `protobuf:"varint,1,opt,name=budgets_restored,json=budgetsRestored,proto3" json:"budgets_restored,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportBudgetsResponse) Reset() {
+	*x = ImportBudgetsResponse{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBudgetsResponse) ProtoMessage() {}
+
+func (x *ImportBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ImportBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportBudgetsResponse) GetBudgetsRestored() int32 {
+	if x != nil {
+		return x.BudgetsRestored
+	}
+	return 0
+}
+
+type UserDataDeletion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BudgetsDeleted int32                  `protobuf:"varint,1,opt,name=budgets_deleted,json=budgetsDeleted,proto3" json:"budgets_deleted,omitempty"`
+	GoalsDeleted   int32                  `protobuf:"varint,2,opt,name=goals_deleted,json=goalsDeleted,proto3" json:"goals_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserDataDeletion) Reset() {
+	*x = UserDataDeletion{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataDeletion) ProtoMessage() {}
+
+func (x *UserDataDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataDeletion.ProtoReflect.Descriptor instead.
+func (*UserDataDeletion) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserDataDeletion) GetBudgetsDeleted() int32 {
+	if x != nil {
+		return x.BudgetsDeleted
+	}
+	return 0
+}
+
+func (x *UserDataDeletion) GetGoalsDeleted() int32 {
+	if x != nil {
+		return x.GoalsDeleted
+	}
+	return 0
+}
+
+type ReplaceCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SourceId      int32                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      int32                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceCategoryRequest) Reset() {
+	*x = ReplaceCategoryRequest{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceCategoryRequest) ProtoMessage() {}
+
+func (x *ReplaceCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplaceCategoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReplaceCategoryRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *ReplaceCategoryRequest) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type ReplaceCategoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BudgetsUpdated int32                  `protobuf:"varint,1,opt,name=budgets_updated,json=budgetsUpdated,proto3" json:"budgets_updated,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReplaceCategoryResponse) Reset() {
+	*x = ReplaceCategoryResponse{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceCategoryResponse) ProtoMessage() {}
+
+func (x *ReplaceCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReplaceCategoryResponse) GetBudgetsUpdated() int32 {
+	if x != nil {
+		return x.BudgetsUpdated
+	}
+	return 0
+}
+
+type ImageIDs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageIDs) Reset() {
+	*x = ImageIDs{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageIDs) ProtoMessage() {}
+
+func (x *ImageIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageIDs.ProtoReflect.Descriptor instead.
+func (*ImageIDs) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImageIDs) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Recurring expense expected before the end of the budget period.
+type BudgetForecastItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Sum           float64                `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetForecastItem) Reset() {
+	*x = BudgetForecastItem{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetForecastItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetForecastItem) ProtoMessage() {}
+
+func (x *BudgetForecastItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetForecastItem.ProtoReflect.Descriptor instead.
+func (*BudgetForecastItem) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BudgetForecastItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BudgetForecastItem) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BudgetForecastItem) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *BudgetForecastItem) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type BudgetForecast struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	BudgetId int32                  `protobuf:"varint,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	Sum      float64                `protobuf:"fixed64,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	Actual   float64                `protobuf:"fixed64,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	// expected spending at the end of the period
+	Projected float64 `protobuf:"fixed64,4,opt,name=projected,proto3" json:"projected,omitempty"`
+	// average daily spending without recurring expenses
+	DailyPace float64 `protobuf:"fixed64,5,opt,name=daily_pace,json=dailyPace,proto3" json:"daily_pace,omitempty"`
+	// unset if the budget is not expected to be exceeded within the period
+	ExceedDate    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=exceed_date,json=exceedDate,proto3" json:"exceed_date,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Recurring     []*BudgetForecastItem  `protobuf:"bytes,10,rep,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetForecast) Reset() {
+	*x = BudgetForecast{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetForecast) ProtoMessage() {}
+
+func (x *BudgetForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetForecast.ProtoReflect.Descriptor instead.
+func (*BudgetForecast) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BudgetForecast) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetProjected() float64 {
+	if x != nil {
+		return x.Projected
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetDailyPace() float64 {
+	if x != nil {
+		return x.DailyPace
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetExceedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExceedDate
+	}
+	return nil
+}
+
+func (x *BudgetForecast) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *BudgetForecast) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *BudgetForecast) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *BudgetForecast) GetRecurring() []*BudgetForecastItem {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+// Savings goal. Progress of a goal linked to an account is the account balance,
+// otherwise it is the sum of the goal contributions.
+type Goal struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Target      float64                `protobuf:"fixed64,5,opt,name=target,proto3" json:"target,omitempty"`
+	CurrencyId  int32                  `protobuf:"varint,6,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	// unset if the goal has no deadline
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// 0 if the progress is tracked by contributions
+	AccountId    int32   `protobuf:"varint,8,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	LogoHashedId string  `protobuf:"bytes,9,opt,name=logo_hashed_id,json=logoHashedId,proto3" json:"logo_hashed_id,omitempty"`
+	Saved        float64 `protobuf:"fixed64,10,opt,name=saved,proto3" json:"saved,omitempty"`
+	Remaining    float64 `protobuf:"fixed64,11,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Percent      float64 `protobuf:"fixed64,12,opt,name=percent,proto3" json:"percent,omitempty"`
+	Achieved     bool    `protobuf:"varint,13,opt,name=achieved,proto3" json:"achieved,omitempty"`
+	Overdue      bool    `protobuf:"varint,14,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	// monthly contributions left before the deadline, including the current month
+	MonthsLeft          int32                  `protobuf:"varint,15,opt,name=months_left,json=monthsLeft,proto3" json:"months_left,omitempty"`
+	MonthlyContribution float64                `protobuf:"fixed64,16,opt,name=monthly_contribution,json=monthlyContribution,proto3" json:"monthly_contribution,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Goal) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Goal) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Goal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Goal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Goal) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Goal) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *Goal) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Goal) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Goal) GetLogoHashedId() string {
+	if x != nil {
+		return x.LogoHashedId
+	}
+	return ""
+}
+
+func (x *Goal) GetSaved() float64 {
+	if x != nil {
+		return x.Saved
+	}
+	return 0
+}
+
+func (x *Goal) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *Goal) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Goal) GetAchieved() bool {
+	if x != nil {
+		return x.Achieved
+	}
+	return false
+}
+
+func (x *Goal) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+func (x *Goal) GetMonthsLeft() int32 {
+	if x != nil {
+		return x.MonthsLeft
+	}
+	return 0
+}
+
+func (x *Goal) GetMonthlyContribution() float64 {
+	if x != nil {
+		return x.MonthlyContribution
+	}
+	return 0
+}
+
+func (x *Goal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Goal) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Target        float64                `protobuf:"fixed64,4,opt,name=target,proto3" json:"target,omitempty"`
+	CurrencyId    int32                  `protobuf:"varint,5,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AccountId     int32                  `protobuf:"varint,7,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	LogoHashedId  string                 `protobuf:"bytes,8,opt,name=logo_hashed_id,json=logoHashedId,proto3" json:"logo_hashed_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoalRequest) Reset() {
+	*x = CreateGoalRequest{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalRequest) ProtoMessage() {}
+
+func (x *CreateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateGoalRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetLogoHashedId() string {
+	if x != nil {
+		return x.LogoHashedId
+	}
+	return ""
+}
+
+type UpdateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        int32                  `protobuf:"varint,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Target        *float64               `protobuf:"fixed64,5,opt,name=target,proto3,oneof" json:"target,omitempty"`
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	ClearDeadline bool                   `protobuf:"varint,7,opt,name=clear_deadline,json=clearDeadline,proto3" json:"clear_deadline,omitempty"`
+	AccountId     *int32                 `protobuf:"varint,8,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	ClearAccount  bool                   `protobuf:"varint,9,opt,name=clear_account,json=clearAccount,proto3" json:"clear_account,omitempty"`
+	LogoHashedId  *string                `protobuf:"bytes,10,opt,name=logo_hashed_id,json=logoHashedId,proto3,oneof" json:"logo_hashed_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoalRequest) Reset() {
+	*x = UpdateGoalRequest{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalRequest) ProtoMessage() {}
+
+func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateGoalRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateGoalRequest) GetGoalId() int32 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+func (x *UpdateGoalRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetTarget() float64 {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return 0
+}
+
+func (x *UpdateGoalRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *UpdateGoalRequest) GetClearDeadline() bool {
+	if x != nil {
+		return x.ClearDeadline
+	}
+	return false
+}
+
+func (x *UpdateGoalRequest) GetAccountId() int32 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateGoalRequest) GetClearAccount() bool {
+	if x != nil {
+		return x.ClearAccount
+	}
+	return false
+}
+
+func (x *UpdateGoalRequest) GetLogoHashedId() string {
+	if x != nil && x.LogoHashedId != nil {
+		return *x.LogoHashedId
+	}
+	return ""
+}
+
+type GoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        int32                  `protobuf:"varint,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoalRequest) Reset() {
+	*x = GoalRequest{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalRequest) ProtoMessage() {}
+
+func (x *GoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalRequest.ProtoReflect.Descriptor instead.
+func (*GoalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GoalRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GoalRequest) GetGoalId() int32 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+type ListGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*Goal                `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoalsResponse) Reset() {
+	*x = ListGoalsResponse{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsResponse) ProtoMessage() {}
+
+func (x *ListGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListGoalsResponse) GetGoals() []*Goal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type GoalContribution struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoalId int32                  `protobuf:"varint,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	// 0 for manual contributions
+	OperationId   int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	ContributedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=contributed_at,json=contributedAt,proto3" json:"contributed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoalContribution) Reset() {
+	*x = GoalContribution{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalContribution) ProtoMessage() {}
+
+func (x *GoalContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalContribution.ProtoReflect.Descriptor instead.
+func (*GoalContribution) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GoalContribution) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoalContribution) GetGoalId() int32 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+func (x *GoalContribution) GetOperationId() int32 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+func (x *GoalContribution) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GoalContribution) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *GoalContribution) GetContributedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ContributedAt
+	}
+	return nil
+}
+
+func (x *GoalContribution) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateContributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        int32                  `protobuf:"varint,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	OperationId   int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	ContributedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=contributed_at,json=contributedAt,proto3" json:"contributed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContributionRequest) Reset() {
+	*x = CreateContributionRequest{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContributionRequest) ProtoMessage() {}
+
+func (x *CreateContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContributionRequest.ProtoReflect.Descriptor instead.
+func (*CreateContributionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateContributionRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateContributionRequest) GetGoalId() int32 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+func (x *CreateContributionRequest) GetOperationId() int32 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+func (x *CreateContributionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateContributionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateContributionRequest) GetContributedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ContributedAt
+	}
+	return nil
+}
+
+type ListContributionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contributions []*GoalContribution    `protobuf:"bytes,1,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContributionsResponse) Reset() {
+	*x = ListContributionsResponse{}
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContributionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContributionsResponse) ProtoMessage() {}
+
+func (x *ListContributionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_budget_service_proto_budget_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContributionsResponse.ProtoReflect.Descriptor instead.
+func (*ListContributionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_budget_service_proto_budget_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListContributionsResponse) GetContributions() []*GoalContribution {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
+}
+
+var File_internal_app_budget_service_proto_budget_proto protoreflect.FileDescriptor
+
+const file_internal_app_budget_service_proto_budget_proto_rawDesc = "" +
+	"\n" +
+	".internal/app/budget_service/proto/budget.proto\x12\x06budget\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x05\n" +
+	"\x06Budget\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x10\n" +
+	"\x03sum\x18\x03 \x01(\x01R\x03sum\x12\x16\n" +
+	"\x06actual\x18\x04 \x01(\x01R\x06actual\x12\x1f\n" +
+	"\vcurrency_id\x18\x05 \x01(\x05R\n" +
+	"currencyId\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
+	"\tclosed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12=\n" +
+	"\fperiod_start\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12!\n" +
+	"\fcategory_ids\x18\f \x03(\x05R\vcategoryIds\x12\x1f\n" +
+	"\vaccount_ids\x18\r \x03(\x05R\n" +
+	"accountIds\x12>\n" +
+	"\n" +
+	"categories\x18\x0e \x03(\v2\x1e.budget.BudgetCategoryProgressR\n" +
+	"categories\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x0f \x01(\tR\n" +
+	"recurrence\x12%\n" +
+	"\x0erecurrence_day\x18\x10 \x01(\x05R\rrecurrenceDay\x12\x1d\n" +
+	"\n" +
+	"carry_over\x18\x11 \x01(\bR\tcarryOver\x12!\n" +
+	"\fcarried_over\x18\x12 \x01(\x01R\vcarriedOver\x12\x1b\n" +
+	"\tseries_id\x18\x13 \x01(\x05R\bseriesId\"Q\n" +
+	"\x16BudgetCategoryProgress\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06actual\x18\x02 \x01(\x01R\x06actual\"\xc0\x03\n" +
+	"\x13CreateBudgetRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\x12\x10\n" +
+	"\x03sum\x18\x02 \x01(\x01R\x03sum\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12!\n" +
+	"\fcategory_ids\x18\a \x03(\x05R\vcategoryIds\x12\x1f\n" +
+	"\vaccount_ids\x18\b \x03(\x05R\n" +
+	"accountIds\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\t \x01(\tR\n" +
+	"recurrence\x12%\n" +
+	"\x0erecurrence_day\x18\n" +
+	" \x01(\x05R\rrecurrenceDay\x12\x1d\n" +
+	"\n" +
+	"carry_over\x18\v \x01(\bR\tcarryOver\"\xc3\x02\n" +
+	"\x13UpdateBudgetRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\x12\x1a\n" +
+	"\bBudgetID\x18\x02 \x01(\x05R\bBudgetID\x12\x15\n" +
+	"\x03sum\x18\x03 \x01(\x01H\x00R\x03sum\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12B\n" +
+	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vperiodStart\x88\x01\x01\x12>\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tperiodEnd\x88\x01\x01B\x06\n" +
+	"\x04_sumB\x0e\n" +
+	"\f_descriptionB\x0f\n" +
+	"\r_period_startB\r\n" +
+	"\v_period_end\"C\n" +
+	"\rBudgetRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\x12\x1a\n" +
+	"\bBudgetID\x18\x02 \x01(\x05R\bBudgetID\" \n" +
+	"\x06UserID\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\"?\n" +
+	"\x13ListBudgetsResponse\x12(\n" +
+	"\abudgets\x18\x02 \x03(\v2\x0e.budget.BudgetR\abudgets\"u\n" +
+	"\x14ImportBudgetsRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x05R\x06UserID\x12\x1b\n" +
+	"\tbackup_id\x18\x02 \x01(\tR\bbackupId\x12(\n" +
+	"\abudgets\x18\x03 \x03(\v2\x0e.budget.BudgetR\abudgets\"B\n" +
+	"\x15ImportBudgetsResponse\x12)\n" +
+	"\x10budgets_restored\x18\x01 \x01(\x05R\x0fbudgetsRestored\"`\n" +
+	"\x10UserDataDeletion\x12'\n" +
+	"\x0fbudgets_deleted\x18\x01 \x01(\x05R\x0ebudgetsDeleted\x12#\n" +
+	"\rgoals_deleted\x18\x02 \x01(\x05R\fgoalsDeleted\"k\n" +
+	"\x16ReplaceCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x05R\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x05R\btargetId\"B\n" +
+	"\x17ReplaceCategoryResponse\x12'\n" +
+	"\x0fbudgets_updated\x18\x01 \x01(\x05R\x0ebudgetsUpdated\"\x1c\n" +
+	"\bImageIDs\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\x8b\x01\n" +
+	"\x12BudgetForecastItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x10\n" +
+	"\x03sum\x18\x03 \x01(\x01R\x03sum\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xb6\x03\n" +
+	"\x0eBudgetForecast\x12\x1b\n" +
+	"\tbudget_id\x18\x01 \x01(\x05R\bbudgetId\x12\x10\n" +
+	"\x03sum\x18\x02 \x01(\x01R\x03sum\x12\x16\n" +
+	"\x06actual\x18\x03 \x01(\x01R\x06actual\x12\x1c\n" +
+	"\tprojected\x18\x04 \x01(\x01R\tprojected\x12\x1d\n" +
+	"\n" +
+	"daily_pace\x18\x05 \x01(\x01R\tdailyPace\x12;\n" +
+	"\vexceed_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exceedDate\x12=\n" +
+	"\fperiod_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12/\n" +
+	"\x05as_of\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x128\n" +
+	"\trecurring\x18\n" +
+	" \x03(\v2\x1a.budget.BudgetForecastItemR\trecurring\"\xe9\x04\n" +
+	"\x04Goal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\x01R\x06target\x12\x1f\n" +
+	"\vcurrency_id\x18\x06 \x01(\x05R\n" +
+	"currencyId\x126\n" +
+	"\bdeadline\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\b \x01(\x05R\taccountId\x12$\n" +
+	"\x0elogo_hashed_id\x18\t \x01(\tR\flogoHashedId\x12\x14\n" +
+	"\x05saved\x18\n" +
+	" \x01(\x01R\x05saved\x12\x1c\n" +
+	"\tremaining\x18\v \x01(\x01R\tremaining\x12\x18\n" +
+	"\apercent\x18\f \x01(\x01R\apercent\x12\x1a\n" +
+	"\bachieved\x18\r \x01(\bR\bachieved\x12\x18\n" +
+	"\aoverdue\x18\x0e \x01(\bR\aoverdue\x12\x1f\n" +
+	"\vmonths_left\x18\x0f \x01(\x05R\n" +
+	"monthsLeft\x121\n" +
+	"\x14monthly_contribution\x18\x10 \x01(\x01R\x13monthlyContribution\x129\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x98\x02\n" +
+	"\x11CreateGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\x01R\x06target\x12\x1f\n" +
+	"\vcurrency_id\x18\x05 \x01(\x05R\n" +
+	"currencyId\x126\n" +
+	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\a \x01(\x05R\taccountId\x12$\n" +
+	"\x0elogo_hashed_id\x18\b \x01(\tR\flogoHashedId\"\xbb\x03\n" +
+	"\x11UpdateGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\x05R\x06goalId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06target\x18\x05 \x01(\x01H\x02R\x06target\x88\x01\x01\x126\n" +
+	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12%\n" +
+	"\x0eclear_deadline\x18\a \x01(\bR\rclearDeadline\x12\"\n" +
+	"\n" +
+	"account_id\x18\b \x01(\x05H\x03R\taccountId\x88\x01\x01\x12#\n" +
+	"\rclear_account\x18\t \x01(\bR\fclearAccount\x12)\n" +
+	"\x0elogo_hashed_id\x18\n" +
+	" \x01(\tH\x04R\flogoHashedId\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_targetB\r\n" +
+	"\v_account_idB\x11\n" +
+	"\x0f_logo_hashed_id\"?\n" +
+	"\vGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\x05R\x06goalId\"7\n" +
+	"\x11ListGoalsResponse\x12\"\n" +
+	"\x05goals\x18\x01 \x03(\v2\f.budget.GoalR\x05goals\"\x88\x02\n" +
+	"\x10GoalContribution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\x05R\x06goalId\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\x05R\voperationId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12A\n" +
+	"\x0econtributed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rcontributedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xdf\x01\n" +
+	"\x19CreateContributionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\x05R\x06goalId\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\x05R\voperationId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12A\n" +
+	"\x0econtributed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rcontributedAt\"[\n" +
+	"\x19ListContributionsResponse\x12>\n" +
+	"\rcontributions\x18\x01 \x03(\v2\x18.budget.GoalContributionR\rcontributions2\xc1\t\n" +
+	"\rBudgetService\x12;\n" +
+	"\fCreateBudget\x12\x1b.budget.CreateBudgetRequest\x1a\x0e.budget.Budget\x122\n" +
+	"\tGetBudget\x12\x15.budget.BudgetRequest\x1a\x0e.budget.Budget\x12=\n" +
+	"\x0eGetListBudgets\x12\x0e.budget.UserID\x1a\x1b.budget.ListBudgetsResponse\x12;\n" +
+	"\fUpdateBudget\x12\x1b.budget.UpdateBudgetRequest\x1a\x0e.budget.Budget\x125\n" +
+	"\fDeleteBudget\x12\x15.budget.BudgetRequest\x1a\x0e.budget.Budget\x12<\n" +
+	"\rExportBudgets\x12\x0e.budget.UserID\x1a\x1b.budget.ListBudgetsResponse\x12L\n" +
+	"\rImportBudgets\x12\x1c.budget.ImportBudgetsRequest\x1a\x1d.budget.ImportBudgetsResponse\x12:\n" +
+	"\x0eDeleteUserData\x12\x0e.budget.UserID\x1a\x18.budget.UserDataDeletion\x126\n" +
+	"\x10FilterUsedImages\x12\x10.budget.ImageIDs\x1a\x10.budget.ImageIDs\x12F\n" +
+	"\x10GetBudgetHistory\x12\x15.budget.BudgetRequest\x1a\x1b.budget.ListBudgetsResponse\x12B\n" +
+	"\x11GetBudgetForecast\x12\x15.budget.BudgetRequest\x1a\x16.budget.BudgetForecast\x12X\n" +
+	"\x15ReplaceBudgetCategory\x12\x1e.budget.ReplaceCategoryRequest\x1a\x1f.budget.ReplaceCategoryResponse\x125\n" +
+	"\n" +
+	"CreateGoal\x12\x19.budget.CreateGoalRequest\x1a\f.budget.Goal\x12,\n" +
+	"\aGetGoal\x12\x13.budget.GoalRequest\x1a\f.budget.Goal\x125\n" +
+	"\bGetGoals\x12\x0e.budget.UserID\x1a\x19.budget.ListGoalsResponse\x125\n" +
+	"\n" +
+	"UpdateGoal\x12\x19.budget.UpdateGoalRequest\x1a\f.budget.Goal\x12/\n" +
+	"\n" +
+	"DeleteGoal\x12\x13.budget.GoalRequest\x1a\f.budget.Goal\x12R\n" +
+	"\x13AddGoalContribution\x12!.budget.CreateContributionRequest\x1a\x18.budget.GoalContribution\x12N\n" +
+	"\x14GetGoalContributions\x12\x13.budget.GoalRequest\x1a!.budget.ListContributionsResponseBTZRgithub.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto;protob\x06proto3"
 
 var (
 	file_internal_app_budget_service_proto_budget_proto_rawDescOnce sync.Once
@@ -523,43 +2055,106 @@ func file_internal_app_budget_service_proto_budget_proto_rawDescGZIP() []byte {
 	return file_internal_app_budget_service_proto_budget_proto_rawDescData
 }
 
-var file_internal_app_budget_service_proto_budget_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_internal_app_budget_service_proto_budget_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_internal_app_budget_service_proto_budget_proto_goTypes = []any{
-	(*Budget)(nil),                // 0: budget.Budget
-	(*CreateBudgetRequest)(nil),   // 1: budget.CreateBudgetRequest
-	(*UpdateBudgetRequest)(nil),   // 2: budget.UpdateBudgetRequest
-	(*BudgetRequest)(nil),         // 3: budget.BudgetRequest
-	(*UserID)(nil),                // 4: budget.UserID
-	(*ListBudgetsResponse)(nil),   // 5: budget.ListBudgetsResponse
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*Budget)(nil),                    // 0: budget.Budget
+	(*BudgetCategoryProgress)(nil),    // 1: budget.BudgetCategoryProgress
+	(*CreateBudgetRequest)(nil),       // 2: budget.CreateBudgetRequest
+	(*UpdateBudgetRequest)(nil),       // 3: budget.UpdateBudgetRequest
+	(*BudgetRequest)(nil),             // 4: budget.BudgetRequest
+	(*UserID)(nil),                    // 5: budget.UserID
+	(*ListBudgetsResponse)(nil),       // 6: budget.ListBudgetsResponse
+	(*ImportBudgetsRequest)(nil),      // 7: budget.ImportBudgetsRequest
+	(*ImportBudgetsResponse)(nil),     // 8: budget.ImportBudgetsResponse
+	(*UserDataDeletion)(nil),          // 9: budget.UserDataDeletion
+	(*ReplaceCategoryRequest)(nil),    // 10: budget.ReplaceCategoryRequest
+	(*ReplaceCategoryResponse)(nil),   // 11: budget.ReplaceCategoryResponse
+	(*ImageIDs)(nil),                  // 12: budget.ImageIDs
+	(*BudgetForecastItem)(nil),        // 13: budget.BudgetForecastItem
+	(*BudgetForecast)(nil),            // 14: budget.BudgetForecast
+	(*Goal)(nil),                      // 15: budget.Goal
+	(*CreateGoalRequest)(nil),         // 16: budget.CreateGoalRequest
+	(*UpdateGoalRequest)(nil),         // 17: budget.UpdateGoalRequest
+	(*GoalRequest)(nil),               // 18: budget.GoalRequest
+	(*ListGoalsResponse)(nil),         // 19: budget.ListGoalsResponse
+	(*GoalContribution)(nil),          // 20: budget.GoalContribution
+	(*CreateContributionRequest)(nil), // 21: budget.CreateContributionRequest
+	(*ListContributionsResponse)(nil), // 22: budget.ListContributionsResponse
+	(*timestamppb.Timestamp)(nil),     // 23: google.protobuf.Timestamp
 }
 var file_internal_app_budget_service_proto_budget_proto_depIdxs = []int32{
-	6,  // 0: budget.Budget.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 1: budget.Budget.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 2: budget.Budget.closed_at:type_name -> google.protobuf.Timestamp
-	6,  // 3: budget.Budget.period_start:type_name -> google.protobuf.Timestamp
-	6,  // 4: budget.Budget.period_end:type_name -> google.protobuf.Timestamp
-	6,  // 5: budget.CreateBudgetRequest.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 6: budget.CreateBudgetRequest.period_start:type_name -> google.protobuf.Timestamp
-	6,  // 7: budget.CreateBudgetRequest.period_end:type_name -> google.protobuf.Timestamp
-	6,  // 8: budget.UpdateBudgetRequest.period_start:type_name -> google.protobuf.Timestamp
-	6,  // 9: budget.UpdateBudgetRequest.period_end:type_name -> google.protobuf.Timestamp
-	0,  // 10: budget.ListBudgetsResponse.budgets:type_name -> budget.Budget
-	1,  // 11: budget.BudgetService.CreateBudget:input_type -> budget.CreateBudgetRequest
-	3,  // 12: budget.BudgetService.GetBudget:input_type -> budget.BudgetRequest
-	4,  // 13: budget.BudgetService.GetListBudgets:input_type -> budget.UserID
-	2,  // 14: budget.BudgetService.UpdateBudget:input_type -> budget.UpdateBudgetRequest
-	3,  // 15: budget.BudgetService.DeleteBudget:input_type -> budget.BudgetRequest
-	0,  // 16: budget.BudgetService.CreateBudget:output_type -> budget.Budget
-	0,  // 17: budget.BudgetService.GetBudget:output_type -> budget.Budget
-	5,  // 18: budget.BudgetService.GetListBudgets:output_type -> budget.ListBudgetsResponse
-	0,  // 19: budget.BudgetService.UpdateBudget:output_type -> budget.Budget
-	0,  // 20: budget.BudgetService.DeleteBudget:output_type -> budget.Budget
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	23, // 0: budget.Budget.created_at:type_name -> google.protobuf.Timestamp
+	23, // 1: budget.Budget.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 2: budget.Budget.closed_at:type_name -> google.protobuf.Timestamp
+	23, // 3: budget.Budget.period_start:type_name -> google.protobuf.Timestamp
+	23, // 4: budget.Budget.period_end:type_name -> google.protobuf.Timestamp
+	1,  // 5: budget.Budget.categories:type_name -> budget.BudgetCategoryProgress
+	23, // 6: budget.CreateBudgetRequest.created_at:type_name -> google.protobuf.Timestamp
+	23, // 7: budget.CreateBudgetRequest.period_start:type_name -> google.protobuf.Timestamp
+	23, // 8: budget.CreateBudgetRequest.period_end:type_name -> google.protobuf.Timestamp
+	23, // 9: budget.UpdateBudgetRequest.period_start:type_name -> google.protobuf.Timestamp
+	23, // 10: budget.UpdateBudgetRequest.period_end:type_name -> google.protobuf.Timestamp
+	0,  // 11: budget.ListBudgetsResponse.budgets:type_name -> budget.Budget
+	0,  // 12: budget.ImportBudgetsRequest.budgets:type_name -> budget.Budget
+	23, // 13: budget.BudgetForecastItem.date:type_name -> google.protobuf.Timestamp
+	23, // 14: budget.BudgetForecast.exceed_date:type_name -> google.protobuf.Timestamp
+	23, // 15: budget.BudgetForecast.period_start:type_name -> google.protobuf.Timestamp
+	23, // 16: budget.BudgetForecast.period_end:type_name -> google.protobuf.Timestamp
+	23, // 17: budget.BudgetForecast.as_of:type_name -> google.protobuf.Timestamp
+	13, // 18: budget.BudgetForecast.recurring:type_name -> budget.BudgetForecastItem
+	23, // 19: budget.Goal.deadline:type_name -> google.protobuf.Timestamp
+	23, // 20: budget.Goal.created_at:type_name -> google.protobuf.Timestamp
+	23, // 21: budget.Goal.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 22: budget.CreateGoalRequest.deadline:type_name -> google.protobuf.Timestamp
+	23, // 23: budget.UpdateGoalRequest.deadline:type_name -> google.protobuf.Timestamp
+	15, // 24: budget.ListGoalsResponse.goals:type_name -> budget.Goal
+	23, // 25: budget.GoalContribution.contributed_at:type_name -> google.protobuf.Timestamp
+	23, // 26: budget.GoalContribution.created_at:type_name -> google.protobuf.Timestamp
+	23, // 27: budget.CreateContributionRequest.contributed_at:type_name -> google.protobuf.Timestamp
+	20, // 28: budget.ListContributionsResponse.contributions:type_name -> budget.GoalContribution
+	2,  // 29: budget.BudgetService.CreateBudget:input_type -> budget.CreateBudgetRequest
+	4,  // 30: budget.BudgetService.GetBudget:input_type -> budget.BudgetRequest
+	5,  // 31: budget.BudgetService.GetListBudgets:input_type -> budget.UserID
+	3,  // 32: budget.BudgetService.UpdateBudget:input_type -> budget.UpdateBudgetRequest
+	4,  // 33: budget.BudgetService.DeleteBudget:input_type -> budget.BudgetRequest
+	5,  // 34: budget.BudgetService.ExportBudgets:input_type -> budget.UserID
+	7,  // 35: budget.BudgetService.ImportBudgets:input_type -> budget.ImportBudgetsRequest
+	5,  // 36: budget.BudgetService.DeleteUserData:input_type -> budget.UserID
+	12, // 37: budget.BudgetService.FilterUsedImages:input_type -> budget.ImageIDs
+	4,  // 38: budget.BudgetService.GetBudgetHistory:input_type -> budget.BudgetRequest
+	4,  // 39: budget.BudgetService.GetBudgetForecast:input_type -> budget.BudgetRequest
+	10, // 40: budget.BudgetService.ReplaceBudgetCategory:input_type -> budget.ReplaceCategoryRequest
+	16, // 41: budget.BudgetService.CreateGoal:input_type -> budget.CreateGoalRequest
+	18, // 42: budget.BudgetService.GetGoal:input_type -> budget.GoalRequest
+	5,  // 43: budget.BudgetService.GetGoals:input_type -> budget.UserID
+	17, // 44: budget.BudgetService.UpdateGoal:input_type -> budget.UpdateGoalRequest
+	18, // 45: budget.BudgetService.DeleteGoal:input_type -> budget.GoalRequest
+	21, // 46: budget.BudgetService.AddGoalContribution:input_type -> budget.CreateContributionRequest
+	18, // 47: budget.BudgetService.GetGoalContributions:input_type -> budget.GoalRequest
+	0,  // 48: budget.BudgetService.CreateBudget:output_type -> budget.Budget
+	0,  // 49: budget.BudgetService.GetBudget:output_type -> budget.Budget
+	6,  // 50: budget.BudgetService.GetListBudgets:output_type -> budget.ListBudgetsResponse
+	0,  // 51: budget.BudgetService.UpdateBudget:output_type -> budget.Budget
+	0,  // 52: budget.BudgetService.DeleteBudget:output_type -> budget.Budget
+	6,  // 53: budget.BudgetService.ExportBudgets:output_type -> budget.ListBudgetsResponse
+	8,  // 54: budget.BudgetService.ImportBudgets:output_type -> budget.ImportBudgetsResponse
+	9,  // 55: budget.BudgetService.DeleteUserData:output_type -> budget.UserDataDeletion
+	12, // 56: budget.BudgetService.FilterUsedImages:output_type -> budget.ImageIDs
+	6,  // 57: budget.BudgetService.GetBudgetHistory:output_type -> budget.ListBudgetsResponse
+	14, // 58: budget.BudgetService.GetBudgetForecast:output_type -> budget.BudgetForecast
+	11, // 59: budget.BudgetService.ReplaceBudgetCategory:output_type -> budget.ReplaceCategoryResponse
+	15, // 60: budget.BudgetService.CreateGoal:output_type -> budget.Goal
+	15, // 61: budget.BudgetService.GetGoal:output_type -> budget.Goal
+	19, // 62: budget.BudgetService.GetGoals:output_type -> budget.ListGoalsResponse
+	15, // 63: budget.BudgetService.UpdateGoal:output_type -> budget.Goal
+	15, // 64: budget.BudgetService.DeleteGoal:output_type -> budget.Goal
+	20, // 65: budget.BudgetService.AddGoalContribution:output_type -> budget.GoalContribution
+	22, // 66: budget.BudgetService.GetGoalContributions:output_type -> budget.ListContributionsResponse
+	48, // [48:67] is the sub-list for method output_type
+	29, // [29:48] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_internal_app_budget_service_proto_budget_proto_init() }
@@ -567,14 +2162,15 @@ func file_internal_app_budget_service_proto_budget_proto_init() {
 	if File_internal_app_budget_service_proto_budget_proto != nil {
 		return
 	}
-	file_internal_app_budget_service_proto_budget_proto_msgTypes[2].OneofWrappers = []any{}
+	file_internal_app_budget_service_proto_budget_proto_msgTypes[3].OneofWrappers = []any{}
+	file_internal_app_budget_service_proto_budget_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_app_budget_service_proto_budget_proto_rawDesc), len(file_internal_app_budget_service_proto_budget_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
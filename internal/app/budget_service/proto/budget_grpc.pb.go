@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BudgetService_CreateBudget_FullMethodName   = "/budget.BudgetService/CreateBudget"
-	BudgetService_GetBudget_FullMethodName      = "/budget.BudgetService/GetBudget"
-	BudgetService_GetListBudgets_FullMethodName = "/budget.BudgetService/GetListBudgets"
-	BudgetService_UpdateBudget_FullMethodName   = "/budget.BudgetService/UpdateBudget"
-	BudgetService_DeleteBudget_FullMethodName   = "/budget.BudgetService/DeleteBudget"
+	BudgetService_CreateBudget_FullMethodName          = "/budget.BudgetService/CreateBudget"
+	BudgetService_GetBudget_FullMethodName             = "/budget.BudgetService/GetBudget"
+	BudgetService_GetListBudgets_FullMethodName        = "/budget.BudgetService/GetListBudgets"
+	BudgetService_UpdateBudget_FullMethodName          = "/budget.BudgetService/UpdateBudget"
+	BudgetService_DeleteBudget_FullMethodName          = "/budget.BudgetService/DeleteBudget"
+	BudgetService_ExportBudgets_FullMethodName         = "/budget.BudgetService/ExportBudgets"
+	BudgetService_ImportBudgets_FullMethodName         = "/budget.BudgetService/ImportBudgets"
+	BudgetService_DeleteUserData_FullMethodName        = "/budget.BudgetService/DeleteUserData"
+	BudgetService_FilterUsedImages_FullMethodName      = "/budget.BudgetService/FilterUsedImages"
+	BudgetService_GetBudgetHistory_FullMethodName      = "/budget.BudgetService/GetBudgetHistory"
+	BudgetService_GetBudgetForecast_FullMethodName     = "/budget.BudgetService/GetBudgetForecast"
+	BudgetService_ReplaceBudgetCategory_FullMethodName = "/budget.BudgetService/ReplaceBudgetCategory"
+	BudgetService_CreateGoal_FullMethodName            = "/budget.BudgetService/CreateGoal"
+	BudgetService_GetGoal_FullMethodName               = "/budget.BudgetService/GetGoal"
+	BudgetService_GetGoals_FullMethodName              = "/budget.BudgetService/GetGoals"
+	BudgetService_UpdateGoal_FullMethodName            = "/budget.BudgetService/UpdateGoal"
+	BudgetService_DeleteGoal_FullMethodName            = "/budget.BudgetService/DeleteGoal"
+	BudgetService_AddGoalContribution_FullMethodName   = "/budget.BudgetService/AddGoalContribution"
+	BudgetService_GetGoalContributions_FullMethodName  = "/budget.BudgetService/GetGoalContributions"
 )
 
 // BudgetServiceClient is the client API for BudgetService service.
@@ -35,6 +49,25 @@ type BudgetServiceClient interface {
 	GetListBudgets(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListBudgetsResponse, error)
 	UpdateBudget(ctx context.Context, in *UpdateBudgetRequest, opts ...grpc.CallOption) (*Budget, error)
 	DeleteBudget(ctx context.Context, in *BudgetRequest, opts ...grpc.CallOption) (*Budget, error)
+	ExportBudgets(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListBudgetsResponse, error)
+	ImportBudgets(ctx context.Context, in *ImportBudgetsRequest, opts ...grpc.CallOption) (*ImportBudgetsResponse, error)
+	// deletes budgets and savings goals of a user for account deletion; repeated calls are no-ops
+	DeleteUserData(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserDataDeletion, error)
+	// returns the given images that are still used as savings goal logos
+	FilterUsedImages(ctx context.Context, in *ImageIDs, opts ...grpc.CallOption) (*ImageIDs, error)
+	// past and current periods of the recurring series the budget belongs to
+	GetBudgetHistory(ctx context.Context, in *BudgetRequest, opts ...grpc.CallOption) (*ListBudgetsResponse, error)
+	// expected spending at the end of the current period of the budget
+	GetBudgetForecast(ctx context.Context, in *BudgetRequest, opts ...grpc.CallOption) (*BudgetForecast, error)
+	// points budgets of the source category to the target after the categories are merged in finance_service
+	ReplaceBudgetCategory(ctx context.Context, in *ReplaceCategoryRequest, opts ...grpc.CallOption) (*ReplaceCategoryResponse, error)
+	CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	GetGoal(ctx context.Context, in *GoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	GetGoals(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListGoalsResponse, error)
+	UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	DeleteGoal(ctx context.Context, in *GoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	AddGoalContribution(ctx context.Context, in *CreateContributionRequest, opts ...grpc.CallOption) (*GoalContribution, error)
+	GetGoalContributions(ctx context.Context, in *GoalRequest, opts ...grpc.CallOption) (*ListContributionsResponse, error)
 }
 
 type budgetServiceClient struct {
@@ -95,6 +128,146 @@ func (c *budgetServiceClient) DeleteBudget(ctx context.Context, in *BudgetReques
 	return out, nil
 }
 
+func (c *budgetServiceClient) ExportBudgets(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBudgetsResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ExportBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ImportBudgets(ctx context.Context, in *ImportBudgetsRequest, opts ...grpc.CallOption) (*ImportBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBudgetsResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ImportBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) DeleteUserData(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserDataDeletion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataDeletion)
+	err := c.cc.Invoke(ctx, BudgetService_DeleteUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) FilterUsedImages(ctx context.Context, in *ImageIDs, opts ...grpc.CallOption) (*ImageIDs, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageIDs)
+	err := c.cc.Invoke(ctx, BudgetService_FilterUsedImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetBudgetHistory(ctx context.Context, in *BudgetRequest, opts ...grpc.CallOption) (*ListBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBudgetsResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetBudgetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetBudgetForecast(ctx context.Context, in *BudgetRequest, opts ...grpc.CallOption) (*BudgetForecast, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetForecast)
+	err := c.cc.Invoke(ctx, BudgetService_GetBudgetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ReplaceBudgetCategory(ctx context.Context, in *ReplaceCategoryRequest, opts ...grpc.CallOption) (*ReplaceCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceCategoryResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ReplaceBudgetCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, BudgetService_CreateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetGoal(ctx context.Context, in *GoalRequest, opts ...grpc.CallOption) (*Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, BudgetService_GetGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetGoals(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListGoalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGoalsResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, BudgetService_UpdateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) DeleteGoal(ctx context.Context, in *GoalRequest, opts ...grpc.CallOption) (*Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, BudgetService_DeleteGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) AddGoalContribution(ctx context.Context, in *CreateContributionRequest, opts ...grpc.CallOption) (*GoalContribution, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoalContribution)
+	err := c.cc.Invoke(ctx, BudgetService_AddGoalContribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetGoalContributions(ctx context.Context, in *GoalRequest, opts ...grpc.CallOption) (*ListContributionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContributionsResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetGoalContributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BudgetServiceServer is the server API for BudgetService service.
 // All implementations must embed UnimplementedBudgetServiceServer
 // for forward compatibility.
@@ -104,6 +277,25 @@ type BudgetServiceServer interface {
 	GetListBudgets(context.Context, *UserID) (*ListBudgetsResponse, error)
 	UpdateBudget(context.Context, *UpdateBudgetRequest) (*Budget, error)
 	DeleteBudget(context.Context, *BudgetRequest) (*Budget, error)
+	ExportBudgets(context.Context, *UserID) (*ListBudgetsResponse, error)
+	ImportBudgets(context.Context, *ImportBudgetsRequest) (*ImportBudgetsResponse, error)
+	// deletes budgets and savings goals of a user for account deletion; repeated calls are no-ops
+	DeleteUserData(context.Context, *UserID) (*UserDataDeletion, error)
+	// returns the given images that are still used as savings goal logos
+	FilterUsedImages(context.Context, *ImageIDs) (*ImageIDs, error)
+	// past and current periods of the recurring series the budget belongs to
+	GetBudgetHistory(context.Context, *BudgetRequest) (*ListBudgetsResponse, error)
+	// expected spending at the end of the current period of the budget
+	GetBudgetForecast(context.Context, *BudgetRequest) (*BudgetForecast, error)
+	// points budgets of the source category to the target after the categories are merged in finance_service
+	ReplaceBudgetCategory(context.Context, *ReplaceCategoryRequest) (*ReplaceCategoryResponse, error)
+	CreateGoal(context.Context, *CreateGoalRequest) (*Goal, error)
+	GetGoal(context.Context, *GoalRequest) (*Goal, error)
+	GetGoals(context.Context, *UserID) (*ListGoalsResponse, error)
+	UpdateGoal(context.Context, *UpdateGoalRequest) (*Goal, error)
+	DeleteGoal(context.Context, *GoalRequest) (*Goal, error)
+	AddGoalContribution(context.Context, *CreateContributionRequest) (*GoalContribution, error)
+	GetGoalContributions(context.Context, *GoalRequest) (*ListContributionsResponse, error)
 	mustEmbedUnimplementedBudgetServiceServer()
 }
 
@@ -129,6 +321,48 @@ func (UnimplementedBudgetServiceServer) UpdateBudget(context.Context, *UpdateBud
 func (UnimplementedBudgetServiceServer) DeleteBudget(context.Context, *BudgetRequest) (*Budget, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteBudget not implemented")
 }
+func (UnimplementedBudgetServiceServer) ExportBudgets(context.Context, *UserID) (*ListBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) ImportBudgets(context.Context, *ImportBudgetsRequest) (*ImportBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) DeleteUserData(context.Context, *UserID) (*UserDataDeletion, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (UnimplementedBudgetServiceServer) FilterUsedImages(context.Context, *ImageIDs) (*ImageIDs, error) {
+	return nil, status.Error(codes.Unimplemented, "method FilterUsedImages not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetBudgetHistory(context.Context, *BudgetRequest) (*ListBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgetHistory not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetBudgetForecast(context.Context, *BudgetRequest) (*BudgetForecast, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgetForecast not implemented")
+}
+func (UnimplementedBudgetServiceServer) ReplaceBudgetCategory(context.Context, *ReplaceCategoryRequest) (*ReplaceCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplaceBudgetCategory not implemented")
+}
+func (UnimplementedBudgetServiceServer) CreateGoal(context.Context, *CreateGoalRequest) (*Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGoal not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetGoal(context.Context, *GoalRequest) (*Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGoal not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetGoals(context.Context, *UserID) (*ListGoalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGoals not implemented")
+}
+func (UnimplementedBudgetServiceServer) UpdateGoal(context.Context, *UpdateGoalRequest) (*Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGoal not implemented")
+}
+func (UnimplementedBudgetServiceServer) DeleteGoal(context.Context, *GoalRequest) (*Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGoal not implemented")
+}
+func (UnimplementedBudgetServiceServer) AddGoalContribution(context.Context, *CreateContributionRequest) (*GoalContribution, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddGoalContribution not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetGoalContributions(context.Context, *GoalRequest) (*ListContributionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGoalContributions not implemented")
+}
 func (UnimplementedBudgetServiceServer) mustEmbedUnimplementedBudgetServiceServer() {}
 func (UnimplementedBudgetServiceServer) testEmbeddedByValue()                       {}
 
@@ -240,6 +474,258 @@ func _BudgetService_DeleteBudget_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BudgetService_ExportBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ExportBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ExportBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ExportBudgets(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ImportBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ImportBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ImportBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ImportBudgets(ctx, req.(*ImportBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_DeleteUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).DeleteUserData(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_FilterUsedImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).FilterUsedImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_FilterUsedImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).FilterUsedImages(ctx, req.(*ImageIDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetBudgetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetBudgetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetHistory(ctx, req.(*BudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetBudgetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetBudgetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetForecast(ctx, req.(*BudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ReplaceBudgetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ReplaceBudgetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ReplaceBudgetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ReplaceBudgetCategory(ctx, req.(*ReplaceCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_CreateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).CreateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_CreateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).CreateGoal(ctx, req.(*CreateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetGoal(ctx, req.(*GoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetGoals(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_UpdateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).UpdateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_UpdateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).UpdateGoal(ctx, req.(*UpdateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).DeleteGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_DeleteGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).DeleteGoal(ctx, req.(*GoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_AddGoalContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).AddGoalContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_AddGoalContribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).AddGoalContribution(ctx, req.(*CreateContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetGoalContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetGoalContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetGoalContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetGoalContributions(ctx, req.(*GoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BudgetService_ServiceDesc is the grpc.ServiceDesc for BudgetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +753,62 @@ var BudgetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBudget",
 			Handler:    _BudgetService_DeleteBudget_Handler,
 		},
+		{
+			MethodName: "ExportBudgets",
+			Handler:    _BudgetService_ExportBudgets_Handler,
+		},
+		{
+			MethodName: "ImportBudgets",
+			Handler:    _BudgetService_ImportBudgets_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _BudgetService_DeleteUserData_Handler,
+		},
+		{
+			MethodName: "FilterUsedImages",
+			Handler:    _BudgetService_FilterUsedImages_Handler,
+		},
+		{
+			MethodName: "GetBudgetHistory",
+			Handler:    _BudgetService_GetBudgetHistory_Handler,
+		},
+		{
+			MethodName: "GetBudgetForecast",
+			Handler:    _BudgetService_GetBudgetForecast_Handler,
+		},
+		{
+			MethodName: "ReplaceBudgetCategory",
+			Handler:    _BudgetService_ReplaceBudgetCategory_Handler,
+		},
+		{
+			MethodName: "CreateGoal",
+			Handler:    _BudgetService_CreateGoal_Handler,
+		},
+		{
+			MethodName: "GetGoal",
+			Handler:    _BudgetService_GetGoal_Handler,
+		},
+		{
+			MethodName: "GetGoals",
+			Handler:    _BudgetService_GetGoals_Handler,
+		},
+		{
+			MethodName: "UpdateGoal",
+			Handler:    _BudgetService_UpdateGoal_Handler,
+		},
+		{
+			MethodName: "DeleteGoal",
+			Handler:    _BudgetService_DeleteGoal_Handler,
+		},
+		{
+			MethodName: "AddGoalContribution",
+			Handler:    _BudgetService_AddGoalContribution_Handler,
+		},
+		{
+			MethodName: "GetGoalContributions",
+			Handler:    _BudgetService_GetGoalContributions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/budget_service/proto/budget.proto",
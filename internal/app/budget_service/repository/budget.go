@@ -4,10 +4,86 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
+
+	"github.com/lib/pq"
 
 	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
 )
 
+// budgetColumns столбцы бюджета в порядке, который ожидает scanBudget.
+// У первого бюджета серии series_id не заполнен, поэтому серией считается он сам.
+const budgetColumns = `_id, user_id, currency_id, amount, budget_description,
+	created_at, updated_at, closed_at, period_start, period_end,
+	category_ids, account_ids, recurrence, recurrence_day, carry_over, carried_over,
+	COALESCE(series_id, _id)`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanBudget(row rowScanner) (bdgmodels.Budget, error) {
+	var b BudgetDB
+	if err := row.Scan(
+		&b.ID,
+		&b.UserID,
+		&b.CurrencyID,
+		&b.Amount,
+		&b.Description,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+		&b.ClosedAt,
+		&b.PeriodStart,
+		&b.PeriodEnd,
+		&b.CategoryIDs,
+		&b.AccountIDs,
+		&b.Recurrence,
+		&b.RecurrenceDay,
+		&b.CarryOver,
+		&b.CarriedOver,
+		&b.SeriesID,
+	); err != nil {
+		return bdgmodels.Budget{}, err
+	}
+
+	budget := bdgmodels.Budget{
+		ID:          b.ID,
+		UserID:      b.UserID,
+		CurrencyID:  b.CurrencyID,
+		Amount:      b.Amount,
+		Description: b.Description,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+		PeriodStart: b.PeriodStart,
+		PeriodEnd:   b.PeriodEnd,
+		CategoryIDs: fromIntArray(b.CategoryIDs),
+		AccountIDs:  fromIntArray(b.AccountIDs),
+		Recurrence: bdgmodels.Recurrence{
+			Period:    b.Recurrence,
+			Day:       b.RecurrenceDay,
+			CarryOver: b.CarryOver,
+		},
+		CarriedOver: b.CarriedOver,
+		SeriesID:    b.SeriesID,
+	}
+	if b.ClosedAt != nil {
+		budget.ClosedAt = *b.ClosedAt
+	}
+	return budget, nil
+}
+
+func scanBudgets(rows *sql.Rows) ([]bdgmodels.Budget, error) {
+	var budgets []bdgmodels.Budget
+	for rows.Next() {
+		budget, err := scanBudget(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan budget: %w", err)
+		}
+		budgets = append(budgets, budget)
+	}
+	return budgets, rows.Err()
+}
+
 type PostgresRepository struct {
 	db *sql.DB
 }
@@ -31,8 +107,7 @@ func NewPostgresRepository(db *sql.DB) *PostgresRepository {
 
 func (r *PostgresRepository) GetBudgetsByUser(ctx context.Context, userID int) ([]bdgmodels.Budget, error) {
 	query := `
-		SELECT _id, user_id, currency_id, amount, budget_description, 
-		       created_at, updated_at, closed_at, period_start, period_end
+		SELECT ` + budgetColumns + `
 		FROM budget
 		WHERE user_id = $1 AND closed_at IS NULL
 		ORDER BY created_at DESC
@@ -44,49 +119,17 @@ func (r *PostgresRepository) GetBudgetsByUser(ctx context.Context, userID int) (
 	}
 	defer rows.Close()
 
-	var budgets []bdgmodels.Budget
-
-	for rows.Next() {
-		var b BudgetDB
-		if err := rows.Scan(
-			&b.ID,
-			&b.UserID,
-			&b.CurrencyID,
-			&b.Amount,
-			&b.Description,
-			&b.CreatedAt,
-			&b.UpdatedAt,
-			&b.ClosedAt,
-			&b.PeriodStart,
-			&b.PeriodEnd,
-		); err != nil {
-			return nil, fmt.Errorf("failed to scan budget: %w", err)
-		}
-
-		pb := bdgmodels.Budget{
-			ID:          b.ID,
-			UserID:      b.UserID,
-			CurrencyID:  b.CurrencyID,
-			Amount:      b.Amount,
-			Description: b.Description,
-			CreatedAt:   b.CreatedAt,
-			UpdatedAt:   b.UpdatedAt,
-			PeriodStart: b.PeriodStart,
-			PeriodEnd:   b.PeriodEnd,
-		}
-		budgets = append(budgets, pb)
-	}
-
-	return budgets, nil
+	return scanBudgets(rows)
 }
 
 func (r *PostgresRepository) CreateBudget(ctx context.Context, budget bdgmodels.Budget) (bdgmodels.Budget, error) {
 	query := `
 		INSERT INTO budget (
 			user_id, currency_id, amount, budget_description, 
-			created_at, updated_at, period_start, period_end
+			created_at, updated_at, period_start, period_end,
+			category_ids, account_ids, recurrence, recurrence_day, carry_over
 		)
-		VALUES ($1, $2, $3, $4, NOW(), NOW(), $5, $6)
+		VALUES ($1, $2, $3, $4, NOW(), NOW(), $5, $6, $7, $8, $9, $10, $11)
 		RETURNING _id, created_at, updated_at
 	`
 
@@ -97,11 +140,17 @@ func (r *PostgresRepository) CreateBudget(ctx context.Context, budget bdgmodels.
 		budget.Description,
 		budget.PeriodStart,
 		budget.PeriodEnd,
+		toIntArray(budget.CategoryIDs),
+		toIntArray(budget.AccountIDs),
+		recurrenceOrNone(budget.Period),
+		budget.Day,
+		budget.CarryOver,
 	).Scan(&budget.ID, &budget.CreatedAt, &budget.UpdatedAt)
 
 	if err != nil {
 		return bdgmodels.Budget{}, MapPgError(err)
 	}
+	budget.SeriesID = budget.ID
 
 	return budget, nil
 }
@@ -116,30 +165,17 @@ func (r *PostgresRepository) UpdateBudget(ctx context.Context, req bdgmodels.Upd
 			period_end = COALESCE($4, period_end),
 			updated_at = NOW()
 		WHERE _id = $5 AND user_id = $6 AND closed_at IS NULL
-		RETURNING _id, user_id,currency_id, amount, budget_description,
-				  created_at, updated_at, period_start, period_end
+		RETURNING ` + budgetColumns + `
 	`
 
-	var b bdgmodels.Budget
-	err := r.db.QueryRowContext(ctx, query,
+	b, err := scanBudget(r.db.QueryRowContext(ctx, query,
 		req.Amount,
 		req.Description,
 		req.PeriodStart,
 		req.PeriodEnd,
 		req.BudgetID,
 		req.UserID,
-	).Scan(
-		&b.ID,
-		&b.UserID,
-		&b.CurrencyID,
-		&b.Amount,
-		&b.Description,
-		&b.CreatedAt,
-		&b.UpdatedAt,
-		&b.PeriodStart,
-		&b.PeriodEnd,
-	)
-
+	))
 	if err != nil {
 		return bdgmodels.Budget{}, MapPgError(err)
 	}
@@ -152,26 +188,183 @@ func (r *PostgresRepository) DeleteBudget(ctx context.Context, budgetID int) (bd
 		UPDATE budget 
 		SET closed_at = NOW(), updated_at = NOW()
 		WHERE _id = $1
-		RETURNING _id, user_id, currency_id, amount, budget_description,
-				  created_at, updated_at, period_start, period_end
+		RETURNING ` + budgetColumns + `
 	`
 
-	var b bdgmodels.Budget
-	err := r.db.QueryRowContext(ctx, query, budgetID).Scan(
-		&b.ID,
-		&b.UserID,
-		&b.CurrencyID,
-		&b.Amount,
-		&b.Description,
-		&b.CreatedAt,
-		&b.UpdatedAt,
-		&b.PeriodStart,
-		&b.PeriodEnd,
-	)
-
+	b, err := scanBudget(r.db.QueryRowContext(ctx, query, budgetID))
 	if err != nil {
 		return bdgmodels.Budget{}, MapPgError(err)
 	}
 
 	return b, nil
 }
+
+func (r *PostgresRepository) GetAllBudgetsByUser(ctx context.Context, userID int) ([]bdgmodels.Budget, error) {
+	query := `
+		SELECT ` + budgetColumns + `
+		FROM budget
+		WHERE user_id = $1
+		ORDER BY period_start
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all budgets by user: %w", err)
+	}
+	defer rows.Close()
+
+	return scanBudgets(rows)
+}
+
+// ImportBudgets восстанавливает бюджеты из резервной копии.
+// Повторный импорт того же архива не создает дубликатов благодаря таблице restore_mapping.
+func (r *PostgresRepository) ImportBudgets(ctx context.Context, req bdgmodels.ImportBudgetsRequest) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	restored := 0
+	for _, b := range req.Budgets {
+		var exists bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM restore_mapping
+				WHERE user_id = $1 AND backup_id = $2 AND entity_type = 'budget' AND source_id = $3
+			)
+		`, req.UserID, req.BackupID, b.ID).Scan(&exists)
+		if err != nil {
+			return 0, MapPgError(err)
+		}
+		if exists {
+			continue
+		}
+
+		// категории и счета в архиве ссылаются на старые идентификаторы;
+		// бюджет, ни одна категория или счет которого не восстановлены, пропускается,
+		// чтобы он не превратился в бюджет без ограничений
+		categoryIDs, err := lookupRestoredIDs(ctx, tx, req, restoreEntityCategory, b.CategoryIDs)
+		if err != nil {
+			return 0, err
+		}
+		accountIDs, err := lookupRestoredIDs(ctx, tx, req, restoreEntityAccount, b.AccountIDs)
+		if err != nil {
+			return 0, err
+		}
+		if (len(b.CategoryIDs) > 0 && len(categoryIDs) == 0) || (len(b.AccountIDs) > 0 && len(accountIDs) == 0) {
+			continue
+		}
+
+		var closedAt *time.Time
+		if !b.ClosedAt.IsZero() {
+			closedAt = &b.ClosedAt
+		}
+
+		var targetID int
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO budget (
+				user_id, currency_id, amount, budget_description,
+				created_at, updated_at, closed_at, period_start, period_end,
+				category_ids, account_ids, recurrence, recurrence_day, carry_over, carried_over
+			)
+			VALUES ($1, $2, $3, $4, $5, NOW(), $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			ON CONFLICT (user_id, currency_id, period_start, period_end, category_ids, account_ids)
+			DO UPDATE SET updated_at = NOW()
+			RETURNING _id
+		`, req.UserID, b.CurrencyID, b.Amount, b.Description, b.CreatedAt, closedAt, b.PeriodStart, b.PeriodEnd,
+			categoryIDs, accountIDs, recurrenceOrNone(b.Period), b.Day, b.CarryOver, b.CarriedOver).Scan(&targetID)
+		if err != nil {
+			return 0, MapPgError(err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO restore_mapping (user_id, backup_id, entity_type, source_id, target_id)
+			VALUES ($1, $2, 'budget', $3, $4)
+		`, req.UserID, req.BackupID, b.ID, targetID)
+		if err != nil {
+			return 0, MapPgError(err)
+		}
+		restored++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return restored, nil
+}
+
+// сущности finance_service, идентификаторы которых сопоставлены при восстановлении
+const (
+	restoreEntityAccount  = "account"
+	restoreEntityCategory = "category"
+)
+
+func lookupRestoredIDs(ctx context.Context, tx *sql.Tx, req bdgmodels.ImportBudgetsRequest, entity string, sourceIDs []int) (pq.Int64Array, error) {
+	ids := pq.Int64Array{}
+	if len(sourceIDs) == 0 {
+		return ids, nil
+	}
+	err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(array_agg(DISTINCT target_id ORDER BY target_id), '{}')
+		FROM restore_mapping
+		WHERE user_id = $1 AND backup_id = $2 AND entity_type = $3 AND source_id = ANY($4)
+	`, req.UserID, req.BackupID, entity, toIntArray(sourceIDs)).Scan(&ids)
+	if err != nil {
+		return nil, MapPgError(err)
+	}
+	return ids, nil
+}
+
+// ReplaceBudgetCategory бюджеты по source начинают учитывать target;
+// массив остается отсортированным и без повторов
+func (r *PostgresRepository) ReplaceBudgetCategory(ctx context.Context, userID, sourceID, targetID int) (int, error) {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE budget
+		SET category_ids = ARRAY(SELECT DISTINCT unnest(array_replace(category_ids, $1, $2)) ORDER BY 1),
+			updated_at = NOW()
+		WHERE user_id = $3 AND $1 = ANY(category_ids)
+	`, sourceID, targetID, userID)
+	if err != nil {
+		return 0, MapPgError(err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(updated), nil
+}
+
+// DeleteUserData удаляет бюджеты и цели накоплений пользователя перед
+// удалением аккаунта; уведомления и взносы удаляются каскадно
+func (r *PostgresRepository) DeleteUserData(ctx context.Context, userID int) (bdgmodels.UserDataDeletion, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return bdgmodels.UserDataDeletion{}, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM budget WHERE user_id = $1`, userID)
+	if err != nil {
+		return bdgmodels.UserDataDeletion{}, fmt.Errorf("failed to delete budgets: %w", err)
+	}
+	budgets, err := res.RowsAffected()
+	if err != nil {
+		return bdgmodels.UserDataDeletion{}, err
+	}
+
+	res, err = tx.ExecContext(ctx, `DELETE FROM savings_goal WHERE user_id = $1`, userID)
+	if err != nil {
+		return bdgmodels.UserDataDeletion{}, fmt.Errorf("failed to delete goals: %w", err)
+	}
+	goals, err := res.RowsAffected()
+	if err != nil {
+		return bdgmodels.UserDataDeletion{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return bdgmodels.UserDataDeletion{}, err
+	}
+	return bdgmodels.UserDataDeletion{BudgetsDeleted: int(budgets), GoalsDeleted: int(goals)}, nil
+}
@@ -25,10 +25,8 @@ func TestPostgresRepository_GetBudgetsByUser(t *testing.T) {
 	ctx := context.Background()
 	userID := 1
 
-	rows := sqlmock.NewRows([]string{
-		"_id", "user_id", "currency_id", "amount", "budget_description",
-		"created_at", "updated_at", "closed_at", "period_start", "period_end",
-	}).AddRow(1, userID, 1, 100.0, "desc", time.Now(), time.Now(), nil, time.Now(), time.Now())
+	rows := budgetRows().
+		AddRow(1, userID, 1, 100.0, "desc", time.Now(), time.Now(), nil, time.Now(), time.Now(), "{3,5}", "{}", "monthly", 5, true, 20.0, 1)
 
 	mock.ExpectQuery("SELECT _id, user_id, currency_id, amount, budget_description,").
 		WithArgs(userID).
@@ -38,6 +36,19 @@ func TestPostgresRepository_GetBudgetsByUser(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, budgets, 1)
 	require.Equal(t, 1, budgets[0].ID)
+	require.Equal(t, []int{3, 5}, budgets[0].CategoryIDs)
+	require.Empty(t, budgets[0].AccountIDs)
+	require.Equal(t, bdgmodels.Recurrence{Period: "monthly", Day: 5, CarryOver: true}, budgets[0].Recurrence)
+	require.Equal(t, 20.0, budgets[0].CarriedOver)
+}
+
+func budgetRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"_id", "user_id", "currency_id", "amount", "budget_description",
+		"created_at", "updated_at", "closed_at", "period_start", "period_end",
+		"category_ids", "account_ids", "recurrence", "recurrence_day", "carry_over", "carried_over",
+		"series_id",
+	})
 }
 
 func TestPostgresRepository_CreateBudget(t *testing.T) {
@@ -58,7 +69,8 @@ func TestPostgresRepository_CreateBudget(t *testing.T) {
 	}
 
 	mock.ExpectQuery("INSERT INTO budget").
-		WithArgs(budget.UserID, budget.CurrencyID, budget.Amount, budget.Description, budget.PeriodStart, budget.PeriodEnd).
+		WithArgs(budget.UserID, budget.CurrencyID, budget.Amount, budget.Description, budget.PeriodStart, budget.PeriodEnd,
+			pq.Int64Array{}, pq.Int64Array{}, "none", 0, false).
 		WillReturnRows(sqlmock.NewRows([]string{"_id", "created_at", "updated_at"}).AddRow(1, time.Now(), time.Now()))
 
 	created, err := repo.CreateBudget(ctx, budget)
@@ -98,10 +110,7 @@ func TestPostgresRepository_UpdateBudget(t *testing.T) {
 
 	mock.ExpectQuery("UPDATE budget").
 		WithArgs(amountArg, descArg, req.PeriodStart, req.PeriodEnd, req.BudgetID, req.UserID).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"_id", "user_id", "currency_id", "amount", "budget_description",
-			"created_at", "updated_at", "period_start", "period_end",
-		}).AddRow(
+		WillReturnRows(budgetRows().AddRow(
 			req.BudgetID,
 			req.UserID,
 			1,
@@ -109,8 +118,16 @@ func TestPostgresRepository_UpdateBudget(t *testing.T) {
 			*req.Description,
 			fixed.FixedTime,
 			fixed.FixedTime,
+			nil,
 			*req.PeriodStart,
 			*req.PeriodEnd,
+			"{}",
+			"{}",
+			"none",
+			0,
+			false,
+			0.0,
+			req.BudgetID,
 		),
 		)
 
@@ -131,10 +148,8 @@ func TestPostgresRepository_DeleteBudget(t *testing.T) {
 
 	mock.ExpectQuery("UPDATE budget").
 		WithArgs(budgetID).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"_id", "user_id", "currency_id", "amount", "budget_description",
-			"created_at", "updated_at", "period_start", "period_end",
-		}).AddRow(budgetID, 1, 1, 100.0, "desc", time.Now(), time.Now(), time.Now(), time.Now()))
+		WillReturnRows(budgetRows().
+			AddRow(budgetID, 1, 1, 100.0, "desc", time.Now(), time.Now(), time.Now(), time.Now(), time.Now(), "{}", "{}", "none", 0, false, 0.0, budgetID))
 
 	deleted, err := repo.DeleteBudget(ctx, budgetID)
 	require.NoError(t, err)
@@ -180,6 +195,7 @@ func TestPostgresRepository_CreateBudget_UniqueViolation(t *testing.T) {
 	mock.ExpectQuery("INSERT INTO budget").WithArgs(
 		budget.UserID, budget.CurrencyID, budget.Amount,
 		budget.Description, budget.PeriodStart, budget.PeriodEnd,
+		pq.Int64Array{}, pq.Int64Array{}, "none", 0, false,
 	).WillReturnError(mockErr)
 
 	_, err = repo.CreateBudget(ctx, budget)
@@ -207,6 +223,7 @@ func TestPostgresRepository_CreateBudget_NotNullViolation(t *testing.T) {
 	mock.ExpectQuery("INSERT INTO budget").WithArgs(
 		budget.UserID, budget.CurrencyID, budget.Amount,
 		budget.Description, budget.PeriodStart, budget.PeriodEnd,
+		pq.Int64Array{}, pq.Int64Array{}, "none", 0, false,
 	).WillReturnError(mockErr)
 
 	_, err = repo.CreateBudget(ctx, budget)
@@ -250,3 +267,119 @@ func TestPostgresRepository_DeleteBudget_NotFound(t *testing.T) {
 	_, err = repo.DeleteBudget(ctx, budgetID)
 	require.ErrorIs(t, err, bdgerrors.ErrBudgetNotFound)
 }
+
+func TestPostgresRepository_ImportBudgets(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	req := bdgmodels.ImportBudgetsRequest{
+		UserID:   1,
+		BackupID: "backup-1",
+		Budgets: []bdgmodels.Budget{
+			{ID: 3, CurrencyID: 1, Amount: 500, PeriodStart: now, PeriodEnd: now.AddDate(0, 1, 0)},
+			{ID: 4, CurrencyID: 1, Amount: 700, PeriodStart: now, PeriodEnd: now.AddDate(0, 2, 0)},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, "backup-1", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, "backup-1", 4).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("INSERT INTO budget").
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(40))
+	mock.ExpectExec("INSERT INTO restore_mapping").
+		WithArgs(1, "backup-1", 4, 40).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	restored, err := repo.ImportBudgets(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, restored)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ImportBudgets_RemapsScope(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	req := bdgmodels.ImportBudgetsRequest{
+		UserID:   1,
+		BackupID: "backup-1",
+		Budgets: []bdgmodels.Budget{
+			{ID: 3, CurrencyID: 1, Amount: 500, PeriodStart: now, PeriodEnd: now, CategoryIDs: []int{7, 8}},
+			{ID: 4, CurrencyID: 1, Amount: 700, PeriodStart: now, PeriodEnd: now, AccountIDs: []int{9}},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, "backup-1", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("SELECT COALESCE\\(array_agg").
+		WithArgs(1, "backup-1", restoreEntityCategory, pq.Int64Array{7, 8}).
+		WillReturnRows(sqlmock.NewRows([]string{"ids"}).AddRow("{70}"))
+	mock.ExpectQuery("INSERT INTO budget").
+		WithArgs(1, 1, 500.0, "", sqlmock.AnyArg(), nil, now, now, pq.Int64Array{70}, pq.Int64Array{}, "none", 0, false, 0.0).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(30))
+	mock.ExpectExec("INSERT INTO restore_mapping").
+		WithArgs(1, "backup-1", 3, 30).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, "backup-1", 4).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("SELECT COALESCE\\(array_agg").
+		WithArgs(1, "backup-1", restoreEntityAccount, pq.Int64Array{9}).
+		WillReturnRows(sqlmock.NewRows([]string{"ids"}).AddRow("{}"))
+	mock.ExpectCommit()
+
+	restored, err := repo.ImportBudgets(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, restored)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_ReplaceBudgetCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec(`UPDATE budget\s+SET category_ids = ARRAY\(SELECT DISTINCT unnest\(array_replace\(category_ids, \$1, \$2\)\) ORDER BY 1\)`).
+		WithArgs(5, 7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	updated, err := repo.ReplaceBudgetCategory(context.Background(), 1, 5, 7)
+	require.NoError(t, err)
+	require.Equal(t, 2, updated)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_DeleteUserData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM budget WHERE user_id = \$1`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM savings_goal WHERE user_id = \$1`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	res, err := repo.DeleteUserData(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, bdgmodels.UserDataDeletion{BudgetsDeleted: 3, GoalsDeleted: 1}, res)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
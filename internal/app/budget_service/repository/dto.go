@@ -1,17 +1,66 @@
 package budget
 
-import "time"
+import (
+	"time"
+
+	"github.com/lib/pq"
+
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+)
 
 type BudgetDB struct {
-	ID          int        `db:"budget_id"`
-	UserID      int        `db:"user_id"`
-	CategoryID  int        `db:"category_id"`
-	Amount      float64    `db:"amount"`
-	CurrencyID  int        `db:"currency_id"`
-	Description string     `db:"description"`
-	CreatedAt   time.Time  `db:"created_at"`
-	UpdatedAt   time.Time  `db:"updated_at"`
-	ClosedAt    *time.Time `db:"closed_at"`
-	PeriodStart time.Time  `db:"period_start"`
-	PeriodEnd   time.Time  `db:"period_end"`
+	ID            int           `db:"budget_id"`
+	UserID        int           `db:"user_id"`
+	Amount        float64       `db:"amount"`
+	CurrencyID    int           `db:"currency_id"`
+	Description   string        `db:"description"`
+	CreatedAt     time.Time     `db:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+	ClosedAt      *time.Time    `db:"closed_at"`
+	PeriodStart   time.Time     `db:"period_start"`
+	PeriodEnd     time.Time     `db:"period_end"`
+	CategoryIDs   pq.Int64Array `db:"category_ids"`
+	AccountIDs    pq.Int64Array `db:"account_ids"`
+	Recurrence    string        `db:"recurrence"`
+	RecurrenceDay int           `db:"recurrence_day"`
+	CarryOver     bool          `db:"carry_over"`
+	CarriedOver   float64       `db:"carried_over"`
+	SeriesID      int           `db:"series_id"`
+}
+
+func toIntArray(ids []int) pq.Int64Array {
+	arr := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		arr = append(arr, int64(id))
+	}
+	return arr
+}
+
+func fromIntArray(arr pq.Int64Array) []int {
+	ids := make([]int, 0, len(arr))
+	for _, id := range arr {
+		ids = append(ids, int(id))
+	}
+	return ids
+}
+
+func recurrenceOrNone(period string) string {
+	if period == "" {
+		return bdgmodels.RecurrenceNone
+	}
+	return period
+}
+
+type GoalDB struct {
+	ID           int        `db:"goal_id"`
+	UserID       int        `db:"user_id"`
+	Name         string     `db:"goal_name"`
+	Description  string     `db:"goal_description"`
+	TargetAmount float64    `db:"target_amount"`
+	CurrencyID   int        `db:"currency_id"`
+	Deadline     *time.Time `db:"deadline"`
+	AccountID    int        `db:"account_id"`
+	LogoHashedID string     `db:"logo_hashed_id"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at"`
 }
@@ -0,0 +1,250 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+)
+
+// goalColumns столбцы цели в порядке, который ожидает scanGoal; g — строка savings_goal.
+// Накопления цели считает сервис: баланс счета и статусы операций знает только finance_service.
+const goalColumns = `g._id, g.user_id, g.goal_name, g.goal_description, g.target_amount, g.currency_id,
+	g.deadline, COALESCE(g.account_id, 0), g.logo_hashed_id, g.created_at, g.updated_at`
+
+func scanGoal(row rowScanner) (bdgmodels.Goal, error) {
+	var g GoalDB
+	if err := row.Scan(
+		&g.ID,
+		&g.UserID,
+		&g.Name,
+		&g.Description,
+		&g.TargetAmount,
+		&g.CurrencyID,
+		&g.Deadline,
+		&g.AccountID,
+		&g.LogoHashedID,
+		&g.CreatedAt,
+		&g.UpdatedAt,
+	); err != nil {
+		return bdgmodels.Goal{}, err
+	}
+	return GoalDBToModel(g), nil
+}
+
+func mapGoalError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return bdgerrors.ErrGoalNotFound
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == ForeignKeyViolation {
+		return bdgerrors.ErrInavlidData
+	}
+	return MapPgError(err)
+}
+
+// GetGoalsByUser возвращает цели пользователя, начиная с ближайшего срока; цели без срока идут последними.
+func (r *PostgresRepository) GetGoalsByUser(ctx context.Context, userID int) ([]bdgmodels.Goal, error) {
+	query := `
+		SELECT ` + goalColumns + `
+		FROM savings_goal g
+		WHERE g.user_id = $1
+		ORDER BY g.deadline NULLS LAST, g.created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals by user: %w", err)
+	}
+	defer rows.Close()
+
+	goals := []bdgmodels.Goal{}
+	for rows.Next() {
+		goal, err := scanGoal(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan goal: %w", err)
+		}
+		goals = append(goals, goal)
+	}
+	return goals, rows.Err()
+}
+
+func (r *PostgresRepository) GetGoal(ctx context.Context, userID, goalID int) (bdgmodels.Goal, error) {
+	query := `
+		SELECT ` + goalColumns + `
+		FROM savings_goal g
+		WHERE g._id = $1 AND g.user_id = $2
+	`
+
+	goal, err := scanGoal(r.db.QueryRowContext(ctx, query, goalID, userID))
+	if err != nil {
+		return bdgmodels.Goal{}, mapGoalError(err)
+	}
+	return goal, nil
+}
+
+func (r *PostgresRepository) CreateGoal(ctx context.Context, goal bdgmodels.Goal) (bdgmodels.Goal, error) {
+	query := `
+		WITH g AS (
+			INSERT INTO savings_goal (
+				user_id, goal_name, goal_description, target_amount, currency_id,
+				deadline, account_id, logo_hashed_id, created_at, updated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+			RETURNING *
+		)
+		SELECT ` + goalColumns + `
+		FROM g`
+
+	created, err := scanGoal(r.db.QueryRowContext(ctx, query,
+		goal.UserID,
+		goal.Name,
+		goal.Description,
+		goal.TargetAmount,
+		goal.CurrencyID,
+		nullDate(goal.Deadline),
+		nullID(goal.AccountID),
+		goal.LogoHashedID,
+	))
+	if err != nil {
+		return bdgmodels.Goal{}, mapGoalError(err)
+	}
+	return created, nil
+}
+
+func (r *PostgresRepository) UpdateGoal(ctx context.Context, req bdgmodels.UpdateGoalRequest) (bdgmodels.Goal, error) {
+	query := `
+		WITH g AS (
+			UPDATE savings_goal
+			SET
+				goal_name = COALESCE($1, goal_name),
+				goal_description = COALESCE($2, goal_description),
+				target_amount = COALESCE($3, target_amount),
+				deadline = CASE WHEN $4::boolean THEN NULL ELSE COALESCE($5, deadline) END,
+				account_id = CASE WHEN $6::boolean THEN NULL ELSE COALESCE($7, account_id) END,
+				logo_hashed_id = COALESCE($8, logo_hashed_id),
+				updated_at = NOW()
+			WHERE _id = $9 AND user_id = $10
+			RETURNING *
+		)
+		SELECT ` + goalColumns + `
+		FROM g`
+
+	goal, err := scanGoal(r.db.QueryRowContext(ctx, query,
+		req.Name,
+		req.Description,
+		req.TargetAmount,
+		req.ClearDeadline,
+		req.Deadline,
+		req.ClearAccount,
+		req.AccountID,
+		req.LogoHashedID,
+		req.GoalID,
+		req.UserID,
+	))
+	if err != nil {
+		return bdgmodels.Goal{}, mapGoalError(err)
+	}
+	return goal, nil
+}
+
+// DeleteGoal удаляет цель вместе со взносами и возвращает ее последнее состояние.
+func (r *PostgresRepository) DeleteGoal(ctx context.Context, userID, goalID int) (bdgmodels.Goal, error) {
+	query := `
+		WITH g AS (
+			DELETE FROM savings_goal
+			WHERE _id = $1 AND user_id = $2
+			RETURNING *
+		)
+		SELECT ` + goalColumns + `
+		FROM g`
+
+	goal, err := scanGoal(r.db.QueryRowContext(ctx, query, goalID, userID))
+	if err != nil {
+		return bdgmodels.Goal{}, mapGoalError(err)
+	}
+	return goal, nil
+}
+
+// CreateContribution добавляет взнос; повторный взнос за счет той же операции — ErrContributionExists.
+func (r *PostgresRepository) CreateContribution(ctx context.Context, c bdgmodels.GoalContribution) (bdgmodels.GoalContribution, error) {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO goal_contribution (goal_id, operation_id, amount, note, contributed_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING _id, created_at
+	`, c.GoalID, nullID(c.OperationID), c.Amount, c.Note, c.ContributedAt).Scan(&c.ID, &c.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == UniqueViolation {
+			return bdgmodels.GoalContribution{}, bdgerrors.ErrContributionExists
+		}
+		return bdgmodels.GoalContribution{}, mapGoalError(err)
+	}
+	return c, nil
+}
+
+// GetContributions возвращает взносы целей, начиная с последнего. Взносы за счет
+// отмененных операций тоже возвращаются: статус операции проверяет сервис.
+func (r *PostgresRepository) GetContributions(ctx context.Context, goalIDs []int) ([]bdgmodels.GoalContribution, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c._id, c.goal_id, COALESCE(c.operation_id, 0), c.amount, c.note, c.contributed_at, c.created_at
+		FROM goal_contribution c
+		WHERE c.goal_id = ANY($1)
+		ORDER BY c.contributed_at DESC, c._id DESC
+	`, pq.Array(goalIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goal contributions: %w", err)
+	}
+	defer rows.Close()
+
+	contributions := []bdgmodels.GoalContribution{}
+	for rows.Next() {
+		var c bdgmodels.GoalContribution
+		if err := rows.Scan(&c.ID, &c.GoalID, &c.OperationID, &c.Amount, &c.Note, &c.ContributedAt, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan goal contribution: %w", err)
+		}
+		contributions = append(contributions, c)
+	}
+	return contributions, rows.Err()
+}
+
+func nullDate(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func nullID(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+// FilterUsedImages изображения из ids, которые стоят логотипами целей
+func (r *PostgresRepository) FilterUsedImages(ctx context.Context, ids []string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT DISTINCT logo_hashed_id FROM savings_goal WHERE logo_hashed_id = ANY($1)
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter used images: %w", err)
+	}
+	defer rows.Close()
+
+	var used []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan image id: %w", err)
+		}
+		used = append(used, id)
+	}
+	return used, rows.Err()
+}
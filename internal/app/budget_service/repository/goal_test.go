@@ -0,0 +1,191 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+)
+
+func goalRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"_id", "user_id", "goal_name", "goal_description", "target_amount", "currency_id",
+		"deadline", "account_id", "logo_hashed_id", "created_at", "updated_at",
+	})
+}
+
+func TestPostgresRepository_GetGoalsByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewPostgresRepository(db)
+
+	deadline := time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+	mock.ExpectQuery(`FROM savings_goal g\s+WHERE g.user_id = \$1`).
+		WithArgs(1).
+		WillReturnRows(goalRows().
+			AddRow(1, 1, "Отпуск", "", 100000.0, 1, deadline, 0, "img", now, now).
+			AddRow(2, 1, "Подушка", "", 300000.0, 1, nil, 7, "", now, now))
+
+	goals, err := repo.GetGoalsByUser(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, goals, 2)
+	require.Equal(t, deadline, goals[0].Deadline)
+	require.True(t, goals[1].Deadline.IsZero())
+	require.Equal(t, 7, goals[1].AccountID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_GetGoal_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`WHERE g._id = \$1 AND g.user_id = \$2`).
+		WithArgs(5, 1).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetGoal(context.Background(), 1, 5)
+	require.ErrorIs(t, err, bdgerrors.ErrGoalNotFound)
+}
+
+func TestPostgresRepository_CreateGoal(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewPostgresRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(`INSERT INTO savings_goal`).
+		WithArgs(1, "Отпуск", "", 100000.0, 1, nil, nil, "").
+		WillReturnRows(goalRows().AddRow(3, 1, "Отпуск", "", 100000.0, 1, nil, 0, "", now, now))
+
+	goal, err := repo.CreateGoal(context.Background(), bdgmodels.Goal{
+		UserID:       1,
+		Name:         "Отпуск",
+		TargetAmount: 100000,
+		CurrencyID:   1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, goal.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_CreateGoal_UnknownAccount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`INSERT INTO savings_goal`).
+		WillReturnError(&pq.Error{Code: ForeignKeyViolation})
+
+	_, err = repo.CreateGoal(context.Background(), bdgmodels.Goal{UserID: 1, Name: "Отпуск", TargetAmount: 1, CurrencyID: 1, AccountID: 9})
+	require.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+}
+
+func TestPostgresRepository_UpdateGoal(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewPostgresRepository(db)
+
+	name := "Новая машина"
+	now := time.Now()
+	mock.ExpectQuery(`UPDATE savings_goal`).
+		WithArgs(&name, nil, nil, true, nil, false, nil, nil, 3, 1).
+		WillReturnRows(goalRows().AddRow(3, 1, name, "", 100000.0, 1, nil, 0, "", now, now))
+
+	goal, err := repo.UpdateGoal(context.Background(), bdgmodels.UpdateGoalRequest{
+		UserID:        1,
+		GoalID:        3,
+		Name:          &name,
+		ClearDeadline: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, goal.Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_DeleteGoal_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`DELETE FROM savings_goal`).
+		WithArgs(3, 1).
+		WillReturnRows(goalRows())
+
+	_, err = repo.DeleteGoal(context.Background(), 1, 3)
+	require.ErrorIs(t, err, bdgerrors.ErrGoalNotFound)
+}
+
+func TestPostgresRepository_CreateContribution(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewPostgresRepository(db)
+
+	date := time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+	mock.ExpectQuery(`INSERT INTO goal_contribution`).
+		WithArgs(3, nil, 1500.0, "зарплата", date).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "created_at"}).AddRow(10, now))
+	mock.ExpectQuery(`INSERT INTO goal_contribution`).
+		WithArgs(3, 40, 5000.0, "", date).
+		WillReturnError(&pq.Error{Code: UniqueViolation})
+
+	c, err := repo.CreateContribution(context.Background(), bdgmodels.GoalContribution{GoalID: 3, Amount: 1500, Note: "зарплата", ContributedAt: date})
+	require.NoError(t, err)
+	require.Equal(t, 10, c.ID)
+
+	_, err = repo.CreateContribution(context.Background(), bdgmodels.GoalContribution{GoalID: 3, OperationID: 40, Amount: 5000, ContributedAt: date})
+	require.ErrorIs(t, err, bdgerrors.ErrContributionExists)
+}
+
+func TestPostgresRepository_GetContributions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewPostgresRepository(db)
+
+	date := time.Date(2030, 6, 3, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`FROM goal_contribution c\s+WHERE c.goal_id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]int{3})).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "goal_id", "operation_id", "amount", "note", "contributed_at", "created_at"}).
+			AddRow(11, 3, 40, 5000.0, "", date, date).
+			AddRow(10, 3, 0, 1500.0, "зарплата", date, date))
+
+	contributions, err := repo.GetContributions(context.Background(), []int{3})
+	require.NoError(t, err)
+	require.Len(t, contributions, 2)
+	require.Equal(t, 40, contributions[0].OperationID)
+	require.Equal(t, "зарплата", contributions[1].Note)
+}
+
+func TestPostgresRepository_FilterUsedImages(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`SELECT DISTINCT logo_hashed_id FROM savings_goal WHERE logo_hashed_id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]string{"aa", "bb"})).
+		WillReturnRows(sqlmock.NewRows([]string{"logo_hashed_id"}).AddRow("bb"))
+
+	used, err := repo.FilterUsedImages(context.Background(), []string{"aa", "bb"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bb"}, used)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
 	"github.com/lib/pq"
 )
 
@@ -33,3 +34,22 @@ func MapPgError(err error) error {
 		return err
 	}
 }
+
+func GoalDBToModel(g GoalDB) bdgmodels.Goal {
+	goal := bdgmodels.Goal{
+		ID:           g.ID,
+		UserID:       g.UserID,
+		Name:         g.Name,
+		Description:  g.Description,
+		TargetAmount: g.TargetAmount,
+		CurrencyID:   g.CurrencyID,
+		AccountID:    g.AccountID,
+		LogoHashedID: g.LogoHashedID,
+		CreatedAt:    g.CreatedAt,
+		UpdatedAt:    g.UpdatedAt,
+	}
+	if g.Deadline != nil {
+		goal.Deadline = *g.Deadline
+	}
+	return goal
+}
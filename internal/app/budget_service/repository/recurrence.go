@@ -0,0 +1,114 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+)
+
+// GetBudgetsDueForRollover возвращает открытые повторяющиеся бюджеты всех пользователей,
+// период которых закончился раньше today.
+func (r *PostgresRepository) GetBudgetsDueForRollover(ctx context.Context, today time.Time) ([]bdgmodels.Budget, error) {
+	query := `
+		SELECT ` + budgetColumns + `
+		FROM budget
+		WHERE closed_at IS NULL AND recurrence <> 'none' AND period_end < $1
+		ORDER BY period_end
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, today)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets due for rollover: %w", err)
+	}
+	defer rows.Close()
+
+	return scanBudgets(rows)
+}
+
+// RolloverBudget закрывает период prevID и создает следующий период next в одной транзакции.
+// Если бюджет на следующий период с тем же набором категорий и счетов уже создан вручную,
+// закрывается только старый период, а возвращаемый бюджет пуст (ID == 0).
+// ErrBudgetNotFound означает, что период уже закрыт — например, параллельным запуском.
+func (r *PostgresRepository) RolloverBudget(ctx context.Context, prevID int, next bdgmodels.Budget) (bdgmodels.Budget, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return bdgmodels.Budget{}, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE budget SET closed_at = NOW(), updated_at = NOW()
+		WHERE _id = $1 AND closed_at IS NULL
+	`, prevID)
+	if err != nil {
+		return bdgmodels.Budget{}, MapPgError(err)
+	}
+	closed, err := res.RowsAffected()
+	if err != nil {
+		return bdgmodels.Budget{}, err
+	}
+	if closed == 0 {
+		return bdgmodels.Budget{}, bdgerrors.ErrBudgetNotFound
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO budget (
+			user_id, currency_id, amount, budget_description,
+			created_at, updated_at, period_start, period_end,
+			category_ids, account_ids, recurrence, recurrence_day, carry_over, carried_over, series_id
+		)
+		VALUES ($1, $2, $3, $4, NOW(), NOW(), $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (user_id, currency_id, period_start, period_end, category_ids, account_ids) DO NOTHING
+		RETURNING _id, created_at, updated_at
+	`,
+		next.UserID,
+		next.CurrencyID,
+		next.Amount,
+		next.Description,
+		next.PeriodStart,
+		next.PeriodEnd,
+		toIntArray(next.CategoryIDs),
+		toIntArray(next.AccountIDs),
+		recurrenceOrNone(next.Period),
+		next.Day,
+		next.CarryOver,
+		next.CarriedOver,
+		next.SeriesID,
+	).Scan(&next.ID, &next.CreatedAt, &next.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		next = bdgmodels.Budget{}
+	} else if err != nil {
+		return bdgmodels.Budget{}, MapPgError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return bdgmodels.Budget{}, err
+	}
+	return next, nil
+}
+
+// GetBudgetSeries возвращает все периоды серии, к которой относится бюджет, начиная с последнего.
+// Для неповторяющегося бюджета серия состоит из него самого.
+func (r *PostgresRepository) GetBudgetSeries(ctx context.Context, userID, budgetID int) ([]bdgmodels.Budget, error) {
+	query := `
+		SELECT ` + budgetColumns + `
+		FROM budget
+		WHERE user_id = $1 AND COALESCE(series_id, _id) = (
+			SELECT COALESCE(series_id, _id) FROM budget WHERE _id = $2 AND user_id = $1
+		)
+		ORDER BY period_start DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, budgetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budget series: %w", err)
+	}
+	defer rows.Close()
+
+	return scanBudgets(rows)
+}
@@ -0,0 +1,134 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+)
+
+func nextPeriodBudget() bdgmodels.Budget {
+	return bdgmodels.Budget{
+		UserID:      1,
+		CurrencyID:  1,
+		Amount:      130,
+		PeriodStart: time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2030, 6, 30, 0, 0, 0, 0, time.UTC),
+		CategoryIDs: []int{3},
+		Recurrence:  bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly, Day: 1, CarryOver: true},
+		CarriedOver: 30,
+		SeriesID:    5,
+	}
+}
+
+func TestPostgresRepository_RolloverBudget(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	next := nextPeriodBudget()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE budget SET closed_at").
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("INSERT INTO budget").
+		WithArgs(1, 1, 130.0, "", next.PeriodStart, next.PeriodEnd, pq.Int64Array{3}, pq.Int64Array{},
+			"monthly", 1, true, 30.0, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "created_at", "updated_at"}).AddRow(8, time.Now(), time.Now()))
+	mock.ExpectCommit()
+
+	created, err := repo.RolloverBudget(context.Background(), 7, next)
+	require.NoError(t, err)
+	require.Equal(t, 8, created.ID)
+	require.Equal(t, 5, created.SeriesID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_RolloverBudget_NextExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE budget SET closed_at").
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("INSERT INTO budget").WillReturnError(sql.ErrNoRows)
+	mock.ExpectCommit()
+
+	created, err := repo.RolloverBudget(context.Background(), 7, nextPeriodBudget())
+	require.NoError(t, err)
+	require.Zero(t, created.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_RolloverBudget_AlreadyClosed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE budget SET closed_at").
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err = repo.RolloverBudget(context.Background(), 7, nextPeriodBudget())
+	require.ErrorIs(t, err, bdgerrors.ErrBudgetNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_GetBudgetsDueForRollover(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	today := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := today.AddDate(0, 0, -1)
+
+	mock.ExpectQuery("recurrence <> 'none' AND period_end < \\$1").
+		WithArgs(today).
+		WillReturnRows(budgetRows().
+			AddRow(7, 1, 1, 100.0, "", time.Now(), time.Now(), nil, end.AddDate(0, -1, 1), end, "{}", "{}", "monthly", 1, false, 0.0, 5))
+
+	due, err := repo.GetBudgetsDueForRollover(context.Background(), today)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, 5, due[0].SeriesID)
+	require.Equal(t, bdgmodels.RecurrenceMonthly, due[0].Period)
+}
+
+func TestPostgresRepository_GetBudgetSeries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	closedAt := time.Now()
+
+	mock.ExpectQuery("COALESCE\\(series_id, _id\\) = \\(").
+		WithArgs(1, 8).
+		WillReturnRows(budgetRows().
+			AddRow(8, 1, 1, 130.0, "", time.Now(), time.Now(), nil, time.Now(), time.Now(), "{}", "{}", "monthly", 1, true, 30.0, 5).
+			AddRow(5, 1, 1, 100.0, "", time.Now(), time.Now(), closedAt, time.Now(), time.Now(), "{}", "{}", "monthly", 1, true, 0.0, 5))
+
+	series, err := repo.GetBudgetSeries(context.Background(), 1, 8)
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+	require.True(t, series[0].ClosedAt.IsZero())
+	require.Equal(t, closedAt, series[1].ClosedAt)
+}
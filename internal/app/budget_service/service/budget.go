@@ -8,18 +8,21 @@ import (
 	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
 	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
 	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
 )
 
 type Service struct {
-	repo  BudgetRepository
-	clock clock.Clock
+	repo    BudgetRepository
+	finance finpb.FinanceServiceClient
+	clock   clock.Clock
 }
 
-func NewService(repo BudgetRepository, clck clock.Clock) *Service {
+func NewService(repo BudgetRepository, finance finpb.FinanceServiceClient, clck clock.Clock) *Service {
 	return &Service{
-		repo:  repo,
-		clock: clck,
+		repo:    repo,
+		finance: finance,
+		clock:   clck,
 	}
 }
 
@@ -46,36 +49,10 @@ func (s *Service) GetBudgets(ctx context.Context, userID int) (*bdgpb.ListBudget
 		return nil, pkgerrors.Wrap(err, "Failed to get budgets for user")
 	}
 
-	// accounts, err := s.repo.GetAccountsByUser(ctx, userID)
-	// if err != nil {
-	// 	return []models.Budget{}, pkgerrors.Wrap(err, "Failed to get accounts for user")
-	// }
-
-	// if accounts == nil {
-	// 	accounts = []models.Account{}
-	// }
-
 	for i := range budgets {
-		// var actual float64
-		// for _, account := range accounts {
-		// 	ops, err := s.repo.GetOperationsByAccount(ctx, account.ID)
-		// 	if err != nil {
-		// 		return []models.Budget{}, pkgerrors.Wrap(err, "Failed to get budgets for user")
-		// 	}
-		// 	for _, op := range ops {
-		// 		if op.CurrencyID != budgets[i].CurrencyID {
-		// 			continue
-		// 		}
-		// 		if op.CreatedAt.Before(budgets[i].PeriodStart) || op.CreatedAt.After(budgets[i].PeriodEnd) {
-		// 			continue
-		// 		}
-
-		// 		if op.Type == "expense" {
-		// 			actual += op.Sum
-		// 		}
-		// 	}
-		// } // пока нет сервиса
-		budgets[i].Actual = 123
+		if err := s.fillSpending(ctx, &budgets[i]); err != nil {
+			return nil, pkgerrors.Wrap(err, "Failed to get budget spending")
+		}
 	}
 
 	return ModelListToProto(budgets), nil
@@ -92,6 +69,9 @@ func (s *Service) GetBudgetByID(ctx context.Context, budgetID, userID int) (*bdg
 
 	for _, budget := range budgets {
 		if budget.ID == budgetID {
+			if err := s.fillSpending(ctx, &budget); err != nil {
+				return nil, pkgerrors.Wrap(err, "budget.GetBudgetByID: failed to get spending")
+			}
 			return ModelBudgetToProto(budget), nil
 		}
 	}
@@ -100,6 +80,17 @@ func (s *Service) GetBudgetByID(ctx context.Context, budgetID, userID int) (*bdg
 }
 
 func (s *Service) CreateBudget(ctx context.Context, req bdgmodels.CreateBudgetRequest, userID int) (*bdgpb.Budget, error) {
+	req.CategoryIDs = normalizeIDs(req.CategoryIDs)
+	req.AccountIDs = normalizeIDs(req.AccountIDs)
+	if err := s.checkScopeOwnership(ctx, userID, req.CategoryIDs, req.AccountIDs); err != nil {
+		return nil, err
+	}
+	recurrence, err := normalizeRecurrence(req.Recurrence, req.PeriodStart, req.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+	req.Recurrence = recurrence
+
 	budget := CreateRequestToModel(req, userID)
 	createdBgt, err := s.repo.CreateBudget(ctx, budget)
 	if err != nil {
@@ -134,3 +125,63 @@ func (s *Service) DeleteBudget(ctx context.Context, userID, budgetID int) (*bdgp
 
 	return ModelBudgetToProto(deletedBgt), nil
 }
+
+func (s *Service) ExportBudgets(ctx context.Context, userID int) (*bdgpb.ListBudgetsResponse, error) {
+	budgets, err := s.repo.GetAllBudgetsByUser(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to export budgets")
+	}
+
+	return ModelListToProto(budgets), nil
+}
+
+func (s *Service) ImportBudgets(ctx context.Context, req bdgmodels.ImportBudgetsRequest) (*bdgpb.ImportBudgetsResponse, error) {
+	if req.BackupID == "" {
+		return nil, bdgerrors.ErrInavlidData
+	}
+
+	restored, err := s.repo.ImportBudgets(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to import budgets")
+	}
+
+	return &bdgpb.ImportBudgetsResponse{BudgetsRestored: int32(restored)}, nil
+}
+
+func (s *Service) DeleteUserData(ctx context.Context, userID int) (*bdgpb.UserDataDeletion, error) {
+	res, err := s.repo.DeleteUserData(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to delete user data")
+	}
+
+	return &bdgpb.UserDataDeletion{
+		BudgetsDeleted: int32(res.BudgetsDeleted),
+		GoalsDeleted:   int32(res.GoalsDeleted),
+	}, nil
+}
+
+// ReplaceBudgetCategory переводит бюджеты с категории source на target,
+// после того как finance_service объединил категории
+func (s *Service) ReplaceBudgetCategory(ctx context.Context, userID, sourceID, targetID int) (*bdgpb.ReplaceCategoryResponse, error) {
+	if sourceID <= 0 || targetID <= 0 || sourceID == targetID {
+		return nil, bdgerrors.ErrInavlidData
+	}
+
+	updated, err := s.repo.ReplaceBudgetCategory(ctx, userID, sourceID, targetID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to replace budget category")
+	}
+
+	return &bdgpb.ReplaceCategoryResponse{BudgetsUpdated: int32(updated)}, nil
+}
+
+func (s *Service) FilterUsedImages(ctx context.Context, ids []string) (*bdgpb.ImageIDs, error) {
+	if len(ids) == 0 {
+		return &bdgpb.ImageIDs{}, nil
+	}
+	used, err := s.repo.FilterUsedImages(ctx, ids)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to filter used images")
+	}
+	return &bdgpb.ImageIDs{Ids: used}, nil
+}
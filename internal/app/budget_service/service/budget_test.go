@@ -9,18 +9,30 @@ import (
 
 	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
 	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
+// expectSpending ожидает запрос у finance_service расходов за период бюджета b
+func expectSpending(mockFinance *mocks.MockFinanceServiceClient, b bdgmodels.Budget) *gomock.Call {
+	return mockFinance.EXPECT().GetSpendingTotals(gomock.Any(), gomock.Cond(func(req *finpb.SpendingStatsRequest) bool {
+		return int(req.UserId) == b.UserID &&
+			int(req.CurrencyId) == b.CurrencyID &&
+			req.Start.AsTime().Equal(b.PeriodStart) &&
+			req.End.AsTime().Equal(b.PeriodEnd)
+	}))
+}
+
 func TestService_GetBudgets(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockBudgetRepository(ctrl)
-	svc := &Service{repo: mockRepo}
+	mockFinance := mocks.NewMockFinanceServiceClient(ctrl)
+	svc := &Service{repo: mockRepo, finance: mockFinance}
 
 	ctx := context.Background()
 	userID := 1
@@ -32,11 +44,15 @@ func TestService_GetBudgets(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		mockRepo.EXPECT().GetBudgetsByUser(ctx, userID).Return(budgets, nil)
+		expectSpending(mockFinance, budgets[0]).Return(&finpb.SpendingTotals{Total: 40}, nil)
+		expectSpending(mockFinance, budgets[1]).Return(&finpb.SpendingTotals{Total: 75}, nil)
 
 		resp, err := svc.GetBudgets(ctx, userID)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(resp.Budgets))
 		assert.Equal(t, int32(1), resp.Budgets[0].Id)
+		assert.Equal(t, 40.0, resp.Budgets[0].Actual)
+		assert.Equal(t, 75.0, resp.Budgets[1].Actual)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -46,6 +62,15 @@ func TestService_GetBudgets(t *testing.T) {
 		assert.Nil(t, resp)
 		assert.ErrorContains(t, err, "Failed to get budgets for user")
 	})
+
+	t.Run("spending error", func(t *testing.T) {
+		mockRepo.EXPECT().GetBudgetsByUser(ctx, userID).Return(budgets, nil)
+		expectSpending(mockFinance, budgets[0]).Return(nil, errors.New("finance unavailable"))
+
+		resp, err := svc.GetBudgets(ctx, userID)
+		assert.Nil(t, resp)
+		assert.ErrorContains(t, err, "Failed to get budget spending")
+	})
 }
 
 func TestService_GetBudgetByID(t *testing.T) {
@@ -53,20 +78,31 @@ func TestService_GetBudgetByID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockBudgetRepository(ctrl)
-	svc := &Service{repo: mockRepo}
+	mockFinance := mocks.NewMockFinanceServiceClient(ctrl)
+	svc := &Service{repo: mockRepo, finance: mockFinance}
 
 	ctx := context.Background()
 	userID := 1
 	budgetID := 1
 
-	budget := bdgmodels.Budget{ID: budgetID, UserID: userID, Amount: 100}
+	budget := bdgmodels.Budget{ID: budgetID, UserID: userID, Amount: 100, CategoryIDs: []int{3, 5}}
 
 	t.Run("success", func(t *testing.T) {
 		mockRepo.EXPECT().GetBudgetsByUser(ctx, userID).Return([]bdgmodels.Budget{budget}, nil).Times(2)
+		expectSpending(mockFinance, budget).Return(&finpb.SpendingTotals{Total: 60, ByCategory: []*finpb.CategorySpending{
+			{CategoryId: 3, Sum: 60},
+			{CategoryId: 5},
+		}}, nil)
 
 		resp, err := svc.GetBudgetByID(ctx, budgetID, userID)
 		assert.NoError(t, err)
 		assert.Equal(t, int32(budgetID), resp.Id)
+		assert.Equal(t, 60.0, resp.Actual)
+		assert.Equal(t, []int32{3, 5}, resp.CategoryIds)
+		assert.Len(t, resp.Categories, 2)
+		assert.Equal(t, 60.0, resp.Categories[0].Actual)
+		assert.Equal(t, int32(5), resp.Categories[1].CategoryId)
+		assert.Equal(t, 0.0, resp.Categories[1].Actual)
 	})
 
 	t.Run("forbidden", func(t *testing.T) {
@@ -82,7 +118,7 @@ func TestService_GetBudgetByID(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockBudgetRepository(ctrl)
-		s := NewService(mockRepo, clock.RealClock{})
+		s := NewService(mockRepo, nil, clock.RealClock{})
 
 		ctx := context.Background()
 		userID := 1
@@ -107,14 +143,31 @@ func TestService_CreateBudget(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockBudgetRepository(ctrl)
-	svc := &Service{repo: mockRepo}
+	mockFinance := mocks.NewMockFinanceServiceClient(ctrl)
+	svc := &Service{repo: mockRepo, finance: mockFinance}
 
 	ctx := context.Background()
 	userID := 1
-	req := bdgmodels.CreateBudgetRequest{CategoryID: 1, Amount: 100}
+	req := bdgmodels.CreateBudgetRequest{CategoryIDs: []int{1}, Amount: 100}
+	categories := func(ids ...int32) *finpb.ListCategoriesResponse {
+		resp := &finpb.ListCategoriesResponse{}
+		for _, id := range ids {
+			resp.Categories = append(resp.Categories, &finpb.Category{Id: id})
+		}
+		return resp
+	}
+	accounts := func(ids ...int32) *finpb.ListAccountsResponse {
+		resp := &finpb.ListAccountsResponse{}
+		for _, id := range ids {
+			resp.Accounts = append(resp.Accounts, &finpb.Account{Id: id})
+		}
+		return resp
+	}
+	byUser := &finpb.UserID{UserId: int32(userID)}
 	budget := bdgmodels.Budget{ID: 1, UserID: userID, Amount: 100}
 
 	t.Run("success", func(t *testing.T) {
+		mockFinance.EXPECT().GetCategoriesByUser(ctx, byUser).Return(categories(1, 2), nil)
 		mockRepo.EXPECT().CreateBudget(ctx, gomock.Any()).Return(budget, nil)
 
 		resp, err := svc.CreateBudget(ctx, req, userID)
@@ -122,7 +175,42 @@ func TestService_CreateBudget(t *testing.T) {
 		assert.Equal(t, int32(1), resp.Id)
 	})
 
+	t.Run("normalizes scope", func(t *testing.T) {
+		req := bdgmodels.CreateBudgetRequest{CategoryIDs: []int{5, 3, 5}, AccountIDs: []int{7}, Amount: 100}
+		mockFinance.EXPECT().GetCategoriesByUser(ctx, byUser).Return(categories(5, 3), nil)
+		mockFinance.EXPECT().GetAccountsByUser(ctx, byUser).Return(accounts(7), nil)
+		mockRepo.EXPECT().CreateBudget(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, b bdgmodels.Budget) (bdgmodels.Budget, error) {
+				assert.Equal(t, []int{3, 5}, b.CategoryIDs)
+				assert.Equal(t, []int{7}, b.AccountIDs)
+				b.ID = 2
+				return b, nil
+			})
+
+		resp, err := svc.CreateBudget(ctx, req, userID)
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{3, 5}, resp.CategoryIds)
+	})
+
+	t.Run("foreign category", func(t *testing.T) {
+		mockFinance.EXPECT().GetCategoriesByUser(ctx, byUser).Return(categories(2), nil)
+
+		resp, err := svc.CreateBudget(ctx, req, userID)
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+	})
+
+	t.Run("foreign account", func(t *testing.T) {
+		req := bdgmodels.CreateBudgetRequest{AccountIDs: []int{7}, Amount: 100}
+		mockFinance.EXPECT().GetAccountsByUser(ctx, byUser).Return(accounts(8), nil)
+
+		resp, err := svc.CreateBudget(ctx, req, userID)
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+	})
+
 	t.Run("repo error", func(t *testing.T) {
+		mockFinance.EXPECT().GetCategoriesByUser(ctx, byUser).Return(categories(1, 2), nil)
 		mockRepo.EXPECT().CreateBudget(ctx, gomock.Any()).Return(bdgmodels.Budget{}, errors.New("db fail"))
 
 		resp, err := svc.CreateBudget(ctx, req, userID)
@@ -210,3 +298,68 @@ func TestService_DeleteBudget(t *testing.T) {
 		assert.ErrorContains(t, err, "Failed to delete budget")
 	})
 }
+
+func TestService_ImportBudgets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	svc := &Service{repo: mockRepo}
+
+	ctx := context.Background()
+
+	t.Run("empty backup id", func(t *testing.T) {
+		_, err := svc.ImportBudgets(ctx, bdgmodels.ImportBudgetsRequest{UserID: 1})
+		assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		req := bdgmodels.ImportBudgetsRequest{UserID: 1, BackupID: "backup-1", Budgets: []bdgmodels.Budget{{ID: 1}}}
+		mockRepo.EXPECT().ImportBudgets(ctx, req).Return(1, nil)
+
+		resp, err := svc.ImportBudgets(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), resp.BudgetsRestored)
+	})
+}
+
+func TestService_ReplaceBudgetCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	svc := &Service{repo: mockRepo}
+
+	ctx := context.Background()
+
+	t.Run("same category", func(t *testing.T) {
+		_, err := svc.ReplaceBudgetCategory(ctx, 1, 5, 5)
+		assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().ReplaceBudgetCategory(ctx, 1, 5, 7).Return(2, nil)
+
+		resp, err := svc.ReplaceBudgetCategory(ctx, 1, 5, 7)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), resp.BudgetsUpdated)
+	})
+}
+
+func TestService_ExportBudgets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	svc := &Service{repo: mockRepo}
+
+	ctx := context.Background()
+	closedAt := time.Now()
+
+	mockRepo.EXPECT().GetAllBudgetsByUser(ctx, 1).Return([]bdgmodels.Budget{{ID: 1, UserID: 1, ClosedAt: closedAt}}, nil)
+
+	resp, err := svc.ExportBudgets(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Budgets, 1)
+	assert.NotNil(t, resp.Budgets[0].ClosedAt)
+}
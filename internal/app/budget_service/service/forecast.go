@@ -0,0 +1,162 @@
+package budget
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+)
+
+// forecastHistoryMonths за сколько месяцев до начала периода ищутся регулярные расходы
+const forecastHistoryMonths = 3
+
+// GetBudgetForecast прогнозирует расходы открытого бюджета на конец текущего периода.
+func (s *Service) GetBudgetForecast(ctx context.Context, budgetID, userID int) (*bdgpb.BudgetForecast, error) {
+	if !s.CheckBudgetOwnership(ctx, budgetID, userID) {
+		return nil, bdgerrors.ErrForbidden
+	}
+	budgets, err := s.repo.GetBudgetsByUser(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get budgets for user")
+	}
+
+	var budget *bdgmodels.Budget
+	for i := range budgets {
+		if budgets[i].ID == budgetID {
+			budget = &budgets[i]
+			break
+		}
+	}
+	if budget == nil {
+		return nil, bdgerrors.ErrBudgetNotFound
+	}
+
+	today := dateOf(s.clock.Now())
+	start := dateOf(budget.PeriodStart)
+	end := dateOf(budget.PeriodEnd)
+	statsEnd := today
+	if statsEnd.After(end) {
+		statsEnd = end
+	}
+	if statsEnd.Before(start) {
+		statsEnd = start
+	}
+
+	req := spendingRequest(*budget, start, statsEnd)
+	req.HistoryStart = timestamppb.New(start.AddDate(0, -forecastHistoryMonths, 0))
+	stats, err := s.finance.GetSpendingStats(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get spending stats")
+	}
+
+	days, recurring := SpendingStatsToModels(stats)
+	return ForecastToProto(BuildForecast(*budget, days, recurring, today)), nil
+}
+
+// BuildForecast строит прогноз на дату today. Расходы до конца периода складываются
+// из среднего дневного темпа нерегулярных расходов и регулярных расходов, которые
+// в этом периоде еще не было, но ожидаются до его окончания.
+// Дата превышения — первый день, к концу которого расходы станут больше суммы бюджета;
+// если бюджет уже превышен, это фактический день превышения.
+func BuildForecast(budget bdgmodels.Budget, days []bdgmodels.DailySpending, recurring []bdgmodels.RecurringExpense, today time.Time) bdgmodels.Forecast {
+	start := dateOf(budget.PeriodStart)
+	end := dateOf(budget.PeriodEnd)
+	asOf := dateOf(today)
+	if asOf.After(end) {
+		asOf = end
+	}
+
+	forecast := bdgmodels.Forecast{
+		BudgetID:    budget.ID,
+		Amount:      budget.Amount,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		AsOf:        asOf,
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Date.Before(days[j].Date) })
+	for _, day := range days {
+		date := dateOf(day.Date)
+		if date.Before(start) || date.After(asOf) {
+			continue
+		}
+		forecast.Actual += day.Sum
+		if forecast.ExceedDate.IsZero() && roundMoney(forecast.Actual) > budget.Amount {
+			forecast.ExceedDate = date
+		}
+	}
+
+	elapsed := 0
+	if !asOf.Before(start) {
+		elapsed = daysBetween(start, asOf) + 1
+	}
+	next := asOf.AddDate(0, 0, 1)
+	if next.Before(start) {
+		next = start
+	}
+
+	// регулярные расходы, уже прошедшие в этом периоде, не должны завышать темп
+	variable := forecast.Actual
+	for _, r := range recurring {
+		last := dateOf(r.LastDate)
+		if !last.Before(start) && !last.After(asOf) {
+			variable -= r.LastSum
+		} else if last.Before(start) {
+			if date := nextDayOfMonth(r.DayOfMonth, next); !date.After(end) {
+				forecast.Recurring = append(forecast.Recurring, bdgmodels.ForecastItem{
+					Name:       r.Name,
+					CategoryID: r.CategoryID,
+					Sum:        r.AvgSum,
+					Date:       date,
+				})
+			}
+		}
+	}
+	if elapsed > 0 && variable > 0 {
+		forecast.DailyPace = variable / float64(elapsed)
+	}
+	sort.SliceStable(forecast.Recurring, func(i, j int) bool {
+		return forecast.Recurring[i].Date.Before(forecast.Recurring[j].Date)
+	})
+
+	running := forecast.Actual
+	item := 0
+	for date := next; !date.After(end); date = date.AddDate(0, 0, 1) {
+		running += forecast.DailyPace
+		for ; item < len(forecast.Recurring) && forecast.Recurring[item].Date.Equal(date); item++ {
+			running += forecast.Recurring[item].Sum
+		}
+		if forecast.ExceedDate.IsZero() && roundMoney(running) > budget.Amount {
+			forecast.ExceedDate = date
+		}
+	}
+
+	forecast.Actual = roundMoney(forecast.Actual)
+	forecast.Projected = roundMoney(running)
+	forecast.DailyPace = roundMoney(forecast.DailyPace)
+	return forecast
+}
+
+// nextDayOfMonth возвращает ближайшее не раньше from число day месяца.
+func nextDayOfMonth(day int, from time.Time) time.Time {
+	date := dayOfMonth(from.Year(), from.Month(), day)
+	if date.Before(from) {
+		date = dayOfMonth(from.Year(), from.Month()+1, day)
+	}
+	return date
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,143 @@
+package budget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func forecastBudget(amount float64) bdgmodels.Budget {
+	return bdgmodels.Budget{
+		ID:          5,
+		UserID:      1,
+		CurrencyID:  2,
+		Amount:      amount,
+		PeriodStart: date(2030, 6, 1),
+		PeriodEnd:   date(2030, 6, 30),
+		CategoryIDs: []int{10},
+	}
+}
+
+func forecastDays() []bdgmodels.DailySpending {
+	return []bdgmodels.DailySpending{
+		{Date: date(2030, 6, 8), Sum: 2000},
+		{Date: date(2030, 6, 2), Sum: 1000},
+		{Date: date(2030, 6, 5), Sum: 30000},
+	}
+}
+
+func forecastRecurring() []bdgmodels.RecurringExpense {
+	return []bdgmodels.RecurringExpense{
+		// аренда уже оплачена в этом периоде и не входит в дневной темп
+		{Name: "Аренда", CategoryID: 10, AvgSum: 30000, DayOfMonth: 5, LastDate: date(2030, 6, 5), LastSum: 30000},
+		{Name: "Кино", CategoryID: 10, AvgSum: 500, DayOfMonth: 20, LastDate: date(2030, 5, 20), LastSum: 450},
+		// день платежа в этом месяце уже прошел, следующий придется на следующий период
+		{Name: "Спортзал", CategoryID: 10, AvgSum: 3000, DayOfMonth: 3, LastDate: date(2030, 5, 3), LastSum: 3000},
+	}
+}
+
+func TestBuildForecast(t *testing.T) {
+	f := BuildForecast(forecastBudget(50000), forecastDays(), forecastRecurring(), time.Date(2030, 6, 10, 18, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, 33000.0, f.Actual)
+	assert.Equal(t, 300.0, f.DailyPace)
+	assert.Equal(t, 39500.0, f.Projected)
+	assert.True(t, f.ExceedDate.IsZero())
+	assert.Equal(t, date(2030, 6, 10), f.AsOf)
+	assert.Equal(t, []bdgmodels.ForecastItem{
+		{Name: "Кино", CategoryID: 10, Sum: 500, Date: date(2030, 6, 20)},
+	}, f.Recurring)
+}
+
+func TestBuildForecast_ExceedDate(t *testing.T) {
+	f := BuildForecast(forecastBudget(38000), forecastDays(), forecastRecurring(), date(2030, 6, 10))
+
+	// к 20 июня 36 500, затем по 300 в день: 38 000 — 25 июня, 38 300 — 26 июня
+	assert.Equal(t, date(2030, 6, 26), f.ExceedDate)
+	assert.Equal(t, 39500.0, f.Projected)
+}
+
+func TestBuildForecast_AlreadyExceeded(t *testing.T) {
+	f := BuildForecast(forecastBudget(30000), forecastDays(), forecastRecurring(), date(2030, 6, 10))
+
+	assert.Equal(t, date(2030, 6, 5), f.ExceedDate)
+}
+
+func TestBuildForecast_PeriodOver(t *testing.T) {
+	f := BuildForecast(forecastBudget(50000), forecastDays(), forecastRecurring(), date(2030, 7, 3))
+
+	assert.Equal(t, date(2030, 6, 30), f.AsOf)
+	assert.Equal(t, 33000.0, f.Projected)
+	assert.Empty(t, f.Recurring)
+}
+
+func TestBuildForecast_BeforePeriod(t *testing.T) {
+	f := BuildForecast(forecastBudget(50000), nil, forecastRecurring(), date(2030, 5, 25))
+
+	// до начала периода в прогноз попадают все ожидаемые регулярные расходы
+	assert.Zero(t, f.Actual)
+	assert.Zero(t, f.DailyPace)
+	assert.Equal(t, 3500.0, f.Projected)
+	assert.Len(t, f.Recurring, 2)
+}
+
+func TestService_GetBudgetForecast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	mockFinance := mocks.NewMockFinanceServiceClient(ctrl)
+	svc := NewService(mockRepo, mockFinance, clock.FixedClock{FixedTime: time.Date(2030, 6, 10, 18, 0, 0, 0, time.UTC)})
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetBudgetsByUser(ctx, 1).Return([]bdgmodels.Budget{forecastBudget(50000)}, nil).Times(2)
+	mockFinance.EXPECT().GetSpendingStats(ctx, &finpb.SpendingStatsRequest{
+		UserId:       1,
+		CurrencyId:   2,
+		CategoryIds:  []int32{10},
+		AccountIds:   []int32{},
+		Start:        timestamppb.New(date(2030, 6, 1)),
+		End:          timestamppb.New(date(2030, 6, 10)),
+		HistoryStart: timestamppb.New(date(2030, 3, 1)),
+	}).Return(&finpb.SpendingStatsResponse{
+		Days: []*finpb.DailySpending{{Date: timestamppb.New(date(2030, 6, 2)), Sum: 1000}},
+		Recurring: []*finpb.RecurringExpense{
+			{Name: "Кино", CategoryId: 10, AvgSum: 500, DayOfMonth: 20, LastDate: timestamppb.New(date(2030, 5, 20))},
+		},
+	}, nil)
+
+	res, err := svc.GetBudgetForecast(ctx, 5, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), res.BudgetId)
+	assert.Equal(t, 1000.0, res.Actual)
+	assert.Equal(t, 100.0, res.DailyPace)
+	assert.Equal(t, 3500.0, res.Projected)
+	assert.Nil(t, res.ExceedDate)
+	require.Len(t, res.Recurring, 1)
+	assert.Equal(t, date(2030, 6, 20), res.Recurring[0].Date.AsTime())
+}
+
+func TestService_GetBudgetForecast_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.FixedClock{FixedTime: date(2030, 6, 10)})
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetBudgetsByUser(ctx, 2).Return([]bdgmodels.Budget{}, nil)
+
+	_, err := svc.GetBudgetForecast(ctx, 5, 2)
+	assert.ErrorIs(t, err, bdgerrors.ErrForbidden)
+}
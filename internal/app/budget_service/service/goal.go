@@ -0,0 +1,376 @@
+package budget
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pkgerrors "github.com/pkg/errors"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+const (
+	maxGoalNameLen        = 60
+	maxGoalDescriptionLen = 250
+	maxContributionNote   = 120
+
+	// operationReverted статус отмененной операции в finance_service
+	operationReverted = "reverted"
+)
+
+// GoalProgressAt считает прогресс цели на дату today.
+// Нужный ежемесячный взнос делит остаток поровну между оставшимися взносами
+// и округляется до копеек вверх, чтобы к сроку накопилась вся сумма.
+// Если срок прошел, а цель не достигнута, весь остаток нужен сразу.
+func GoalProgressAt(goal bdgmodels.Goal, today time.Time) bdgmodels.GoalProgress {
+	progress := bdgmodels.GoalProgress{Goal: goal}
+	progress.Saved = roundMoney(goal.Saved)
+	progress.Remaining = roundMoney(math.Max(goal.TargetAmount-goal.Saved, 0))
+	progress.Achieved = progress.Remaining == 0
+	if goal.TargetAmount > 0 {
+		progress.Percent = math.Round(math.Min(math.Max(goal.Saved/goal.TargetAmount, 0), 1)*1000) / 10
+	}
+	if progress.Achieved || goal.Deadline.IsZero() {
+		return progress
+	}
+
+	today = dateOf(today)
+	deadline := dateOf(goal.Deadline)
+	if deadline.Before(today) {
+		progress.Overdue = true
+		progress.MonthlyContribution = progress.Remaining
+		return progress
+	}
+
+	progress.MonthsLeft = monthsUntil(today, deadline)
+	progress.MonthlyContribution = math.Ceil(progress.Remaining/float64(progress.MonthsLeft)*100) / 100
+	return progress
+}
+
+// monthsUntil считает ежемесячные даты, начиная с from, которые не позже to.
+func monthsUntil(from, to time.Time) int {
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	if dayOfMonth(from.Year(), from.Month()+time.Month(months), from.Day()).After(to) {
+		months--
+	}
+	return months + 1
+}
+
+func validGoalName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= maxGoalNameLen
+}
+
+// checkGoalAccount проверяет через finance_service, что счет доступен пользователю
+// и ведется в валюте цели.
+func (s *Service) checkGoalAccount(ctx context.Context, userID, accountID, currencyID int) error {
+	resp, err := s.finance.GetAccountsByUser(ctx, &finpb.UserID{UserId: int32(userID)})
+	if err != nil {
+		return pkgerrors.Wrap(err, "Failed to check goal account")
+	}
+	for _, acc := range resp.GetAccounts() {
+		if int(acc.Id) == accountID {
+			if int(acc.CurrencyId) != currencyID {
+				return bdgerrors.ErrInavlidData
+			}
+			return nil
+		}
+	}
+	return bdgerrors.ErrInavlidData
+}
+
+// fillGoalsSaved считает накопления целей. Цели со счетом получают баланс счета,
+// если пользователь еще участвует в нем; остальные — сумму взносов без отмененных операций.
+func (s *Service) fillGoalsSaved(ctx context.Context, userID int, goals []bdgmodels.Goal) error {
+	var manual []int
+	linked := false
+	for _, goal := range goals {
+		if goal.AccountID == 0 {
+			manual = append(manual, goal.ID)
+		} else {
+			linked = true
+		}
+	}
+
+	balances := map[int]float64{}
+	if linked {
+		resp, err := s.finance.GetAccountsByUser(ctx, &finpb.UserID{UserId: int32(userID)})
+		if err != nil {
+			return pkgerrors.Wrap(err, "Failed to get goal accounts")
+		}
+		for _, acc := range resp.GetAccounts() {
+			balances[int(acc.Id)] = acc.Balance
+		}
+	}
+
+	saved := map[int]float64{}
+	if len(manual) > 0 {
+		contributions, err := s.repo.GetContributions(ctx, manual)
+		if err != nil {
+			return pkgerrors.Wrap(err, "Failed to get goal contributions")
+		}
+		contributions, err = s.countedContributions(ctx, userID, contributions)
+		if err != nil {
+			return err
+		}
+		for _, c := range contributions {
+			saved[c.GoalID] += c.Amount
+		}
+	}
+
+	for i := range goals {
+		if goals[i].AccountID != 0 {
+			goals[i].Saved = balances[goals[i].AccountID]
+		} else {
+			goals[i].Saved = saved[goals[i].ID]
+		}
+	}
+	return nil
+}
+
+// countedContributions оставляет взносы, которые учитываются в прогрессе: без операции
+// или за счет неотмененной операции. Взнос за счет операции со счета, к которому
+// у пользователя больше нет доступа, остается — деньги уже были отложены.
+func (s *Service) countedContributions(ctx context.Context, userID int, contributions []bdgmodels.GoalContribution) ([]bdgmodels.GoalContribution, error) {
+	var opIDs []int32
+	for _, c := range contributions {
+		if c.OperationID != 0 {
+			opIDs = append(opIDs, int32(c.OperationID))
+		}
+	}
+	if len(opIDs) == 0 {
+		return contributions, nil
+	}
+
+	resp, err := s.finance.GetOperationsByIDs(ctx, &finpb.OperationsByIDsRequest{UserId: int32(userID), OperationIds: opIDs})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get contribution operations")
+	}
+	reverted := map[int]bool{}
+	for _, op := range resp.GetOperations() {
+		if op.Status == operationReverted {
+			reverted[int(op.Id)] = true
+		}
+	}
+
+	counted := contributions[:0:0]
+	for _, c := range contributions {
+		if !reverted[c.OperationID] {
+			counted = append(counted, c)
+		}
+	}
+	return counted, nil
+}
+
+// contributionOperation операция пользователя, за счет которой делается взнос.
+// ErrInavlidData означает, что операции нет на счетах пользователя или она отменена.
+func (s *Service) contributionOperation(ctx context.Context, userID, operationID int) (bdgmodels.ContributionOperation, error) {
+	resp, err := s.finance.GetOperationsByIDs(ctx, &finpb.OperationsByIDsRequest{
+		UserId:       int32(userID),
+		OperationIds: []int32{int32(operationID)},
+	})
+	if err != nil {
+		return bdgmodels.ContributionOperation{}, pkgerrors.Wrap(err, "Failed to get contribution operation")
+	}
+	for _, op := range resp.GetOperations() {
+		if int(op.Id) == operationID && op.Status != operationReverted {
+			return bdgmodels.ContributionOperation{
+				ID:         int(op.Id),
+				Sum:        op.Sum,
+				CurrencyID: int(op.CurrencyId),
+				Date:       op.Date.AsTime(),
+			}, nil
+		}
+	}
+	return bdgmodels.ContributionOperation{}, bdgerrors.ErrInavlidData
+}
+
+// goalProgress считает накопления одной цели и ее прогресс на дату today.
+func (s *Service) goalProgress(ctx context.Context, goal bdgmodels.Goal, today time.Time) (*bdgpb.Goal, error) {
+	goals := []bdgmodels.Goal{goal}
+	if err := s.fillGoalsSaved(ctx, goal.UserID, goals); err != nil {
+		return nil, err
+	}
+	return GoalProgressToProto(GoalProgressAt(goals[0], today)), nil
+}
+
+func (s *Service) GetGoals(ctx context.Context, userID int) (*bdgpb.ListGoalsResponse, error) {
+	goals, err := s.repo.GetGoalsByUser(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get goals for user")
+	}
+	if err := s.fillGoalsSaved(ctx, userID, goals); err != nil {
+		return nil, err
+	}
+
+	today := s.clock.Now()
+	res := &bdgpb.ListGoalsResponse{Goals: make([]*bdgpb.Goal, 0, len(goals))}
+	for _, goal := range goals {
+		res.Goals = append(res.Goals, GoalProgressToProto(GoalProgressAt(goal, today)))
+	}
+	return res, nil
+}
+
+func (s *Service) GetGoal(ctx context.Context, goalID, userID int) (*bdgpb.Goal, error) {
+	goal, err := s.repo.GetGoal(ctx, userID, goalID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get goal")
+	}
+	return s.goalProgress(ctx, goal, s.clock.Now())
+}
+
+func (s *Service) CreateGoal(ctx context.Context, req bdgmodels.CreateGoalRequest) (*bdgpb.Goal, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	today := dateOf(s.clock.Now())
+	if !validGoalName(req.Name) ||
+		utf8.RuneCountInString(req.Description) > maxGoalDescriptionLen ||
+		req.TargetAmount <= 0 || req.CurrencyID <= 0 || req.AccountID < 0 ||
+		(!req.Deadline.IsZero() && dateOf(req.Deadline).Before(today)) {
+		return nil, bdgerrors.ErrInavlidData
+	}
+	if req.AccountID > 0 {
+		if err := s.checkGoalAccount(ctx, req.UserID, req.AccountID, req.CurrencyID); err != nil {
+			return nil, err
+		}
+	}
+
+	goal, err := s.repo.CreateGoal(ctx, CreateGoalRequestToModel(req))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to create goal")
+	}
+	return s.goalProgress(ctx, goal, today)
+}
+
+func (s *Service) UpdateGoal(ctx context.Context, req bdgmodels.UpdateGoalRequest) (*bdgpb.Goal, error) {
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if !validGoalName(name) {
+			return nil, bdgerrors.ErrInavlidData
+		}
+		req.Name = &name
+	}
+	today := dateOf(s.clock.Now())
+	if (req.Description != nil && utf8.RuneCountInString(*req.Description) > maxGoalDescriptionLen) ||
+		(req.TargetAmount != nil && *req.TargetAmount <= 0) ||
+		(req.Deadline != nil && (req.ClearDeadline || dateOf(*req.Deadline).Before(today))) ||
+		(req.AccountID != nil && (req.ClearAccount || *req.AccountID <= 0)) {
+		return nil, bdgerrors.ErrInavlidData
+	}
+
+	if req.AccountID != nil {
+		goal, err := s.repo.GetGoal(ctx, req.UserID, req.GoalID)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "Failed to get goal")
+		}
+		if err := s.checkGoalAccount(ctx, req.UserID, *req.AccountID, goal.CurrencyID); err != nil {
+			return nil, err
+		}
+	}
+
+	goal, err := s.repo.UpdateGoal(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to update goal")
+	}
+	return s.goalProgress(ctx, goal, today)
+}
+
+// DeleteGoal возвращает последнее состояние цели; накопления считаются до удаления,
+// потому что взносы удаляются вместе с целью.
+func (s *Service) DeleteGoal(ctx context.Context, goalID, userID int) (*bdgpb.Goal, error) {
+	goal, err := s.repo.GetGoal(ctx, userID, goalID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get goal")
+	}
+	goals := []bdgmodels.Goal{goal}
+	if err := s.fillGoalsSaved(ctx, userID, goals); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.repo.DeleteGoal(ctx, userID, goalID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to delete goal")
+	}
+	deleted.Saved = goals[0].Saved
+	return GoalProgressToProto(GoalProgressAt(deleted, s.clock.Now())), nil
+}
+
+// AddGoalContribution добавляет взнос в цель без привязанного счета.
+// Взнос за счет операции по умолчанию равен ее сумме и датируется днем операции;
+// валюта операции должна совпадать с валютой цели. Отрицательный взнос — снятие накоплений.
+func (s *Service) AddGoalContribution(ctx context.Context, req bdgmodels.CreateContributionRequest) (*bdgpb.GoalContribution, error) {
+	if utf8.RuneCountInString(req.Note) > maxContributionNote || req.OperationID < 0 {
+		return nil, bdgerrors.ErrInavlidData
+	}
+
+	goal, err := s.repo.GetGoal(ctx, req.UserID, req.GoalID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get goal")
+	}
+	if goal.AccountID != 0 {
+		// прогресс такой цели — баланс счета, взносы в нем не участвуют
+		return nil, bdgerrors.ErrInavlidData
+	}
+
+	contribution := bdgmodels.GoalContribution{
+		GoalID:        goal.ID,
+		OperationID:   req.OperationID,
+		Amount:        roundMoney(req.Amount),
+		Note:          req.Note,
+		ContributedAt: req.ContributedAt,
+	}
+	today := dateOf(s.clock.Now())
+	if req.OperationID > 0 {
+		op, err := s.contributionOperation(ctx, req.UserID, req.OperationID)
+		if err != nil {
+			return nil, err
+		}
+		if op.CurrencyID != 0 && op.CurrencyID != goal.CurrencyID {
+			return nil, bdgerrors.ErrInavlidData
+		}
+		if contribution.Amount == 0 {
+			contribution.Amount = op.Sum
+		}
+		if contribution.ContributedAt.IsZero() {
+			contribution.ContributedAt = op.Date
+		}
+	}
+	if contribution.ContributedAt.IsZero() {
+		contribution.ContributedAt = today
+	}
+	contribution.ContributedAt = dateOf(contribution.ContributedAt)
+	if contribution.Amount == 0 || contribution.ContributedAt.After(today) {
+		return nil, bdgerrors.ErrInavlidData
+	}
+
+	created, err := s.repo.CreateContribution(ctx, contribution)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to create goal contribution")
+	}
+	return ContributionToProto(created), nil
+}
+
+func (s *Service) GetGoalContributions(ctx context.Context, goalID, userID int) (*bdgpb.ListContributionsResponse, error) {
+	if _, err := s.repo.GetGoal(ctx, userID, goalID); err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get goal")
+	}
+
+	contributions, err := s.repo.GetContributions(ctx, []int{goalID})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get goal contributions")
+	}
+	contributions, err = s.countedContributions(ctx, userID, contributions)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &bdgpb.ListContributionsResponse{Contributions: make([]*bdgpb.GoalContribution, 0, len(contributions))}
+	for _, c := range contributions {
+		res.Contributions = append(res.Contributions, ContributionToProto(c))
+	}
+	return res, nil
+}
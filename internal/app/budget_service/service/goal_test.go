@@ -0,0 +1,312 @@
+package budget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestGoalProgressAt(t *testing.T) {
+	today := time.Date(2030, 6, 10, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		goal    bdgmodels.Goal
+		percent float64
+		months  int
+		monthly float64
+		overdue bool
+	}{
+		{
+			name:    "no deadline",
+			goal:    bdgmodels.Goal{TargetAmount: 1000, Saved: 250},
+			percent: 25,
+		},
+		{
+			// июнь, июль, ..., декабрь — 7 взносов, 70 000 / 7
+			name:    "deadline at the end of the year",
+			goal:    bdgmodels.Goal{TargetAmount: 100000, Saved: 30000, Deadline: date(2030, 12, 31)},
+			percent: 30,
+			months:  7,
+			monthly: 10000,
+		},
+		{
+			// 10 декабря еще успевает, 9-го — нет
+			name:    "deadline before the same day of month",
+			goal:    bdgmodels.Goal{TargetAmount: 1000, Deadline: date(2030, 12, 9)},
+			months:  6,
+			monthly: 166.67,
+		},
+		{
+			name:    "deadline this month",
+			goal:    bdgmodels.Goal{TargetAmount: 1000, Saved: 400, Deadline: date(2030, 6, 20)},
+			percent: 40,
+			months:  1,
+			monthly: 600,
+		},
+		{
+			name:    "overdue",
+			goal:    bdgmodels.Goal{TargetAmount: 1000, Saved: 400, Deadline: date(2030, 6, 1)},
+			percent: 40,
+			monthly: 600,
+			overdue: true,
+		},
+		{
+			name:    "achieved",
+			goal:    bdgmodels.Goal{TargetAmount: 1000, Saved: 1200, Deadline: date(2030, 6, 1)},
+			percent: 100,
+		},
+		{
+			name: "withdrawn below zero",
+			goal: bdgmodels.Goal{TargetAmount: 1000, Saved: -100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := GoalProgressAt(tt.goal, today)
+			assert.Equal(t, tt.percent, p.Percent)
+			assert.Equal(t, tt.months, p.MonthsLeft)
+			assert.Equal(t, tt.monthly, p.MonthlyContribution)
+			assert.Equal(t, tt.overdue, p.Overdue)
+			assert.Equal(t, tt.goal.Saved >= tt.goal.TargetAmount, p.Achieved)
+		})
+	}
+}
+
+func TestMonthsUntil_ShortMonth(t *testing.T) {
+	// 31 января -> 28 февраля: февральский взнос приходится на последний день месяца
+	assert.Equal(t, 2, monthsUntil(date(2030, 1, 31), date(2030, 2, 28)))
+	assert.Equal(t, 1, monthsUntil(date(2030, 1, 31), date(2030, 2, 27)))
+}
+
+func newGoalTestService(t *testing.T) (*Service, *mocks.MockBudgetRepository, *mocks.MockFinanceServiceClient) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	return NewService(mockRepo, mockFin, clock.FixedClock{FixedTime: time.Date(2030, 6, 10, 15, 0, 0, 0, time.UTC)}), mockRepo, mockFin
+}
+
+func userAccounts(accounts ...*finpb.Account) *finpb.ListAccountsResponse {
+	return &finpb.ListAccountsResponse{Accounts: accounts}
+}
+
+func TestService_GetGoals(t *testing.T) {
+	svc, mockRepo, mockFin := newGoalTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetGoalsByUser(ctx, 1).Return([]bdgmodels.Goal{
+		{ID: 1, UserID: 1, TargetAmount: 1000},
+		{ID: 2, UserID: 1, TargetAmount: 1000, AccountID: 7},
+		// пользователь вышел из общего счета 8
+		{ID: 3, UserID: 1, TargetAmount: 1000, AccountID: 8},
+	}, nil)
+	mockFin.EXPECT().
+		GetAccountsByUser(ctx, &finpb.UserID{UserId: 1}).
+		Return(userAccounts(&finpb.Account{Id: 7, Balance: 600, CurrencyId: 1}), nil)
+	mockRepo.EXPECT().GetContributions(ctx, []int{1}).Return([]bdgmodels.GoalContribution{
+		{ID: 10, GoalID: 1, OperationID: 40, Amount: 300},
+		{ID: 11, GoalID: 1, OperationID: 41, Amount: 200},
+		{ID: 12, GoalID: 1, Amount: 50},
+	}, nil)
+	mockFin.EXPECT().
+		GetOperationsByIDs(ctx, &finpb.OperationsByIDsRequest{UserId: 1, OperationIds: []int32{40, 41}}).
+		Return(&finpb.OperationsByIDsResponse{Operations: []*finpb.Operation{
+			{Id: 40, Status: "finished"},
+			{Id: 41, Status: "reverted"},
+		}}, nil)
+
+	res, err := svc.GetGoals(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, res.Goals, 3)
+	assert.Equal(t, 350.0, res.Goals[0].Saved)
+	assert.Equal(t, 600.0, res.Goals[1].Saved)
+	assert.Zero(t, res.Goals[2].Saved)
+}
+
+func TestService_DeleteGoal(t *testing.T) {
+	svc, mockRepo, _ := newGoalTestService(t)
+	ctx := context.Background()
+
+	// взносы удаляются вместе с целью, поэтому накопления считаются до удаления
+	gomock.InOrder(
+		mockRepo.EXPECT().GetGoal(ctx, 1, 3).Return(bdgmodels.Goal{ID: 3, UserID: 1, TargetAmount: 1000}, nil),
+		mockRepo.EXPECT().GetContributions(ctx, []int{3}).Return([]bdgmodels.GoalContribution{{GoalID: 3, Amount: 250}}, nil),
+		mockRepo.EXPECT().DeleteGoal(ctx, 1, 3).Return(bdgmodels.Goal{ID: 3, UserID: 1, TargetAmount: 1000}, nil),
+	)
+
+	res, err := svc.DeleteGoal(ctx, 3, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 250.0, res.Saved)
+}
+
+func TestService_CreateGoal(t *testing.T) {
+	svc, mockRepo, mockFin := newGoalTestService(t)
+	ctx := context.Background()
+
+	req := bdgmodels.CreateGoalRequest{
+		UserID:       1,
+		Name:         "  Подушка  ",
+		TargetAmount: 300000,
+		CurrencyID:   1,
+		AccountID:    7,
+		Deadline:     date(2031, 6, 1),
+	}
+	mockFin.EXPECT().
+		GetAccountsByUser(ctx, &finpb.UserID{UserId: 1}).
+		Return(userAccounts(&finpb.Account{Id: 7, Balance: 60000, CurrencyId: 1}), nil).
+		Times(2)
+	mockRepo.EXPECT().CreateGoal(ctx, bdgmodels.Goal{
+		UserID:       1,
+		Name:         "Подушка",
+		TargetAmount: 300000,
+		CurrencyID:   1,
+		AccountID:    7,
+		Deadline:     date(2031, 6, 1),
+	}).Return(bdgmodels.Goal{ID: 3, UserID: 1, Name: "Подушка", TargetAmount: 300000, CurrencyID: 1, AccountID: 7, Deadline: date(2031, 6, 1)}, nil)
+
+	res, err := svc.CreateGoal(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), res.Id)
+	assert.Equal(t, 240000.0, res.Remaining)
+	assert.Equal(t, int32(12), res.MonthsLeft)
+	assert.Equal(t, 20000.0, res.MonthlyContribution)
+}
+
+func TestService_CreateGoal_Invalid(t *testing.T) {
+	svc, _, mockFin := newGoalTestService(t)
+	ctx := context.Background()
+
+	valid := bdgmodels.CreateGoalRequest{UserID: 1, Name: "Отпуск", TargetAmount: 1000, CurrencyID: 1}
+
+	pastDeadline := valid
+	pastDeadline.Deadline = date(2030, 6, 9)
+	_, err := svc.CreateGoal(ctx, pastDeadline)
+	assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+
+	blankName := valid
+	blankName.Name = "   "
+	_, err = svc.CreateGoal(ctx, blankName)
+	assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+
+	otherCurrency := valid
+	otherCurrency.AccountID = 7
+	mockFin.EXPECT().
+		GetAccountsByUser(ctx, &finpb.UserID{UserId: 1}).
+		Return(userAccounts(&finpb.Account{Id: 7, CurrencyId: 2}), nil)
+	_, err = svc.CreateGoal(ctx, otherCurrency)
+	assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+}
+
+func TestService_UpdateGoal_LinkAccount(t *testing.T) {
+	svc, mockRepo, mockFin := newGoalTestService(t)
+	ctx := context.Background()
+
+	accountID := 7
+	req := bdgmodels.UpdateGoalRequest{UserID: 1, GoalID: 3, AccountID: &accountID}
+	mockRepo.EXPECT().GetGoal(ctx, 1, 3).Return(bdgmodels.Goal{ID: 3, CurrencyID: 1, TargetAmount: 1000}, nil)
+	mockFin.EXPECT().
+		GetAccountsByUser(ctx, &finpb.UserID{UserId: 1}).
+		Return(userAccounts(&finpb.Account{Id: 7, Balance: 1000, CurrencyId: 1}), nil).
+		Times(2)
+	mockRepo.EXPECT().UpdateGoal(ctx, req).Return(bdgmodels.Goal{ID: 3, UserID: 1, CurrencyID: 1, TargetAmount: 1000, AccountID: 7}, nil)
+
+	res, err := svc.UpdateGoal(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, res.Achieved)
+	assert.Equal(t, int32(7), res.AccountId)
+}
+
+func TestService_UpdateGoal_ConflictingFlags(t *testing.T) {
+	svc, _, _ := newGoalTestService(t)
+
+	deadline := date(2031, 1, 1)
+	_, err := svc.UpdateGoal(context.Background(), bdgmodels.UpdateGoalRequest{UserID: 1, GoalID: 3, Deadline: &deadline, ClearDeadline: true})
+	assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+}
+
+func TestService_AddGoalContribution_FromOperation(t *testing.T) {
+	svc, mockRepo, mockFin := newGoalTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetGoal(ctx, 1, 3).Return(bdgmodels.Goal{ID: 3, CurrencyID: 1}, nil)
+	mockFin.EXPECT().
+		GetOperationsByIDs(ctx, &finpb.OperationsByIDsRequest{UserId: 1, OperationIds: []int32{40}}).
+		Return(&finpb.OperationsByIDsResponse{Operations: []*finpb.Operation{{
+			Id: 40, Sum: 5000, CurrencyId: 1, Status: "finished",
+			Date: timestamppb.New(time.Date(2030, 6, 3, 12, 30, 0, 0, time.UTC)),
+		}}}, nil)
+	mockRepo.EXPECT().CreateContribution(ctx, bdgmodels.GoalContribution{
+		GoalID:        3,
+		OperationID:   40,
+		Amount:        5000,
+		ContributedAt: date(2030, 6, 3),
+	}).Return(bdgmodels.GoalContribution{ID: 10, GoalID: 3, OperationID: 40, Amount: 5000, ContributedAt: date(2030, 6, 3)}, nil)
+
+	res, err := svc.AddGoalContribution(ctx, bdgmodels.CreateContributionRequest{UserID: 1, GoalID: 3, OperationID: 40})
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), res.Id)
+	assert.Equal(t, 5000.0, res.Amount)
+}
+
+func TestService_AddGoalContribution_Manual(t *testing.T) {
+	svc, mockRepo, _ := newGoalTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetGoal(ctx, 1, 3).Return(bdgmodels.Goal{ID: 3, CurrencyID: 1}, nil)
+	mockRepo.EXPECT().CreateContribution(ctx, bdgmodels.GoalContribution{
+		GoalID:        3,
+		Amount:        -1500,
+		Note:          "сняли на ремонт",
+		ContributedAt: date(2030, 6, 10),
+	}).Return(bdgmodels.GoalContribution{ID: 11}, nil)
+
+	_, err := svc.AddGoalContribution(ctx, bdgmodels.CreateContributionRequest{UserID: 1, GoalID: 3, Amount: -1500, Note: "сняли на ремонт"})
+	require.NoError(t, err)
+}
+
+func TestService_AddGoalContribution_Rejected(t *testing.T) {
+	svc, mockRepo, mockFin := newGoalTestService(t)
+	ctx := context.Background()
+
+	// у цели со счетом прогресс — баланс счета
+	mockRepo.EXPECT().GetGoal(ctx, 1, 3).Return(bdgmodels.Goal{ID: 3, CurrencyID: 1, AccountID: 7}, nil)
+	_, err := svc.AddGoalContribution(ctx, bdgmodels.CreateContributionRequest{UserID: 1, GoalID: 3, Amount: 100})
+	assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+
+	mockRepo.EXPECT().GetGoal(ctx, 1, 4).Return(bdgmodels.Goal{ID: 4, CurrencyID: 1}, nil)
+	mockFin.EXPECT().
+		GetOperationsByIDs(ctx, gomock.Any()).
+		Return(&finpb.OperationsByIDsResponse{Operations: []*finpb.Operation{{Id: 40, Sum: 10, CurrencyId: 2}}}, nil)
+	_, err = svc.AddGoalContribution(ctx, bdgmodels.CreateContributionRequest{UserID: 1, GoalID: 4, OperationID: 40})
+	assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+
+	// отмененная операция и операция со счета, к которому нет доступа
+	for _, ops := range [][]*finpb.Operation{{{Id: 41, Sum: 10, CurrencyId: 1, Status: "reverted"}}, nil} {
+		mockRepo.EXPECT().GetGoal(ctx, 1, 4).Return(bdgmodels.Goal{ID: 4, CurrencyID: 1}, nil)
+		mockFin.EXPECT().
+			GetOperationsByIDs(ctx, gomock.Any()).
+			Return(&finpb.OperationsByIDsResponse{Operations: ops}, nil)
+		_, err = svc.AddGoalContribution(ctx, bdgmodels.CreateContributionRequest{UserID: 1, GoalID: 4, OperationID: 41})
+		assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+	}
+
+	mockRepo.EXPECT().GetGoal(ctx, 1, 4).Return(bdgmodels.Goal{ID: 4, CurrencyID: 1}, nil)
+	_, err = svc.AddGoalContribution(ctx, bdgmodels.CreateContributionRequest{UserID: 1, GoalID: 4, Amount: 100, ContributedAt: date(2030, 6, 11)})
+	assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+
+	mockRepo.EXPECT().GetGoal(ctx, 1, 5).Return(bdgmodels.Goal{}, bdgerrors.ErrGoalNotFound)
+	_, err = svc.AddGoalContribution(ctx, bdgmodels.CreateContributionRequest{UserID: 1, GoalID: 5, Amount: 100})
+	assert.ErrorIs(t, err, bdgerrors.ErrGoalNotFound)
+}
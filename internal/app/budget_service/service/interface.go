@@ -2,6 +2,7 @@ package budget
 
 import (
 	"context"
+	"time"
 
 	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
 )
@@ -13,4 +14,19 @@ type BudgetRepository interface {
 	CreateBudget(ctx context.Context, budget bdgmodels.Budget) (bdgmodels.Budget, error)
 	UpdateBudget(ctx context.Context, req bdgmodels.UpdatedBudgetRequest) (bdgmodels.Budget, error)
 	DeleteBudget(ctx context.Context, budgetID int) (bdgmodels.Budget, error)
+	GetAllBudgetsByUser(ctx context.Context, userID int) ([]bdgmodels.Budget, error)
+	ImportBudgets(ctx context.Context, req bdgmodels.ImportBudgetsRequest) (int, error)
+	DeleteUserData(ctx context.Context, userID int) (bdgmodels.UserDataDeletion, error)
+	ReplaceBudgetCategory(ctx context.Context, userID, sourceID, targetID int) (int, error)
+	FilterUsedImages(ctx context.Context, ids []string) ([]string, error)
+	GetBudgetsDueForRollover(ctx context.Context, today time.Time) ([]bdgmodels.Budget, error)
+	RolloverBudget(ctx context.Context, prevID int, next bdgmodels.Budget) (bdgmodels.Budget, error)
+	GetBudgetSeries(ctx context.Context, userID, budgetID int) ([]bdgmodels.Budget, error)
+	GetGoalsByUser(ctx context.Context, userID int) ([]bdgmodels.Goal, error)
+	GetGoal(ctx context.Context, userID, goalID int) (bdgmodels.Goal, error)
+	CreateGoal(ctx context.Context, goal bdgmodels.Goal) (bdgmodels.Goal, error)
+	UpdateGoal(ctx context.Context, req bdgmodels.UpdateGoalRequest) (bdgmodels.Goal, error)
+	DeleteGoal(ctx context.Context, userID, goalID int) (bdgmodels.Goal, error)
+	CreateContribution(ctx context.Context, c bdgmodels.GoalContribution) (bdgmodels.GoalContribution, error)
+	GetContributions(ctx context.Context, goalIDs []int) ([]bdgmodels.GoalContribution, error)
 }
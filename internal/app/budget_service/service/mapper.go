@@ -5,6 +5,7 @@ import (
 
 	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
 	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 )
 
 func CreateRequestToModel(req bdgmodels.CreateBudgetRequest, userID int) bdgmodels.Budget {
@@ -13,27 +14,59 @@ func CreateRequestToModel(req bdgmodels.CreateBudgetRequest, userID int) bdgmode
 		Amount:      req.Amount,
 		Actual:      0,
 		CurrencyID:  1,
+		Description: req.Description,
 		CreatedAt:   req.CreatedAt,
 		PeriodStart: req.PeriodStart,
 		PeriodEnd:   req.PeriodEnd,
+		CategoryIDs: req.CategoryIDs,
+		AccountIDs:  req.AccountIDs,
+		Recurrence:  req.Recurrence,
 	}
 }
 
 func ModelBudgetToProto(bdg bdgmodels.Budget) *bdgpb.Budget {
+	var closedAt *timestamppb.Timestamp
+	if !bdg.ClosedAt.IsZero() {
+		closedAt = timestamppb.New(bdg.ClosedAt)
+	}
+	categories := make([]*bdgpb.BudgetCategoryProgress, 0, len(bdg.Categories))
+	for _, c := range bdg.Categories {
+		categories = append(categories, &bdgpb.BudgetCategoryProgress{
+			CategoryId: int32(c.CategoryID),
+			Actual:     c.Actual,
+		})
+	}
 	return &bdgpb.Budget{
-		Id:          int32(bdg.ID),
-		UserId:      int32(bdg.UserID),
-		Sum:         bdg.Amount,
-		Actual:      bdg.Actual,
-		CurrencyId:  int32(bdg.CurrencyID),
-		Description: bdg.Description,
-		CreatedAt:   timestamppb.New(bdg.CreatedAt),
-		UpdatedAt:   timestamppb.New(bdg.UpdatedAt),
-		PeriodStart: timestamppb.New(bdg.PeriodStart),
-		PeriodEnd:   timestamppb.New(bdg.PeriodEnd),
+		Id:            int32(bdg.ID),
+		UserId:        int32(bdg.UserID),
+		Sum:           bdg.Amount,
+		Actual:        bdg.Actual,
+		CurrencyId:    int32(bdg.CurrencyID),
+		Description:   bdg.Description,
+		CreatedAt:     timestamppb.New(bdg.CreatedAt),
+		UpdatedAt:     timestamppb.New(bdg.UpdatedAt),
+		ClosedAt:      closedAt,
+		PeriodStart:   timestamppb.New(bdg.PeriodStart),
+		PeriodEnd:     timestamppb.New(bdg.PeriodEnd),
+		CategoryIds:   intsToProto(bdg.CategoryIDs),
+		AccountIds:    intsToProto(bdg.AccountIDs),
+		Categories:    categories,
+		Recurrence:    bdg.Period,
+		RecurrenceDay: int32(bdg.Day),
+		CarryOver:     bdg.CarryOver,
+		CarriedOver:   bdg.CarriedOver,
+		SeriesId:      int32(bdg.SeriesID),
 	}
 }
 
+func intsToProto(ids []int) []int32 {
+	res := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int32(id))
+	}
+	return res
+}
+
 func ModelListToProto(bdg []bdgmodels.Budget) *bdgpb.ListBudgetsResponse {
 	var budgets []*bdgpb.Budget
 	for _, b := range bdg {
@@ -43,3 +76,116 @@ func ModelListToProto(bdg []bdgmodels.Budget) *bdgpb.ListBudgetsResponse {
 		Budgets: budgets,
 	}
 }
+
+func SpendingTotalsToModel(totals *finpb.SpendingTotals) bdgmodels.BudgetSpending {
+	spending := bdgmodels.BudgetSpending{
+		Actual:     totals.GetTotal(),
+		ByCategory: make(map[int]float64, len(totals.GetByCategory())),
+	}
+	for _, ctg := range totals.GetByCategory() {
+		spending.ByCategory[int(ctg.CategoryId)] = ctg.Sum
+	}
+	return spending
+}
+
+func SpendingStatsToModels(stats *finpb.SpendingStatsResponse) ([]bdgmodels.DailySpending, []bdgmodels.RecurringExpense) {
+	days := make([]bdgmodels.DailySpending, 0, len(stats.GetDays()))
+	for _, d := range stats.GetDays() {
+		days = append(days, bdgmodels.DailySpending{
+			Date: d.GetDate().AsTime(),
+			Sum:  d.GetSum(),
+		})
+	}
+	recurring := make([]bdgmodels.RecurringExpense, 0, len(stats.GetRecurring()))
+	for _, r := range stats.GetRecurring() {
+		recurring = append(recurring, bdgmodels.RecurringExpense{
+			Name:       r.GetName(),
+			CategoryID: int(r.GetCategoryId()),
+			AvgSum:     r.GetAvgSum(),
+			DayOfMonth: int(r.GetDayOfMonth()),
+			LastDate:   r.GetLastDate().AsTime(),
+			LastSum:    r.GetLastSum(),
+		})
+	}
+	return days, recurring
+}
+
+func ForecastToProto(f bdgmodels.Forecast) *bdgpb.BudgetForecast {
+	var exceedDate *timestamppb.Timestamp
+	if !f.ExceedDate.IsZero() {
+		exceedDate = timestamppb.New(f.ExceedDate)
+	}
+	recurring := make([]*bdgpb.BudgetForecastItem, 0, len(f.Recurring))
+	for _, item := range f.Recurring {
+		recurring = append(recurring, &bdgpb.BudgetForecastItem{
+			Name:       item.Name,
+			CategoryId: int32(item.CategoryID),
+			Sum:        item.Sum,
+			Date:       timestamppb.New(item.Date),
+		})
+	}
+	return &bdgpb.BudgetForecast{
+		BudgetId:    int32(f.BudgetID),
+		Sum:         f.Amount,
+		Actual:      f.Actual,
+		Projected:   f.Projected,
+		DailyPace:   f.DailyPace,
+		ExceedDate:  exceedDate,
+		PeriodStart: timestamppb.New(f.PeriodStart),
+		PeriodEnd:   timestamppb.New(f.PeriodEnd),
+		AsOf:        timestamppb.New(f.AsOf),
+		Recurring:   recurring,
+	}
+}
+
+func CreateGoalRequestToModel(req bdgmodels.CreateGoalRequest) bdgmodels.Goal {
+	return bdgmodels.Goal{
+		UserID:       req.UserID,
+		Name:         req.Name,
+		Description:  req.Description,
+		TargetAmount: req.TargetAmount,
+		CurrencyID:   req.CurrencyID,
+		Deadline:     req.Deadline,
+		AccountID:    req.AccountID,
+		LogoHashedID: req.LogoHashedID,
+	}
+}
+
+func GoalProgressToProto(p bdgmodels.GoalProgress) *bdgpb.Goal {
+	var deadline *timestamppb.Timestamp
+	if !p.Deadline.IsZero() {
+		deadline = timestamppb.New(p.Deadline)
+	}
+	return &bdgpb.Goal{
+		Id:                  int32(p.ID),
+		UserId:              int32(p.UserID),
+		Name:                p.Name,
+		Description:         p.Description,
+		Target:              p.TargetAmount,
+		CurrencyId:          int32(p.CurrencyID),
+		Deadline:            deadline,
+		AccountId:           int32(p.AccountID),
+		LogoHashedId:        p.LogoHashedID,
+		Saved:               p.Saved,
+		Remaining:           p.Remaining,
+		Percent:             p.Percent,
+		Achieved:            p.Achieved,
+		Overdue:             p.Overdue,
+		MonthsLeft:          int32(p.MonthsLeft),
+		MonthlyContribution: p.MonthlyContribution,
+		CreatedAt:           timestamppb.New(p.CreatedAt),
+		UpdatedAt:           timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func ContributionToProto(c bdgmodels.GoalContribution) *bdgpb.GoalContribution {
+	return &bdgpb.GoalContribution{
+		Id:            int32(c.ID),
+		GoalId:        int32(c.GoalID),
+		OperationId:   int32(c.OperationID),
+		Amount:        c.Amount,
+		Note:          c.Note,
+		ContributedAt: timestamppb.New(c.ContributedAt),
+		CreatedAt:     timestamppb.New(c.CreatedAt),
+	}
+}
@@ -12,7 +12,7 @@ import (
 
 func TestCreateRequestToModel(t *testing.T) {
 	req := bdgmodels.CreateBudgetRequest{
-		CategoryID:  2,
+		CategoryIDs: []int{2},
 		Amount:      5000,
 		CreatedAt:   time.Now(),
 		PeriodStart: time.Now(),
@@ -0,0 +1,210 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+)
+
+// normalizeRecurrence проверяет настройку повторения и подставляет день начала
+// из первого периода, если он не задан явно.
+func normalizeRecurrence(rec bdgmodels.Recurrence, periodStart, periodEnd time.Time) (bdgmodels.Recurrence, error) {
+	switch rec.Period {
+	case "", bdgmodels.RecurrenceNone:
+		if rec.Day != 0 || rec.CarryOver {
+			return bdgmodels.Recurrence{}, bdgerrors.ErrInavlidData
+		}
+		return bdgmodels.Recurrence{Period: bdgmodels.RecurrenceNone}, nil
+	case bdgmodels.RecurrenceWeekly:
+		if rec.Day == 0 {
+			rec.Day = isoWeekday(periodStart)
+		}
+		if rec.Day < 1 || rec.Day > 7 {
+			return bdgmodels.Recurrence{}, bdgerrors.ErrInavlidData
+		}
+	case bdgmodels.RecurrenceMonthly:
+		if rec.Day == 0 {
+			rec.Day = periodStart.Day()
+		}
+		if rec.Day < 1 || rec.Day > 31 {
+			return bdgmodels.Recurrence{}, bdgerrors.ErrInavlidData
+		}
+	default:
+		return bdgmodels.Recurrence{}, bdgerrors.ErrInavlidData
+	}
+
+	if periodStart.IsZero() || periodEnd.Before(periodStart) {
+		return bdgmodels.Recurrence{}, bdgerrors.ErrInavlidData
+	}
+	return rec, nil
+}
+
+// NextPeriod возвращает период, следующий за периодом, который заканчивается end.
+// Новый период начинается на следующий день и длится до дня перед ближайшим
+// началом периода по настройке rec, поэтому первый период произвольной длины
+// выравнивается уже на следующем шаге.
+func NextPeriod(rec bdgmodels.Recurrence, end time.Time) (time.Time, time.Time) {
+	start := dateOf(end).AddDate(0, 0, 1)
+
+	var boundary time.Time
+	switch rec.Period {
+	case bdgmodels.RecurrenceWeekly:
+		boundary = start.AddDate(0, 0, 1)
+		for isoWeekday(boundary) != rec.Day {
+			boundary = boundary.AddDate(0, 0, 1)
+		}
+	default:
+		boundary = dayOfMonth(start.Year(), start.Month(), rec.Day)
+		if !boundary.After(start) {
+			boundary = dayOfMonth(start.Year(), start.Month()+1, rec.Day)
+		}
+	}
+
+	return start, boundary.AddDate(0, 0, -1)
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// isoWeekday нумерует дни недели с понедельника: 1 — понедельник, 7 — воскресенье.
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
+
+// dayOfMonth возвращает day-е число месяца или последний день, если месяц короче.
+func dayOfMonth(year int, month time.Month, day int) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	return time.Date(year, month, min(day, lastDay), 0, 0, 0, 0, time.UTC)
+}
+
+// RolloverBudgets закрывает закончившиеся периоды повторяющихся бюджетов и открывает следующие.
+// Если сервис долго не работал, пропущенные периоды создаются по очереди.
+// Ошибка для одного бюджета не прерывает обработку остальных.
+func (s *Service) RolloverBudgets(ctx context.Context) (int, error) {
+	today := dateOf(s.clock.Now())
+
+	due, err := s.repo.GetBudgetsDueForRollover(ctx, today)
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "Failed to get budgets due for rollover")
+	}
+
+	rolled := 0
+	var firstErr error
+	for _, budget := range due {
+		if ctx.Err() != nil {
+			return rolled, ctx.Err()
+		}
+		n, err := s.rolloverBudget(ctx, budget, today)
+		rolled += n
+		if err != nil && firstErr == nil {
+			firstErr = pkgerrors.Wrapf(err, "Failed to roll over budget %d", budget.ID)
+		}
+	}
+	return rolled, firstErr
+}
+
+func (s *Service) rolloverBudget(ctx context.Context, budget bdgmodels.Budget, today time.Time) (int, error) {
+	rolled := 0
+	for budget.PeriodEnd.Before(today) {
+		next, err := s.nextPeriodBudget(ctx, budget)
+		if err != nil {
+			return rolled, err
+		}
+
+		created, err := s.repo.RolloverBudget(ctx, budget.ID, next)
+		if errors.Is(err, bdgerrors.ErrBudgetNotFound) {
+			// период уже закрыт параллельным запуском
+			return rolled, nil
+		}
+		if err != nil {
+			return rolled, err
+		}
+		rolled++
+
+		if created.ID == 0 {
+			// следующий период уже создан пользователем, серия продолжается в нем
+			return rolled, nil
+		}
+		budget = created
+	}
+	return rolled, nil
+}
+
+// nextPeriodBudget строит бюджет следующего периода. При переносе остатка
+// к базовому лимиту добавляется неизрасходованная часть текущего периода.
+func (s *Service) nextPeriodBudget(ctx context.Context, prev bdgmodels.Budget) (bdgmodels.Budget, error) {
+	start, end := NextPeriod(prev.Recurrence, prev.PeriodEnd)
+
+	next := bdgmodels.Budget{
+		UserID:      prev.UserID,
+		CurrencyID:  prev.CurrencyID,
+		Amount:      prev.Amount - prev.CarriedOver,
+		Description: prev.Description,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		CategoryIDs: prev.CategoryIDs,
+		AccountIDs:  prev.AccountIDs,
+		Recurrence:  prev.Recurrence,
+		SeriesID:    prev.SeriesID,
+	}
+
+	if prev.CarryOver {
+		spending, err := s.budgetSpending(ctx, prev)
+		if err != nil {
+			return bdgmodels.Budget{}, err
+		}
+		if rest := math.Round((prev.Amount-spending.Actual)*100) / 100; rest > 0 {
+			next.Amount += rest
+			next.CarriedOver = rest
+		}
+	}
+	return next, nil
+}
+
+// RunBudgetRollover периодически переносит повторяющиеся бюджеты на новый период, пока не отменен ctx.
+func (s *Service) RunBudgetRollover(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RolloverBudgets(ctx); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetBudgetHistory возвращает периоды серии бюджета с плановой и фактической суммой.
+func (s *Service) GetBudgetHistory(ctx context.Context, budgetID, userID int) (*bdgpb.ListBudgetsResponse, error) {
+	periods, err := s.repo.GetBudgetSeries(ctx, userID, budgetID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get budget history")
+	}
+	if len(periods) == 0 {
+		return nil, bdgerrors.ErrBudgetNotFound
+	}
+
+	for i := range periods {
+		if err := s.fillSpending(ctx, &periods[i]); err != nil {
+			return nil, pkgerrors.Wrap(err, "Failed to get budget spending")
+		}
+	}
+
+	return ModelListToProto(periods), nil
+}
@@ -0,0 +1,215 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNextPeriod(t *testing.T) {
+	tests := []struct {
+		name      string
+		rec       bdgmodels.Recurrence
+		end       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "monthly from the first",
+			rec:       bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly, Day: 1},
+			end:       date(2030, 1, 31),
+			wantStart: date(2030, 2, 1),
+			wantEnd:   date(2030, 2, 28),
+		},
+		{
+			name:      "monthly on payday",
+			rec:       bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly, Day: 25},
+			end:       date(2030, 12, 24),
+			wantStart: date(2030, 12, 25),
+			wantEnd:   date(2031, 1, 24),
+		},
+		{
+			name:      "monthly on the 31st in short month",
+			rec:       bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly, Day: 31},
+			end:       date(2030, 2, 27),
+			wantStart: date(2030, 2, 28),
+			wantEnd:   date(2030, 3, 30),
+		},
+		{
+			name:      "monthly aligns custom first period",
+			rec:       bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly, Day: 10},
+			end:       date(2030, 5, 3),
+			wantStart: date(2030, 5, 4),
+			wantEnd:   date(2030, 5, 9),
+		},
+		{
+			name:      "weekly from monday",
+			rec:       bdgmodels.Recurrence{Period: bdgmodels.RecurrenceWeekly, Day: 1},
+			end:       date(2030, 6, 2),
+			wantStart: date(2030, 6, 3),
+			wantEnd:   date(2030, 6, 9),
+		},
+		{
+			name:      "weekly from sunday",
+			rec:       bdgmodels.Recurrence{Period: bdgmodels.RecurrenceWeekly, Day: 7},
+			end:       date(2030, 6, 1),
+			wantStart: date(2030, 6, 2),
+			wantEnd:   date(2030, 6, 8),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := NextPeriod(tt.rec, tt.end)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
+
+func TestNormalizeRecurrence(t *testing.T) {
+	start := date(2030, 6, 5) // среда
+	end := date(2030, 6, 30)
+
+	rec, err := normalizeRecurrence(bdgmodels.Recurrence{}, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, bdgmodels.RecurrenceNone, rec.Period)
+
+	rec, err = normalizeRecurrence(bdgmodels.Recurrence{Period: bdgmodels.RecurrenceWeekly}, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, 3, rec.Day)
+
+	rec, err = normalizeRecurrence(bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly, CarryOver: true}, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly, Day: 5, CarryOver: true}, rec)
+
+	invalid := []bdgmodels.Recurrence{
+		{Period: "yearly"},
+		{Period: bdgmodels.RecurrenceWeekly, Day: 8},
+		{Period: bdgmodels.RecurrenceMonthly, Day: 32},
+		{Period: bdgmodels.RecurrenceNone, CarryOver: true},
+	}
+	for _, r := range invalid {
+		_, err := normalizeRecurrence(r, start, end)
+		assert.ErrorIs(t, err, bdgerrors.ErrInavlidData, r)
+	}
+
+	_, err = normalizeRecurrence(bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly}, end, start)
+	assert.ErrorIs(t, err, bdgerrors.ErrInavlidData)
+}
+
+func TestService_RolloverBudgets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	mockFinance := mocks.NewMockFinanceServiceClient(ctrl)
+	svc := &Service{repo: mockRepo, finance: mockFinance, clock: clock.FixedClock{FixedTime: time.Date(2030, 7, 2, 15, 0, 0, 0, time.UTC)}}
+	ctx := context.Background()
+
+	may := bdgmodels.Budget{
+		ID:          5,
+		UserID:      1,
+		CurrencyID:  1,
+		Amount:      100,
+		PeriodStart: date(2030, 5, 1),
+		PeriodEnd:   date(2030, 5, 31),
+		Recurrence:  bdgmodels.Recurrence{Period: bdgmodels.RecurrenceMonthly, Day: 1, CarryOver: true},
+		SeriesID:    5,
+	}
+	june := may
+	june.ID = 0
+	june.PeriodStart = date(2030, 6, 1)
+	june.PeriodEnd = date(2030, 6, 30)
+	june.Amount = 130
+	june.CarriedOver = 30
+	createdJune := june
+	createdJune.ID = 8
+	july := june
+	july.PeriodStart = date(2030, 7, 1)
+	july.PeriodEnd = date(2030, 7, 31)
+	july.Amount = 100
+	july.CarriedOver = 0
+
+	mockRepo.EXPECT().GetBudgetsDueForRollover(ctx, date(2030, 7, 2)).Return([]bdgmodels.Budget{may}, nil)
+	expectSpending(mockFinance, may).Return(&finpb.SpendingTotals{Total: 70}, nil)
+	mockRepo.EXPECT().RolloverBudget(ctx, 5, june).Return(createdJune, nil)
+	// в июне потрачено больше лимита — переносить нечего
+	expectSpending(mockFinance, createdJune).Return(&finpb.SpendingTotals{Total: 150}, nil)
+	mockRepo.EXPECT().RolloverBudget(ctx, 8, july).Return(bdgmodels.Budget{ID: 9, PeriodEnd: july.PeriodEnd}, nil)
+
+	rolled, err := svc.RolloverBudgets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rolled)
+}
+
+func TestService_RolloverBudgets_ContinuesAfterError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	svc := &Service{repo: mockRepo, clock: clock.FixedClock{FixedTime: date(2030, 6, 2)}}
+	ctx := context.Background()
+
+	rec := bdgmodels.Recurrence{Period: bdgmodels.RecurrenceWeekly, Day: 1}
+	first := bdgmodels.Budget{ID: 1, PeriodEnd: date(2030, 5, 26), Recurrence: rec}
+	second := bdgmodels.Budget{ID: 2, PeriodEnd: date(2030, 5, 26), Recurrence: rec}
+
+	mockRepo.EXPECT().GetBudgetsDueForRollover(ctx, date(2030, 6, 2)).Return([]bdgmodels.Budget{first, second}, nil)
+	mockRepo.EXPECT().RolloverBudget(ctx, 1, gomock.Any()).Return(bdgmodels.Budget{}, errors.New("db error"))
+	mockRepo.EXPECT().RolloverBudget(ctx, 2, gomock.Any()).Return(bdgmodels.Budget{}, bdgerrors.ErrBudgetNotFound)
+
+	rolled, err := svc.RolloverBudgets(ctx)
+	assert.ErrorContains(t, err, "Failed to roll over budget 1")
+	assert.Equal(t, 0, rolled)
+}
+
+func TestService_GetBudgetHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBudgetRepository(ctrl)
+	mockFinance := mocks.NewMockFinanceServiceClient(ctrl)
+	svc := &Service{repo: mockRepo, finance: mockFinance}
+	ctx := context.Background()
+
+	current := bdgmodels.Budget{ID: 8, UserID: 1, Amount: 130, SeriesID: 5}
+	past := bdgmodels.Budget{ID: 5, UserID: 1, Amount: 100, SeriesID: 5, ClosedAt: time.Now()}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().GetBudgetSeries(ctx, 1, 5).Return([]bdgmodels.Budget{current, past}, nil)
+		gomock.InOrder(
+			expectSpending(mockFinance, current).Return(&finpb.SpendingTotals{Total: 20}, nil),
+			expectSpending(mockFinance, past).Return(&finpb.SpendingTotals{Total: 70}, nil),
+		)
+
+		resp, err := svc.GetBudgetHistory(ctx, 5, 1)
+		require.NoError(t, err)
+		require.Len(t, resp.Budgets, 2)
+		assert.Equal(t, 100.0, resp.Budgets[1].Sum)
+		assert.Equal(t, 70.0, resp.Budgets[1].Actual)
+		assert.NotNil(t, resp.Budgets[1].ClosedAt)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetBudgetSeries(ctx, 1, 42).Return(nil, nil)
+
+		_, err := svc.GetBudgetHistory(ctx, 42, 1)
+		assert.ErrorIs(t, err, bdgerrors.ErrBudgetNotFound)
+	})
+}
@@ -0,0 +1,117 @@
+package budget
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bdgerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/errors"
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+// normalizeIDs сортирует идентификаторы и убирает повторы:
+// уникальность бюджета в БД проверяется по массивам целиком.
+func normalizeIDs(ids []int) []int {
+	if len(ids) == 0 {
+		return nil
+	}
+	normalized := slices.Clone(ids)
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
+// checkScopeOwnership проверяет через finance_service, что категории принадлежат
+// пользователю, а к счетам у него есть доступ.
+func (s *Service) checkScopeOwnership(ctx context.Context, userID int, categoryIDs, accountIDs []int) error {
+	for _, id := range append(slices.Clone(categoryIDs), accountIDs...) {
+		if id <= 0 {
+			return bdgerrors.ErrInavlidData
+		}
+	}
+
+	if len(categoryIDs) > 0 {
+		resp, err := s.finance.GetCategoriesByUser(ctx, &finpb.UserID{UserId: int32(userID)})
+		if err != nil {
+			return pkgerrors.Wrap(err, "Failed to check budget categories")
+		}
+		owned := make([]int, 0, len(resp.GetCategories()))
+		for _, ctg := range resp.GetCategories() {
+			owned = append(owned, int(ctg.Id))
+		}
+		if !containsAll(owned, categoryIDs) {
+			return bdgerrors.ErrInavlidData
+		}
+	}
+
+	if len(accountIDs) > 0 {
+		resp, err := s.finance.GetAccountsByUser(ctx, &finpb.UserID{UserId: int32(userID)})
+		if err != nil {
+			return pkgerrors.Wrap(err, "Failed to check budget accounts")
+		}
+		available := make([]int, 0, len(resp.GetAccounts()))
+		for _, acc := range resp.GetAccounts() {
+			available = append(available, int(acc.Id))
+		}
+		if !containsAll(available, accountIDs) {
+			return bdgerrors.ErrInavlidData
+		}
+	}
+
+	return nil
+}
+
+func containsAll(set, ids []int) bool {
+	for _, id := range ids {
+		if !slices.Contains(set, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// spendingRequest запрос к finance_service расходов, попадающих в бюджет:
+// завершенных расходов в валюте бюджета по его категориям и счетам с start по end.
+// По нему считаются и фактические расходы, и прогноз, поэтому отбор операций
+// у них всегда совпадает.
+func spendingRequest(budget bdgmodels.Budget, start, end time.Time) *finpb.SpendingStatsRequest {
+	return &finpb.SpendingStatsRequest{
+		UserId:      int32(budget.UserID),
+		CurrencyId:  int32(budget.CurrencyID),
+		CategoryIds: intsToProto(budget.CategoryIDs),
+		AccountIds:  intsToProto(budget.AccountIDs),
+		Start:       timestamppb.New(start),
+		End:         timestamppb.New(end),
+	}
+}
+
+// budgetSpending получает у finance_service расходы бюджета за весь его период.
+func (s *Service) budgetSpending(ctx context.Context, budget bdgmodels.Budget) (bdgmodels.BudgetSpending, error) {
+	totals, err := s.finance.GetSpendingTotals(ctx, spendingRequest(budget, budget.PeriodStart, budget.PeriodEnd))
+	if err != nil {
+		return bdgmodels.BudgetSpending{}, pkgerrors.Wrap(err, "Failed to get budget spending")
+	}
+	return SpendingTotalsToModel(totals), nil
+}
+
+// fillSpending заполняет фактические расходы бюджета и прогресс по его категориям
+// в порядке CategoryIDs.
+func (s *Service) fillSpending(ctx context.Context, budget *bdgmodels.Budget) error {
+	spending, err := s.budgetSpending(ctx, *budget)
+	if err != nil {
+		return err
+	}
+
+	budget.Actual = spending.Actual
+	budget.Categories = make([]bdgmodels.CategoryProgress, 0, len(budget.CategoryIDs))
+	for _, id := range budget.CategoryIDs {
+		budget.Categories = append(budget.Categories, bdgmodels.CategoryProgress{
+			CategoryID: id,
+			Actual:     spending.ByCategory[id],
+		})
+	}
+	return nil
+}
@@ -73,3 +73,75 @@ func (uc *UseCase) DeleteBudget(ctx context.Context, budgetID, userID int) (*bdg
 	}
 	return budgetData, nil
 }
+
+func (uc *UseCase) ExportBudgets(ctx context.Context, userID int) (*bdgpb.ListBudgetsResponse, error) {
+	log := logger.FromContext(ctx)
+	budgetsData, err := uc.budgetSvc.ExportBudgets(ctx, userID)
+	if err != nil {
+		log.Error("Failed to export budgets for user", "error", err, "user_id", userID)
+		return nil, pkgerrors.Wrap(err, "budget.ExportBudgets")
+	}
+	return budgetsData, nil
+}
+
+func (uc *UseCase) ImportBudgets(ctx context.Context, req bdgmodels.ImportBudgetsRequest) (*bdgpb.ImportBudgetsResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.ImportBudgets(ctx, req)
+	if err != nil {
+		log.Error("Failed to import budgets for user", "error", err, "user_id", req.UserID, "backup_id", req.BackupID)
+		return nil, pkgerrors.Wrap(err, "budget.ImportBudgets")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) DeleteUserData(ctx context.Context, userID int) (*bdgpb.UserDataDeletion, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.DeleteUserData(ctx, userID)
+	if err != nil {
+		log.Error("Failed to delete budgets for user", "error", err, "user_id", userID)
+		return nil, pkgerrors.Wrap(err, "budget.DeleteUserData")
+	}
+	log.Info("Budgets deleted for user", "user_id", userID,
+		"budgets_deleted", res.BudgetsDeleted, "goals_deleted", res.GoalsDeleted)
+	return res, nil
+}
+
+func (uc *UseCase) ReplaceBudgetCategory(ctx context.Context, userID, sourceID, targetID int) (*bdgpb.ReplaceCategoryResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.ReplaceBudgetCategory(ctx, userID, sourceID, targetID)
+	if err != nil {
+		log.Error("Failed to replace budget category", "error", err, "user_id", userID, "source_id", sourceID, "target_id", targetID)
+		return nil, pkgerrors.Wrap(err, "budget.ReplaceBudgetCategory")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) FilterUsedImages(ctx context.Context, ids []string) (*bdgpb.ImageIDs, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.FilterUsedImages(ctx, ids)
+	if err != nil {
+		log.Error("Failed to filter used images", "error", err)
+		return nil, pkgerrors.Wrap(err, "budget.FilterUsedImages")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetBudgetHistory(ctx context.Context, budgetID, userID int) (*bdgpb.ListBudgetsResponse, error) {
+	log := logger.FromContext(ctx)
+	history, err := uc.budgetSvc.GetBudgetHistory(ctx, budgetID, userID)
+	if err != nil {
+		log.Error("Failed to get budget history for user", "error", err, "user_id", userID, "budget_id", budgetID)
+		return nil, pkgerrors.Wrap(err, "budget.GetBudgetHistory")
+	}
+	return history, nil
+}
+
+func (uc *UseCase) GetBudgetForecast(ctx context.Context, budgetID, userID int) (*bdgpb.BudgetForecast, error) {
+	log := logger.FromContext(ctx)
+	forecast, err := uc.budgetSvc.GetBudgetForecast(ctx, budgetID, userID)
+	if err != nil {
+		log.Error("Failed to get budget forecast for user", "error", err, "user_id", userID, "budget_id", budgetID)
+		return nil, pkgerrors.Wrap(err, "budget.GetBudgetForecast")
+	}
+	return forecast, nil
+}
@@ -80,7 +80,7 @@ func TestUseCase_CreateBudget(t *testing.T) {
 	ctx := logger.WithLogger(context.Background(), logger.NewSlogLogger())
 
 	userID := 1
-	req := models.CreateBudgetRequest{CategoryID: 1, Amount: 100}
+	req := models.CreateBudgetRequest{CategoryIDs: []int{1}, Amount: 100}
 	mockBudget := &bdgpb.Budget{Id: 10, UserId: int32(userID), Sum: 100}
 
 	t.Run("success", func(t *testing.T) {
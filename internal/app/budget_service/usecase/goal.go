@@ -0,0 +1,81 @@
+package budget
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+
+	bdgmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+)
+
+func (uc *UseCase) GetGoals(ctx context.Context, userID int) (*bdgpb.ListGoalsResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.GetGoals(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get goals for user", "error", err, "user_id", userID)
+		return nil, pkgerrors.Wrap(err, "budget.GetGoals")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetGoal(ctx context.Context, goalID, userID int) (*bdgpb.Goal, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.GetGoal(ctx, goalID, userID)
+	if err != nil {
+		log.Error("Failed to get goal for user", "error", err, "user_id", userID, "goal_id", goalID)
+		return nil, pkgerrors.Wrap(err, "budget.GetGoal")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) CreateGoal(ctx context.Context, req bdgmodels.CreateGoalRequest) (*bdgpb.Goal, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.CreateGoal(ctx, req)
+	if err != nil {
+		log.Error("Failed to create goal for user", "error", err, "user_id", req.UserID)
+		return nil, pkgerrors.Wrap(err, "budget.CreateGoal")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) UpdateGoal(ctx context.Context, req bdgmodels.UpdateGoalRequest) (*bdgpb.Goal, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.UpdateGoal(ctx, req)
+	if err != nil {
+		log.Error("Failed to update goal for user", "error", err, "user_id", req.UserID, "goal_id", req.GoalID)
+		return nil, pkgerrors.Wrap(err, "budget.UpdateGoal")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) DeleteGoal(ctx context.Context, goalID, userID int) (*bdgpb.Goal, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.DeleteGoal(ctx, goalID, userID)
+	if err != nil {
+		log.Error("Failed to delete goal for user", "error", err, "user_id", userID, "goal_id", goalID)
+		return nil, pkgerrors.Wrap(err, "budget.DeleteGoal")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) AddGoalContribution(ctx context.Context, req bdgmodels.CreateContributionRequest) (*bdgpb.GoalContribution, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.AddGoalContribution(ctx, req)
+	if err != nil {
+		log.Error("Failed to add goal contribution for user", "error", err, "user_id", req.UserID, "goal_id", req.GoalID)
+		return nil, pkgerrors.Wrap(err, "budget.AddGoalContribution")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetGoalContributions(ctx context.Context, goalID, userID int) (*bdgpb.ListContributionsResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.budgetSvc.GetGoalContributions(ctx, goalID, userID)
+	if err != nil {
+		log.Error("Failed to get goal contributions for user", "error", err, "user_id", userID, "goal_id", goalID)
+		return nil, pkgerrors.Wrap(err, "budget.GetGoalContributions")
+	}
+	return res, nil
+}
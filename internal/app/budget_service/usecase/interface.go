@@ -13,4 +13,18 @@ type BudgetService interface {
 	GetBudgets(context.Context, int) (*budgetpb.ListBudgetsResponse, error)
 	UpdateBudget(context.Context, budg.UpdatedBudgetRequest) (*budgetpb.Budget, error)
 	DeleteBudget(ctx context.Context, budgetID, userID int) (*budgetpb.Budget, error)
+	ExportBudgets(ctx context.Context, userID int) (*budgetpb.ListBudgetsResponse, error)
+	ImportBudgets(ctx context.Context, req budg.ImportBudgetsRequest) (*budgetpb.ImportBudgetsResponse, error)
+	DeleteUserData(ctx context.Context, userID int) (*budgetpb.UserDataDeletion, error)
+	ReplaceBudgetCategory(ctx context.Context, userID, sourceID, targetID int) (*budgetpb.ReplaceCategoryResponse, error)
+	FilterUsedImages(ctx context.Context, ids []string) (*budgetpb.ImageIDs, error)
+	GetBudgetHistory(ctx context.Context, budgetID, userID int) (*budgetpb.ListBudgetsResponse, error)
+	GetBudgetForecast(ctx context.Context, budgetID, userID int) (*budgetpb.BudgetForecast, error)
+	GetGoals(ctx context.Context, userID int) (*budgetpb.ListGoalsResponse, error)
+	GetGoal(ctx context.Context, goalID, userID int) (*budgetpb.Goal, error)
+	CreateGoal(ctx context.Context, req budg.CreateGoalRequest) (*budgetpb.Goal, error)
+	UpdateGoal(ctx context.Context, req budg.UpdateGoalRequest) (*budgetpb.Goal, error)
+	DeleteGoal(ctx context.Context, goalID, userID int) (*budgetpb.Goal, error)
+	AddGoalContribution(ctx context.Context, req budg.CreateContributionRequest) (*budgetpb.GoalContribution, error)
+	GetGoalContributions(ctx context.Context, goalID, userID int) (*budgetpb.ListContributionsResponse, error)
 }
@@ -1,15 +1,18 @@
 package financeservice
 
 import (
+	"context"
 	"log/slog"
 	"net"
 	"net/http"
+	"time"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 
 	config "github.com/go-park-mail-ru/2025_2_VKarmane/cmd/api/app"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/defaults"
 	fin "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/grpc"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	finrepo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/repository"
@@ -20,6 +23,8 @@ import (
 	"github.com/go-park-mail-ru/2025_2_VKarmane/pkg/interceptors"
 )
 
+const suggestionRetrainInterval = time.Hour
+
 func Run() error {
 	config := config.LoadConfig()
 	clock := clock.RealClock{}
@@ -68,6 +73,17 @@ func Run() error {
 	}
 	svc := finsvc.NewService(store, es, clock)
 
+	categoryPack, err := defaults.Load(config.DefaultCategories.File)
+	if err != nil {
+		appLogger.Error("Failed to load default categories, using built-in set", "error", err)
+	} else {
+		svc.SetDefaultCategoryPack(categoryPack)
+	}
+
+	go svc.RunSuggestionRetraining(context.Background(), suggestionRetrainInterval, func(err error) {
+		appLogger.Error("Failed to retrain category suggestions", "error", err)
+	})
+
 	uc := finusecase.NewFinanceUseCase(svc)
 	financeService := fin.NewFinanceServer(uc)
 
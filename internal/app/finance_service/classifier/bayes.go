@@ -0,0 +1,143 @@
+package classifier
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// MinSamples минимальный объем истории, с которого подсказки имеют смысл.
+	MinSamples = 5
+	// MinConfidence порог апостериорной вероятности для автоподстановки категории.
+	MinConfidence = 0.5
+
+	smoothing = 1.0
+)
+
+type Sample struct {
+	CategoryID int
+	Name       string
+	Sum        float64
+}
+
+type Prediction struct {
+	CategoryID int
+	Confidence float64
+}
+
+// Model мультиномиальный наивный байесовский классификатор по словам названия
+// и порядку суммы операции.
+type Model struct {
+	samples     int
+	docCounts   map[int]int
+	tokenCounts map[int]map[string]int
+	tokenTotals map[int]int
+	vocabulary  map[string]struct{}
+}
+
+func Train(samples []Sample) *Model {
+	m := &Model{
+		docCounts:   make(map[int]int),
+		tokenCounts: make(map[int]map[string]int),
+		tokenTotals: make(map[int]int),
+		vocabulary:  make(map[string]struct{}),
+	}
+
+	for _, s := range samples {
+		if s.CategoryID == 0 {
+			continue
+		}
+		m.samples++
+		m.docCounts[s.CategoryID]++
+		counts, ok := m.tokenCounts[s.CategoryID]
+		if !ok {
+			counts = make(map[string]int)
+			m.tokenCounts[s.CategoryID] = counts
+		}
+		for _, token := range features(s.Name, s.Sum) {
+			counts[token]++
+			m.tokenTotals[s.CategoryID]++
+			m.vocabulary[token] = struct{}{}
+		}
+	}
+
+	return m
+}
+
+func (m *Model) Samples() int {
+	return m.samples
+}
+
+// Predict возвращает наиболее вероятную категорию. ok == false, если истории
+// недостаточно, ни одно слово названия не встречалось раньше
+// или уверенность ниже MinConfidence.
+func (m *Model) Predict(name string, sum float64) (Prediction, bool) {
+	if m.samples < MinSamples || !m.knowsAnyWord(name) {
+		return Prediction{}, false
+	}
+	ranked := m.Rank(name, sum)
+	if len(ranked) == 0 {
+		return Prediction{}, false
+	}
+	best := ranked[0]
+	return best, best.Confidence >= MinConfidence
+}
+
+// knowsAnyWord не дает подсказывать категорию по одной лишь сумме.
+func (m *Model) knowsAnyWord(name string) bool {
+	for _, token := range Tokenize(name) {
+		if _, ok := m.vocabulary[token]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Rank возвращает все известные категории по убыванию вероятности.
+func (m *Model) Rank(name string, sum float64) []Prediction {
+	if m.samples == 0 {
+		return nil
+	}
+
+	tokens := features(name, sum)
+	vocab := float64(len(m.vocabulary))
+
+	categories := make([]int, 0, len(m.docCounts))
+	for ctg := range m.docCounts {
+		categories = append(categories, ctg)
+	}
+	sort.Ints(categories)
+
+	scores := make([]float64, len(categories))
+	maxScore := math.Inf(-1)
+	for i, ctg := range categories {
+		score := math.Log(float64(m.docCounts[ctg]) / float64(m.samples))
+		denominator := float64(m.tokenTotals[ctg]) + smoothing*vocab
+		for _, token := range tokens {
+			if _, known := m.vocabulary[token]; !known {
+				continue
+			}
+			score += math.Log((float64(m.tokenCounts[ctg][token]) + smoothing) / denominator)
+		}
+		scores[i] = score
+		if score > maxScore {
+			maxScore = score
+		}
+	}
+
+	// softmax по логарифмам для получения нормированной уверенности
+	var total float64
+	for i := range scores {
+		scores[i] = math.Exp(scores[i] - maxScore)
+		total += scores[i]
+	}
+
+	ranked := make([]Prediction, len(categories))
+	for i, ctg := range categories {
+		ranked[i] = Prediction{CategoryID: ctg, Confidence: scores[i] / total}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Confidence > ranked[j].Confidence
+	})
+	return ranked
+}
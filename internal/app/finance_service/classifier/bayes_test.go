@@ -0,0 +1,131 @@
+package classifier
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadFixture(t *testing.T) []Sample {
+	t.Helper()
+
+	f, err := os.Open("testdata/operations.csv")
+	require.NoError(t, err)
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+
+	samples := make([]Sample, 0, len(records)-1)
+	for _, rec := range records[1:] {
+		sum, err := strconv.ParseFloat(rec[1], 64)
+		require.NoError(t, err)
+		ctg, err := strconv.Atoi(rec[2])
+		require.NoError(t, err)
+		samples = append(samples, Sample{Name: rec[0], Sum: sum, CategoryID: ctg})
+	}
+	return samples
+}
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"пятерочка"}, Tokenize("Пятёрочка №123"))
+	assert.Equal(t, []string{"яндекс", "такси"}, Tokenize("ЯНДЕКС.Такси 4521"))
+	assert.Equal(t, []string{"аптека"}, Tokenize("Аптека 36.6"))
+	assert.Empty(t, Tokenize("  № 1 "))
+}
+
+func TestAccuracyOnFixture(t *testing.T) {
+	samples := loadFixture(t)
+	split := len(samples) * 4 / 5
+	model := Train(samples[:split])
+
+	test := samples[split:]
+	correct := 0
+	for _, s := range test {
+		if p, ok := model.Predict(s.Name, s.Sum); ok && p.CategoryID == s.CategoryID {
+			correct++
+		}
+	}
+
+	accuracy := float64(correct) / float64(len(test))
+	t.Logf("accuracy: %.3f (%d/%d)", accuracy, correct, len(test))
+	assert.GreaterOrEqual(t, accuracy, 0.9)
+}
+
+func TestAccuracyOnFixture_CrossValidation(t *testing.T) {
+	samples := loadFixture(t)
+	const folds = 5
+
+	var correct, total int
+	for k := 0; k < folds; k++ {
+		var train, test []Sample
+		for i, s := range samples {
+			if i%folds == k {
+				test = append(test, s)
+			} else {
+				train = append(train, s)
+			}
+		}
+
+		model := Train(train)
+		for _, s := range test {
+			if p, ok := model.Predict(s.Name, s.Sum); ok && p.CategoryID == s.CategoryID {
+				correct++
+			}
+			total++
+		}
+	}
+
+	accuracy := float64(correct) / float64(total)
+	t.Logf("cross-validated accuracy: %.3f", accuracy)
+	assert.GreaterOrEqual(t, accuracy, 0.9)
+}
+
+func TestPredict_NotEnoughHistory(t *testing.T) {
+	model := Train([]Sample{
+		{CategoryID: 1, Name: "Пятерочка", Sum: 500},
+		{CategoryID: 2, Name: "Яндекс Такси", Sum: 400},
+	})
+
+	_, ok := model.Predict("Пятерочка", 500)
+	assert.False(t, ok)
+}
+
+func TestPredict_EmptyModel(t *testing.T) {
+	model := Train(nil)
+
+	_, ok := model.Predict("Пятерочка", 500)
+	assert.False(t, ok)
+	assert.Empty(t, model.Rank("Пятерочка", 500))
+}
+
+func TestPredict_UnknownWordsLowConfidence(t *testing.T) {
+	samples := []Sample{
+		{CategoryID: 1, Name: "Пятерочка", Sum: 500},
+		{CategoryID: 1, Name: "Магнит", Sum: 700},
+		{CategoryID: 1, Name: "Перекресток", Sum: 900},
+		{CategoryID: 2, Name: "Яндекс Такси", Sum: 450},
+		{CategoryID: 2, Name: "Такси", Sum: 600},
+		{CategoryID: 2, Name: "Метро", Sum: 800},
+	}
+	model := Train(samples)
+
+	_, ok := model.Predict("Совершенно новое название", 650)
+	assert.False(t, ok)
+
+	p, ok := model.Predict("такси домой", 500)
+	assert.True(t, ok)
+	assert.Equal(t, 2, p.CategoryID)
+}
+
+func TestTrain_SkipsUncategorized(t *testing.T) {
+	model := Train([]Sample{
+		{CategoryID: 0, Name: "Без категории", Sum: 100},
+		{CategoryID: 1, Name: "Пятерочка", Sum: 100},
+	})
+	assert.Equal(t, 1, model.Samples())
+}
@@ -0,0 +1,155 @@
+package classifier
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+// TrainingSource загружает категоризированную историю операций пользователя.
+type TrainingSource func(ctx context.Context, userID int) ([]Sample, error)
+
+type entry struct {
+	model     *Model
+	trainedAt time.Time
+	usedAt    time.Time
+}
+
+// Store хранит обученные модели пользователей в памяти процесса.
+// Модель переобучается при обращении, если она старше maxAge, и периодически в Run.
+// Модели пользователей, не обращавшихся за подсказками дольше idleTTL, удаляются.
+type Store struct {
+	mu      sync.RWMutex
+	models  map[int]entry
+	source  TrainingSource
+	clock   clock.Clock
+	maxAge  time.Duration
+	idleTTL time.Duration
+}
+
+func NewStore(source TrainingSource, clck clock.Clock, maxAge, idleTTL time.Duration) *Store {
+	return &Store{
+		models:  make(map[int]entry),
+		source:  source,
+		clock:   clck,
+		maxAge:  maxAge,
+		idleTTL: idleTTL,
+	}
+}
+
+func (s *Store) Get(ctx context.Context, userID int) (*Model, error) {
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	e, ok := s.models[userID]
+	if ok {
+		e.usedAt = now
+		s.models[userID] = e
+	}
+	s.mu.Unlock()
+
+	if ok && now.Sub(e.trainedAt) < s.maxAge {
+		return e.model, nil
+	}
+	model, err := s.Retrain(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if e, ok := s.models[userID]; ok {
+		e.usedAt = now
+		s.models[userID] = e
+	}
+	s.mu.Unlock()
+
+	return model, nil
+}
+
+// Retrain обучает модель заново. Время последнего обращения сохраняется,
+// поэтому фоновое переобучение не продлевает жизнь модели.
+func (s *Store) Retrain(ctx context.Context, userID int) (*Model, error) {
+	samples, err := s.source(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	model := Train(samples)
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	usedAt := now
+	if prev, ok := s.models[userID]; ok {
+		usedAt = prev.usedAt
+	}
+	s.models[userID] = entry{model: model, trainedAt: now, usedAt: usedAt}
+	s.mu.Unlock()
+
+	return model, nil
+}
+
+func (s *Store) Invalidate(userID int) {
+	s.mu.Lock()
+	delete(s.models, userID)
+	s.mu.Unlock()
+}
+
+// Evict удаляет модели пользователей, не обращавшихся за подсказками дольше idleTTL.
+func (s *Store) Evict() int {
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	evicted := 0
+	for userID, e := range s.models {
+		if now.Sub(e.usedAt) >= s.idleTTL {
+			delete(s.models, userID)
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// RetrainAll удаляет простаивающие модели и переобучает модели пользователей,
+// недавно обращавшихся за подсказками. Ошибка для одного пользователя не
+// прерывает обучение остальных.
+func (s *Store) RetrainAll(ctx context.Context) error {
+	s.Evict()
+
+	s.mu.RLock()
+	users := make([]int, 0, len(s.models))
+	for userID := range s.models {
+		users = append(users, userID)
+	}
+	s.mu.RUnlock()
+
+	var firstErr error
+	for _, userID := range users {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, err := s.Retrain(ctx, userID); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *Store) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RetrainAll(ctx); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
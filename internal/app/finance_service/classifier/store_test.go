@@ -0,0 +1,104 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+type mutableClock struct {
+	now time.Time
+}
+
+func (c *mutableClock) Now() time.Time { return c.now }
+
+var _ clock.Clock = (*mutableClock)(nil)
+
+func TestStore_GetCachesUntilMaxAge(t *testing.T) {
+	clck := &mutableClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	calls := 0
+	source := func(ctx context.Context, userID int) ([]Sample, error) {
+		calls++
+		return []Sample{{CategoryID: 1, Name: "Пятерочка", Sum: 100}}, nil
+	}
+	store := NewStore(source, clck, time.Hour, 24*time.Hour)
+
+	_, err := store.Get(context.Background(), 1)
+	require.NoError(t, err)
+	_, err = store.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	clck.now = clck.now.Add(2 * time.Hour)
+	_, err = store.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestStore_InvalidateAndRetrainAll(t *testing.T) {
+	clck := &mutableClock{now: time.Now()}
+	calls := map[int]int{}
+	source := func(ctx context.Context, userID int) ([]Sample, error) {
+		calls[userID]++
+		return nil, nil
+	}
+	store := NewStore(source, clck, time.Hour, 24*time.Hour)
+
+	_, _ = store.Get(context.Background(), 1)
+	_, _ = store.Get(context.Background(), 2)
+
+	require.NoError(t, store.RetrainAll(context.Background()))
+	assert.Equal(t, 2, calls[1])
+	assert.Equal(t, 2, calls[2])
+
+	store.Invalidate(1)
+	require.NoError(t, store.RetrainAll(context.Background()))
+	assert.Equal(t, 2, calls[1])
+	assert.Equal(t, 3, calls[2])
+}
+
+func TestStore_SourceError(t *testing.T) {
+	source := func(ctx context.Context, userID int) ([]Sample, error) {
+		return nil, errors.New("db down")
+	}
+	store := NewStore(source, clock.RealClock{}, time.Hour, 24*time.Hour)
+
+	_, err := store.Get(context.Background(), 1)
+	assert.Error(t, err)
+}
+
+func TestStore_RetrainAllEvictsIdleModels(t *testing.T) {
+	clck := &mutableClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	calls := map[int]int{}
+	source := func(ctx context.Context, userID int) ([]Sample, error) {
+		calls[userID]++
+		return nil, nil
+	}
+	store := NewStore(source, clck, time.Hour, 24*time.Hour)
+
+	_, _ = store.Get(context.Background(), 1)
+	_, _ = store.Get(context.Background(), 2)
+
+	// фоновое переобучение не считается обращением пользователя
+	clck.now = clck.now.Add(20 * time.Hour)
+	require.NoError(t, store.RetrainAll(context.Background()))
+	_, _ = store.Get(context.Background(), 2)
+
+	clck.now = clck.now.Add(5 * time.Hour)
+	require.NoError(t, store.RetrainAll(context.Background()))
+	assert.Equal(t, 2, calls[1])
+	assert.Equal(t, 3, calls[2])
+
+	store.mu.RLock()
+	_, kept1 := store.models[1]
+	_, kept2 := store.models[2]
+	store.mu.RUnlock()
+	assert.False(t, kept1)
+	assert.True(t, kept2)
+}
@@ -0,0 +1,52 @@
+package classifier
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const minTokenLen = 2
+
+// Tokenize разбивает название операции на нормализованные слова.
+// Числа отбрасываются: номера магазинов и чеков только мешают обобщению.
+func Tokenize(name string) []string {
+	name = strings.ReplaceAll(strings.ToLower(name), "ё", "е")
+
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for _, w := range words {
+		if len([]rune(w)) < minTokenLen || isNumber(w) {
+			continue
+		}
+		tokens = append(tokens, w)
+	}
+	return tokens
+}
+
+func isNumber(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// amountToken превращает сумму в признак порядка величины,
+// чтобы 450 и 520 рублей считались похожими, а 500 и 50000 — нет.
+func amountToken(sum float64) string {
+	if sum <= 0 {
+		return "amount:0"
+	}
+	bucket := int(math.Floor(math.Log10(sum) * 2))
+	return "amount:" + strconv.Itoa(bucket)
+}
+
+func features(name string, sum float64) []string {
+	return append(Tokenize(name), amountToken(sum))
+}
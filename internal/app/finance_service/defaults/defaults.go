@@ -0,0 +1,222 @@
+// Package defaults описывает набор категорий, который получает новый пользователь.
+//
+// Набор хранится в JSON с локализованными названиями и описаниями и ссылается
+// на иконки рядом с файлом. Идентификатор иконки — sha256 ее содержимого, так же
+// как в image service, поэтому finance service знает logo_hashed_id, не обращаясь
+// к хранилищу изображений, а шлюзу достаточно один раз загрузить недостающие иконки.
+package defaults
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+//go:embed pack.json icons
+var builtinFS embed.FS
+
+const builtinPackFile = "pack.json"
+
+// ограничения совпадают с CHECK-ограничениями таблицы category
+const (
+	maxNameLength        = 30
+	maxDescriptionLength = 60
+)
+
+var ErrInvalidPack = errors.New("invalid default category pack")
+
+type Category struct {
+	Name         string
+	Description  string
+	LogoHashedID string
+}
+
+type Icon struct {
+	ID          string
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+type Pack struct {
+	defaultLocale string
+	categories    []packCategory
+	icons         []Icon
+}
+
+type packCategory struct {
+	name        map[string]string
+	description map[string]string
+	iconID      string
+}
+
+type packFile struct {
+	DefaultLocale string `json:"default_locale"`
+	Categories    []struct {
+		Icon        string            `json:"icon"`
+		Name        map[string]string `json:"name"`
+		Description map[string]string `json:"description"`
+	} `json:"categories"`
+}
+
+// Builtin возвращает набор, встроенный в бинарник.
+func Builtin() *Pack {
+	pack, err := Parse(builtinFS, builtinPackFile)
+	if err != nil {
+		panic(err)
+	}
+	return pack
+}
+
+// Load читает набор из файла; пустой путь означает встроенный набор.
+// Пути к иконкам в файле задаются относительно его каталога.
+func Load(file string) (*Pack, error) {
+	if file == "" {
+		return Builtin(), nil
+	}
+	return Parse(os.DirFS(filepath.Dir(file)), filepath.Base(file))
+}
+
+func Parse(fsys fs.FS, name string) (*Pack, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("read default category pack: %w", err)
+	}
+
+	var file packFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPack, err)
+	}
+
+	pack := &Pack{defaultLocale: NormalizeLocale(file.DefaultLocale)}
+	if pack.defaultLocale == "" {
+		return nil, fmt.Errorf("%w: default_locale is required", ErrInvalidPack)
+	}
+
+	icons := make(map[string]string)
+	names := make(map[string]bool)
+	for i, ctg := range file.Categories {
+		name := ctg.Name[pack.defaultLocale]
+		if name == "" {
+			return nil, fmt.Errorf("%w: category %d has no %s name", ErrInvalidPack, i, pack.defaultLocale)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("%w: duplicate category %q", ErrInvalidPack, name)
+		}
+		names[name] = true
+
+		for locale, value := range ctg.Name {
+			if utf8.RuneCountInString(value) > maxNameLength {
+				return nil, fmt.Errorf("%w: %s name of %q is too long", ErrInvalidPack, locale, name)
+			}
+		}
+		for locale, value := range ctg.Description {
+			if utf8.RuneCountInString(value) > maxDescriptionLength {
+				return nil, fmt.Errorf("%w: %s description of %q is too long", ErrInvalidPack, locale, name)
+			}
+		}
+
+		category := packCategory{
+			name:        normalizeKeys(ctg.Name),
+			description: normalizeKeys(ctg.Description),
+		}
+		if ctg.Icon != "" {
+			id, ok := icons[ctg.Icon]
+			if !ok {
+				icon, err := readIcon(fsys, ctg.Icon)
+				if err != nil {
+					return nil, err
+				}
+				id = icon.ID
+				icons[ctg.Icon] = id
+				pack.icons = append(pack.icons, icon)
+			}
+			category.iconID = id
+		}
+		pack.categories = append(pack.categories, category)
+	}
+
+	if len(pack.categories) == 0 {
+		return nil, fmt.Errorf("%w: no categories", ErrInvalidPack)
+	}
+	return pack, nil
+}
+
+func readIcon(fsys fs.FS, name string) (Icon, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return Icon{}, fmt.Errorf("read default category icon: %w", err)
+	}
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if !strings.HasPrefix(contentType, "image/") {
+		return Icon{}, fmt.Errorf("%w: %s is not an image", ErrInvalidPack, name)
+	}
+	return Icon{
+		ID:          ImageID(data),
+		Filename:    path.Base(name),
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
+func normalizeKeys(values map[string]string) map[string]string {
+	normalized := make(map[string]string, len(values))
+	for locale, value := range values {
+		normalized[NormalizeLocale(locale)] = value
+	}
+	return normalized
+}
+
+// ImageID вычисляет идентификатор, под которым image service сохранит изображение.
+func ImageID(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// NormalizeLocale приводит язык к основному тегу: "en-US,en;q=0.9" -> "en".
+// Подходит как для значения из конфигурации, так и для заголовка Accept-Language.
+func NormalizeLocale(locale string) string {
+	locale, _, _ = strings.Cut(locale, ",")
+	locale, _, _ = strings.Cut(locale, ";")
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	locale, _, _ = strings.Cut(locale, "-")
+	return strings.ToLower(locale)
+}
+
+func (p *Pack) DefaultLocale() string {
+	return p.defaultLocale
+}
+
+// Categories возвращает категории набора на языке locale. Если перевода нет,
+// используется язык набора по умолчанию.
+func (p *Pack) Categories(locale string) []Category {
+	locale = NormalizeLocale(locale)
+
+	categories := make([]Category, 0, len(p.categories))
+	for _, ctg := range p.categories {
+		name, ok := ctg.name[locale]
+		description := ctg.description[locale]
+		if !ok || name == "" {
+			name = ctg.name[p.defaultLocale]
+			description = ctg.description[p.defaultLocale]
+		}
+		categories = append(categories, Category{
+			Name:         name,
+			Description:  description,
+			LogoHashedID: ctg.iconID,
+		})
+	}
+	return categories
+}
+
+func (p *Pack) Icons() []Icon {
+	return p.icons
+}
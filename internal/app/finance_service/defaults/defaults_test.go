@@ -0,0 +1,126 @@
+package defaults
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+)
+
+func TestBuiltinPack(t *testing.T) {
+	pack := Builtin()
+
+	require.Equal(t, "ru", pack.DefaultLocale())
+	ru := pack.Categories("ru")
+	en := pack.Categories("en")
+	require.NotEmpty(t, ru)
+	require.Len(t, en, len(ru))
+	require.Equal(t, "Продукты", ru[0].Name)
+	require.Equal(t, "Groceries", en[0].Name)
+	require.Equal(t, ru[0].LogoHashedID, en[0].LogoHashedID)
+
+	ids := make(map[string]bool)
+	for _, icon := range pack.Icons() {
+		require.Equal(t, "image/svg+xml", icon.ContentType)
+		require.Equal(t, ImageID(icon.Data), icon.ID)
+		ids[icon.ID] = true
+	}
+	for _, ctg := range ru {
+		require.True(t, ids[ctg.LogoHashedID], ctg.Name)
+	}
+}
+
+func TestCategories_LocaleFallback(t *testing.T) {
+	pack := Builtin()
+
+	require.Equal(t, "Groceries", pack.Categories("en-US,en;q=0.9")[0].Name)
+	require.Equal(t, "Продукты", pack.Categories("de")[0].Name)
+	require.Equal(t, "Продукты", pack.Categories("")[0].Name)
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	require.Equal(t, "en", NormalizeLocale("en_GB"))
+	require.Equal(t, "ru", NormalizeLocale(" RU-ru;q=0.8"))
+	require.Equal(t, "", NormalizeLocale(""))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	icon := &fstest.MapFile{Data: []byte("<svg/>")}
+	tests := []struct {
+		name string
+		pack string
+	}{
+		{"no default locale", `{"categories":[{"name":{"ru":"Еда"}}]}`},
+		{"missing default name", `{"default_locale":"ru","categories":[{"name":{"en":"Food"}}]}`},
+		{"duplicate", `{"default_locale":"ru","categories":[{"name":{"ru":"Еда"}},{"name":{"ru":"Еда"}}]}`},
+		{"long name", `{"default_locale":"ru","categories":[{"name":{"ru":"Очень длинное название категории"}}]}`},
+		{"not an image", `{"default_locale":"ru","categories":[{"name":{"ru":"Еда"},"icon":"food.txt"}]}`},
+		{"empty", `{"default_locale":"ru","categories":[]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"pack.json": {Data: []byte(tt.pack)},
+				"food.txt":  icon,
+			}
+			_, err := Parse(fsys, "pack.json")
+			require.ErrorIs(t, err, ErrInvalidPack)
+		})
+	}
+}
+
+func TestParse_SharedIcon(t *testing.T) {
+	fsys := fstest.MapFS{
+		"pack.json": {Data: []byte(`{"default_locale":"en","categories":[
+			{"name":{"en":"Food"},"icon":"icons/food.png"},
+			{"name":{"en":"Snacks"},"icon":"icons/food.png"}
+		]}`)},
+		"icons/food.png": {Data: []byte("png")},
+	}
+
+	pack, err := Parse(fsys, "pack.json")
+	require.NoError(t, err)
+	require.Len(t, pack.Icons(), 1)
+	require.Equal(t, "image/png", pack.Icons()[0].ContentType)
+
+	categories := pack.Categories("en")
+	require.Equal(t, ImageID([]byte("png")), categories[1].LogoHashedID)
+}
+
+func TestUploadIcons(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pack := Builtin()
+	icons := pack.Icons()
+	storage := mocks.NewMockImageUseCase(ctrl)
+
+	storage.EXPECT().ImageExists(gomock.Any(), icons[0].ID).Return(true, nil)
+	for _, icon := range icons[1:] {
+		storage.EXPECT().ImageExists(gomock.Any(), icon.ID).Return(false, nil)
+		storage.EXPECT().
+			UploadImage(gomock.Any(), gomock.Any(), icon.Filename, int64(len(icon.Data)), "image/svg+xml").
+			Return(icon.ID, nil)
+	}
+
+	uploaded, err := UploadIcons(context.Background(), storage, pack)
+	require.NoError(t, err)
+	require.Equal(t, len(icons)-1, uploaded)
+}
+
+func TestUploadIcons_StorageError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockImageUseCase(ctrl)
+	storage.EXPECT().ImageExists(gomock.Any(), gomock.Any()).Return(false, errors.New("minio down"))
+
+	_, err := UploadIcons(context.Background(), storage, Builtin())
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package defaults
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+type ImageStorage interface {
+	ImageExists(ctx context.Context, imageID string) (bool, error)
+	UploadImage(ctx context.Context, reader io.Reader, filename string, size int64, contentType string) (string, error)
+}
+
+// UploadIcons загружает иконки набора, которых еще нет в хранилище.
+// Возвращает количество загруженных иконок.
+func UploadIcons(ctx context.Context, storage ImageStorage, pack *Pack) (int, error) {
+	uploaded := 0
+	for _, icon := range pack.Icons() {
+		exists, err := storage.ImageExists(ctx, icon.ID)
+		if err != nil {
+			return uploaded, fmt.Errorf("check icon %s: %w", icon.Filename, err)
+		}
+		if exists {
+			continue
+		}
+
+		id, err := storage.UploadImage(ctx, bytes.NewReader(icon.Data), icon.Filename, int64(len(icon.Data)), icon.ContentType)
+		if err != nil {
+			return uploaded, fmt.Errorf("upload icon %s: %w", icon.Filename, err)
+		}
+		if id != icon.ID {
+			return uploaded, fmt.Errorf("upload icon %s: storage returned id %s, expected %s", icon.Filename, id, icon.ID)
+		}
+		uploaded++
+	}
+	return uploaded, nil
+}
@@ -3,14 +3,25 @@ package errors
 import "errors"
 
 var (
-	ErrAccountNotFound   = errors.New("account not found")
-	ErrOperationNotFound = errors.New("operation not found")
-	ErrCategoryNotFound  = errors.New("category not found")
-	ErrCategoryExists    = errors.New("category already exists")
-	ErrForbidden         = errors.New("forbidden")
-	ErrInvalidData       = errors.New("invalid data")
-	ErrNegativeBalance   = errors.New("negative balance")
-	ErrPrivateAccount    = errors.New("private account")
-	ErrSharingExists     = errors.New("sharing exists")
-	ErrUserNotFound      = errors.New("user not found")
+	ErrAccountNotFound    = errors.New("account not found")
+	ErrOperationNotFound  = errors.New("operation not found")
+	ErrCategoryNotFound   = errors.New("category not found")
+	ErrCategoryExists     = errors.New("category already exists")
+	ErrForbidden          = errors.New("forbidden")
+	ErrInvalidData        = errors.New("invalid data")
+	ErrNegativeBalance    = errors.New("negative balance")
+	ErrPrivateAccount     = errors.New("private account")
+	ErrSharingExists      = errors.New("sharing exists")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrRuleNotFound       = errors.New("category rule not found")
+	ErrParentNotFound     = errors.New("parent category not found")
+	ErrCategoryCycle      = errors.New("category hierarchy cycle")
+	ErrDebtNotFound       = errors.New("debt not found")
+	ErrReceiverNotFound   = errors.New("receiver not found")
+	ErrMemberNotFound     = errors.New("account member not found")
+	ErrOwnerCannotLeave   = errors.New("account owner cannot leave")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrInvitationExpired  = errors.New("invitation expired")
+	ErrInvitationExists   = errors.New("invitation already exists")
+	ErrSplitNotFound      = errors.New("operation split not found")
 )
@@ -9,14 +9,25 @@ var ErrorMap = map[error]struct {
 	Code codes.Code
 	Msg  string
 }{
-	ErrCategoryExists:    {Code: codes.AlreadyExists, Msg: string(models.ErrCodeCategoryExists)},
-	ErrCategoryNotFound:  {Code: codes.NotFound, Msg: string(models.ErrCodeCategoryNotFound)},
-	ErrOperationNotFound: {Code: codes.NotFound, Msg: string(models.ErrCodeTransactionNotFound)},
-	ErrAccountNotFound:   {Code: codes.NotFound, Msg: string(models.ErrCodeAccountNotFound)},
-	ErrForbidden:         {Code: codes.PermissionDenied, Msg: string(models.ErrCodeForbidden)},
-	ErrInvalidData:       {Code: codes.InvalidArgument, Msg: string(models.ErrCodeInvalidData)},
-	ErrNegativeBalance:   {Code: codes.FailedPrecondition, Msg: string(models.ErrCodeNegaticeBalance)},
-	ErrPrivateAccount:    {Code: codes.FailedPrecondition, Msg: string(models.ErrCodePrivateAccount)},
-	ErrSharingExists:     {Code: codes.AlreadyExists, Msg: string(models.ErrCodeSharingExists)},
-	ErrUserNotFound:      {Code: codes.NotFound, Msg: string(models.ErrCodeUserNotFound)},
+	ErrCategoryExists:     {Code: codes.AlreadyExists, Msg: string(models.ErrCodeCategoryExists)},
+	ErrCategoryNotFound:   {Code: codes.NotFound, Msg: string(models.ErrCodeCategoryNotFound)},
+	ErrOperationNotFound:  {Code: codes.NotFound, Msg: string(models.ErrCodeTransactionNotFound)},
+	ErrAccountNotFound:    {Code: codes.NotFound, Msg: string(models.ErrCodeAccountNotFound)},
+	ErrForbidden:          {Code: codes.PermissionDenied, Msg: string(models.ErrCodeForbidden)},
+	ErrInvalidData:        {Code: codes.InvalidArgument, Msg: string(models.ErrCodeInvalidData)},
+	ErrNegativeBalance:    {Code: codes.FailedPrecondition, Msg: string(models.ErrCodeNegaticeBalance)},
+	ErrPrivateAccount:     {Code: codes.FailedPrecondition, Msg: string(models.ErrCodePrivateAccount)},
+	ErrSharingExists:      {Code: codes.AlreadyExists, Msg: string(models.ErrCodeSharingExists)},
+	ErrUserNotFound:       {Code: codes.NotFound, Msg: string(models.ErrCodeUserNotFound)},
+	ErrRuleNotFound:       {Code: codes.NotFound, Msg: string(models.ErrCodeRuleNotFound)},
+	ErrParentNotFound:     {Code: codes.NotFound, Msg: string(models.ErrCodeParentNotFound)},
+	ErrCategoryCycle:      {Code: codes.InvalidArgument, Msg: string(models.ErrCodeCategoryCycle)},
+	ErrDebtNotFound:       {Code: codes.NotFound, Msg: string(models.ErrCodeDebtNotFound)},
+	ErrReceiverNotFound:   {Code: codes.NotFound, Msg: string(models.ErrCodeReceiverNotFound)},
+	ErrMemberNotFound:     {Code: codes.NotFound, Msg: string(models.ErrCodeMemberNotFound)},
+	ErrOwnerCannotLeave:   {Code: codes.FailedPrecondition, Msg: string(models.ErrCodeOwnerCannotLeave)},
+	ErrInvitationNotFound: {Code: codes.NotFound, Msg: string(models.ErrCodeInvitationNotFound)},
+	ErrInvitationExpired:  {Code: codes.FailedPrecondition, Msg: string(models.ErrCodeInvitationExpired)},
+	ErrInvitationExists:   {Code: codes.AlreadyExists, Msg: string(models.ErrCodeInvitationExists)},
+	ErrSplitNotFound:      {Code: codes.NotFound, Msg: string(models.ErrCodeSplitNotFound)},
 }
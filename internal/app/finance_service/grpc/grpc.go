@@ -151,28 +151,268 @@ func (s *FinanceServerImpl) CreateOperation(ctx context.Context, req *finpb.Crea
 	return operation, nil
 }
 
-func (s *FinanceServerImpl) AddUserToAccounnt(ctx context.Context, req *finpb.AddToAccountReqeust) (*finpb.SharingsResponse, error) {
-	sharing, err := s.financeUC.AddUserToAccount(ctx, req.UserLogin, int(req.AccountId))
+func (s *FinanceServerImpl) GetAccountMembers(ctx context.Context, req *finpb.AccountRequest) (*finpb.ListAccountMembersResponse, error) {
+	members, err := s.financeUC.GetAccountMembers(ctx, int(req.UserId), int(req.AccountId))
 	if err != nil {
 		logger := logger.FromContext(ctx)
 		for targetErr, resp := range finerrors.ErrorMap {
 			if errors.Is(err, targetErr) {
 				if logger != nil {
-					logger.Error("Failed to add user to account", "error", err)
+					logger.Error("Failed to get account members", "error", err)
 				}
 				return nil, status.Error(resp.Code, resp.Msg)
 			}
 		}
 		if logger != nil {
-			logger.Error("Failed to add user to account, internal error", "error", err)
+			logger.Error("Failed to get account members, internal error", "error", err)
 		}
-		return nil, status.Error(codes.Internal, string(models.ErrCodeAccountNotFound))
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return members, nil
+}
+
+func (s *FinanceServerImpl) UpdateAccountMemberRole(ctx context.Context, req *finpb.AccountMemberRequest) (*finpb.SharingsResponse, error) {
+	sharing, err := s.financeUC.UpdateAccountMemberRole(ctx, protoToAccountMemberRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to update account member role", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to update account member role, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return sharing, nil
+}
+
+func (s *FinanceServerImpl) RemoveAccountMember(ctx context.Context, req *finpb.AccountMemberRequest) (*finpb.SharingsResponse, error) {
+	sharing, err := s.financeUC.RemoveAccountMember(ctx, protoToAccountMemberRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to remove account member", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to remove account member, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return sharing, nil
+}
+
+func (s *FinanceServerImpl) LeaveAccount(ctx context.Context, req *finpb.AccountRequest) (*finpb.SharingsResponse, error) {
+	sharing, err := s.financeUC.LeaveAccount(ctx, int(req.UserId), int(req.AccountId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to leave account", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to leave account, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return sharing, nil
+}
+
+func (s *FinanceServerImpl) TransferAccountOwnership(ctx context.Context, req *finpb.AccountMemberRequest) (*finpb.ListAccountMembersResponse, error) {
+	members, err := s.financeUC.TransferAccountOwnership(ctx, protoToAccountMemberRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to transfer account ownership", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to transfer account ownership, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return members, nil
+}
+
+func (s *FinanceServerImpl) GetAccountUserIDs(ctx context.Context, req *finpb.AccountID) (*finpb.UserIDs, error) {
+	users, err := s.financeUC.GetAccountUserIDs(ctx, int(req.AccountId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get account users", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get account users, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return users, nil
+}
+
+func (s *FinanceServerImpl) CreateAccountInvitation(ctx context.Context, req *finpb.CreateAccountInvitationRequest) (*finpb.AccountInvitation, error) {
+	invitation, err := s.financeUC.CreateAccountInvitation(ctx, protoToCreateInvitationRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to create account invitation", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to create account invitation, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return invitation, nil
+}
+
+func (s *FinanceServerImpl) GetPendingInvitations(ctx context.Context, req *finpb.UserID) (*finpb.ListAccountInvitationsResponse, error) {
+	invitations, err := s.financeUC.GetPendingInvitations(ctx, int(req.UserId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get pending invitations", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get pending invitations, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return invitations, nil
+}
+
+func (s *FinanceServerImpl) GetAccountInvitations(ctx context.Context, req *finpb.AccountRequest) (*finpb.ListAccountInvitationsResponse, error) {
+	invitations, err := s.financeUC.GetAccountInvitations(ctx, int(req.UserId), int(req.AccountId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get account invitations", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get account invitations, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return invitations, nil
+}
+
+func (s *FinanceServerImpl) AcceptAccountInvitation(ctx context.Context, req *finpb.AccountInvitationRequest) (*finpb.SharingsResponse, error) {
+	sharing, err := s.financeUC.AcceptAccountInvitation(ctx, protoToInvitationRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to accept account invitation", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to accept account invitation, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return sharing, nil
+}
+
+func (s *FinanceServerImpl) AcceptInvitationLink(ctx context.Context, req *finpb.AcceptInvitationLinkRequest) (*finpb.SharingsResponse, error) {
+	sharing, err := s.financeUC.AcceptInvitationLink(ctx, int(req.UserId), req.Token)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to accept invitation link", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to accept invitation link, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
 	}
 	return sharing, nil
 }
 
+func (s *FinanceServerImpl) DeclineAccountInvitation(ctx context.Context, req *finpb.AccountInvitationRequest) (*finpb.AccountInvitation, error) {
+	invitation, err := s.financeUC.DeclineAccountInvitation(ctx, protoToInvitationRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to decline account invitation", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to decline account invitation, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return invitation, nil
+}
+
+func (s *FinanceServerImpl) RevokeAccountInvitation(ctx context.Context, req *finpb.AccountInvitationRequest) (*finpb.AccountInvitation, error) {
+	invitation, err := s.financeUC.RevokeAccountInvitation(ctx, protoToInvitationRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to revoke account invitation", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to revoke account invitation, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return invitation, nil
+}
+
 func (s *FinanceServerImpl) GetOperation(ctx context.Context, req *finpb.OperationRequest) (*finpb.Operation, error) {
-	operation, err := s.financeUC.GetOperationByID(ctx, int(req.AccountId), int(req.OperationId))
+	operation, err := s.financeUC.GetOperationByID(ctx, int(req.UserId), int(req.AccountId), int(req.OperationId))
 	if err != nil {
 		logger := logger.FromContext(ctx)
 		for targetErr, resp := range finerrors.ErrorMap {
@@ -201,7 +441,7 @@ func (s *FinanceServerImpl) GetOperationsByAccount(ctx context.Context, req *fin
 		date = ""
 	}
 
-	operations, err := s.financeUC.GetOperationsByAccount(ctx, int(req.AccountId), CategoryIDsInt(req.CategoryIds), req.Name, req.OperationType, req.AccountType, date)
+	operations, err := s.financeUC.GetOperationsByAccount(ctx, int(req.UserId), int(req.AccountId), CategoryIDsInt(req.CategoryIds), req.Name, req.OperationType, req.AccountType, date)
 	if err != nil {
 		for targetErr, resp := range finerrors.ErrorMap {
 			if errors.Is(err, targetErr) {
@@ -241,7 +481,7 @@ func (s *FinanceServerImpl) UpdateOperation(ctx context.Context, req *finpb.Upda
 }
 
 func (s *FinanceServerImpl) DeleteOperation(ctx context.Context, req *finpb.OperationRequest) (*finpb.Operation, error) {
-	operation, err := s.financeUC.DeleteOperation(ctx, int(req.AccountId), int(req.OperationId))
+	operation, err := s.financeUC.DeleteOperation(ctx, int(req.UserId), int(req.AccountId), int(req.OperationId))
 	if err != nil {
 		logger := logger.FromContext(ctx)
 		for targetErr, resp := range finerrors.ErrorMap {
@@ -260,6 +500,31 @@ func (s *FinanceServerImpl) DeleteOperation(ctx context.Context, req *finpb.Oper
 	return operation, nil
 }
 
+func (s *FinanceServerImpl) GetOperationsByIDs(ctx context.Context, req *finpb.OperationsByIDsRequest) (*finpb.OperationsByIDsResponse, error) {
+	opIDs := make([]int, 0, len(req.OperationIds))
+	for _, id := range req.OperationIds {
+		opIDs = append(opIDs, int(id))
+	}
+
+	operations, err := s.financeUC.GetOperationsByIDs(ctx, int(req.UserId), opIDs)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get operations by ids", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get operations by ids, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return operations, nil
+}
+
 // Category methods
 func (s *FinanceServerImpl) CreateCategory(ctx context.Context, req *finpb.CreateCategoryRequest) (*finpb.Category, error) {
 	createReq := protoToCreateCategoryRequest(req)
@@ -339,6 +604,7 @@ func (s *FinanceServerImpl) UpdateCategory(ctx context.Context, req *finpb.Updat
 	category := finmodels.Category{
 		ID:           int(updateReq.CategoryID),
 		UserID:       int(updateReq.UserID),
+		ParentID:     updateReq.ParentID,
 		Name:         getStringValue(updateReq.Name),
 		Description:  getStringValue(updateReq.Description),
 		LogoHashedID: getStringValue(updateReq.LogoHashedID),
@@ -363,8 +629,8 @@ func (s *FinanceServerImpl) UpdateCategory(ctx context.Context, req *finpb.Updat
 	return updatedCategory, nil
 }
 
-func (s *FinanceServerImpl) DeleteCategory(ctx context.Context, req *finpb.CategoryRequest) (*finpb.Category, error) {
-	err := s.financeUC.DeleteCategory(ctx, int(req.UserId), int(req.CategoryId))
+func (s *FinanceServerImpl) DeleteCategory(ctx context.Context, req *finpb.DeleteCategoryRequest) (*finpb.Category, error) {
+	res, err := s.financeUC.DeleteCategory(ctx, int(req.UserId), int(req.CategoryId), int(req.ReassignTo))
 	if err != nil {
 		logger := logger.FromContext(ctx)
 		for targetErr, resp := range finerrors.ErrorMap {
@@ -380,7 +646,47 @@ func (s *FinanceServerImpl) DeleteCategory(ctx context.Context, req *finpb.Categ
 		}
 		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
 	}
-	return &finpb.Category{}, nil
+	return res, nil
+}
+
+func (s *FinanceServerImpl) MergeCategories(ctx context.Context, req *finpb.MergeCategoriesRequest) (*finpb.MergeCategoriesResponse, error) {
+	res, err := s.financeUC.MergeCategories(ctx, int(req.UserId), int(req.SourceId), int(req.TargetId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to merge categories", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to merge categories, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) ProvisionDefaultCategories(ctx context.Context, req *finpb.ProvisionDefaultCategoriesRequest) (*finpb.ListCategoriesResponse, error) {
+	categories, err := s.financeUC.ProvisionDefaultCategories(ctx, int(req.UserId), req.Locale, req.Overwrite)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to provision default categories", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to provision default categories, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return categories, nil
 }
 
 func (s *FinanceServerImpl) GetCategoriesReport(ctx context.Context, req *finpb.CategoryReportRequest) (*finpb.CategoryReportResponse, error) {
@@ -403,9 +709,549 @@ func (s *FinanceServerImpl) GetCategoriesReport(ctx context.Context, req *finpb.
 	return report, nil
 }
 
-func getStringValue(s *string) string {
-	if s == nil {
-		return ""
+func (s *FinanceServerImpl) ExportUserData(ctx context.Context, req *finpb.UserID) (*finpb.UserDataExport, error) {
+	data, err := s.financeUC.ExportUserData(ctx, int(req.UserId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to export user data", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to export user data, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
 	}
-	return *s
+	return data, nil
+}
+
+func (s *FinanceServerImpl) ImportUserData(ctx context.Context, req *finpb.ImportUserDataRequest) (*finpb.ImportUserDataResponse, error) {
+	res, err := s.financeUC.ImportUserData(ctx, protoToImportUserDataRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to import user data", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to import user data, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+func (s *FinanceServerImpl) DeleteUserData(ctx context.Context, req *finpb.UserID) (*finpb.UserDataDeletion, error) {
+	res, err := s.financeUC.DeleteUserData(ctx, int(req.UserId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to delete user data", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to delete user data, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) FilterUsedImages(ctx context.Context, req *finpb.ImageIDs) (*finpb.ImageIDs, error) {
+	res, err := s.financeUC.FilterUsedImages(ctx, req.GetIds())
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to filter used images", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to filter used images, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+// Category rule methods
+func (s *FinanceServerImpl) CreateCategoryRule(ctx context.Context, req *finpb.CreateCategoryRuleRequest) (*finpb.CategoryRule, error) {
+	res, err := s.financeUC.CreateCategoryRule(ctx, protoToCreateCategoryRuleRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to create category rule", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to create category rule, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) GetCategoryRules(ctx context.Context, req *finpb.UserID) (*finpb.ListCategoryRulesResponse, error) {
+	res, err := s.financeUC.GetCategoryRules(ctx, int(req.UserId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get category rules", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get category rules, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) UpdateCategoryRule(ctx context.Context, req *finpb.UpdateCategoryRuleRequest) (*finpb.CategoryRule, error) {
+	res, err := s.financeUC.UpdateCategoryRule(ctx, protoToUpdateCategoryRuleRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to update category rule", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to update category rule, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) DeleteCategoryRule(ctx context.Context, req *finpb.CategoryRuleRequest) (*finpb.CategoryRule, error) {
+	res, err := s.financeUC.DeleteCategoryRule(ctx, int(req.UserId), int(req.RuleId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to delete category rule", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to delete category rule, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) ReorderCategoryRules(ctx context.Context, req *finpb.ReorderCategoryRulesRequest) (*finpb.ListCategoryRulesResponse, error) {
+	res, err := s.financeUC.ReorderCategoryRules(ctx, int(req.UserId), protoIDsToInts(req.RuleIds))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to reorder category rules", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to reorder category rules, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) TestCategoryRules(ctx context.Context, req *finpb.TestCategoryRulesRequest) (*finpb.TestCategoryRulesResponse, error) {
+	res, err := s.financeUC.TestCategoryRules(ctx, int(req.UserId), req.Name, req.Sum, finmodels.OperationType(req.Type))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to test category rules", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to test category rules, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) ApplyCategoryRules(ctx context.Context, req *finpb.ApplyCategoryRulesRequest) (*finpb.ApplyCategoryRulesResponse, error) {
+	res, err := s.financeUC.ApplyCategoryRules(ctx, int(req.UserId), req.Overwrite)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to apply category rules", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to apply category rules, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func getStringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func (s *FinanceServerImpl) SuggestCategory(ctx context.Context, req *finpb.SuggestCategoryRequest) (*finpb.SuggestCategoryResponse, error) {
+	res, err := s.financeUC.SuggestCategory(ctx, int(req.UserId), req.Name, req.Sum, finmodels.OperationType(req.Type))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to suggest category", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to suggest category, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) GetSpendingStats(ctx context.Context, req *finpb.SpendingStatsRequest) (*finpb.SpendingStatsResponse, error) {
+	stats, err := s.financeUC.GetSpendingStats(ctx, ProtoToSpendingStatsRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get spending stats", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get spending stats, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return stats, nil
+}
+
+func (s *FinanceServerImpl) GetSpendingTotals(ctx context.Context, req *finpb.SpendingStatsRequest) (*finpb.SpendingTotals, error) {
+	totals, err := s.financeUC.GetSpendingTotals(ctx, ProtoToSpendingStatsRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get spending totals", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get spending totals, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return totals, nil
+}
+
+func (s *FinanceServerImpl) GetCounterparties(ctx context.Context, req *finpb.UserID) (*finpb.ListCounterpartiesResponse, error) {
+	res, err := s.financeUC.GetCounterparties(ctx, int(req.UserId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get counterparties", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get counterparties, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) CreateDebt(ctx context.Context, req *finpb.CreateDebtRequest) (*finpb.Debt, error) {
+	res, err := s.financeUC.CreateDebt(ctx, protoToCreateDebtRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to create debt", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to create debt, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) GetDebts(ctx context.Context, req *finpb.ListDebtsRequest) (*finpb.ListDebtsResponse, error) {
+	res, err := s.financeUC.GetDebts(ctx, int(req.UserId), req.Status)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get debts", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get debts, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) GetDebt(ctx context.Context, req *finpb.DebtRequest) (*finpb.Debt, error) {
+	res, err := s.financeUC.GetDebt(ctx, int(req.UserId), int(req.DebtId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get debt", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get debt, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) DeleteDebt(ctx context.Context, req *finpb.DebtRequest) (*finpb.Debt, error) {
+	res, err := s.financeUC.DeleteDebt(ctx, int(req.UserId), int(req.DebtId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to delete debt", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to delete debt, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) AddDebtRepayment(ctx context.Context, req *finpb.CreateDebtRepaymentRequest) (*finpb.DebtPayment, error) {
+	res, err := s.financeUC.AddDebtRepayment(ctx, protoToCreateDebtRepaymentRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to add debt repayment", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to add debt repayment, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) GetDebtPayments(ctx context.Context, req *finpb.DebtRequest) (*finpb.ListDebtPaymentsResponse, error) {
+	res, err := s.financeUC.GetDebtPayments(ctx, int(req.UserId), int(req.DebtId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get debt payments", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get debt payments, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) SplitOperation(ctx context.Context, req *finpb.SplitOperationRequest) (*finpb.OperationSplit, error) {
+	res, err := s.financeUC.SplitOperation(ctx, protoToSplitOperationRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to split operation", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to split operation, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) GetOperationSplit(ctx context.Context, req *finpb.OperationRequest) (*finpb.OperationSplit, error) {
+	res, err := s.financeUC.GetOperationSplit(ctx, int(req.UserId), int(req.AccountId), int(req.OperationId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get operation split", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get operation split, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) DeleteOperationSplit(ctx context.Context, req *finpb.OperationRequest) (*finpb.OperationSplit, error) {
+	res, err := s.financeUC.DeleteOperationSplit(ctx, int(req.UserId), int(req.AccountId), int(req.OperationId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to delete operation split", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to delete operation split, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) GetAccountBalances(ctx context.Context, req *finpb.AccountRequest) (*finpb.AccountBalances, error) {
+	res, err := s.financeUC.GetAccountBalances(ctx, int(req.UserId), int(req.AccountId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get account balances", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get account balances, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) CreateSettlement(ctx context.Context, req *finpb.CreateSettlementRequest) (*finpb.Settlement, error) {
+	res, err := s.financeUC.CreateSettlement(ctx, protoToCreateSettlementRequest(req))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to create settlement", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to create settlement, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
+}
+
+func (s *FinanceServerImpl) GetSettlements(ctx context.Context, req *finpb.AccountRequest) (*finpb.ListSettlementsResponse, error) {
+	res, err := s.financeUC.GetSettlements(ctx, int(req.UserId), int(req.AccountId))
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range finerrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get settlements", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get settlements, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return res, nil
 }
@@ -115,10 +115,10 @@ func TestFinanceServer_GetOperation(t *testing.T) {
 	mockUC := mocks.NewMockFinanceUseCase(ctrl)
 	server := NewFinanceServer(mockUC)
 
-	req := &finpb.OperationRequest{AccountId: 1, OperationId: 2}
+	req := &finpb.OperationRequest{UserId: 5, AccountId: 1, OperationId: 2}
 	expected := &finpb.Operation{Id: 2, AccountId: 1, Sum: 50}
 
-	mockUC.EXPECT().GetOperationByID(gomock.Any(), 1, 2).Return(expected, nil)
+	mockUC.EXPECT().GetOperationByID(gomock.Any(), 5, 1, 2).Return(expected, nil)
 
 	resp, err := server.GetOperation(context.Background(), req)
 	assert.NoError(t, err)
@@ -132,10 +132,10 @@ func TestFinanceServer_DeleteOperation(t *testing.T) {
 	mockUC := mocks.NewMockFinanceUseCase(ctrl)
 	server := NewFinanceServer(mockUC)
 
-	req := &finpb.OperationRequest{AccountId: 1, OperationId: 2}
+	req := &finpb.OperationRequest{UserId: 5, AccountId: 1, OperationId: 2}
 	expected := &finpb.Operation{Id: 2, AccountId: 1, Sum: 50}
 
-	mockUC.EXPECT().DeleteOperation(gomock.Any(), 1, 2).Return(expected, nil)
+	mockUC.EXPECT().DeleteOperation(gomock.Any(), 5, 1, 2).Return(expected, nil)
 
 	resp, err := server.DeleteOperation(context.Background(), req)
 	assert.NoError(t, err)
@@ -245,3 +245,52 @@ func TestFinanceServer_GetCategoriesByUser_Internal(t *testing.T) {
 	st, _ := status.FromError(err)
 	assert.Equal(t, codes.Internal, st.Code())
 }
+
+func TestFinanceServer_AcceptInvitationLink_Expired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockFinanceUseCase(ctrl)
+	server := NewFinanceServer(mockUC)
+
+	mockUC.EXPECT().AcceptInvitationLink(gomock.Any(), 1, "raw").Return(nil, finerrors.ErrInvitationExpired)
+
+	resp, err := server.AcceptInvitationLink(context.Background(), &finpb.AcceptInvitationLinkRequest{UserId: 1, Token: "raw"})
+	assert.Nil(t, resp)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestFinanceServer_GetOperationSplit_NotSplit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockFinanceUseCase(ctrl)
+	server := NewFinanceServer(mockUC)
+
+	mockUC.EXPECT().GetOperationSplit(gomock.Any(), 1, 3, 10).Return(nil, finerrors.ErrSplitNotFound)
+
+	resp, err := server.GetOperationSplit(context.Background(), &finpb.OperationRequest{UserId: 1, AccountId: 3, OperationId: 10})
+	assert.Nil(t, resp)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "SPLIT_NOT_FOUND", st.Message())
+}
+
+func TestFinanceServer_DeleteUserData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockFinanceUseCase(ctrl)
+	server := NewFinanceServer(mockUC)
+
+	mockUC.EXPECT().DeleteUserData(gomock.Any(), 1).Return(&finpb.UserDataDeletion{DeletedAccountIds: []int32{3}}, nil)
+	resp, err := server.DeleteUserData(context.Background(), &finpb.UserID{UserId: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{3}, resp.DeletedAccountIds)
+
+	mockUC.EXPECT().DeleteUserData(gomock.Any(), 2).Return(nil, errors.New("es down"))
+	_, err = server.DeleteUserData(context.Background(), &finpb.UserID{UserId: 2})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Internal, st.Code())
+}
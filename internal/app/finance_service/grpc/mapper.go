@@ -5,6 +5,7 @@ import (
 
 	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func protoToCreateAccountRequest(req *finpb.CreateAccountRequest) finmodels.CreateAccountRequest {
@@ -33,6 +34,31 @@ func protoToUpdateAccountRequest(req *finpb.UpdateAccountRequest) finmodels.Upda
 	}
 }
 
+func protoToCreateInvitationRequest(req *finpb.CreateAccountInvitationRequest) finmodels.CreateInvitationRequest {
+	return finmodels.CreateInvitationRequest{
+		UserID:    int(req.UserId),
+		AccountID: int(req.AccountId),
+		UserLogin: req.UserLogin,
+		Role:      finmodels.SharingRole(req.Role),
+	}
+}
+
+func protoToInvitationRequest(req *finpb.AccountInvitationRequest) finmodels.InvitationRequest {
+	return finmodels.InvitationRequest{
+		UserID:       int(req.UserId),
+		InvitationID: int(req.InvitationId),
+	}
+}
+
+func protoToAccountMemberRequest(req *finpb.AccountMemberRequest) finmodels.AccountMemberRequest {
+	return finmodels.AccountMemberRequest{
+		UserID:    int(req.UserId),
+		AccountID: int(req.AccountId),
+		MemberID:  int(req.MemberId),
+		Role:      finmodels.SharingRole(req.Role),
+	}
+}
+
 func protoToCreateOperationRequest(req *finpb.CreateOperationRequest) finmodels.CreateOperationRequest {
 	var categoryID *int
 	if req.CategoryId != nil {
@@ -99,8 +125,15 @@ func protoToUpdateOperationRequest(req *finpb.UpdateOperationRequest) finmodels.
 }
 
 func protoToCreateCategoryRequest(req *finpb.CreateCategoryRequest) finmodels.CreateCategoryRequest {
+	var parentID *int
+	if req.ParentId != 0 {
+		id := int(req.ParentId)
+		parentID = &id
+	}
+
 	return finmodels.CreateCategoryRequest{
 		UserID:       int(req.UserId),
+		ParentID:     parentID,
 		Name:         req.Name,
 		Description:  req.Description,
 		LogoHashedID: req.LogoHashedId,
@@ -123,9 +156,16 @@ func protoToUpdateCategoryRequest(req *finpb.UpdateCategoryRequest) finmodels.Up
 		logoHashedID = req.LogoHashedId
 	}
 
+	var parentID *int
+	if req.ParentId != nil {
+		id := int(*req.ParentId)
+		parentID = &id
+	}
+
 	return finmodels.UpdateCategoryRequest{
 		UserID:       int(req.UserId),
 		CategoryID:   int(req.CategoryId),
+		ParentID:     parentID,
 		Name:         name,
 		Description:  description,
 		LogoHashedID: logoHashedID,
@@ -147,3 +187,199 @@ func CategoryIDsInt(ids []int32) []int {
 	}
 	return intIds
 }
+
+func protoToImportUserDataRequest(req *finpb.ImportUserDataRequest) finmodels.ImportUserDataRequest {
+	data := finmodels.UserData{}
+	src := req.GetData()
+
+	for _, acc := range src.GetAccounts() {
+		description := acc.Description
+		data.Accounts = append(data.Accounts, finmodels.Account{
+			ID:          int(acc.Id),
+			Balance:     acc.Balance,
+			Type:        acc.Type,
+			Name:        acc.Name,
+			Description: &description,
+			CurrencyID:  int(acc.CurrencyId),
+			CreatedAt:   acc.CreatedAt.AsTime(),
+			UpdatedAt:   acc.UpdatedAt.AsTime(),
+		})
+	}
+
+	for _, ctg := range src.GetCategories() {
+		var parentID *int
+		if ctg.ParentId != 0 {
+			id := int(ctg.ParentId)
+			parentID = &id
+		}
+		data.Categories = append(data.Categories, finmodels.Category{
+			ID:           int(ctg.Id),
+			UserID:       int(req.UserId),
+			ParentID:     parentID,
+			Name:         ctg.Name,
+			Description:  ctg.Description,
+			LogoHashedID: ctg.LogoHashedId,
+			CreatedAt:    ctg.CreatedAt.AsTime(),
+			UpdatedAt:    ctg.UpdatedAt.AsTime(),
+		})
+	}
+
+	for _, op := range src.GetOperations() {
+		data.Operations = append(data.Operations, finmodels.Operation{
+			ID:           int(op.Id),
+			AccountID:    int(op.AccountId),
+			AccountToID:  int(op.AccountToId),
+			CategoryID:   int(op.CategoryId),
+			CategoryName: op.CategoryName,
+			Type:         finmodels.OperationType(op.Type),
+			Status:       finmodels.OperationStatus(op.Status),
+			AccountType:  finmodels.AccountType(op.AccountType),
+			Description:  op.Description,
+			ReceiptURL:   op.ReceiptUrl,
+			Name:         op.Name,
+			Sum:          op.Sum,
+			CurrencyID:   int(op.CurrencyId),
+			CreatedAt:    op.CreatedAt.AsTime(),
+			Date:         op.Date.AsTime(),
+		})
+	}
+
+	for _, rcv := range src.GetReceivers() {
+		data.Receivers = append(data.Receivers, finmodels.Receiver{
+			ID:           int(rcv.Id),
+			UserID:       int(req.UserId),
+			Name:         rcv.Name,
+			LogoHashedID: rcv.LogoHashedId,
+			CreatedAt:    rcv.CreatedAt.AsTime(),
+		})
+	}
+
+	for _, sh := range src.GetSharings() {
+		data.Sharings = append(data.Sharings, finmodels.SharingAccount{
+			UserID:    int(sh.UserId),
+			UserLogin: sh.UserLogin,
+			AccountID: int(sh.AccountId),
+			Role:      finmodels.SharingRole(sh.Role),
+			CreatedAt: sh.CreatedAt.AsTime(),
+		})
+	}
+
+	return finmodels.ImportUserDataRequest{
+		UserID:   int(req.UserId),
+		BackupID: req.BackupId,
+		Data:     data,
+	}
+}
+
+func protoToCreateCategoryRuleRequest(req *finpb.CreateCategoryRuleRequest) finmodels.CreateCategoryRuleRequest {
+	return finmodels.CreateCategoryRuleRequest{
+		UserID:        int(req.UserId),
+		CategoryID:    int(req.CategoryId),
+		NameContains:  req.NameContains,
+		OperationType: finmodels.OperationType(req.OperationType),
+		AmountGT:      req.AmountGt,
+		AmountLT:      req.AmountLt,
+	}
+}
+
+func protoToUpdateCategoryRuleRequest(req *finpb.UpdateCategoryRuleRequest) finmodels.UpdateCategoryRuleRequest {
+	return finmodels.UpdateCategoryRuleRequest{
+		UserID:        int(req.UserId),
+		RuleID:        int(req.RuleId),
+		CategoryID:    int(req.CategoryId),
+		NameContains:  req.NameContains,
+		OperationType: finmodels.OperationType(req.OperationType),
+		AmountGT:      req.AmountGt,
+		AmountLT:      req.AmountLt,
+	}
+}
+
+func protoIDsToInts(ids []int32) []int {
+	res := make([]int, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int(id))
+	}
+	return res
+}
+
+func ProtoToSpendingStatsRequest(req *finpb.SpendingStatsRequest) finmodels.SpendingStatsRequest {
+	categoryIDs := make([]int, 0, len(req.CategoryIds))
+	for _, id := range req.CategoryIds {
+		categoryIDs = append(categoryIDs, int(id))
+	}
+	accountIDs := make([]int, 0, len(req.AccountIds))
+	for _, id := range req.AccountIds {
+		accountIDs = append(accountIDs, int(id))
+	}
+	return finmodels.SpendingStatsRequest{
+		UserID:       int(req.UserId),
+		CurrencyID:   int(req.CurrencyId),
+		CategoryIDs:  categoryIDs,
+		AccountIDs:   accountIDs,
+		Start:        req.Start.AsTime(),
+		End:          req.End.AsTime(),
+		HistoryStart: req.HistoryStart.AsTime(),
+	}
+}
+
+func protoTimePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func protoToCreateDebtRequest(req *finpb.CreateDebtRequest) finmodels.CreateDebtRequest {
+	return finmodels.CreateDebtRequest{
+		UserID:            int(req.UserId),
+		ReceiverID:        int(req.ReceiverId),
+		ReceiverName:      req.ReceiverName,
+		CounterpartyLogin: req.CounterpartyLogin,
+		Direction:         finmodels.DebtDirection(req.Direction),
+		AccountID:         int(req.AccountId),
+		Principal:         req.Principal,
+		Description:       req.Description,
+		DueDate:           protoTimePtr(req.DueDate),
+		Date:              protoTimePtr(req.Date),
+	}
+}
+
+func protoToCreateDebtRepaymentRequest(req *finpb.CreateDebtRepaymentRequest) finmodels.CreateDebtRepaymentRequest {
+	return finmodels.CreateDebtRepaymentRequest{
+		UserID:    int(req.UserId),
+		DebtID:    int(req.DebtId),
+		AccountID: int(req.AccountId),
+		Amount:    req.Amount,
+		Date:      protoTimePtr(req.Date),
+	}
+}
+
+func protoToSplitOperationRequest(req *finpb.SplitOperationRequest) finmodels.SplitOperationRequest {
+	shares := make([]finmodels.SplitShare, 0, len(req.Shares))
+	for _, share := range req.Shares {
+		shares = append(shares, finmodels.SplitShare{
+			UserID: int(share.UserId),
+			Value:  share.Value,
+		})
+	}
+	return finmodels.SplitOperationRequest{
+		UserID:      int(req.UserId),
+		AccountID:   int(req.AccountId),
+		OperationID: int(req.OperationId),
+		PayerID:     int(req.PayerId),
+		Method:      finmodels.SplitMethod(req.Method),
+		Shares:      shares,
+	}
+}
+
+func protoToCreateSettlementRequest(req *finpb.CreateSettlementRequest) finmodels.CreateSettlementRequest {
+	return finmodels.CreateSettlementRequest{
+		UserID:      int(req.UserId),
+		AccountID:   int(req.AccountId),
+		PayerID:     int(req.PayerId),
+		PayeeID:     int(req.PayeeId),
+		Amount:      req.Amount,
+		Description: req.Description,
+	}
+}
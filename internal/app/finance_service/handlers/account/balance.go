@@ -0,0 +1,158 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) handleBalanceError(w http.ResponseWriter, r *http.Request, err error, method string) {
+	log := logger.FromContext(r.Context())
+	st, ok := status.FromError(err)
+	if !ok {
+		if log != nil {
+			log.Error("grpc "+method+" unknown error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to process account balances")
+		return
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		httputils.Error(w, r, "Некорректные данные погашения", http.StatusBadRequest)
+	case codes.PermissionDenied:
+		httputils.Error(w, r, "Погашение может записать только плательщик или получатель", http.StatusForbidden)
+	case codes.NotFound:
+		httputils.NotFoundError(w, r, models.ErrorCode(st.Message()).GetErrorMessage())
+	default:
+		if log != nil {
+			log.Error("grpc "+method+" error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to process account balances")
+	}
+}
+
+// GetAccountBalances godoc
+// @Summary Взаиморасчеты участников счета
+// @Description Возвращает баланс каждого участника по разделенным расходам с учетом погашений и долги между парами участников
+// @Tags accounts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID счета"
+// @Success 200 {object} AccountBalancesAPI "Балансы участников"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID счета (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Счет не найден (ACCOUNT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /accounts/{id}/balances [get]
+func (h *Handler) GetAccountBalances(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	accID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "id")
+		return
+	}
+
+	balances, err := h.finClient.GetAccountBalances(r.Context(), UserIDAndAccountIDToProtoID(userID, accID))
+	if err != nil {
+		h.handleBalanceError(w, r, err, "GetAccountBalances")
+		return
+	}
+
+	httputils.Success(w, r, BalancesProtoToApi(balances))
+}
+
+// CreateSettlement godoc
+// @Summary Погашение долга
+// @Description Записывает выплату между участниками счета, в том числе бывшими, с которыми не рассчитались. Без payer_id плательщиком считается текущий пользователь. Записать погашение может только плательщик или получатель
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID счета"
+// @Param request body models.CreateSettlementRequest true "Плательщик, получатель и сумма"
+// @Success 201 {object} SettlementAPI "Записанное погашение"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Погашение может записать только плательщик или получатель"
+// @Failure 404 {object} models.ErrorResponse "Счет или участник не найден (ACCOUNT_NOT_FOUND, MEMBER_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /accounts/{id}/balances/settlements [post]
+func (h *Handler) CreateSettlement(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	accID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "id")
+		return
+	}
+
+	var req models.CreateSettlementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if req.PayeeID <= 0 {
+		httputils.ValidationError(w, r, "Не указан получатель", "payee_id")
+		return
+	}
+	if req.Amount <= 0 {
+		httputils.ValidationError(w, r, "Сумма должна быть положительной", "amount")
+		return
+	}
+
+	settlement, err := h.finClient.CreateSettlement(r.Context(), CreateSettlementRequestToProto(userID, accID, req))
+	if err != nil {
+		h.handleBalanceError(w, r, err, "CreateSettlement")
+		return
+	}
+
+	httputils.Created(w, r, SettlementProtoToApi(settlement))
+}
+
+// GetSettlements godoc
+// @Summary История погашений
+// @Description Возвращает погашения долгов между участниками счета, новые первыми
+// @Tags accounts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID счета"
+// @Success 200 {object} SettlementsAPI "Погашения"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID счета (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /accounts/{id}/balances/settlements [get]
+func (h *Handler) GetSettlements(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	accID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "id")
+		return
+	}
+
+	settlements, err := h.finClient.GetSettlements(r.Context(), UserIDAndAccountIDToProtoID(userID, accID))
+	if err != nil {
+		h.handleBalanceError(w, r, err, "GetSettlements")
+		return
+	}
+
+	httputils.Success(w, r, SettlementsProtoToApi(settlements))
+}
@@ -0,0 +1,98 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestGetAccountBalances_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		GetAccountBalances(gomock.Any(), &finpb.AccountRequest{UserId: 1, AccountId: 5}).
+		Return(&finpb.AccountBalances{
+			AccountId: 5, CurrencyId: 1,
+			Members: []*finpb.MemberBalance{
+				{UserId: 1, UserLogin: "ivan", Net: -10},
+				{UserId: 2, UserLogin: "anna", Net: 10},
+			},
+			Debts: []*finpb.PairBalance{{DebtorId: 1, DebtorLogin: "ivan", CreditorId: 2, CreditorLogin: "anna", Amount: 10}},
+		}, nil)
+
+	req := mux.SetURLVars(memberRequest(t, http.MethodGet, "/accounts/5/balances", nil), map[string]string{"id": "5"})
+	rr := httptest.NewRecorder()
+	handler.GetAccountBalances(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp AccountBalancesAPI
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Members, 2)
+	require.Equal(t, 2, resp.Debts[0].CreditorID)
+}
+
+func TestCreateSettlement_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		CreateSettlement(gomock.Any(), &finpb.CreateSettlementRequest{UserId: 1, AccountId: 5, PayeeId: 2, Amount: 10}).
+		Return(&finpb.Settlement{
+			Id: 3, AccountId: 5, PayerId: 1, PayerLogin: "ivan", PayeeId: 2, PayeeLogin: "anna",
+			Amount: 10, CreatedBy: 1, CreatedAt: timestamppb.Now(),
+		}, nil)
+
+	req := mux.SetURLVars(memberRequest(t, http.MethodPost, "/accounts/5/balances/settlements",
+		models.CreateSettlementRequest{PayeeID: 2, Amount: 10}), map[string]string{"id": "5"})
+	rr := httptest.NewRecorder()
+	handler.CreateSettlement(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var resp SettlementAPI
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, "anna", resp.PayeeLogin)
+}
+
+func TestCreateSettlement_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	req := mux.SetURLVars(memberRequest(t, http.MethodPost, "/accounts/5/balances/settlements",
+		models.CreateSettlementRequest{PayeeID: 2}), map[string]string{"id": "5"})
+	rr := httptest.NewRecorder()
+	handler.CreateSettlement(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	mockClient.EXPECT().
+		CreateSettlement(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeMemberNotFound)))
+
+	req = mux.SetURLVars(memberRequest(t, http.MethodPost, "/accounts/5/balances/settlements",
+		models.CreateSettlementRequest{PayeeID: 9, Amount: 10}), map[string]string{"id": "5"})
+	rr = httptest.NewRecorder()
+	handler.CreateSettlement(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
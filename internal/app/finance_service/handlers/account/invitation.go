@@ -0,0 +1,297 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) handleInvitationError(w http.ResponseWriter, r *http.Request, err error, method string) {
+	log := logger.FromContext(r.Context())
+	st, ok := status.FromError(err)
+	if !ok {
+		if log != nil {
+			log.Error("grpc "+method+" unknown error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to process account invitation")
+		return
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		httputils.Error(w, r, "Некорректные данные приглашения", http.StatusBadRequest)
+	case codes.PermissionDenied:
+		httputils.Error(w, r, "Действие доступно только владельцу счета", http.StatusForbidden)
+	case codes.FailedPrecondition:
+		// Приватный счет или истекшее приглашение
+		httputils.Error(w, r, models.ErrorCode(st.Message()).GetErrorMessage(), http.StatusBadRequest)
+	case codes.NotFound:
+		httputils.NotFoundError(w, r, models.ErrorCode(st.Message()).GetErrorMessage())
+	case codes.AlreadyExists:
+		code := models.ErrorCode(st.Message())
+		httputils.ConflictError(w, r, code.GetErrorMessage(), code)
+	default:
+		if log != nil {
+			log.Error("grpc "+method+" error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to process account invitation")
+	}
+}
+
+// CreateAccountInvitation godoc
+// @Summary Приглашение в совместный счет
+// @Description Приглашает пользователя по логину. Без логина создает одноразовую ссылку-приглашение: токен возвращается только в этом ответе. Приглашение действует 7 дней. Роль — editor (по умолчанию) или viewer. Доступно только владельцу счета
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.CreateAccountInvitationRequest true "Счет, логин пользователя и роль"
+// @Success 201 {object} AccountInvitationAPI "Созданное приглашение"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные или приватный счет (PRIVATE_ACCOUNT)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Действие доступно только владельцу счета"
+// @Failure 404 {object} models.ErrorResponse "Счет или пользователь не найден (ACCOUNT_NOT_FOUND, USER_NOT_FOUND)"
+// @Failure 409 {object} models.ErrorResponse "Пользователь уже участвует в счете или приглашен (SHARING_EXISTS, INVITATION_EXISTS)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /accounts/invitations [post]
+func (h *Handler) CreateAccountInvitation(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	var req models.CreateAccountInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if req.AccountID <= 0 {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "account_id")
+		return
+	}
+
+	invitation, err := h.finClient.CreateAccountInvitation(r.Context(), CreateInvitationRequestToProto(userID, req))
+	if err != nil {
+		h.handleInvitationError(w, r, err, "CreateAccountInvitation")
+		return
+	}
+
+	httputils.Created(w, r, InvitationProtoToApi(invitation))
+}
+
+// GetPendingInvitations godoc
+// @Summary Входящие приглашения
+// @Description Возвращает действующие приглашения в совместные счета, адресованные текущему пользователю
+// @Tags accounts
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} AccountInvitationsAPI "Входящие приглашения"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /invitations [get]
+func (h *Handler) GetPendingInvitations(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	invitations, err := h.finClient.GetPendingInvitations(r.Context(), UserIDToProtoID(userID))
+	if err != nil {
+		h.handleInvitationError(w, r, err, "GetPendingInvitations")
+		return
+	}
+
+	httputils.Success(w, r, InvitationsProtoToApi(invitations))
+}
+
+// GetAccountInvitations godoc
+// @Summary Приглашения счета
+// @Description Возвращает действующие приглашения счета, включая ссылки. Доступно только владельцу счета
+// @Tags accounts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID счета"
+// @Success 200 {object} AccountInvitationsAPI "Приглашения счета"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID счета (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Действие доступно только владельцу счета"
+// @Failure 404 {object} models.ErrorResponse "Счет не найден (ACCOUNT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /account/{id}/invitations [get]
+func (h *Handler) GetAccountInvitations(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	accID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "id")
+		return
+	}
+
+	invitations, err := h.finClient.GetAccountInvitations(r.Context(), UserIDAndAccountIDToProtoID(userID, accID))
+	if err != nil {
+		h.handleInvitationError(w, r, err, "GetAccountInvitations")
+		return
+	}
+
+	httputils.Success(w, r, InvitationsProtoToApi(invitations))
+}
+
+// AcceptAccountInvitation godoc
+// @Summary Принятие приглашения
+// @Description Принимает приглашение, адресованное текущему пользователю, и добавляет его в участники счета
+// @Tags accounts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID приглашения"
+// @Success 200 {object} SharingApi "Участие в счете"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID или срок действия приглашения истек (INVITATION_EXPIRED)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Приглашение не найдено (INVITATION_NOT_FOUND)"
+// @Failure 409 {object} models.ErrorResponse "Пользователь уже участвует в счете (SHARING_EXISTS)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /invitations/{id}/accept [post]
+func (h *Handler) AcceptAccountInvitation(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	invitationID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID приглашения", "id")
+		return
+	}
+
+	sharing, err := h.finClient.AcceptAccountInvitation(r.Context(), InvitationRequestToProto(userID, invitationID))
+	if err != nil {
+		h.handleInvitationError(w, r, err, "AcceptAccountInvitation")
+		return
+	}
+
+	httputils.Success(w, r, SharingProtoToApi(sharing))
+}
+
+// AcceptInvitationLink godoc
+// @Summary Принятие приглашения по ссылке
+// @Description Добавляет текущего пользователя в участники счета по токену ссылки-приглашения. Ссылка одноразовая
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.AcceptInvitationLinkRequest true "Токен ссылки"
+// @Success 200 {object} SharingApi "Участие в счете"
+// @Failure 400 {object} models.ErrorResponse "Некорректный токен или срок действия приглашения истек (INVITATION_EXPIRED)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Приглашение не найдено или уже использовано (INVITATION_NOT_FOUND)"
+// @Failure 409 {object} models.ErrorResponse "Пользователь уже участвует в счете (SHARING_EXISTS)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /invitations/accept [post]
+func (h *Handler) AcceptInvitationLink(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	var req models.AcceptInvitationLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	token := strings.TrimSpace(req.Token)
+	if token == "" {
+		httputils.ValidationError(w, r, "Токен приглашения обязателен", "token")
+		return
+	}
+
+	sharing, err := h.finClient.AcceptInvitationLink(r.Context(), AcceptInvitationLinkToProto(userID, token))
+	if err != nil {
+		h.handleInvitationError(w, r, err, "AcceptInvitationLink")
+		return
+	}
+
+	httputils.Success(w, r, SharingProtoToApi(sharing))
+}
+
+// DeclineAccountInvitation godoc
+// @Summary Отклонение приглашения
+// @Description Отклоняет приглашение, адресованное текущему пользователю
+// @Tags accounts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID приглашения"
+// @Success 200 {object} AccountInvitationAPI "Отклоненное приглашение"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID приглашения (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Приглашение не найдено (INVITATION_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /invitations/{id}/decline [post]
+func (h *Handler) DeclineAccountInvitation(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	invitationID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID приглашения", "id")
+		return
+	}
+
+	invitation, err := h.finClient.DeclineAccountInvitation(r.Context(), InvitationRequestToProto(userID, invitationID))
+	if err != nil {
+		h.handleInvitationError(w, r, err, "DeclineAccountInvitation")
+		return
+	}
+
+	httputils.Success(w, r, InvitationProtoToApi(invitation))
+}
+
+// RevokeAccountInvitation godoc
+// @Summary Отзыв приглашения
+// @Description Отзывает действующее приглашение или ссылку. Доступно только владельцу счета
+// @Tags accounts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID приглашения"
+// @Success 200 {object} AccountInvitationAPI "Отозванное приглашение"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID приглашения (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Действие доступно только владельцу счета"
+// @Failure 404 {object} models.ErrorResponse "Приглашение не найдено (INVITATION_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /invitations/{id} [delete]
+func (h *Handler) RevokeAccountInvitation(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	invitationID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID приглашения", "id")
+		return
+	}
+
+	invitation, err := h.finClient.RevokeAccountInvitation(r.Context(), InvitationRequestToProto(userID, invitationID))
+	if err != nil {
+		h.handleInvitationError(w, r, err, "RevokeAccountInvitation")
+		return
+	}
+
+	httputils.Success(w, r, InvitationProtoToApi(invitation))
+}
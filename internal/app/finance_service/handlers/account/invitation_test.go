@@ -0,0 +1,169 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func TestCreateAccountInvitation_Link(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		CreateAccountInvitation(gomock.Any(), &finpb.CreateAccountInvitationRequest{UserId: 1, AccountId: 5, Role: "viewer"}).
+		Return(&finpb.AccountInvitation{
+			Id: 7, AccountId: 5, InviterId: 1, Role: "viewer", Status: "pending", Token: "raw",
+			ExpiresAt: timestamppb.Now(), CreatedAt: timestamppb.Now(),
+		}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.CreateAccountInvitation(rr, memberRequest(t, http.MethodPost, "/accounts/invitations",
+		models.CreateAccountInvitationRequest{AccountID: 5, Role: "viewer"}))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var resp AccountInvitationAPI
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, "raw", resp.Token)
+	require.Zero(t, resp.InviteeID)
+}
+
+func TestCreateAccountInvitation_AlreadyInvited(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		CreateAccountInvitation(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.AlreadyExists, string(models.ErrCodeInvitationExists)))
+
+	rr := httptest.NewRecorder()
+	handler.CreateAccountInvitation(rr, memberRequest(t, http.MethodPost, "/accounts/invitations",
+		models.CreateAccountInvitationRequest{AccountID: 5, UserLogin: "anna"}))
+
+	require.Equal(t, http.StatusConflict, rr.Code)
+	require.Contains(t, rr.Body.String(), string(models.ErrCodeInvitationExists))
+}
+
+func TestCreateAccountInvitation_InvalidAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), clock.RealClock{})
+
+	rr := httptest.NewRecorder()
+	handler.CreateAccountInvitation(rr, memberRequest(t, http.MethodPost, "/accounts/invitations",
+		models.CreateAccountInvitationRequest{UserLogin: "anna"}))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetPendingInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		GetPendingInvitations(gomock.Any(), &finpb.UserID{UserId: 1}).
+		Return(&finpb.ListAccountInvitationsResponse{Invitations: []*finpb.AccountInvitation{
+			{Id: 7, AccountId: 5, AccountName: "Семья", InviterLogin: "ivan", Role: "editor", Status: "pending",
+				ExpiresAt: timestamppb.Now(), CreatedAt: timestamppb.Now()},
+		}}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.GetPendingInvitations(rr, memberRequest(t, http.MethodGet, "/invitations", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp AccountInvitationsAPI
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Invitations, 1)
+	require.Equal(t, "Семья", resp.Invitations[0].AccountName)
+}
+
+func TestAcceptAccountInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		AcceptAccountInvitation(gomock.Any(), &finpb.AccountInvitationRequest{UserId: 1, InvitationId: 7}).
+		Return(&finpb.SharingsResponse{SharingId: 3, AccountId: 5, UserId: 1, Role: "editor", CreatedAt: timestamppb.Now()}, nil)
+
+	req := mux.SetURLVars(memberRequest(t, http.MethodPost, "/invitations/7/accept", nil), map[string]string{"id": "7"})
+	rr := httptest.NewRecorder()
+	handler.AcceptAccountInvitation(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestAcceptInvitationLink_Expired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		AcceptInvitationLink(gomock.Any(), &finpb.AcceptInvitationLinkRequest{UserId: 1, Token: "raw"}).
+		Return(nil, status.Error(codes.FailedPrecondition, string(models.ErrCodeInvitationExpired)))
+
+	rr := httptest.NewRecorder()
+	handler.AcceptInvitationLink(rr, memberRequest(t, http.MethodPost, "/invitations/accept",
+		models.AcceptInvitationLinkRequest{Token: "raw"}))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "Срок действия приглашения истек")
+}
+
+func TestAcceptInvitationLink_EmptyToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), clock.RealClock{})
+
+	rr := httptest.NewRecorder()
+	handler.AcceptInvitationLink(rr, memberRequest(t, http.MethodPost, "/invitations/accept",
+		models.AcceptInvitationLinkRequest{Token: " "}))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestRevokeAccountInvitation_NotOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		RevokeAccountInvitation(gomock.Any(), &finpb.AccountInvitationRequest{UserId: 1, InvitationId: 7}).
+		Return(nil, status.Error(codes.PermissionDenied, string(models.ErrCodeForbidden)))
+
+	req := mux.SetURLVars(memberRequest(t, http.MethodDelete, "/invitations/7", nil), map[string]string{"id": "7"})
+	rr := httptest.NewRecorder()
+	handler.RevokeAccountInvitation(rr, req)
+
+	require.Equal(t, http.StatusForbidden, rr.Code)
+}
@@ -14,6 +14,7 @@ type AccountAPI struct {
 	Name        string  `json:"name"`
 	Description string  `json:"description"`
 	CurrencyID  int     `json:"currency_id"`
+	Role        string  `json:"role,omitempty"`
 	CreatedAt   string  `json:"created_at,omitempty"`
 	UpdatedAt   string  `json:"updated_at,omitempty"`
 }
@@ -27,10 +28,37 @@ type AccountsAPI struct {
 type SharingApi struct {
 	ID        int    `json:"id"`
 	UserID    int    `json:"user_id"`
+	UserLogin string `json:"user_login,omitempty"`
 	AccountID int    `json:"account_id"`
+	Role      string `json:"role"`
 	CreatedAt string `json:"created_at"`
 }
 
+type AccountMembersAPI struct {
+	AccountID int          `json:"account_id"`
+	Members   []SharingApi `json:"members"`
+}
+
+// AccountInvitationAPI token заполнен только в ответе на создание приглашения по ссылке
+type AccountInvitationAPI struct {
+	ID           int    `json:"id"`
+	AccountID    int    `json:"account_id"`
+	AccountName  string `json:"account_name"`
+	InviterID    int    `json:"inviter_id"`
+	InviterLogin string `json:"inviter_login"`
+	InviteeID    int    `json:"invitee_id,omitempty"`
+	InviteeLogin string `json:"invitee_login,omitempty"`
+	Role         string `json:"role"`
+	Status       string `json:"status"`
+	Token        string `json:"token,omitempty"`
+	ExpiresAt    string `json:"expires_at"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type AccountInvitationsAPI struct {
+	Invitations []AccountInvitationAPI `json:"invitations"`
+}
+
 func UserIDToProtoID(userID int) *finpb.UserID {
 	return &finpb.UserID{
 		UserId: int32(userID),
@@ -44,10 +72,35 @@ func UserIDAndAccountIDToProtoID(userID, accID int) *finpb.AccountRequest {
 	}
 }
 
-func UserLoginIDtoProtoID(login string, accID int) *finpb.AddToAccountReqeust {
-	return &finpb.AddToAccountReqeust{
-		AccountId: int32(accID),
-		UserLogin: login,
+func CreateInvitationRequestToProto(userID int, req models.CreateAccountInvitationRequest) *finpb.CreateAccountInvitationRequest {
+	return &finpb.CreateAccountInvitationRequest{
+		UserId:    int32(userID),
+		AccountId: int32(req.AccountID),
+		UserLogin: req.UserLogin,
+		Role:      req.Role,
+	}
+}
+
+func InvitationRequestToProto(userID, invitationID int) *finpb.AccountInvitationRequest {
+	return &finpb.AccountInvitationRequest{
+		UserId:       int32(userID),
+		InvitationId: int32(invitationID),
+	}
+}
+
+func AcceptInvitationLinkToProto(userID int, token string) *finpb.AcceptInvitationLinkRequest {
+	return &finpb.AcceptInvitationLinkRequest{
+		UserId: int32(userID),
+		Token:  token,
+	}
+}
+
+func AccountMemberRequestToProto(userID int, req models.AccountMemberRequest) *finpb.AccountMemberRequest {
+	return &finpb.AccountMemberRequest{
+		UserId:    int32(userID),
+		AccountId: int32(req.AccountID),
+		MemberId:  int32(req.UserID),
+		Role:      req.Role,
 	}
 }
 
@@ -84,6 +137,7 @@ func AccountResponseListProtoToApi(resp *finpb.ListAccountsResponse, userID int)
 			Description: acc.Description,
 			Type:        acc.Type,
 			CurrencyID:  int(acc.CurrencyId),
+			Role:        acc.Role,
 			CreatedAt:   createdAt,
 			UpdatedAt:   updatedAt,
 		})
@@ -105,6 +159,7 @@ func ProtoAccountToApi(acc *finpb.Account) AccountAPI {
 		Description: acc.Description,
 		Type:        acc.Type,
 		CurrencyID:  int(acc.CurrencyId),
+		Role:        acc.Role,
 		CreatedAt:   acc.CreatedAt.AsTime().Format(time.RFC3339),
 		UpdatedAt:   acc.UpdatedAt.AsTime().Format(time.RFC3339),
 	}
@@ -136,6 +191,141 @@ func SharingProtoToApi(resp *finpb.SharingsResponse) SharingApi {
 		ID:        int(resp.SharingId),
 		AccountID: int(resp.AccountId),
 		UserID:    int(resp.UserId),
+		UserLogin: resp.UserLogin,
+		Role:      resp.Role,
 		CreatedAt: resp.CreatedAt.AsTime().Format(time.RFC3339),
 	}
 }
+
+func AccountMembersProtoToApi(accID int, resp *finpb.ListAccountMembersResponse) AccountMembersAPI {
+	members := make([]SharingApi, 0, len(resp.GetMembers()))
+	for _, sh := range resp.GetMembers() {
+		members = append(members, SharingProtoToApi(sh))
+	}
+	return AccountMembersAPI{AccountID: accID, Members: members}
+}
+
+func InvitationProtoToApi(inv *finpb.AccountInvitation) AccountInvitationAPI {
+	return AccountInvitationAPI{
+		ID:           int(inv.Id),
+		AccountID:    int(inv.AccountId),
+		AccountName:  inv.AccountName,
+		InviterID:    int(inv.InviterId),
+		InviterLogin: inv.InviterLogin,
+		InviteeID:    int(inv.InviteeId),
+		InviteeLogin: inv.InviteeLogin,
+		Role:         inv.Role,
+		Status:       inv.Status,
+		Token:        inv.Token,
+		ExpiresAt:    inv.ExpiresAt.AsTime().Format(time.RFC3339),
+		CreatedAt:    inv.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+}
+
+func InvitationsProtoToApi(resp *finpb.ListAccountInvitationsResponse) AccountInvitationsAPI {
+	invitations := make([]AccountInvitationAPI, 0, len(resp.GetInvitations()))
+	for _, inv := range resp.GetInvitations() {
+		invitations = append(invitations, InvitationProtoToApi(inv))
+	}
+	return AccountInvitationsAPI{Invitations: invitations}
+}
+
+// MemberBalanceAPI net > 0 — участнику должны, net < 0 — участник должен
+type MemberBalanceAPI struct {
+	UserID    int     `json:"user_id"`
+	UserLogin string  `json:"user_login"`
+	Net       float64 `json:"net"`
+}
+
+type PairBalanceAPI struct {
+	DebtorID      int     `json:"debtor_id"`
+	DebtorLogin   string  `json:"debtor_login"`
+	CreditorID    int     `json:"creditor_id"`
+	CreditorLogin string  `json:"creditor_login"`
+	Amount        float64 `json:"amount"`
+}
+
+type AccountBalancesAPI struct {
+	AccountID  int                `json:"account_id"`
+	CurrencyID int                `json:"currency_id"`
+	Members    []MemberBalanceAPI `json:"members"`
+	Debts      []PairBalanceAPI   `json:"debts"`
+}
+
+type SettlementAPI struct {
+	ID          int     `json:"id"`
+	AccountID   int     `json:"account_id"`
+	PayerID     int     `json:"payer_id"`
+	PayerLogin  string  `json:"payer_login"`
+	PayeeID     int     `json:"payee_id"`
+	PayeeLogin  string  `json:"payee_login"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description,omitempty"`
+	CreatedBy   int     `json:"created_by"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+type SettlementsAPI struct {
+	Settlements []SettlementAPI `json:"settlements"`
+}
+
+func CreateSettlementRequestToProto(userID, accID int, req models.CreateSettlementRequest) *finpb.CreateSettlementRequest {
+	return &finpb.CreateSettlementRequest{
+		UserId:      int32(userID),
+		AccountId:   int32(accID),
+		PayerId:     int32(req.PayerID),
+		PayeeId:     int32(req.PayeeID),
+		Amount:      req.Amount,
+		Description: req.Description,
+	}
+}
+
+func BalancesProtoToApi(resp *finpb.AccountBalances) AccountBalancesAPI {
+	members := make([]MemberBalanceAPI, 0, len(resp.GetMembers()))
+	for _, m := range resp.GetMembers() {
+		members = append(members, MemberBalanceAPI{
+			UserID:    int(m.UserId),
+			UserLogin: m.UserLogin,
+			Net:       m.Net,
+		})
+	}
+	debts := make([]PairBalanceAPI, 0, len(resp.GetDebts()))
+	for _, d := range resp.GetDebts() {
+		debts = append(debts, PairBalanceAPI{
+			DebtorID:      int(d.DebtorId),
+			DebtorLogin:   d.DebtorLogin,
+			CreditorID:    int(d.CreditorId),
+			CreditorLogin: d.CreditorLogin,
+			Amount:        d.Amount,
+		})
+	}
+	return AccountBalancesAPI{
+		AccountID:  int(resp.AccountId),
+		CurrencyID: int(resp.CurrencyId),
+		Members:    members,
+		Debts:      debts,
+	}
+}
+
+func SettlementProtoToApi(st *finpb.Settlement) SettlementAPI {
+	return SettlementAPI{
+		ID:          int(st.Id),
+		AccountID:   int(st.AccountId),
+		PayerID:     int(st.PayerId),
+		PayerLogin:  st.PayerLogin,
+		PayeeID:     int(st.PayeeId),
+		PayeeLogin:  st.PayeeLogin,
+		Amount:      st.Amount,
+		Description: st.Description,
+		CreatedBy:   int(st.CreatedBy),
+		CreatedAt:   st.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+}
+
+func SettlementsProtoToApi(resp *finpb.ListSettlementsResponse) SettlementsAPI {
+	settlements := make([]SettlementAPI, 0, len(resp.GetSettlements()))
+	for _, st := range resp.GetSettlements() {
+		settlements = append(settlements, SettlementProtoToApi(st))
+	}
+	return SettlementsAPI{Settlements: settlements}
+}
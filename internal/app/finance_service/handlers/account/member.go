@@ -0,0 +1,240 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) handleMemberError(w http.ResponseWriter, r *http.Request, err error, method string) {
+	log := logger.FromContext(r.Context())
+	st, ok := status.FromError(err)
+	if !ok {
+		if log != nil {
+			log.Error("grpc "+method+" unknown error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to manage account members")
+		return
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		httputils.Error(w, r, "Некорректные данные участника счета", http.StatusBadRequest)
+	case codes.PermissionDenied:
+		httputils.Error(w, r, "Действие доступно только владельцу счета", http.StatusForbidden)
+	case codes.FailedPrecondition:
+		httputils.Error(w, r, models.ErrorCode(st.Message()).GetErrorMessage(), http.StatusBadRequest)
+	case codes.NotFound:
+		// Не найден может быть счет или участник — код ошибки в сообщении
+		httputils.NotFoundError(w, r, models.ErrorCode(st.Message()).GetErrorMessage())
+	default:
+		if log != nil {
+			log.Error("grpc "+method+" error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to manage account members")
+	}
+}
+
+func (h *Handler) decodeMemberRequest(w http.ResponseWriter, r *http.Request) (models.AccountMemberRequest, bool) {
+	var req models.AccountMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return req, false
+	}
+	if req.AccountID <= 0 {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "account_id")
+		return req, false
+	}
+	if req.UserID <= 0 {
+		httputils.ValidationError(w, r, "Некорректный ID пользователя", "user_id")
+		return req, false
+	}
+	return req, true
+}
+
+// GetAccountMembers godoc
+// @Summary Участники счета
+// @Description Возвращает участников счета с их ролями: owner, editor, viewer. Владелец идет первым
+// @Tags accounts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID счета"
+// @Success 200 {object} AccountMembersAPI "Участники счета"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID счета (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Счет не найден (ACCOUNT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /account/{id}/members [get]
+func (h *Handler) GetAccountMembers(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	accID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "id")
+		return
+	}
+
+	members, err := h.finClient.GetAccountMembers(r.Context(), UserIDAndAccountIDToProtoID(userID, accID))
+	if err != nil {
+		h.handleMemberError(w, r, err, "GetAccountMembers")
+		return
+	}
+
+	httputils.Success(w, r, AccountMembersProtoToApi(accID, members))
+}
+
+// UpdateAccountMemberRole godoc
+// @Summary Изменение роли участника счета
+// @Description Назначает участнику роль editor (ведет операции) или viewer (только просмотр). Доступно только владельцу; владелец меняется через передачу счета
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.AccountMemberRequest true "Счет, участник и новая роль"
+// @Success 200 {object} SharingApi "Участник с новой ролью"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные участника счета"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Действие доступно только владельцу счета"
+// @Failure 404 {object} models.ErrorResponse "Счет или участник не найден (ACCOUNT_NOT_FOUND, MEMBER_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /accounts/role [post]
+func (h *Handler) UpdateAccountMemberRole(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	req, ok := h.decodeMemberRequest(w, r)
+	if !ok {
+		return
+	}
+
+	sharing, err := h.finClient.UpdateAccountMemberRole(r.Context(), AccountMemberRequestToProto(userID, req))
+	if err != nil {
+		h.handleMemberError(w, r, err, "UpdateAccountMemberRole")
+		return
+	}
+
+	httputils.Success(w, r, SharingProtoToApi(sharing))
+}
+
+// RemoveAccountMember godoc
+// @Summary Удаление участника счета
+// @Description Лишает пользователя доступа к счету. Доступно только владельцу счета
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.AccountMemberRequest true "Счет и участник"
+// @Success 200 {object} SharingApi "Удаленный участник"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные участника счета"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Действие доступно только владельцу счета"
+// @Failure 404 {object} models.ErrorResponse "Счет или участник не найден (ACCOUNT_NOT_FOUND, MEMBER_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /accounts/remove [post]
+func (h *Handler) RemoveAccountMember(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	req, ok := h.decodeMemberRequest(w, r)
+	if !ok {
+		return
+	}
+
+	sharing, err := h.finClient.RemoveAccountMember(r.Context(), AccountMemberRequestToProto(userID, req))
+	if err != nil {
+		h.handleMemberError(w, r, err, "RemoveAccountMember")
+		return
+	}
+
+	httputils.Success(w, r, SharingProtoToApi(sharing))
+}
+
+// LeaveAccount godoc
+// @Summary Выход из совместного счета
+// @Description Удаляет текущего пользователя из участников счета. Владелец должен сначала передать счет другому участнику
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.LeaveAccountRequest true "Счет"
+// @Success 200 {object} SharingApi "Прежнее участие в счете"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID счета или пользователь — владелец счета (OWNER_CANNOT_LEAVE)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Счет не найден (ACCOUNT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /accounts/leave [post]
+func (h *Handler) LeaveAccount(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	var req models.LeaveAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	if req.AccountID <= 0 {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "account_id")
+		return
+	}
+
+	sharing, err := h.finClient.LeaveAccount(r.Context(), UserIDAndAccountIDToProtoID(userID, req.AccountID))
+	if err != nil {
+		h.handleMemberError(w, r, err, "LeaveAccount")
+		return
+	}
+
+	httputils.Success(w, r, SharingProtoToApi(sharing))
+}
+
+// TransferAccountOwnership godoc
+// @Summary Передача счета другому участнику
+// @Description Делает участника владельцем счета, прежний владелец становится редактором. Доступно только владельцу счета
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.AccountMemberRequest true "Счет и новый владелец"
+// @Success 200 {object} AccountMembersAPI "Участники счета после передачи"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные участника счета"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Действие доступно только владельцу счета"
+// @Failure 404 {object} models.ErrorResponse "Счет или участник не найден (ACCOUNT_NOT_FOUND, MEMBER_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /accounts/transfer [post]
+func (h *Handler) TransferAccountOwnership(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	req, ok := h.decodeMemberRequest(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.finClient.TransferAccountOwnership(r.Context(), AccountMemberRequestToProto(userID, req))
+	if err != nil {
+		h.handleMemberError(w, r, err, "TransferAccountOwnership")
+		return
+	}
+
+	httputils.Success(w, r, AccountMembersProtoToApi(req.AccountID, members))
+}
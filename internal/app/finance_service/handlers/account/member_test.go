@@ -0,0 +1,144 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+func memberRequest(t *testing.T, method, target string, body any) *http.Request {
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	req := httptest.NewRequest(method, target, &buf)
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestGetAccountMembers_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		GetAccountMembers(gomock.Any(), &finpb.AccountRequest{UserId: 1, AccountId: 5}).
+		Return(&finpb.ListAccountMembersResponse{Members: []*finpb.SharingsResponse{
+			{SharingId: 1, AccountId: 5, UserId: 1, UserLogin: "ivan", Role: "owner", CreatedAt: timestamppb.Now()},
+			{SharingId: 2, AccountId: 5, UserId: 2, UserLogin: "anna", Role: "viewer", CreatedAt: timestamppb.Now()},
+		}}, nil)
+
+	req := mux.SetURLVars(memberRequest(t, http.MethodGet, "/account/5/members", nil), map[string]string{"id": "5"})
+	rr := httptest.NewRecorder()
+	handler.GetAccountMembers(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp AccountMembersAPI
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 5, resp.AccountID)
+	require.Len(t, resp.Members, 2)
+	require.Equal(t, "owner", resp.Members[0].Role)
+}
+
+func TestUpdateAccountMemberRole_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		UpdateAccountMemberRole(gomock.Any(), &finpb.AccountMemberRequest{UserId: 1, AccountId: 5, MemberId: 2, Role: "viewer"}).
+		Return(&finpb.SharingsResponse{SharingId: 2, AccountId: 5, UserId: 2, Role: "viewer", CreatedAt: timestamppb.Now()}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.UpdateAccountMemberRole(rr, memberRequest(t, http.MethodPost, "/accounts/role",
+		models.AccountMemberRequest{AccountID: 5, UserID: 2, Role: "viewer"}))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestUpdateAccountMemberRole_InvalidBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), clock.RealClock{})
+
+	rr := httptest.NewRecorder()
+	handler.UpdateAccountMemberRole(rr, memberRequest(t, http.MethodPost, "/accounts/role",
+		models.AccountMemberRequest{AccountID: 5, Role: "viewer"}))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestRemoveAccountMember_MemberNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		RemoveAccountMember(gomock.Any(), &finpb.AccountMemberRequest{UserId: 1, AccountId: 5, MemberId: 9}).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeMemberNotFound)))
+
+	rr := httptest.NewRecorder()
+	handler.RemoveAccountMember(rr, memberRequest(t, http.MethodPost, "/accounts/remove",
+		models.AccountMemberRequest{AccountID: 5, UserID: 9}))
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	require.Contains(t, rr.Body.String(), "Участник счета не найден")
+}
+
+func TestLeaveAccount_Owner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		LeaveAccount(gomock.Any(), &finpb.AccountRequest{UserId: 1, AccountId: 5}).
+		Return(nil, status.Error(codes.FailedPrecondition, string(models.ErrCodeOwnerCannotLeave)))
+
+	rr := httptest.NewRecorder()
+	handler.LeaveAccount(rr, memberRequest(t, http.MethodPost, "/accounts/leave", models.LeaveAccountRequest{AccountID: 5}))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "передайте права")
+}
+
+func TestTransferAccountOwnership_NotOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockClient, clock.RealClock{})
+
+	mockClient.EXPECT().
+		TransferAccountOwnership(gomock.Any(), &finpb.AccountMemberRequest{UserId: 1, AccountId: 5, MemberId: 2}).
+		Return(nil, status.Error(codes.PermissionDenied, string(models.ErrCodeForbidden)))
+
+	rr := httptest.NewRecorder()
+	handler.TransferAccountOwnership(rr, memberRequest(t, http.MethodPost, "/accounts/transfer",
+		models.AccountMemberRequest{AccountID: 5, UserID: 2}))
+
+	require.Equal(t, http.StatusForbidden, rr.Code)
+}
@@ -16,8 +16,23 @@ func Register(r *mux.Router, finClient finpb.FinanceServiceClient) {
 	// Старый формат
 	r.HandleFunc("/accounts", h.GetAccounts).Methods(http.MethodGet)
 	r.HandleFunc("/accounts", h.CreateAccount).Methods(http.MethodPost)
-	r.HandleFunc("/accounts/add", h.AddUserToAccount).Methods(http.MethodPost)
+	r.HandleFunc("/accounts/invitations", h.CreateAccountInvitation).Methods(http.MethodPost)
+	r.HandleFunc("/accounts/role", h.UpdateAccountMemberRole).Methods(http.MethodPost)
+	r.HandleFunc("/accounts/remove", h.RemoveAccountMember).Methods(http.MethodPost)
+	r.HandleFunc("/accounts/leave", h.LeaveAccount).Methods(http.MethodPost)
+	r.HandleFunc("/accounts/transfer", h.TransferAccountOwnership).Methods(http.MethodPost)
 	r.HandleFunc("/account/{id}", h.GetAccountByID).Methods(http.MethodGet)
 	r.HandleFunc("/account/{id}", h.UpdateAccount).Methods(http.MethodPut)
 	r.HandleFunc("/account/{id}", h.DeleteAccount).Methods(http.MethodDelete)
+	r.HandleFunc("/account/{id}/members", h.GetAccountMembers).Methods(http.MethodGet)
+	r.HandleFunc("/account/{id}/invitations", h.GetAccountInvitations).Methods(http.MethodGet)
+	r.HandleFunc("/accounts/{id}/balances", h.GetAccountBalances).Methods(http.MethodGet)
+	r.HandleFunc("/accounts/{id}/balances/settlements", h.GetSettlements).Methods(http.MethodGet)
+	r.HandleFunc("/accounts/{id}/balances/settlements", h.CreateSettlement).Methods(http.MethodPost)
+
+	r.HandleFunc("/invitations", h.GetPendingInvitations).Methods(http.MethodGet)
+	r.HandleFunc("/invitations/accept", h.AcceptInvitationLink).Methods(http.MethodPost)
+	r.HandleFunc("/invitations/{id}/accept", h.AcceptAccountInvitation).Methods(http.MethodPost)
+	r.HandleFunc("/invitations/{id}/decline", h.DeclineAccountInvitation).Methods(http.MethodPost)
+	r.HandleFunc("/invitations/{id}", h.RevokeAccountInvitation).Methods(http.MethodDelete)
 }
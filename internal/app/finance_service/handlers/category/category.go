@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	image "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/usecase"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
@@ -23,13 +24,15 @@ import (
 
 type Handler struct {
 	finClient     finpb.FinanceServiceClient
+	bdgClient     bdgpb.BudgetServiceClient
 	imageUC       image.ImageUseCase
 	kafkaProducer kafkautils.KafkaProducer
 }
 
-func NewHandler(finClient finpb.FinanceServiceClient, imageUC image.ImageUseCase, kafkaProducer kafkautils.KafkaProducer) *Handler {
+func NewHandler(finClient finpb.FinanceServiceClient, bdgClient bdgpb.BudgetServiceClient, imageUC image.ImageUseCase, kafkaProducer kafkautils.KafkaProducer) *Handler {
 	return &Handler{
 		finClient:     finClient,
+		bdgClient:     bdgClient,
 		imageUC:       imageUC,
 		kafkaProducer: kafkaProducer,
 	}
@@ -46,6 +49,19 @@ func (h *Handler) parseIDFromURL(r *http.Request, paramName string) (int, error)
 	return strconv.Atoi(idStr)
 }
 
+// parseParentIDForm читает parent_id из формы; пустое значение означает, что поле не передано.
+func parseParentIDForm(r *http.Request) (*int, error) {
+	value := r.FormValue("parent_id")
+	if value == "" {
+		return nil, nil
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
 func (h *Handler) enrichCategoryWithLogoURL(ctx context.Context, category *models.Category) {
 	if category.LogoHashedID == "" {
 		return
@@ -132,10 +148,12 @@ func (h *Handler) GetCategories(w http.ResponseWriter, r *http.Request) {
 // @Security ApiKeyAuth
 // @Param name formData string true "Название категории"
 // @Param description formData string false "Описание категории"
+// @Param parent_id formData int false "ID родительской категории"
 // @Param image formData file false "Картинка категории (опционально)"
 // @Success 201 {object} models.Category "Созданная категория"
 // @Failure 400 {object} models.ErrorResponse "Некорректные данные (VALIDATION_ERROR, INVALID_INPUT)"
 // @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Родительская категория не найдена (PARENT_CATEGORY_NOT_FOUND)"
 // @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
 // @Router /categories [post]
 func (h *Handler) CreateCategory(w http.ResponseWriter, r *http.Request) {
@@ -150,10 +168,19 @@ func (h *Handler) CreateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	parentID, err := parseParentIDForm(r)
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректная родительская категория", "parent_id")
+		return
+	}
+
 	req := models.CreateCategoryRequest{
 		Name:        r.FormValue("name"),
 		Description: r.FormValue("description"),
 	}
+	if parentID != nil {
+		req.ParentID = *parentID
+	}
 
 	validationErrors := utils.ValidateStruct(req)
 	if len(validationErrors) > 0 {
@@ -213,6 +240,12 @@ func (h *Handler) CreateCategory(w http.ResponseWriter, r *http.Request) {
 			}
 			httputils.Error(w, r, "failed to create category", http.StatusBadRequest)
 			return
+		case codes.NotFound:
+			if log != nil {
+				log.Error("grpc CreateCategory parent not found", "error", err)
+			}
+			httputils.NotFoundError(w, r, "Родительская категория не найдена")
+			return
 		case codes.AlreadyExists:
 			if log != nil {
 				log.Error("grpc CreateCategory exists error", "error", err)
@@ -305,11 +338,12 @@ func (h *Handler) GetCategoryByID(w http.ResponseWriter, r *http.Request) {
 // @Param id path int true "ID категории"
 // @Param name formData string false "Название категории"
 // @Param description formData string false "Описание категории"
+// @Param parent_id formData int false "ID родительской категории, 0 — сделать категорию корневой"
 // @Param image formData file false "Картинка категории (опционально)"
 // @Success 200 {object} models.Category "Обновленная категория"
-// @Failure 400 {object} models.ErrorResponse "Некорректные данные (VALIDATION_ERROR, INVALID_INPUT)"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (VALIDATION_ERROR, INVALID_INPUT, CATEGORY_CYCLE)"
 // @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
-// @Failure 404 {object} models.ErrorResponse "Категория не найдена (RESOURCE_NOT_FOUND)"
+// @Failure 404 {object} models.ErrorResponse "Категория не найдена (RESOURCE_NOT_FOUND, PARENT_CATEGORY_NOT_FOUND)"
 // @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
 // @Router /categories/{id} [put]
 func (h *Handler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
@@ -338,6 +372,12 @@ func (h *Handler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 	if description := r.FormValue("description"); description != "" {
 		req.Description = &description
 	}
+	parentID, err := parseParentIDForm(r)
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректная родительская категория", "parent_id")
+		return
+	}
+	req.ParentID = parentID
 
 	validationErrors := utils.ValidateStruct(req)
 	if len(validationErrors) > 0 {
@@ -394,12 +434,20 @@ func (h *Handler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 			if log != nil {
 				log.Error("grpc UpdateCategory invalid arg", "error", err)
 			}
+			if st.Message() == string(models.ErrCodeCategoryCycle) {
+				httputils.ValidationError(w, r, models.ErrCodeCategoryCycle.GetErrorMessage(), "parent_id")
+				return
+			}
 			httputils.Error(w, r, "failed to update category", http.StatusBadRequest)
 			return
 		case codes.NotFound:
 			if log != nil {
 				log.Error("grpc UpdateCategory not found", "error", err)
 			}
+			if st.Message() == string(models.ErrCodeParentNotFound) {
+				httputils.NotFoundError(w, r, "Родительская категория не найдена")
+				return
+			}
 			httputils.Error(w, r, "failed to update category", http.StatusBadRequest)
 			return
 		case codes.AlreadyExists:
@@ -443,7 +491,9 @@ func (h *Handler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Security ApiKeyAuth
 // @Param id path int true "ID категории"
+// @Param reassign_to query int false "ID категории, в которую перенести операции удаляемой категории"
 // @Success 204 "Категория успешно удалена"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
 // @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
 // @Failure 404 {object} models.ErrorResponse "Категория не найдена (RESOURCE_NOT_FOUND)"
 // @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
@@ -461,11 +511,20 @@ func (h *Handler) DeleteCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var reassignTo int
+	if value := r.URL.Query().Get("reassign_to"); value != "" {
+		reassignTo, err = strconv.Atoi(value)
+		if err != nil || reassignTo <= 0 {
+			httputils.ValidationError(w, r, "Invalid target category ID", "reassign_to")
+			return
+		}
+	}
+
+	log := logger.FromContext(r.Context())
 	// err = h.categoryUC.DeleteCategory(r.Context(), userID, categoryID)
-	_, err = h.finClient.DeleteCategory(r.Context(), UserAndCtegoryIDToProto(userID, categoryID))
+	target, err := h.finClient.DeleteCategory(r.Context(), DeleteCategoryRequestToProto(userID, categoryID, reassignTo))
 	if err != nil {
 		st, ok := status.FromError(err)
-		log := logger.FromContext(r.Context())
 		if !ok {
 			if log != nil {
 				log.Error("grpc DeleteCategory unknown error", "error", err)
@@ -480,6 +539,12 @@ func (h *Handler) DeleteCategory(w http.ResponseWriter, r *http.Request) {
 			}
 			httputils.Error(w, r, "failed to delete category", http.StatusBadRequest)
 			return
+		case codes.InvalidArgument:
+			if log != nil {
+				log.Error("grpc DeleteCategory invalid arg", "error", err)
+			}
+			httputils.ValidationError(w, r, "Invalid target category ID", "reassign_to")
+			return
 		default:
 			if log != nil {
 				log.Error("grpc DeleteCategory error", "error", err)
@@ -489,6 +554,16 @@ func (h *Handler) DeleteCategory(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	var categorySearch models.UpdateCategoryInOperationSearch
+	if reassignTo != 0 {
+		targetDTO := ProtoCategoryToApi(target)
+		h.enrichCategoryWithLogoURL(r.Context(), targetDTO)
+		categorySearch = CategoryMergeToUpdateSearch(categoryID, targetDTO)
+	} else {
+		categorySearch = models.UpdateCategoryInOperationSearch{CategoryID: categoryID, Action: models.DELETE}
+	}
+	h.publishCategorySearch(r, categorySearch)
+
 	w.WriteHeader(http.StatusNoContent)
 }
 
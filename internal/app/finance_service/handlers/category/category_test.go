@@ -23,7 +23,7 @@ func TestGetCategories_Unauthorized(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
-	handler := NewHandler(mockFin, nil, nil)
+	handler := NewHandler(mockFin, nil, nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/categories", nil)
 	rr := httptest.NewRecorder()
@@ -37,7 +37,7 @@ func TestGetCategories_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
-	handler := NewHandler(mockFin, nil, nil)
+	handler := NewHandler(mockFin, nil, nil, nil)
 
 	mockFin.EXPECT().
 		GetCategoriesWithStatsByUser(gomock.Any(), gomock.Any()).
@@ -66,7 +66,7 @@ func TestCreateCategory_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
-	handler := NewHandler(mockFin, nil, nil)
+	handler := NewHandler(mockFin, nil, nil, nil)
 
 	mockFin.EXPECT().
 		CreateCategory(gomock.Any(), gomock.Any()).
@@ -96,7 +96,7 @@ func TestGetCategoryByID_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
-	handler := NewHandler(mockFin, nil, nil)
+	handler := NewHandler(mockFin, nil, nil, nil)
 
 	mockFin.EXPECT().
 		GetCategory(gomock.Any(), gomock.Any()).
@@ -122,7 +122,7 @@ func TestGetCategoryByID_NotFound(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
-	handler := NewHandler(mockFin, nil, nil)
+	handler := NewHandler(mockFin, nil, nil, nil)
 
 	mockFin.EXPECT().
 		GetCategory(gomock.Any(), gomock.Any()).
@@ -144,7 +144,7 @@ func TestUpdateCategory_Success(t *testing.T) {
 	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
 	mockKafka := mocks.NewMockKafkaProducer(ctrl)
 
-	handler := NewHandler(mockFin, nil, mockKafka)
+	handler := NewHandler(mockFin, nil, nil, mockKafka)
 
 	mockFin.EXPECT().
 		UpdateCategory(gomock.Any(), gomock.Any()).
@@ -178,13 +178,18 @@ func TestDeleteCategory_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
-	handler := NewHandler(mockFin, nil, nil)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	handler := NewHandler(mockFin, nil, nil, mockKafka)
 
 	// DeleteCategory возвращает *finpb.Category (пустой объект)
 	mockFin.EXPECT().
 		DeleteCategory(gomock.Any(), gomock.Any()).
 		Return(&finpb.Category{}, nil)
 
+	mockKafka.EXPECT().
+		WriteMessages(gomock.Any(), gomock.Any()).
+		Return(nil)
+
 	req := httptest.NewRequest(http.MethodDelete, "/categories/1", nil)
 	req = mux.SetURLVars(req, map[string]string{"id": "1"})
 	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
@@ -200,7 +205,7 @@ func TestCreateCategory_WithImage(t *testing.T) {
 
 	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
 	mockImgUC := mocks.NewMockImageUseCase(ctrl)
-	handler := NewHandler(mockFin, mockImgUC, nil)
+	handler := NewHandler(mockFin, nil, mockImgUC, nil)
 
 	// Мок на UploadImage
 	mockImgUC.EXPECT().
@@ -0,0 +1,57 @@
+package category
+
+import (
+	"net/http"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// ResetDefaultCategories godoc
+// @Summary Восстановление категорий по умолчанию
+// @Description Создает недостающие категории из набора по умолчанию и возвращает им исходные описание и иконку. Пользовательские категории и операции не затрагиваются
+// @Tags categories
+// @Produce json
+// @Security ApiKeyAuth
+// @Param Accept-Language header string false "Язык категорий (ru, en)"
+// @Success 200 {object} models.ResetDefaultCategoriesResponse "Созданные и восстановленные категории"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/defaults [post]
+func (h *Handler) ResetDefaultCategories(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	resp, err := h.finClient.ProvisionDefaultCategories(r.Context(), &finpb.ProvisionDefaultCategoriesRequest{
+		UserId:    int32(userID),
+		Locale:    r.Header.Get("Accept-Language"),
+		Overwrite: true,
+	})
+	if err != nil {
+		if log := logger.FromContext(r.Context()); log != nil {
+			log.Error("grpc ProvisionDefaultCategories error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to reset default categories")
+		return
+	}
+
+	categories := make([]models.Category, 0, len(resp.Categories))
+	for _, ctg := range resp.Categories {
+		categoryDTO := ProtoCategoryToApi(ctg)
+		h.enrichCategoryWithLogoURL(r.Context(), categoryDTO)
+
+		// у восстановленных категорий могла смениться иконка в операциях поискового индекса
+		categorySearch := CategoryToUpdateSearch(categoryDTO)
+		categorySearch.Action = models.UPDATE
+		h.publishCategorySearch(r, categorySearch)
+
+		categories = append(categories, *categoryDTO)
+	}
+
+	httputils.Success(w, r, models.ResetDefaultCategoriesResponse{Categories: categories})
+}
@@ -0,0 +1,76 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func TestResetDefaultCategories_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	mockImage := mocks.NewMockImageUseCase(ctrl)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	handler := NewHandler(mockFin, nil, mockImage, mockKafka)
+
+	mockFin.EXPECT().
+		ProvisionDefaultCategories(gomock.Any(), &finpb.ProvisionDefaultCategoriesRequest{UserId: 1, Locale: "en", Overwrite: true}).
+		Return(&finpb.ListCategoriesResponse{Categories: []*finpb.Category{
+			{Id: 3, UserId: 1, Name: "Groceries", LogoHashedId: "icon-1"},
+			{Id: 4, UserId: 1, Name: "Transport"},
+		}}, nil)
+	mockImage.EXPECT().GetImageURL(gomock.Any(), "icon-1").Return("https://cdn/icon-1", nil)
+	mockKafka.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	req := httptest.NewRequest(http.MethodPost, "/categories/defaults", nil)
+	req.Header.Set("Accept-Language", "en")
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+	handler.ResetDefaultCategories(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.ResetDefaultCategoriesResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Categories, 2)
+	require.Equal(t, "https://cdn/icon-1", resp.Categories[0].LogoURL)
+}
+
+func TestResetDefaultCategories_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().ProvisionDefaultCategories(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+
+	req := httptest.NewRequest(http.MethodPost, "/categories/defaults", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+	handler.ResetDefaultCategories(rr, req)
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestResetDefaultCategories_Unauthorized(t *testing.T) {
+	handler := NewHandler(nil, nil, nil, nil)
+
+	rr := httptest.NewRecorder()
+	handler.ResetDefaultCategories(rr, httptest.NewRequest(http.MethodPost, "/categories/defaults", nil))
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
@@ -0,0 +1,102 @@
+package category
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func categoryFormRequest(method, url string, fields map[string]string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for k, v := range fields {
+		_ = writer.WriteField(k, v)
+	}
+	_ = writer.Close()
+
+	req := httptest.NewRequest(method, url, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req = mux.SetURLVars(req, map[string]string{"id": "1"})
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestCreateCategory_WithParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		CreateCategory(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *finpb.CreateCategoryRequest, _ ...interface{}) (*finpb.Category, error) {
+			require.Equal(t, int32(3), req.ParentId)
+			return &finpb.Category{Id: 4, UserId: 1, Name: "Такси", ParentId: 3}, nil
+		})
+
+	rr := httptest.NewRecorder()
+	handler.CreateCategory(rr, categoryFormRequest(http.MethodPost, "/categories", map[string]string{"name": "Такси", "parent_id": "3"}))
+	require.Equal(t, http.StatusCreated, rr.Code)
+	require.Contains(t, rr.Body.String(), `"parent_id":3`)
+}
+
+func TestCreateCategory_InvalidParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), nil, nil, nil)
+
+	rr := httptest.NewRecorder()
+	handler.CreateCategory(rr, categoryFormRequest(http.MethodPost, "/categories", map[string]string{"name": "Такси", "parent_id": "abc"}))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestCreateCategory_ParentNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		CreateCategory(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeParentNotFound)))
+
+	rr := httptest.NewRecorder()
+	handler.CreateCategory(rr, categoryFormRequest(http.MethodPost, "/categories", map[string]string{"name": "Такси", "parent_id": "42"}))
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestUpdateCategory_Cycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		UpdateCategory(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *finpb.UpdateCategoryRequest, _ ...interface{}) (*finpb.Category, error) {
+			require.NotNil(t, req.ParentId)
+			require.Equal(t, int32(4), *req.ParentId)
+			return nil, status.Error(codes.InvalidArgument, string(models.ErrCodeCategoryCycle))
+		})
+
+	rr := httptest.NewRecorder()
+	handler.UpdateCategory(rr, categoryFormRequest(http.MethodPut, "/categories/1", map[string]string{"parent_id": "4"}))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "parent_id")
+}
@@ -20,6 +20,7 @@ func CategoryWithStatsToAPI(ctg *finpb.CategoryWithStats) models.CategoryWithSta
 		Category: models.Category{
 			ID:           int(ctg.Category.Id),
 			UserID:       int(ctg.Category.UserId),
+			ParentID:     int(ctg.Category.ParentId),
 			Name:         ctg.Category.Name,
 			Description:  ctg.Category.Description,
 			LogoHashedID: ctg.Category.LogoHashedId,
@@ -27,7 +28,8 @@ func CategoryWithStatsToAPI(ctg *finpb.CategoryWithStats) models.CategoryWithSta
 			CreatedAt:    ctg.Category.CreatedAt.AsTime(),
 			UpdatedAt:    ctg.Category.UpdatedAt.AsTime(),
 		},
-		OperationsCount: int(ctg.OperationsCount),
+		OperationsCount:    int(ctg.OperationsCount),
+		OwnOperationsCount: int(ctg.OwnOperationsCount),
 	}
 }
 func CategoriesWithStatsToAPI(userID int, ctgs *finpb.ListCategoriesWithStatsResponse) []models.CategoryWithStats {
@@ -41,6 +43,7 @@ func CategoriesWithStatsToAPI(userID int, ctgs *finpb.ListCategoriesWithStatsRes
 func CategoryCreateRequestToProto(userID int, req models.CreateCategoryRequest) *finpb.CreateCategoryRequest {
 	return &finpb.CreateCategoryRequest{
 		UserId:       int32(userID),
+		ParentId:     int32(req.ParentID),
 		Name:         req.Name,
 		Description:  req.Description,
 		LogoHashedId: req.LogoHashedID,
@@ -51,6 +54,7 @@ func ProtoCategoryToApi(ctg *finpb.Category) *models.Category {
 	return &models.Category{
 		ID:           int(ctg.Id),
 		UserID:       int(ctg.UserId),
+		ParentID:     int(ctg.ParentId),
 		Name:         ctg.Name,
 		Description:  ctg.Description,
 		LogoHashedID: ctg.LogoHashedId,
@@ -67,6 +71,22 @@ func UserAndCtegoryIDToProto(userID, ctgID int) *finpb.CategoryRequest {
 	}
 }
 
+func DeleteCategoryRequestToProto(userID, ctgID, reassignTo int) *finpb.DeleteCategoryRequest {
+	return &finpb.DeleteCategoryRequest{
+		UserId:     int32(userID),
+		CategoryId: int32(ctgID),
+		ReassignTo: int32(reassignTo),
+	}
+}
+
+func MergeCategoriesRequestToProto(userID, sourceID int, req models.MergeCategoriesRequest) *finpb.MergeCategoriesRequest {
+	return &finpb.MergeCategoriesRequest{
+		UserId:   int32(userID),
+		SourceId: int32(sourceID),
+		TargetId: int32(req.TargetID),
+	}
+}
+
 func UserIDCategoryNameToProto(userID int, categoryName string) *finpb.CategoryByNameRequest {
 	return &finpb.CategoryByNameRequest{
 		UserId:       int32(userID),
@@ -75,7 +95,14 @@ func UserIDCategoryNameToProto(userID int, categoryName string) *finpb.CategoryB
 }
 
 func CategoryUpdateRequestToProto(userID, ctgID int, req models.UpdateCategoryRequest) *finpb.UpdateCategoryRequest {
+	var parentID *int32
+	if req.ParentID != nil {
+		id := int32(*req.ParentID)
+		parentID = &id
+	}
+
 	return &finpb.UpdateCategoryRequest{
+		ParentId:     parentID,
 		UserId:       int32(userID),
 		CategoryId:   int32(ctgID),
 		Name:         req.Name,
@@ -93,6 +120,14 @@ func CategoryToUpdateSearch(ctg *models.Category) models.UpdateCategoryInOperati
 	}
 }
 
+// CategoryMergeToUpdateSearch переносит операции категории sourceID в поисковом индексе на target.
+func CategoryMergeToUpdateSearch(sourceID int, target *models.Category) models.UpdateCategoryInOperationSearch {
+	search := CategoryToUpdateSearch(target)
+	search.SourceCategoryID = sourceID
+	search.Action = models.MERGE
+	return search
+}
+
 func CreateCategoryReportRequestToProto(userID int, q url.Values) *finpb.CategoryReportRequest {
 	startStr := q.Get("start")
 	endStr := q.Get("end")
@@ -130,12 +165,108 @@ func ProtoToCategoryReport(resp *finpb.CategoryReportResponse) models.CategoryRe
 
 	for _, c := range resp.GetCategories() {
 		result.Categories = append(result.Categories, models.CategoryInReport{
-			CategoryID:     int(c.CategoryId),
-			CategoryName:   c.CategoryName,
-			OperationCount: int(c.OperationsCount),
-			TotalSum:       c.TotalSum,
+			CategoryID:        int(c.CategoryId),
+			ParentID:          int(c.ParentId),
+			CategoryName:      c.CategoryName,
+			OperationCount:    int(c.OperationsCount),
+			TotalSum:          c.TotalSum,
+			OwnOperationCount: int(c.OwnOperationsCount),
+			OwnSum:            c.OwnSum,
 		})
 	}
 
 	return result
 }
+
+func ProtoCategoryRuleToApi(rule *finpb.CategoryRule) models.CategoryRule {
+	return models.CategoryRule{
+		ID:            int(rule.Id),
+		CategoryID:    int(rule.CategoryId),
+		Priority:      int(rule.Priority),
+		NameContains:  rule.NameContains,
+		OperationType: rule.OperationType,
+		AmountGT:      rule.AmountGt,
+		AmountLT:      rule.AmountLt,
+		CreatedAt:     rule.CreatedAt.AsTime(),
+		UpdatedAt:     rule.UpdatedAt.AsTime(),
+	}
+}
+
+func ProtoCategoryRulesToApi(resp *finpb.ListCategoryRulesResponse) []models.CategoryRule {
+	rules := make([]models.CategoryRule, 0, len(resp.Rules))
+	for _, rule := range resp.Rules {
+		rules = append(rules, ProtoCategoryRuleToApi(rule))
+	}
+	return rules
+}
+
+func CategoryRuleCreateRequestToProto(userID int, req models.CategoryRuleRequest) *finpb.CreateCategoryRuleRequest {
+	return &finpb.CreateCategoryRuleRequest{
+		UserId:        int32(userID),
+		CategoryId:    int32(req.CategoryID),
+		NameContains:  req.NameContains,
+		OperationType: req.OperationType,
+		AmountGt:      req.AmountGT,
+		AmountLt:      req.AmountLT,
+	}
+}
+
+func CategoryRuleUpdateRequestToProto(userID, ruleID int, req models.CategoryRuleRequest) *finpb.UpdateCategoryRuleRequest {
+	return &finpb.UpdateCategoryRuleRequest{
+		UserId:        int32(userID),
+		RuleId:        int32(ruleID),
+		CategoryId:    int32(req.CategoryID),
+		NameContains:  req.NameContains,
+		OperationType: req.OperationType,
+		AmountGt:      req.AmountGT,
+		AmountLt:      req.AmountLT,
+	}
+}
+
+func ReorderCategoryRulesToProto(userID int, req models.ReorderCategoryRulesRequest) *finpb.ReorderCategoryRulesRequest {
+	ids := make([]int32, 0, len(req.RuleIDs))
+	for _, id := range req.RuleIDs {
+		ids = append(ids, int32(id))
+	}
+	return &finpb.ReorderCategoryRulesRequest{
+		UserId:  int32(userID),
+		RuleIds: ids,
+	}
+}
+
+func RecategorizedOperationToSearch(op *finpb.Operation, ctg *finpb.Category, logo string) models.TransactionSearch {
+	search := models.TransactionSearch{
+		ID:           int(op.Id),
+		AccountID:    int(op.AccountId),
+		CategoryID:   int(op.CategoryId),
+		CategoryName: op.CategoryName,
+		Type:         op.Type,
+		Description:  op.Description,
+		Status:       op.Status,
+		Name:         op.Name,
+		CategoryLogo: logo,
+		Sum:          op.Sum,
+		AccountType:  op.AccountType,
+		CurrencyID:   int(op.CurrencyId),
+		CreatedAt:    op.CreatedAt.AsTime(),
+		Date:         op.Date.AsTime(),
+		Action:       models.UPDATE,
+	}
+	if ctg != nil {
+		search.CategoryLogoHashedID = ctg.LogoHashedId
+	}
+	return search
+}
+
+func ProtoSuggestionToApi(resp *finpb.SuggestCategoryResponse) models.CategorySuggestion {
+	if !resp.GetFound() {
+		return models.CategorySuggestion{Found: false}
+	}
+	return models.CategorySuggestion{
+		Found:        true,
+		CategoryID:   int(resp.GetCategoryId()),
+		CategoryName: resp.GetCategoryName(),
+		Confidence:   resp.GetConfidence(),
+		Source:       resp.GetSource(),
+	}
+}
@@ -0,0 +1,119 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// publishCategorySearch отправляет изменение категории в поисковый индекс операций.
+// Изменения в БД к этому моменту уже зафиксированы, поэтому ошибка только логируется.
+func (h *Handler) publishCategorySearch(r *http.Request, search models.UpdateCategoryInOperationSearch) {
+	data, _ := search.MarshalJSON()
+	if err := h.kafkaProducer.WriteMessages(r.Context(), kafkautils.KafkaMessage{Payload: data, Type: models.CATEGORIES}); err != nil {
+		log := logger.FromContext(r.Context())
+		if log != nil {
+			log.Error("kafka category "+search.Action+" error", "category_id", search.CategoryID, "error", err)
+		}
+	}
+}
+
+// replaceBudgetCategory переносит исходную категорию в бюджетах на целевую.
+// Категории к этому моменту уже объединены, поэтому ошибка только логируется.
+func (h *Handler) replaceBudgetCategory(r *http.Request, userID, sourceID, targetID int) {
+	_, err := h.bdgClient.ReplaceBudgetCategory(r.Context(), &bdgpb.ReplaceCategoryRequest{
+		UserId:   int32(userID),
+		SourceId: int32(sourceID),
+		TargetId: int32(targetID),
+	})
+	if err != nil {
+		log := logger.FromContext(r.Context())
+		if log != nil {
+			log.Error("grpc ReplaceBudgetCategory error", "source_id", sourceID, "target_id", targetID, "error", err)
+		}
+	}
+}
+
+// MergeCategories godoc
+// @Summary Слияние категорий
+// @Description Переносит операции, правила и подкатегории в целевую категорию и удаляет исходную
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID исходной категории"
+// @Param request body models.MergeCategoriesRequest true "Целевая категория"
+// @Success 200 {object} models.MergeCategoriesResponse "Целевая категория и количество перенесенных операций"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Категория не найдена (CATEGORY_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/{id}/merge [post]
+func (h *Handler) MergeCategories(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	sourceID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Invalid category ID", "id")
+		return
+	}
+
+	var req models.MergeCategoriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	resp, err := h.finClient.MergeCategories(r.Context(), MergeCategoriesRequestToProto(userID, sourceID, req))
+	if err != nil {
+		log := logger.FromContext(r.Context())
+		st, ok := status.FromError(err)
+		if !ok {
+			if log != nil {
+				log.Error("grpc MergeCategories unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to merge categories")
+			return
+		}
+		switch st.Code() {
+		case codes.InvalidArgument:
+			httputils.ValidationError(w, r, "Нельзя объединить категорию саму с собой", "target_id")
+		case codes.NotFound:
+			httputils.NotFoundError(w, r, "Категория не найдена")
+		default:
+			if log != nil {
+				log.Error("grpc MergeCategories error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to merge categories")
+		}
+		return
+	}
+
+	target := ProtoCategoryToApi(resp.Target)
+	h.enrichCategoryWithLogoURL(r.Context(), target)
+	h.replaceBudgetCategory(r, userID, sourceID, target.ID)
+	h.publishCategorySearch(r, CategoryMergeToUpdateSearch(sourceID, target))
+
+	httputils.Success(w, r, models.MergeCategoriesResponse{
+		Target:          *target,
+		MovedOperations: int(resp.MovedOperations),
+	})
+}
@@ -0,0 +1,220 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+func decodeCategorySearch(t *testing.T, msg kafkautils.KafkaMessage) models.UpdateCategoryInOperationSearch {
+	t.Helper()
+	require.Equal(t, models.CATEGORIES, msg.Type)
+	var search models.UpdateCategoryInOperationSearch
+	require.NoError(t, search.UnmarshalJSON(msg.Payload))
+	return search
+}
+
+func TestMergeCategories_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	mockBdg := mocks.NewMockBudgetServiceClient(ctrl)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	handler := NewHandler(mockFin, mockBdg, nil, mockKafka)
+
+	mockFin.EXPECT().
+		MergeCategories(gomock.Any(), &finpb.MergeCategoriesRequest{UserId: 1, SourceId: 3, TargetId: 5}).
+		Return(&finpb.MergeCategoriesResponse{
+			Target:          &finpb.Category{Id: 5, UserId: 1, Name: "Еда"},
+			MovedOperations: 7,
+		}, nil)
+	mockBdg.EXPECT().
+		ReplaceBudgetCategory(gomock.Any(), &bdgpb.ReplaceCategoryRequest{UserId: 1, SourceId: 3, TargetId: 5}).
+		Return(&bdgpb.ReplaceCategoryResponse{BudgetsUpdated: 2}, nil)
+
+	var sent models.UpdateCategoryInOperationSearch
+	mockKafka.EXPECT().
+		WriteMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msgs ...kafkautils.KafkaMessage) error {
+			require.Len(t, msgs, 1)
+			sent = decodeCategorySearch(t, msgs[0])
+			return nil
+		})
+
+	req := mux.SetURLVars(ruleRequest(http.MethodPost, "/categories/3/merge", models.MergeCategoriesRequest{TargetID: 5}), map[string]string{"id": "3"})
+	rr := httptest.NewRecorder()
+	handler.MergeCategories(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.MergeCategoriesResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, 5, resp.Target.ID)
+	require.Equal(t, 7, resp.MovedOperations)
+
+	require.Equal(t, models.MERGE, sent.Action)
+	require.Equal(t, 3, sent.SourceCategoryID)
+	require.Equal(t, 5, sent.CategoryID)
+	require.Equal(t, "Еда", sent.CategoryName)
+}
+
+func TestMergeCategories_BudgetErrorIgnored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	mockBdg := mocks.NewMockBudgetServiceClient(ctrl)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	handler := NewHandler(mockFin, mockBdg, nil, mockKafka)
+
+	mockFin.EXPECT().
+		MergeCategories(gomock.Any(), gomock.Any()).
+		Return(&finpb.MergeCategoriesResponse{Target: &finpb.Category{Id: 5, UserId: 1, Name: "Еда"}}, nil)
+	mockBdg.EXPECT().
+		ReplaceBudgetCategory(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unavailable, "budget service down"))
+	mockKafka.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil)
+
+	req := mux.SetURLVars(ruleRequest(http.MethodPost, "/categories/3/merge", models.MergeCategoriesRequest{TargetID: 5}), map[string]string{"id": "3"})
+	rr := httptest.NewRecorder()
+	handler.MergeCategories(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestMergeCategories_MissingTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), nil, nil, nil)
+
+	req := mux.SetURLVars(ruleRequest(http.MethodPost, "/categories/3/merge", map[string]int{}), map[string]string{"id": "3"})
+	rr := httptest.NewRecorder()
+	handler.MergeCategories(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestMergeCategories_GRPCErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"same category", status.Error(codes.InvalidArgument, string(models.ErrCodeInvalidRequest)), http.StatusBadRequest},
+		{"not found", status.Error(codes.NotFound, string(models.ErrCodeCategoryNotFound)), http.StatusNotFound},
+		{"internal", status.Error(codes.Internal, "boom"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+			handler := NewHandler(mockFin, nil, nil, nil)
+
+			mockFin.EXPECT().MergeCategories(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+			req := mux.SetURLVars(ruleRequest(http.MethodPost, "/categories/3/merge", models.MergeCategoriesRequest{TargetID: 3}), map[string]string{"id": "3"})
+			rr := httptest.NewRecorder()
+			handler.MergeCategories(rr, req)
+
+			require.Equal(t, tt.expected, rr.Code)
+		})
+	}
+}
+
+func TestDeleteCategory_Reassign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	handler := NewHandler(mockFin, nil, nil, mockKafka)
+
+	mockFin.EXPECT().
+		DeleteCategory(gomock.Any(), &finpb.DeleteCategoryRequest{UserId: 1, CategoryId: 2, ReassignTo: 4}).
+		Return(&finpb.Category{Id: 4, UserId: 1, Name: "Транспорт"}, nil)
+
+	var sent models.UpdateCategoryInOperationSearch
+	mockKafka.EXPECT().
+		WriteMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msgs ...kafkautils.KafkaMessage) error {
+			sent = decodeCategorySearch(t, msgs[0])
+			return nil
+		})
+
+	req := httptest.NewRequest(http.MethodDelete, "/categories/2?reassign_to=4", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "2"})
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+	handler.DeleteCategory(rr, req)
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	require.Equal(t, models.MERGE, sent.Action)
+	require.Equal(t, 2, sent.SourceCategoryID)
+	require.Equal(t, 4, sent.CategoryID)
+}
+
+func TestDeleteCategory_WithoutReassignClearsSearch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	handler := NewHandler(mockFin, nil, nil, mockKafka)
+
+	mockFin.EXPECT().
+		DeleteCategory(gomock.Any(), &finpb.DeleteCategoryRequest{UserId: 1, CategoryId: 2}).
+		Return(&finpb.Category{}, nil)
+
+	var sent models.UpdateCategoryInOperationSearch
+	mockKafka.EXPECT().
+		WriteMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msgs ...kafkautils.KafkaMessage) error {
+			sent = decodeCategorySearch(t, msgs[0])
+			return nil
+		})
+
+	req := httptest.NewRequest(http.MethodDelete, "/categories/2", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "2"})
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+	handler.DeleteCategory(rr, req)
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	require.Equal(t, models.DELETE, sent.Action)
+	require.Equal(t, 2, sent.CategoryID)
+}
+
+func TestDeleteCategory_InvalidReassign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), nil, nil, nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/categories/2?reassign_to=abc", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "2"})
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+	rr := httptest.NewRecorder()
+	handler.DeleteCategory(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
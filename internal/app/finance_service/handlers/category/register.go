@@ -3,18 +3,29 @@ package category
 import (
 	"github.com/gorilla/mux"
 
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	image "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/usecase"
 	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
 )
 
-func Register(router *mux.Router, finClient finpb.FinanceServiceClient, imageUC image.ImageUseCase, kafkaProducer kafkautils.KafkaProducer) {
-	handler := NewHandler(finClient, imageUC, kafkaProducer)
+func Register(router *mux.Router, finClient finpb.FinanceServiceClient, bdgClient bdgpb.BudgetServiceClient, imageUC image.ImageUseCase, kafkaProducer kafkautils.KafkaProducer) {
+	handler := NewHandler(finClient, bdgClient, imageUC, kafkaProducer)
 
 	router.HandleFunc("/categories", handler.GetCategories).Methods("GET")
 	router.HandleFunc("/categories", handler.CreateCategory).Methods("POST")
 	router.HandleFunc("/categories/report", handler.GetCategoriesReport).Methods("GET")
+	router.HandleFunc("/categories/defaults", handler.ResetDefaultCategories).Methods("POST")
+	router.HandleFunc("/categories/suggest", handler.SuggestCategory).Methods("GET")
+	router.HandleFunc("/categories/rules", handler.GetCategoryRules).Methods("GET")
+	router.HandleFunc("/categories/rules", handler.CreateCategoryRule).Methods("POST")
+	router.HandleFunc("/categories/rules/order", handler.ReorderCategoryRules).Methods("PUT")
+	router.HandleFunc("/categories/rules/test", handler.TestCategoryRules).Methods("POST")
+	router.HandleFunc("/categories/rules/apply", handler.ApplyCategoryRules).Methods("POST")
+	router.HandleFunc("/categories/rules/{id}", handler.UpdateCategoryRule).Methods("PUT")
+	router.HandleFunc("/categories/rules/{id}", handler.DeleteCategoryRule).Methods("DELETE")
 	router.HandleFunc("/categories/{id}", handler.GetCategoryByID).Methods("GET")
 	router.HandleFunc("/categories/{id}", handler.UpdateCategory).Methods("PUT")
 	router.HandleFunc("/categories/{id}", handler.DeleteCategory).Methods("DELETE")
+	router.HandleFunc("/categories/{id}/merge", handler.MergeCategories).Methods("POST")
 }
@@ -0,0 +1,353 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+func (h *Handler) handleRuleError(w http.ResponseWriter, r *http.Request, err error, method string) {
+	log := logger.FromContext(r.Context())
+	st, ok := status.FromError(err)
+	if !ok {
+		if log != nil {
+			log.Error("grpc "+method+" unknown error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to process category rule")
+		return
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		httputils.ValidationError(w, r, "Некорректные условия правила", "rule")
+	case codes.NotFound:
+		if st.Message() == string(models.ErrCodeCategoryNotFound) {
+			httputils.NotFoundError(w, r, "Категория не найдена")
+			return
+		}
+		httputils.NotFoundError(w, r, "Правило не найдено")
+	default:
+		if log != nil {
+			log.Error("grpc "+method+" error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to process category rule")
+	}
+}
+
+func (h *Handler) decodeRuleRequest(w http.ResponseWriter, r *http.Request) (models.CategoryRuleRequest, bool) {
+	var req models.CategoryRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return req, false
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return req, false
+	}
+	return req, true
+}
+
+// GetCategoryRules godoc
+// @Summary Получение правил автокатегоризации
+// @Description Возвращает правила автоматической категоризации операций в порядке их применения
+// @Tags categories
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} models.CategoryRule "Список правил"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/rules [get]
+func (h *Handler) GetCategoryRules(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	rules, err := h.finClient.GetCategoryRules(r.Context(), UserIDToProtoID(userID))
+	if err != nil {
+		h.handleRuleError(w, r, err, "GetCategoryRules")
+		return
+	}
+
+	httputils.Success(w, r, ProtoCategoryRulesToApi(rules))
+}
+
+// CreateCategoryRule godoc
+// @Summary Создание правила автокатегоризации
+// @Description Создает правило, назначающее категорию операциям по названию, типу и сумме. Новое правило добавляется в конец списка
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.CategoryRuleRequest true "Условия правила"
+// @Success 201 {object} models.CategoryRule "Созданное правило"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Категория не найдена"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/rules [post]
+func (h *Handler) CreateCategoryRule(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	req, ok := h.decodeRuleRequest(w, r)
+	if !ok {
+		return
+	}
+
+	rule, err := h.finClient.CreateCategoryRule(r.Context(), CategoryRuleCreateRequestToProto(userID, req))
+	if err != nil {
+		h.handleRuleError(w, r, err, "CreateCategoryRule")
+		return
+	}
+
+	httputils.Created(w, r, ProtoCategoryRuleToApi(rule))
+}
+
+// UpdateCategoryRule godoc
+// @Summary Обновление правила автокатегоризации
+// @Description Полностью заменяет условия и категорию правила, порядок правила не меняется
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID правила"
+// @Param request body models.CategoryRuleRequest true "Условия правила"
+// @Success 200 {object} models.CategoryRule "Обновленное правило"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Правило или категория не найдены"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/rules/{id} [put]
+func (h *Handler) UpdateCategoryRule(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	ruleID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Invalid rule ID", "id")
+		return
+	}
+
+	req, ok := h.decodeRuleRequest(w, r)
+	if !ok {
+		return
+	}
+
+	rule, err := h.finClient.UpdateCategoryRule(r.Context(), CategoryRuleUpdateRequestToProto(userID, ruleID, req))
+	if err != nil {
+		h.handleRuleError(w, r, err, "UpdateCategoryRule")
+		return
+	}
+
+	httputils.Success(w, r, ProtoCategoryRuleToApi(rule))
+}
+
+// DeleteCategoryRule godoc
+// @Summary Удаление правила автокатегоризации
+// @Description Удаляет правило; уже назначенные им категории операций не меняются
+// @Tags categories
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID правила"
+// @Success 204 "Правило успешно удалено"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Правило не найдено (RULE_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/rules/{id} [delete]
+func (h *Handler) DeleteCategoryRule(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	ruleID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Invalid rule ID", "id")
+		return
+	}
+
+	_, err = h.finClient.DeleteCategoryRule(r.Context(), &finpb.CategoryRuleRequest{UserId: int32(userID), RuleId: int32(ruleID)})
+	if err != nil {
+		h.handleRuleError(w, r, err, "DeleteCategoryRule")
+		return
+	}
+
+	httputils.NoContent(w, r)
+}
+
+// ReorderCategoryRules godoc
+// @Summary Изменение порядка правил автокатегоризации
+// @Description Задает порядок применения правил. Список должен содержать все правила пользователя
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.ReorderCategoryRulesRequest true "ID правил в новом порядке"
+// @Success 200 {array} models.CategoryRule "Правила в новом порядке"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/rules/order [put]
+func (h *Handler) ReorderCategoryRules(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	var req models.ReorderCategoryRulesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	rules, err := h.finClient.ReorderCategoryRules(r.Context(), ReorderCategoryRulesToProto(userID, req))
+	if err != nil {
+		h.handleRuleError(w, r, err, "ReorderCategoryRules")
+		return
+	}
+
+	httputils.Success(w, r, ProtoCategoryRulesToApi(rules))
+}
+
+// TestCategoryRules godoc
+// @Summary Проверка правил автокатегоризации
+// @Description Показывает, какое правило сработает для операции с указанными параметрами. Данные не изменяются
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.TestCategoryRulesRequest true "Параметры операции"
+// @Success 200 {object} models.TestCategoryRulesResponse "Результат проверки"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/rules/test [post]
+func (h *Handler) TestCategoryRules(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	var req models.TestCategoryRulesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	resp, err := h.finClient.TestCategoryRules(r.Context(), &finpb.TestCategoryRulesRequest{
+		UserId: int32(userID),
+		Name:   req.Name,
+		Sum:    req.Sum,
+		Type:   req.Type,
+	})
+	if err != nil {
+		h.handleRuleError(w, r, err, "TestCategoryRules")
+		return
+	}
+
+	result := models.TestCategoryRulesResponse{Matched: resp.Matched}
+	if resp.Rule != nil {
+		rule := ProtoCategoryRuleToApi(resp.Rule)
+		result.Rule = &rule
+	}
+
+	httputils.Success(w, r, result)
+}
+
+// ApplyCategoryRules godoc
+// @Summary Применение правил к истории операций
+// @Description Повторно применяет правила к существующим операциям. По умолчанию затрагиваются только операции без категории, с overwrite=true — все операции пользователя
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.ApplyCategoryRulesRequest false "Параметры применения"
+// @Success 200 {object} models.ApplyCategoryRulesResponse "Количество проверенных и перекатегоризированных операций"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/rules/apply [post]
+func (h *Handler) ApplyCategoryRules(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	log := logger.FromContext(r.Context())
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	var req models.ApplyCategoryRulesRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+			return
+		}
+	}
+
+	resp, err := h.finClient.ApplyCategoryRules(r.Context(), &finpb.ApplyCategoryRulesRequest{
+		UserId:    int32(userID),
+		Overwrite: req.Overwrite,
+	})
+	if err != nil {
+		h.handleRuleError(w, r, err, "ApplyCategoryRules")
+		return
+	}
+
+	categories := make(map[int32]*finpb.Category)
+	logos := make(map[int32]string)
+	for _, op := range resp.Recategorized {
+		ctg, cached := categories[op.CategoryId]
+		if !cached {
+			ctgWithStats, err := h.finClient.GetCategory(r.Context(), UserAndCtegoryIDToProto(userID, int(op.CategoryId)))
+			if err == nil {
+				ctg = ctgWithStats.Category
+				logos[op.CategoryId], _ = h.imageUC.GetImageURL(r.Context(), ctg.LogoHashedId)
+			}
+			categories[op.CategoryId] = ctg
+		}
+
+		searchObj := RecategorizedOperationToSearch(op, ctg, logos[op.CategoryId])
+		data, _ := searchObj.MarshalJSON()
+		if err := h.kafkaProducer.WriteMessages(r.Context(), kafkautils.KafkaMessage{Payload: data, Type: models.TRANSACTIONS}); err != nil {
+			if log != nil {
+				log.Error("kafka ApplyCategoryRules error", "operation_id", op.Id, "error", err)
+			}
+		}
+	}
+
+	httputils.Success(w, r, models.ApplyCategoryRulesResponse{
+		Checked:       int(resp.Checked),
+		Recategorized: len(resp.Recategorized),
+	})
+}
@@ -0,0 +1,154 @@
+package category
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ruleRequest(method, url string, body interface{}) *http.Request {
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, url, bytes.NewReader(data))
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestCreateCategoryRule_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		CreateCategoryRule(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *finpb.CreateCategoryRuleRequest, _ ...interface{}) (*finpb.CategoryRule, error) {
+			require.Equal(t, int32(1), req.UserId)
+			require.Equal(t, "Пятёрочка", req.NameContains)
+			return &finpb.CategoryRule{Id: 3, CategoryId: req.CategoryId, Priority: 1, NameContains: req.NameContains, CreatedAt: timestamppb.Now(), UpdatedAt: timestamppb.Now()}, nil
+		})
+
+	rr := httptest.NewRecorder()
+	handler.CreateCategoryRule(rr, ruleRequest(http.MethodPost, "/categories/rules", models.CategoryRuleRequest{CategoryID: 5, NameContains: "Пятёрочка"}))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+}
+
+func TestCreateCategoryRule_InvalidType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), nil, nil, nil)
+
+	rr := httptest.NewRecorder()
+	handler.CreateCategoryRule(rr, ruleRequest(http.MethodPost, "/categories/rules", models.CategoryRuleRequest{CategoryID: 5, OperationType: "transfer"}))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestCreateCategoryRule_CategoryNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		CreateCategoryRule(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeCategoryNotFound)))
+
+	rr := httptest.NewRecorder()
+	handler.CreateCategoryRule(rr, ruleRequest(http.MethodPost, "/categories/rules", models.CategoryRuleRequest{CategoryID: 5, NameContains: "taxi"}))
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestDeleteCategoryRule_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		DeleteCategoryRule(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeRuleNotFound)))
+
+	req := ruleRequest(http.MethodDelete, "/categories/rules/7", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "7"})
+	rr := httptest.NewRecorder()
+	handler.DeleteCategoryRule(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestTestCategoryRules_Matched(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		TestCategoryRules(gomock.Any(), gomock.Any()).
+		Return(&finpb.TestCategoryRulesResponse{Matched: true, Rule: &finpb.CategoryRule{Id: 2, CategoryId: 9}}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.TestCategoryRules(rr, ruleRequest(http.MethodPost, "/categories/rules/test", models.TestCategoryRulesRequest{Name: "Зарплата", Sum: 60000, Type: "income"}))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.TestCategoryRulesResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.True(t, resp.Matched)
+	require.Equal(t, 2, resp.Rule.ID)
+}
+
+func TestApplyCategoryRules_EmitsSearchUpdates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	mockImage := mocks.NewMockImageUseCase(ctrl)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	handler := NewHandler(mockFin, nil, mockImage, mockKafka)
+
+	mockFin.EXPECT().
+		ApplyCategoryRules(gomock.Any(), gomock.Any()).
+		Return(&finpb.ApplyCategoryRulesResponse{
+			Checked: 5,
+			Recategorized: []*finpb.Operation{
+				{Id: 1, CategoryId: 9, CreatedAt: timestamppb.Now(), Date: timestamppb.Now()},
+				{Id: 2, CategoryId: 9, CreatedAt: timestamppb.Now(), Date: timestamppb.Now()},
+			},
+		}, nil)
+	mockFin.EXPECT().
+		GetCategory(gomock.Any(), gomock.Any()).
+		Return(&finpb.CategoryWithStats{Category: &finpb.Category{Id: 9, LogoHashedId: "logo"}}, nil).
+		Times(1)
+	mockImage.EXPECT().GetImageURL(gomock.Any(), "logo").Return("http://logo", nil)
+	mockKafka.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	rr := httptest.NewRecorder()
+	handler.ApplyCategoryRules(rr, ruleRequest(http.MethodPost, "/categories/rules/apply", models.ApplyCategoryRulesRequest{Overwrite: true}))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.ApplyCategoryRulesResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, 5, resp.Checked)
+	require.Equal(t, 2, resp.Recategorized)
+}
@@ -0,0 +1,69 @@
+package category
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// SuggestCategory godoc
+// @Summary Подсказка категории для операции
+// @Description Подбирает категорию по названию и сумме операции: сначала по правилам автокатегоризации, затем по истории операций пользователя
+// @Tags categories
+// @Produce json
+// @Security ApiKeyAuth
+// @Param name query string true "Название операции"
+// @Param sum query number false "Сумма операции"
+// @Param type query string false "Тип операции (income, expense)"
+// @Success 200 {object} models.CategorySuggestion "Предложенная категория"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /categories/suggest [get]
+func (h *Handler) SuggestCategory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	name := strings.TrimSpace(q.Get("name"))
+	if name == "" {
+		httputils.ValidationError(w, r, "Название операции обязательно", "name")
+		return
+	}
+
+	var sum float64
+	if sumStr := q.Get("sum"); sumStr != "" {
+		parsed, err := strconv.ParseFloat(sumStr, 64)
+		if err != nil || parsed < 0 {
+			httputils.ValidationError(w, r, "Некорректная сумма", "sum")
+			return
+		}
+		sum = parsed
+	}
+
+	opType := q.Get("type")
+	if opType != "" && opType != string(models.OperationIncome) && opType != string(models.OperationExpense) {
+		httputils.ValidationError(w, r, "Некорректный тип операции", "type")
+		return
+	}
+
+	resp, err := h.finClient.SuggestCategory(r.Context(), &finpb.SuggestCategoryRequest{
+		UserId: int32(userID),
+		Name:   name,
+		Sum:    sum,
+		Type:   opType,
+	})
+	if err != nil {
+		h.handleRuleError(w, r, err, "SuggestCategory")
+		return
+	}
+
+	httputils.Success(w, r, ProtoSuggestionToApi(resp))
+}
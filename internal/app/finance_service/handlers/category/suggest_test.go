@@ -0,0 +1,76 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSuggestCategory_Found(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		SuggestCategory(gomock.Any(), &finpb.SuggestCategoryRequest{UserId: 1, Name: "Яндекс Такси", Sum: 350, Type: "expense"}).
+		Return(&finpb.SuggestCategoryResponse{Found: true, CategoryId: 4, CategoryName: "Транспорт", Confidence: 0.93, Source: "history"}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.SuggestCategory(rr, ruleRequest(http.MethodGet, "/categories/suggest?name=%D0%AF%D0%BD%D0%B4%D0%B5%D0%BA%D1%81+%D0%A2%D0%B0%D0%BA%D1%81%D0%B8&sum=350&type=expense", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.CategorySuggestion
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.True(t, resp.Found)
+	require.Equal(t, 4, resp.CategoryID)
+	require.Equal(t, "history", resp.Source)
+}
+
+func TestSuggestCategory_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, nil, nil, nil)
+
+	mockFin.EXPECT().
+		SuggestCategory(gomock.Any(), gomock.Any()).
+		Return(&finpb.SuggestCategoryResponse{Found: false}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.SuggestCategory(rr, ruleRequest(http.MethodGet, "/categories/suggest?name=abc", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.CategorySuggestion
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.False(t, resp.Found)
+	require.Zero(t, resp.CategoryID)
+}
+
+func TestSuggestCategory_Validation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), nil, nil, nil)
+
+	for _, url := range []string{
+		"/categories/suggest",
+		"/categories/suggest?name=abc&sum=x",
+		"/categories/suggest?name=abc&type=transfer",
+	} {
+		rr := httptest.NewRecorder()
+		handler.SuggestCategory(rr, ruleRequest(http.MethodGet, url, nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code, url)
+	}
+}
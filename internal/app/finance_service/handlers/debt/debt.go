@@ -0,0 +1,383 @@
+package debt
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/operation"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	image "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/usecase"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// principalPaymentKind — вид платежа, которым записывается выдача или получение долга
+const principalPaymentKind = "principal"
+
+type Handler struct {
+	finClient     finpb.FinanceServiceClient
+	imageUC       image.ImageUseCase
+	kafkaProducer kafkautils.KafkaProducer
+}
+
+func NewHandler(finClient finpb.FinanceServiceClient, imageUC image.ImageUseCase, kafkaProducer kafkautils.KafkaProducer) *Handler {
+	return &Handler{finClient: finClient, imageUC: imageUC, kafkaProducer: kafkaProducer}
+}
+
+func (h *Handler) getUserID(r *http.Request) (int, bool) {
+	return middleware.GetUserIDFromContext(r.Context())
+}
+
+func (h *Handler) parseIDFromURL(r *http.Request, paramName string) (int, error) {
+	vars := mux.Vars(r)
+	idStr := vars[paramName]
+	return strconv.Atoi(idStr)
+}
+
+// publishOperation отправляет созданную по долгу операцию в поисковый индекс, как это делает
+// обработчик операций. У операций по долгам нет категории
+func (h *Handler) publishOperation(ctx context.Context, userID, accountID, operationID int) error {
+	op, err := h.finClient.GetOperation(ctx, operation.OperationAndUserIDToProtoID(operationID, accountID, userID))
+	if err != nil {
+		return err
+	}
+
+	transactionSearch := operation.OperationResponseToSearch(operation.ProtoOperationToResponse(op), models.CategoryWithStats{}, "")
+	transactionSearch.Action = models.WRITE
+
+	data, _ := transactionSearch.MarshalJSON()
+	return h.kafkaProducer.WriteMessages(ctx, kafkautils.KafkaMessage{Payload: data, Type: models.TRANSACTIONS})
+}
+
+func (h *Handler) handleDebtError(w http.ResponseWriter, r *http.Request, err error, method string) {
+	log := logger.FromContext(r.Context())
+	st, ok := status.FromError(err)
+	if !ok {
+		if log != nil {
+			log.Error("grpc "+method+" unknown error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to process debt")
+		return
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		httputils.Error(w, r, "Некорректные данные долга", http.StatusBadRequest)
+	case codes.FailedPrecondition:
+		httputils.Error(w, r, "Баланс счета не может быть отрицательным", http.StatusBadRequest)
+	case codes.PermissionDenied:
+		httputils.Error(w, r, "Недостаточно прав для операций по счету", http.StatusForbidden)
+	case codes.NotFound:
+		// Не найден может быть долг, счет, контрагент или пользователь — код ошибки в сообщении
+		httputils.NotFoundError(w, r, models.ErrorCode(st.Message()).GetErrorMessage())
+	default:
+		if log != nil {
+			log.Error("grpc "+method+" error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to process debt")
+	}
+}
+
+func (h *Handler) enrichCounterpartyWithLogoURL(ctx context.Context, logoHashedID string, counterparty *models.Counterparty) {
+	if logoHashedID == "" {
+		return
+	}
+	url, err := h.imageUC.GetImageURL(ctx, logoHashedID)
+	if err != nil {
+		log := logger.FromContext(ctx)
+		if log != nil {
+			log.Error("Failed to get image URL for counterparty", "counterparty_id", counterparty.ID, "image_id", logoHashedID, "error", err)
+		}
+		return
+	}
+	counterparty.LogoURL = url
+}
+
+// GetCounterparties godoc
+// @Summary Контрагенты и баланс долгов
+// @Description Возвращает получателей пользователя с непогашенными долгами по каждой валюте. net > 0 — контрагент должен пользователю, net < 0 — пользователь должен контрагенту
+// @Tags debts
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} models.CounterpartiesResponse "Контрагенты"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /counterparties [get]
+func (h *Handler) GetCounterparties(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	counterparties, err := h.finClient.GetCounterparties(r.Context(), &finpb.UserID{UserId: int32(userID)})
+	if err != nil {
+		h.handleDebtError(w, r, err, "GetCounterparties")
+		return
+	}
+
+	res := CounterpartiesToAPI(counterparties)
+	for i, c := range counterparties.Counterparties {
+		h.enrichCounterpartyWithLogoURL(r.Context(), c.Receiver.GetLogoHashedId(), &res.Counterparties[i])
+	}
+	httputils.Success(w, r, res)
+}
+
+// GetDebts godoc
+// @Summary Список долгов
+// @Description Возвращает долги пользователя, начиная с последнего. status=open — только непогашенные, status=closed — только погашенные
+// @Tags debts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param status query string false "open или closed"
+// @Success 200 {object} models.DebtsResponse "Долги"
+// @Failure 400 {object} models.ErrorResponse "Некорректный статус (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /debts [get]
+func (h *Handler) GetDebts(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	debtStatus := r.URL.Query().Get("status")
+	if debtStatus != "" && debtStatus != "open" && debtStatus != "closed" {
+		httputils.ValidationError(w, r, "Некорректный статус долга", "status")
+		return
+	}
+
+	debts, err := h.finClient.GetDebts(r.Context(), &finpb.ListDebtsRequest{UserId: int32(userID), Status: debtStatus})
+	if err != nil {
+		h.handleDebtError(w, r, err, "GetDebts")
+		return
+	}
+
+	httputils.Success(w, r, DebtsToAPI(debts))
+}
+
+// CreateDebt godoc
+// @Summary Создание долга
+// @Description Записывает долг и создает операцию на счете: расход, если пользователь дал в долг (lent), доход, если взял (borrowed). Контрагент задается одним из полей counterparty_id, counterparty_name или counterparty_login. Валюта долга — валюта счета
+// @Tags debts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.CreateDebtRequest true "Данные долга"
+// @Success 201 {object} models.Debt "Созданный долг"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные или недостаточно средств (INVALID_REQUEST, INVALID_DATA, NEGATIVE_BALANCE)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Счет, контрагент или пользователь не найден (ACCOUNT_NOT_FOUND, RECEIVER_NOT_FOUND, USER_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /debts [post]
+func (h *Handler) CreateDebt(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	var req models.CreateDebtRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	debt, err := h.finClient.CreateDebt(r.Context(), CreateDebtRequestToProto(req, userID))
+	if err != nil {
+		h.handleDebtError(w, r, err, "CreateDebt")
+		return
+	}
+
+	payments, err := h.finClient.GetDebtPayments(r.Context(), IDsToDebtRequest(int(debt.Id), userID))
+	if err != nil {
+		h.handleDebtError(w, r, err, "GetDebtPayments")
+		return
+	}
+	for _, payment := range payments.Payments {
+		if payment.Kind != principalPaymentKind {
+			continue
+		}
+		if err := h.publishOperation(r.Context(), userID, int(payment.AccountId), int(payment.OperationId)); err != nil {
+			h.handleDebtError(w, r, err, "CreateDebt")
+			return
+		}
+	}
+
+	httputils.Created(w, r, DebtToAPI(debt))
+}
+
+// GetDebt godoc
+// @Summary Получение долга
+// @Description Возвращает долг с возвращенной суммой и остатком
+// @Tags debts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID долга"
+// @Success 200 {object} models.Debt "Долг"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID долга (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Долг не найден (DEBT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /debts/{id} [get]
+func (h *Handler) GetDebt(w http.ResponseWriter, r *http.Request) {
+	debtID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID долга", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	debt, err := h.finClient.GetDebt(r.Context(), IDsToDebtRequest(debtID, userID))
+	if err != nil {
+		h.handleDebtError(w, r, err, "GetDebt")
+		return
+	}
+
+	httputils.Success(w, r, DebtToAPI(debt))
+}
+
+// DeleteDebt godoc
+// @Summary Удаление долга
+// @Description Удаляет долг. Операции выдачи и возвратов остаются в истории счетов
+// @Tags debts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID долга"
+// @Success 200 {object} models.Debt "Удаленный долг"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID долга (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Долг не найден (DEBT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /debts/{id} [delete]
+func (h *Handler) DeleteDebt(w http.ResponseWriter, r *http.Request) {
+	debtID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID долга", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	debt, err := h.finClient.DeleteDebt(r.Context(), IDsToDebtRequest(debtID, userID))
+	if err != nil {
+		h.handleDebtError(w, r, err, "DeleteDebt")
+		return
+	}
+
+	httputils.Success(w, r, DebtToAPI(debt))
+}
+
+// GetDebtPayments godoc
+// @Summary Операции по долгу
+// @Description Возвращает операцию выдачи и операции возврата долга в хронологическом порядке. Отмененные операции помечены reverted и не учитываются в остатке
+// @Tags debts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID долга"
+// @Success 200 {object} models.DebtPaymentsResponse "Операции по долгу"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID долга (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Долг не найден (DEBT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /debts/{id}/payments [get]
+func (h *Handler) GetDebtPayments(w http.ResponseWriter, r *http.Request) {
+	debtID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID долга", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	payments, err := h.finClient.GetDebtPayments(r.Context(), IDsToDebtRequest(debtID, userID))
+	if err != nil {
+		h.handleDebtError(w, r, err, "GetDebtPayments")
+		return
+	}
+
+	httputils.Success(w, r, DebtPaymentsToAPI(payments))
+}
+
+// AddDebtRepayment godoc
+// @Summary Возврат долга
+// @Description Создает операцию возврата (доход для выданного долга, расход для взятого) и уменьшает остаток долга. По умолчанию операция создается на счете долга; другой счет должен быть в той же валюте. Сумма не может превышать остаток
+// @Tags debts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "ID долга"
+// @Param request body models.CreateDebtRepaymentRequest true "Возврат"
+// @Success 201 {object} models.DebtPayment "Операция возврата"
+// @Failure 400 {object} models.ErrorResponse "Некорректная сумма, счет или недостаточно средств (INVALID_REQUEST, INVALID_DATA, NEGATIVE_BALANCE)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Долг или счет не найден (DEBT_NOT_FOUND, ACCOUNT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /debts/{id}/repayments [post]
+func (h *Handler) AddDebtRepayment(w http.ResponseWriter, r *http.Request) {
+	debtID, err := h.parseIDFromURL(r, "id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID долга", "id")
+		return
+	}
+
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "User not authenticated", models.ErrCodeUnauthorized)
+		return
+	}
+
+	var req models.CreateDebtRepaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	payment, err := h.finClient.AddDebtRepayment(r.Context(), CreateDebtRepaymentRequestToProto(req, debtID, userID))
+	if err != nil {
+		h.handleDebtError(w, r, err, "AddDebtRepayment")
+		return
+	}
+
+	if err := h.publishOperation(r.Context(), userID, int(payment.AccountId), int(payment.OperationId)); err != nil {
+		h.handleDebtError(w, r, err, "AddDebtRepayment")
+		return
+	}
+
+	httputils.Created(w, r, DebtPaymentToAPI(payment))
+}
@@ -0,0 +1,259 @@
+package debt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+func newDebtRequest(method, url string, body any, vars map[string]string) *http.Request {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+	req := httptest.NewRequest(method, url, &buf)
+	if vars != nil {
+		req = mux.SetURLVars(req, vars)
+	}
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestGetCounterparties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	mockImage := mocks.NewMockImageUseCase(ctrl)
+	h := NewHandler(mockClient, mockImage, mocks.NewMockKafkaProducer(ctrl))
+
+	mockClient.EXPECT().
+		GetCounterparties(gomock.Any(), &finpb.UserID{UserId: 1}).
+		Return(&finpb.ListCounterpartiesResponse{Counterparties: []*finpb.Counterparty{
+			{
+				Receiver: &finpb.Receiver{Id: 3, Name: "petr", LogoHashedId: "logo", CounterpartyUserId: 2},
+				Balances: []*finpb.CounterpartyBalance{{CurrencyId: 1, Lent: 7500, Borrowed: 3000, Net: 4500}},
+			},
+		}}, nil)
+	mockImage.EXPECT().GetImageURL(gomock.Any(), "logo").Return("https://cdn/logo", nil)
+
+	rr := httptest.NewRecorder()
+	h.GetCounterparties(rr, newDebtRequest(http.MethodGet, "/api/v1/counterparties", nil, nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.CounterpartiesResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Counterparties, 1)
+	require.Equal(t, 2, resp.Counterparties[0].UserID)
+	require.Equal(t, "https://cdn/logo", resp.Counterparties[0].LogoURL)
+	require.Equal(t, 4500.0, resp.Counterparties[0].Balances[0].Net)
+}
+
+func TestGetDebts_InvalidStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), mocks.NewMockImageUseCase(ctrl), mocks.NewMockKafkaProducer(ctrl))
+
+	rr := httptest.NewRecorder()
+	h.GetDebts(rr, newDebtRequest(http.MethodGet, "/api/v1/debts?status=paid", nil, nil))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetDebts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl), mocks.NewMockKafkaProducer(ctrl))
+
+	mockClient.EXPECT().
+		GetDebts(gomock.Any(), &finpb.ListDebtsRequest{UserId: 1, Status: "open"}).
+		Return(&finpb.ListDebtsResponse{Debts: []*finpb.Debt{
+			{Id: 5, ReceiverId: 3, ReceiverName: "Иван", Direction: "lent", Principal: 10000, Repaid: 2500, Outstanding: 7500, DueDate: timestamppb.Now()},
+		}}, nil)
+
+	rr := httptest.NewRecorder()
+	h.GetDebts(rr, newDebtRequest(http.MethodGet, "/api/v1/debts?status=open", nil, nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.DebtsResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Debts, 1)
+	require.Equal(t, "Иван", resp.Debts[0].CounterpartyName)
+	require.Equal(t, 7500.0, resp.Debts[0].Outstanding)
+	require.NotNil(t, resp.Debts[0].DueDate)
+}
+
+func TestCreateDebt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl), mockKafka)
+
+	mockClient.EXPECT().
+		CreateDebt(gomock.Any(), &finpb.CreateDebtRequest{UserId: 1, CounterpartyLogin: "petr", Direction: "borrowed", AccountId: 7, Principal: 500}).
+		Return(&finpb.Debt{Id: 6, Direction: "borrowed", Principal: 500, Outstanding: 500}, nil)
+	mockClient.EXPECT().
+		GetDebtPayments(gomock.Any(), &finpb.DebtRequest{UserId: 1, DebtId: 6}).
+		Return(&finpb.ListDebtPaymentsResponse{Payments: []*finpb.DebtPayment{
+			{Id: 1, DebtId: 6, OperationId: 41, Kind: "principal", Amount: 500, AccountId: 7},
+		}}, nil)
+	mockClient.EXPECT().
+		GetOperation(gomock.Any(), &finpb.OperationRequest{UserId: 1, AccountId: 7, OperationId: 41}).
+		Return(&finpb.Operation{Id: 41, AccountId: 7, Type: "income", Sum: 500, CreatedAt: timestamppb.Now(), Date: timestamppb.Now()}, nil)
+	mockKafka.EXPECT().
+		WriteMessages(gomock.Any(), gomock.Cond(func(msg kafkautils.KafkaMessage) bool {
+			return msg.Type == models.TRANSACTIONS && bytes.Contains(msg.Payload, []byte(`"id":41`))
+		})).
+		Return(nil)
+
+	rr := httptest.NewRecorder()
+	h.CreateDebt(rr, newDebtRequest(http.MethodPost, "/api/v1/debts", models.CreateDebtRequest{
+		CounterpartyLogin: "petr",
+		Direction:         "borrowed",
+		AccountID:         7,
+		Principal:         500,
+	}, nil))
+	require.Equal(t, http.StatusCreated, rr.Code)
+}
+
+func TestCreateDebt_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl), mocks.NewMockKafkaProducer(ctrl))
+
+	rr := httptest.NewRecorder()
+	h.CreateDebt(rr, newDebtRequest(http.MethodPost, "/api/v1/debts", models.CreateDebtRequest{
+		CounterpartyName: "Иван",
+		Direction:        "gift",
+		AccountID:        7,
+		Principal:        500,
+	}, nil))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	req := models.CreateDebtRequest{CounterpartyLogin: "nobody", Direction: "lent", AccountID: 7, Principal: 500}
+	mockClient.EXPECT().CreateDebt(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeUserNotFound)))
+	rr = httptest.NewRecorder()
+	h.CreateDebt(rr, newDebtRequest(http.MethodPost, "/api/v1/debts", req, nil))
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	var resp models.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, "Пользователь не найден", resp.Error)
+
+	mockClient.EXPECT().CreateDebt(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.FailedPrecondition, string(models.ErrCodeNegaticeBalance)))
+	rr = httptest.NewRecorder()
+	h.CreateDebt(rr, newDebtRequest(http.MethodPost, "/api/v1/debts", req, nil))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetDebt_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl), mocks.NewMockKafkaProducer(ctrl))
+
+	mockClient.EXPECT().
+		GetDebt(gomock.Any(), &finpb.DebtRequest{UserId: 1, DebtId: 5}).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeDebtNotFound)))
+
+	rr := httptest.NewRecorder()
+	h.GetDebt(rr, newDebtRequest(http.MethodGet, "/api/v1/debts/5", nil, map[string]string{"id": "5"}))
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestDeleteDebt_InvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewHandler(mocks.NewMockFinanceServiceClient(ctrl), mocks.NewMockImageUseCase(ctrl), mocks.NewMockKafkaProducer(ctrl))
+
+	rr := httptest.NewRecorder()
+	h.DeleteDebt(rr, newDebtRequest(http.MethodDelete, "/api/v1/debts/abc", nil, map[string]string{"id": "abc"}))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestAddDebtRepayment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	mockKafka := mocks.NewMockKafkaProducer(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl), mockKafka)
+
+	mockClient.EXPECT().
+		AddDebtRepayment(gomock.Any(), &finpb.CreateDebtRepaymentRequest{UserId: 1, DebtId: 5, Amount: 2500}).
+		Return(&finpb.DebtPayment{Id: 9, DebtId: 5, OperationId: 42, Kind: "repayment", Amount: 2500, AccountId: 7, Date: timestamppb.Now(), CreatedAt: timestamppb.Now()}, nil)
+	mockClient.EXPECT().
+		GetOperation(gomock.Any(), &finpb.OperationRequest{UserId: 1, AccountId: 7, OperationId: 42}).
+		Return(&finpb.Operation{Id: 42, AccountId: 7, Type: "income", Sum: 2500, CreatedAt: timestamppb.Now(), Date: timestamppb.Now()}, nil)
+	mockKafka.EXPECT().
+		WriteMessages(gomock.Any(), gomock.Cond(func(msg kafkautils.KafkaMessage) bool {
+			return msg.Type == models.TRANSACTIONS && bytes.Contains(msg.Payload, []byte(`"id":42`))
+		})).
+		Return(nil)
+	mockClient.EXPECT().
+		AddDebtRepayment(gomock.Any(), &finpb.CreateDebtRepaymentRequest{UserId: 1, DebtId: 5, Amount: 9000}).
+		Return(nil, status.Error(codes.InvalidArgument, string(models.ErrCodeInvalidData)))
+
+	rr := httptest.NewRecorder()
+	h.AddDebtRepayment(rr, newDebtRequest(http.MethodPost, "/api/v1/debts/5/repayments",
+		models.CreateDebtRepaymentRequest{Amount: 2500}, map[string]string{"id": "5"}))
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	var resp models.DebtPayment
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 42, resp.OperationID)
+
+	rr = httptest.NewRecorder()
+	h.AddDebtRepayment(rr, newDebtRequest(http.MethodPost, "/api/v1/debts/5/repayments",
+		models.CreateDebtRepaymentRequest{Amount: 9000}, map[string]string{"id": "5"}))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetDebtPayments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockFinanceServiceClient(ctrl)
+	h := NewHandler(mockClient, mocks.NewMockImageUseCase(ctrl), mocks.NewMockKafkaProducer(ctrl))
+
+	mockClient.EXPECT().
+		GetDebtPayments(gomock.Any(), &finpb.DebtRequest{UserId: 1, DebtId: 5}).
+		Return(&finpb.ListDebtPaymentsResponse{Payments: []*finpb.DebtPayment{
+			{Id: 1, DebtId: 5, Kind: "principal", Amount: 10000, Date: timestamppb.Now(), CreatedAt: timestamppb.Now()},
+			{Id: 2, DebtId: 5, Kind: "repayment", Amount: 2500, Reverted: true, Date: timestamppb.Now(), CreatedAt: timestamppb.Now()},
+		}}, nil)
+
+	rr := httptest.NewRecorder()
+	h.GetDebtPayments(rr, newDebtRequest(http.MethodGet, "/api/v1/debts/5/payments", nil, map[string]string{"id": "5"}))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.DebtPaymentsResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Payments, 2)
+	require.True(t, resp.Payments[1].Reverted)
+}
@@ -0,0 +1,127 @@
+package debt
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func DebtToAPI(d *finpb.Debt) models.Debt {
+	debt := models.Debt{
+		ID:                 int(d.Id),
+		CounterpartyID:     int(d.ReceiverId),
+		CounterpartyName:   d.ReceiverName,
+		CounterpartyUserID: int(d.CounterpartyUserId),
+		Direction:          d.Direction,
+		AccountID:          int(d.AccountId),
+		CurrencyID:         int(d.CurrencyId),
+		Principal:          d.Principal,
+		Repaid:             d.Repaid,
+		Outstanding:        d.Outstanding,
+		Description:        d.Description,
+		Closed:             d.Closed,
+		Overdue:            d.Overdue,
+		CreatedAt:          d.CreatedAt.AsTime(),
+		UpdatedAt:          d.UpdatedAt.AsTime(),
+	}
+	if d.DueDate != nil {
+		dueDate := d.DueDate.AsTime()
+		debt.DueDate = &dueDate
+	}
+	return debt
+}
+
+func DebtsToAPI(debts *finpb.ListDebtsResponse) models.DebtsResponse {
+	res := models.DebtsResponse{Debts: make([]models.Debt, 0, len(debts.Debts))}
+	for _, d := range debts.Debts {
+		res.Debts = append(res.Debts, DebtToAPI(d))
+	}
+	return res
+}
+
+func DebtPaymentToAPI(p *finpb.DebtPayment) models.DebtPayment {
+	return models.DebtPayment{
+		ID:          int(p.Id),
+		DebtID:      int(p.DebtId),
+		OperationID: int(p.OperationId),
+		Kind:        p.Kind,
+		Amount:      p.Amount,
+		AccountID:   int(p.AccountId),
+		Reverted:    p.Reverted,
+		Date:        p.Date.AsTime(),
+		CreatedAt:   p.CreatedAt.AsTime(),
+	}
+}
+
+func DebtPaymentsToAPI(payments *finpb.ListDebtPaymentsResponse) models.DebtPaymentsResponse {
+	res := models.DebtPaymentsResponse{Payments: make([]models.DebtPayment, 0, len(payments.Payments))}
+	for _, p := range payments.Payments {
+		res.Payments = append(res.Payments, DebtPaymentToAPI(p))
+	}
+	return res
+}
+
+func CounterpartyToAPI(c *finpb.Counterparty) models.Counterparty {
+	counterparty := models.Counterparty{
+		ID:       int(c.Receiver.GetId()),
+		Name:     c.Receiver.GetName(),
+		UserID:   int(c.Receiver.GetCounterpartyUserId()),
+		Balances: make([]models.CounterpartyBalance, 0, len(c.Balances)),
+	}
+	for _, b := range c.Balances {
+		counterparty.Balances = append(counterparty.Balances, models.CounterpartyBalance{
+			CurrencyID: int(b.CurrencyId),
+			Lent:       b.Lent,
+			Borrowed:   b.Borrowed,
+			Net:        b.Net,
+		})
+	}
+	return counterparty
+}
+
+func CounterpartiesToAPI(counterparties *finpb.ListCounterpartiesResponse) models.CounterpartiesResponse {
+	res := models.CounterpartiesResponse{Counterparties: make([]models.Counterparty, 0, len(counterparties.Counterparties))}
+	for _, c := range counterparties.Counterparties {
+		res.Counterparties = append(res.Counterparties, CounterpartyToAPI(c))
+	}
+	return res
+}
+
+func optionalTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func CreateDebtRequestToProto(req models.CreateDebtRequest, userID int) *finpb.CreateDebtRequest {
+	return &finpb.CreateDebtRequest{
+		UserId:            int32(userID),
+		ReceiverId:        int32(req.CounterpartyID),
+		ReceiverName:      req.CounterpartyName,
+		CounterpartyLogin: req.CounterpartyLogin,
+		Direction:         req.Direction,
+		AccountId:         int32(req.AccountID),
+		Principal:         req.Principal,
+		Description:       req.Description,
+		DueDate:           optionalTimeToProto(req.DueDate),
+		Date:              optionalTimeToProto(req.Date),
+	}
+}
+
+func CreateDebtRepaymentRequestToProto(req models.CreateDebtRepaymentRequest, debtID, userID int) *finpb.CreateDebtRepaymentRequest {
+	return &finpb.CreateDebtRepaymentRequest{
+		UserId:    int32(userID),
+		DebtId:    int32(debtID),
+		AccountId: int32(req.AccountID),
+		Amount:    req.Amount,
+		Date:      optionalTimeToProto(req.Date),
+	}
+}
+
+func IDsToDebtRequest(debtID, userID int) *finpb.DebtRequest {
+	return &finpb.DebtRequest{UserId: int32(userID), DebtId: int32(debtID)}
+}
@@ -0,0 +1,23 @@
+package debt
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	image "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/usecase"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+func Register(r *mux.Router, finClient finpb.FinanceServiceClient, imageUC image.ImageUseCase, kafkaProducer kafkautils.KafkaProducer) {
+	handler := NewHandler(finClient, imageUC, kafkaProducer)
+
+	r.HandleFunc("/counterparties", handler.GetCounterparties).Methods(http.MethodGet)
+	r.HandleFunc("/debts", handler.GetDebts).Methods(http.MethodGet)
+	r.HandleFunc("/debts", handler.CreateDebt).Methods(http.MethodPost)
+	r.HandleFunc("/debts/{id}", handler.GetDebt).Methods(http.MethodGet)
+	r.HandleFunc("/debts/{id}", handler.DeleteDebt).Methods(http.MethodDelete)
+	r.HandleFunc("/debts/{id}/payments", handler.GetDebtPayments).Methods(http.MethodGet)
+	r.HandleFunc("/debts/{id}/repayments", handler.AddDebtRepayment).Methods(http.MethodPost)
+}
@@ -202,3 +202,46 @@ func ProtoGetOperationsRequest(
 		Date:          ts,
 	}
 }
+
+func SplitRequestToProto(userID, accID, opID int, req models.SplitOperationRequest) *finpb.SplitOperationRequest {
+	shares := make([]*finpb.SplitShare, 0, len(req.Shares))
+	for _, share := range req.Shares {
+		shares = append(shares, &finpb.SplitShare{
+			UserId: int32(share.UserID),
+			Value:  share.Value,
+		})
+	}
+	return &finpb.SplitOperationRequest{
+		UserId:      int32(userID),
+		AccountId:   int32(accID),
+		OperationId: int32(opID),
+		PayerId:     int32(req.PayerID),
+		Method:      req.Method,
+		Shares:      shares,
+	}
+}
+
+func ProtoSplitToResponse(split *finpb.OperationSplit) models.OperationSplitResponse {
+	shares := make([]models.SplitShareResponse, 0, len(split.Shares))
+	for _, share := range split.Shares {
+		resp := models.SplitShareResponse{
+			UserID:    int(share.UserId),
+			UserLogin: share.UserLogin,
+			Amount:    share.Amount,
+		}
+		if split.Method != "equal" {
+			resp.Value = share.Value
+		}
+		shares = append(shares, resp)
+	}
+	return models.OperationSplitResponse{
+		OperationID: int(split.OperationId),
+		AccountID:   int(split.AccountId),
+		PayerID:     int(split.PayerId),
+		PayerLogin:  split.PayerLogin,
+		Method:      split.Method,
+		Total:       split.Total,
+		Shares:      shares,
+		CreatedAt:   split.CreatedAt.AsTime(),
+	}
+}
@@ -108,7 +108,7 @@ func (h *Handler) GetAccountOperations(w http.ResponseWriter, r *http.Request) {
 	// ops, err := h.opUC.GetAccountOperations(r.Context(), accID)
 	ops, err := h.finClient.GetOperationsByAccount(r.Context(), ProtoGetOperationsRequest(id, accID, urlQueries))
 	if err != nil {
-		_, ok := status.FromError(err)
+		st, ok := status.FromError(err)
 		log := logger.FromContext(r.Context())
 		if !ok {
 			if log != nil {
@@ -117,6 +117,10 @@ func (h *Handler) GetAccountOperations(w http.ResponseWriter, r *http.Request) {
 			httputils.InternalError(w, r, "failed to get operations")
 			return
 		}
+		if st.Code() == codes.NotFound {
+			httputils.NotFoundError(w, r, "Счет не найден")
+			return
+		}
 
 		if log != nil {
 			log.Error("grpc GetOperationsByAccount error", "error", err)
@@ -714,6 +718,22 @@ func (h *Handler) UploadCVSData(w http.ResponseWriter, r *http.Request) {
 			sum, _ = strconv.ParseFloat(row[5], 64)
 		}
 
+		if ctgID == 0 {
+			suggestion, err := h.finClient.SuggestCategory(r.Context(), &finpb.SuggestCategoryRequest{
+				UserId: int32(userID),
+				Name:   row[4],
+				Sum:    sum,
+				Type:   opType,
+			})
+			if err != nil {
+				if log != nil {
+					log.Warn("grpc SuggestCategory error", "error", err)
+				}
+			} else if suggestion.Found {
+				ctgID = int(suggestion.CategoryId)
+			}
+		}
+
 		date := row[0]
 		t, err := time.Parse("2006-01-02", date)
 		if err != nil {
@@ -30,4 +30,7 @@ func Register(r *mux.Router, finClient finpb.FinanceServiceClient, imageUC image
 	r.HandleFunc("/account/{acc_id}/operations/{op_id}", handler.GetOperationByID).Methods(http.MethodGet)
 	r.HandleFunc("/account/{acc_id}/operations/{op_id}", handler.UpdateOperation).Methods(http.MethodPut)
 	r.HandleFunc("/account/{acc_id}/operations/{op_id}", handler.DeleteOperation).Methods(http.MethodDelete)
+	r.HandleFunc("/account/{acc_id}/operations/{op_id}/split", handler.GetOperationSplit).Methods(http.MethodGet)
+	r.HandleFunc("/account/{acc_id}/operations/{op_id}/split", handler.SplitOperation).Methods(http.MethodPut)
+	r.HandleFunc("/account/{acc_id}/operations/{op_id}/split", handler.DeleteOperationSplit).Methods(http.MethodDelete)
 }
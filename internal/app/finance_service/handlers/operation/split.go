@@ -0,0 +1,168 @@
+package operation
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+func (h *Handler) handleSplitError(w http.ResponseWriter, r *http.Request, err error, method string) {
+	log := logger.FromContext(r.Context())
+	st, ok := status.FromError(err)
+	if !ok {
+		if log != nil {
+			log.Error("grpc "+method+" unknown error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to split operation")
+		return
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		httputils.Error(w, r, "Некорректное разделение: доли должны принадлежать участникам счета и в сумме давать сумму расхода или 100%", http.StatusBadRequest)
+	case codes.PermissionDenied:
+		httputils.Error(w, r, "Недостаточно прав для операций по счету", http.StatusForbidden)
+	case codes.NotFound:
+		// Не найдена может быть операция, счет или разделение — код ошибки в сообщении
+		httputils.NotFoundError(w, r, models.ErrorCode(st.Message()).GetErrorMessage())
+	default:
+		if log != nil {
+			log.Error("grpc "+method+" error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to split operation")
+	}
+}
+
+func (h *Handler) parseOperationPath(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	accID, err := h.parseIDFromURL(r, "acc_id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID счета", "acc_id")
+		return 0, 0, false
+	}
+	opID, err := h.parseIDFromURL(r, "op_id")
+	if err != nil {
+		httputils.ValidationError(w, r, "Некорректный ID операции", "op_id")
+		return 0, 0, false
+	}
+	return accID, opID, true
+}
+
+// SplitOperation godoc
+// @Summary Разделение расхода между участниками счета
+// @Description Делит расход совместного счета поровну (equal), точными суммами (exact) или процентами (percent). Заменяет прежнее разделение. Без shares при делении поровну расход делится на всех участников, без payer_id плательщиком считается текущий пользователь
+// @Tags operations
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param acc_id path int true "ID счета"
+// @Param op_id path int true "ID операции"
+// @Param request body models.SplitOperationRequest true "Плательщик, способ и доли"
+// @Success 200 {object} models.OperationSplitResponse "Разделение расхода"
+// @Failure 400 {object} models.ErrorResponse "Некорректное разделение (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Недостаточно прав для операций по счету"
+// @Failure 404 {object} models.ErrorResponse "Операция или счет не найдены (OPERATION_NOT_FOUND, ACCOUNT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /account/{acc_id}/operations/{op_id}/split [put]
+func (h *Handler) SplitOperation(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "Требуется авторизация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	accID, opID, ok := h.parseOperationPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.SplitOperationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	split, err := h.finClient.SplitOperation(r.Context(), SplitRequestToProto(userID, accID, opID, req))
+	if err != nil {
+		h.handleSplitError(w, r, err, "SplitOperation")
+		return
+	}
+
+	httputils.Success(w, r, ProtoSplitToResponse(split))
+}
+
+// GetOperationSplit godoc
+// @Summary Разделение расхода
+// @Description Возвращает доли участников в расходе. Доли считаются от текущей суммы операции
+// @Tags operations
+// @Produce json
+// @Security ApiKeyAuth
+// @Param acc_id path int true "ID счета"
+// @Param op_id path int true "ID операции"
+// @Success 200 {object} models.OperationSplitResponse "Разделение расхода"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Операция не разделена (SPLIT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /account/{acc_id}/operations/{op_id}/split [get]
+func (h *Handler) GetOperationSplit(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "Требуется авторизация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	accID, opID, ok := h.parseOperationPath(w, r)
+	if !ok {
+		return
+	}
+
+	split, err := h.finClient.GetOperationSplit(r.Context(), OperationAndUserIDToProtoID(opID, accID, userID))
+	if err != nil {
+		h.handleSplitError(w, r, err, "GetOperationSplit")
+		return
+	}
+
+	httputils.Success(w, r, ProtoSplitToResponse(split))
+}
+
+// DeleteOperationSplit godoc
+// @Summary Отмена разделения расхода
+// @Description Удаляет разделение расхода, операция остается без изменений
+// @Tags operations
+// @Produce json
+// @Security ApiKeyAuth
+// @Param acc_id path int true "ID счета"
+// @Param op_id path int true "ID операции"
+// @Success 200 {object} models.OperationSplitResponse "Удаленное разделение"
+// @Failure 400 {object} models.ErrorResponse "Некорректный ID (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 403 {object} models.ErrorResponse "Недостаточно прав для операций по счету"
+// @Failure 404 {object} models.ErrorResponse "Операция не разделена (SPLIT_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /account/{acc_id}/operations/{op_id}/split [delete]
+func (h *Handler) DeleteOperationSplit(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.UnauthorizedError(w, r, "Требуется авторизация", models.ErrCodeUnauthorized)
+		return
+	}
+
+	accID, opID, ok := h.parseOperationPath(w, r)
+	if !ok {
+		return
+	}
+
+	split, err := h.finClient.DeleteOperationSplit(r.Context(), OperationAndUserIDToProtoID(opID, accID, userID))
+	if err != nil {
+		h.handleSplitError(w, r, err, "DeleteOperationSplit")
+		return
+	}
+
+	httputils.Success(w, r, ProtoSplitToResponse(split))
+}
@@ -0,0 +1,100 @@
+package operation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func splitRequest(t *testing.T, method string, body any) *http.Request {
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	req := httptest.NewRequest(method, "/account/1/operations/7/split", &buf)
+	req = mux.SetURLVars(req, map[string]string{"acc_id": "1", "op_id": "7"})
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestSplitOperation_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, mocks.NewMockImageUseCase(ctrl), nil, clock.RealClock{})
+
+	mockFin.EXPECT().
+		SplitOperation(gomock.Any(), &finpb.SplitOperationRequest{
+			UserId: 1, AccountId: 1, OperationId: 7, Method: "percent",
+			Shares: []*finpb.SplitShare{{UserId: 1, Value: 40}, {UserId: 2, Value: 60}},
+		}).
+		Return(&finpb.OperationSplit{
+			OperationId: 7, AccountId: 1, PayerId: 1, Method: "percent", Total: 50,
+			Shares: []*finpb.SplitShare{
+				{UserId: 1, UserLogin: "ivan", Value: 40, Amount: 20},
+				{UserId: 2, UserLogin: "anna", Value: 60, Amount: 30},
+			},
+			CreatedAt: timestamppb.Now(),
+		}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.SplitOperation(rr, splitRequest(t, http.MethodPut, models.SplitOperationRequest{
+		Method: "percent",
+		Shares: []models.SplitShareRequest{{UserID: 1, Value: 40}, {UserID: 2, Value: 60}},
+	}))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp models.OperationSplitResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Len(t, resp.Shares, 2)
+	require.Equal(t, 30.0, resp.Shares[1].Amount)
+}
+
+func TestSplitOperation_InvalidShares(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, mocks.NewMockImageUseCase(ctrl), nil, clock.RealClock{})
+
+	mockFin.EXPECT().
+		SplitOperation(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.InvalidArgument, string(models.ErrCodeInvalidData)))
+
+	rr := httptest.NewRecorder()
+	handler.SplitOperation(rr, splitRequest(t, http.MethodPut, models.SplitOperationRequest{Method: "exact"}))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetOperationSplit_NotSplit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFin := mocks.NewMockFinanceServiceClient(ctrl)
+	handler := NewHandler(mockFin, mocks.NewMockImageUseCase(ctrl), nil, clock.RealClock{})
+
+	mockFin.EXPECT().
+		GetOperationSplit(gomock.Any(), &finpb.OperationRequest{UserId: 1, AccountId: 1, OperationId: 7}).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeSplitNotFound)))
+
+	rr := httptest.NewRecorder()
+	handler.GetOperationSplit(rr, splitRequest(t, http.MethodGet, nil))
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -9,6 +9,7 @@ type Account struct {
 	Name        string
 	Description *string
 	CurrencyID  int
+	Role        SharingRole
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -30,9 +31,44 @@ type UpdateAccountRequest struct {
 	Balance     *float64
 }
 
+// SharingRole роль участника счета.
+// owner — единственный владелец, управляет счетом и участниками;
+// editor — ведет операции; viewer — только просматривает.
+type SharingRole string
+
+const (
+	RoleOwner  SharingRole = "owner"
+	RoleEditor SharingRole = "editor"
+	RoleViewer SharingRole = "viewer"
+)
+
+func (r SharingRole) Valid() bool {
+	return r == RoleOwner || r == RoleEditor || r == RoleViewer
+}
+
+// CanEditOperations может ли участник создавать, изменять и удалять операции
+func (r SharingRole) CanEditOperations() bool {
+	return r == RoleOwner || r == RoleEditor
+}
+
+// CanManage может ли участник изменять и удалять счет, управлять участниками
+func (r SharingRole) CanManage() bool {
+	return r == RoleOwner
+}
+
 type SharingAccount struct {
 	ID        int
 	UserID    int
+	UserLogin string
 	AccountID int
+	Role      SharingRole
 	CreatedAt time.Time
 }
+
+// AccountMemberRequest действие владельца счета над участником MemberID
+type AccountMemberRequest struct {
+	UserID    int
+	AccountID int
+	MemberID  int
+	Role      SharingRole
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// Receiver получатель платежей пользователя, он же контрагент по долгам.
+// CounterpartyUserID — связанный зарегистрированный пользователь, 0 если не связан.
+type Receiver struct {
+	ID                 int
+	UserID             int
+	Name               string
+	LogoHashedID       string
+	CounterpartyUserID int
+	CreatedAt          time.Time
+}
+
+type UserData struct {
+	Accounts   []Account
+	Categories []Category
+	Operations []Operation
+	Receivers  []Receiver
+	// Sharings остальные участники счетов, которыми владеет пользователь
+	Sharings []SharingAccount
+}
+
+type ImportUserDataRequest struct {
+	UserID   int
+	BackupID string
+	Data     UserData
+}
+
+type ImportUserDataResult struct {
+	AccountsRestored   int
+	CategoriesRestored int
+	OperationsRestored int
+	ReceiversRestored  int
+	RestoredOperations []Operation
+}
+
+// UserDataDeletion итог удаления данных пользователя: счета, где он был
+// единственным участником, удалены, из совместных он вышел.
+type UserDataDeletion struct {
+	DeletedAccountIDs []int
+	LeftAccountIDs    []int
+	// UncategorizedOperations операции совместных счетов, у которых сброшена
+	// категория удаленного пользователя
+	UncategorizedOperations []Operation
+}
@@ -5,6 +5,7 @@ import "time"
 type Category struct {
 	ID           int
 	UserID       int
+	ParentID     *int // nil у корневой; при обновлении nil — не менять, 0 — сделать корневой
 	Name         string
 	Description  string
 	LogoHashedID string
@@ -15,6 +16,7 @@ type Category struct {
 
 type CreateCategoryRequest struct {
 	UserID       int
+	ParentID     *int
 	Name         string
 	Description  string
 	LogoHashedID string
@@ -23,6 +25,7 @@ type CreateCategoryRequest struct {
 type UpdateCategoryRequest struct {
 	UserID       int
 	CategoryID   int
+	ParentID     *int
 	Name         *string
 	Description  *string
 	LogoHashedID *string
@@ -30,7 +33,8 @@ type UpdateCategoryRequest struct {
 
 type CategoryWithStats struct {
 	Category
-	OperationsCount int
+	OperationsCount    int // вместе с подкатегориями
+	OwnOperationsCount int
 }
 
 type CategoryReportRequest struct {
@@ -40,8 +44,20 @@ type CategoryReportRequest struct {
 }
 
 type CategoryInReport struct {
-	CategoryID     int
-	CategoryName   string
-	OperationCount int
-	TotalSum       float64
+	CategoryID        int
+	ParentID          int
+	CategoryName      string
+	OperationCount    int     // вместе с подкатегориями
+	TotalSum          float64 // вместе с подкатегориями
+	OwnOperationCount int
+	OwnSum            float64
+}
+
+type MergeCategoriesRequest struct {
+	UserID   int
+	SourceID int
+	TargetID int
+	// DetachTarget переносит target на уровень source перед слиянием,
+	// если target вложена в source, иначе подкатегории source образовали бы цикл.
+	DetachTarget bool
 }
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+type DebtDirection string
+
+const (
+	DebtLent     DebtDirection = "lent"     // пользователь дал в долг
+	DebtBorrowed DebtDirection = "borrowed" // пользователь взял в долг
+)
+
+type DebtPaymentKind string
+
+const (
+	DebtPaymentPrincipal DebtPaymentKind = "principal"
+	DebtPaymentRepayment DebtPaymentKind = "repayment"
+)
+
+// Debt долг пользователя перед контрагентом или контрагента перед пользователем.
+// Repaid — сумма неотмененных возвратов.
+type Debt struct {
+	ID                 int
+	UserID             int
+	ReceiverID         int
+	ReceiverName       string
+	CounterpartyUserID int
+	Direction          DebtDirection
+	AccountID          int
+	CurrencyID         int
+	Principal          float64
+	Repaid             float64
+	Description        string
+	DueDate            *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Outstanding непогашенный остаток долга.
+func (d Debt) Outstanding() float64 {
+	return math.Max(math.Round((d.Principal-d.Repaid)*100)/100, 0)
+}
+
+func (d Debt) Closed() bool {
+	return d.Outstanding() == 0
+}
+
+// PrincipalType тип операции выдачи или получения долга.
+func (d Debt) PrincipalType() OperationType {
+	if d.Direction == DebtBorrowed {
+		return OperationIncome
+	}
+	return OperationExpense
+}
+
+// RepaymentType тип операции возврата долга, противоположный выдаче.
+func (d Debt) RepaymentType() OperationType {
+	if d.Direction == DebtBorrowed {
+		return OperationExpense
+	}
+	return OperationIncome
+}
+
+// DebtPayment операция, связанная с долгом.
+type DebtPayment struct {
+	ID          int
+	DebtID      int
+	OperationID int
+	Kind        DebtPaymentKind
+	Amount      float64
+	AccountID   int
+	Reverted    bool
+	Date        time.Time
+	CreatedAt   time.Time
+}
+
+// CounterpartyBalance непогашенные долги по контрагенту в одной валюте.
+// Lent — сколько должен контрагент, Borrowed — сколько должен пользователь.
+type CounterpartyBalance struct {
+	CurrencyID int
+	Lent       float64
+	Borrowed   float64
+}
+
+func (b CounterpartyBalance) Net() float64 {
+	return math.Round((b.Lent-b.Borrowed)*100) / 100
+}
+
+type Counterparty struct {
+	Receiver
+	Balances []CounterpartyBalance
+}
+
+// CreateDebtRequest контрагент задается одним из полей: ReceiverID, ReceiverName
+// (получатель создается, если его нет) или CounterpartyLogin.
+type CreateDebtRequest struct {
+	UserID            int
+	ReceiverID        int
+	ReceiverName      string
+	CounterpartyLogin string
+	Direction         DebtDirection
+	AccountID         int
+	Principal         float64
+	Description       string
+	DueDate           *time.Time
+	Date              *time.Time
+}
+
+// CreateDebtRepaymentRequest нулевой AccountID — счет, с которым создан долг.
+type CreateDebtRepaymentRequest struct {
+	UserID    int
+	DebtID    int
+	AccountID int
+	Amount    float64
+	Date      *time.Time
+}
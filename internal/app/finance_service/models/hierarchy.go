@@ -0,0 +1,91 @@
+package models
+
+// CategoryParents строит отображение категории в ее родителя (0 у корневых).
+func CategoryParents(categories []Category) map[int]int {
+	parents := make(map[int]int, len(categories))
+	for _, ctg := range categories {
+		parents[ctg.ID] = 0
+		if ctg.ParentID != nil {
+			parents[ctg.ID] = *ctg.ParentID
+		}
+	}
+	return parents
+}
+
+// CategoryAncestors возвращает цепочку предков начиная с непосредственного родителя.
+// Повторно встреченная категория обрывает цепочку, поэтому испорченные данные
+// не приводят к бесконечному циклу.
+func CategoryAncestors(parents map[int]int, categoryID int) []int {
+	var ancestors []int
+	visited := map[int]bool{categoryID: true}
+	for id := parents[categoryID]; id != 0 && !visited[id]; id = parents[id] {
+		visited[id] = true
+		ancestors = append(ancestors, id)
+	}
+	return ancestors
+}
+
+// CreatesCategoryCycle сообщает, образуется ли цикл, если сделать parentID родителем categoryID.
+func CreatesCategoryCycle(parents map[int]int, categoryID, parentID int) bool {
+	if parentID == categoryID {
+		return true
+	}
+	for _, id := range CategoryAncestors(parents, parentID) {
+		if id == categoryID {
+			return true
+		}
+	}
+	return false
+}
+
+// RollUpCategoryStats добавляет количество операций подкатегорий к их предкам.
+func RollUpCategoryStats(categories []CategoryWithStats) []CategoryWithStats {
+	plain := make([]Category, 0, len(categories))
+	index := make(map[int]int, len(categories))
+	for i, ctg := range categories {
+		plain = append(plain, ctg.Category)
+		index[ctg.ID] = i
+	}
+	parents := CategoryParents(plain)
+
+	result := make([]CategoryWithStats, len(categories))
+	for i, ctg := range categories {
+		ctg.OwnOperationsCount = ctg.OperationsCount
+		result[i] = ctg
+	}
+	for _, ctg := range categories {
+		for _, ancestor := range CategoryAncestors(parents, ctg.ID) {
+			if i, ok := index[ancestor]; ok {
+				result[i].OperationsCount += ctg.OperationsCount
+			}
+		}
+	}
+	return result
+}
+
+// RollUpCategoryReport добавляет суммы и количество операций подкатегорий к их предкам,
+// сохраняя собственные значения каждой категории в Own*.
+func RollUpCategoryReport(items []CategoryInReport) []CategoryInReport {
+	parents := make(map[int]int, len(items))
+	index := make(map[int]int, len(items))
+	for i, item := range items {
+		parents[item.CategoryID] = item.ParentID
+		index[item.CategoryID] = i
+	}
+
+	result := make([]CategoryInReport, len(items))
+	for i, item := range items {
+		item.OwnOperationCount = item.OperationCount
+		item.OwnSum = item.TotalSum
+		result[i] = item
+	}
+	for _, item := range items {
+		for _, ancestor := range CategoryAncestors(parents, item.CategoryID) {
+			if i, ok := index[ancestor]; ok {
+				result[i].OperationCount += item.OperationCount
+				result[i].TotalSum += item.TotalSum
+			}
+		}
+	}
+	return result
+}
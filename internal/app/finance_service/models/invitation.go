@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationDeclined InvitationStatus = "declined"
+	InvitationRevoked  InvitationStatus = "revoked"
+)
+
+// AccountInvitation приглашение в совместный счет.
+// Приглашение по ссылке не адресовано пользователю: InviteeID равен 0,
+// пока ссылку не примут. Token заполняется только при создании ссылки,
+// в базе хранится лишь его хеш.
+type AccountInvitation struct {
+	ID           int
+	AccountID    int
+	AccountName  string
+	InviterID    int
+	InviterLogin string
+	InviteeID    int
+	InviteeLogin string
+	Role         SharingRole
+	Status       InvitationStatus
+	Token        string
+	TokenHash    string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+// IsLink приглашение по ссылке
+func (i AccountInvitation) IsLink() bool {
+	return i.TokenHash != ""
+}
+
+// CreateInvitationRequest пустой UserLogin — приглашение по ссылке
+type CreateInvitationRequest struct {
+	UserID    int
+	AccountID int
+	UserLogin string
+	Role      SharingRole
+}
+
+type InvitationRequest struct {
+	UserID       int
+	InvitationID int
+}
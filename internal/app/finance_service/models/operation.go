@@ -26,6 +26,7 @@ const (
 type Operation struct {
 	ID           int
 	AccountID    int
+	AccountToID  int // счет зачисления перевода, 0 для остальных операций
 	CategoryID   int
 	CategoryName string
 	Type         OperationType
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// CategoryRule правило автоматической категоризации операции.
+// Пустые условия не проверяются, заданные объединяются через "и".
+type CategoryRule struct {
+	ID            int
+	UserID        int
+	CategoryID    int
+	Priority      int
+	NameContains  string
+	OperationType OperationType
+	AmountGT      *float64
+	AmountLT      *float64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type CreateCategoryRuleRequest struct {
+	UserID        int
+	CategoryID    int
+	NameContains  string
+	OperationType OperationType
+	AmountGT      *float64
+	AmountLT      *float64
+}
+
+type UpdateCategoryRuleRequest struct {
+	UserID        int
+	RuleID        int
+	CategoryID    int
+	NameContains  string
+	OperationType OperationType
+	AmountGT      *float64
+	AmountLT      *float64
+}
+
+func (r CategoryRule) HasConditions() bool {
+	return r.NameContains != "" || r.OperationType != "" || r.AmountGT != nil || r.AmountLT != nil
+}
+
+func (r CategoryRule) Matches(name string, sum float64, opType OperationType) bool {
+	if !r.HasConditions() {
+		return false
+	}
+	if r.NameContains != "" && !strings.Contains(normalizeRuleText(name), normalizeRuleText(r.NameContains)) {
+		return false
+	}
+	if r.OperationType != "" && r.OperationType != opType {
+		return false
+	}
+	if r.AmountGT != nil && !(sum > *r.AmountGT) {
+		return false
+	}
+	if r.AmountLT != nil && !(sum < *r.AmountLT) {
+		return false
+	}
+	return true
+}
+
+// normalizeRuleText приводит текст к нижнему регистру, заменяет "ё" на "е"
+// и схлопывает пробелы, чтобы "Пятёрочка" и " ПЯТЕРОЧКА" совпадали.
+func normalizeRuleText(s string) string {
+	s = strings.ReplaceAll(strings.ToLower(s), "ё", "е")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// MatchCategoryRule возвращает первое по порядку правило, подходящее под операцию.
+// Ожидается, что rules уже отсортированы по приоритету.
+func MatchCategoryRule(rules []CategoryRule, name string, sum float64, opType OperationType) (CategoryRule, bool) {
+	for _, rule := range rules {
+		if rule.Matches(name, sum, opType) {
+			return rule, true
+		}
+	}
+	return CategoryRule{}, false
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// SpendingStatsRequest расходы пользователя в валюте CurrencyID по категориям и счетам.
+// Пустые CategoryIDs и AccountIDs — все категории и счета, подкатегории учитываются.
+type SpendingStatsRequest struct {
+	UserID       int
+	CurrencyID   int
+	CategoryIDs  []int
+	AccountIDs   []int
+	Start        time.Time
+	End          time.Time
+	HistoryStart time.Time
+}
+
+type DailySpending struct {
+	Date time.Time
+	Sum  float64
+}
+
+// RecurringExpense расход с одинаковым названием и категорией, встречавшийся в нескольких месяцах.
+type RecurringExpense struct {
+	Name       string
+	CategoryID int
+	Months     int
+	AvgSum     float64
+	DayOfMonth int // типичное число месяца
+	LastDate   time.Time
+	LastSum    float64
+}
+
+// SpendingTotals расходы за период: всего и по каждой запрошенной категории
+// вместе с ее подкатегориями.
+type SpendingTotals struct {
+	Total      float64
+	ByCategory map[int]float64
+}
+
+type SpendingStats struct {
+	Days      []DailySpending
+	Recurring []RecurringExpense
+}
@@ -0,0 +1,92 @@
+package models
+
+import "time"
+
+type SplitMethod string
+
+const (
+	SplitEqual   SplitMethod = "equal"   // поровну
+	SplitExact   SplitMethod = "exact"   // точными суммами
+	SplitPercent SplitMethod = "percent" // процентами
+)
+
+func (m SplitMethod) Valid() bool {
+	return m == SplitEqual || m == SplitExact || m == SplitPercent
+}
+
+// SplitShare доля участника. Value — введенное значение: сумма для exact,
+// процент для percent, 1 для equal. Amount вычисляется от суммы операции.
+type SplitShare struct {
+	UserID    int
+	UserLogin string
+	Value     float64
+	Amount    float64
+}
+
+// OperationSplit разделение расхода: PayerID оплатил, участники должны ему свои доли
+type OperationSplit struct {
+	ID          int
+	OperationID int
+	AccountID   int
+	PayerID     int
+	PayerLogin  string
+	Method      SplitMethod
+	Total       float64
+	Shares      []SplitShare
+	CreatedAt   time.Time
+}
+
+type SplitOperationRequest struct {
+	UserID      int
+	AccountID   int
+	OperationID int
+	PayerID     int
+	Method      SplitMethod
+	Shares      []SplitShare
+}
+
+// Settlement погашение долга: PayerID передал PayeeID сумму Amount
+type Settlement struct {
+	ID          int
+	AccountID   int
+	PayerID     int
+	PayerLogin  string
+	PayeeID     int
+	PayeeLogin  string
+	Amount      float64
+	Description string
+	CreatedBy   int
+	CreatedAt   time.Time
+}
+
+type CreateSettlementRequest struct {
+	UserID      int
+	AccountID   int
+	PayerID     int
+	PayeeID     int
+	Amount      float64
+	Description string
+}
+
+// MemberBalance Net > 0 — участнику должны, Net < 0 — должен он
+type MemberBalance struct {
+	UserID    int
+	UserLogin string
+	Net       float64
+}
+
+// PairBalance DebtorID должен CreditorID сумму Amount
+type PairBalance struct {
+	DebtorID      int
+	DebtorLogin   string
+	CreditorID    int
+	CreditorLogin string
+	Amount        float64
+}
+
+type AccountBalances struct {
+	AccountID  int
+	CurrencyID int
+	Members    []MemberBalance
+	Debts      []PairBalance
+}
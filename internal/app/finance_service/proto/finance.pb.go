@@ -32,6 +32,7 @@ type Account struct {
 	CurrencyId    int32                  `protobuf:"varint,6,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Role          string                 `protobuf:"bytes,9,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,6 +123,13 @@ func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Account) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 type CreateAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -334,28 +342,42 @@ func (x *AccountRequest) GetAccountId() int32 {
 	return 0
 }
 
-type AddToAccountReqeust struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserLogin     string                 `protobuf:"bytes,1,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
-	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+type AccountInvitation struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId    int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountName  string                 `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	InviterId    int32                  `protobuf:"varint,4,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	InviterLogin string                 `protobuf:"bytes,5,opt,name=inviter_login,json=inviterLogin,proto3" json:"inviter_login,omitempty"`
+	// empty for a link invitation until it is accepted
+	InviteeId    int32  `protobuf:"varint,6,opt,name=invitee_id,json=inviteeId,proto3" json:"invitee_id,omitempty"`
+	InviteeLogin string `protobuf:"bytes,7,opt,name=invitee_login,json=inviteeLogin,proto3" json:"invitee_login,omitempty"`
+	// editor or viewer
+	Role string `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	// pending, accepted, declined or revoked
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// single-use link token, returned only when a link invitation is created
+	Token         string                 `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddToAccountReqeust) Reset() {
-	*x = AddToAccountReqeust{}
+func (x *AccountInvitation) Reset() {
+	*x = AccountInvitation{}
 	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddToAccountReqeust) String() string {
+func (x *AccountInvitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToAccountReqeust) ProtoMessage() {}
+func (*AccountInvitation) ProtoMessage() {}
 
-func (x *AddToAccountReqeust) ProtoReflect() protoreflect.Message {
+func (x *AccountInvitation) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -367,46 +389,120 @@ func (x *AddToAccountReqeust) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToAccountReqeust.ProtoReflect.Descriptor instead.
-func (*AddToAccountReqeust) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountInvitation.ProtoReflect.Descriptor instead.
+func (*AccountInvitation) Descriptor() ([]byte, []int) {
 	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AddToAccountReqeust) GetUserLogin() string {
+func (x *AccountInvitation) GetId() int32 {
 	if x != nil {
-		return x.UserLogin
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *AddToAccountReqeust) GetAccountId() int32 {
+func (x *AccountInvitation) GetAccountId() int32 {
 	if x != nil {
 		return x.AccountId
 	}
 	return 0
 }
 
-type UserID struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x *AccountInvitation) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetInviterId() int32 {
+	if x != nil {
+		return x.InviterId
+	}
+	return 0
+}
+
+func (x *AccountInvitation) GetInviterLogin() string {
+	if x != nil {
+		return x.InviterLogin
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetInviteeId() int32 {
+	if x != nil {
+		return x.InviteeId
+	}
+	return 0
+}
+
+func (x *AccountInvitation) GetInviteeLogin() string {
+	if x != nil {
+		return x.InviteeLogin
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AccountInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAccountInvitationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// invitee login, empty for a link invitation
+	UserLogin     string `protobuf:"bytes,3,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserID) Reset() {
-	*x = UserID{}
+func (x *CreateAccountInvitationRequest) Reset() {
+	*x = CreateAccountInvitationRequest{}
 	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserID) String() string {
+func (x *CreateAccountInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserID) ProtoMessage() {}
+func (*CreateAccountInvitationRequest) ProtoMessage() {}
 
-func (x *UserID) ProtoReflect() protoreflect.Message {
+func (x *CreateAccountInvitationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,39 +514,61 @@ func (x *UserID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserID.ProtoReflect.Descriptor instead.
-func (*UserID) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAccountInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountInvitationRequest) Descriptor() ([]byte, []int) {
 	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UserID) GetUserId() int32 {
+func (x *CreateAccountInvitationRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type ListAccountsResponse struct {
+func (x *CreateAccountInvitationRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CreateAccountInvitationRequest) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *CreateAccountInvitationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AccountInvitationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InvitationId  int32                  `protobuf:"varint,2,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAccountsResponse) Reset() {
-	*x = ListAccountsResponse{}
+func (x *AccountInvitationRequest) Reset() {
+	*x = AccountInvitationRequest{}
 	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAccountsResponse) String() string {
+func (x *AccountInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsResponse) ProtoMessage() {}
+func (*AccountInvitationRequest) ProtoMessage() {}
 
-func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+func (x *AccountInvitationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,52 +580,47 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AccountInvitationRequest) Descriptor() ([]byte, []int) {
 	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListAccountsResponse) GetAccounts() []*Account {
+func (x *AccountInvitationRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Accounts
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type Operation struct {
+func (x *AccountInvitationRequest) GetInvitationId() int32 {
+	if x != nil {
+		return x.InvitationId
+	}
+	return 0
+}
+
+type AcceptInvitationLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	ReceiptUrl    string                 `protobuf:"bytes,8,opt,name=receipt_url,json=receiptUrl,proto3" json:"receipt_url,omitempty"`
-	Name          string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
-	Sum           float64                `protobuf:"fixed64,10,opt,name=sum,proto3" json:"sum,omitempty"`
-	CurrencyId    int32                  `protobuf:"varint,11,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
-	AccountType   string                 `protobuf:"bytes,12,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=date,proto3" json:"date,omitempty"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Operation) Reset() {
-	*x = Operation{}
+func (x *AcceptInvitationLinkRequest) Reset() {
+	*x = AcceptInvitationLinkRequest{}
 	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Operation) String() string {
+func (x *AcceptInvitationLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation) ProtoMessage() {}
+func (*AcceptInvitationLinkRequest) ProtoMessage() {}
 
-func (x *Operation) ProtoReflect() protoreflect.Message {
+func (x *AcceptInvitationLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -519,144 +632,94 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
-func (*Operation) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptInvitationLinkRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationLinkRequest) Descriptor() ([]byte, []int) {
 	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Operation) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Operation) GetAccountId() int32 {
-	if x != nil {
-		return x.AccountId
-	}
-	return 0
-}
-
-func (x *Operation) GetCategoryId() int32 {
+func (x *AcceptInvitationLinkRequest) GetUserId() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Operation) GetCategoryName() string {
-	if x != nil {
-		return x.CategoryName
-	}
-	return ""
-}
-
-func (x *Operation) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *Operation) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *Operation) GetDescription() string {
+func (x *AcceptInvitationLinkRequest) GetToken() string {
 	if x != nil {
-		return x.Description
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Operation) GetReceiptUrl() string {
-	if x != nil {
-		return x.ReceiptUrl
-	}
-	return ""
+type ListAccountInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*AccountInvitation   `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Operation) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ListAccountInvitationsResponse) Reset() {
+	*x = ListAccountInvitationsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Operation) GetSum() float64 {
-	if x != nil {
-		return x.Sum
-	}
-	return 0
+func (x *ListAccountInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Operation) GetCurrencyId() int32 {
-	if x != nil {
-		return x.CurrencyId
-	}
-	return 0
-}
+func (*ListAccountInvitationsResponse) ProtoMessage() {}
 
-func (x *Operation) GetAccountType() string {
+func (x *ListAccountInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[8]
 	if x != nil {
-		return x.AccountType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Operation) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use ListAccountInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Operation) GetDate() *timestamppb.Timestamp {
+func (x *ListAccountInvitationsResponse) GetInvitations() []*AccountInvitation {
 	if x != nil {
-		return x.Date
+		return x.Invitations
 	}
 	return nil
 }
 
-type OperationInList struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountId            int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	CategoryId           int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CategoryName         string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Type                 string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	Description          string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Name                 string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	CategoryLogoHashedId string                 `protobuf:"bytes,8,opt,name=category_logo_hashed_id,json=categoryLogoHashedId,proto3" json:"category_logo_hashed_id,omitempty"`
-	CategoryLogo         string                 `protobuf:"bytes,9,opt,name=category_logo,json=categoryLogo,proto3" json:"category_logo,omitempty"`
-	Sum                  float64                `protobuf:"fixed64,10,opt,name=sum,proto3" json:"sum,omitempty"`
-	CurrencyId           int32                  `protobuf:"varint,11,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
-	AccountType          string                 `protobuf:"bytes,12,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
-	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Date                 *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=date,proto3" json:"date,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type AccountMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	MemberId      int32                  `protobuf:"varint,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationInList) Reset() {
-	*x = OperationInList{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[8]
+func (x *AccountMemberRequest) Reset() {
+	*x = AccountMemberRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationInList) String() string {
+func (x *AccountMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationInList) ProtoMessage() {}
+func (*AccountMemberRequest) ProtoMessage() {}
 
-func (x *OperationInList) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[8]
+func (x *AccountMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,238 +730,4098 @@ func (x *OperationInList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationInList.ProtoReflect.Descriptor instead.
-func (*OperationInList) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AccountMemberRequest.ProtoReflect.Descriptor instead.
+func (*AccountMemberRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *OperationInList) GetId() int32 {
+func (x *AccountMemberRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *OperationInList) GetAccountId() int32 {
+func (x *AccountMemberRequest) GetAccountId() int32 {
 	if x != nil {
 		return x.AccountId
 	}
 	return 0
 }
 
-func (x *OperationInList) GetCategoryId() int32 {
+func (x *AccountMemberRequest) GetMemberId() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.MemberId
 	}
 	return 0
 }
 
-func (x *OperationInList) GetCategoryName() string {
+func (x *AccountMemberRequest) GetRole() string {
 	if x != nil {
-		return x.CategoryName
+		return x.Role
 	}
 	return ""
 }
 
-func (x *OperationInList) GetType() string {
-	if x != nil {
-		return x.Type
+type ListAccountMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*SharingsResponse    `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountMembersResponse) Reset() {
+	*x = ListAccountMembersResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountMembersResponse) ProtoMessage() {}
+
+func (x *ListAccountMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountMembersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAccountMembersResponse) GetMembers() []*SharingsResponse {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type UserID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserID) Reset() {
+	*x = UserID{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserID) ProtoMessage() {}
+
+func (x *UserID) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserID.ProtoReflect.Descriptor instead.
+func (*UserID) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UserID) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AccountID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int32                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountID) Reset() {
+	*x = AccountID{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountID) ProtoMessage() {}
+
+func (x *AccountID) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountID.ProtoReflect.Descriptor instead.
+func (*AccountID) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AccountID) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type UserIDs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int32                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIDs) Reset() {
+	*x = UserIDs{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIDs) ProtoMessage() {}
+
+func (x *UserIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIDs.ProtoReflect.Descriptor instead.
+func (*UserIDs) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserIDs) GetUserIds() []int32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type Operation struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId    int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CategoryId   int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Type         string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Status       string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Description  string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	ReceiptUrl   string                 `protobuf:"bytes,8,opt,name=receipt_url,json=receiptUrl,proto3" json:"receipt_url,omitempty"`
+	Name         string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	Sum          float64                `protobuf:"fixed64,10,opt,name=sum,proto3" json:"sum,omitempty"`
+	CurrencyId   int32                  `protobuf:"varint,11,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	AccountType  string                 `protobuf:"bytes,12,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Date         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=date,proto3" json:"date,omitempty"`
+	// destination account of a transfer, 0 for other operations
+	AccountToId   int32 `protobuf:"varint,15,opt,name=account_to_id,json=accountToId,proto3" json:"account_to_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Operation) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Operation) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Operation) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Operation) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Operation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Operation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Operation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Operation) GetReceiptUrl() string {
+	if x != nil {
+		return x.ReceiptUrl
+	}
+	return ""
+}
+
+func (x *Operation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Operation) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *Operation) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *Operation) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *Operation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Operation) GetAccountToId() int32 {
+	if x != nil {
+		return x.AccountToId
+	}
+	return 0
+}
+
+type OperationInList struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId            int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CategoryId           int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName         string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Type                 string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Description          string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Name                 string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryLogoHashedId string                 `protobuf:"bytes,8,opt,name=category_logo_hashed_id,json=categoryLogoHashedId,proto3" json:"category_logo_hashed_id,omitempty"`
+	CategoryLogo         string                 `protobuf:"bytes,9,opt,name=category_logo,json=categoryLogo,proto3" json:"category_logo,omitempty"`
+	Sum                  float64                `protobuf:"fixed64,10,opt,name=sum,proto3" json:"sum,omitempty"`
+	CurrencyId           int32                  `protobuf:"varint,11,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	AccountType          string                 `protobuf:"bytes,12,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Date                 *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OperationInList) Reset() {
+	*x = OperationInList{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationInList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationInList) ProtoMessage() {}
+
+func (x *OperationInList) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationInList.ProtoReflect.Descriptor instead.
+func (*OperationInList) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OperationInList) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OperationInList) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *OperationInList) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *OperationInList) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *OperationInList) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OperationInList) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OperationInList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperationInList) GetCategoryLogoHashedId() string {
+	if x != nil {
+		return x.CategoryLogoHashedId
+	}
+	return ""
+}
+
+func (x *OperationInList) GetCategoryLogo() string {
+	if x != nil {
+		return x.CategoryLogo
+	}
+	return ""
+}
+
+func (x *OperationInList) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *OperationInList) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *OperationInList) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *OperationInList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OperationInList) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type CreateOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CategoryId    *int32                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Sum           float64                `protobuf:"fixed64,7,opt,name=sum,proto3" json:"sum,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3,oneof" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOperationRequest) Reset() {
+	*x = CreateOperationRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOperationRequest) ProtoMessage() {}
+
+func (x *CreateOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOperationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOperationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateOperationRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateOperationRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CreateOperationRequest) GetCategoryId() int32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateOperationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateOperationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOperationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateOperationRequest) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *CreateOperationRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type UpdateOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OperationId   int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	CategoryId    *int32                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Sum           *float64               `protobuf:"fixed64,7,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOperationRequest) Reset() {
+	*x = UpdateOperationRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperationRequest) ProtoMessage() {}
+
+func (x *UpdateOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOperationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOperationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateOperationRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateOperationRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateOperationRequest) GetOperationId() int32 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+func (x *UpdateOperationRequest) GetCategoryId() int32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateOperationRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateOperationRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateOperationRequest) GetSum() float64 {
+	if x != nil && x.Sum != nil {
+		return *x.Sum
+	}
+	return 0
+}
+
+func (x *UpdateOperationRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type OperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OperationId   int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationRequest) Reset() {
+	*x = OperationRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationRequest) ProtoMessage() {}
+
+func (x *OperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationRequest.ProtoReflect.Descriptor instead.
+func (*OperationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OperationRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OperationRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *OperationRequest) GetOperationId() int32 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+type ListOperationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operations    []*OperationInList     `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsResponse) ProtoMessage() {}
+
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListOperationsResponse) GetOperations() []*OperationInList {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type OperationsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperationIds  []int32                `protobuf:"varint,2,rep,packed,name=operation_ids,json=operationIds,proto3" json:"operation_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationsByIDsRequest) Reset() {
+	*x = OperationsByIDsRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationsByIDsRequest) ProtoMessage() {}
+
+func (x *OperationsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*OperationsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OperationsByIDsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OperationsByIDsRequest) GetOperationIds() []int32 {
+	if x != nil {
+		return x.OperationIds
+	}
+	return nil
+}
+
+type OperationsByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operations    []*Operation           `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationsByIDsResponse) Reset() {
+	*x = OperationsByIDsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationsByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationsByIDsResponse) ProtoMessage() {}
+
+func (x *OperationsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*OperationsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OperationsByIDsResponse) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type Category struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	LogoHashedId string                 `protobuf:"bytes,5,opt,name=logo_hashed_id,json=logoHashedId,proto3" json:"logo_hashed_id,omitempty"`
+	LogoUrl      string                 `protobuf:"bytes,6,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 0 for root categories
+	ParentId      int32 `protobuf:"varint,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Category) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetLogoHashedId() string {
+	if x != nil {
+		return x.LogoHashedId
+	}
+	return ""
+}
+
+func (x *Category) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type CreateCategoryRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LogoHashedId string                 `protobuf:"bytes,4,opt,name=logo_hashed_id,json=logoHashedId,proto3" json:"logo_hashed_id,omitempty"`
+	// 0 creates a root category
+	ParentId      int32 `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateCategoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetLogoHashedId() string {
+	if x != nil {
+		return x.LogoHashedId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type UpdateCategoryRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId   int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name         *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description  *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	LogoHashedId *string                `protobuf:"bytes,5,opt,name=logo_hashed_id,json=logoHashedId,proto3,oneof" json:"logo_hashed_id,omitempty"`
+	// unset keeps the current parent, 0 moves the category to the root
+	ParentId      *int32 `protobuf:"varint,6,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateCategoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetLogoHashedId() string {
+	if x != nil && x.LogoHashedId != nil {
+		return *x.LogoHashedId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetParentId() int32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type CategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryRequest) Reset() {
+	*x = CategoryRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRequest) ProtoMessage() {}
+
+func (x *CategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRequest.ProtoReflect.Descriptor instead.
+func (*CategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CategoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CategoryRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type DeleteCategoryRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// 0 leaves operations uncategorized
+	ReassignTo    int32 `protobuf:"varint,3,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteCategoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetReassignTo() int32 {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return 0
+}
+
+type MergeCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SourceId      int32                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      int32                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCategoriesRequest) Reset() {
+	*x = MergeCategoriesRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCategoriesRequest) ProtoMessage() {}
+
+func (x *MergeCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*MergeCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MergeCategoriesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MergeCategoriesRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *MergeCategoriesRequest) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type MergeCategoriesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Target          *Category              `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	MovedOperations int32                  `protobuf:"varint,2,opt,name=moved_operations,json=movedOperations,proto3" json:"moved_operations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeCategoriesResponse) Reset() {
+	*x = MergeCategoriesResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCategoriesResponse) ProtoMessage() {}
+
+func (x *MergeCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*MergeCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MergeCategoriesResponse) GetTarget() *Category {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *MergeCategoriesResponse) GetMovedOperations() int32 {
+	if x != nil {
+		return x.MovedOperations
+	}
+	return 0
+}
+
+type ProvisionDefaultCategoriesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// falls back to the pack's default locale when empty or unknown
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// also restores descriptions and icons of default categories the user already has
+	Overwrite     bool `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionDefaultCategoriesRequest) Reset() {
+	*x = ProvisionDefaultCategoriesRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionDefaultCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionDefaultCategoriesRequest) ProtoMessage() {}
+
+func (x *ProvisionDefaultCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionDefaultCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ProvisionDefaultCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProvisionDefaultCategoriesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProvisionDefaultCategoriesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ProvisionDefaultCategoriesRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type CategoryByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryByNameRequest) Reset() {
+	*x = CategoryByNameRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryByNameRequest) ProtoMessage() {}
+
+func (x *CategoryByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryByNameRequest.ProtoReflect.Descriptor instead.
+func (*CategoryByNameRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CategoryByNameRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CategoryByNameRequest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CategoryWithStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// includes operations of all subcategories
+	OperationsCount    int32 `protobuf:"varint,2,opt,name=operations_count,json=operationsCount,proto3" json:"operations_count,omitempty"`
+	OwnOperationsCount int32 `protobuf:"varint,3,opt,name=own_operations_count,json=ownOperationsCount,proto3" json:"own_operations_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CategoryWithStats) Reset() {
+	*x = CategoryWithStats{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryWithStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryWithStats) ProtoMessage() {}
+
+func (x *CategoryWithStats) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryWithStats.ProtoReflect.Descriptor instead.
+func (*CategoryWithStats) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CategoryWithStats) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryWithStats) GetOperationsCount() int32 {
+	if x != nil {
+		return x.OperationsCount
+	}
+	return 0
+}
+
+func (x *CategoryWithStats) GetOwnOperationsCount() int32 {
+	if x != nil {
+		return x.OwnOperationsCount
+	}
+	return 0
+}
+
+type ListCategoriesWithStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryWithStats   `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesWithStatsResponse) Reset() {
+	*x = ListCategoriesWithStatsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesWithStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesWithStatsResponse) ProtoMessage() {}
+
+func (x *ListCategoriesWithStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesWithStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesWithStatsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListCategoriesWithStatsResponse) GetCategories() []*CategoryWithStats {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CategoryReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryReportRequest) Reset() {
+	*x = CategoryReportRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryReportRequest) ProtoMessage() {}
+
+func (x *CategoryReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryReportRequest.ProtoReflect.Descriptor instead.
+func (*CategoryReportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CategoryReportRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CategoryReportRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CategoryReportRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type CategoryInReport struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId   int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	// operations_count and total_sum include all subcategories
+	OperationsCount    int32   `protobuf:"varint,3,opt,name=operations_count,json=operationsCount,proto3" json:"operations_count,omitempty"`
+	TotalSum           float64 `protobuf:"fixed64,4,opt,name=total_sum,json=totalSum,proto3" json:"total_sum,omitempty"`
+	ParentId           int32   `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	OwnOperationsCount int32   `protobuf:"varint,6,opt,name=own_operations_count,json=ownOperationsCount,proto3" json:"own_operations_count,omitempty"`
+	OwnSum             float64 `protobuf:"fixed64,7,opt,name=own_sum,json=ownSum,proto3" json:"own_sum,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CategoryInReport) Reset() {
+	*x = CategoryInReport{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryInReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryInReport) ProtoMessage() {}
+
+func (x *CategoryInReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryInReport.ProtoReflect.Descriptor instead.
+func (*CategoryInReport) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CategoryInReport) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryInReport) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryInReport) GetOperationsCount() int32 {
+	if x != nil {
+		return x.OperationsCount
+	}
+	return 0
+}
+
+func (x *CategoryInReport) GetTotalSum() float64 {
+	if x != nil {
+		return x.TotalSum
+	}
+	return 0
+}
+
+func (x *CategoryInReport) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CategoryInReport) GetOwnOperationsCount() int32 {
+	if x != nil {
+		return x.OwnOperationsCount
+	}
+	return 0
+}
+
+func (x *CategoryInReport) GetOwnSum() float64 {
+	if x != nil {
+		return x.OwnSum
+	}
+	return 0
+}
+
+type CategoryReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryInReport    `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryReportResponse) Reset() {
+	*x = CategoryReportResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryReportResponse) ProtoMessage() {}
+
+func (x *CategoryReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryReportResponse.ProtoReflect.Descriptor instead.
+func (*CategoryReportResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CategoryReportResponse) GetCategories() []*CategoryInReport {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CategoryReportResponse) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CategoryReportResponse) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type OperationsByAccountAndFiltersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OperationId   int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryIds   []int32                `protobuf:"varint,5,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	OperationType string                 `protobuf:"bytes,6,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	AccountType   string                 `protobuf:"bytes,7,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationsByAccountAndFiltersRequest) Reset() {
+	*x = OperationsByAccountAndFiltersRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationsByAccountAndFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationsByAccountAndFiltersRequest) ProtoMessage() {}
+
+func (x *OperationsByAccountAndFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationsByAccountAndFiltersRequest.ProtoReflect.Descriptor instead.
+func (*OperationsByAccountAndFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *OperationsByAccountAndFiltersRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OperationsByAccountAndFiltersRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *OperationsByAccountAndFiltersRequest) GetOperationId() int32 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+func (x *OperationsByAccountAndFiltersRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperationsByAccountAndFiltersRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *OperationsByAccountAndFiltersRequest) GetOperationType() string {
+	if x != nil {
+		return x.OperationType
+	}
+	return ""
+}
+
+func (x *OperationsByAccountAndFiltersRequest) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *OperationsByAccountAndFiltersRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type SharingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharingId     int32                  `protobuf:"varint,1,opt,name=sharing_id,json=sharingId,proto3" json:"sharing_id,omitempty"`
+	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,6,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharingsResponse) Reset() {
+	*x = SharingsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharingsResponse) ProtoMessage() {}
+
+func (x *SharingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharingsResponse.ProtoReflect.Descriptor instead.
+func (*SharingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SharingsResponse) GetSharingId() int32 {
+	if x != nil {
+		return x.SharingId
+	}
+	return 0
+}
+
+func (x *SharingsResponse) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *SharingsResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SharingsResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SharingsResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SharingsResponse) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+type Receiver struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LogoHashedId string                 `protobuf:"bytes,3,opt,name=logo_hashed_id,json=logoHashedId,proto3" json:"logo_hashed_id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// registered user linked to the receiver, 0 if none
+	CounterpartyUserId int32 `protobuf:"varint,5,opt,name=counterparty_user_id,json=counterpartyUserId,proto3" json:"counterparty_user_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Receiver) Reset() {
+	*x = Receiver{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receiver) ProtoMessage() {}
+
+func (x *Receiver) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receiver.ProtoReflect.Descriptor instead.
+func (*Receiver) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Receiver) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Receiver) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Receiver) GetLogoHashedId() string {
+	if x != nil {
+		return x.LogoHashedId
+	}
+	return ""
+}
+
+func (x *Receiver) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Receiver) GetCounterpartyUserId() int32 {
+	if x != nil {
+		return x.CounterpartyUserId
+	}
+	return 0
+}
+
+type UserDataExport struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Accounts   []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Categories []*Category            `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	Operations []*Operation           `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	Receivers  []*Receiver            `protobuf:"bytes,4,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	// other members of the accounts the user owns
+	Sharings      []*SharingsResponse `protobuf:"bytes,5,rep,name=sharings,proto3" json:"sharings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExport) Reset() {
+	*x = UserDataExport{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport) ProtoMessage() {}
+
+func (x *UserDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport.ProtoReflect.Descriptor instead.
+func (*UserDataExport) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UserDataExport) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetReceivers() []*Receiver {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetSharings() []*SharingsResponse {
+	if x != nil {
+		return x.Sharings
+	}
+	return nil
+}
+
+type ImportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BackupId      string                 `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	Data          *UserDataExport        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUserDataRequest) Reset() {
+	*x = ImportUserDataRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserDataRequest) ProtoMessage() {}
+
+func (x *ImportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ImportUserDataRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportUserDataRequest) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
+	}
+	return ""
+}
+
+func (x *ImportUserDataRequest) GetData() *UserDataExport {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportUserDataResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AccountsRestored   int32                  `protobuf:"varint,1,opt,name=accounts_restored,json=accountsRestored,proto3" json:"accounts_restored,omitempty"`
+	CategoriesRestored int32                  `protobuf:"varint,2,opt,name=categories_restored,json=categoriesRestored,proto3" json:"categories_restored,omitempty"`
+	OperationsRestored int32                  `protobuf:"varint,3,opt,name=operations_restored,json=operationsRestored,proto3" json:"operations_restored,omitempty"`
+	ReceiversRestored  int32                  `protobuf:"varint,4,opt,name=receivers_restored,json=receiversRestored,proto3" json:"receivers_restored,omitempty"`
+	RestoredOperations []*Operation           `protobuf:"bytes,5,rep,name=restored_operations,json=restoredOperations,proto3" json:"restored_operations,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ImportUserDataResponse) Reset() {
+	*x = ImportUserDataResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserDataResponse) ProtoMessage() {}
+
+func (x *ImportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ImportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImportUserDataResponse) GetAccountsRestored() int32 {
+	if x != nil {
+		return x.AccountsRestored
+	}
+	return 0
+}
+
+func (x *ImportUserDataResponse) GetCategoriesRestored() int32 {
+	if x != nil {
+		return x.CategoriesRestored
+	}
+	return 0
+}
+
+func (x *ImportUserDataResponse) GetOperationsRestored() int32 {
+	if x != nil {
+		return x.OperationsRestored
+	}
+	return 0
+}
+
+func (x *ImportUserDataResponse) GetReceiversRestored() int32 {
+	if x != nil {
+		return x.ReceiversRestored
+	}
+	return 0
+}
+
+func (x *ImportUserDataResponse) GetRestoredOperations() []*Operation {
+	if x != nil {
+		return x.RestoredOperations
+	}
+	return nil
+}
+
+type UserDataDeletion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// accounts deleted together with their operations, the user was their only member
+	DeletedAccountIds []int32 `protobuf:"varint,1,rep,packed,name=deleted_account_ids,json=deletedAccountIds,proto3" json:"deleted_account_ids,omitempty"`
+	// shared accounts the user left; ownership passes to another member
+	LeftAccountIds []int32 `protobuf:"varint,2,rep,packed,name=left_account_ids,json=leftAccountIds,proto3" json:"left_account_ids,omitempty"`
+	// operations of the shared accounts that lost the user's category
+	UncategorizedOperations []*Operation `protobuf:"bytes,3,rep,name=uncategorized_operations,json=uncategorizedOperations,proto3" json:"uncategorized_operations,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UserDataDeletion) Reset() {
+	*x = UserDataDeletion{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataDeletion) ProtoMessage() {}
+
+func (x *UserDataDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataDeletion.ProtoReflect.Descriptor instead.
+func (*UserDataDeletion) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UserDataDeletion) GetDeletedAccountIds() []int32 {
+	if x != nil {
+		return x.DeletedAccountIds
+	}
+	return nil
+}
+
+func (x *UserDataDeletion) GetLeftAccountIds() []int32 {
+	if x != nil {
+		return x.LeftAccountIds
+	}
+	return nil
+}
+
+func (x *UserDataDeletion) GetUncategorizedOperations() []*Operation {
+	if x != nil {
+		return x.UncategorizedOperations
+	}
+	return nil
+}
+
+type ImageIDs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageIDs) Reset() {
+	*x = ImageIDs{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageIDs) ProtoMessage() {}
+
+func (x *ImageIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageIDs.ProtoReflect.Descriptor instead.
+func (*ImageIDs) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImageIDs) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type CategoryRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	NameContains  string                 `protobuf:"bytes,5,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	OperationType string                 `protobuf:"bytes,6,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	AmountGt      *float64               `protobuf:"fixed64,7,opt,name=amount_gt,json=amountGt,proto3,oneof" json:"amount_gt,omitempty"`
+	AmountLt      *float64               `protobuf:"fixed64,8,opt,name=amount_lt,json=amountLt,proto3,oneof" json:"amount_lt,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryRule) Reset() {
+	*x = CategoryRule{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRule) ProtoMessage() {}
+
+func (x *CategoryRule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRule.ProtoReflect.Descriptor instead.
+func (*CategoryRule) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CategoryRule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetOperationType() string {
+	if x != nil {
+		return x.OperationType
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetAmountGt() float64 {
+	if x != nil && x.AmountGt != nil {
+		return *x.AmountGt
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetAmountLt() float64 {
+	if x != nil && x.AmountLt != nil {
+		return *x.AmountLt
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CategoryRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateCategoryRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	NameContains  string                 `protobuf:"bytes,3,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	OperationType string                 `protobuf:"bytes,4,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	AmountGt      *float64               `protobuf:"fixed64,5,opt,name=amount_gt,json=amountGt,proto3,oneof" json:"amount_gt,omitempty"`
+	AmountLt      *float64               `protobuf:"fixed64,6,opt,name=amount_lt,json=amountLt,proto3,oneof" json:"amount_lt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRuleRequest) Reset() {
+	*x = CreateCategoryRuleRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRuleRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateCategoryRuleRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetOperationType() string {
+	if x != nil {
+		return x.OperationType
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetAmountGt() float64 {
+	if x != nil && x.AmountGt != nil {
+		return *x.AmountGt
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetAmountLt() float64 {
+	if x != nil && x.AmountLt != nil {
+		return *x.AmountLt
+	}
+	return 0
+}
+
+type UpdateCategoryRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleId        int32                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	NameContains  string                 `protobuf:"bytes,4,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	OperationType string                 `protobuf:"bytes,5,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	AmountGt      *float64               `protobuf:"fixed64,6,opt,name=amount_gt,json=amountGt,proto3,oneof" json:"amount_gt,omitempty"`
+	AmountLt      *float64               `protobuf:"fixed64,7,opt,name=amount_lt,json=amountLt,proto3,oneof" json:"amount_lt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRuleRequest) Reset() {
+	*x = UpdateCategoryRuleRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRuleRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateCategoryRuleRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetOperationType() string {
+	if x != nil {
+		return x.OperationType
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetAmountGt() float64 {
+	if x != nil && x.AmountGt != nil {
+		return *x.AmountGt
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetAmountLt() float64 {
+	if x != nil && x.AmountLt != nil {
+		return *x.AmountLt
+	}
+	return 0
+}
+
+type CategoryRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleId        int32                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryRuleRequest) Reset() {
+	*x = CategoryRuleRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRuleRequest) ProtoMessage() {}
+
+func (x *CategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*CategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CategoryRuleRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CategoryRuleRequest) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type ListCategoryRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CategoryRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryRulesResponse) Reset() {
+	*x = ListCategoryRulesResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryRulesResponse) ProtoMessage() {}
+
+func (x *ListCategoryRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryRulesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListCategoryRulesResponse) GetRules() []*CategoryRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ReorderCategoryRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleIds       []int32                `protobuf:"varint,2,rep,packed,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCategoryRulesRequest) Reset() {
+	*x = ReorderCategoryRulesRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCategoryRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCategoryRulesRequest) ProtoMessage() {}
+
+func (x *ReorderCategoryRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCategoryRulesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCategoryRulesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReorderCategoryRulesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReorderCategoryRulesRequest) GetRuleIds() []int32 {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+type TestCategoryRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sum           float64                `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestCategoryRulesRequest) Reset() {
+	*x = TestCategoryRulesRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCategoryRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCategoryRulesRequest) ProtoMessage() {}
+
+func (x *TestCategoryRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCategoryRulesRequest.ProtoReflect.Descriptor instead.
+func (*TestCategoryRulesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TestCategoryRulesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TestCategoryRulesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestCategoryRulesRequest) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *TestCategoryRulesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type TestCategoryRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matched       bool                   `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Rule          *CategoryRule          `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestCategoryRulesResponse) Reset() {
+	*x = TestCategoryRulesResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCategoryRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCategoryRulesResponse) ProtoMessage() {}
+
+func (x *TestCategoryRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCategoryRulesResponse.ProtoReflect.Descriptor instead.
+func (*TestCategoryRulesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TestCategoryRulesResponse) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *TestCategoryRulesResponse) GetRule() *CategoryRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ApplyCategoryRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Overwrite     bool                   `protobuf:"varint,2,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyCategoryRulesRequest) Reset() {
+	*x = ApplyCategoryRulesRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCategoryRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCategoryRulesRequest) ProtoMessage() {}
+
+func (x *ApplyCategoryRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCategoryRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCategoryRulesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ApplyCategoryRulesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApplyCategoryRulesRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type ApplyCategoryRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Recategorized []*Operation           `protobuf:"bytes,2,rep,name=recategorized,proto3" json:"recategorized,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyCategoryRulesResponse) Reset() {
+	*x = ApplyCategoryRulesResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCategoryRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCategoryRulesResponse) ProtoMessage() {}
+
+func (x *ApplyCategoryRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCategoryRulesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyCategoryRulesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ApplyCategoryRulesResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ApplyCategoryRulesResponse) GetRecategorized() []*Operation {
+	if x != nil {
+		return x.Recategorized
+	}
+	return nil
+}
+
+type SuggestCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sum           float64                `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestCategoryRequest) Reset() {
+	*x = SuggestCategoryRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCategoryRequest) ProtoMessage() {}
+
+func (x *SuggestCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCategoryRequest.ProtoReflect.Descriptor instead.
+func (*SuggestCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SuggestCategoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SuggestCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SuggestCategoryRequest) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *SuggestCategoryRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type SuggestCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestCategoryResponse) Reset() {
+	*x = SuggestCategoryResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCategoryResponse) ProtoMessage() {}
+
+func (x *SuggestCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCategoryResponse.ProtoReflect.Descriptor instead.
+func (*SuggestCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SuggestCategoryResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *SuggestCategoryResponse) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SuggestCategoryResponse) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *SuggestCategoryResponse) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *SuggestCategoryResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type SpendingStatsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CurrencyId int32                  `protobuf:"varint,2,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	// empty means all categories (accounts) of the user; subcategories are included
+	CategoryIds []int32 `protobuf:"varint,3,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	AccountIds  []int32 `protobuf:"varint,4,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	// daily totals are returned for [start, end]
+	Start *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	// recurring expenses are looked for in [history_start, end]
+	HistoryStart  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=history_start,json=historyStart,proto3" json:"history_start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingStatsRequest) Reset() {
+	*x = SpendingStatsRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingStatsRequest) ProtoMessage() {}
+
+func (x *SpendingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingStatsRequest.ProtoReflect.Descriptor instead.
+func (*SpendingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SpendingStatsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SpendingStatsRequest) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *SpendingStatsRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *SpendingStatsRequest) GetAccountIds() []int32 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *SpendingStatsRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *SpendingStatsRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *SpendingStatsRequest) GetHistoryStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HistoryStart
+	}
+	return nil
+}
+
+type DailySpending struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Sum           float64                `protobuf:"fixed64,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailySpending) Reset() {
+	*x = DailySpending{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailySpending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailySpending) ProtoMessage() {}
+
+func (x *DailySpending) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailySpending.ProtoReflect.Descriptor instead.
+func (*DailySpending) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DailySpending) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *DailySpending) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+// Expense with the same name and category met in several calendar months.
+type RecurringExpense struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Months     int32                  `protobuf:"varint,3,opt,name=months,proto3" json:"months,omitempty"`
+	AvgSum     float64                `protobuf:"fixed64,4,opt,name=avg_sum,json=avgSum,proto3" json:"avg_sum,omitempty"`
+	// typical day of month of the expense
+	DayOfMonth    int32                  `protobuf:"varint,5,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"`
+	LastDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_date,json=lastDate,proto3" json:"last_date,omitempty"`
+	LastSum       float64                `protobuf:"fixed64,7,opt,name=last_sum,json=lastSum,proto3" json:"last_sum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringExpense) Reset() {
+	*x = RecurringExpense{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringExpense) ProtoMessage() {}
+
+func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringExpense.ProtoReflect.Descriptor instead.
+func (*RecurringExpense) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RecurringExpense) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *RecurringExpense) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *RecurringExpense) GetAvgSum() float64 {
+	if x != nil {
+		return x.AvgSum
 	}
-	return ""
+	return 0
 }
 
-func (x *OperationInList) GetDescription() string {
+func (x *RecurringExpense) GetDayOfMonth() int32 {
 	if x != nil {
-		return x.Description
+		return x.DayOfMonth
 	}
-	return ""
+	return 0
 }
 
-func (x *OperationInList) GetName() string {
+func (x *RecurringExpense) GetLastDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.LastDate
 	}
-	return ""
+	return nil
 }
 
-func (x *OperationInList) GetCategoryLogoHashedId() string {
+func (x *RecurringExpense) GetLastSum() float64 {
 	if x != nil {
-		return x.CategoryLogoHashedId
+		return x.LastSum
 	}
-	return ""
+	return 0
 }
 
-func (x *OperationInList) GetCategoryLogo() string {
+type SpendingStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*DailySpending       `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Recurring     []*RecurringExpense    `protobuf:"bytes,2,rep,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingStatsResponse) Reset() {
+	*x = SpendingStatsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingStatsResponse) ProtoMessage() {}
+
+func (x *SpendingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[61]
 	if x != nil {
-		return x.CategoryLogo
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OperationInList) GetSum() float64 {
+// Deprecated: Use SpendingStatsResponse.ProtoReflect.Descriptor instead.
+func (*SpendingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SpendingStatsResponse) GetDays() []*DailySpending {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *SpendingStatsResponse) GetRecurring() []*RecurringExpense {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+// Expenses of a requested category together with its subcategories.
+type CategorySpending struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Sum           float64                `protobuf:"fixed64,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorySpending) Reset() {
+	*x = CategorySpending{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorySpending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorySpending) ProtoMessage() {}
+
+func (x *CategorySpending) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorySpending.ProtoReflect.Descriptor instead.
+func (*CategorySpending) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CategorySpending) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategorySpending) GetSum() float64 {
 	if x != nil {
 		return x.Sum
 	}
 	return 0
 }
 
-func (x *OperationInList) GetCurrencyId() int32 {
+type SpendingTotals struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Total float64                `protobuf:"fixed64,1,opt,name=total,proto3" json:"total,omitempty"`
+	// one entry per requested category
+	ByCategory    []*CategorySpending `protobuf:"bytes,2,rep,name=by_category,json=byCategory,proto3" json:"by_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingTotals) Reset() {
+	*x = SpendingTotals{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingTotals) ProtoMessage() {}
+
+func (x *SpendingTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingTotals.ProtoReflect.Descriptor instead.
+func (*SpendingTotals) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SpendingTotals) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SpendingTotals) GetByCategory() []*CategorySpending {
+	if x != nil {
+		return x.ByCategory
+	}
+	return nil
+}
+
+// Outstanding debts with a counterparty in one currency.
+type CounterpartyBalance struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyId int32                  `protobuf:"varint,1,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	// owed to the user
+	Lent float64 `protobuf:"fixed64,2,opt,name=lent,proto3" json:"lent,omitempty"`
+	// owed by the user
+	Borrowed float64 `protobuf:"fixed64,3,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
+	// lent - borrowed
+	Net           float64 `protobuf:"fixed64,4,opt,name=net,proto3" json:"net,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CounterpartyBalance) Reset() {
+	*x = CounterpartyBalance{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterpartyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterpartyBalance) ProtoMessage() {}
+
+func (x *CounterpartyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterpartyBalance.ProtoReflect.Descriptor instead.
+func (*CounterpartyBalance) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CounterpartyBalance) GetCurrencyId() int32 {
 	if x != nil {
 		return x.CurrencyId
 	}
 	return 0
 }
 
-func (x *OperationInList) GetAccountType() string {
+func (x *CounterpartyBalance) GetLent() float64 {
 	if x != nil {
-		return x.AccountType
+		return x.Lent
 	}
-	return ""
+	return 0
 }
 
-func (x *OperationInList) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CounterpartyBalance) GetBorrowed() float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Borrowed
+	}
+	return 0
+}
+
+func (x *CounterpartyBalance) GetNet() float64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+type Counterparty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receiver      *Receiver              `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Balances      []*CounterpartyBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Counterparty) Reset() {
+	*x = Counterparty{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Counterparty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counterparty) ProtoMessage() {}
+
+func (x *Counterparty) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counterparty.ProtoReflect.Descriptor instead.
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Counterparty) GetReceiver() *Receiver {
+	if x != nil {
+		return x.Receiver
 	}
 	return nil
 }
 
-func (x *OperationInList) GetDate() *timestamppb.Timestamp {
+func (x *Counterparty) GetBalances() []*CounterpartyBalance {
 	if x != nil {
-		return x.Date
+		return x.Balances
 	}
 	return nil
 }
 
-type CreateOperationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	CategoryId    *int32                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Sum           float64                `protobuf:"fixed64,7,opt,name=sum,proto3" json:"sum,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3,oneof" json:"date,omitempty"`
+type ListCounterpartiesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Counterparties []*Counterparty        `protobuf:"bytes,1,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCounterpartiesResponse) Reset() {
+	*x = ListCounterpartiesResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCounterpartiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCounterpartiesResponse) ProtoMessage() {}
+
+func (x *ListCounterpartiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCounterpartiesResponse.ProtoReflect.Descriptor instead.
+func (*ListCounterpartiesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListCounterpartiesResponse) GetCounterparties() []*Counterparty {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+type Debt struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId             int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReceiverId         int32                  `protobuf:"varint,3,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
+	ReceiverName       string                 `protobuf:"bytes,4,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
+	CounterpartyUserId int32                  `protobuf:"varint,5,opt,name=counterparty_user_id,json=counterpartyUserId,proto3" json:"counterparty_user_id,omitempty"`
+	// lent or borrowed
+	Direction     string                 `protobuf:"bytes,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	AccountId     int32                  `protobuf:"varint,7,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CurrencyId    int32                  `protobuf:"varint,8,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Principal     float64                `protobuf:"fixed64,9,opt,name=principal,proto3" json:"principal,omitempty"`
+	Repaid        float64                `protobuf:"fixed64,10,opt,name=repaid,proto3" json:"repaid,omitempty"`
+	Outstanding   float64                `protobuf:"fixed64,11,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	Description   string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Closed        bool                   `protobuf:"varint,14,opt,name=closed,proto3" json:"closed,omitempty"`
+	Overdue       bool                   `protobuf:"varint,15,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOperationRequest) Reset() {
-	*x = CreateOperationRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Debt) Reset() {
+	*x = Debt{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Debt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Debt) ProtoMessage() {}
+
+func (x *Debt) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Debt.ProtoReflect.Descriptor instead.
+func (*Debt) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Debt) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Debt) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Debt) GetReceiverId() int32 {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return 0
+}
+
+func (x *Debt) GetReceiverName() string {
+	if x != nil {
+		return x.ReceiverName
+	}
+	return ""
 }
 
-func (x *CreateOperationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Debt) GetCounterpartyUserId() int32 {
+	if x != nil {
+		return x.CounterpartyUserId
+	}
+	return 0
 }
 
-func (*CreateOperationRequest) ProtoMessage() {}
+func (x *Debt) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
 
-func (x *CreateOperationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[9]
+func (x *Debt) GetAccountId() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AccountId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateOperationRequest.ProtoReflect.Descriptor instead.
-func (*CreateOperationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{9}
+func (x *Debt) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
 }
 
-func (x *CreateOperationRequest) GetUserId() int32 {
+func (x *Debt) GetPrincipal() float64 {
 	if x != nil {
-		return x.UserId
+		return x.Principal
 	}
 	return 0
 }
 
-func (x *CreateOperationRequest) GetAccountId() int32 {
+func (x *Debt) GetRepaid() float64 {
 	if x != nil {
-		return x.AccountId
+		return x.Repaid
 	}
 	return 0
 }
 
-func (x *CreateOperationRequest) GetCategoryId() int32 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+func (x *Debt) GetOutstanding() float64 {
+	if x != nil {
+		return x.Outstanding
 	}
 	return 0
 }
 
-func (x *CreateOperationRequest) GetType() string {
+func (x *Debt) GetDescription() string {
 	if x != nil {
-		return x.Type
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateOperationRequest) GetName() string {
+func (x *Debt) GetDueDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.DueDate
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOperationRequest) GetDescription() string {
+func (x *Debt) GetClosed() bool {
 	if x != nil {
-		return x.Description
+		return x.Closed
 	}
-	return ""
+	return false
 }
 
-func (x *CreateOperationRequest) GetSum() float64 {
+func (x *Debt) GetOverdue() bool {
 	if x != nil {
-		return x.Sum
+		return x.Overdue
 	}
-	return 0
+	return false
 }
 
-func (x *CreateOperationRequest) GetDate() *timestamppb.Timestamp {
+func (x *Debt) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Date
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type UpdateOperationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	OperationId   int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
-	CategoryId    *int32                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Sum           *float64               `protobuf:"fixed64,7,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+func (x *Debt) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Counterparty is set by exactly one of receiver_id, receiver_name or counterparty_login.
+type CreateDebtRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReceiverId        int32                  `protobuf:"varint,2,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
+	ReceiverName      string                 `protobuf:"bytes,3,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
+	CounterpartyLogin string                 `protobuf:"bytes,4,opt,name=counterparty_login,json=counterpartyLogin,proto3" json:"counterparty_login,omitempty"`
+	Direction         string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	AccountId         int32                  `protobuf:"varint,6,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Principal         float64                `protobuf:"fixed64,7,opt,name=principal,proto3" json:"principal,omitempty"`
+	Description       string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// date of the principal operation, now if empty
+	Date          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOperationRequest) Reset() {
-	*x = UpdateOperationRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[10]
+func (x *CreateDebtRequest) Reset() {
+	*x = CreateDebtRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOperationRequest) String() string {
+func (x *CreateDebtRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOperationRequest) ProtoMessage() {}
+func (*CreateDebtRequest) ProtoMessage() {}
 
-func (x *UpdateOperationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[10]
+func (x *CreateDebtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,91 +4832,105 @@ func (x *UpdateOperationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOperationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOperationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateDebtRequest.ProtoReflect.Descriptor instead.
+func (*CreateDebtRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UpdateOperationRequest) GetUserId() int32 {
+func (x *CreateDebtRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateOperationRequest) GetAccountId() int32 {
+func (x *CreateDebtRequest) GetReceiverId() int32 {
 	if x != nil {
-		return x.AccountId
+		return x.ReceiverId
 	}
 	return 0
 }
 
-func (x *UpdateOperationRequest) GetOperationId() int32 {
+func (x *CreateDebtRequest) GetReceiverName() string {
 	if x != nil {
-		return x.OperationId
+		return x.ReceiverName
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateOperationRequest) GetCategoryId() int32 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+func (x *CreateDebtRequest) GetCounterpartyLogin() string {
+	if x != nil {
+		return x.CounterpartyLogin
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateOperationRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *CreateDebtRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
 	}
 	return ""
 }
 
-func (x *UpdateOperationRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *CreateDebtRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateOperationRequest) GetSum() float64 {
-	if x != nil && x.Sum != nil {
-		return *x.Sum
+func (x *CreateDebtRequest) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
 	}
 	return 0
 }
 
-func (x *UpdateOperationRequest) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreateDebtRequest) GetDescription() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
 	}
 	return nil
 }
 
-type OperationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	OperationId   int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+func (x *CreateDebtRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type ListDebtsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// open, closed or empty for all debts
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationRequest) Reset() {
-	*x = OperationRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[11]
+func (x *ListDebtsRequest) Reset() {
+	*x = ListDebtsRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationRequest) String() string {
+func (x *ListDebtsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationRequest) ProtoMessage() {}
+func (*ListDebtsRequest) ProtoMessage() {}
 
-func (x *OperationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[11]
+func (x *ListDebtsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,54 +4941,47 @@ func (x *OperationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationRequest.ProtoReflect.Descriptor instead.
-func (*OperationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListDebtsRequest.ProtoReflect.Descriptor instead.
+func (*ListDebtsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *OperationRequest) GetUserId() int32 {
+func (x *ListDebtsRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *OperationRequest) GetAccountId() int32 {
-	if x != nil {
-		return x.AccountId
-	}
-	return 0
-}
-
-func (x *OperationRequest) GetOperationId() int32 {
+func (x *ListDebtsRequest) GetStatus() string {
 	if x != nil {
-		return x.OperationId
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type ListOperationsResponse struct {
+type ListDebtsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Operations    []*OperationInList     `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	Debts         []*Debt                `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOperationsResponse) Reset() {
-	*x = ListOperationsResponse{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[12]
+func (x *ListDebtsResponse) Reset() {
+	*x = ListDebtsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOperationsResponse) String() string {
+func (x *ListDebtsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOperationsResponse) ProtoMessage() {}
+func (*ListDebtsResponse) ProtoMessage() {}
 
-func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[12]
+func (x *ListDebtsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,47 +4992,41 @@ func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
-func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListDebtsResponse.ProtoReflect.Descriptor instead.
+func (*ListDebtsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListOperationsResponse) GetOperations() []*OperationInList {
+func (x *ListDebtsResponse) GetDebts() []*Debt {
 	if x != nil {
-		return x.Operations
+		return x.Debts
 	}
 	return nil
 }
 
-type Category struct {
+type DebtRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	LogoHashedId  string                 `protobuf:"bytes,5,opt,name=logo_hashed_id,json=logoHashedId,proto3" json:"logo_hashed_id,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,6,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DebtId        int32                  `protobuf:"varint,2,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[13]
+func (x *DebtRequest) Reset() {
+	*x = DebtRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *DebtRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*DebtRequest) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[13]
+func (x *DebtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1113,92 +5037,52 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *Category) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use DebtRequest.ProtoReflect.Descriptor instead.
+func (*DebtRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Category) GetUserId() int32 {
+func (x *DebtRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *Category) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Category) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Category) GetLogoHashedId() string {
-	if x != nil {
-		return x.LogoHashedId
-	}
-	return ""
-}
-
-func (x *Category) GetLogoUrl() string {
-	if x != nil {
-		return x.LogoUrl
-	}
-	return ""
-}
-
-func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *DebtRequest) GetDebtId() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.DebtId
 	}
-	return nil
+	return 0
 }
 
-type CreateCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	LogoHashedId  string                 `protobuf:"bytes,4,opt,name=logo_hashed_id,json=logoHashedId,proto3" json:"logo_hashed_id,omitempty"`
+type CreateDebtRepaymentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DebtId int32                  `protobuf:"varint,2,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	// account of the debt if 0
+	AccountId     int32                  `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[14]
+func (x *CreateDebtRepaymentRequest) Reset() {
+	*x = CreateDebtRepaymentRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *CreateDebtRepaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*CreateDebtRepaymentRequest) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[14]
+func (x *CreateDebtRepaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,65 +5093,77 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CreateDebtRepaymentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDebtRepaymentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateDebtRepaymentRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *CreateCategoryRequest) GetUserId() int32 {
+func (x *CreateDebtRepaymentRequest) GetDebtId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.DebtId
 	}
 	return 0
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *CreateDebtRepaymentRequest) GetAccountId() int32 {
 	if x != nil {
-		return x.Name
+		return x.AccountId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCategoryRequest) GetDescription() string {
+func (x *CreateDebtRepaymentRequest) GetAmount() float64 {
 	if x != nil {
-		return x.Description
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCategoryRequest) GetLogoHashedId() string {
+func (x *CreateDebtRepaymentRequest) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LogoHashedId
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-type UpdateCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	LogoHashedId  *string                `protobuf:"bytes,5,opt,name=logo_hashed_id,json=logoHashedId,proto3,oneof" json:"logo_hashed_id,omitempty"`
+type DebtPayment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DebtId      int32                  `protobuf:"varint,2,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	OperationId int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// principal or repayment
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AccountId     int32                  `protobuf:"varint,6,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Reverted      bool                   `protobuf:"varint,7,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCategoryRequest) Reset() {
-	*x = UpdateCategoryRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[15]
+func (x *DebtPayment) Reset() {
+	*x = DebtPayment{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryRequest) String() string {
+func (x *DebtPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryRequest) ProtoMessage() {}
+func (*DebtPayment) ProtoMessage() {}
 
-func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[15]
+func (x *DebtPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1278,69 +5174,96 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DebtPayment.ProtoReflect.Descriptor instead.
+func (*DebtPayment) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UpdateCategoryRequest) GetUserId() int32 {
+func (x *DebtPayment) GetId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateCategoryRequest) GetCategoryId() int32 {
+func (x *DebtPayment) GetDebtId() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.DebtId
 	}
 	return 0
 }
 
-func (x *UpdateCategoryRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *DebtPayment) GetOperationId() int32 {
+	if x != nil {
+		return x.OperationId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCategoryRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *DebtPayment) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *UpdateCategoryRequest) GetLogoHashedId() string {
-	if x != nil && x.LogoHashedId != nil {
-		return *x.LogoHashedId
+func (x *DebtPayment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-type CategoryRequest struct {
+func (x *DebtPayment) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *DebtPayment) GetReverted() bool {
+	if x != nil {
+		return x.Reverted
+	}
+	return false
+}
+
+func (x *DebtPayment) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *DebtPayment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListDebtPaymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Payments      []*DebtPayment         `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryRequest) Reset() {
-	*x = CategoryRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[16]
+func (x *ListDebtPaymentsResponse) Reset() {
+	*x = ListDebtPaymentsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryRequest) String() string {
+func (x *ListDebtPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryRequest) ProtoMessage() {}
+func (*ListDebtPaymentsResponse) ProtoMessage() {}
 
-func (x *CategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[16]
+func (x *ListDebtPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,48 +5274,45 @@ func (x *CategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryRequest.ProtoReflect.Descriptor instead.
-func (*CategoryRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *CategoryRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use ListDebtPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDebtPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CategoryRequest) GetCategoryId() int32 {
+func (x *ListDebtPaymentsResponse) GetPayments() []*DebtPayment {
 	if x != nil {
-		return x.CategoryId
+		return x.Payments
 	}
-	return 0
+	return nil
 }
 
-type CategoryByNameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+type SplitShare struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	// amount for exact split, percent for percent split, unused for equal split
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	// share of the operation sum
+	Amount        float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryByNameRequest) Reset() {
-	*x = CategoryByNameRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[17]
+func (x *SplitShare) Reset() {
+	*x = SplitShare{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryByNameRequest) String() string {
+func (x *SplitShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryByNameRequest) ProtoMessage() {}
+func (*SplitShare) ProtoMessage() {}
 
-func (x *CategoryByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[17]
+func (x *SplitShare) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,47 +5323,70 @@ func (x *CategoryByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryByNameRequest.ProtoReflect.Descriptor instead.
-func (*CategoryByNameRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SplitShare.ProtoReflect.Descriptor instead.
+func (*SplitShare) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CategoryByNameRequest) GetUserId() int32 {
+func (x *SplitShare) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *CategoryByNameRequest) GetCategoryName() string {
+func (x *SplitShare) GetUserLogin() string {
 	if x != nil {
-		return x.CategoryName
+		return x.UserLogin
 	}
 	return ""
 }
 
-type ListCategoriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+func (x *SplitShare) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SplitShare) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type OperationSplit struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperationId int32                  `protobuf:"varint,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	AccountId   int32                  `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PayerId     int32                  `protobuf:"varint,4,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	PayerLogin  string                 `protobuf:"bytes,5,opt,name=payer_login,json=payerLogin,proto3" json:"payer_login,omitempty"`
+	// equal, exact or percent
+	Method        string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	Total         float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	Shares        []*SplitShare          `protobuf:"bytes,8,rep,name=shares,proto3" json:"shares,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesResponse) Reset() {
-	*x = ListCategoriesResponse{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[18]
+func (x *OperationSplit) Reset() {
+	*x = OperationSplit{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesResponse) String() string {
+func (x *OperationSplit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesResponse) ProtoMessage() {}
+func (*OperationSplit) ProtoMessage() {}
 
-func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[18]
+func (x *OperationSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,92 +5397,103 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use OperationSplit.ProtoReflect.Descriptor instead.
+func (*OperationSplit) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListCategoriesResponse) GetCategories() []*Category {
+func (x *OperationSplit) GetId() int32 {
 	if x != nil {
-		return x.Categories
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type CategoryWithStats struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Category        *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	OperationsCount int32                  `protobuf:"varint,2,opt,name=operations_count,json=operationsCount,proto3" json:"operations_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *OperationSplit) GetOperationId() int32 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
 }
 
-func (x *CategoryWithStats) Reset() {
-	*x = CategoryWithStats{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OperationSplit) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
 }
 
-func (x *CategoryWithStats) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OperationSplit) GetPayerId() int32 {
+	if x != nil {
+		return x.PayerId
+	}
+	return 0
 }
 
-func (*CategoryWithStats) ProtoMessage() {}
+func (x *OperationSplit) GetPayerLogin() string {
+	if x != nil {
+		return x.PayerLogin
+	}
+	return ""
+}
 
-func (x *CategoryWithStats) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[19]
+func (x *OperationSplit) GetMethod() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Method
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CategoryWithStats.ProtoReflect.Descriptor instead.
-func (*CategoryWithStats) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{19}
+func (x *OperationSplit) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (x *CategoryWithStats) GetCategory() *Category {
+func (x *OperationSplit) GetShares() []*SplitShare {
 	if x != nil {
-		return x.Category
+		return x.Shares
 	}
 	return nil
 }
 
-func (x *CategoryWithStats) GetOperationsCount() int32 {
+func (x *OperationSplit) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OperationsCount
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-type ListCategoriesWithStatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*CategoryWithStats   `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+type SplitOperationRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId   int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OperationId int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// the calling user if empty
+	PayerId int32  `protobuf:"varint,4,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	Method  string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// all account members for an equal split if empty
+	Shares        []*SplitShare `protobuf:"bytes,6,rep,name=shares,proto3" json:"shares,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesWithStatsResponse) Reset() {
-	*x = ListCategoriesWithStatsResponse{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[20]
+func (x *SplitOperationRequest) Reset() {
+	*x = SplitOperationRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesWithStatsResponse) String() string {
+func (x *SplitOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesWithStatsResponse) ProtoMessage() {}
+func (*SplitOperationRequest) ProtoMessage() {}
 
-func (x *ListCategoriesWithStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[20]
+func (x *SplitOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1550,42 +5504,78 @@ func (x *ListCategoriesWithStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesWithStatsResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesWithStatsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SplitOperationRequest.ProtoReflect.Descriptor instead.
+func (*SplitOperationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ListCategoriesWithStatsResponse) GetCategories() []*CategoryWithStats {
+func (x *SplitOperationRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Categories
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SplitOperationRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *SplitOperationRequest) GetOperationId() int32 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+func (x *SplitOperationRequest) GetPayerId() int32 {
+	if x != nil {
+		return x.PayerId
+	}
+	return 0
+}
+
+func (x *SplitOperationRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SplitOperationRequest) GetShares() []*SplitShare {
+	if x != nil {
+		return x.Shares
 	}
 	return nil
 }
 
-type CategoryReportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+type MemberBalance struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	// positive if the member is owed money
+	Net           float64 `protobuf:"fixed64,3,opt,name=net,proto3" json:"net,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryReportRequest) Reset() {
-	*x = CategoryReportRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[21]
+func (x *MemberBalance) Reset() {
+	*x = MemberBalance{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryReportRequest) String() string {
+func (x *MemberBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryReportRequest) ProtoMessage() {}
+func (*MemberBalance) ProtoMessage() {}
 
-func (x *CategoryReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[21]
+func (x *MemberBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1596,57 +5586,58 @@ func (x *CategoryReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryReportRequest.ProtoReflect.Descriptor instead.
-func (*CategoryReportRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MemberBalance.ProtoReflect.Descriptor instead.
+func (*MemberBalance) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *CategoryReportRequest) GetUserId() int32 {
+func (x *MemberBalance) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *CategoryReportRequest) GetStart() *timestamppb.Timestamp {
+func (x *MemberBalance) GetUserLogin() string {
 	if x != nil {
-		return x.Start
+		return x.UserLogin
 	}
-	return nil
+	return ""
 }
 
-func (x *CategoryReportRequest) GetEnd() *timestamppb.Timestamp {
+func (x *MemberBalance) GetNet() float64 {
 	if x != nil {
-		return x.End
+		return x.Net
 	}
-	return nil
+	return 0
 }
 
-type CategoryInReport struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId      int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CategoryName    string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	OperationsCount int32                  `protobuf:"varint,3,opt,name=operations_count,json=operationsCount,proto3" json:"operations_count,omitempty"`
-	TotalSum        float64                `protobuf:"fixed64,4,opt,name=total_sum,json=totalSum,proto3" json:"total_sum,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type PairBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DebtorId      int32                  `protobuf:"varint,1,opt,name=debtor_id,json=debtorId,proto3" json:"debtor_id,omitempty"`
+	DebtorLogin   string                 `protobuf:"bytes,2,opt,name=debtor_login,json=debtorLogin,proto3" json:"debtor_login,omitempty"`
+	CreditorId    int32                  `protobuf:"varint,3,opt,name=creditor_id,json=creditorId,proto3" json:"creditor_id,omitempty"`
+	CreditorLogin string                 `protobuf:"bytes,4,opt,name=creditor_login,json=creditorLogin,proto3" json:"creditor_login,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryInReport) Reset() {
-	*x = CategoryInReport{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[22]
+func (x *PairBalance) Reset() {
+	*x = PairBalance{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryInReport) String() string {
+func (x *PairBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryInReport) ProtoMessage() {}
+func (*PairBalance) ProtoMessage() {}
 
-func (x *CategoryInReport) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[22]
+func (x *PairBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1657,63 +5648,71 @@ func (x *CategoryInReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryInReport.ProtoReflect.Descriptor instead.
-func (*CategoryInReport) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PairBalance.ProtoReflect.Descriptor instead.
+func (*PairBalance) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CategoryInReport) GetCategoryId() int32 {
+func (x *PairBalance) GetDebtorId() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.DebtorId
 	}
 	return 0
 }
 
-func (x *CategoryInReport) GetCategoryName() string {
+func (x *PairBalance) GetDebtorLogin() string {
 	if x != nil {
-		return x.CategoryName
+		return x.DebtorLogin
 	}
 	return ""
 }
 
-func (x *CategoryInReport) GetOperationsCount() int32 {
+func (x *PairBalance) GetCreditorId() int32 {
 	if x != nil {
-		return x.OperationsCount
+		return x.CreditorId
 	}
 	return 0
 }
 
-func (x *CategoryInReport) GetTotalSum() float64 {
+func (x *PairBalance) GetCreditorLogin() string {
 	if x != nil {
-		return x.TotalSum
+		return x.CreditorLogin
+	}
+	return ""
+}
+
+func (x *PairBalance) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-type CategoryReportResponse struct {
+type AccountBalances struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*CategoryInReport    `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	AccountId     int32                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CurrencyId    int32                  `protobuf:"varint,2,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Members       []*MemberBalance       `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Debts         []*PairBalance         `protobuf:"bytes,4,rep,name=debts,proto3" json:"debts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryReportResponse) Reset() {
-	*x = CategoryReportResponse{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[23]
+func (x *AccountBalances) Reset() {
+	*x = AccountBalances{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryReportResponse) String() string {
+func (x *AccountBalances) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryReportResponse) ProtoMessage() {}
+func (*AccountBalances) ProtoMessage() {}
 
-func (x *CategoryReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[23]
+func (x *AccountBalances) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,61 +5723,70 @@ func (x *CategoryReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryReportResponse.ProtoReflect.Descriptor instead.
-func (*CategoryReportResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AccountBalances.ProtoReflect.Descriptor instead.
+func (*AccountBalances) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CategoryReportResponse) GetCategories() []*CategoryInReport {
+func (x *AccountBalances) GetAccountId() int32 {
 	if x != nil {
-		return x.Categories
+		return x.AccountId
 	}
-	return nil
+	return 0
 }
 
-func (x *CategoryReportResponse) GetStart() *timestamppb.Timestamp {
+func (x *AccountBalances) GetCurrencyId() int32 {
 	if x != nil {
-		return x.Start
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *AccountBalances) GetMembers() []*MemberBalance {
+	if x != nil {
+		return x.Members
 	}
 	return nil
 }
 
-func (x *CategoryReportResponse) GetEnd() *timestamppb.Timestamp {
+func (x *AccountBalances) GetDebts() []*PairBalance {
 	if x != nil {
-		return x.End
+		return x.Debts
 	}
 	return nil
 }
 
-type OperationsByAccountAndFiltersRequest struct {
+type Settlement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	OperationId   int32                  `protobuf:"varint,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	CategoryIds   []int32                `protobuf:"varint,5,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
-	OperationType string                 `protobuf:"bytes,6,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
-	AccountType   string                 `protobuf:"bytes,7,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	PayerId       int32                  `protobuf:"varint,3,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	PayerLogin    string                 `protobuf:"bytes,4,opt,name=payer_login,json=payerLogin,proto3" json:"payer_login,omitempty"`
+	PayeeId       int32                  `protobuf:"varint,5,opt,name=payee_id,json=payeeId,proto3" json:"payee_id,omitempty"`
+	PayeeLogin    string                 `protobuf:"bytes,6,opt,name=payee_login,json=payeeLogin,proto3" json:"payee_login,omitempty"`
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedBy     int32                  `protobuf:"varint,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationsByAccountAndFiltersRequest) Reset() {
-	*x = OperationsByAccountAndFiltersRequest{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[24]
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationsByAccountAndFiltersRequest) String() string {
+func (x *Settlement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationsByAccountAndFiltersRequest) ProtoMessage() {}
+func (*Settlement) ProtoMessage() {}
 
-func (x *OperationsByAccountAndFiltersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[24]
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,92 +5797,109 @@ func (x *OperationsByAccountAndFiltersRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationsByAccountAndFiltersRequest.ProtoReflect.Descriptor instead.
-func (*OperationsByAccountAndFiltersRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *OperationsByAccountAndFiltersRequest) GetUserId() int32 {
+func (x *Settlement) GetId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *OperationsByAccountAndFiltersRequest) GetAccountId() int32 {
+func (x *Settlement) GetAccountId() int32 {
 	if x != nil {
 		return x.AccountId
 	}
 	return 0
 }
 
-func (x *OperationsByAccountAndFiltersRequest) GetOperationId() int32 {
+func (x *Settlement) GetPayerId() int32 {
 	if x != nil {
-		return x.OperationId
+		return x.PayerId
 	}
 	return 0
 }
 
-func (x *OperationsByAccountAndFiltersRequest) GetName() string {
+func (x *Settlement) GetPayerLogin() string {
 	if x != nil {
-		return x.Name
+		return x.PayerLogin
 	}
 	return ""
 }
 
-func (x *OperationsByAccountAndFiltersRequest) GetCategoryIds() []int32 {
+func (x *Settlement) GetPayeeId() int32 {
 	if x != nil {
-		return x.CategoryIds
+		return x.PayeeId
 	}
-	return nil
+	return 0
 }
 
-func (x *OperationsByAccountAndFiltersRequest) GetOperationType() string {
+func (x *Settlement) GetPayeeLogin() string {
 	if x != nil {
-		return x.OperationType
+		return x.PayeeLogin
 	}
 	return ""
 }
 
-func (x *OperationsByAccountAndFiltersRequest) GetAccountType() string {
+func (x *Settlement) GetAmount() float64 {
 	if x != nil {
-		return x.AccountType
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Settlement) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *OperationsByAccountAndFiltersRequest) GetDate() *timestamppb.Timestamp {
+func (x *Settlement) GetCreatedBy() int32 {
 	if x != nil {
-		return x.Date
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Settlement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type SharingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SharingId     int32                  `protobuf:"varint,1,opt,name=sharing_id,json=sharingId,proto3" json:"sharing_id,omitempty"`
-	AccountId     int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+type CreateSettlementRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId int32                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// the calling user if empty
+	PayerId       int32   `protobuf:"varint,3,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	PayeeId       int32   `protobuf:"varint,4,opt,name=payee_id,json=payeeId,proto3" json:"payee_id,omitempty"`
+	Amount        float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string  `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SharingsResponse) Reset() {
-	*x = SharingsResponse{}
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[25]
+func (x *CreateSettlementRequest) Reset() {
+	*x = CreateSettlementRequest{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SharingsResponse) String() string {
+func (x *CreateSettlementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SharingsResponse) ProtoMessage() {}
+func (*CreateSettlementRequest) ProtoMessage() {}
 
-func (x *SharingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[25]
+func (x *CreateSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1885,35 +5910,93 @@ func (x *SharingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SharingsResponse.ProtoReflect.Descriptor instead.
-func (*SharingsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CreateSettlementRequest.ProtoReflect.Descriptor instead.
+func (*CreateSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *SharingsResponse) GetSharingId() int32 {
+func (x *CreateSettlementRequest) GetUserId() int32 {
 	if x != nil {
-		return x.SharingId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *SharingsResponse) GetAccountId() int32 {
+func (x *CreateSettlementRequest) GetAccountId() int32 {
 	if x != nil {
 		return x.AccountId
 	}
 	return 0
 }
 
-func (x *SharingsResponse) GetUserId() int32 {
+func (x *CreateSettlementRequest) GetPayerId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.PayerId
 	}
 	return 0
 }
 
-func (x *SharingsResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreateSettlementRequest) GetPayeeId() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.PayeeId
+	}
+	return 0
+}
+
+func (x *CreateSettlementRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateSettlementRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListSettlementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlements   []*Settlement          `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettlementsResponse) Reset() {
+	*x = ListSettlementsResponse{}
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettlementsResponse) ProtoMessage() {}
+
+func (x *ListSettlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_finance_service_proto_finance_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettlementsResponse.ProtoReflect.Descriptor instead.
+func (*ListSettlementsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_finance_service_proto_finance_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListSettlementsResponse) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
 	}
 	return nil
 }
@@ -1922,7 +6005,7 @@ var File_internal_app_finance_service_proto_finance_proto protoreflect.FileDescr
 
 const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"\n" +
-	"0internal/app/finance_service/proto/finance.proto\x12\afinance\x1a\x1fgoogle/protobuf/timestamp.proto\"\x94\x02\n" +
+	"0internal/app/finance_service/proto/finance.proto\x12\afinance\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x02\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x12\n" +
@@ -1934,7 +6017,8 @@ const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc9\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04role\x18\t \x01(\tR\x04role\"\xc9\x01\n" +
 	"\x14CreateAccountRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x18\n" +
 	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x12\n" +
@@ -1958,16 +6042,58 @@ const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"\x0eAccountRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
-	"account_id\x18\x02 \x01(\x05R\taccountId\"S\n" +
-	"\x13AddToAccountReqeust\x12\x1d\n" +
+	"account_id\x18\x02 \x01(\x05R\taccountId\"\xa5\x03\n" +
+	"\x11AccountInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x05R\taccountId\x12!\n" +
+	"\faccount_name\x18\x03 \x01(\tR\vaccountName\x12\x1d\n" +
+	"\n" +
+	"inviter_id\x18\x04 \x01(\x05R\tinviterId\x12#\n" +
+	"\rinviter_login\x18\x05 \x01(\tR\finviterLogin\x12\x1d\n" +
+	"\n" +
+	"invitee_id\x18\x06 \x01(\x05R\tinviteeId\x12#\n" +
+	"\rinvitee_login\x18\a \x01(\tR\finviteeLogin\x12\x12\n" +
+	"\x04role\x18\b \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x14\n" +
+	"\x05token\x18\n" +
+	" \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8b\x01\n" +
+	"\x1eCreateAccountInvitationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x05R\taccountId\x12\x1d\n" +
 	"\n" +
-	"user_login\x18\x01 \x01(\tR\tuserLogin\x12\x1d\n" +
+	"user_login\x18\x03 \x01(\tR\tuserLogin\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"X\n" +
+	"\x18AccountInvitationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12#\n" +
+	"\rinvitation_id\x18\x02 \x01(\x05R\finvitationId\"L\n" +
+	"\x1bAcceptInvitationLinkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"^\n" +
+	"\x1eListAccountInvitationsResponse\x12<\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x1a.finance.AccountInvitationR\vinvitations\"\x7f\n" +
+	"\x14AccountMemberRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
-	"account_id\x18\x02 \x01(\x05R\taccountId\"!\n" +
+	"account_id\x18\x02 \x01(\x05R\taccountId\x12\x1b\n" +
+	"\tmember_id\x18\x03 \x01(\x05R\bmemberId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"Q\n" +
+	"\x1aListAccountMembersResponse\x123\n" +
+	"\amembers\x18\x01 \x03(\v2\x19.finance.SharingsResponseR\amembers\"!\n" +
 	"\x06UserID\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"D\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"*\n" +
+	"\tAccountID\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x05R\taccountId\"$\n" +
+	"\aUserIDs\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x05R\auserIds\"D\n" +
 	"\x14ListAccountsResponse\x12,\n" +
-	"\baccounts\x18\x01 \x03(\v2\x10.finance.AccountR\baccounts\"\xc4\x03\n" +
+	"\baccounts\x18\x01 \x03(\v2\x10.finance.AccountR\baccounts\"\xe8\x03\n" +
 	"\tOperation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -1988,7 +6114,8 @@ const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"\faccount_type\x18\f \x01(\tR\vaccountType\x129\n" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12.\n" +
-	"\x04date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xed\x03\n" +
+	"\x04date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\"\n" +
+	"\raccount_to_id\x18\x0f \x01(\x05R\vaccountToId\"\xed\x03\n" +
 	"\x0fOperationInList\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -2047,7 +6174,14 @@ const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"\x16ListOperationsResponse\x128\n" +
 	"\n" +
 	"operations\x18\x01 \x03(\v2\x18.finance.OperationInListR\n" +
-	"operations\"\xa0\x02\n" +
+	"operations\"V\n" +
+	"\x16OperationsByIDsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12#\n" +
+	"\roperation_ids\x18\x02 \x03(\x05R\foperationIds\"M\n" +
+	"\x17OperationsByIDsResponse\x122\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2\x12.finance.OperationR\n" +
+	"operations\"\xbd\x02\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
@@ -2058,36 +6192,59 @@ const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8c\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tparent_id\x18\t \x01(\x05R\bparentId\"\xa9\x01\n" +
 	"\x15CreateCategoryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
-	"\x0elogo_hashed_id\x18\x04 \x01(\tR\flogoHashedId\"\xe8\x01\n" +
+	"\x0elogo_hashed_id\x18\x04 \x01(\tR\flogoHashedId\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\x05R\bparentId\"\x98\x02\n" +
 	"\x15UpdateCategoryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x05R\n" +
 	"categoryId\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12)\n" +
-	"\x0elogo_hashed_id\x18\x05 \x01(\tH\x02R\flogoHashedId\x88\x01\x01B\a\n" +
+	"\x0elogo_hashed_id\x18\x05 \x01(\tH\x02R\flogoHashedId\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x06 \x01(\x05H\x03R\bparentId\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x11\n" +
-	"\x0f_logo_hashed_id\"K\n" +
+	"\x0f_logo_hashed_idB\f\n" +
+	"\n" +
+	"_parent_id\"K\n" +
 	"\x0fCategoryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x05R\n" +
-	"categoryId\"U\n" +
+	"categoryId\"r\n" +
+	"\x15DeleteCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x1f\n" +
+	"\vreassign_to\x18\x03 \x01(\x05R\n" +
+	"reassignTo\"k\n" +
+	"\x16MergeCategoriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x05R\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x05R\btargetId\"o\n" +
+	"\x17MergeCategoriesResponse\x12)\n" +
+	"\x06target\x18\x01 \x01(\v2\x11.finance.CategoryR\x06target\x12)\n" +
+	"\x10moved_operations\x18\x02 \x01(\x05R\x0fmovedOperations\"r\n" +
+	"!ProvisionDefaultCategoriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x1c\n" +
+	"\toverwrite\x18\x03 \x01(\bR\toverwrite\"U\n" +
 	"\x15CategoryByNameRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12#\n" +
 	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\"K\n" +
 	"\x16ListCategoriesResponse\x121\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x11.finance.CategoryR\n" +
-	"categories\"m\n" +
+	"categories\"\x9f\x01\n" +
 	"\x11CategoryWithStats\x12-\n" +
 	"\bcategory\x18\x01 \x01(\v2\x11.finance.CategoryR\bcategory\x12)\n" +
-	"\x10operations_count\x18\x02 \x01(\x05R\x0foperationsCount\"]\n" +
+	"\x10operations_count\x18\x02 \x01(\x05R\x0foperationsCount\x120\n" +
+	"\x14own_operations_count\x18\x03 \x01(\x05R\x12ownOperationsCount\"]\n" +
 	"\x1fListCategoriesWithStatsResponse\x12:\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1a.finance.CategoryWithStatsR\n" +
@@ -2095,13 +6252,16 @@ const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"\x15CategoryReportRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x120\n" +
 	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
-	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\xa0\x01\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\x88\x02\n" +
 	"\x10CategoryInReport\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x05R\n" +
 	"categoryId\x12#\n" +
 	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12)\n" +
 	"\x10operations_count\x18\x03 \x01(\x05R\x0foperationsCount\x12\x1b\n" +
-	"\ttotal_sum\x18\x04 \x01(\x01R\btotalSum\"\xb3\x01\n" +
+	"\ttotal_sum\x18\x04 \x01(\x01R\btotalSum\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\x05R\bparentId\x120\n" +
+	"\x14own_operations_count\x18\x06 \x01(\x05R\x12ownOperationsCount\x12\x17\n" +
+	"\aown_sum\x18\a \x01(\x01R\x06ownSum\"\xb3\x01\n" +
 	"\x16CategoryReportResponse\x129\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x19.finance.CategoryInReportR\n" +
@@ -2117,7 +6277,7 @@ const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"\fcategory_ids\x18\x05 \x03(\x05R\vcategoryIds\x12%\n" +
 	"\x0eoperation_type\x18\x06 \x01(\tR\roperationType\x12!\n" +
 	"\faccount_type\x18\a \x01(\tR\vaccountType\x12.\n" +
-	"\x04date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xa4\x01\n" +
+	"\x04date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xd7\x01\n" +
 	"\x10SharingsResponse\x12\x1d\n" +
 	"\n" +
 	"sharing_id\x18\x01 \x01(\x05R\tsharingId\x12\x1d\n" +
@@ -2125,29 +6285,375 @@ const file_internal_app_finance_service_proto_finance_proto_rawDesc = "" +
 	"account_id\x18\x02 \x01(\x05R\taccountId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\x05R\x06userId\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt2\xf2\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x06 \x01(\tR\tuserLogin\"\xc1\x01\n" +
+	"\bReceiver\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\x0elogo_hashed_id\x18\x03 \x01(\tR\flogoHashedId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x120\n" +
+	"\x14counterparty_user_id\x18\x05 \x01(\x05R\x12counterpartyUserId\"\x8d\x02\n" +
+	"\x0eUserDataExport\x12,\n" +
+	"\baccounts\x18\x01 \x03(\v2\x10.finance.AccountR\baccounts\x121\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x11.finance.CategoryR\n" +
+	"categories\x122\n" +
+	"\n" +
+	"operations\x18\x03 \x03(\v2\x12.finance.OperationR\n" +
+	"operations\x12/\n" +
+	"\treceivers\x18\x04 \x03(\v2\x11.finance.ReceiverR\treceivers\x125\n" +
+	"\bsharings\x18\x05 \x03(\v2\x19.finance.SharingsResponseR\bsharings\"z\n" +
+	"\x15ImportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tbackup_id\x18\x02 \x01(\tR\bbackupId\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.finance.UserDataExportR\x04data\"\x9b\x02\n" +
+	"\x16ImportUserDataResponse\x12+\n" +
+	"\x11accounts_restored\x18\x01 \x01(\x05R\x10accountsRestored\x12/\n" +
+	"\x13categories_restored\x18\x02 \x01(\x05R\x12categoriesRestored\x12/\n" +
+	"\x13operations_restored\x18\x03 \x01(\x05R\x12operationsRestored\x12-\n" +
+	"\x12receivers_restored\x18\x04 \x01(\x05R\x11receiversRestored\x12C\n" +
+	"\x13restored_operations\x18\x05 \x03(\v2\x12.finance.OperationR\x12restoredOperations\"\xbb\x01\n" +
+	"\x10UserDataDeletion\x12.\n" +
+	"\x13deleted_account_ids\x18\x01 \x03(\x05R\x11deletedAccountIds\x12(\n" +
+	"\x10left_account_ids\x18\x02 \x03(\x05R\x0eleftAccountIds\x12M\n" +
+	"\x18uncategorized_operations\x18\x03 \x03(\v2\x12.finance.OperationR\x17uncategorizedOperations\"\x1c\n" +
+	"\bImageIDs\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\x96\x03\n" +
+	"\fCategoryRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12#\n" +
+	"\rname_contains\x18\x05 \x01(\tR\fnameContains\x12%\n" +
+	"\x0eoperation_type\x18\x06 \x01(\tR\roperationType\x12 \n" +
+	"\tamount_gt\x18\a \x01(\x01H\x00R\bamountGt\x88\x01\x01\x12 \n" +
+	"\tamount_lt\x18\b \x01(\x01H\x01R\bamountLt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_amount_gtB\f\n" +
+	"\n" +
+	"_amount_lt\"\x81\x02\n" +
+	"\x19CreateCategoryRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rname_contains\x18\x03 \x01(\tR\fnameContains\x12%\n" +
+	"\x0eoperation_type\x18\x04 \x01(\tR\roperationType\x12 \n" +
+	"\tamount_gt\x18\x05 \x01(\x01H\x00R\bamountGt\x88\x01\x01\x12 \n" +
+	"\tamount_lt\x18\x06 \x01(\x01H\x01R\bamountLt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_amount_gtB\f\n" +
+	"\n" +
+	"_amount_lt\"\x9a\x02\n" +
+	"\x19UpdateCategoryRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x05R\x06ruleId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rname_contains\x18\x04 \x01(\tR\fnameContains\x12%\n" +
+	"\x0eoperation_type\x18\x05 \x01(\tR\roperationType\x12 \n" +
+	"\tamount_gt\x18\x06 \x01(\x01H\x00R\bamountGt\x88\x01\x01\x12 \n" +
+	"\tamount_lt\x18\a \x01(\x01H\x01R\bamountLt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_amount_gtB\f\n" +
+	"\n" +
+	"_amount_lt\"G\n" +
+	"\x13CategoryRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x05R\x06ruleId\"H\n" +
+	"\x19ListCategoryRulesResponse\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.finance.CategoryRuleR\x05rules\"Q\n" +
+	"\x1bReorderCategoryRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\brule_ids\x18\x02 \x03(\x05R\aruleIds\"m\n" +
+	"\x18TestCategoryRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03sum\x18\x03 \x01(\x01R\x03sum\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\"`\n" +
+	"\x19TestCategoryRulesResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\bR\amatched\x12)\n" +
+	"\x04rule\x18\x02 \x01(\v2\x15.finance.CategoryRuleR\x04rule\"R\n" +
+	"\x19ApplyCategoryRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1c\n" +
+	"\toverwrite\x18\x02 \x01(\bR\toverwrite\"p\n" +
+	"\x1aApplyCategoryRulesResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x128\n" +
+	"\rrecategorized\x18\x02 \x03(\v2\x12.finance.OperationR\rrecategorized\"k\n" +
+	"\x16SuggestCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03sum\x18\x03 \x01(\x01R\x03sum\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\"\xad\x01\n" +
+	"\x17SuggestCategoryResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\"\xb5\x02\n" +
+	"\x14SpendingStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vcurrency_id\x18\x02 \x01(\x05R\n" +
+	"currencyId\x12!\n" +
+	"\fcategory_ids\x18\x03 \x03(\x05R\vcategoryIds\x12\x1f\n" +
+	"\vaccount_ids\x18\x04 \x03(\x05R\n" +
+	"accountIds\x120\n" +
+	"\x05start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12?\n" +
+	"\rhistory_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fhistoryStart\"Q\n" +
+	"\rDailySpending\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x10\n" +
+	"\x03sum\x18\x02 \x01(\x01R\x03sum\"\xee\x01\n" +
+	"\x10RecurringExpense\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06months\x18\x03 \x01(\x05R\x06months\x12\x17\n" +
+	"\aavg_sum\x18\x04 \x01(\x01R\x06avgSum\x12 \n" +
+	"\fday_of_month\x18\x05 \x01(\x05R\n" +
+	"dayOfMonth\x127\n" +
+	"\tlast_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\blastDate\x12\x19\n" +
+	"\blast_sum\x18\a \x01(\x01R\alastSum\"|\n" +
+	"\x15SpendingStatsResponse\x12*\n" +
+	"\x04days\x18\x01 \x03(\v2\x16.finance.DailySpendingR\x04days\x127\n" +
+	"\trecurring\x18\x02 \x03(\v2\x19.finance.RecurringExpenseR\trecurring\"E\n" +
+	"\x10CategorySpending\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12\x10\n" +
+	"\x03sum\x18\x02 \x01(\x01R\x03sum\"b\n" +
+	"\x0eSpendingTotals\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x01R\x05total\x12:\n" +
+	"\vby_category\x18\x02 \x03(\v2\x19.finance.CategorySpendingR\n" +
+	"byCategory\"x\n" +
+	"\x13CounterpartyBalance\x12\x1f\n" +
+	"\vcurrency_id\x18\x01 \x01(\x05R\n" +
+	"currencyId\x12\x12\n" +
+	"\x04lent\x18\x02 \x01(\x01R\x04lent\x12\x1a\n" +
+	"\bborrowed\x18\x03 \x01(\x01R\bborrowed\x12\x10\n" +
+	"\x03net\x18\x04 \x01(\x01R\x03net\"w\n" +
+	"\fCounterparty\x12-\n" +
+	"\breceiver\x18\x01 \x01(\v2\x11.finance.ReceiverR\breceiver\x128\n" +
+	"\bbalances\x18\x02 \x03(\v2\x1c.finance.CounterpartyBalanceR\bbalances\"[\n" +
+	"\x1aListCounterpartiesResponse\x12=\n" +
+	"\x0ecounterparties\x18\x01 \x03(\v2\x15.finance.CounterpartyR\x0ecounterparties\"\xde\x04\n" +
+	"\x04Debt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vreceiver_id\x18\x03 \x01(\x05R\n" +
+	"receiverId\x12#\n" +
+	"\rreceiver_name\x18\x04 \x01(\tR\freceiverName\x120\n" +
+	"\x14counterparty_user_id\x18\x05 \x01(\x05R\x12counterpartyUserId\x12\x1c\n" +
+	"\tdirection\x18\x06 \x01(\tR\tdirection\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\a \x01(\x05R\taccountId\x12\x1f\n" +
+	"\vcurrency_id\x18\b \x01(\x05R\n" +
+	"currencyId\x12\x1c\n" +
+	"\tprincipal\x18\t \x01(\x01R\tprincipal\x12\x16\n" +
+	"\x06repaid\x18\n" +
+	" \x01(\x01R\x06repaid\x12 \n" +
+	"\voutstanding\x18\v \x01(\x01R\voutstanding\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x125\n" +
+	"\bdue_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x16\n" +
+	"\x06closed\x18\x0e \x01(\bR\x06closed\x12\x18\n" +
+	"\aoverdue\x18\x0f \x01(\bR\aoverdue\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x85\x03\n" +
+	"\x11CreateDebtRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vreceiver_id\x18\x02 \x01(\x05R\n" +
+	"receiverId\x12#\n" +
+	"\rreceiver_name\x18\x03 \x01(\tR\freceiverName\x12-\n" +
+	"\x12counterparty_login\x18\x04 \x01(\tR\x11counterpartyLogin\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\x05R\taccountId\x12\x1c\n" +
+	"\tprincipal\x18\a \x01(\x01R\tprincipal\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x125\n" +
+	"\bdue_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12.\n" +
+	"\x04date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"C\n" +
+	"\x10ListDebtsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"8\n" +
+	"\x11ListDebtsResponse\x12#\n" +
+	"\x05debts\x18\x01 \x03(\v2\r.finance.DebtR\x05debts\"?\n" +
+	"\vDebtRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\adebt_id\x18\x02 \x01(\x05R\x06debtId\"\xb5\x01\n" +
+	"\x1aCreateDebtRepaymentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\adebt_id\x18\x02 \x01(\x05R\x06debtId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x05R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12.\n" +
+	"\x04date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xab\x02\n" +
+	"\vDebtPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\adebt_id\x18\x02 \x01(\x05R\x06debtId\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\x05R\voperationId\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\x05R\taccountId\x12\x1a\n" +
+	"\breverted\x18\a \x01(\bR\breverted\x12.\n" +
+	"\x04date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"L\n" +
+	"\x18ListDebtPaymentsResponse\x120\n" +
+	"\bpayments\x18\x01 \x03(\v2\x14.finance.DebtPaymentR\bpayments\"r\n" +
+	"\n" +
+	"SplitShare\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\"\xb4\x02\n" +
+	"\x0eOperationSplit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
+	"\foperation_id\x18\x02 \x01(\x05R\voperationId\x12\x1d\n" +
 	"\n" +
+	"account_id\x18\x03 \x01(\x05R\taccountId\x12\x19\n" +
+	"\bpayer_id\x18\x04 \x01(\x05R\apayerId\x12\x1f\n" +
+	"\vpayer_login\x18\x05 \x01(\tR\n" +
+	"payerLogin\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x14\n" +
+	"\x05total\x18\a \x01(\x01R\x05total\x12+\n" +
+	"\x06shares\x18\b \x03(\v2\x13.finance.SplitShareR\x06shares\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd2\x01\n" +
+	"\x15SplitOperationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x05R\taccountId\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\x05R\voperationId\x12\x19\n" +
+	"\bpayer_id\x18\x04 \x01(\x05R\apayerId\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12+\n" +
+	"\x06shares\x18\x06 \x03(\v2\x13.finance.SplitShareR\x06shares\"Y\n" +
+	"\rMemberBalance\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x10\n" +
+	"\x03net\x18\x03 \x01(\x01R\x03net\"\xad\x01\n" +
+	"\vPairBalance\x12\x1b\n" +
+	"\tdebtor_id\x18\x01 \x01(\x05R\bdebtorId\x12!\n" +
+	"\fdebtor_login\x18\x02 \x01(\tR\vdebtorLogin\x12\x1f\n" +
+	"\vcreditor_id\x18\x03 \x01(\x05R\n" +
+	"creditorId\x12%\n" +
+	"\x0ecreditor_login\x18\x04 \x01(\tR\rcreditorLogin\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\"\xaf\x01\n" +
+	"\x0fAccountBalances\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x05R\taccountId\x12\x1f\n" +
+	"\vcurrency_id\x18\x02 \x01(\x05R\n" +
+	"currencyId\x120\n" +
+	"\amembers\x18\x03 \x03(\v2\x16.finance.MemberBalanceR\amembers\x12*\n" +
+	"\x05debts\x18\x04 \x03(\v2\x14.finance.PairBalanceR\x05debts\"\xc7\x02\n" +
+	"\n" +
+	"Settlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x05R\taccountId\x12\x19\n" +
+	"\bpayer_id\x18\x03 \x01(\x05R\apayerId\x12\x1f\n" +
+	"\vpayer_login\x18\x04 \x01(\tR\n" +
+	"payerLogin\x12\x19\n" +
+	"\bpayee_id\x18\x05 \x01(\x05R\apayeeId\x12\x1f\n" +
+	"\vpayee_login\x18\x06 \x01(\tR\n" +
+	"payeeLogin\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\x05R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc1\x01\n" +
+	"\x17CreateSettlementRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x05R\taccountId\x12\x19\n" +
+	"\bpayer_id\x18\x03 \x01(\x05R\apayerId\x12\x19\n" +
+	"\bpayee_id\x18\x04 \x01(\x05R\apayeeId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"P\n" +
+	"\x17ListSettlementsResponse\x125\n" +
+	"\vsettlements\x18\x01 \x03(\v2\x13.finance.SettlementR\vsettlements2\xe7$\n" +
 	"\x0eFinanceService\x12@\n" +
 	"\rCreateAccount\x12\x1d.finance.CreateAccountRequest\x1a\x10.finance.Account\x127\n" +
 	"\n" +
 	"GetAccount\x12\x17.finance.AccountRequest\x1a\x10.finance.Account\x12C\n" +
 	"\x11GetAccountsByUser\x12\x0f.finance.UserID\x1a\x1d.finance.ListAccountsResponse\x12@\n" +
 	"\rUpdateAccount\x12\x1d.finance.UpdateAccountRequest\x1a\x10.finance.Account\x12:\n" +
-	"\rDeleteAccount\x12\x17.finance.AccountRequest\x1a\x10.finance.Account\x12L\n" +
-	"\x11AddUserToAccounnt\x12\x1c.finance.AddToAccountReqeust\x1a\x19.finance.SharingsResponse\x12F\n" +
+	"\rDeleteAccount\x12\x17.finance.AccountRequest\x1a\x10.finance.Account\x12^\n" +
+	"\x17CreateAccountInvitation\x12'.finance.CreateAccountInvitationRequest\x1a\x1a.finance.AccountInvitation\x12Q\n" +
+	"\x15GetPendingInvitations\x12\x0f.finance.UserID\x1a'.finance.ListAccountInvitationsResponse\x12Y\n" +
+	"\x15GetAccountInvitations\x12\x17.finance.AccountRequest\x1a'.finance.ListAccountInvitationsResponse\x12W\n" +
+	"\x17AcceptAccountInvitation\x12!.finance.AccountInvitationRequest\x1a\x19.finance.SharingsResponse\x12W\n" +
+	"\x14AcceptInvitationLink\x12$.finance.AcceptInvitationLinkRequest\x1a\x19.finance.SharingsResponse\x12Y\n" +
+	"\x18DeclineAccountInvitation\x12!.finance.AccountInvitationRequest\x1a\x1a.finance.AccountInvitation\x12X\n" +
+	"\x17RevokeAccountInvitation\x12!.finance.AccountInvitationRequest\x1a\x1a.finance.AccountInvitation\x12Q\n" +
+	"\x11GetAccountMembers\x12\x17.finance.AccountRequest\x1a#.finance.ListAccountMembersResponse\x12S\n" +
+	"\x17UpdateAccountMemberRole\x12\x1d.finance.AccountMemberRequest\x1a\x19.finance.SharingsResponse\x12O\n" +
+	"\x13RemoveAccountMember\x12\x1d.finance.AccountMemberRequest\x1a\x19.finance.SharingsResponse\x12B\n" +
+	"\fLeaveAccount\x12\x17.finance.AccountRequest\x1a\x19.finance.SharingsResponse\x12^\n" +
+	"\x18TransferAccountOwnership\x12\x1d.finance.AccountMemberRequest\x1a#.finance.ListAccountMembersResponse\x129\n" +
+	"\x11GetAccountUserIDs\x12\x12.finance.AccountID\x1a\x10.finance.UserIDs\x12F\n" +
 	"\x0fCreateOperation\x12\x1f.finance.CreateOperationRequest\x1a\x12.finance.Operation\x12=\n" +
 	"\fGetOperation\x12\x19.finance.OperationRequest\x1a\x12.finance.Operation\x12h\n" +
 	"\x16GetOperationsByAccount\x12-.finance.OperationsByAccountAndFiltersRequest\x1a\x1f.finance.ListOperationsResponse\x12F\n" +
 	"\x0fUpdateOperation\x12\x1f.finance.UpdateOperationRequest\x1a\x12.finance.Operation\x12@\n" +
-	"\x0fDeleteOperation\x12\x19.finance.OperationRequest\x1a\x12.finance.Operation\x12C\n" +
+	"\x0fDeleteOperation\x12\x19.finance.OperationRequest\x1a\x12.finance.Operation\x12W\n" +
+	"\x12GetOperationsByIDs\x12\x1f.finance.OperationsByIDsRequest\x1a .finance.OperationsByIDsResponse\x12C\n" +
 	"\x0eCreateCategory\x12\x1e.finance.CreateCategoryRequest\x1a\x11.finance.Category\x12C\n" +
 	"\vGetCategory\x12\x18.finance.CategoryRequest\x1a\x1a.finance.CategoryWithStats\x12O\n" +
 	"\x11GetCategoryByName\x12\x1e.finance.CategoryByNameRequest\x1a\x1a.finance.CategoryWithStats\x12G\n" +
 	"\x13GetCategoriesByUser\x12\x0f.finance.UserID\x1a\x1f.finance.ListCategoriesResponse\x12Y\n" +
 	"\x1cGetCategoriesWithStatsByUser\x12\x0f.finance.UserID\x1a(.finance.ListCategoriesWithStatsResponse\x12C\n" +
-	"\x0eUpdateCategory\x12\x1e.finance.UpdateCategoryRequest\x1a\x11.finance.Category\x12=\n" +
-	"\x0eDeleteCategory\x12\x18.finance.CategoryRequest\x1a\x11.finance.Category\x12V\n" +
-	"\x13GetCategoriesReport\x12\x1e.finance.CategoryReportRequest\x1a\x1f.finance.CategoryReportResponseBQZOgithub.com/go-park-mail-ru/2025_2_VKarmane/internal/finance_service/proto;protob\x06proto3"
+	"\x0eUpdateCategory\x12\x1e.finance.UpdateCategoryRequest\x1a\x11.finance.Category\x12C\n" +
+	"\x0eDeleteCategory\x12\x1e.finance.DeleteCategoryRequest\x1a\x11.finance.Category\x12T\n" +
+	"\x0fMergeCategories\x12\x1f.finance.MergeCategoriesRequest\x1a .finance.MergeCategoriesResponse\x12i\n" +
+	"\x1aProvisionDefaultCategories\x12*.finance.ProvisionDefaultCategoriesRequest\x1a\x1f.finance.ListCategoriesResponse\x12V\n" +
+	"\x13GetCategoriesReport\x12\x1e.finance.CategoryReportRequest\x1a\x1f.finance.CategoryReportResponse\x12:\n" +
+	"\x0eExportUserData\x12\x0f.finance.UserID\x1a\x17.finance.UserDataExport\x12Q\n" +
+	"\x0eImportUserData\x12\x1e.finance.ImportUserDataRequest\x1a\x1f.finance.ImportUserDataResponse\x12<\n" +
+	"\x0eDeleteUserData\x12\x0f.finance.UserID\x1a\x19.finance.UserDataDeletion\x128\n" +
+	"\x10FilterUsedImages\x12\x11.finance.ImageIDs\x1a\x11.finance.ImageIDs\x12O\n" +
+	"\x12CreateCategoryRule\x12\".finance.CreateCategoryRuleRequest\x1a\x15.finance.CategoryRule\x12G\n" +
+	"\x10GetCategoryRules\x12\x0f.finance.UserID\x1a\".finance.ListCategoryRulesResponse\x12O\n" +
+	"\x12UpdateCategoryRule\x12\".finance.UpdateCategoryRuleRequest\x1a\x15.finance.CategoryRule\x12I\n" +
+	"\x12DeleteCategoryRule\x12\x1c.finance.CategoryRuleRequest\x1a\x15.finance.CategoryRule\x12`\n" +
+	"\x14ReorderCategoryRules\x12$.finance.ReorderCategoryRulesRequest\x1a\".finance.ListCategoryRulesResponse\x12Z\n" +
+	"\x11TestCategoryRules\x12!.finance.TestCategoryRulesRequest\x1a\".finance.TestCategoryRulesResponse\x12]\n" +
+	"\x12ApplyCategoryRules\x12\".finance.ApplyCategoryRulesRequest\x1a#.finance.ApplyCategoryRulesResponse\x12T\n" +
+	"\x0fSuggestCategory\x12\x1f.finance.SuggestCategoryRequest\x1a .finance.SuggestCategoryResponse\x12Q\n" +
+	"\x10GetSpendingStats\x12\x1d.finance.SpendingStatsRequest\x1a\x1e.finance.SpendingStatsResponse\x12K\n" +
+	"\x11GetSpendingTotals\x12\x1d.finance.SpendingStatsRequest\x1a\x17.finance.SpendingTotals\x12I\n" +
+	"\x11GetCounterparties\x12\x0f.finance.UserID\x1a#.finance.ListCounterpartiesResponse\x127\n" +
+	"\n" +
+	"CreateDebt\x12\x1a.finance.CreateDebtRequest\x1a\r.finance.Debt\x12A\n" +
+	"\bGetDebts\x12\x19.finance.ListDebtsRequest\x1a\x1a.finance.ListDebtsResponse\x12.\n" +
+	"\aGetDebt\x12\x14.finance.DebtRequest\x1a\r.finance.Debt\x121\n" +
+	"\n" +
+	"DeleteDebt\x12\x14.finance.DebtRequest\x1a\r.finance.Debt\x12M\n" +
+	"\x10AddDebtRepayment\x12#.finance.CreateDebtRepaymentRequest\x1a\x14.finance.DebtPayment\x12J\n" +
+	"\x0fGetDebtPayments\x12\x14.finance.DebtRequest\x1a!.finance.ListDebtPaymentsResponse\x12I\n" +
+	"\x0eSplitOperation\x12\x1e.finance.SplitOperationRequest\x1a\x17.finance.OperationSplit\x12G\n" +
+	"\x11GetOperationSplit\x12\x19.finance.OperationRequest\x1a\x17.finance.OperationSplit\x12J\n" +
+	"\x14DeleteOperationSplit\x12\x19.finance.OperationRequest\x1a\x17.finance.OperationSplit\x12G\n" +
+	"\x12GetAccountBalances\x12\x17.finance.AccountRequest\x1a\x18.finance.AccountBalances\x12I\n" +
+	"\x10CreateSettlement\x12 .finance.CreateSettlementRequest\x1a\x13.finance.Settlement\x12K\n" +
+	"\x0eGetSettlements\x12\x17.finance.AccountRequest\x1a .finance.ListSettlementsResponseBQZOgithub.com/go-park-mail-ru/2025_2_VKarmane/internal/finance_service/proto;protob\x06proto3"
 
 var (
 	file_internal_app_finance_service_proto_finance_proto_rawDescOnce sync.Once
@@ -2161,102 +6667,292 @@ func file_internal_app_finance_service_proto_finance_proto_rawDescGZIP() []byte
 	return file_internal_app_finance_service_proto_finance_proto_rawDescData
 }
 
-var file_internal_app_finance_service_proto_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_internal_app_finance_service_proto_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_internal_app_finance_service_proto_finance_proto_goTypes = []any{
 	(*Account)(nil),                              // 0: finance.Account
 	(*CreateAccountRequest)(nil),                 // 1: finance.CreateAccountRequest
 	(*UpdateAccountRequest)(nil),                 // 2: finance.UpdateAccountRequest
 	(*AccountRequest)(nil),                       // 3: finance.AccountRequest
-	(*AddToAccountReqeust)(nil),                  // 4: finance.AddToAccountReqeust
-	(*UserID)(nil),                               // 5: finance.UserID
-	(*ListAccountsResponse)(nil),                 // 6: finance.ListAccountsResponse
-	(*Operation)(nil),                            // 7: finance.Operation
-	(*OperationInList)(nil),                      // 8: finance.OperationInList
-	(*CreateOperationRequest)(nil),               // 9: finance.CreateOperationRequest
-	(*UpdateOperationRequest)(nil),               // 10: finance.UpdateOperationRequest
-	(*OperationRequest)(nil),                     // 11: finance.OperationRequest
-	(*ListOperationsResponse)(nil),               // 12: finance.ListOperationsResponse
-	(*Category)(nil),                             // 13: finance.Category
-	(*CreateCategoryRequest)(nil),                // 14: finance.CreateCategoryRequest
-	(*UpdateCategoryRequest)(nil),                // 15: finance.UpdateCategoryRequest
-	(*CategoryRequest)(nil),                      // 16: finance.CategoryRequest
-	(*CategoryByNameRequest)(nil),                // 17: finance.CategoryByNameRequest
-	(*ListCategoriesResponse)(nil),               // 18: finance.ListCategoriesResponse
-	(*CategoryWithStats)(nil),                    // 19: finance.CategoryWithStats
-	(*ListCategoriesWithStatsResponse)(nil),      // 20: finance.ListCategoriesWithStatsResponse
-	(*CategoryReportRequest)(nil),                // 21: finance.CategoryReportRequest
-	(*CategoryInReport)(nil),                     // 22: finance.CategoryInReport
-	(*CategoryReportResponse)(nil),               // 23: finance.CategoryReportResponse
-	(*OperationsByAccountAndFiltersRequest)(nil), // 24: finance.OperationsByAccountAndFiltersRequest
-	(*SharingsResponse)(nil),                     // 25: finance.SharingsResponse
-	(*timestamppb.Timestamp)(nil),                // 26: google.protobuf.Timestamp
+	(*AccountInvitation)(nil),                    // 4: finance.AccountInvitation
+	(*CreateAccountInvitationRequest)(nil),       // 5: finance.CreateAccountInvitationRequest
+	(*AccountInvitationRequest)(nil),             // 6: finance.AccountInvitationRequest
+	(*AcceptInvitationLinkRequest)(nil),          // 7: finance.AcceptInvitationLinkRequest
+	(*ListAccountInvitationsResponse)(nil),       // 8: finance.ListAccountInvitationsResponse
+	(*AccountMemberRequest)(nil),                 // 9: finance.AccountMemberRequest
+	(*ListAccountMembersResponse)(nil),           // 10: finance.ListAccountMembersResponse
+	(*UserID)(nil),                               // 11: finance.UserID
+	(*AccountID)(nil),                            // 12: finance.AccountID
+	(*UserIDs)(nil),                              // 13: finance.UserIDs
+	(*ListAccountsResponse)(nil),                 // 14: finance.ListAccountsResponse
+	(*Operation)(nil),                            // 15: finance.Operation
+	(*OperationInList)(nil),                      // 16: finance.OperationInList
+	(*CreateOperationRequest)(nil),               // 17: finance.CreateOperationRequest
+	(*UpdateOperationRequest)(nil),               // 18: finance.UpdateOperationRequest
+	(*OperationRequest)(nil),                     // 19: finance.OperationRequest
+	(*ListOperationsResponse)(nil),               // 20: finance.ListOperationsResponse
+	(*OperationsByIDsRequest)(nil),               // 21: finance.OperationsByIDsRequest
+	(*OperationsByIDsResponse)(nil),              // 22: finance.OperationsByIDsResponse
+	(*Category)(nil),                             // 23: finance.Category
+	(*CreateCategoryRequest)(nil),                // 24: finance.CreateCategoryRequest
+	(*UpdateCategoryRequest)(nil),                // 25: finance.UpdateCategoryRequest
+	(*CategoryRequest)(nil),                      // 26: finance.CategoryRequest
+	(*DeleteCategoryRequest)(nil),                // 27: finance.DeleteCategoryRequest
+	(*MergeCategoriesRequest)(nil),               // 28: finance.MergeCategoriesRequest
+	(*MergeCategoriesResponse)(nil),              // 29: finance.MergeCategoriesResponse
+	(*ProvisionDefaultCategoriesRequest)(nil),    // 30: finance.ProvisionDefaultCategoriesRequest
+	(*CategoryByNameRequest)(nil),                // 31: finance.CategoryByNameRequest
+	(*ListCategoriesResponse)(nil),               // 32: finance.ListCategoriesResponse
+	(*CategoryWithStats)(nil),                    // 33: finance.CategoryWithStats
+	(*ListCategoriesWithStatsResponse)(nil),      // 34: finance.ListCategoriesWithStatsResponse
+	(*CategoryReportRequest)(nil),                // 35: finance.CategoryReportRequest
+	(*CategoryInReport)(nil),                     // 36: finance.CategoryInReport
+	(*CategoryReportResponse)(nil),               // 37: finance.CategoryReportResponse
+	(*OperationsByAccountAndFiltersRequest)(nil), // 38: finance.OperationsByAccountAndFiltersRequest
+	(*SharingsResponse)(nil),                     // 39: finance.SharingsResponse
+	(*Receiver)(nil),                             // 40: finance.Receiver
+	(*UserDataExport)(nil),                       // 41: finance.UserDataExport
+	(*ImportUserDataRequest)(nil),                // 42: finance.ImportUserDataRequest
+	(*ImportUserDataResponse)(nil),               // 43: finance.ImportUserDataResponse
+	(*UserDataDeletion)(nil),                     // 44: finance.UserDataDeletion
+	(*ImageIDs)(nil),                             // 45: finance.ImageIDs
+	(*CategoryRule)(nil),                         // 46: finance.CategoryRule
+	(*CreateCategoryRuleRequest)(nil),            // 47: finance.CreateCategoryRuleRequest
+	(*UpdateCategoryRuleRequest)(nil),            // 48: finance.UpdateCategoryRuleRequest
+	(*CategoryRuleRequest)(nil),                  // 49: finance.CategoryRuleRequest
+	(*ListCategoryRulesResponse)(nil),            // 50: finance.ListCategoryRulesResponse
+	(*ReorderCategoryRulesRequest)(nil),          // 51: finance.ReorderCategoryRulesRequest
+	(*TestCategoryRulesRequest)(nil),             // 52: finance.TestCategoryRulesRequest
+	(*TestCategoryRulesResponse)(nil),            // 53: finance.TestCategoryRulesResponse
+	(*ApplyCategoryRulesRequest)(nil),            // 54: finance.ApplyCategoryRulesRequest
+	(*ApplyCategoryRulesResponse)(nil),           // 55: finance.ApplyCategoryRulesResponse
+	(*SuggestCategoryRequest)(nil),               // 56: finance.SuggestCategoryRequest
+	(*SuggestCategoryResponse)(nil),              // 57: finance.SuggestCategoryResponse
+	(*SpendingStatsRequest)(nil),                 // 58: finance.SpendingStatsRequest
+	(*DailySpending)(nil),                        // 59: finance.DailySpending
+	(*RecurringExpense)(nil),                     // 60: finance.RecurringExpense
+	(*SpendingStatsResponse)(nil),                // 61: finance.SpendingStatsResponse
+	(*CategorySpending)(nil),                     // 62: finance.CategorySpending
+	(*SpendingTotals)(nil),                       // 63: finance.SpendingTotals
+	(*CounterpartyBalance)(nil),                  // 64: finance.CounterpartyBalance
+	(*Counterparty)(nil),                         // 65: finance.Counterparty
+	(*ListCounterpartiesResponse)(nil),           // 66: finance.ListCounterpartiesResponse
+	(*Debt)(nil),                                 // 67: finance.Debt
+	(*CreateDebtRequest)(nil),                    // 68: finance.CreateDebtRequest
+	(*ListDebtsRequest)(nil),                     // 69: finance.ListDebtsRequest
+	(*ListDebtsResponse)(nil),                    // 70: finance.ListDebtsResponse
+	(*DebtRequest)(nil),                          // 71: finance.DebtRequest
+	(*CreateDebtRepaymentRequest)(nil),           // 72: finance.CreateDebtRepaymentRequest
+	(*DebtPayment)(nil),                          // 73: finance.DebtPayment
+	(*ListDebtPaymentsResponse)(nil),             // 74: finance.ListDebtPaymentsResponse
+	(*SplitShare)(nil),                           // 75: finance.SplitShare
+	(*OperationSplit)(nil),                       // 76: finance.OperationSplit
+	(*SplitOperationRequest)(nil),                // 77: finance.SplitOperationRequest
+	(*MemberBalance)(nil),                        // 78: finance.MemberBalance
+	(*PairBalance)(nil),                          // 79: finance.PairBalance
+	(*AccountBalances)(nil),                      // 80: finance.AccountBalances
+	(*Settlement)(nil),                           // 81: finance.Settlement
+	(*CreateSettlementRequest)(nil),              // 82: finance.CreateSettlementRequest
+	(*ListSettlementsResponse)(nil),              // 83: finance.ListSettlementsResponse
+	(*timestamppb.Timestamp)(nil),                // 84: google.protobuf.Timestamp
 }
 var file_internal_app_finance_service_proto_finance_proto_depIdxs = []int32{
-	26, // 0: finance.Account.created_at:type_name -> google.protobuf.Timestamp
-	26, // 1: finance.Account.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: finance.ListAccountsResponse.accounts:type_name -> finance.Account
-	26, // 3: finance.Operation.created_at:type_name -> google.protobuf.Timestamp
-	26, // 4: finance.Operation.date:type_name -> google.protobuf.Timestamp
-	26, // 5: finance.OperationInList.created_at:type_name -> google.protobuf.Timestamp
-	26, // 6: finance.OperationInList.date:type_name -> google.protobuf.Timestamp
-	26, // 7: finance.CreateOperationRequest.date:type_name -> google.protobuf.Timestamp
-	26, // 8: finance.UpdateOperationRequest.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 9: finance.ListOperationsResponse.operations:type_name -> finance.OperationInList
-	26, // 10: finance.Category.created_at:type_name -> google.protobuf.Timestamp
-	26, // 11: finance.Category.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 12: finance.ListCategoriesResponse.categories:type_name -> finance.Category
-	13, // 13: finance.CategoryWithStats.category:type_name -> finance.Category
-	19, // 14: finance.ListCategoriesWithStatsResponse.categories:type_name -> finance.CategoryWithStats
-	26, // 15: finance.CategoryReportRequest.start:type_name -> google.protobuf.Timestamp
-	26, // 16: finance.CategoryReportRequest.end:type_name -> google.protobuf.Timestamp
-	22, // 17: finance.CategoryReportResponse.categories:type_name -> finance.CategoryInReport
-	26, // 18: finance.CategoryReportResponse.start:type_name -> google.protobuf.Timestamp
-	26, // 19: finance.CategoryReportResponse.end:type_name -> google.protobuf.Timestamp
-	26, // 20: finance.OperationsByAccountAndFiltersRequest.date:type_name -> google.protobuf.Timestamp
-	26, // 21: finance.SharingsResponse.created_at:type_name -> google.protobuf.Timestamp
-	1,  // 22: finance.FinanceService.CreateAccount:input_type -> finance.CreateAccountRequest
-	3,  // 23: finance.FinanceService.GetAccount:input_type -> finance.AccountRequest
-	5,  // 24: finance.FinanceService.GetAccountsByUser:input_type -> finance.UserID
-	2,  // 25: finance.FinanceService.UpdateAccount:input_type -> finance.UpdateAccountRequest
-	3,  // 26: finance.FinanceService.DeleteAccount:input_type -> finance.AccountRequest
-	4,  // 27: finance.FinanceService.AddUserToAccounnt:input_type -> finance.AddToAccountReqeust
-	9,  // 28: finance.FinanceService.CreateOperation:input_type -> finance.CreateOperationRequest
-	11, // 29: finance.FinanceService.GetOperation:input_type -> finance.OperationRequest
-	24, // 30: finance.FinanceService.GetOperationsByAccount:input_type -> finance.OperationsByAccountAndFiltersRequest
-	10, // 31: finance.FinanceService.UpdateOperation:input_type -> finance.UpdateOperationRequest
-	11, // 32: finance.FinanceService.DeleteOperation:input_type -> finance.OperationRequest
-	14, // 33: finance.FinanceService.CreateCategory:input_type -> finance.CreateCategoryRequest
-	16, // 34: finance.FinanceService.GetCategory:input_type -> finance.CategoryRequest
-	17, // 35: finance.FinanceService.GetCategoryByName:input_type -> finance.CategoryByNameRequest
-	5,  // 36: finance.FinanceService.GetCategoriesByUser:input_type -> finance.UserID
-	5,  // 37: finance.FinanceService.GetCategoriesWithStatsByUser:input_type -> finance.UserID
-	15, // 38: finance.FinanceService.UpdateCategory:input_type -> finance.UpdateCategoryRequest
-	16, // 39: finance.FinanceService.DeleteCategory:input_type -> finance.CategoryRequest
-	21, // 40: finance.FinanceService.GetCategoriesReport:input_type -> finance.CategoryReportRequest
-	0,  // 41: finance.FinanceService.CreateAccount:output_type -> finance.Account
-	0,  // 42: finance.FinanceService.GetAccount:output_type -> finance.Account
-	6,  // 43: finance.FinanceService.GetAccountsByUser:output_type -> finance.ListAccountsResponse
-	0,  // 44: finance.FinanceService.UpdateAccount:output_type -> finance.Account
-	0,  // 45: finance.FinanceService.DeleteAccount:output_type -> finance.Account
-	25, // 46: finance.FinanceService.AddUserToAccounnt:output_type -> finance.SharingsResponse
-	7,  // 47: finance.FinanceService.CreateOperation:output_type -> finance.Operation
-	7,  // 48: finance.FinanceService.GetOperation:output_type -> finance.Operation
-	12, // 49: finance.FinanceService.GetOperationsByAccount:output_type -> finance.ListOperationsResponse
-	7,  // 50: finance.FinanceService.UpdateOperation:output_type -> finance.Operation
-	7,  // 51: finance.FinanceService.DeleteOperation:output_type -> finance.Operation
-	13, // 52: finance.FinanceService.CreateCategory:output_type -> finance.Category
-	19, // 53: finance.FinanceService.GetCategory:output_type -> finance.CategoryWithStats
-	19, // 54: finance.FinanceService.GetCategoryByName:output_type -> finance.CategoryWithStats
-	18, // 55: finance.FinanceService.GetCategoriesByUser:output_type -> finance.ListCategoriesResponse
-	20, // 56: finance.FinanceService.GetCategoriesWithStatsByUser:output_type -> finance.ListCategoriesWithStatsResponse
-	13, // 57: finance.FinanceService.UpdateCategory:output_type -> finance.Category
-	13, // 58: finance.FinanceService.DeleteCategory:output_type -> finance.Category
-	23, // 59: finance.FinanceService.GetCategoriesReport:output_type -> finance.CategoryReportResponse
-	41, // [41:60] is the sub-list for method output_type
-	22, // [22:41] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	84,  // 0: finance.Account.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 1: finance.Account.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 2: finance.AccountInvitation.expires_at:type_name -> google.protobuf.Timestamp
+	84,  // 3: finance.AccountInvitation.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 4: finance.ListAccountInvitationsResponse.invitations:type_name -> finance.AccountInvitation
+	39,  // 5: finance.ListAccountMembersResponse.members:type_name -> finance.SharingsResponse
+	0,   // 6: finance.ListAccountsResponse.accounts:type_name -> finance.Account
+	84,  // 7: finance.Operation.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 8: finance.Operation.date:type_name -> google.protobuf.Timestamp
+	84,  // 9: finance.OperationInList.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 10: finance.OperationInList.date:type_name -> google.protobuf.Timestamp
+	84,  // 11: finance.CreateOperationRequest.date:type_name -> google.protobuf.Timestamp
+	84,  // 12: finance.UpdateOperationRequest.created_at:type_name -> google.protobuf.Timestamp
+	16,  // 13: finance.ListOperationsResponse.operations:type_name -> finance.OperationInList
+	15,  // 14: finance.OperationsByIDsResponse.operations:type_name -> finance.Operation
+	84,  // 15: finance.Category.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 16: finance.Category.updated_at:type_name -> google.protobuf.Timestamp
+	23,  // 17: finance.MergeCategoriesResponse.target:type_name -> finance.Category
+	23,  // 18: finance.ListCategoriesResponse.categories:type_name -> finance.Category
+	23,  // 19: finance.CategoryWithStats.category:type_name -> finance.Category
+	33,  // 20: finance.ListCategoriesWithStatsResponse.categories:type_name -> finance.CategoryWithStats
+	84,  // 21: finance.CategoryReportRequest.start:type_name -> google.protobuf.Timestamp
+	84,  // 22: finance.CategoryReportRequest.end:type_name -> google.protobuf.Timestamp
+	36,  // 23: finance.CategoryReportResponse.categories:type_name -> finance.CategoryInReport
+	84,  // 24: finance.CategoryReportResponse.start:type_name -> google.protobuf.Timestamp
+	84,  // 25: finance.CategoryReportResponse.end:type_name -> google.protobuf.Timestamp
+	84,  // 26: finance.OperationsByAccountAndFiltersRequest.date:type_name -> google.protobuf.Timestamp
+	84,  // 27: finance.SharingsResponse.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 28: finance.Receiver.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 29: finance.UserDataExport.accounts:type_name -> finance.Account
+	23,  // 30: finance.UserDataExport.categories:type_name -> finance.Category
+	15,  // 31: finance.UserDataExport.operations:type_name -> finance.Operation
+	40,  // 32: finance.UserDataExport.receivers:type_name -> finance.Receiver
+	39,  // 33: finance.UserDataExport.sharings:type_name -> finance.SharingsResponse
+	41,  // 34: finance.ImportUserDataRequest.data:type_name -> finance.UserDataExport
+	15,  // 35: finance.ImportUserDataResponse.restored_operations:type_name -> finance.Operation
+	15,  // 36: finance.UserDataDeletion.uncategorized_operations:type_name -> finance.Operation
+	84,  // 37: finance.CategoryRule.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 38: finance.CategoryRule.updated_at:type_name -> google.protobuf.Timestamp
+	46,  // 39: finance.ListCategoryRulesResponse.rules:type_name -> finance.CategoryRule
+	46,  // 40: finance.TestCategoryRulesResponse.rule:type_name -> finance.CategoryRule
+	15,  // 41: finance.ApplyCategoryRulesResponse.recategorized:type_name -> finance.Operation
+	84,  // 42: finance.SpendingStatsRequest.start:type_name -> google.protobuf.Timestamp
+	84,  // 43: finance.SpendingStatsRequest.end:type_name -> google.protobuf.Timestamp
+	84,  // 44: finance.SpendingStatsRequest.history_start:type_name -> google.protobuf.Timestamp
+	84,  // 45: finance.DailySpending.date:type_name -> google.protobuf.Timestamp
+	84,  // 46: finance.RecurringExpense.last_date:type_name -> google.protobuf.Timestamp
+	59,  // 47: finance.SpendingStatsResponse.days:type_name -> finance.DailySpending
+	60,  // 48: finance.SpendingStatsResponse.recurring:type_name -> finance.RecurringExpense
+	62,  // 49: finance.SpendingTotals.by_category:type_name -> finance.CategorySpending
+	40,  // 50: finance.Counterparty.receiver:type_name -> finance.Receiver
+	64,  // 51: finance.Counterparty.balances:type_name -> finance.CounterpartyBalance
+	65,  // 52: finance.ListCounterpartiesResponse.counterparties:type_name -> finance.Counterparty
+	84,  // 53: finance.Debt.due_date:type_name -> google.protobuf.Timestamp
+	84,  // 54: finance.Debt.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 55: finance.Debt.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 56: finance.CreateDebtRequest.due_date:type_name -> google.protobuf.Timestamp
+	84,  // 57: finance.CreateDebtRequest.date:type_name -> google.protobuf.Timestamp
+	67,  // 58: finance.ListDebtsResponse.debts:type_name -> finance.Debt
+	84,  // 59: finance.CreateDebtRepaymentRequest.date:type_name -> google.protobuf.Timestamp
+	84,  // 60: finance.DebtPayment.date:type_name -> google.protobuf.Timestamp
+	84,  // 61: finance.DebtPayment.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 62: finance.ListDebtPaymentsResponse.payments:type_name -> finance.DebtPayment
+	75,  // 63: finance.OperationSplit.shares:type_name -> finance.SplitShare
+	84,  // 64: finance.OperationSplit.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 65: finance.SplitOperationRequest.shares:type_name -> finance.SplitShare
+	78,  // 66: finance.AccountBalances.members:type_name -> finance.MemberBalance
+	79,  // 67: finance.AccountBalances.debts:type_name -> finance.PairBalance
+	84,  // 68: finance.Settlement.created_at:type_name -> google.protobuf.Timestamp
+	81,  // 69: finance.ListSettlementsResponse.settlements:type_name -> finance.Settlement
+	1,   // 70: finance.FinanceService.CreateAccount:input_type -> finance.CreateAccountRequest
+	3,   // 71: finance.FinanceService.GetAccount:input_type -> finance.AccountRequest
+	11,  // 72: finance.FinanceService.GetAccountsByUser:input_type -> finance.UserID
+	2,   // 73: finance.FinanceService.UpdateAccount:input_type -> finance.UpdateAccountRequest
+	3,   // 74: finance.FinanceService.DeleteAccount:input_type -> finance.AccountRequest
+	5,   // 75: finance.FinanceService.CreateAccountInvitation:input_type -> finance.CreateAccountInvitationRequest
+	11,  // 76: finance.FinanceService.GetPendingInvitations:input_type -> finance.UserID
+	3,   // 77: finance.FinanceService.GetAccountInvitations:input_type -> finance.AccountRequest
+	6,   // 78: finance.FinanceService.AcceptAccountInvitation:input_type -> finance.AccountInvitationRequest
+	7,   // 79: finance.FinanceService.AcceptInvitationLink:input_type -> finance.AcceptInvitationLinkRequest
+	6,   // 80: finance.FinanceService.DeclineAccountInvitation:input_type -> finance.AccountInvitationRequest
+	6,   // 81: finance.FinanceService.RevokeAccountInvitation:input_type -> finance.AccountInvitationRequest
+	3,   // 82: finance.FinanceService.GetAccountMembers:input_type -> finance.AccountRequest
+	9,   // 83: finance.FinanceService.UpdateAccountMemberRole:input_type -> finance.AccountMemberRequest
+	9,   // 84: finance.FinanceService.RemoveAccountMember:input_type -> finance.AccountMemberRequest
+	3,   // 85: finance.FinanceService.LeaveAccount:input_type -> finance.AccountRequest
+	9,   // 86: finance.FinanceService.TransferAccountOwnership:input_type -> finance.AccountMemberRequest
+	12,  // 87: finance.FinanceService.GetAccountUserIDs:input_type -> finance.AccountID
+	17,  // 88: finance.FinanceService.CreateOperation:input_type -> finance.CreateOperationRequest
+	19,  // 89: finance.FinanceService.GetOperation:input_type -> finance.OperationRequest
+	38,  // 90: finance.FinanceService.GetOperationsByAccount:input_type -> finance.OperationsByAccountAndFiltersRequest
+	18,  // 91: finance.FinanceService.UpdateOperation:input_type -> finance.UpdateOperationRequest
+	19,  // 92: finance.FinanceService.DeleteOperation:input_type -> finance.OperationRequest
+	21,  // 93: finance.FinanceService.GetOperationsByIDs:input_type -> finance.OperationsByIDsRequest
+	24,  // 94: finance.FinanceService.CreateCategory:input_type -> finance.CreateCategoryRequest
+	26,  // 95: finance.FinanceService.GetCategory:input_type -> finance.CategoryRequest
+	31,  // 96: finance.FinanceService.GetCategoryByName:input_type -> finance.CategoryByNameRequest
+	11,  // 97: finance.FinanceService.GetCategoriesByUser:input_type -> finance.UserID
+	11,  // 98: finance.FinanceService.GetCategoriesWithStatsByUser:input_type -> finance.UserID
+	25,  // 99: finance.FinanceService.UpdateCategory:input_type -> finance.UpdateCategoryRequest
+	27,  // 100: finance.FinanceService.DeleteCategory:input_type -> finance.DeleteCategoryRequest
+	28,  // 101: finance.FinanceService.MergeCategories:input_type -> finance.MergeCategoriesRequest
+	30,  // 102: finance.FinanceService.ProvisionDefaultCategories:input_type -> finance.ProvisionDefaultCategoriesRequest
+	35,  // 103: finance.FinanceService.GetCategoriesReport:input_type -> finance.CategoryReportRequest
+	11,  // 104: finance.FinanceService.ExportUserData:input_type -> finance.UserID
+	42,  // 105: finance.FinanceService.ImportUserData:input_type -> finance.ImportUserDataRequest
+	11,  // 106: finance.FinanceService.DeleteUserData:input_type -> finance.UserID
+	45,  // 107: finance.FinanceService.FilterUsedImages:input_type -> finance.ImageIDs
+	47,  // 108: finance.FinanceService.CreateCategoryRule:input_type -> finance.CreateCategoryRuleRequest
+	11,  // 109: finance.FinanceService.GetCategoryRules:input_type -> finance.UserID
+	48,  // 110: finance.FinanceService.UpdateCategoryRule:input_type -> finance.UpdateCategoryRuleRequest
+	49,  // 111: finance.FinanceService.DeleteCategoryRule:input_type -> finance.CategoryRuleRequest
+	51,  // 112: finance.FinanceService.ReorderCategoryRules:input_type -> finance.ReorderCategoryRulesRequest
+	52,  // 113: finance.FinanceService.TestCategoryRules:input_type -> finance.TestCategoryRulesRequest
+	54,  // 114: finance.FinanceService.ApplyCategoryRules:input_type -> finance.ApplyCategoryRulesRequest
+	56,  // 115: finance.FinanceService.SuggestCategory:input_type -> finance.SuggestCategoryRequest
+	58,  // 116: finance.FinanceService.GetSpendingStats:input_type -> finance.SpendingStatsRequest
+	58,  // 117: finance.FinanceService.GetSpendingTotals:input_type -> finance.SpendingStatsRequest
+	11,  // 118: finance.FinanceService.GetCounterparties:input_type -> finance.UserID
+	68,  // 119: finance.FinanceService.CreateDebt:input_type -> finance.CreateDebtRequest
+	69,  // 120: finance.FinanceService.GetDebts:input_type -> finance.ListDebtsRequest
+	71,  // 121: finance.FinanceService.GetDebt:input_type -> finance.DebtRequest
+	71,  // 122: finance.FinanceService.DeleteDebt:input_type -> finance.DebtRequest
+	72,  // 123: finance.FinanceService.AddDebtRepayment:input_type -> finance.CreateDebtRepaymentRequest
+	71,  // 124: finance.FinanceService.GetDebtPayments:input_type -> finance.DebtRequest
+	77,  // 125: finance.FinanceService.SplitOperation:input_type -> finance.SplitOperationRequest
+	19,  // 126: finance.FinanceService.GetOperationSplit:input_type -> finance.OperationRequest
+	19,  // 127: finance.FinanceService.DeleteOperationSplit:input_type -> finance.OperationRequest
+	3,   // 128: finance.FinanceService.GetAccountBalances:input_type -> finance.AccountRequest
+	82,  // 129: finance.FinanceService.CreateSettlement:input_type -> finance.CreateSettlementRequest
+	3,   // 130: finance.FinanceService.GetSettlements:input_type -> finance.AccountRequest
+	0,   // 131: finance.FinanceService.CreateAccount:output_type -> finance.Account
+	0,   // 132: finance.FinanceService.GetAccount:output_type -> finance.Account
+	14,  // 133: finance.FinanceService.GetAccountsByUser:output_type -> finance.ListAccountsResponse
+	0,   // 134: finance.FinanceService.UpdateAccount:output_type -> finance.Account
+	0,   // 135: finance.FinanceService.DeleteAccount:output_type -> finance.Account
+	4,   // 136: finance.FinanceService.CreateAccountInvitation:output_type -> finance.AccountInvitation
+	8,   // 137: finance.FinanceService.GetPendingInvitations:output_type -> finance.ListAccountInvitationsResponse
+	8,   // 138: finance.FinanceService.GetAccountInvitations:output_type -> finance.ListAccountInvitationsResponse
+	39,  // 139: finance.FinanceService.AcceptAccountInvitation:output_type -> finance.SharingsResponse
+	39,  // 140: finance.FinanceService.AcceptInvitationLink:output_type -> finance.SharingsResponse
+	4,   // 141: finance.FinanceService.DeclineAccountInvitation:output_type -> finance.AccountInvitation
+	4,   // 142: finance.FinanceService.RevokeAccountInvitation:output_type -> finance.AccountInvitation
+	10,  // 143: finance.FinanceService.GetAccountMembers:output_type -> finance.ListAccountMembersResponse
+	39,  // 144: finance.FinanceService.UpdateAccountMemberRole:output_type -> finance.SharingsResponse
+	39,  // 145: finance.FinanceService.RemoveAccountMember:output_type -> finance.SharingsResponse
+	39,  // 146: finance.FinanceService.LeaveAccount:output_type -> finance.SharingsResponse
+	10,  // 147: finance.FinanceService.TransferAccountOwnership:output_type -> finance.ListAccountMembersResponse
+	13,  // 148: finance.FinanceService.GetAccountUserIDs:output_type -> finance.UserIDs
+	15,  // 149: finance.FinanceService.CreateOperation:output_type -> finance.Operation
+	15,  // 150: finance.FinanceService.GetOperation:output_type -> finance.Operation
+	20,  // 151: finance.FinanceService.GetOperationsByAccount:output_type -> finance.ListOperationsResponse
+	15,  // 152: finance.FinanceService.UpdateOperation:output_type -> finance.Operation
+	15,  // 153: finance.FinanceService.DeleteOperation:output_type -> finance.Operation
+	22,  // 154: finance.FinanceService.GetOperationsByIDs:output_type -> finance.OperationsByIDsResponse
+	23,  // 155: finance.FinanceService.CreateCategory:output_type -> finance.Category
+	33,  // 156: finance.FinanceService.GetCategory:output_type -> finance.CategoryWithStats
+	33,  // 157: finance.FinanceService.GetCategoryByName:output_type -> finance.CategoryWithStats
+	32,  // 158: finance.FinanceService.GetCategoriesByUser:output_type -> finance.ListCategoriesResponse
+	34,  // 159: finance.FinanceService.GetCategoriesWithStatsByUser:output_type -> finance.ListCategoriesWithStatsResponse
+	23,  // 160: finance.FinanceService.UpdateCategory:output_type -> finance.Category
+	23,  // 161: finance.FinanceService.DeleteCategory:output_type -> finance.Category
+	29,  // 162: finance.FinanceService.MergeCategories:output_type -> finance.MergeCategoriesResponse
+	32,  // 163: finance.FinanceService.ProvisionDefaultCategories:output_type -> finance.ListCategoriesResponse
+	37,  // 164: finance.FinanceService.GetCategoriesReport:output_type -> finance.CategoryReportResponse
+	41,  // 165: finance.FinanceService.ExportUserData:output_type -> finance.UserDataExport
+	43,  // 166: finance.FinanceService.ImportUserData:output_type -> finance.ImportUserDataResponse
+	44,  // 167: finance.FinanceService.DeleteUserData:output_type -> finance.UserDataDeletion
+	45,  // 168: finance.FinanceService.FilterUsedImages:output_type -> finance.ImageIDs
+	46,  // 169: finance.FinanceService.CreateCategoryRule:output_type -> finance.CategoryRule
+	50,  // 170: finance.FinanceService.GetCategoryRules:output_type -> finance.ListCategoryRulesResponse
+	46,  // 171: finance.FinanceService.UpdateCategoryRule:output_type -> finance.CategoryRule
+	46,  // 172: finance.FinanceService.DeleteCategoryRule:output_type -> finance.CategoryRule
+	50,  // 173: finance.FinanceService.ReorderCategoryRules:output_type -> finance.ListCategoryRulesResponse
+	53,  // 174: finance.FinanceService.TestCategoryRules:output_type -> finance.TestCategoryRulesResponse
+	55,  // 175: finance.FinanceService.ApplyCategoryRules:output_type -> finance.ApplyCategoryRulesResponse
+	57,  // 176: finance.FinanceService.SuggestCategory:output_type -> finance.SuggestCategoryResponse
+	61,  // 177: finance.FinanceService.GetSpendingStats:output_type -> finance.SpendingStatsResponse
+	63,  // 178: finance.FinanceService.GetSpendingTotals:output_type -> finance.SpendingTotals
+	66,  // 179: finance.FinanceService.GetCounterparties:output_type -> finance.ListCounterpartiesResponse
+	67,  // 180: finance.FinanceService.CreateDebt:output_type -> finance.Debt
+	70,  // 181: finance.FinanceService.GetDebts:output_type -> finance.ListDebtsResponse
+	67,  // 182: finance.FinanceService.GetDebt:output_type -> finance.Debt
+	67,  // 183: finance.FinanceService.DeleteDebt:output_type -> finance.Debt
+	73,  // 184: finance.FinanceService.AddDebtRepayment:output_type -> finance.DebtPayment
+	74,  // 185: finance.FinanceService.GetDebtPayments:output_type -> finance.ListDebtPaymentsResponse
+	76,  // 186: finance.FinanceService.SplitOperation:output_type -> finance.OperationSplit
+	76,  // 187: finance.FinanceService.GetOperationSplit:output_type -> finance.OperationSplit
+	76,  // 188: finance.FinanceService.DeleteOperationSplit:output_type -> finance.OperationSplit
+	80,  // 189: finance.FinanceService.GetAccountBalances:output_type -> finance.AccountBalances
+	81,  // 190: finance.FinanceService.CreateSettlement:output_type -> finance.Settlement
+	83,  // 191: finance.FinanceService.GetSettlements:output_type -> finance.ListSettlementsResponse
+	131, // [131:192] is the sub-list for method output_type
+	70,  // [70:131] is the sub-list for method input_type
+	70,  // [70:70] is the sub-list for extension type_name
+	70,  // [70:70] is the sub-list for extension extendee
+	0,   // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_internal_app_finance_service_proto_finance_proto_init() }
@@ -2266,16 +6962,19 @@ func file_internal_app_finance_service_proto_finance_proto_init() {
 	}
 	file_internal_app_finance_service_proto_finance_proto_msgTypes[1].OneofWrappers = []any{}
 	file_internal_app_finance_service_proto_finance_proto_msgTypes[2].OneofWrappers = []any{}
-	file_internal_app_finance_service_proto_finance_proto_msgTypes[9].OneofWrappers = []any{}
-	file_internal_app_finance_service_proto_finance_proto_msgTypes[10].OneofWrappers = []any{}
-	file_internal_app_finance_service_proto_finance_proto_msgTypes[15].OneofWrappers = []any{}
+	file_internal_app_finance_service_proto_finance_proto_msgTypes[17].OneofWrappers = []any{}
+	file_internal_app_finance_service_proto_finance_proto_msgTypes[18].OneofWrappers = []any{}
+	file_internal_app_finance_service_proto_finance_proto_msgTypes[25].OneofWrappers = []any{}
+	file_internal_app_finance_service_proto_finance_proto_msgTypes[46].OneofWrappers = []any{}
+	file_internal_app_finance_service_proto_finance_proto_msgTypes[47].OneofWrappers = []any{}
+	file_internal_app_finance_service_proto_finance_proto_msgTypes[48].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_app_finance_service_proto_finance_proto_rawDesc), len(file_internal_app_finance_service_proto_finance_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
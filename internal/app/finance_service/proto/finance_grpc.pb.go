@@ -24,12 +24,25 @@ const (
 	FinanceService_GetAccountsByUser_FullMethodName            = "/finance.FinanceService/GetAccountsByUser"
 	FinanceService_UpdateAccount_FullMethodName                = "/finance.FinanceService/UpdateAccount"
 	FinanceService_DeleteAccount_FullMethodName                = "/finance.FinanceService/DeleteAccount"
-	FinanceService_AddUserToAccounnt_FullMethodName            = "/finance.FinanceService/AddUserToAccounnt"
+	FinanceService_CreateAccountInvitation_FullMethodName      = "/finance.FinanceService/CreateAccountInvitation"
+	FinanceService_GetPendingInvitations_FullMethodName        = "/finance.FinanceService/GetPendingInvitations"
+	FinanceService_GetAccountInvitations_FullMethodName        = "/finance.FinanceService/GetAccountInvitations"
+	FinanceService_AcceptAccountInvitation_FullMethodName      = "/finance.FinanceService/AcceptAccountInvitation"
+	FinanceService_AcceptInvitationLink_FullMethodName         = "/finance.FinanceService/AcceptInvitationLink"
+	FinanceService_DeclineAccountInvitation_FullMethodName     = "/finance.FinanceService/DeclineAccountInvitation"
+	FinanceService_RevokeAccountInvitation_FullMethodName      = "/finance.FinanceService/RevokeAccountInvitation"
+	FinanceService_GetAccountMembers_FullMethodName            = "/finance.FinanceService/GetAccountMembers"
+	FinanceService_UpdateAccountMemberRole_FullMethodName      = "/finance.FinanceService/UpdateAccountMemberRole"
+	FinanceService_RemoveAccountMember_FullMethodName          = "/finance.FinanceService/RemoveAccountMember"
+	FinanceService_LeaveAccount_FullMethodName                 = "/finance.FinanceService/LeaveAccount"
+	FinanceService_TransferAccountOwnership_FullMethodName     = "/finance.FinanceService/TransferAccountOwnership"
+	FinanceService_GetAccountUserIDs_FullMethodName            = "/finance.FinanceService/GetAccountUserIDs"
 	FinanceService_CreateOperation_FullMethodName              = "/finance.FinanceService/CreateOperation"
 	FinanceService_GetOperation_FullMethodName                 = "/finance.FinanceService/GetOperation"
 	FinanceService_GetOperationsByAccount_FullMethodName       = "/finance.FinanceService/GetOperationsByAccount"
 	FinanceService_UpdateOperation_FullMethodName              = "/finance.FinanceService/UpdateOperation"
 	FinanceService_DeleteOperation_FullMethodName              = "/finance.FinanceService/DeleteOperation"
+	FinanceService_GetOperationsByIDs_FullMethodName           = "/finance.FinanceService/GetOperationsByIDs"
 	FinanceService_CreateCategory_FullMethodName               = "/finance.FinanceService/CreateCategory"
 	FinanceService_GetCategory_FullMethodName                  = "/finance.FinanceService/GetCategory"
 	FinanceService_GetCategoryByName_FullMethodName            = "/finance.FinanceService/GetCategoryByName"
@@ -37,7 +50,36 @@ const (
 	FinanceService_GetCategoriesWithStatsByUser_FullMethodName = "/finance.FinanceService/GetCategoriesWithStatsByUser"
 	FinanceService_UpdateCategory_FullMethodName               = "/finance.FinanceService/UpdateCategory"
 	FinanceService_DeleteCategory_FullMethodName               = "/finance.FinanceService/DeleteCategory"
+	FinanceService_MergeCategories_FullMethodName              = "/finance.FinanceService/MergeCategories"
+	FinanceService_ProvisionDefaultCategories_FullMethodName   = "/finance.FinanceService/ProvisionDefaultCategories"
 	FinanceService_GetCategoriesReport_FullMethodName          = "/finance.FinanceService/GetCategoriesReport"
+	FinanceService_ExportUserData_FullMethodName               = "/finance.FinanceService/ExportUserData"
+	FinanceService_ImportUserData_FullMethodName               = "/finance.FinanceService/ImportUserData"
+	FinanceService_DeleteUserData_FullMethodName               = "/finance.FinanceService/DeleteUserData"
+	FinanceService_FilterUsedImages_FullMethodName             = "/finance.FinanceService/FilterUsedImages"
+	FinanceService_CreateCategoryRule_FullMethodName           = "/finance.FinanceService/CreateCategoryRule"
+	FinanceService_GetCategoryRules_FullMethodName             = "/finance.FinanceService/GetCategoryRules"
+	FinanceService_UpdateCategoryRule_FullMethodName           = "/finance.FinanceService/UpdateCategoryRule"
+	FinanceService_DeleteCategoryRule_FullMethodName           = "/finance.FinanceService/DeleteCategoryRule"
+	FinanceService_ReorderCategoryRules_FullMethodName         = "/finance.FinanceService/ReorderCategoryRules"
+	FinanceService_TestCategoryRules_FullMethodName            = "/finance.FinanceService/TestCategoryRules"
+	FinanceService_ApplyCategoryRules_FullMethodName           = "/finance.FinanceService/ApplyCategoryRules"
+	FinanceService_SuggestCategory_FullMethodName              = "/finance.FinanceService/SuggestCategory"
+	FinanceService_GetSpendingStats_FullMethodName             = "/finance.FinanceService/GetSpendingStats"
+	FinanceService_GetSpendingTotals_FullMethodName            = "/finance.FinanceService/GetSpendingTotals"
+	FinanceService_GetCounterparties_FullMethodName            = "/finance.FinanceService/GetCounterparties"
+	FinanceService_CreateDebt_FullMethodName                   = "/finance.FinanceService/CreateDebt"
+	FinanceService_GetDebts_FullMethodName                     = "/finance.FinanceService/GetDebts"
+	FinanceService_GetDebt_FullMethodName                      = "/finance.FinanceService/GetDebt"
+	FinanceService_DeleteDebt_FullMethodName                   = "/finance.FinanceService/DeleteDebt"
+	FinanceService_AddDebtRepayment_FullMethodName             = "/finance.FinanceService/AddDebtRepayment"
+	FinanceService_GetDebtPayments_FullMethodName              = "/finance.FinanceService/GetDebtPayments"
+	FinanceService_SplitOperation_FullMethodName               = "/finance.FinanceService/SplitOperation"
+	FinanceService_GetOperationSplit_FullMethodName            = "/finance.FinanceService/GetOperationSplit"
+	FinanceService_DeleteOperationSplit_FullMethodName         = "/finance.FinanceService/DeleteOperationSplit"
+	FinanceService_GetAccountBalances_FullMethodName           = "/finance.FinanceService/GetAccountBalances"
+	FinanceService_CreateSettlement_FullMethodName             = "/finance.FinanceService/CreateSettlement"
+	FinanceService_GetSettlements_FullMethodName               = "/finance.FinanceService/GetSettlements"
 )
 
 // FinanceServiceClient is the client API for FinanceService service.
@@ -58,8 +100,33 @@ type FinanceServiceClient interface {
 	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error)
 	// Deletes an account by ID and returns the deleted account.
 	DeleteAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*Account, error)
-	// Shares an account with another user and returns updated sharing info.
-	AddUserToAccounnt(ctx context.Context, in *AddToAccountReqeust, opts ...grpc.CallOption) (*SharingsResponse, error)
+	// Invites a user to a shared account by login or creates an invite link. Only the owner can do it.
+	CreateAccountInvitation(ctx context.Context, in *CreateAccountInvitationRequest, opts ...grpc.CallOption) (*AccountInvitation, error)
+	// Retrieves pending invitations addressed to the user.
+	GetPendingInvitations(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListAccountInvitationsResponse, error)
+	// Retrieves pending invitations of an account. Only the owner can do it.
+	GetAccountInvitations(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ListAccountInvitationsResponse, error)
+	// Accepts an invitation addressed to the user and makes them an account member.
+	AcceptAccountInvitation(ctx context.Context, in *AccountInvitationRequest, opts ...grpc.CallOption) (*SharingsResponse, error)
+	// Accepts an invite link by its token and makes the user an account member.
+	AcceptInvitationLink(ctx context.Context, in *AcceptInvitationLinkRequest, opts ...grpc.CallOption) (*SharingsResponse, error)
+	// Declines an invitation addressed to the user.
+	DeclineAccountInvitation(ctx context.Context, in *AccountInvitationRequest, opts ...grpc.CallOption) (*AccountInvitation, error)
+	// Revokes a pending invitation. Only the owner can do it.
+	RevokeAccountInvitation(ctx context.Context, in *AccountInvitationRequest, opts ...grpc.CallOption) (*AccountInvitation, error)
+	// Retrieves members of an account with their roles.
+	GetAccountMembers(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ListAccountMembersResponse, error)
+	// Changes the role of an account member. Only the owner can do it.
+	UpdateAccountMemberRole(ctx context.Context, in *AccountMemberRequest, opts ...grpc.CallOption) (*SharingsResponse, error)
+	// Removes a member from an account. Only the owner can do it.
+	RemoveAccountMember(ctx context.Context, in *AccountMemberRequest, opts ...grpc.CallOption) (*SharingsResponse, error)
+	// Removes the calling user from an account. The owner has to transfer ownership first.
+	LeaveAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*SharingsResponse, error)
+	// Makes another member the owner of an account; the former owner becomes an editor.
+	TransferAccountOwnership(ctx context.Context, in *AccountMemberRequest, opts ...grpc.CallOption) (*ListAccountMembersResponse, error)
+	// Returns IDs of all users with access to an account. Meant for other services
+	// reacting to account events, so the caller does not have to be a member.
+	GetAccountUserIDs(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*UserIDs, error)
 	// Creates a new financial operation (expense, income, transfer, etc.).
 	CreateOperation(ctx context.Context, in *CreateOperationRequest, opts ...grpc.CallOption) (*Operation, error)
 	// Retrieves a financial operation by its ID.
@@ -70,6 +137,9 @@ type FinanceServiceClient interface {
 	UpdateOperation(ctx context.Context, in *UpdateOperationRequest, opts ...grpc.CallOption) (*Operation, error)
 	// Deletes an operation and returns the deleted entity.
 	DeleteOperation(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// Retrieves the listed operations that are on accounts the user has access to,
+	// reverted ones included; the rest of the IDs are skipped.
+	GetOperationsByIDs(ctx context.Context, in *OperationsByIDsRequest, opts ...grpc.CallOption) (*OperationsByIDsResponse, error)
 	// Creates a new category for classifying operations.
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
 	// Retrieves a category by its ID, including associated statistics.
@@ -82,10 +152,70 @@ type FinanceServiceClient interface {
 	GetCategoriesWithStatsByUser(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListCategoriesWithStatsResponse, error)
 	// Updates properties of an existing category.
 	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
-	// Deletes a category and returns the deleted entity.
-	DeleteCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	// Deletes a category. With reassign_to set, operations are moved to that category
+	// and it is returned; otherwise operations become uncategorized and an empty category is returned.
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	// Moves operations, rules and subcategories of source into target and deletes source in one transaction.
+	MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*MergeCategoriesResponse, error)
+	// Creates the default categories the user is missing. Returns created (and, on reset, restored) categories.
+	ProvisionDefaultCategories(ctx context.Context, in *ProvisionDefaultCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
 	// Generates a category-based financial report for a user.
 	GetCategoriesReport(ctx context.Context, in *CategoryReportRequest, opts ...grpc.CallOption) (*CategoryReportResponse, error)
+	// Exports accounts, categories, operations and receivers of a user.
+	ExportUserData(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserDataExport, error)
+	// Restores exported user data, remapping IDs; repeated calls with the same backup_id are no-ops.
+	ImportUserData(ctx context.Context, in *ImportUserDataRequest, opts ...grpc.CallOption) (*ImportUserDataResponse, error)
+	// Deletes categories, receivers and accounts of a user for account deletion; repeated calls are no-ops.
+	DeleteUserData(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserDataDeletion, error)
+	// Returns the given images that are still used as category or receiver logos.
+	FilterUsedImages(ctx context.Context, in *ImageIDs, opts ...grpc.CallOption) (*ImageIDs, error)
+	// Creates an auto-categorization rule; new rules are appended to the end of the list.
+	CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CategoryRule, error)
+	// Retrieves all rules of a user in the order they are evaluated.
+	GetCategoryRules(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error)
+	// Replaces conditions and target category of an existing rule.
+	UpdateCategoryRule(ctx context.Context, in *UpdateCategoryRuleRequest, opts ...grpc.CallOption) (*CategoryRule, error)
+	// Deletes a rule and returns the deleted entity.
+	DeleteCategoryRule(ctx context.Context, in *CategoryRuleRequest, opts ...grpc.CallOption) (*CategoryRule, error)
+	// Sets evaluation order of rules; rule_ids must list every rule of the user.
+	ReorderCategoryRules(ctx context.Context, in *ReorderCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error)
+	// Reports which rule would match an operation without changing any data.
+	TestCategoryRules(ctx context.Context, in *TestCategoryRulesRequest, opts ...grpc.CallOption) (*TestCategoryRulesResponse, error)
+	// Re-applies rules to existing operations and returns the recategorized ones.
+	ApplyCategoryRules(ctx context.Context, in *ApplyCategoryRulesRequest, opts ...grpc.CallOption) (*ApplyCategoryRulesResponse, error)
+	// Suggests a category for a new operation: a matching rule wins,
+	// otherwise a classifier trained on the user's operation history is used.
+	SuggestCategory(ctx context.Context, in *SuggestCategoryRequest, opts ...grpc.CallOption) (*SuggestCategoryResponse, error)
+	// Returns daily expense totals of a period and expenses recurring in the preceding history.
+	GetSpendingStats(ctx context.Context, in *SpendingStatsRequest, opts ...grpc.CallOption) (*SpendingStatsResponse, error)
+	// Returns expense totals of the period [start, end], overall and per requested category.
+	GetSpendingTotals(ctx context.Context, in *SpendingStatsRequest, opts ...grpc.CallOption) (*SpendingTotals, error)
+	// Retrieves receivers of a user with outstanding debt balances per currency.
+	GetCounterparties(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListCounterpartiesResponse, error)
+	// Records a debt and creates the principal operation on the account.
+	CreateDebt(ctx context.Context, in *CreateDebtRequest, opts ...grpc.CallOption) (*Debt, error)
+	// Retrieves debts of a user filtered by status.
+	GetDebts(ctx context.Context, in *ListDebtsRequest, opts ...grpc.CallOption) (*ListDebtsResponse, error)
+	// Retrieves a debt by its ID.
+	GetDebt(ctx context.Context, in *DebtRequest, opts ...grpc.CallOption) (*Debt, error)
+	// Deletes a debt; its operations stay in the account history.
+	DeleteDebt(ctx context.Context, in *DebtRequest, opts ...grpc.CallOption) (*Debt, error)
+	// Creates a repayment operation and links it to the debt.
+	AddDebtRepayment(ctx context.Context, in *CreateDebtRepaymentRequest, opts ...grpc.CallOption) (*DebtPayment, error)
+	// Retrieves principal and repayment operations of a debt.
+	GetDebtPayments(ctx context.Context, in *DebtRequest, opts ...grpc.CallOption) (*ListDebtPaymentsResponse, error)
+	// Splits an expense among account members, replacing the previous split.
+	SplitOperation(ctx context.Context, in *SplitOperationRequest, opts ...grpc.CallOption) (*OperationSplit, error)
+	// Retrieves the split of an operation.
+	GetOperationSplit(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*OperationSplit, error)
+	// Removes the split of an operation.
+	DeleteOperationSplit(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*OperationSplit, error)
+	// Computes net balances between account members from splits and settlements.
+	GetAccountBalances(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountBalances, error)
+	// Records a settle-up payment between account members.
+	CreateSettlement(ctx context.Context, in *CreateSettlementRequest, opts ...grpc.CallOption) (*Settlement, error)
+	// Retrieves settle-up payments of an account.
+	GetSettlements(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ListSettlementsResponse, error)
 }
 
 type financeServiceClient struct {
@@ -146,10 +276,130 @@ func (c *financeServiceClient) DeleteAccount(ctx context.Context, in *AccountReq
 	return out, nil
 }
 
-func (c *financeServiceClient) AddUserToAccounnt(ctx context.Context, in *AddToAccountReqeust, opts ...grpc.CallOption) (*SharingsResponse, error) {
+func (c *financeServiceClient) CreateAccountInvitation(ctx context.Context, in *CreateAccountInvitationRequest, opts ...grpc.CallOption) (*AccountInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountInvitation)
+	err := c.cc.Invoke(ctx, FinanceService_CreateAccountInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetPendingInvitations(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListAccountInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountInvitationsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetPendingInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetAccountInvitations(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ListAccountInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountInvitationsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetAccountInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) AcceptAccountInvitation(ctx context.Context, in *AccountInvitationRequest, opts ...grpc.CallOption) (*SharingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharingsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_AcceptAccountInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) AcceptInvitationLink(ctx context.Context, in *AcceptInvitationLinkRequest, opts ...grpc.CallOption) (*SharingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharingsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_AcceptInvitationLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) DeclineAccountInvitation(ctx context.Context, in *AccountInvitationRequest, opts ...grpc.CallOption) (*AccountInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountInvitation)
+	err := c.cc.Invoke(ctx, FinanceService_DeclineAccountInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) RevokeAccountInvitation(ctx context.Context, in *AccountInvitationRequest, opts ...grpc.CallOption) (*AccountInvitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountInvitation)
+	err := c.cc.Invoke(ctx, FinanceService_RevokeAccountInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetAccountMembers(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ListAccountMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountMembersResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetAccountMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) UpdateAccountMemberRole(ctx context.Context, in *AccountMemberRequest, opts ...grpc.CallOption) (*SharingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharingsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_UpdateAccountMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) RemoveAccountMember(ctx context.Context, in *AccountMemberRequest, opts ...grpc.CallOption) (*SharingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharingsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_RemoveAccountMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) LeaveAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*SharingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SharingsResponse)
-	err := c.cc.Invoke(ctx, FinanceService_AddUserToAccounnt_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, FinanceService_LeaveAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) TransferAccountOwnership(ctx context.Context, in *AccountMemberRequest, opts ...grpc.CallOption) (*ListAccountMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountMembersResponse)
+	err := c.cc.Invoke(ctx, FinanceService_TransferAccountOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetAccountUserIDs(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*UserIDs, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserIDs)
+	err := c.cc.Invoke(ctx, FinanceService_GetAccountUserIDs_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +456,16 @@ func (c *financeServiceClient) DeleteOperation(ctx context.Context, in *Operatio
 	return out, nil
 }
 
+func (c *financeServiceClient) GetOperationsByIDs(ctx context.Context, in *OperationsByIDsRequest, opts ...grpc.CallOption) (*OperationsByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationsByIDsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetOperationsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *financeServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Category)
@@ -266,7 +526,7 @@ func (c *financeServiceClient) UpdateCategory(ctx context.Context, in *UpdateCat
 	return out, nil
 }
 
-func (c *financeServiceClient) DeleteCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+func (c *financeServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Category)
 	err := c.cc.Invoke(ctx, FinanceService_DeleteCategory_FullMethodName, in, out, cOpts...)
@@ -276,6 +536,26 @@ func (c *financeServiceClient) DeleteCategory(ctx context.Context, in *CategoryR
 	return out, nil
 }
 
+func (c *financeServiceClient) MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*MergeCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCategoriesResponse)
+	err := c.cc.Invoke(ctx, FinanceService_MergeCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ProvisionDefaultCategories(ctx context.Context, in *ProvisionDefaultCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, FinanceService_ProvisionDefaultCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *financeServiceClient) GetCategoriesReport(ctx context.Context, in *CategoryReportRequest, opts ...grpc.CallOption) (*CategoryReportResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CategoryReportResponse)
@@ -286,96 +566,493 @@ func (c *financeServiceClient) GetCategoriesReport(ctx context.Context, in *Cate
 	return out, nil
 }
 
-// FinanceServiceServer is the server API for FinanceService service.
-// All implementations must embed UnimplementedFinanceServiceServer
-// for forward compatibility.
-//
-// FinanceService provides account, operation, and category management
-// for users, enabling creation, retrieval, update, deletion, and reporting
-// of financial data within the system.
-type FinanceServiceServer interface {
-	// Creates a new financial account for the user.
-	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
-	// Retrieves an account by its ID.
-	GetAccount(context.Context, *AccountRequest) (*Account, error)
-	// Retrieves all accounts associated with a specific user.
-	GetAccountsByUser(context.Context, *UserID) (*ListAccountsResponse, error)
-	// Updates properties of an existing account.
-	UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error)
-	// Deletes an account by ID and returns the deleted account.
-	DeleteAccount(context.Context, *AccountRequest) (*Account, error)
-	// Shares an account with another user and returns updated sharing info.
-	AddUserToAccounnt(context.Context, *AddToAccountReqeust) (*SharingsResponse, error)
-	// Creates a new financial operation (expense, income, transfer, etc.).
-	CreateOperation(context.Context, *CreateOperationRequest) (*Operation, error)
-	// Retrieves a financial operation by its ID.
-	GetOperation(context.Context, *OperationRequest) (*Operation, error)
-	// Retrieves operations for a given account, supporting filters.
-	GetOperationsByAccount(context.Context, *OperationsByAccountAndFiltersRequest) (*ListOperationsResponse, error)
-	// Updates an existing financial operation.
-	UpdateOperation(context.Context, *UpdateOperationRequest) (*Operation, error)
-	// Deletes an operation and returns the deleted entity.
-	DeleteOperation(context.Context, *OperationRequest) (*Operation, error)
-	// Creates a new category for classifying operations.
-	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
-	// Retrieves a category by its ID, including associated statistics.
-	GetCategory(context.Context, *CategoryRequest) (*CategoryWithStats, error)
-	// Retrieves a category by its name, including associated statistics.
-	GetCategoryByName(context.Context, *CategoryByNameRequest) (*CategoryWithStats, error)
-	// Retrieves all categories belonging to a specific user.
-	GetCategoriesByUser(context.Context, *UserID) (*ListCategoriesResponse, error)
-	// Retrieves categories with statistics for a specific user.
-	GetCategoriesWithStatsByUser(context.Context, *UserID) (*ListCategoriesWithStatsResponse, error)
-	// Updates properties of an existing category.
-	UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error)
-	// Deletes a category and returns the deleted entity.
-	DeleteCategory(context.Context, *CategoryRequest) (*Category, error)
-	// Generates a category-based financial report for a user.
-	GetCategoriesReport(context.Context, *CategoryReportRequest) (*CategoryReportResponse, error)
-	mustEmbedUnimplementedFinanceServiceServer()
+func (c *financeServiceClient) ExportUserData(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, FinanceService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedFinanceServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedFinanceServiceServer struct{}
+func (c *financeServiceClient) ImportUserData(ctx context.Context, in *ImportUserDataRequest, opts ...grpc.CallOption) (*ImportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportUserDataResponse)
+	err := c.cc.Invoke(ctx, FinanceService_ImportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedFinanceServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
+func (c *financeServiceClient) DeleteUserData(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserDataDeletion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataDeletion)
+	err := c.cc.Invoke(ctx, FinanceService_DeleteUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) GetAccount(context.Context, *AccountRequest) (*Account, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
+
+func (c *financeServiceClient) FilterUsedImages(ctx context.Context, in *ImageIDs, opts ...grpc.CallOption) (*ImageIDs, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageIDs)
+	err := c.cc.Invoke(ctx, FinanceService_FilterUsedImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) GetAccountsByUser(context.Context, *UserID) (*ListAccountsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAccountsByUser not implemented")
+
+func (c *financeServiceClient) CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CategoryRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryRule)
+	err := c.cc.Invoke(ctx, FinanceService_CreateCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateAccount not implemented")
+
+func (c *financeServiceClient) GetCategoryRules(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) DeleteAccount(context.Context, *AccountRequest) (*Account, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteAccount not implemented")
+
+func (c *financeServiceClient) UpdateCategoryRule(ctx context.Context, in *UpdateCategoryRuleRequest, opts ...grpc.CallOption) (*CategoryRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryRule)
+	err := c.cc.Invoke(ctx, FinanceService_UpdateCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) AddUserToAccounnt(context.Context, *AddToAccountReqeust) (*SharingsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddUserToAccounnt not implemented")
+
+func (c *financeServiceClient) DeleteCategoryRule(ctx context.Context, in *CategoryRuleRequest, opts ...grpc.CallOption) (*CategoryRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryRule)
+	err := c.cc.Invoke(ctx, FinanceService_DeleteCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) CreateOperation(context.Context, *CreateOperationRequest) (*Operation, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateOperation not implemented")
+
+func (c *financeServiceClient) ReorderCategoryRules(ctx context.Context, in *ReorderCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, FinanceService_ReorderCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) GetOperation(context.Context, *OperationRequest) (*Operation, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetOperation not implemented")
+
+func (c *financeServiceClient) TestCategoryRules(ctx context.Context, in *TestCategoryRulesRequest, opts ...grpc.CallOption) (*TestCategoryRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, FinanceService_TestCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) GetOperationsByAccount(context.Context, *OperationsByAccountAndFiltersRequest) (*ListOperationsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetOperationsByAccount not implemented")
+
+func (c *financeServiceClient) ApplyCategoryRules(ctx context.Context, in *ApplyCategoryRulesRequest, opts ...grpc.CallOption) (*ApplyCategoryRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, FinanceService_ApplyCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) UpdateOperation(context.Context, *UpdateOperationRequest) (*Operation, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateOperation not implemented")
+
+func (c *financeServiceClient) SuggestCategory(ctx context.Context, in *SuggestCategoryRequest, opts ...grpc.CallOption) (*SuggestCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestCategoryResponse)
+	err := c.cc.Invoke(ctx, FinanceService_SuggestCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) DeleteOperation(context.Context, *OperationRequest) (*Operation, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteOperation not implemented")
+
+func (c *financeServiceClient) GetSpendingStats(ctx context.Context, in *SpendingStatsRequest, opts ...grpc.CallOption) (*SpendingStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendingStatsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetSpendingStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
+
+func (c *financeServiceClient) GetSpendingTotals(ctx context.Context, in *SpendingStatsRequest, opts ...grpc.CallOption) (*SpendingTotals, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendingTotals)
+	err := c.cc.Invoke(ctx, FinanceService_GetSpendingTotals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetCounterparties(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ListCounterpartiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCounterpartiesResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetCounterparties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CreateDebt(ctx context.Context, in *CreateDebtRequest, opts ...grpc.CallOption) (*Debt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Debt)
+	err := c.cc.Invoke(ctx, FinanceService_CreateDebt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetDebts(ctx context.Context, in *ListDebtsRequest, opts ...grpc.CallOption) (*ListDebtsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDebtsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetDebts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetDebt(ctx context.Context, in *DebtRequest, opts ...grpc.CallOption) (*Debt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Debt)
+	err := c.cc.Invoke(ctx, FinanceService_GetDebt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) DeleteDebt(ctx context.Context, in *DebtRequest, opts ...grpc.CallOption) (*Debt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Debt)
+	err := c.cc.Invoke(ctx, FinanceService_DeleteDebt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) AddDebtRepayment(ctx context.Context, in *CreateDebtRepaymentRequest, opts ...grpc.CallOption) (*DebtPayment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebtPayment)
+	err := c.cc.Invoke(ctx, FinanceService_AddDebtRepayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetDebtPayments(ctx context.Context, in *DebtRequest, opts ...grpc.CallOption) (*ListDebtPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDebtPaymentsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetDebtPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) SplitOperation(ctx context.Context, in *SplitOperationRequest, opts ...grpc.CallOption) (*OperationSplit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationSplit)
+	err := c.cc.Invoke(ctx, FinanceService_SplitOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetOperationSplit(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*OperationSplit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationSplit)
+	err := c.cc.Invoke(ctx, FinanceService_GetOperationSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) DeleteOperationSplit(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*OperationSplit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationSplit)
+	err := c.cc.Invoke(ctx, FinanceService_DeleteOperationSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetAccountBalances(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountBalances, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountBalances)
+	err := c.cc.Invoke(ctx, FinanceService_GetAccountBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CreateSettlement(ctx context.Context, in *CreateSettlementRequest, opts ...grpc.CallOption) (*Settlement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Settlement)
+	err := c.cc.Invoke(ctx, FinanceService_CreateSettlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetSettlements(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*ListSettlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSettlementsResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetSettlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FinanceServiceServer is the server API for FinanceService service.
+// All implementations must embed UnimplementedFinanceServiceServer
+// for forward compatibility.
+//
+// FinanceService provides account, operation, and category management
+// for users, enabling creation, retrieval, update, deletion, and reporting
+// of financial data within the system.
+type FinanceServiceServer interface {
+	// Creates a new financial account for the user.
+	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
+	// Retrieves an account by its ID.
+	GetAccount(context.Context, *AccountRequest) (*Account, error)
+	// Retrieves all accounts associated with a specific user.
+	GetAccountsByUser(context.Context, *UserID) (*ListAccountsResponse, error)
+	// Updates properties of an existing account.
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error)
+	// Deletes an account by ID and returns the deleted account.
+	DeleteAccount(context.Context, *AccountRequest) (*Account, error)
+	// Invites a user to a shared account by login or creates an invite link. Only the owner can do it.
+	CreateAccountInvitation(context.Context, *CreateAccountInvitationRequest) (*AccountInvitation, error)
+	// Retrieves pending invitations addressed to the user.
+	GetPendingInvitations(context.Context, *UserID) (*ListAccountInvitationsResponse, error)
+	// Retrieves pending invitations of an account. Only the owner can do it.
+	GetAccountInvitations(context.Context, *AccountRequest) (*ListAccountInvitationsResponse, error)
+	// Accepts an invitation addressed to the user and makes them an account member.
+	AcceptAccountInvitation(context.Context, *AccountInvitationRequest) (*SharingsResponse, error)
+	// Accepts an invite link by its token and makes the user an account member.
+	AcceptInvitationLink(context.Context, *AcceptInvitationLinkRequest) (*SharingsResponse, error)
+	// Declines an invitation addressed to the user.
+	DeclineAccountInvitation(context.Context, *AccountInvitationRequest) (*AccountInvitation, error)
+	// Revokes a pending invitation. Only the owner can do it.
+	RevokeAccountInvitation(context.Context, *AccountInvitationRequest) (*AccountInvitation, error)
+	// Retrieves members of an account with their roles.
+	GetAccountMembers(context.Context, *AccountRequest) (*ListAccountMembersResponse, error)
+	// Changes the role of an account member. Only the owner can do it.
+	UpdateAccountMemberRole(context.Context, *AccountMemberRequest) (*SharingsResponse, error)
+	// Removes a member from an account. Only the owner can do it.
+	RemoveAccountMember(context.Context, *AccountMemberRequest) (*SharingsResponse, error)
+	// Removes the calling user from an account. The owner has to transfer ownership first.
+	LeaveAccount(context.Context, *AccountRequest) (*SharingsResponse, error)
+	// Makes another member the owner of an account; the former owner becomes an editor.
+	TransferAccountOwnership(context.Context, *AccountMemberRequest) (*ListAccountMembersResponse, error)
+	// Returns IDs of all users with access to an account. Meant for other services
+	// reacting to account events, so the caller does not have to be a member.
+	GetAccountUserIDs(context.Context, *AccountID) (*UserIDs, error)
+	// Creates a new financial operation (expense, income, transfer, etc.).
+	CreateOperation(context.Context, *CreateOperationRequest) (*Operation, error)
+	// Retrieves a financial operation by its ID.
+	GetOperation(context.Context, *OperationRequest) (*Operation, error)
+	// Retrieves operations for a given account, supporting filters.
+	GetOperationsByAccount(context.Context, *OperationsByAccountAndFiltersRequest) (*ListOperationsResponse, error)
+	// Updates an existing financial operation.
+	UpdateOperation(context.Context, *UpdateOperationRequest) (*Operation, error)
+	// Deletes an operation and returns the deleted entity.
+	DeleteOperation(context.Context, *OperationRequest) (*Operation, error)
+	// Retrieves the listed operations that are on accounts the user has access to,
+	// reverted ones included; the rest of the IDs are skipped.
+	GetOperationsByIDs(context.Context, *OperationsByIDsRequest) (*OperationsByIDsResponse, error)
+	// Creates a new category for classifying operations.
+	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
+	// Retrieves a category by its ID, including associated statistics.
+	GetCategory(context.Context, *CategoryRequest) (*CategoryWithStats, error)
+	// Retrieves a category by its name, including associated statistics.
+	GetCategoryByName(context.Context, *CategoryByNameRequest) (*CategoryWithStats, error)
+	// Retrieves all categories belonging to a specific user.
+	GetCategoriesByUser(context.Context, *UserID) (*ListCategoriesResponse, error)
+	// Retrieves categories with statistics for a specific user.
+	GetCategoriesWithStatsByUser(context.Context, *UserID) (*ListCategoriesWithStatsResponse, error)
+	// Updates properties of an existing category.
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error)
+	// Deletes a category. With reassign_to set, operations are moved to that category
+	// and it is returned; otherwise operations become uncategorized and an empty category is returned.
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*Category, error)
+	// Moves operations, rules and subcategories of source into target and deletes source in one transaction.
+	MergeCategories(context.Context, *MergeCategoriesRequest) (*MergeCategoriesResponse, error)
+	// Creates the default categories the user is missing. Returns created (and, on reset, restored) categories.
+	ProvisionDefaultCategories(context.Context, *ProvisionDefaultCategoriesRequest) (*ListCategoriesResponse, error)
+	// Generates a category-based financial report for a user.
+	GetCategoriesReport(context.Context, *CategoryReportRequest) (*CategoryReportResponse, error)
+	// Exports accounts, categories, operations and receivers of a user.
+	ExportUserData(context.Context, *UserID) (*UserDataExport, error)
+	// Restores exported user data, remapping IDs; repeated calls with the same backup_id are no-ops.
+	ImportUserData(context.Context, *ImportUserDataRequest) (*ImportUserDataResponse, error)
+	// Deletes categories, receivers and accounts of a user for account deletion; repeated calls are no-ops.
+	DeleteUserData(context.Context, *UserID) (*UserDataDeletion, error)
+	// Returns the given images that are still used as category or receiver logos.
+	FilterUsedImages(context.Context, *ImageIDs) (*ImageIDs, error)
+	// Creates an auto-categorization rule; new rules are appended to the end of the list.
+	CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CategoryRule, error)
+	// Retrieves all rules of a user in the order they are evaluated.
+	GetCategoryRules(context.Context, *UserID) (*ListCategoryRulesResponse, error)
+	// Replaces conditions and target category of an existing rule.
+	UpdateCategoryRule(context.Context, *UpdateCategoryRuleRequest) (*CategoryRule, error)
+	// Deletes a rule and returns the deleted entity.
+	DeleteCategoryRule(context.Context, *CategoryRuleRequest) (*CategoryRule, error)
+	// Sets evaluation order of rules; rule_ids must list every rule of the user.
+	ReorderCategoryRules(context.Context, *ReorderCategoryRulesRequest) (*ListCategoryRulesResponse, error)
+	// Reports which rule would match an operation without changing any data.
+	TestCategoryRules(context.Context, *TestCategoryRulesRequest) (*TestCategoryRulesResponse, error)
+	// Re-applies rules to existing operations and returns the recategorized ones.
+	ApplyCategoryRules(context.Context, *ApplyCategoryRulesRequest) (*ApplyCategoryRulesResponse, error)
+	// Suggests a category for a new operation: a matching rule wins,
+	// otherwise a classifier trained on the user's operation history is used.
+	SuggestCategory(context.Context, *SuggestCategoryRequest) (*SuggestCategoryResponse, error)
+	// Returns daily expense totals of a period and expenses recurring in the preceding history.
+	GetSpendingStats(context.Context, *SpendingStatsRequest) (*SpendingStatsResponse, error)
+	// Returns expense totals of the period [start, end], overall and per requested category.
+	GetSpendingTotals(context.Context, *SpendingStatsRequest) (*SpendingTotals, error)
+	// Retrieves receivers of a user with outstanding debt balances per currency.
+	GetCounterparties(context.Context, *UserID) (*ListCounterpartiesResponse, error)
+	// Records a debt and creates the principal operation on the account.
+	CreateDebt(context.Context, *CreateDebtRequest) (*Debt, error)
+	// Retrieves debts of a user filtered by status.
+	GetDebts(context.Context, *ListDebtsRequest) (*ListDebtsResponse, error)
+	// Retrieves a debt by its ID.
+	GetDebt(context.Context, *DebtRequest) (*Debt, error)
+	// Deletes a debt; its operations stay in the account history.
+	DeleteDebt(context.Context, *DebtRequest) (*Debt, error)
+	// Creates a repayment operation and links it to the debt.
+	AddDebtRepayment(context.Context, *CreateDebtRepaymentRequest) (*DebtPayment, error)
+	// Retrieves principal and repayment operations of a debt.
+	GetDebtPayments(context.Context, *DebtRequest) (*ListDebtPaymentsResponse, error)
+	// Splits an expense among account members, replacing the previous split.
+	SplitOperation(context.Context, *SplitOperationRequest) (*OperationSplit, error)
+	// Retrieves the split of an operation.
+	GetOperationSplit(context.Context, *OperationRequest) (*OperationSplit, error)
+	// Removes the split of an operation.
+	DeleteOperationSplit(context.Context, *OperationRequest) (*OperationSplit, error)
+	// Computes net balances between account members from splits and settlements.
+	GetAccountBalances(context.Context, *AccountRequest) (*AccountBalances, error)
+	// Records a settle-up payment between account members.
+	CreateSettlement(context.Context, *CreateSettlementRequest) (*Settlement, error)
+	// Retrieves settle-up payments of an account.
+	GetSettlements(context.Context, *AccountRequest) (*ListSettlementsResponse, error)
+	mustEmbedUnimplementedFinanceServiceServer()
+}
+
+// UnimplementedFinanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFinanceServiceServer struct{}
+
+func (UnimplementedFinanceServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetAccount(context.Context, *AccountRequest) (*Account, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetAccountsByUser(context.Context, *UserID) (*ListAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountsByUser not implemented")
+}
+func (UnimplementedFinanceServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedFinanceServiceServer) DeleteAccount(context.Context, *AccountRequest) (*Account, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateAccountInvitation(context.Context, *CreateAccountInvitationRequest) (*AccountInvitation, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccountInvitation not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetPendingInvitations(context.Context, *UserID) (*ListAccountInvitationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPendingInvitations not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetAccountInvitations(context.Context, *AccountRequest) (*ListAccountInvitationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountInvitations not implemented")
+}
+func (UnimplementedFinanceServiceServer) AcceptAccountInvitation(context.Context, *AccountInvitationRequest) (*SharingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptAccountInvitation not implemented")
+}
+func (UnimplementedFinanceServiceServer) AcceptInvitationLink(context.Context, *AcceptInvitationLinkRequest) (*SharingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptInvitationLink not implemented")
+}
+func (UnimplementedFinanceServiceServer) DeclineAccountInvitation(context.Context, *AccountInvitationRequest) (*AccountInvitation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineAccountInvitation not implemented")
+}
+func (UnimplementedFinanceServiceServer) RevokeAccountInvitation(context.Context, *AccountInvitationRequest) (*AccountInvitation, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAccountInvitation not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetAccountMembers(context.Context, *AccountRequest) (*ListAccountMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountMembers not implemented")
+}
+func (UnimplementedFinanceServiceServer) UpdateAccountMemberRole(context.Context, *AccountMemberRequest) (*SharingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAccountMemberRole not implemented")
+}
+func (UnimplementedFinanceServiceServer) RemoveAccountMember(context.Context, *AccountMemberRequest) (*SharingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveAccountMember not implemented")
+}
+func (UnimplementedFinanceServiceServer) LeaveAccount(context.Context, *AccountRequest) (*SharingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveAccount not implemented")
+}
+func (UnimplementedFinanceServiceServer) TransferAccountOwnership(context.Context, *AccountMemberRequest) (*ListAccountMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferAccountOwnership not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetAccountUserIDs(context.Context, *AccountID) (*UserIDs, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountUserIDs not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateOperation(context.Context, *CreateOperationRequest) (*Operation, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOperation not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetOperation(context.Context, *OperationRequest) (*Operation, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetOperationsByAccount(context.Context, *OperationsByAccountAndFiltersRequest) (*ListOperationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOperationsByAccount not implemented")
+}
+func (UnimplementedFinanceServiceServer) UpdateOperation(context.Context, *UpdateOperationRequest) (*Operation, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateOperation not implemented")
+}
+func (UnimplementedFinanceServiceServer) DeleteOperation(context.Context, *OperationRequest) (*Operation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteOperation not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetOperationsByIDs(context.Context, *OperationsByIDsRequest) (*OperationsByIDsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOperationsByIDs not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateCategory not implemented")
 }
 func (UnimplementedFinanceServiceServer) GetCategory(context.Context, *CategoryRequest) (*CategoryWithStats, error) {
@@ -390,374 +1067,1217 @@ func (UnimplementedFinanceServiceServer) GetCategoriesByUser(context.Context, *U
 func (UnimplementedFinanceServiceServer) GetCategoriesWithStatsByUser(context.Context, *UserID) (*ListCategoriesWithStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCategoriesWithStatsByUser not implemented")
 }
-func (UnimplementedFinanceServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
+func (UnimplementedFinanceServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedFinanceServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedFinanceServiceServer) MergeCategories(context.Context, *MergeCategoriesRequest) (*MergeCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeCategories not implemented")
+}
+func (UnimplementedFinanceServiceServer) ProvisionDefaultCategories(context.Context, *ProvisionDefaultCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProvisionDefaultCategories not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetCategoriesReport(context.Context, *CategoryReportRequest) (*CategoryReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoriesReport not implemented")
+}
+func (UnimplementedFinanceServiceServer) ExportUserData(context.Context, *UserID) (*UserDataExport, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedFinanceServiceServer) ImportUserData(context.Context, *ImportUserDataRequest) (*ImportUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportUserData not implemented")
+}
+func (UnimplementedFinanceServiceServer) DeleteUserData(context.Context, *UserID) (*UserDataDeletion, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (UnimplementedFinanceServiceServer) FilterUsedImages(context.Context, *ImageIDs) (*ImageIDs, error) {
+	return nil, status.Error(codes.Unimplemented, "method FilterUsedImages not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CategoryRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCategoryRule not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetCategoryRules(context.Context, *UserID) (*ListCategoryRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryRules not implemented")
+}
+func (UnimplementedFinanceServiceServer) UpdateCategoryRule(context.Context, *UpdateCategoryRuleRequest) (*CategoryRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCategoryRule not implemented")
+}
+func (UnimplementedFinanceServiceServer) DeleteCategoryRule(context.Context, *CategoryRuleRequest) (*CategoryRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategoryRule not implemented")
+}
+func (UnimplementedFinanceServiceServer) ReorderCategoryRules(context.Context, *ReorderCategoryRulesRequest) (*ListCategoryRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderCategoryRules not implemented")
+}
+func (UnimplementedFinanceServiceServer) TestCategoryRules(context.Context, *TestCategoryRulesRequest) (*TestCategoryRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestCategoryRules not implemented")
+}
+func (UnimplementedFinanceServiceServer) ApplyCategoryRules(context.Context, *ApplyCategoryRulesRequest) (*ApplyCategoryRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyCategoryRules not implemented")
+}
+func (UnimplementedFinanceServiceServer) SuggestCategory(context.Context, *SuggestCategoryRequest) (*SuggestCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestCategory not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetSpendingStats(context.Context, *SpendingStatsRequest) (*SpendingStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSpendingStats not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetSpendingTotals(context.Context, *SpendingStatsRequest) (*SpendingTotals, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSpendingTotals not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetCounterparties(context.Context, *UserID) (*ListCounterpartiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCounterparties not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateDebt(context.Context, *CreateDebtRequest) (*Debt, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDebt not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetDebts(context.Context, *ListDebtsRequest) (*ListDebtsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDebts not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetDebt(context.Context, *DebtRequest) (*Debt, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDebt not implemented")
+}
+func (UnimplementedFinanceServiceServer) DeleteDebt(context.Context, *DebtRequest) (*Debt, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDebt not implemented")
+}
+func (UnimplementedFinanceServiceServer) AddDebtRepayment(context.Context, *CreateDebtRepaymentRequest) (*DebtPayment, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddDebtRepayment not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetDebtPayments(context.Context, *DebtRequest) (*ListDebtPaymentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDebtPayments not implemented")
+}
+func (UnimplementedFinanceServiceServer) SplitOperation(context.Context, *SplitOperationRequest) (*OperationSplit, error) {
+	return nil, status.Error(codes.Unimplemented, "method SplitOperation not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetOperationSplit(context.Context, *OperationRequest) (*OperationSplit, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOperationSplit not implemented")
+}
+func (UnimplementedFinanceServiceServer) DeleteOperationSplit(context.Context, *OperationRequest) (*OperationSplit, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteOperationSplit not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetAccountBalances(context.Context, *AccountRequest) (*AccountBalances, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountBalances not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateSettlement(context.Context, *CreateSettlementRequest) (*Settlement, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSettlement not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetSettlements(context.Context, *AccountRequest) (*ListSettlementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSettlements not implemented")
+}
+func (UnimplementedFinanceServiceServer) mustEmbedUnimplementedFinanceServiceServer() {}
+func (UnimplementedFinanceServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeFinanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FinanceServiceServer will
+// result in compilation errors.
+type UnsafeFinanceServiceServer interface {
+	mustEmbedUnimplementedFinanceServiceServer()
+}
+
+func RegisterFinanceServiceServer(s grpc.ServiceRegistrar, srv FinanceServiceServer) {
+	// If the following call panics, it indicates UnimplementedFinanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FinanceService_ServiceDesc, srv)
+}
+
+func _FinanceService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetAccountsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetAccountsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetAccountsByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetAccountsByUser(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).DeleteAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CreateAccountInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateAccountInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateAccountInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateAccountInvitation(ctx, req.(*CreateAccountInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetPendingInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetPendingInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetPendingInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetPendingInvitations(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetAccountInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetAccountInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetAccountInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetAccountInvitations(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_AcceptAccountInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).AcceptAccountInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_AcceptAccountInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).AcceptAccountInvitation(ctx, req.(*AccountInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_AcceptInvitationLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).AcceptInvitationLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_AcceptInvitationLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).AcceptInvitationLink(ctx, req.(*AcceptInvitationLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_DeclineAccountInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).DeclineAccountInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_DeclineAccountInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).DeclineAccountInvitation(ctx, req.(*AccountInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_RevokeAccountInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).RevokeAccountInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_RevokeAccountInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).RevokeAccountInvitation(ctx, req.(*AccountInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetAccountMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetAccountMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetAccountMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetAccountMembers(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_UpdateAccountMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).UpdateAccountMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_UpdateAccountMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).UpdateAccountMemberRole(ctx, req.(*AccountMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_RemoveAccountMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).RemoveAccountMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_RemoveAccountMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).RemoveAccountMember(ctx, req.(*AccountMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_LeaveAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).LeaveAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_LeaveAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).LeaveAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_TransferAccountOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).TransferAccountOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_TransferAccountOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).TransferAccountOwnership(ctx, req.(*AccountMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetAccountUserIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetAccountUserIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetAccountUserIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetAccountUserIDs(ctx, req.(*AccountID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CreateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateOperation(ctx, req.(*CreateOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetOperation(ctx, req.(*OperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetOperationsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationsByAccountAndFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetOperationsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetOperationsByAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetOperationsByAccount(ctx, req.(*OperationsByAccountAndFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_UpdateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).UpdateOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_UpdateOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).UpdateOperation(ctx, req.(*UpdateOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_DeleteOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).DeleteOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_DeleteOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).DeleteOperation(ctx, req.(*OperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetOperationsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetOperationsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetOperationsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetOperationsByIDs(ctx, req.(*OperationsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetCategory(ctx, req.(*CategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetCategoryByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetCategoryByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetCategoryByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetCategoryByName(ctx, req.(*CategoryByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetCategoriesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetCategoriesByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetCategoriesByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetCategoriesByUser(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetCategoriesWithStatsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetCategoriesWithStatsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetCategoriesWithStatsByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetCategoriesWithStatsByUser(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_MergeCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).MergeCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_MergeCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).MergeCategories(ctx, req.(*MergeCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ProvisionDefaultCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvisionDefaultCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ProvisionDefaultCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ProvisionDefaultCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ProvisionDefaultCategories(ctx, req.(*ProvisionDefaultCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetCategoriesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetCategoriesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetCategoriesReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetCategoriesReport(ctx, req.(*CategoryReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ExportUserData(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ImportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ImportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ImportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ImportUserData(ctx, req.(*ImportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_DeleteUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).DeleteUserData(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_FilterUsedImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).FilterUsedImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_FilterUsedImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).FilterUsedImages(ctx, req.(*ImageIDs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServiceServer) DeleteCategory(context.Context, *CategoryRequest) (*Category, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
+
+func _FinanceService_CreateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateCategoryRule(ctx, req.(*CreateCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServiceServer) GetCategoriesReport(context.Context, *CategoryReportRequest) (*CategoryReportResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCategoriesReport not implemented")
+
+func _FinanceService_GetCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetCategoryRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetCategoryRules(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServiceServer) mustEmbedUnimplementedFinanceServiceServer() {}
-func (UnimplementedFinanceServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeFinanceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FinanceServiceServer will
-// result in compilation errors.
-type UnsafeFinanceServiceServer interface {
-	mustEmbedUnimplementedFinanceServiceServer()
+func _FinanceService_UpdateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).UpdateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_UpdateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).UpdateCategoryRule(ctx, req.(*UpdateCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterFinanceServiceServer(s grpc.ServiceRegistrar, srv FinanceServiceServer) {
-	// If the following call panics, it indicates UnimplementedFinanceServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _FinanceService_DeleteCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&FinanceService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).DeleteCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_DeleteCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).DeleteCategoryRule(ctx, req.(*CategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _FinanceService_ReorderCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCategoryRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).CreateAccount(ctx, in)
+		return srv.(FinanceServiceServer).ReorderCategoryRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_CreateAccount_FullMethodName,
+		FullMethod: FinanceService_ReorderCategoryRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(FinanceServiceServer).ReorderCategoryRules(ctx, req.(*ReorderCategoryRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountRequest)
+func _FinanceService_TestCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCategoryRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetAccount(ctx, in)
+		return srv.(FinanceServiceServer).TestCategoryRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetAccount_FullMethodName,
+		FullMethod: FinanceService_TestCategoryRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetAccount(ctx, req.(*AccountRequest))
+		return srv.(FinanceServiceServer).TestCategoryRules(ctx, req.(*TestCategoryRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetAccountsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _FinanceService_ApplyCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCategoryRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetAccountsByUser(ctx, in)
+		return srv.(FinanceServiceServer).ApplyCategoryRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetAccountsByUser_FullMethodName,
+		FullMethod: FinanceService_ApplyCategoryRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetAccountsByUser(ctx, req.(*UserID))
+		return srv.(FinanceServiceServer).ApplyCategoryRules(ctx, req.(*ApplyCategoryRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccountRequest)
+func _FinanceService_SuggestCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).UpdateAccount(ctx, in)
+		return srv.(FinanceServiceServer).SuggestCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_UpdateAccount_FullMethodName,
+		FullMethod: FinanceService_SuggestCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+		return srv.(FinanceServiceServer).SuggestCategory(ctx, req.(*SuggestCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountRequest)
+func _FinanceService_GetSpendingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendingStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).DeleteAccount(ctx, in)
+		return srv.(FinanceServiceServer).GetSpendingStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_DeleteAccount_FullMethodName,
+		FullMethod: FinanceService_GetSpendingStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).DeleteAccount(ctx, req.(*AccountRequest))
+		return srv.(FinanceServiceServer).GetSpendingStats(ctx, req.(*SpendingStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_AddUserToAccounnt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToAccountReqeust)
+func _FinanceService_GetSpendingTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendingStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).AddUserToAccounnt(ctx, in)
+		return srv.(FinanceServiceServer).GetSpendingTotals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_AddUserToAccounnt_FullMethodName,
+		FullMethod: FinanceService_GetSpendingTotals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).AddUserToAccounnt(ctx, req.(*AddToAccountReqeust))
+		return srv.(FinanceServiceServer).GetSpendingTotals(ctx, req.(*SpendingStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_CreateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOperationRequest)
+func _FinanceService_GetCounterparties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).CreateOperation(ctx, in)
+		return srv.(FinanceServiceServer).GetCounterparties(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_CreateOperation_FullMethodName,
+		FullMethod: FinanceService_GetCounterparties_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).CreateOperation(ctx, req.(*CreateOperationRequest))
+		return srv.(FinanceServiceServer).GetCounterparties(ctx, req.(*UserID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperationRequest)
+func _FinanceService_CreateDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDebtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetOperation(ctx, in)
+		return srv.(FinanceServiceServer).CreateDebt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetOperation_FullMethodName,
+		FullMethod: FinanceService_CreateDebt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetOperation(ctx, req.(*OperationRequest))
+		return srv.(FinanceServiceServer).CreateDebt(ctx, req.(*CreateDebtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetOperationsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperationsByAccountAndFiltersRequest)
+func _FinanceService_GetDebts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDebtsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetOperationsByAccount(ctx, in)
+		return srv.(FinanceServiceServer).GetDebts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetOperationsByAccount_FullMethodName,
+		FullMethod: FinanceService_GetDebts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetOperationsByAccount(ctx, req.(*OperationsByAccountAndFiltersRequest))
+		return srv.(FinanceServiceServer).GetDebts(ctx, req.(*ListDebtsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_UpdateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOperationRequest)
+func _FinanceService_GetDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).UpdateOperation(ctx, in)
+		return srv.(FinanceServiceServer).GetDebt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_UpdateOperation_FullMethodName,
+		FullMethod: FinanceService_GetDebt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).UpdateOperation(ctx, req.(*UpdateOperationRequest))
+		return srv.(FinanceServiceServer).GetDebt(ctx, req.(*DebtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_DeleteOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperationRequest)
+func _FinanceService_DeleteDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).DeleteOperation(ctx, in)
+		return srv.(FinanceServiceServer).DeleteDebt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_DeleteOperation_FullMethodName,
+		FullMethod: FinanceService_DeleteDebt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).DeleteOperation(ctx, req.(*OperationRequest))
+		return srv.(FinanceServiceServer).DeleteDebt(ctx, req.(*DebtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _FinanceService_AddDebtRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDebtRepaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).CreateCategory(ctx, in)
+		return srv.(FinanceServiceServer).AddDebtRepayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_CreateCategory_FullMethodName,
+		FullMethod: FinanceService_AddDebtRepayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(FinanceServiceServer).AddDebtRepayment(ctx, req.(*CreateDebtRepaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryRequest)
+func _FinanceService_GetDebtPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetCategory(ctx, in)
+		return srv.(FinanceServiceServer).GetDebtPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetCategory_FullMethodName,
+		FullMethod: FinanceService_GetDebtPayments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetCategory(ctx, req.(*CategoryRequest))
+		return srv.(FinanceServiceServer).GetDebtPayments(ctx, req.(*DebtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetCategoryByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryByNameRequest)
+func _FinanceService_SplitOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitOperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetCategoryByName(ctx, in)
+		return srv.(FinanceServiceServer).SplitOperation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetCategoryByName_FullMethodName,
+		FullMethod: FinanceService_SplitOperation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetCategoryByName(ctx, req.(*CategoryByNameRequest))
+		return srv.(FinanceServiceServer).SplitOperation(ctx, req.(*SplitOperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetCategoriesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _FinanceService_GetOperationSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetCategoriesByUser(ctx, in)
+		return srv.(FinanceServiceServer).GetOperationSplit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetCategoriesByUser_FullMethodName,
+		FullMethod: FinanceService_GetOperationSplit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetCategoriesByUser(ctx, req.(*UserID))
+		return srv.(FinanceServiceServer).GetOperationSplit(ctx, req.(*OperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetCategoriesWithStatsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _FinanceService_DeleteOperationSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetCategoriesWithStatsByUser(ctx, in)
+		return srv.(FinanceServiceServer).DeleteOperationSplit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetCategoriesWithStatsByUser_FullMethodName,
+		FullMethod: FinanceService_DeleteOperationSplit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetCategoriesWithStatsByUser(ctx, req.(*UserID))
+		return srv.(FinanceServiceServer).DeleteOperationSplit(ctx, req.(*OperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCategoryRequest)
+func _FinanceService_GetAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).UpdateCategory(ctx, in)
+		return srv.(FinanceServiceServer).GetAccountBalances(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_UpdateCategory_FullMethodName,
+		FullMethod: FinanceService_GetAccountBalances_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+		return srv.(FinanceServiceServer).GetAccountBalances(ctx, req.(*AccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryRequest)
+func _FinanceService_CreateSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSettlementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).DeleteCategory(ctx, in)
+		return srv.(FinanceServiceServer).CreateSettlement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_DeleteCategory_FullMethodName,
+		FullMethod: FinanceService_CreateSettlement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).DeleteCategory(ctx, req.(*CategoryRequest))
+		return srv.(FinanceServiceServer).CreateSettlement(ctx, req.(*CreateSettlementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetCategoriesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryReportRequest)
+func _FinanceService_GetSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetCategoriesReport(ctx, in)
+		return srv.(FinanceServiceServer).GetSettlements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetCategoriesReport_FullMethodName,
+		FullMethod: FinanceService_GetSettlements_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetCategoriesReport(ctx, req.(*CategoryReportRequest))
+		return srv.(FinanceServiceServer).GetSettlements(ctx, req.(*AccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -790,8 +2310,56 @@ var FinanceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _FinanceService_DeleteAccount_Handler,
 		},
 		{
-			MethodName: "AddUserToAccounnt",
-			Handler:    _FinanceService_AddUserToAccounnt_Handler,
+			MethodName: "CreateAccountInvitation",
+			Handler:    _FinanceService_CreateAccountInvitation_Handler,
+		},
+		{
+			MethodName: "GetPendingInvitations",
+			Handler:    _FinanceService_GetPendingInvitations_Handler,
+		},
+		{
+			MethodName: "GetAccountInvitations",
+			Handler:    _FinanceService_GetAccountInvitations_Handler,
+		},
+		{
+			MethodName: "AcceptAccountInvitation",
+			Handler:    _FinanceService_AcceptAccountInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitationLink",
+			Handler:    _FinanceService_AcceptInvitationLink_Handler,
+		},
+		{
+			MethodName: "DeclineAccountInvitation",
+			Handler:    _FinanceService_DeclineAccountInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeAccountInvitation",
+			Handler:    _FinanceService_RevokeAccountInvitation_Handler,
+		},
+		{
+			MethodName: "GetAccountMembers",
+			Handler:    _FinanceService_GetAccountMembers_Handler,
+		},
+		{
+			MethodName: "UpdateAccountMemberRole",
+			Handler:    _FinanceService_UpdateAccountMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveAccountMember",
+			Handler:    _FinanceService_RemoveAccountMember_Handler,
+		},
+		{
+			MethodName: "LeaveAccount",
+			Handler:    _FinanceService_LeaveAccount_Handler,
+		},
+		{
+			MethodName: "TransferAccountOwnership",
+			Handler:    _FinanceService_TransferAccountOwnership_Handler,
+		},
+		{
+			MethodName: "GetAccountUserIDs",
+			Handler:    _FinanceService_GetAccountUserIDs_Handler,
 		},
 		{
 			MethodName: "CreateOperation",
@@ -813,6 +2381,10 @@ var FinanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOperation",
 			Handler:    _FinanceService_DeleteOperation_Handler,
 		},
+		{
+			MethodName: "GetOperationsByIDs",
+			Handler:    _FinanceService_GetOperationsByIDs_Handler,
+		},
 		{
 			MethodName: "CreateCategory",
 			Handler:    _FinanceService_CreateCategory_Handler,
@@ -841,10 +2413,126 @@ var FinanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategory",
 			Handler:    _FinanceService_DeleteCategory_Handler,
 		},
+		{
+			MethodName: "MergeCategories",
+			Handler:    _FinanceService_MergeCategories_Handler,
+		},
+		{
+			MethodName: "ProvisionDefaultCategories",
+			Handler:    _FinanceService_ProvisionDefaultCategories_Handler,
+		},
 		{
 			MethodName: "GetCategoriesReport",
 			Handler:    _FinanceService_GetCategoriesReport_Handler,
 		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _FinanceService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "ImportUserData",
+			Handler:    _FinanceService_ImportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _FinanceService_DeleteUserData_Handler,
+		},
+		{
+			MethodName: "FilterUsedImages",
+			Handler:    _FinanceService_FilterUsedImages_Handler,
+		},
+		{
+			MethodName: "CreateCategoryRule",
+			Handler:    _FinanceService_CreateCategoryRule_Handler,
+		},
+		{
+			MethodName: "GetCategoryRules",
+			Handler:    _FinanceService_GetCategoryRules_Handler,
+		},
+		{
+			MethodName: "UpdateCategoryRule",
+			Handler:    _FinanceService_UpdateCategoryRule_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryRule",
+			Handler:    _FinanceService_DeleteCategoryRule_Handler,
+		},
+		{
+			MethodName: "ReorderCategoryRules",
+			Handler:    _FinanceService_ReorderCategoryRules_Handler,
+		},
+		{
+			MethodName: "TestCategoryRules",
+			Handler:    _FinanceService_TestCategoryRules_Handler,
+		},
+		{
+			MethodName: "ApplyCategoryRules",
+			Handler:    _FinanceService_ApplyCategoryRules_Handler,
+		},
+		{
+			MethodName: "SuggestCategory",
+			Handler:    _FinanceService_SuggestCategory_Handler,
+		},
+		{
+			MethodName: "GetSpendingStats",
+			Handler:    _FinanceService_GetSpendingStats_Handler,
+		},
+		{
+			MethodName: "GetSpendingTotals",
+			Handler:    _FinanceService_GetSpendingTotals_Handler,
+		},
+		{
+			MethodName: "GetCounterparties",
+			Handler:    _FinanceService_GetCounterparties_Handler,
+		},
+		{
+			MethodName: "CreateDebt",
+			Handler:    _FinanceService_CreateDebt_Handler,
+		},
+		{
+			MethodName: "GetDebts",
+			Handler:    _FinanceService_GetDebts_Handler,
+		},
+		{
+			MethodName: "GetDebt",
+			Handler:    _FinanceService_GetDebt_Handler,
+		},
+		{
+			MethodName: "DeleteDebt",
+			Handler:    _FinanceService_DeleteDebt_Handler,
+		},
+		{
+			MethodName: "AddDebtRepayment",
+			Handler:    _FinanceService_AddDebtRepayment_Handler,
+		},
+		{
+			MethodName: "GetDebtPayments",
+			Handler:    _FinanceService_GetDebtPayments_Handler,
+		},
+		{
+			MethodName: "SplitOperation",
+			Handler:    _FinanceService_SplitOperation_Handler,
+		},
+		{
+			MethodName: "GetOperationSplit",
+			Handler:    _FinanceService_GetOperationSplit_Handler,
+		},
+		{
+			MethodName: "DeleteOperationSplit",
+			Handler:    _FinanceService_DeleteOperationSplit_Handler,
+		},
+		{
+			MethodName: "GetAccountBalances",
+			Handler:    _FinanceService_GetAccountBalances_Handler,
+		},
+		{
+			MethodName: "CreateSettlement",
+			Handler:    _FinanceService_CreateSettlement_Handler,
+		},
+		{
+			MethodName: "GetSettlements",
+			Handler:    _FinanceService_GetSettlements_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/finance_service/proto/finance.proto",
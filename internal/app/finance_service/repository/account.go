@@ -7,9 +7,7 @@ import (
 
 	"github.com/elastic/go-elasticsearch/v8"
 
-	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
 	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
-	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
 )
 
 type PostgresRepository struct {
@@ -60,7 +58,7 @@ func NewPostgresRepository(db *sql.DB) *PostgresRepository {
 func (r *PostgresRepository) GetAccountsByUser(ctx context.Context, userID int) ([]finmodels.Account, error) {
 	query := `
 			SELECT a._id, a.balance, a.account_type, a.currency_id, 
-		a.created_at, a.updated_at, a.account_name, a.account_description
+		a.created_at, a.updated_at, a.account_name, a.account_description, s.sharing_role
 		FROM account a
 		JOIN sharings s ON a._id = s.account_id
 		WHERE s.user_id = $1
@@ -87,6 +85,7 @@ func (r *PostgresRepository) GetAccountsByUser(ctx context.Context, userID int)
 			&account.UpdatedAt,
 			&account.Name,
 			&account.Description,
+			&account.Role,
 		)
 		if err != nil {
 			return nil, MapPgAccountError(err)
@@ -99,7 +98,8 @@ func (r *PostgresRepository) GetAccountsByUser(ctx context.Context, userID int)
 
 func (r *PostgresRepository) GetAccountByID(ctx context.Context, userID, accountID int) (finmodels.Account, error) {
 	query := `
-		SELECT a._id, a.balance, a.account_type, a.currency_id, a.created_at, a.updated_at, a.account_name, a.account_description
+		SELECT a._id, a.balance, a.account_type, a.currency_id, a.created_at, a.updated_at, a.account_name, a.account_description,
+		       s.sharing_role
 		FROM account a
 		JOIN sharings s ON a._id = s.account_id
 		WHERE s.user_id = $1 AND a._id = $2
@@ -115,6 +115,7 @@ func (r *PostgresRepository) GetAccountByID(ctx context.Context, userID, account
 		&account.UpdatedAt,
 		&account.Name,
 		&account.Description,
+		&account.Role,
 	)
 
 	if err != nil {
@@ -145,14 +146,16 @@ func (r *PostgresRepository) CreateAccount(ctx context.Context, account finmodel
 	if err := r.CreateUserAccount(ctx, userID, account.ID); err != nil {
 		return finmodels.Account{}, MapPgAccountError(err)
 	}
+	account.Role = finmodels.RoleOwner
 
 	return account, nil
 }
 
+// CreateUserAccount делает пользователя владельцем счета
 func (r *PostgresRepository) CreateUserAccount(ctx context.Context, userID, accountID int) error {
 	query := `
-		INSERT INTO sharings (account_id, user_id, created_at, updated_at)
-		VALUES ($1, $2, NOW(), NOW())
+		INSERT INTO sharings (account_id, user_id, sharing_role, created_at, updated_at)
+		VALUES ($1, $2, 'owner', NOW(), NOW())
 	`
 
 	_, err := r.db.ExecContext(ctx, query, accountID, userID)
@@ -163,6 +166,10 @@ func (r *PostgresRepository) CreateUserAccount(ctx context.Context, userID, acco
 }
 
 func (r *PostgresRepository) UpdateAccount(ctx context.Context, req finmodels.UpdateAccountRequest) (finmodels.Account, error) {
+	if err := requireAccountRole(ctx, r.db, req.UserID, req.AccountID, finmodels.SharingRole.CanManage); err != nil {
+		return finmodels.Account{}, err
+	}
+
 	query := `
 		UPDATE account a
 		SET 
@@ -172,7 +179,8 @@ func (r *PostgresRepository) UpdateAccount(ctx context.Context, req finmodels.Up
 			updated_at = NOW()
 		FROM sharings s
 		WHERE a._id = s.account_id AND s.user_id = $4 AND a._id = $5
-		RETURNING a._id, a.account_name, a.account_description, a.balance, a.account_type, a.currency_id, a.created_at, a.updated_at
+		RETURNING a._id, a.account_name, a.account_description, a.balance, a.account_type, a.currency_id, a.created_at, a.updated_at,
+		          s.sharing_role
 	`
 
 	var acc finmodels.Account
@@ -191,6 +199,7 @@ func (r *PostgresRepository) UpdateAccount(ctx context.Context, req finmodels.Up
 		&acc.CurrencyID,
 		&acc.CreatedAt,
 		&acc.UpdatedAt,
+		&acc.Role,
 	)
 
 	if err != nil {
@@ -215,7 +224,12 @@ func (r *PostgresRepository) UpdateAccountBalance(ctx context.Context, accountID
 	return nil
 }
 
+// DeleteAccount удаляет счет вместе с операциями. Удалить счет может только владелец.
 func (r *PostgresRepository) DeleteAccount(ctx context.Context, userID, accID int) (finmodels.Account, error) {
+	if err := requireAccountRole(ctx, r.db, userID, accID, finmodels.SharingRole.CanManage); err != nil {
+		return finmodels.Account{}, err
+	}
+
 	query := `
 		DELETE FROM account
 		WHERE _id = $1
@@ -234,42 +248,7 @@ func (r *PostgresRepository) DeleteAccount(ctx context.Context, userID, accID in
 	if err != nil {
 		return finmodels.Account{}, MapPgAccountError(err)
 	}
+	acc.Role = finmodels.RoleOwner
 
 	return acc, nil
 }
-
-func (r *PostgresRepository) AddUserToAccount(ctx context.Context, userLogin string, accountID int) (finmodels.SharingAccount, error) {
-	var accountType string
-
-	err := r.db.QueryRowContext(ctx, `SELECT account_type FROM account where _id = $1`, accountID).Scan(&accountType)
-	if err != nil {
-		return finmodels.SharingAccount{}, MapPgAccountError(err)
-	}
-
-	if accountType == models.PrivateAccount {
-		return finmodels.SharingAccount{}, errors.ErrPrivateAccount
-	}
-
-	var userID int
-	err = r.db.QueryRowContext(ctx, `SELECT _id FROM "user" where user_login = $1`, userLogin).Scan(&userID)
-	if err != nil {
-		return finmodels.SharingAccount{}, errors.ErrUserNotFound
-	}
-
-	query := `
-		INSERT INTO sharings
-		(account_id, user_id, created_at, updated_at)
-		VALUES ($1, $2, NOW(), NOW())
-		RETURNING _id, account_id, user_id, created_at
-	`
-	sh := finmodels.SharingAccount{}
-
-	err = r.db.QueryRowContext(ctx, query, accountID, userID).
-		Scan(&sh.ID, &sh.AccountID, &sh.UserID, &sh.CreatedAt)
-
-	if err != nil {
-		return finmodels.SharingAccount{}, MapPgAccountError(err)
-	}
-	return sh, nil
-
-}
@@ -31,7 +31,7 @@ func TestGetAccountByID_Success(t *testing.T) {
 	updated := time.Now()
 	mock.ExpectQuery(regexp.QuoteMeta(`
     SELECT a._id, a.balance, a.account_type, a.currency_id, a.created_at, a.updated_at,
-           a.account_name, a.account_description
+           a.account_name, a.account_description, s.sharing_role
     FROM account a
     JOIN sharings s ON a._id = s.account_id
     WHERE s.user_id = $1 AND a._id = $2
@@ -39,13 +39,14 @@ func TestGetAccountByID_Success(t *testing.T) {
 		WithArgs(userID, accountID).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"_id", "balance", "account_type", "currency_id",
-			"created_at", "updated_at", "account_name", "account_description",
-		}).AddRow(accountID, 100.0, "cash", 1, created, updated, "name", "desc"))
+			"created_at", "updated_at", "account_name", "account_description", "sharing_role",
+		}).AddRow(accountID, 100.0, "cash", 1, created, updated, "name", "desc", "viewer"))
 
 	acc, err := repo.GetAccountByID(context.Background(), userID, accountID)
 	require.NoError(t, err)
 	require.Equal(t, accountID, acc.ID)
 	require.Equal(t, 100.0, acc.Balance)
+	require.Equal(t, models.RoleViewer, acc.Role)
 }
 
 func TestGetAccountByID_NotFound(t *testing.T) {
@@ -54,7 +55,7 @@ func TestGetAccountByID_NotFound(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
     SELECT a._id, a.balance, a.account_type, a.currency_id, a.created_at, a.updated_at,
-           a.account_name, a.account_description
+           a.account_name, a.account_description, s.sharing_role
     FROM account a
     JOIN sharings s ON a._id = s.account_id
     WHERE s.user_id = $1 AND a._id = $2
@@ -76,14 +77,14 @@ func TestGetAccountsByUser(t *testing.T) {
 
 	rows := sqlmock.NewRows([]string{
 		"_id", "balance", "account_type", "currency_id",
-		"created_at", "updated_at", "account_name", "account_description",
+		"created_at", "updated_at", "account_name", "account_description", "sharing_role",
 	}).
-		AddRow(1, 100.0, "cash", 1, created, updated, "Cash Wallet", "My cash").
-		AddRow(2, 200.0, "card", 2, created, updated, "Bank Card", "My card")
+		AddRow(1, 100.0, "cash", 1, created, updated, "Cash Wallet", "My cash", "owner").
+		AddRow(2, 200.0, "card", 2, created, updated, "Bank Card", "My card", "editor")
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		SELECT a._id, a.balance, a.account_type, a.currency_id, 
-		       a.created_at, a.updated_at, a.account_name, a.account_description
+		       a.created_at, a.updated_at, a.account_name, a.account_description, s.sharing_role
 		FROM account a
 		JOIN sharings s ON a._id = s.account_id
 		WHERE s.user_id = $1
@@ -132,8 +133,8 @@ func TestCreateAccount_Success(t *testing.T) {
 
 	// mock insert into sharings
 	mock.ExpectExec(regexp.QuoteMeta(`
-		INSERT INTO sharings (account_id, user_id, created_at, updated_at)
-		VALUES ($1, $2, NOW(), NOW())
+		INSERT INTO sharings (account_id, user_id, sharing_role, created_at, updated_at)
+		VALUES ($1, $2, 'owner', NOW(), NOW())
 	`)).
 		WithArgs(5, userID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
@@ -144,6 +145,7 @@ func TestCreateAccount_Success(t *testing.T) {
 	require.Equal(t, 100.0, acc.Balance)
 	require.Equal(t, account.Name, acc.Name)
 	require.Equal(t, account.Description, acc.Description)
+	require.Equal(t, models.RoleOwner, acc.Role)
 }
 
 func TestUpdateAccount_Success(t *testing.T) {
@@ -157,6 +159,7 @@ func TestUpdateAccount_Success(t *testing.T) {
 	created := time.Now()
 	updated := time.Now()
 
+	expectAccountRole(mock, 1, 2, "owner")
 	mock.ExpectQuery(regexp.QuoteMeta(`
     UPDATE account a
     SET 
@@ -166,12 +169,13 @@ func TestUpdateAccount_Success(t *testing.T) {
         updated_at = NOW()
     FROM sharings s
     WHERE a._id = s.account_id AND s.user_id = $4 AND a._id = $5
-    RETURNING a._id, a.account_name, a.account_description, a.balance, a.account_type, a.currency_id, a.created_at, a.updated_at
+    RETURNING a._id, a.account_name, a.account_description, a.balance, a.account_type, a.currency_id, a.created_at, a.updated_at,
+              s.sharing_role
 `)).
 		WithArgs(req.Balance, req.Name, req.Description, req.UserID, req.AccountID).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"_id", "account_name", "account_description", "balance", "account_type", "currency_id", "created_at", "updated_at",
-		}).AddRow(req.AccountID, "", "", req.Balance, "cash", 1, created, updated))
+			"_id", "account_name", "account_description", "balance", "account_type", "currency_id", "created_at", "updated_at", "sharing_role",
+		}).AddRow(req.AccountID, "", "", req.Balance, "cash", 1, created, updated, "owner"))
 
 	acc, err := repo.UpdateAccount(context.Background(), req)
 	require.NoError(t, err)
@@ -203,6 +207,7 @@ func TestDeleteAccount(t *testing.T) {
 	created := time.Now()
 	updated := time.Now()
 
+	expectAccountRole(mock, 1, accID, "owner")
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		DELETE FROM account
 		WHERE _id = $1
@@ -214,3 +219,26 @@ func TestDeleteAccount(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 400.0, acc.Balance)
 }
+
+func TestUpdateAccount_EditorForbidden(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	name := "beba"
+
+	expectAccountRole(mock, 1, 2, "editor")
+
+	_, err := repo.UpdateAccount(context.Background(), models.UpdateAccountRequest{UserID: 1, AccountID: 2, Name: &name})
+	require.ErrorIs(t, err, serviceerrors.ErrForbidden)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAccount_NotOwner(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	expectAccountRole(mock, 1, 3, "editor")
+
+	_, err := repo.DeleteAccount(context.Background(), 1, 3)
+	require.ErrorIs(t, err, serviceerrors.ErrForbidden)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,425 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+const (
+	restoreEntityAccount   = "account"
+	restoreEntityCategory  = "category"
+	restoreEntityOperation = "operation"
+)
+
+// GetOperationsByUser операции счетов, которыми владеет пользователь. Счета, где
+// он только участник, принадлежат другим и в его резервную копию не попадают.
+func (r *PostgresRepository) GetOperationsByUser(ctx context.Context, userID int) ([]finmodels.Operation, error) {
+	query := `
+		SELECT o._id, o.account_from_id, o.account_to_id, o.category_id, o.currency_id,
+		       o.operation_status, o.operation_type, o.operation_name, COALESCE(o.operation_description, ''),
+		       COALESCE(o.receipt_url, ''), o.sum, o.created_at, o.operation_date,
+		       COALESCE(c.category_name, 'Без категории') as category_name,
+		       a.account_type
+		FROM operation o
+		LEFT JOIN category c ON o.category_id = c._id
+		JOIN account a ON a._id = COALESCE(o.account_from_id, o.account_to_id)
+		JOIN sharings s ON s.account_id = a._id AND s.sharing_role = 'owner'
+		WHERE s.user_id = $1 AND o.operation_status != 'reverted'
+		ORDER BY o.operation_date
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, MapPgOperationError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var operations []finmodels.Operation
+	for rows.Next() {
+		var opDB OperationDB
+		err := rows.Scan(
+			&opDB.ID,
+			&opDB.AccountFromID,
+			&opDB.AccountToID,
+			&opDB.CategoryID,
+			&opDB.CurrencyID,
+			&opDB.Status,
+			&opDB.Type,
+			&opDB.Name,
+			&opDB.Description,
+			&opDB.ReceiptURL,
+			&opDB.Sum,
+			&opDB.CreatedAt,
+			&opDB.Date,
+			&opDB.CategoryName,
+			&opDB.AccountType,
+		)
+		if err != nil {
+			return nil, MapPgOperationError(err)
+		}
+		operations = append(operations, operationDBToModel(opDB))
+	}
+
+	return operations, nil
+}
+
+func (r *PostgresRepository) GetReceiversByUser(ctx context.Context, userID int) ([]finmodels.Receiver, error) {
+	query := `
+		SELECT _id, user_id, receiver_name, logo_hashed_id, created_at
+		FROM receiver
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var receivers []finmodels.Receiver
+	for rows.Next() {
+		var rcv finmodels.Receiver
+		if err := rows.Scan(&rcv.ID, &rcv.UserID, &rcv.Name, &rcv.LogoHashedID, &rcv.CreatedAt); err != nil {
+			return nil, err
+		}
+		receivers = append(receivers, rcv)
+	}
+
+	return receivers, nil
+}
+
+// GetSharingsByOwner остальные участники счетов, которыми владеет пользователь
+func (r *PostgresRepository) GetSharingsByOwner(ctx context.Context, userID int) ([]finmodels.SharingAccount, error) {
+	query := `
+		SELECT s._id, s.user_id, u.user_login, s.account_id, s.sharing_role, s.created_at
+		FROM sharings s
+		JOIN "user" u ON u._id = s.user_id
+		JOIN sharings own ON own.account_id = s.account_id AND own.sharing_role = 'owner'
+		WHERE own.user_id = $1 AND s.user_id <> $1
+		ORDER BY s.account_id, s.created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, MapPgAccountError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var sharings []finmodels.SharingAccount
+	for rows.Next() {
+		var sh finmodels.SharingAccount
+		if err := rows.Scan(&sh.ID, &sh.UserID, &sh.UserLogin, &sh.AccountID, &sh.Role, &sh.CreatedAt); err != nil {
+			return nil, MapPgAccountError(err)
+		}
+		sharings = append(sharings, sh)
+	}
+
+	return sharings, nil
+}
+
+// ImportUserData восстанавливает данные из резервной копии в одной транзакции.
+// Уже восстановленные записи пропускаются по таблице restore_mapping: по
+// идентификатору из того же архива или по содержимому из любого прошлого,
+// так что две выгрузки одних и тех же данных не создают дубликатов. Записи,
+// удаленные пользователем после восстановления, создаются заново.
+func (r *PostgresRepository) ImportUserData(ctx context.Context, req finmodels.ImportUserDataRequest) (finmodels.ImportUserDataResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return finmodels.ImportUserDataResult{}, err
+	}
+	defer tx.Rollback()
+
+	var result finmodels.ImportUserDataResult
+
+	categoryIDs := make(map[int]int, len(req.Data.Categories))
+	for _, ctg := range req.Data.Categories {
+		key := contentKey(restoreEntityCategory, ctg.Name)
+		targetID, found, err := lookupRestored(ctx, tx, req, restoreEntityCategory, ctg.ID, key)
+		if err != nil {
+			return finmodels.ImportUserDataResult{}, err
+		}
+		if !found {
+			var description *string
+			if ctg.Description != "" {
+				description = &ctg.Description
+			}
+			err = tx.QueryRowContext(ctx, `
+				INSERT INTO category (user_id, category_name, category_description, logo_hashed_id, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, NOW())
+				ON CONFLICT (user_id, category_name) DO UPDATE SET updated_at = NOW()
+				RETURNING _id
+			`, req.UserID, ctg.Name, description, ctg.LogoHashedID, ctg.CreatedAt).Scan(&targetID)
+			if err != nil {
+				return finmodels.ImportUserDataResult{}, MapPgCategoryError(err)
+			}
+			if err := saveRestored(ctx, tx, req, restoreEntityCategory, ctg.ID, targetID, key); err != nil {
+				return finmodels.ImportUserDataResult{}, err
+			}
+			result.CategoriesRestored++
+		}
+		categoryIDs[ctg.ID] = targetID
+	}
+
+	// связи с родителями восстанавливаются после создания всех категорий;
+	// категории, уже вложенные куда-то, и переносы, замыкающие цикл, пропускаются
+	for _, ctg := range req.Data.Categories {
+		if ctg.ParentID == nil {
+			continue
+		}
+		parentID, ok := categoryIDs[*ctg.ParentID]
+		if !ok {
+			continue
+		}
+		_, err := tx.ExecContext(ctx, `
+			UPDATE category SET parent_id = $1
+			WHERE _id = $2 AND user_id = $3 AND parent_id IS NULL AND _id <> $1
+			  AND NOT EXISTS (
+				WITH RECURSIVE ancestors AS (
+					SELECT _id, parent_id FROM category WHERE _id = $1
+					UNION
+					SELECT c._id, c.parent_id FROM category c JOIN ancestors a ON c._id = a.parent_id
+				)
+				SELECT 1 FROM ancestors WHERE _id = $2
+			  )
+		`, parentID, categoryIDs[ctg.ID], req.UserID)
+		if err != nil {
+			return finmodels.ImportUserDataResult{}, MapPgCategoryError(err)
+		}
+	}
+
+	accountIDs := make(map[int]int, len(req.Data.Accounts))
+	accountKeys := make(map[int]string, len(req.Data.Accounts))
+	createdAccounts := make(map[int]bool, len(req.Data.Accounts))
+	for _, acc := range req.Data.Accounts {
+		key := contentKey(restoreEntityAccount, acc.Type, acc.Name, strconv.Itoa(acc.CurrencyID), formatRestoreTime(acc.CreatedAt))
+		accountKeys[acc.ID] = key
+		targetID, found, err := lookupRestored(ctx, tx, req, restoreEntityAccount, acc.ID, key)
+		if err != nil {
+			return finmodels.ImportUserDataResult{}, err
+		}
+		if !found {
+			var currencyID interface{}
+			if acc.CurrencyID > 0 {
+				currencyID = acc.CurrencyID
+			}
+			err = tx.QueryRowContext(ctx, `
+				INSERT INTO account (account_name, account_description, balance, account_type, currency_id, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, NOW())
+				RETURNING _id
+			`, acc.Name, acc.Description, acc.Balance, acc.Type, currencyID, acc.CreatedAt).Scan(&targetID)
+			if err != nil {
+				return finmodels.ImportUserDataResult{}, MapPgAccountError(err)
+			}
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO sharings (account_id, user_id, sharing_role, created_at, updated_at)
+				VALUES ($1, $2, 'owner', NOW(), NOW())
+			`, targetID, req.UserID)
+			if err != nil {
+				return finmodels.ImportUserDataResult{}, MapPgAccountError(err)
+			}
+			if err := saveRestored(ctx, tx, req, restoreEntityAccount, acc.ID, targetID, key); err != nil {
+				return finmodels.ImportUserDataResult{}, err
+			}
+			createdAccounts[targetID] = true
+			result.AccountsRestored++
+		}
+		accountIDs[acc.ID] = targetID
+	}
+
+	// участники возвращаются только на только что созданные счета и находятся
+	// по логину: на другом инстансе те же id принадлежат другим людям, а
+	// незарегистрированные здесь участники пропускаются
+	for _, sh := range req.Data.Sharings {
+		accountID, ok := accountIDs[sh.AccountID]
+		if !ok || !createdAccounts[accountID] {
+			continue
+		}
+		if sh.Role != finmodels.RoleEditor && sh.Role != finmodels.RoleViewer {
+			continue
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO sharings (account_id, user_id, sharing_role, created_at, updated_at)
+			SELECT $1, u._id, $2, NOW(), NOW()
+			FROM "user" u
+			WHERE u.user_login = $3 AND u._id <> $4
+			ON CONFLICT (account_id, user_id) DO NOTHING
+		`, accountID, sh.Role, sh.UserLogin, req.UserID)
+		if err != nil {
+			return finmodels.ImportUserDataResult{}, MapPgAccountError(err)
+		}
+	}
+
+	for _, op := range req.Data.Operations {
+		accountID, ok := accountIDs[op.AccountID]
+		if !ok {
+			continue
+		}
+		// операция определяется содержимым вместе со своими счетами
+		key := contentKey(restoreEntityOperation, accountKeys[op.AccountID], accountKeys[op.AccountToID], string(op.Type), op.Name,
+			strconv.FormatFloat(op.Sum, 'f', -1, 64), formatRestoreTime(op.Date), formatRestoreTime(op.CreatedAt))
+		_, found, err := lookupRestored(ctx, tx, req, restoreEntityOperation, op.ID, key)
+		if err != nil {
+			return finmodels.ImportUserDataResult{}, err
+		}
+		if found {
+			continue
+		}
+
+		var categoryID interface{}
+		if id, ok := categoryIDs[op.CategoryID]; ok {
+			categoryID = id
+		}
+		var currencyID interface{}
+		if op.CurrencyID > 0 {
+			currencyID = op.CurrencyID
+		}
+		// перевод на счет не из архива восстанавливается как списание
+		var accountToID interface{}
+		if id, ok := accountIDs[op.AccountToID]; ok && op.AccountToID != 0 {
+			accountToID = id
+		}
+		status := op.Status
+		if status != finmodels.OperationReverted {
+			status = finmodels.OperationFinished
+		}
+
+		var targetID int
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO operation (
+			    account_from_id, account_to_id, category_id, currency_id, operation_status, operation_type,
+			    operation_name, operation_description, receipt_url, sum, created_at, operation_date
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			RETURNING _id
+		`, accountID, accountToID, categoryID, currencyID, status, op.Type,
+			op.Name, op.Description, op.ReceiptURL, op.Sum, op.CreatedAt, op.Date).Scan(&targetID)
+		if err != nil {
+			return finmodels.ImportUserDataResult{}, MapPgOperationError(err)
+		}
+		if err := saveRestored(ctx, tx, req, restoreEntityOperation, op.ID, targetID, key); err != nil {
+			return finmodels.ImportUserDataResult{}, err
+		}
+
+		restored := op
+		restored.ID = targetID
+		restored.AccountID = accountID
+		restored.AccountToID = 0
+		if id, ok := accountToID.(int); ok {
+			restored.AccountToID = id
+		}
+		restored.CategoryID = 0
+		if id, ok := categoryIDs[op.CategoryID]; ok {
+			restored.CategoryID = id
+		}
+		restored.Status = status
+		result.RestoredOperations = append(result.RestoredOperations, restored)
+		result.OperationsRestored++
+	}
+
+	for _, rcv := range req.Data.Receivers {
+		res, err := tx.ExecContext(ctx, `
+			INSERT INTO receiver (user_id, receiver_name, logo_hashed_id, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, NOW())
+			ON CONFLICT (user_id, receiver_name) DO NOTHING
+		`, req.UserID, rcv.Name, rcv.LogoHashedID, rcv.CreatedAt)
+		if err != nil {
+			return finmodels.ImportUserDataResult{}, MapPgOperationError(err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return finmodels.ImportUserDataResult{}, err
+		}
+		result.ReceiversRestored += int(affected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return finmodels.ImportUserDataResult{}, err
+	}
+
+	return result, nil
+}
+
+// restoredTargetExists условие, что созданная при восстановлении запись m.target_id
+// еще существует и принадлежит пользователю
+var restoredTargetExists = map[string]string{
+	restoreEntityAccount: `
+		SELECT 1 FROM sharings s
+		WHERE s.account_id = m.target_id AND s.user_id = m.user_id AND s.sharing_role = 'owner'`,
+	restoreEntityCategory: `
+		SELECT 1 FROM category c
+		WHERE c._id = m.target_id AND c.user_id = m.user_id`,
+	restoreEntityOperation: `
+		SELECT 1 FROM operation o
+		JOIN sharings s ON s.account_id = COALESCE(o.account_from_id, o.account_to_id)
+		WHERE o._id = m.target_id AND s.user_id = m.user_id AND s.sharing_role = 'owner'`,
+}
+
+// lookupRestored ищет запись, уже созданную из sourceID этого архива или из
+// того же содержимого в прошлых архивах. Сопоставления с удаленными с тех
+// пор записями не учитываются. Совпадение по содержимому запоминается и
+// для этого архива, чтобы по нему находили запись и бюджеты.
+func lookupRestored(ctx context.Context, tx *sql.Tx, req finmodels.ImportUserDataRequest, entity string, sourceID int, key string) (int, bool, error) {
+	var (
+		targetID int
+		current  bool
+	)
+	err := tx.QueryRowContext(ctx, `
+		SELECT m.target_id, m.backup_id = $3 AND m.source_id = $4
+		FROM restore_mapping m
+		WHERE m.user_id = $1 AND m.entity_type = $2
+		  AND ((m.backup_id = $3 AND m.source_id = $4) OR m.content_key = $5)
+		  AND EXISTS (`+restoredTargetExists[entity]+`)
+		ORDER BY 2 DESC, m._id DESC
+		LIMIT 1
+	`, req.UserID, entity, req.BackupID, sourceID, key).Scan(&targetID, &current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if !current {
+		if err := saveRestored(ctx, tx, req, entity, sourceID, targetID, key); err != nil {
+			return 0, false, err
+		}
+	}
+	return targetID, true, nil
+}
+
+// saveRestored запоминает сопоставление; устаревшее сопоставление того же
+// архива, запись которого была удалена, заменяется
+func saveRestored(ctx context.Context, tx *sql.Tx, req finmodels.ImportUserDataRequest, entity string, sourceID, targetID int, key string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO restore_mapping (user_id, backup_id, entity_type, source_id, target_id, content_key)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, backup_id, entity_type, source_id)
+		DO UPDATE SET target_id = EXCLUDED.target_id, content_key = EXCLUDED.content_key
+	`, req.UserID, req.BackupID, entity, sourceID, targetID, key)
+	return err
+}
+
+// contentKey устойчивый идентификатор записи по ее содержимому: не зависит
+// ни от идентификаторов исходной базы, ни от того, из какой выгрузки запись
+func contentKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
+
+func formatRestoreTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
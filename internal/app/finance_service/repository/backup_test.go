@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	"github.com/stretchr/testify/require"
+)
+
+func backupImportRequest() finmodels.ImportUserDataRequest {
+	now := time.Now()
+	return finmodels.ImportUserDataRequest{
+		UserID:   1,
+		BackupID: "backup-1",
+		Data: finmodels.UserData{
+			Categories: []finmodels.Category{{ID: 5, Name: "Food", CreatedAt: now}},
+			Accounts:   []finmodels.Account{{ID: 7, Name: "Card", Balance: 100, Type: "regular", CurrencyID: 1, CreatedAt: now}},
+			Operations: []finmodels.Operation{{ID: 9, AccountID: 7, CategoryID: 5, Type: "expense", Name: "Lunch", Sum: 10, CurrencyID: 1, CreatedAt: now, Date: now}},
+		},
+	}
+}
+
+func TestImportUserData_CreatesAndMaps(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	req := backupImportRequest()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "category", "backup-1", 5, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}))
+	mock.ExpectQuery(`INSERT INTO category`).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(50))
+	mock.ExpectExec(`INSERT INTO restore_mapping`).
+		WithArgs(1, "backup-1", "category", 5, 50, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "account", "backup-1", 7, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}))
+	mock.ExpectQuery(`INSERT INTO account`).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(70))
+	mock.ExpectExec(`INSERT INTO sharings`).
+		WithArgs(70, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO restore_mapping`).
+		WithArgs(1, "backup-1", "account", 7, 70, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "operation", "backup-1", 9, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}))
+	mock.ExpectQuery(`INSERT INTO operation`).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(90))
+	mock.ExpectExec(`INSERT INTO restore_mapping`).
+		WithArgs(1, "backup-1", "operation", 9, 90, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	res, err := repo.ImportUserData(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.CategoriesRestored)
+	require.Equal(t, 1, res.AccountsRestored)
+	require.Equal(t, 1, res.OperationsRestored)
+	require.Len(t, res.RestoredOperations, 1)
+	require.Equal(t, 90, res.RestoredOperations[0].ID)
+	require.Equal(t, 70, res.RestoredOperations[0].AccountID)
+	require.Equal(t, 50, res.RestoredOperations[0].CategoryID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportUserData_AlreadyRestored(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	req := backupImportRequest()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "category", "backup-1", 5, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}).AddRow(50, true))
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "account", "backup-1", 7, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}).AddRow(70, true))
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "operation", "backup-1", 9, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}).AddRow(90, true))
+	mock.ExpectCommit()
+
+	res, err := repo.ImportUserData(context.Background(), req)
+	require.NoError(t, err)
+	require.Zero(t, res.CategoriesRestored)
+	require.Zero(t, res.AccountsRestored)
+	require.Zero(t, res.OperationsRestored)
+	require.Empty(t, res.RestoredOperations)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportUserData_SameDataFromAnotherExport(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	req := backupImportRequest()
+	req.BackupID = "backup-2"
+	acc := req.Data.Accounts[0]
+	accountKey := contentKey(restoreEntityAccount, acc.Type, acc.Name, "1", formatRestoreTime(acc.CreatedAt))
+
+	// записи из первой выгрузки находятся по содержимому и сопоставляются
+	// с этим архивом, новые не создаются
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "category", "backup-2", 5, contentKey(restoreEntityCategory, "Food")).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}).AddRow(50, false))
+	mock.ExpectExec(`INSERT INTO restore_mapping .* ON CONFLICT`).
+		WithArgs(1, "backup-2", "category", 5, 50, contentKey(restoreEntityCategory, "Food")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "account", "backup-2", 7, accountKey).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}).AddRow(70, false))
+	mock.ExpectExec(`INSERT INTO restore_mapping`).
+		WithArgs(1, "backup-2", "account", 7, 70, accountKey).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "operation", "backup-2", 9, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}).AddRow(90, false))
+	mock.ExpectExec(`INSERT INTO restore_mapping`).
+		WithArgs(1, "backup-2", "operation", 9, 90, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	res, err := repo.ImportUserData(context.Background(), req)
+	require.NoError(t, err)
+	require.Zero(t, res.CategoriesRestored)
+	require.Zero(t, res.AccountsRestored)
+	require.Zero(t, res.OperationsRestored)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportUserData_RecreatesDeletedAccount(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	req := backupImportRequest()
+	req.Data.Categories = nil
+	req.Data.Operations = nil
+
+	// сопоставление с удаленным счетом не находится из-за проверки владельца,
+	// счет создается заново, а сопоставление перезаписывается
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM restore_mapping m .* EXISTS \( SELECT 1 FROM sharings s`).
+		WithArgs(1, "account", "backup-1", 7, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}))
+	mock.ExpectQuery(`INSERT INTO account`).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(71))
+	mock.ExpectExec(`INSERT INTO sharings`).
+		WithArgs(71, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO restore_mapping .* DO UPDATE SET target_id = EXCLUDED.target_id`).
+		WithArgs(1, "backup-1", "account", 7, 71, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	res, err := repo.ImportUserData(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.AccountsRestored)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReceiversByUser(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`FROM receiver`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "user_id", "receiver_name", "logo_hashed_id", "created_at"}).
+			AddRow(3, 1, "Shop", "", time.Now()))
+
+	receivers, err := repo.GetReceiversByUser(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, receivers, 1)
+	require.Equal(t, "Shop", receivers[0].Name)
+}
+
+func TestImportUserData_RestoresCategoryParents(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	parentID := 5
+	req := finmodels.ImportUserDataRequest{
+		UserID:   1,
+		BackupID: "backup-1",
+		Data: finmodels.UserData{
+			Categories: []finmodels.Category{
+				{ID: 5, Name: "Транспорт"},
+				{ID: 6, Name: "Такси", ParentID: &parentID},
+			},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "category", "backup-1", 5, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}).AddRow(50, true))
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "category", "backup-1", 6, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}).AddRow(60, true))
+	mock.ExpectExec(`UPDATE category SET parent_id`).
+		WithArgs(50, 60, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	_, err := repo.ImportUserData(context.Background(), req)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportUserData_RestoresTransfersAndSharings(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	now := time.Now()
+	req := finmodels.ImportUserDataRequest{
+		UserID:   1,
+		BackupID: "backup-1",
+		Data: finmodels.UserData{
+			Accounts: []finmodels.Account{
+				{ID: 7, Name: "Card", Type: "regular", CreatedAt: now},
+				{ID: 8, Name: "Savings", Type: "regular", CreatedAt: now},
+			},
+			Sharings: []finmodels.SharingAccount{
+				{AccountID: 7, UserLogin: "bob", Role: finmodels.RoleEditor},
+				{AccountID: 99, UserLogin: "eve", Role: finmodels.RoleViewer},
+			},
+			Operations: []finmodels.Operation{
+				{ID: 9, AccountID: 7, AccountToID: 8, Type: "transfer", Status: finmodels.OperationFinished, Name: "Move", Sum: 10, Date: now},
+			},
+		},
+	}
+
+	mock.ExpectBegin()
+	for i, src := range []int{7, 8} {
+		mock.ExpectQuery(`FROM restore_mapping m`).
+			WithArgs(1, "account", "backup-1", src, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}))
+		mock.ExpectQuery(`INSERT INTO account`).
+			WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(70 + i))
+		mock.ExpectExec(`INSERT INTO sharings`).
+			WithArgs(70+i, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`INSERT INTO restore_mapping`).
+			WithArgs(1, "backup-1", "account", src, 70+i, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectExec(`INSERT INTO sharings .* FROM "user" u`).
+		WithArgs(70, finmodels.RoleEditor, "bob", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectQuery(`FROM restore_mapping m`).
+		WithArgs(1, "operation", "backup-1", 9, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "current"}))
+	mock.ExpectQuery(`INSERT INTO operation`).
+		WithArgs(70, 71, nil, nil, finmodels.OperationFinished, finmodels.OperationType("transfer"),
+			"Move", "", "", float64(10), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(90))
+	mock.ExpectExec(`INSERT INTO restore_mapping`).
+		WithArgs(1, "backup-1", "operation", 9, 90, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	res, err := repo.ImportUserData(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, res.RestoredOperations, 1)
+	require.Equal(t, 71, res.RestoredOperations[0].AccountToID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSharingsByOwner(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`JOIN sharings own ON own.account_id = s.account_id AND own.sharing_role = 'owner'`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "user_id", "user_login", "account_id", "sharing_role", "created_at"}).
+			AddRow(4, 2, "bob", 7, "viewer", time.Now()))
+
+	sharings, err := repo.GetSharingsByOwner(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, sharings, 1)
+	require.Equal(t, "bob", sharings[0].UserLogin)
+	require.Equal(t, finmodels.RoleViewer, sharings[0].Role)
+}
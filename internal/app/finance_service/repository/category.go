@@ -13,6 +13,7 @@ import (
 type CategoryDB struct {
 	ID           int
 	UserID       int
+	ParentID     *int
 	Name         string
 	Description  *string
 	LogoHashedID string
@@ -22,8 +23,8 @@ type CategoryDB struct {
 
 func (r *PostgresRepository) CreateCategory(ctx context.Context, category finmodels.Category) (finmodels.Category, error) {
 	query := `
-		INSERT INTO category (user_id, category_name, category_description, logo_hashed_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		INSERT INTO category (user_id, parent_id, category_name, category_description, logo_hashed_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
 		RETURNING _id, created_at, updated_at
 	`
 
@@ -34,6 +35,7 @@ func (r *PostgresRepository) CreateCategory(ctx context.Context, category finmod
 
 	err := r.db.QueryRowContext(ctx, query,
 		category.UserID,
+		category.ParentID,
 		category.Name,
 		description,
 		category.LogoHashedID,
@@ -48,7 +50,7 @@ func (r *PostgresRepository) CreateCategory(ctx context.Context, category finmod
 
 func (r *PostgresRepository) GetCategoriesByUser(ctx context.Context, userID int) ([]finmodels.Category, error) {
 	query := `
-		SELECT _id, user_id, category_name, category_description, logo_hashed_id, created_at, updated_at
+		SELECT _id, user_id, parent_id, category_name, category_description, logo_hashed_id, created_at, updated_at
 		FROM category
 		WHERE user_id = $1
 		ORDER BY created_at DESC
@@ -68,6 +70,7 @@ func (r *PostgresRepository) GetCategoriesByUser(ctx context.Context, userID int
 		err := rows.Scan(
 			&categoryDB.ID,
 			&categoryDB.UserID,
+			&categoryDB.ParentID,
 			&categoryDB.Name,
 			&categoryDB.Description,
 			&categoryDB.LogoHashedID,
@@ -85,7 +88,7 @@ func (r *PostgresRepository) GetCategoriesByUser(ctx context.Context, userID int
 
 func (r *PostgresRepository) GetCategoriesWithStatsByUser(ctx context.Context, userID int) ([]finmodels.CategoryWithStats, error) {
 	query := `
-		SELECT c._id, c.user_id, c.category_name, c.category_description, c.logo_hashed_id, 
+		SELECT c._id, c.user_id, c.parent_id, c.category_name, c.category_description, c.logo_hashed_id, 
 		       c.created_at, c.updated_at,
 		       COALESCE(COUNT(op._id), 0) as operations_count
 		FROM category c
@@ -94,7 +97,7 @@ func (r *PostgresRepository) GetCategoriesWithStatsByUser(ctx context.Context, u
 			AND (op.account_from_id IN (SELECT account_id FROM sharings WHERE user_id = $1)
 			     OR op.account_to_id IN (SELECT account_id FROM sharings WHERE user_id = $1))
 		WHERE c.user_id = $1
-		GROUP BY c._id, c.user_id, c.category_name, c.category_description, c.logo_hashed_id, 
+		GROUP BY c._id, c.user_id, c.parent_id, c.category_name, c.category_description, c.logo_hashed_id, 
 		         c.created_at, c.updated_at
 		ORDER BY c.created_at DESC
 	`
@@ -114,6 +117,7 @@ func (r *PostgresRepository) GetCategoriesWithStatsByUser(ctx context.Context, u
 		err := rows.Scan(
 			&categoryDB.ID,
 			&categoryDB.UserID,
+			&categoryDB.ParentID,
 			&categoryDB.Name,
 			&categoryDB.Description,
 			&categoryDB.LogoHashedID,
@@ -135,7 +139,7 @@ func (r *PostgresRepository) GetCategoriesWithStatsByUser(ctx context.Context, u
 
 func (r *PostgresRepository) GetCategoryByID(ctx context.Context, userID, categoryID int) (finmodels.Category, error) {
 	query := `
-		SELECT _id, user_id, category_name, category_description, logo_hashed_id, created_at, updated_at
+		SELECT _id, user_id, parent_id, category_name, category_description, logo_hashed_id, created_at, updated_at
 		FROM category
 		WHERE _id = $1 AND user_id = $2
 	`
@@ -144,6 +148,7 @@ func (r *PostgresRepository) GetCategoryByID(ctx context.Context, userID, catego
 	err := r.db.QueryRowContext(ctx, query, categoryID, userID).Scan(
 		&categoryDB.ID,
 		&categoryDB.UserID,
+		&categoryDB.ParentID,
 		&categoryDB.Name,
 		&categoryDB.Description,
 		&categoryDB.LogoHashedID,
@@ -160,7 +165,7 @@ func (r *PostgresRepository) GetCategoryByID(ctx context.Context, userID, catego
 
 func (r *PostgresRepository) GetCategoryByName(ctx context.Context, userID int, categoryName string) (finmodels.Category, error) {
 	query := `
-		SELECT _id, user_id, category_name, category_description, logo_hashed_id, created_at, updated_at
+		SELECT _id, user_id, parent_id, category_name, category_description, logo_hashed_id, created_at, updated_at
 		FROM category
 		WHERE user_id = $1 AND category_name = $2
 	`
@@ -169,6 +174,7 @@ func (r *PostgresRepository) GetCategoryByName(ctx context.Context, userID int,
 	err := r.db.QueryRowContext(ctx, query, userID, categoryName).Scan(
 		&categoryDB.ID,
 		&categoryDB.UserID,
+		&categoryDB.ParentID,
 		&categoryDB.Name,
 		&categoryDB.Description,
 		&categoryDB.LogoHashedID,
@@ -188,7 +194,8 @@ func (r *PostgresRepository) UpdateCategory(ctx context.Context, category finmod
 	log.Printf("hash %s", category.LogoHashedID)
 	query := `
 		UPDATE category 
-		SET category_name = COALESCE($1,category_name), category_description = COALESCE($2,category_description), logo_hashed_id = COALESCE($3,logo_hashed_id), updated_at = NOW()
+		SET category_name = COALESCE($1,category_name), category_description = COALESCE($2,category_description), logo_hashed_id = COALESCE($3,logo_hashed_id),
+		    parent_id = CASE WHEN $6::int IS NULL THEN parent_id ELSE NULLIF($6::int, 0) END, updated_at = NOW()
 		WHERE _id = $4 AND user_id = $5
 	`
 
@@ -212,6 +219,7 @@ func (r *PostgresRepository) UpdateCategory(ctx context.Context, category finmod
 		logoHash,
 		category.ID,
 		category.UserID,
+		category.ParentID,
 	)
 
 	if err != nil {
@@ -277,6 +285,7 @@ func (r *PostgresRepository) GetCategoriesReport(
 	query := `
         SELECT
             c._id AS category_id,
+            COALESCE(c.parent_id, 0) AS parent_id,
             c.category_name,
             COUNT(op._id) AS operations_count,
             COALESCE(SUM(op.sum), 0) AS total_sum
@@ -292,7 +301,7 @@ func (r *PostgresRepository) GetCategoriesReport(
             ON sh.account_id = acc._id
         WHERE c.user_id = $1
             AND (sh.user_id = $1 OR sh.user_id IS NULL)
-        GROUP BY c._id, c.category_name
+        GROUP BY c._id, c.parent_id, c.category_name
         ORDER BY c.category_name;
     `
 
@@ -309,6 +318,7 @@ func (r *PostgresRepository) GetCategoriesReport(
 
 		err := rows.Scan(
 			&rep.CategoryID,
+			&rep.ParentID,
 			&rep.CategoryName,
 			&rep.OperationCount,
 			&rep.TotalSum,
@@ -336,6 +346,7 @@ func categoryDBToModel(categoryDB CategoryDB) finmodels.Category {
 	return finmodels.Category{
 		ID:           categoryDB.ID,
 		UserID:       categoryDB.UserID,
+		ParentID:     categoryDB.ParentID,
 		Name:         categoryDB.Name,
 		Description:  description,
 		LogoHashedID: categoryDB.LogoHashedID,
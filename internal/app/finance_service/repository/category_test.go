@@ -33,11 +33,11 @@ func TestCreateCategory(t *testing.T) {
 	}
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		INSERT INTO category (user_id, category_name, category_description, logo_hashed_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		INSERT INTO category (user_id, parent_id, category_name, category_description, logo_hashed_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
 		RETURNING _id, created_at, updated_at
 	`)).
-		WithArgs(category.UserID, category.Name, &category.Description, category.LogoHashedID).
+		WithArgs(category.UserID, nil, category.Name, &category.Description, category.LogoHashedID).
 		WillReturnRows(sqlmock.NewRows([]string{"_id", "created_at", "updated_at"}).
 			AddRow(10, time.Now(), time.Now()))
 
@@ -51,16 +51,16 @@ func TestGetCategoriesByUser(t *testing.T) {
 	defer close()
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT _id, user_id, category_name, category_description, logo_hashed_id, created_at, updated_at
+		SELECT _id, user_id, parent_id, category_name, category_description, logo_hashed_id, created_at, updated_at
 		FROM category
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 	`)).
 		WithArgs(1).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"_id", "user_id", "category_name", "category_description",
+			"_id", "user_id", "parent_id", "category_name", "category_description",
 			"logo_hashed_id", "created_at", "updated_at",
-		}).AddRow(10, 1, "Food", "desc", "logo123", time.Now(), time.Now()))
+		}).AddRow(10, 1, nil, "Food", "desc", "logo123", time.Now(), time.Now()))
 
 	cats, err := repo.GetCategoriesByUser(context.Background(), 1)
 	require.NoError(t, err)
@@ -73,7 +73,7 @@ func TestGetCategoriesWithStatsByUser(t *testing.T) {
 	defer close()
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT c._id, c.user_id, c.category_name, c.category_description, c.logo_hashed_id, 
+		SELECT c._id, c.user_id, c.parent_id, c.category_name, c.category_description, c.logo_hashed_id, 
 		       c.created_at, c.updated_at,
 		       COALESCE(COUNT(op._id), 0) as operations_count
 		FROM category c
@@ -82,20 +82,22 @@ func TestGetCategoriesWithStatsByUser(t *testing.T) {
 			AND (op.account_from_id IN (SELECT account_id FROM sharings WHERE user_id = $1)
 			     OR op.account_to_id IN (SELECT account_id FROM sharings WHERE user_id = $1))
 		WHERE c.user_id = $1
-		GROUP BY c._id, c.user_id, c.category_name, c.category_description, c.logo_hashed_id, 
+		GROUP BY c._id, c.user_id, c.parent_id, c.category_name, c.category_description, c.logo_hashed_id, 
 		         c.created_at, c.updated_at
 		ORDER BY c.created_at DESC
 	`)).
 		WithArgs(1).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"_id", "user_id", "category_name", "category_description",
+			"_id", "user_id", "parent_id", "category_name", "category_description",
 			"logo_hashed_id", "created_at", "updated_at", "operations_count",
-		}).AddRow(10, 1, "Food", "desc", "logo123", time.Now(), time.Now(), 3))
+		}).AddRow(10, 1, 4, "Food", "desc", "logo123", time.Now(), time.Now(), 3))
 
 	out, err := repo.GetCategoriesWithStatsByUser(context.Background(), 1)
 	require.NoError(t, err)
 	require.Len(t, out, 1)
 	require.Equal(t, 3, out[0].OperationsCount)
+	require.NotNil(t, out[0].ParentID)
+	require.Equal(t, 4, *out[0].ParentID)
 }
 
 func TestGetCategoryByID(t *testing.T) {
@@ -103,15 +105,15 @@ func TestGetCategoryByID(t *testing.T) {
 	defer close()
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT _id, user_id, category_name, category_description, logo_hashed_id, created_at, updated_at
+		SELECT _id, user_id, parent_id, category_name, category_description, logo_hashed_id, created_at, updated_at
 		FROM category
 		WHERE _id = $1 AND user_id = $2
 	`)).
 		WithArgs(10, 1).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"_id", "user_id", "category_name", "category_description",
+			"_id", "user_id", "parent_id", "category_name", "category_description",
 			"logo_hashed_id", "created_at", "updated_at",
-		}).AddRow(10, 1, "Food", "desc", "logo123", time.Now(), time.Now()))
+		}).AddRow(10, 1, nil, "Food", "desc", "logo123", time.Now(), time.Now()))
 
 	cat, err := repo.GetCategoryByID(context.Background(), 1, 10)
 	require.NoError(t, err)
@@ -126,11 +128,11 @@ func TestUpdateCategory_Success(t *testing.T) {
 		UPDATE category 
 		SET category_name = COALESCE($1,category_name), 
 		    category_description = COALESCE($2,category_description), 
-		    logo_hashed_id = COALESCE($3,logo_hashed_id), 
-		    updated_at = NOW()
+		    logo_hashed_id = COALESCE($3,logo_hashed_id),
+		    parent_id = CASE WHEN $6::int IS NULL THEN parent_id ELSE NULLIF($6::int, 0) END, updated_at = NOW()
 		WHERE _id = $4 AND user_id = $5
 	`)).
-		WithArgs("Food", "desc", "logo123", 10, 1).
+		WithArgs("Food", "desc", "logo123", 10, 1, nil).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	err := repo.UpdateCategory(context.Background(), finmodels.Category{
@@ -151,11 +153,11 @@ func TestUpdateCategory_NotFound(t *testing.T) {
 		UPDATE category 
 		SET category_name = COALESCE($1,category_name), 
 		    category_description = COALESCE($2,category_description), 
-		    logo_hashed_id = COALESCE($3,logo_hashed_id), 
-		    updated_at = NOW()
+		    logo_hashed_id = COALESCE($3,logo_hashed_id),
+		    parent_id = CASE WHEN $6::int IS NULL THEN parent_id ELSE NULLIF($6::int, 0) END, updated_at = NOW()
 		WHERE _id = $4 AND user_id = $5
 	`)).
-		WithArgs("Food", "desc", "logo123", 10, 1).
+		WithArgs("Food", "desc", "logo123", 10, 1, nil).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
 	err := repo.UpdateCategory(context.Background(), finmodels.Category{
@@ -208,3 +210,22 @@ func TestGetCategoryStats(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 7, count)
 }
+
+func TestGetCategoriesReport(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	start, end := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`FROM category AS c`).
+		WithArgs(1, start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"category_id", "parent_id", "category_name", "operations_count", "total_sum"}).
+			AddRow(1, 0, "Транспорт", 1, 100.0).
+			AddRow(2, 1, "Такси", 2, 900.0))
+
+	report, err := repo.GetCategoriesReport(context.Background(), 1, start, end)
+	require.NoError(t, err)
+	require.Len(t, report, 2)
+	require.Equal(t, 1, report[1].ParentID)
+	require.Equal(t, 900.0, report[1].TotalSum)
+}
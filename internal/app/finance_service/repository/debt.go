@@ -0,0 +1,372 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+// Возвращенная сумма считается только по неотмененным операциям
+const debtColumns = `
+	d._id, d.user_id, d.receiver_id, r.receiver_name, COALESCE(r.counterparty_user_id, 0),
+	d.direction, COALESCE(d.account_id, 0), d.currency_id, d.principal,
+	COALESCE((
+		SELECT SUM(p.amount)
+		FROM debt_payment p
+		JOIN operation o ON o._id = p.operation_id
+		WHERE p.debt_id = d._id AND p.payment_kind = 'repayment' AND o.operation_status != 'reverted'
+	), 0),
+	d.debt_description, d.due_date, d.created_at, d.updated_at
+`
+
+const receiverColumns = `_id, user_id, receiver_name, logo_hashed_id, COALESCE(counterparty_user_id, 0), created_at`
+
+func MapPgDebtError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return serviceerrors.ErrDebtNotFound
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case ForeignKeyViolation, NotNullViolation, CheckViolation, UniqueViolation:
+		return serviceerrors.ErrInvalidData
+	default:
+		return err
+	}
+}
+
+func MapPgReceiverError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return serviceerrors.ErrReceiverNotFound
+	}
+	return MapPgDebtError(err)
+}
+
+func scanDebt(row rowScanner) (finmodels.Debt, error) {
+	var debt finmodels.Debt
+	var direction string
+	var dueDate sql.NullTime
+	err := row.Scan(
+		&debt.ID,
+		&debt.UserID,
+		&debt.ReceiverID,
+		&debt.ReceiverName,
+		&debt.CounterpartyUserID,
+		&direction,
+		&debt.AccountID,
+		&debt.CurrencyID,
+		&debt.Principal,
+		&debt.Repaid,
+		&debt.Description,
+		&dueDate,
+		&debt.CreatedAt,
+		&debt.UpdatedAt,
+	)
+	debt.Direction = finmodels.DebtDirection(direction)
+	if dueDate.Valid {
+		debt.DueDate = &dueDate.Time
+	}
+	return debt, err
+}
+
+func scanReceiver(row rowScanner) (finmodels.Receiver, error) {
+	var rcv finmodels.Receiver
+	err := row.Scan(&rcv.ID, &rcv.UserID, &rcv.Name, &rcv.LogoHashedID, &rcv.CounterpartyUserID, &rcv.CreatedAt)
+	return rcv, err
+}
+
+func (r *PostgresRepository) GetUserIDByLogin(ctx context.Context, login string) (int, error) {
+	var userID int
+	err := r.db.QueryRowContext(ctx, `SELECT _id FROM "user" WHERE user_login = $1`, login).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, serviceerrors.ErrUserNotFound
+	}
+	return userID, err
+}
+
+// GetCounterparties возвращает всех получателей пользователя, включая тех, по кому нет долгов.
+func (r *PostgresRepository) GetCounterparties(ctx context.Context, userID int) ([]finmodels.Receiver, error) {
+	query := `SELECT ` + receiverColumns + `
+		FROM receiver
+		WHERE user_id = $1
+		ORDER BY receiver_name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, MapPgReceiverError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var receivers []finmodels.Receiver
+	for rows.Next() {
+		rcv, err := scanReceiver(rows)
+		if err != nil {
+			return nil, MapPgReceiverError(err)
+		}
+		receivers = append(receivers, rcv)
+	}
+
+	return receivers, rows.Err()
+}
+
+func (r *PostgresRepository) GetReceiver(ctx context.Context, userID, receiverID int) (finmodels.Receiver, error) {
+	query := `SELECT ` + receiverColumns + `
+		FROM receiver
+		WHERE _id = $2 AND user_id = $1
+	`
+
+	rcv, err := scanReceiver(r.db.QueryRowContext(ctx, query, userID, receiverID))
+	if err != nil {
+		return finmodels.Receiver{}, MapPgReceiverError(err)
+	}
+	return rcv, nil
+}
+
+// UpsertReceiver находит получателя по имени или создает нового.
+// Связь с пользователем у существующего получателя перезаписывается только непустой.
+func (r *PostgresRepository) UpsertReceiver(ctx context.Context, rcv finmodels.Receiver) (finmodels.Receiver, error) {
+	query := `
+		INSERT INTO receiver (user_id, receiver_name, counterparty_user_id, created_at, updated_at)
+		VALUES ($1, $2, $3, NOW(), NOW())
+		ON CONFLICT (user_id, receiver_name) DO UPDATE
+		SET counterparty_user_id = COALESCE(EXCLUDED.counterparty_user_id, receiver.counterparty_user_id)
+		RETURNING ` + receiverColumns
+
+	var counterpartyUserID any
+	if rcv.CounterpartyUserID > 0 {
+		counterpartyUserID = rcv.CounterpartyUserID
+	}
+
+	saved, err := scanReceiver(r.db.QueryRowContext(ctx, query, rcv.UserID, rcv.Name, counterpartyUserID))
+	if err != nil {
+		return finmodels.Receiver{}, MapPgReceiverError(err)
+	}
+	return saved, nil
+}
+
+func (r *PostgresRepository) GetDebtsByUser(ctx context.Context, userID int) ([]finmodels.Debt, error) {
+	query := `SELECT ` + debtColumns + `
+		FROM debt d
+		JOIN receiver r ON r._id = d.receiver_id
+		WHERE d.user_id = $1
+		ORDER BY d.created_at DESC, d._id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, MapPgDebtError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var debts []finmodels.Debt
+	for rows.Next() {
+		debt, err := scanDebt(rows)
+		if err != nil {
+			return nil, MapPgDebtError(err)
+		}
+		debts = append(debts, debt)
+	}
+
+	return debts, rows.Err()
+}
+
+func (r *PostgresRepository) GetDebt(ctx context.Context, userID, debtID int) (finmodels.Debt, error) {
+	query := `SELECT ` + debtColumns + `
+		FROM debt d
+		JOIN receiver r ON r._id = d.receiver_id
+		WHERE d._id = $2 AND d.user_id = $1
+	`
+
+	debt, err := scanDebt(r.db.QueryRowContext(ctx, query, userID, debtID))
+	if err != nil {
+		return finmodels.Debt{}, MapPgDebtError(err)
+	}
+	return debt, nil
+}
+
+// CreateDebt сохраняет долг вместе со связью с уже созданной операцией выдачи.
+func (r *PostgresRepository) CreateDebt(ctx context.Context, debt finmodels.Debt, operationID int) (finmodels.Debt, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return finmodels.Debt{}, err
+	}
+	defer tx.Rollback()
+
+	var dueDate any
+	if debt.DueDate != nil {
+		dueDate = *debt.DueDate
+	}
+
+	var id int
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO debt (user_id, receiver_id, direction, account_id, currency_id, principal, debt_description, due_date, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		RETURNING _id
+	`,
+		debt.UserID,
+		debt.ReceiverID,
+		string(debt.Direction),
+		debt.AccountID,
+		debt.CurrencyID,
+		debt.Principal,
+		debt.Description,
+		dueDate,
+	).Scan(&id)
+	if err != nil {
+		return finmodels.Debt{}, MapPgDebtError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO debt_payment (debt_id, operation_id, payment_kind, amount, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+	`, id, operationID, string(finmodels.DebtPaymentPrincipal), debt.Principal)
+	if err != nil {
+		return finmodels.Debt{}, MapPgDebtError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return finmodels.Debt{}, MapPgDebtError(err)
+	}
+
+	return r.GetDebt(ctx, debt.UserID, id)
+}
+
+// DeleteDebt удаляет долг и связи с операциями, сами операции остаются в истории счетов.
+func (r *PostgresRepository) DeleteDebt(ctx context.Context, userID, debtID int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM debt WHERE _id = $2 AND user_id = $1`, userID, debtID)
+	if err != nil {
+		return MapPgDebtError(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return serviceerrors.ErrDebtNotFound
+	}
+	return nil
+}
+
+// AddDebtPayment связывает операцию с долгом. Строка долга блокируется до конца транзакции,
+// поэтому параллельные возвраты проверяют остаток по очереди и не могут переплатить долг.
+func (r *PostgresRepository) AddDebtPayment(ctx context.Context, payment finmodels.DebtPayment) (finmodels.DebtPayment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return finmodels.DebtPayment{}, err
+	}
+	defer tx.Rollback()
+
+	debt := finmodels.Debt{ID: payment.DebtID}
+	err = tx.QueryRowContext(ctx, `SELECT principal FROM debt WHERE _id = $1 FOR UPDATE`, payment.DebtID).Scan(&debt.Principal)
+	if err != nil {
+		return finmodels.DebtPayment{}, MapPgDebtError(err)
+	}
+
+	if payment.Kind == finmodels.DebtPaymentRepayment {
+		err = tx.QueryRowContext(ctx, `
+			SELECT COALESCE(SUM(p.amount), 0)
+			FROM debt_payment p
+			JOIN operation o ON o._id = p.operation_id
+			WHERE p.debt_id = $1 AND p.payment_kind = 'repayment' AND o.operation_status != 'reverted'
+		`, payment.DebtID).Scan(&debt.Repaid)
+		if err != nil {
+			return finmodels.DebtPayment{}, MapPgDebtError(err)
+		}
+		if payment.Amount > debt.Outstanding() {
+			return finmodels.DebtPayment{}, serviceerrors.ErrInvalidData
+		}
+	}
+
+	query := `
+		WITH p AS (
+			INSERT INTO debt_payment (debt_id, operation_id, payment_kind, amount, created_at)
+			VALUES ($1, $2, $3, $4, NOW())
+			RETURNING _id, debt_id, operation_id, payment_kind, amount, created_at
+		)
+		SELECT p._id, p.debt_id, p.operation_id, p.payment_kind, p.amount,
+		       COALESCE(o.account_from_id, 0), o.operation_status = 'reverted', o.operation_date, p.created_at
+		FROM p
+		JOIN operation o ON o._id = p.operation_id
+	`
+
+	saved, err := scanDebtPayment(tx.QueryRowContext(ctx, query,
+		payment.DebtID,
+		payment.OperationID,
+		string(payment.Kind),
+		payment.Amount,
+	))
+	if err != nil {
+		return finmodels.DebtPayment{}, MapPgDebtError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return finmodels.DebtPayment{}, MapPgDebtError(err)
+	}
+	return saved, nil
+}
+
+func (r *PostgresRepository) GetDebtPayments(ctx context.Context, debtID int) ([]finmodels.DebtPayment, error) {
+	query := `
+		SELECT p._id, p.debt_id, p.operation_id, p.payment_kind, p.amount,
+		       COALESCE(o.account_from_id, 0), o.operation_status = 'reverted', o.operation_date, p.created_at
+		FROM debt_payment p
+		JOIN operation o ON o._id = p.operation_id
+		WHERE p.debt_id = $1
+		ORDER BY o.operation_date, p._id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, debtID)
+	if err != nil {
+		return nil, MapPgDebtError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var payments []finmodels.DebtPayment
+	for rows.Next() {
+		payment, err := scanDebtPayment(rows)
+		if err != nil {
+			return nil, MapPgDebtError(err)
+		}
+		payments = append(payments, payment)
+	}
+
+	return payments, rows.Err()
+}
+
+func scanDebtPayment(row rowScanner) (finmodels.DebtPayment, error) {
+	var payment finmodels.DebtPayment
+	var kind string
+	err := row.Scan(
+		&payment.ID,
+		&payment.DebtID,
+		&payment.OperationID,
+		&kind,
+		&payment.Amount,
+		&payment.AccountID,
+		&payment.Reverted,
+		&payment.Date,
+		&payment.CreatedAt,
+	)
+	payment.Kind = finmodels.DebtPaymentKind(kind)
+	return payment, err
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+var debtRowColumns = []string{
+	"_id", "user_id", "receiver_id", "receiver_name", "counterparty_user_id", "direction",
+	"account_id", "currency_id", "principal", "repaid", "debt_description", "due_date", "created_at", "updated_at",
+}
+
+func debtRows(dueDate any) *sqlmock.Rows {
+	now := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	return sqlmock.NewRows(debtRowColumns).
+		AddRow(5, 1, 3, "Иван", 0, "lent", 7, 1, 10000.0, 2500.0, "", dueDate, now, now)
+}
+
+func TestGetDebt(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	due := time.Date(2030, 7, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`FROM debt d`).WithArgs(1, 5).WillReturnRows(debtRows(due))
+
+	debt, err := repo.GetDebt(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, finmodels.DebtLent, debt.Direction)
+	require.Equal(t, 7500.0, debt.Outstanding())
+	require.Equal(t, due, *debt.DueDate)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDebt_NotFound(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`FROM debt d`).WithArgs(1, 5).WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetDebt(context.Background(), 1, 5)
+	require.ErrorIs(t, err, serviceerrors.ErrDebtNotFound)
+}
+
+func TestGetDebtsByUser(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`FROM debt d`).WithArgs(1).WillReturnRows(debtRows(nil))
+
+	debts, err := repo.GetDebtsByUser(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, debts, 1)
+	require.Nil(t, debts[0].DueDate)
+}
+
+func TestCreateDebt(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	debt := finmodels.Debt{UserID: 1, ReceiverID: 3, Direction: finmodels.DebtLent, AccountID: 7, CurrencyID: 1, Principal: 10000}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO debt`).
+		WithArgs(1, 3, "lent", 7, 1, 10000.0, "", nil).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(5))
+	mock.ExpectExec(`INSERT INTO debt_payment`).
+		WithArgs(5, 40, "principal", 10000.0).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`FROM debt d`).WithArgs(1, 5).WillReturnRows(debtRows(nil))
+
+	created, err := repo.CreateDebt(context.Background(), debt, 40)
+	require.NoError(t, err)
+	require.Equal(t, 5, created.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateDebt_InvalidReceiver(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO debt`).WillReturnError(&pq.Error{Code: ForeignKeyViolation})
+	mock.ExpectRollback()
+
+	_, err := repo.CreateDebt(context.Background(), finmodels.Debt{UserID: 1, ReceiverID: 99}, 40)
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+}
+
+func TestDeleteDebt(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectExec(`DELETE FROM debt`).WithArgs(1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.DeleteDebt(context.Background(), 1, 5))
+
+	mock.ExpectExec(`DELETE FROM debt`).WithArgs(1, 6).WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.DeleteDebt(context.Background(), 1, 6), serviceerrors.ErrDebtNotFound)
+}
+
+func TestUpsertReceiver(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	now := time.Now()
+	mock.ExpectQuery(`ON CONFLICT \(user_id, receiver_name\)`).
+		WithArgs(1, "petr", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "user_id", "receiver_name", "logo_hashed_id", "counterparty_user_id", "created_at"}).
+			AddRow(8, 1, "petr", "logo", 2, now))
+
+	rcv, err := repo.UpsertReceiver(context.Background(), finmodels.Receiver{UserID: 1, Name: "petr", CounterpartyUserID: 2})
+	require.NoError(t, err)
+	require.Equal(t, 8, rcv.ID)
+	require.Equal(t, 2, rcv.CounterpartyUserID)
+
+	mock.ExpectQuery(`ON CONFLICT \(user_id, receiver_name\)`).
+		WithArgs(1, "Иван", nil).
+		WillReturnError(&pq.Error{Code: CheckViolation})
+	_, err = repo.UpsertReceiver(context.Background(), finmodels.Receiver{UserID: 1, Name: "Иван"})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+}
+
+func TestGetReceiver_NotFound(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`FROM receiver`).WithArgs(1, 3).WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetReceiver(context.Background(), 1, 3)
+	require.ErrorIs(t, err, serviceerrors.ErrReceiverNotFound)
+}
+
+func TestGetUserIDByLogin(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`SELECT _id FROM "user"`).WithArgs("petr").WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(2))
+	id, err := repo.GetUserIDByLogin(context.Background(), "petr")
+	require.NoError(t, err)
+	require.Equal(t, 2, id)
+
+	mock.ExpectQuery(`SELECT _id FROM "user"`).WithArgs("nobody").WillReturnError(sql.ErrNoRows)
+	_, err = repo.GetUserIDByLogin(context.Background(), "nobody")
+	require.ErrorIs(t, err, serviceerrors.ErrUserNotFound)
+}
+
+func TestGetDebtPayments(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	date := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`FROM debt_payment p`).WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "debt_id", "operation_id", "payment_kind", "amount", "account_id", "reverted", "operation_date", "created_at"}).
+			AddRow(1, 5, 40, "principal", 10000.0, 7, false, date, date).
+			AddRow(2, 5, 42, "repayment", 2500.0, 7, true, date, date))
+
+	payments, err := repo.GetDebtPayments(context.Background(), 5)
+	require.NoError(t, err)
+	require.Len(t, payments, 2)
+	require.Equal(t, finmodels.DebtPaymentPrincipal, payments[0].Kind)
+	require.True(t, payments[1].Reverted)
+}
+
+func TestAddDebtPayment(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	now := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT principal FROM debt WHERE _id = \$1 FOR UPDATE`).WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"principal"}).AddRow(10000.0))
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(p.amount\), 0\)`).WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(2500.0))
+	mock.ExpectQuery(`INSERT INTO debt_payment`).WithArgs(5, 42, "repayment", 7500.0).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "debt_id", "operation_id", "payment_kind", "amount", "account_id", "reverted", "operation_date", "created_at"}).
+			AddRow(9, 5, 42, "repayment", 7500.0, 7, false, now, now))
+	mock.ExpectCommit()
+
+	payment, err := repo.AddDebtPayment(context.Background(), finmodels.DebtPayment{DebtID: 5, OperationID: 42, Kind: finmodels.DebtPaymentRepayment, Amount: 7500})
+	require.NoError(t, err)
+	require.Equal(t, 9, payment.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddDebtPayment_Overpayment(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FOR UPDATE`).WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"principal"}).AddRow(10000.0))
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(p.amount\), 0\)`).WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(5000.0))
+	mock.ExpectRollback()
+
+	_, err := repo.AddDebtPayment(context.Background(), finmodels.DebtPayment{DebtID: 5, OperationID: 42, Kind: finmodels.DebtPaymentRepayment, Amount: 7500})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
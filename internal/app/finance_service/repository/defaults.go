@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+// ProvisionCategories создает категории, которых у пользователя еще нет (сравнение по имени).
+// С overwrite у существующих категорий восстанавливаются описание и иконка.
+// Возвращает только созданные или измененные категории.
+func (r *PostgresRepository) ProvisionCategories(ctx context.Context, userID int, categories []finmodels.Category, overwrite bool) ([]finmodels.Category, error) {
+	onConflict := `DO NOTHING`
+	if overwrite {
+		onConflict = `DO UPDATE
+			SET category_description = EXCLUDED.category_description,
+			    logo_hashed_id = EXCLUDED.logo_hashed_id,
+			    updated_at = NOW()
+			WHERE category.category_description IS DISTINCT FROM EXCLUDED.category_description
+			   OR category.logo_hashed_id IS DISTINCT FROM EXCLUDED.logo_hashed_id`
+	}
+	query := `
+		INSERT INTO category (user_id, category_name, category_description, logo_hashed_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		ON CONFLICT (user_id, category_name) ` + onConflict + `
+		RETURNING _id, user_id, parent_id, category_name, category_description, logo_hashed_id, created_at, updated_at
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	provisioned := make([]finmodels.Category, 0, len(categories))
+	for _, ctg := range categories {
+		var description *string
+		if ctg.Description != "" {
+			description = &ctg.Description
+		}
+
+		var categoryDB CategoryDB
+		err := tx.QueryRowContext(ctx, query, userID, ctg.Name, description, ctg.LogoHashedID).Scan(
+			&categoryDB.ID,
+			&categoryDB.UserID,
+			&categoryDB.ParentID,
+			&categoryDB.Name,
+			&categoryDB.Description,
+			&categoryDB.LogoHashedID,
+			&categoryDB.CreatedAt,
+			&categoryDB.UpdatedAt,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			// категория уже есть и менять ее не нужно
+			continue
+		}
+		if err != nil {
+			return nil, MapPgCategoryError(err)
+		}
+		provisioned = append(provisioned, categoryDBToModel(categoryDB))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return provisioned, nil
+}
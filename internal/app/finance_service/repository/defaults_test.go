@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	"github.com/stretchr/testify/require"
+)
+
+var provisionColumns = []string{"_id", "user_id", "parent_id", "category_name", "category_description", "logo_hashed_id", "created_at", "updated_at"}
+
+func TestProvisionCategories_SkipsExisting(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`ON CONFLICT \(user_id, category_name\) DO NOTHING`).
+		WithArgs(1, "Продукты", "Супермаркеты", "abc").
+		WillReturnRows(sqlmock.NewRows(provisionColumns).AddRow(10, 1, nil, "Продукты", "Супермаркеты", "abc", now, now))
+	mock.ExpectQuery(`ON CONFLICT \(user_id, category_name\) DO NOTHING`).
+		WithArgs(1, "Транспорт", nil, "def").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectCommit()
+
+	created, err := repo.ProvisionCategories(context.Background(), 1, []finmodels.Category{
+		{Name: "Продукты", Description: "Супермаркеты", LogoHashedID: "abc"},
+		{Name: "Транспорт", LogoHashedID: "def"},
+	}, false)
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	require.Equal(t, 10, created[0].ID)
+	require.Equal(t, "Супермаркеты", created[0].Description)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProvisionCategories_Overwrite(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DO UPDATE SET category_description = EXCLUDED.category_description`).
+		WithArgs(1, "Продукты", nil, "abc").
+		WillReturnRows(sqlmock.NewRows(provisionColumns).AddRow(3, 1, 2, "Продукты", nil, "abc", now, now))
+	mock.ExpectCommit()
+
+	restored, err := repo.ProvisionCategories(context.Background(), 1, []finmodels.Category{
+		{Name: "Продукты", LogoHashedID: "abc"},
+	}, true)
+	require.NoError(t, err)
+	require.Len(t, restored, 1)
+	require.Equal(t, 2, *restored[0].ParentID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProvisionCategories_Error(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO category`).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err := repo.ProvisionCategories(context.Background(), 1, []finmodels.Category{{Name: "Продукты"}}, false)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
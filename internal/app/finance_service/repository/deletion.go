@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+// soleAccountsQuery счета, в которых пользователь единственный участник
+const soleAccountsQuery = `
+	SELECT account_id
+	FROM sharings
+	WHERE account_id IN (SELECT account_id FROM sharings WHERE user_id = $1)
+	GROUP BY account_id
+	HAVING COUNT(*) = 1
+	ORDER BY account_id
+`
+
+func scanIDs(rows *sql.Rows) ([]int, error) {
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *PostgresRepository) GetSoleAccountIDs(ctx context.Context, userID int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, soleAccountsQuery, userID)
+	if err != nil {
+		return nil, MapPgAccountError(err)
+	}
+	return scanIDs(rows)
+}
+
+// DeleteUserData удаляет данные пользователя перед удалением аккаунта.
+// Счета, где он единственный участник, удаляются вместе с операциями;
+// операции переводов на чужие счета остаются у получателя. Из совместных
+// счетов пользователь выходит, а если владел ими, владельцем становится
+// редактор, добавленный первым, или, если редакторов нет, первый участник.
+func (r *PostgresRepository) DeleteUserData(ctx context.Context, userID int) (finmodels.UserDataDeletion, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return finmodels.UserDataDeletion{}, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, soleAccountsQuery, userID)
+	if err != nil {
+		return finmodels.UserDataDeletion{}, MapPgAccountError(err)
+	}
+	sole, err := scanIDs(rows)
+	if err != nil {
+		return finmodels.UserDataDeletion{}, err
+	}
+
+	if len(sole) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM operation
+			WHERE (account_from_id = ANY($1) OR account_to_id = ANY($1))
+			  AND (account_from_id IS NULL OR account_from_id = ANY($1))
+			  AND (account_to_id IS NULL OR account_to_id = ANY($1))
+		`, intArray(sole))
+		if err != nil {
+			return finmodels.UserDataDeletion{}, MapPgOperationError(err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM account WHERE _id = ANY($1)`, intArray(sole)); err != nil {
+			return finmodels.UserDataDeletion{}, MapPgAccountError(err)
+		}
+	}
+
+	rows, err = tx.QueryContext(ctx, `
+		DELETE FROM sharings
+		WHERE user_id = $1
+		RETURNING account_id, sharing_role = 'owner'
+	`, userID)
+	if err != nil {
+		return finmodels.UserDataDeletion{}, MapPgAccountError(err)
+	}
+	var left, owned []int
+	for rows.Next() {
+		var (
+			accountID int
+			owner     bool
+		)
+		if err := rows.Scan(&accountID, &owner); err != nil {
+			rows.Close()
+			return finmodels.UserDataDeletion{}, err
+		}
+		left = append(left, accountID)
+		if owner {
+			owned = append(owned, accountID)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return finmodels.UserDataDeletion{}, err
+	}
+
+	if len(owned) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE sharings SET sharing_role = 'owner', updated_at = NOW()
+			WHERE _id IN (
+				SELECT DISTINCT ON (account_id) _id
+				FROM sharings
+				WHERE account_id = ANY($1)
+				ORDER BY account_id, sharing_role = 'editor' DESC, created_at, _id
+			)
+		`, intArray(owned))
+		if err != nil {
+			return finmodels.UserDataDeletion{}, MapPgAccountError(err)
+		}
+	}
+
+	// у операций на совместных счетах категория сбрасывается явно, чтобы
+	// вернуть их для обновления поискового индекса
+	uncategorized, err := clearUserCategories(ctx, tx, userID)
+	if err != nil {
+		return finmodels.UserDataDeletion{}, err
+	}
+
+	// правила категорий и долги удаляются каскадно
+	if _, err := tx.ExecContext(ctx, `DELETE FROM category WHERE user_id = $1`, userID); err != nil {
+		return finmodels.UserDataDeletion{}, MapPgCategoryError(err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM receiver WHERE user_id = $1`, userID); err != nil {
+		return finmodels.UserDataDeletion{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return finmodels.UserDataDeletion{}, err
+	}
+	return finmodels.UserDataDeletion{
+		DeletedAccountIDs:       sole,
+		LeftAccountIDs:          left,
+		UncategorizedOperations: uncategorized,
+	}, nil
+}
+
+// clearUserCategories снимает категории пользователя с оставшихся операций
+// и возвращает эти операции.
+func clearUserCategories(ctx context.Context, tx *sql.Tx, userID int) ([]finmodels.Operation, error) {
+	rows, err := tx.QueryContext(ctx, `
+		WITH cleared AS (
+			UPDATE operation SET category_id = NULL
+			WHERE category_id IN (SELECT _id FROM category WHERE user_id = $1)
+			RETURNING _id, account_from_id, account_to_id, currency_id, operation_status, operation_type,
+			          operation_name, operation_description, sum, created_at, operation_date
+		)
+		SELECT o._id, COALESCE(o.account_from_id, o.account_to_id), COALESCE(o.account_to_id, 0), o.currency_id,
+		       o.operation_status, o.operation_type, o.operation_name, COALESCE(o.operation_description, ''),
+		       o.sum, o.created_at, o.operation_date, COALESCE(a.account_type, '')
+		FROM cleared o
+		LEFT JOIN account a ON a._id = COALESCE(o.account_from_id, o.account_to_id)
+		ORDER BY o._id
+	`, userID)
+	if err != nil {
+		return nil, MapPgOperationError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var operations []finmodels.Operation
+	for rows.Next() {
+		var op finmodels.Operation
+		var currencyID *int
+		err := rows.Scan(
+			&op.ID,
+			&op.AccountID,
+			&op.AccountToID,
+			&currencyID,
+			&op.Status,
+			&op.Type,
+			&op.Name,
+			&op.Description,
+			&op.Sum,
+			&op.CreatedAt,
+			&op.Date,
+			&op.AccountType,
+		)
+		if err != nil {
+			return nil, MapPgOperationError(err)
+		}
+		if currencyID != nil {
+			op.CurrencyID = *currencyID
+		}
+		op.CategoryName = "Без категории"
+		operations = append(operations, op)
+	}
+	return operations, rows.Err()
+}
+
+// FilterUsedImages изображения из ids, которые стоят логотипами категорий или получателей
+func (r *PostgresRepository) FilterUsedImages(ctx context.Context, ids []string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT logo_hashed_id FROM category WHERE logo_hashed_id = ANY($1)
+		UNION
+		SELECT logo_hashed_id FROM receiver WHERE logo_hashed_id = ANY($1)
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var used []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		used = append(used, id)
+	}
+	return used, rows.Err()
+}
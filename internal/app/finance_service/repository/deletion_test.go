@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSoleAccountIDs(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectQuery(`FROM sharings\s+WHERE account_id IN \(SELECT account_id FROM sharings WHERE user_id = \$1\)\s+GROUP BY account_id\s+HAVING COUNT\(\*\) = 1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}).AddRow(3).AddRow(4))
+
+	ids, err := repo.GetSoleAccountIDs(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 4}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteUserData(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`HAVING COUNT\(\*\) = 1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}).AddRow(3))
+	mock.ExpectExec(`DELETE FROM operation`).
+		WithArgs(intArray([]int{3})).
+		WillReturnResult(sqlmock.NewResult(0, 7))
+	mock.ExpectExec(`DELETE FROM account WHERE _id = ANY\(\$1\)`).
+		WithArgs(intArray([]int{3})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`DELETE FROM sharings\s+WHERE user_id = \$1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "owner"}).AddRow(5, true).AddRow(6, false))
+	// владение переходит только на счетах, которыми пользователь владел
+	mock.ExpectExec(`UPDATE sharings SET sharing_role = 'owner'`).
+		WithArgs(intArray([]int{5})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// на совместном счете остается операция с категорией пользователя
+	now := time.Now()
+	mock.ExpectQuery(`UPDATE operation SET category_id = NULL\s+WHERE category_id IN \(SELECT _id FROM category WHERE user_id = \$1\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"_id", "account_id", "account_to_id", "currency_id", "operation_status", "operation_type",
+			"operation_name", "operation_description", "sum", "created_at", "operation_date", "account_type",
+		}).AddRow(10, 6, 0, 1, "finished", "expense", "Кофе", "", 300.0, now, now, "regular"))
+	mock.ExpectExec(`DELETE FROM category WHERE user_id = \$1`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec(`DELETE FROM receiver WHERE user_id = \$1`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	res, err := repo.DeleteUserData(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, res.DeletedAccountIDs)
+	require.Equal(t, []int{5, 6}, res.LeftAccountIDs)
+	require.Len(t, res.UncategorizedOperations, 1)
+	require.Equal(t, 10, res.UncategorizedOperations[0].ID)
+	require.Equal(t, 6, res.UncategorizedOperations[0].AccountID)
+	require.Zero(t, res.UncategorizedOperations[0].CategoryID)
+	require.Equal(t, "Без категории", res.UncategorizedOperations[0].CategoryName)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteUserData_AlreadyDeleted(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	// повторный вызов после успешного удаления ничего не находит
+	mock.ExpectBegin()
+	mock.ExpectQuery(`HAVING COUNT\(\*\) = 1`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"account_id"}))
+	mock.ExpectQuery(`DELETE FROM sharings`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"account_id", "owner"}))
+	mock.ExpectQuery(`UPDATE operation SET category_id = NULL`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"_id"}))
+	mock.ExpectExec(`DELETE FROM category`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM receiver`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	res, err := repo.DeleteUserData(context.Background(), 1)
+	require.NoError(t, err)
+	require.Empty(t, res.DeletedAccountIDs)
+	require.Empty(t, res.LeftAccountIDs)
+	require.Empty(t, res.UncategorizedOperations)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFilterUsedImages(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectQuery(`SELECT logo_hashed_id FROM category WHERE logo_hashed_id = ANY\(\$1\)\s+UNION`).
+		WithArgs(pq.Array([]string{"aa", "bb"})).
+		WillReturnRows(sqlmock.NewRows([]string{"logo_hashed_id"}).AddRow("aa"))
+
+	used, err := repo.FilterUsedImages(context.Background(), []string{"aa", "bb"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"aa"}, used)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
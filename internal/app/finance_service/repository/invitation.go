@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+const invitationColumns = `
+	i._id, i.account_id, a.account_name, i.inviter_id, inviter.user_login,
+	COALESCE(i.invitee_id, 0), COALESCE(invitee.user_login, ''), i.sharing_role,
+	i.invitation_status, COALESCE(i.token_hash, ''), i.expires_at, i.created_at
+`
+
+const invitationJoins = `
+	JOIN account a ON a._id = i.account_id
+	JOIN "user" inviter ON inviter._id = i.inviter_id
+	LEFT JOIN "user" invitee ON invitee._id = i.invitee_id
+`
+
+func MapPgInvitationError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return serviceerrors.ErrInvitationNotFound
+	}
+	return MapPgAccountError(err)
+}
+
+func scanInvitation(row rowScanner) (finmodels.AccountInvitation, error) {
+	var inv finmodels.AccountInvitation
+	var role, status string
+	err := row.Scan(
+		&inv.ID,
+		&inv.AccountID,
+		&inv.AccountName,
+		&inv.InviterID,
+		&inv.InviterLogin,
+		&inv.InviteeID,
+		&inv.InviteeLogin,
+		&role,
+		&status,
+		&inv.TokenHash,
+		&inv.ExpiresAt,
+		&inv.CreatedAt,
+	)
+	inv.Role = finmodels.SharingRole(role)
+	inv.Status = finmodels.InvitationStatus(status)
+	return inv, err
+}
+
+func (r *PostgresRepository) queryInvitations(ctx context.Context, query string, args ...any) ([]finmodels.AccountInvitation, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, MapPgAccountError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var invitations []finmodels.AccountInvitation
+	for rows.Next() {
+		inv, err := scanInvitation(rows)
+		if err != nil {
+			return nil, MapPgAccountError(err)
+		}
+		invitations = append(invitations, inv)
+	}
+
+	return invitations, rows.Err()
+}
+
+// CreateAccountInvitation создает приглашение в совместный счет. Приглашать может только владелец.
+// Пользователя, который уже участвует в счете или уже приглашен, повторно пригласить нельзя.
+func (r *PostgresRepository) CreateAccountInvitation(ctx context.Context, inv finmodels.AccountInvitation) (finmodels.AccountInvitation, error) {
+	if err := requireAccountRole(ctx, r.db, inv.InviterID, inv.AccountID, finmodels.SharingRole.CanManage); err != nil {
+		return finmodels.AccountInvitation{}, err
+	}
+
+	var accountType string
+	err := r.db.QueryRowContext(ctx, `SELECT account_type FROM account WHERE _id = $1`, inv.AccountID).Scan(&accountType)
+	if err != nil {
+		return finmodels.AccountInvitation{}, MapPgAccountError(err)
+	}
+	if accountType == models.PrivateAccount {
+		return finmodels.AccountInvitation{}, serviceerrors.ErrPrivateAccount
+	}
+
+	if inv.InviteeID != 0 {
+		var isMember, isInvited bool
+		err := r.db.QueryRowContext(ctx, `
+			SELECT
+				EXISTS (SELECT 1 FROM sharings WHERE account_id = $1 AND user_id = $2),
+				EXISTS (
+					SELECT 1 FROM account_invitation
+					WHERE account_id = $1 AND invitee_id = $2
+						AND invitation_status = 'pending' AND expires_at > NOW()
+				)
+		`, inv.AccountID, inv.InviteeID).Scan(&isMember, &isInvited)
+		if err != nil {
+			return finmodels.AccountInvitation{}, MapPgAccountError(err)
+		}
+		if isMember {
+			return finmodels.AccountInvitation{}, serviceerrors.ErrSharingExists
+		}
+		if isInvited {
+			return finmodels.AccountInvitation{}, serviceerrors.ErrInvitationExists
+		}
+	}
+
+	query := `
+		WITH i AS (
+			INSERT INTO account_invitation
+			(account_id, inviter_id, invitee_id, token_hash, sharing_role, expires_at)
+			VALUES ($1, $2, NULLIF($3, 0), NULLIF($4, ''), $5, $6)
+			RETURNING *
+		)
+		SELECT ` + invitationColumns + `
+		FROM i
+	` + invitationJoins
+
+	created, err := scanInvitation(r.db.QueryRowContext(ctx, query,
+		inv.AccountID, inv.InviterID, inv.InviteeID, inv.TokenHash, string(inv.Role), inv.ExpiresAt))
+	if err != nil {
+		return finmodels.AccountInvitation{}, MapPgAccountError(err)
+	}
+	created.Token = inv.Token
+	return created, nil
+}
+
+// GetPendingInvitations возвращает действующие приглашения, адресованные пользователю
+func (r *PostgresRepository) GetPendingInvitations(ctx context.Context, userID int) ([]finmodels.AccountInvitation, error) {
+	query := `SELECT ` + invitationColumns + `
+		FROM account_invitation i
+	` + invitationJoins + `
+		WHERE i.invitee_id = $1 AND i.invitation_status = 'pending' AND i.expires_at > NOW()
+		ORDER BY i.created_at DESC, i._id DESC
+	`
+	return r.queryInvitations(ctx, query, userID)
+}
+
+// GetAccountInvitations возвращает действующие приглашения счета, включая ссылки. Доступно только владельцу.
+func (r *PostgresRepository) GetAccountInvitations(ctx context.Context, userID, accountID int) ([]finmodels.AccountInvitation, error) {
+	if err := requireAccountRole(ctx, r.db, userID, accountID, finmodels.SharingRole.CanManage); err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + invitationColumns + `
+		FROM account_invitation i
+	` + invitationJoins + `
+		WHERE i.account_id = $1 AND i.invitation_status = 'pending' AND i.expires_at > NOW()
+		ORDER BY i.created_at DESC, i._id DESC
+	`
+	return r.queryInvitations(ctx, query, accountID)
+}
+
+// AcceptAccountInvitation принимает приглашение, адресованное пользователю
+func (r *PostgresRepository) AcceptAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (finmodels.SharingAccount, error) {
+	return r.acceptInvitation(ctx, req.UserID, `_id = $1 AND invitee_id = $2`, req.InvitationID, req.UserID)
+}
+
+// AcceptInvitationByToken принимает приглашение по ссылке. Ссылка одноразовая.
+func (r *PostgresRepository) AcceptInvitationByToken(ctx context.Context, userID int, tokenHash string) (finmodels.SharingAccount, error) {
+	return r.acceptInvitation(ctx, userID, `token_hash = $1`, tokenHash)
+}
+
+// acceptInvitation добавляет пользователя в участники счета и закрывает приглашение
+// в одной транзакции, чтобы приглашение нельзя было принять дважды.
+func (r *PostgresRepository) acceptInvitation(ctx context.Context, userID int, cond string, args ...any) (finmodels.SharingAccount, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return finmodels.SharingAccount{}, err
+	}
+	defer tx.Rollback()
+
+	var invitationID, accountID int
+	var role string
+	var expired bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT _id, account_id, sharing_role, expires_at <= NOW()
+		FROM account_invitation
+		WHERE `+cond+` AND invitation_status = 'pending'
+		FOR UPDATE
+	`, args...).Scan(&invitationID, &accountID, &role, &expired)
+	if err != nil {
+		return finmodels.SharingAccount{}, MapPgInvitationError(err)
+	}
+	if expired {
+		return finmodels.SharingAccount{}, serviceerrors.ErrInvitationExpired
+	}
+
+	query := `
+		WITH s AS (
+			INSERT INTO sharings
+			(account_id, user_id, sharing_role, created_at, updated_at)
+			VALUES ($1, $2, $3, NOW(), NOW())
+			RETURNING _id, account_id, user_id, sharing_role, created_at
+		)
+		SELECT ` + sharingColumns + `
+		FROM s
+		JOIN "user" u ON u._id = s.user_id
+	`
+	sh, err := scanSharing(tx.QueryRowContext(ctx, query, accountID, userID, role))
+	if err != nil {
+		return finmodels.SharingAccount{}, MapPgAccountError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE account_invitation
+		SET invitation_status = 'accepted', invitee_id = $2, responded_at = NOW()
+		WHERE _id = $1
+	`, invitationID, userID)
+	if err != nil {
+		return finmodels.SharingAccount{}, MapPgAccountError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return finmodels.SharingAccount{}, MapPgAccountError(err)
+	}
+	return sh, nil
+}
+
+// DeclineAccountInvitation отклоняет приглашение, адресованное пользователю
+func (r *PostgresRepository) DeclineAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (finmodels.AccountInvitation, error) {
+	query := `
+		WITH i AS (
+			UPDATE account_invitation
+			SET invitation_status = 'declined', responded_at = NOW()
+			WHERE _id = $1 AND invitee_id = $2 AND invitation_status = 'pending'
+			RETURNING *
+		)
+		SELECT ` + invitationColumns + `
+		FROM i
+	` + invitationJoins
+
+	inv, err := scanInvitation(r.db.QueryRowContext(ctx, query, req.InvitationID, req.UserID))
+	if err != nil {
+		return finmodels.AccountInvitation{}, MapPgInvitationError(err)
+	}
+	return inv, nil
+}
+
+// RevokeAccountInvitation отзывает приглашение. Доступно только владельцу счета.
+func (r *PostgresRepository) RevokeAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (finmodels.AccountInvitation, error) {
+	var accountID int
+	err := r.db.QueryRowContext(ctx, `SELECT account_id FROM account_invitation WHERE _id = $1`, req.InvitationID).Scan(&accountID)
+	if err != nil {
+		return finmodels.AccountInvitation{}, MapPgInvitationError(err)
+	}
+
+	err = requireAccountRole(ctx, r.db, req.UserID, accountID, finmodels.SharingRole.CanManage)
+	if errors.Is(err, serviceerrors.ErrAccountNotFound) {
+		// Приглашения чужих счетов не раскрываются
+		return finmodels.AccountInvitation{}, serviceerrors.ErrInvitationNotFound
+	}
+	if err != nil {
+		return finmodels.AccountInvitation{}, err
+	}
+
+	query := `
+		WITH i AS (
+			UPDATE account_invitation
+			SET invitation_status = 'revoked', responded_at = NOW()
+			WHERE _id = $1 AND invitation_status = 'pending'
+			RETURNING *
+		)
+		SELECT ` + invitationColumns + `
+		FROM i
+	` + invitationJoins
+
+	inv, err := scanInvitation(r.db.QueryRowContext(ctx, query, req.InvitationID))
+	if err != nil {
+		return finmodels.AccountInvitation{}, MapPgInvitationError(err)
+	}
+	return inv, nil
+}
@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+var invitationRowColumns = []string{
+	"_id", "account_id", "account_name", "inviter_id", "inviter_login",
+	"invitee_id", "invitee_login", "sharing_role", "invitation_status", "token_hash",
+	"expires_at", "created_at",
+}
+
+func expectAccountType(mock sqlmock.Sqlmock, accountID int, accountType string) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_type FROM account WHERE _id = $1`)).
+		WithArgs(accountID).
+		WillReturnRows(sqlmock.NewRows([]string{"account_type"}).AddRow(accountType))
+}
+
+func TestCreateAccountInvitation_ByLogin(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	expires := time.Now().Add(time.Hour)
+
+	expectAccountRole(mock, 1, 3, "owner")
+	expectAccountType(mock, 3, "shared")
+	mock.ExpectQuery(`EXISTS \(SELECT 1 FROM sharings`).
+		WithArgs(3, 8).
+		WillReturnRows(sqlmock.NewRows([]string{"is_member", "is_invited"}).AddRow(false, false))
+	mock.ExpectQuery(`INSERT INTO account_invitation`).
+		WithArgs(3, 1, 8, "", "viewer", expires).
+		WillReturnRows(sqlmock.NewRows(invitationRowColumns).
+			AddRow(5, 3, "Семья", 1, "ivan", 8, "anna", "viewer", "pending", "", expires, time.Now()))
+
+	inv, err := repo.CreateAccountInvitation(context.Background(), finmodels.AccountInvitation{
+		AccountID: 3, InviterID: 1, InviteeID: 8, Role: finmodels.RoleViewer, ExpiresAt: expires,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, inv.ID)
+	require.Equal(t, "anna", inv.InviteeLogin)
+	require.Equal(t, finmodels.InvitationPending, inv.Status)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateAccountInvitation_LinkKeepsToken(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	expires := time.Now().Add(time.Hour)
+
+	expectAccountRole(mock, 1, 3, "owner")
+	expectAccountType(mock, 3, "shared")
+	mock.ExpectQuery(`INSERT INTO account_invitation`).
+		WithArgs(3, 1, 0, "hash", "editor", expires).
+		WillReturnRows(sqlmock.NewRows(invitationRowColumns).
+			AddRow(6, 3, "Семья", 1, "ivan", 0, "", "editor", "pending", "hash", expires, time.Now()))
+
+	inv, err := repo.CreateAccountInvitation(context.Background(), finmodels.AccountInvitation{
+		AccountID: 3, InviterID: 1, Role: finmodels.RoleEditor, Token: "raw", TokenHash: "hash", ExpiresAt: expires,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "raw", inv.Token)
+	require.True(t, inv.IsLink())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateAccountInvitation_Errors(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	inv := finmodels.AccountInvitation{AccountID: 3, InviterID: 1, InviteeID: 8, Role: finmodels.RoleEditor}
+
+	expectAccountRole(mock, 1, 3, "editor")
+	_, err := repo.CreateAccountInvitation(context.Background(), inv)
+	require.ErrorIs(t, err, serviceerrors.ErrForbidden)
+
+	expectAccountRole(mock, 1, 3, "owner")
+	expectAccountType(mock, 3, "private")
+	_, err = repo.CreateAccountInvitation(context.Background(), inv)
+	require.ErrorIs(t, err, serviceerrors.ErrPrivateAccount)
+
+	expectAccountRole(mock, 1, 3, "owner")
+	expectAccountType(mock, 3, "shared")
+	mock.ExpectQuery(`EXISTS`).
+		WithArgs(3, 8).
+		WillReturnRows(sqlmock.NewRows([]string{"is_member", "is_invited"}).AddRow(true, false))
+	_, err = repo.CreateAccountInvitation(context.Background(), inv)
+	require.ErrorIs(t, err, serviceerrors.ErrSharingExists)
+
+	expectAccountRole(mock, 1, 3, "owner")
+	expectAccountType(mock, 3, "shared")
+	mock.ExpectQuery(`EXISTS`).
+		WithArgs(3, 8).
+		WillReturnRows(sqlmock.NewRows([]string{"is_member", "is_invited"}).AddRow(false, true))
+	_, err = repo.CreateAccountInvitation(context.Background(), inv)
+	require.ErrorIs(t, err, serviceerrors.ErrInvitationExists)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPendingInvitations(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	mock.ExpectQuery(`WHERE i.invitee_id = \$1 AND i.invitation_status = 'pending' AND i.expires_at > NOW\(\)`).
+		WithArgs(8).
+		WillReturnRows(sqlmock.NewRows(invitationRowColumns).
+			AddRow(5, 3, "Семья", 1, "ivan", 8, "anna", "viewer", "pending", "", now.Add(time.Hour), now))
+
+	invitations, err := repo.GetPendingInvitations(context.Background(), 8)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	require.Equal(t, "Семья", invitations[0].AccountName)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptAccountInvitation(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM account_invitation\s+WHERE _id = \$1 AND invitee_id = \$2 AND invitation_status = 'pending'\s+FOR UPDATE`).
+		WithArgs(5, 8).
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "account_id", "sharing_role", "expired"}).AddRow(5, 3, "viewer", false))
+	mock.ExpectQuery(`INSERT INTO sharings`).
+		WithArgs(3, 8, "viewer").
+		WillReturnRows(sqlmock.NewRows(sharingRowColumns).AddRow(12, 3, 8, "anna", "viewer", time.Now()))
+	mock.ExpectExec(`SET invitation_status = 'accepted', invitee_id = \$2`).
+		WithArgs(5, 8).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	sh, err := repo.AcceptAccountInvitation(context.Background(), finmodels.InvitationRequest{UserID: 8, InvitationID: 5})
+	require.NoError(t, err)
+	require.Equal(t, 3, sh.AccountID)
+	require.Equal(t, finmodels.RoleViewer, sh.Role)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptInvitationByToken_Expired(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`WHERE token_hash = \$1 AND invitation_status = 'pending'`).
+		WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "account_id", "sharing_role", "expired"}).AddRow(6, 3, "editor", true))
+	mock.ExpectRollback()
+
+	_, err := repo.AcceptInvitationByToken(context.Background(), 8, "hash")
+	require.ErrorIs(t, err, serviceerrors.ErrInvitationExpired)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptInvitationByToken_Used(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`WHERE token_hash = \$1`).
+		WithArgs("hash").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.AcceptInvitationByToken(context.Background(), 8, "hash")
+	require.ErrorIs(t, err, serviceerrors.ErrInvitationNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeclineAccountInvitation(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	mock.ExpectQuery(`SET invitation_status = 'declined'`).
+		WithArgs(5, 8).
+		WillReturnRows(sqlmock.NewRows(invitationRowColumns).
+			AddRow(5, 3, "Семья", 1, "ivan", 8, "anna", "viewer", "declined", "", now, now))
+
+	inv, err := repo.DeclineAccountInvitation(context.Background(), finmodels.InvitationRequest{UserID: 8, InvitationID: 5})
+	require.NoError(t, err)
+	require.Equal(t, finmodels.InvitationDeclined, inv.Status)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokeAccountInvitation(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT account_id FROM account_invitation WHERE _id = $1`)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}).AddRow(3))
+	expectAccountRole(mock, 1, 3, "owner")
+	mock.ExpectQuery(`SET invitation_status = 'revoked'`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(invitationRowColumns).
+			AddRow(5, 3, "Семья", 1, "ivan", 8, "anna", "viewer", "revoked", "", now, now))
+
+	inv, err := repo.RevokeAccountInvitation(context.Background(), finmodels.InvitationRequest{UserID: 1, InvitationID: 5})
+	require.NoError(t, err)
+	require.Equal(t, finmodels.InvitationRevoked, inv.Status)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokeAccountInvitation_ForeignAccount(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectQuery(`SELECT account_id FROM account_invitation`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}).AddRow(3))
+	mock.ExpectQuery(`SELECT sharing_role FROM sharings`).
+		WithArgs(2, 3).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.RevokeAccountInvitation(context.Background(), finmodels.InvitationRequest{UserID: 2, InvitationID: 5})
+	require.ErrorIs(t, err, serviceerrors.ErrInvitationNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
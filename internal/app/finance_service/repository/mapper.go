@@ -83,3 +83,29 @@ func MapPgAccountError(err error) error {
 		return err
 	}
 }
+
+func MapPgRuleError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return serviceerrors.ErrRuleNotFound
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case ForeignKeyViolation:
+		return serviceerrors.ErrCategoryNotFound
+	case NotNullViolation:
+		return serviceerrors.ErrInvalidData
+	case CheckViolation:
+		return serviceerrors.ErrInvalidData
+	default:
+		return err
+	}
+}
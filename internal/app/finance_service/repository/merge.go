@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+// MergeCategories переносит операции, правила и подкатегории source в target и удаляет source.
+// Возвращает количество перенесенных операций.
+func (r *PostgresRepository) MergeCategories(ctx context.Context, req finmodels.MergeCategoriesRequest) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// блокировка source не дает параллельно создать операцию со ссылкой на нее
+	var sourceID int
+	err = tx.QueryRowContext(ctx, `
+		SELECT _id FROM category WHERE _id = $1 AND user_id = $2 FOR UPDATE
+	`, req.SourceID, req.UserID).Scan(&sourceID)
+	if err != nil {
+		return 0, MapPgCategoryError(err)
+	}
+
+	if req.DetachTarget {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE category SET parent_id = (SELECT parent_id FROM category WHERE _id = $1), updated_at = NOW()
+			WHERE _id = $2 AND user_id = $3
+		`, req.SourceID, req.TargetID, req.UserID)
+		if err != nil {
+			return 0, MapPgCategoryError(err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE category SET parent_id = $1, updated_at = NOW()
+		WHERE parent_id = $2 AND user_id = $3 AND _id <> $1
+	`, req.TargetID, req.SourceID, req.UserID)
+	if err != nil {
+		return 0, MapPgCategoryError(err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE category_rule SET category_id = $1, updated_at = NOW()
+		WHERE category_id = $2 AND user_id = $3
+	`, req.TargetID, req.SourceID, req.UserID)
+	if err != nil {
+		return 0, MapPgRuleError(err)
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE operation SET category_id = $1 WHERE category_id = $2
+	`, req.TargetID, req.SourceID)
+	if err != nil {
+		return 0, MapPgOperationError(err)
+	}
+	moved, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err = tx.ExecContext(ctx, `
+		DELETE FROM category WHERE _id = $1 AND user_id = $2
+	`, req.SourceID, req.UserID)
+	if err != nil {
+		return 0, MapPgCategoryError(err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if deleted == 0 {
+		return 0, serviceerrors.ErrCategoryNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(moved), nil
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeCategories(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT _id FROM category WHERE _id = \$1 AND user_id = \$2 FOR UPDATE`).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(5))
+	mock.ExpectExec(`UPDATE category SET parent_id = \(SELECT parent_id`).
+		WithArgs(5, 7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE category SET parent_id = \$1`).
+		WithArgs(7, 5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`UPDATE category_rule SET category_id`).
+		WithArgs(7, 5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE operation SET category_id`).
+		WithArgs(7, 5).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+	mock.ExpectExec(`DELETE FROM category`).
+		WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	moved, err := repo.MergeCategories(context.Background(), finmodels.MergeCategoriesRequest{
+		UserID: 1, SourceID: 5, TargetID: 7, DetachTarget: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 12, moved)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMergeCategories_SourceNotFound(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FOR UPDATE`).
+		WithArgs(5, 1).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.MergeCategories(context.Background(), finmodels.MergeCategoriesRequest{UserID: 1, SourceID: 5, TargetID: 7})
+	require.ErrorIs(t, err, serviceerrors.ErrCategoryNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
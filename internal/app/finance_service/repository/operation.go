@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/lib/pq"
+
 	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
 )
@@ -26,7 +28,7 @@ type OperationDB struct {
 	Date          time.Time
 }
 
-func (r *PostgresRepository) GetOperationsByAccount(ctx context.Context, accountID int) ([]finmodels.OperationInList, error) {
+func (r *PostgresRepository) GetOperationsByAccount(ctx context.Context, userID, accountID int) ([]finmodels.OperationInList, error) {
 	query := `
 		SELECT o._id, o.account_from_id, o.account_to_id, o.category_id, o.currency_id, 
 		       o.operation_status, o.operation_type, o.operation_name, o.operation_description, 
@@ -37,11 +39,12 @@ func (r *PostgresRepository) GetOperationsByAccount(ctx context.Context, account
 		FROM operation o
 		LEFT JOIN category c ON o.category_id = c._id
 		JOIN account a ON a._id = o.account_from_id
+		JOIN sharings s ON s.account_id = $1 AND s.user_id = $2
 		WHERE (o.account_from_id = $1 OR o.account_to_id = $1) AND o.operation_status != 'reverted'
 		ORDER BY o.created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, accountID)
+	rows, err := r.db.QueryContext(ctx, query, accountID, userID)
 	if err != nil {
 		return nil, MapPgOperationError(err)
 	}
@@ -82,7 +85,7 @@ func (r *PostgresRepository) GetOperationsByAccount(ctx context.Context, account
 	return operations, nil
 }
 
-func (r *PostgresRepository) GetOperationByID(ctx context.Context, accID int, opID int) (finmodels.Operation, error) {
+func (r *PostgresRepository) GetOperationByID(ctx context.Context, userID, accID int, opID int) (finmodels.Operation, error) {
 	query := `
 		SELECT o._id, o.account_from_id, o.account_to_id, o.category_id, o.currency_id, 
 		       o.operation_status, o.operation_type, o.operation_name, o.operation_description, 
@@ -90,11 +93,12 @@ func (r *PostgresRepository) GetOperationByID(ctx context.Context, accID int, op
 		       COALESCE(c.category_name, 'Без категории') as category_name
 		FROM operation o
 		LEFT JOIN category c ON o.category_id = c._id
+		JOIN sharings s ON s.account_id = $2 AND s.user_id = $3
 		WHERE o._id = $1 AND (o.account_from_id = $2 OR o.account_to_id = $2) AND o.operation_status != 'reverted'
 	`
 
 	var operation OperationDB
-	err := r.db.QueryRowContext(ctx, query, opID, accID).Scan(
+	err := r.db.QueryRowContext(ctx, query, opID, accID, userID).Scan(
 		&operation.ID,
 		&operation.AccountFromID,
 		&operation.AccountToID,
@@ -118,13 +122,68 @@ func (r *PostgresRepository) GetOperationByID(ctx context.Context, accID int, op
 	return operationDBToModel(operation), nil
 }
 
-func (r *PostgresRepository) CreateOperation(ctx context.Context, op finmodels.Operation) (finmodels.Operation, error) {
+// GetOperationsByIDs операции из opIDs со счетов, к которым у пользователя есть доступ,
+// включая отмененные. Операции чужих счетов пропускаются.
+func (r *PostgresRepository) GetOperationsByIDs(ctx context.Context, userID int, opIDs []int) ([]finmodels.Operation, error) {
+	query := `
+		SELECT o._id, o.account_from_id, COALESCE(o.account_to_id, 0), o.currency_id,
+		       o.operation_status, o.operation_type, o.operation_name, o.sum, o.created_at, o.operation_date
+		FROM operation o
+		WHERE o._id = ANY($2) AND EXISTS (
+			SELECT 1 FROM sharings s
+			WHERE s.user_id = $1 AND s.account_id IN (o.account_from_id, o.account_to_id)
+		)
+		ORDER BY o._id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, pq.Array(opIDs))
+	if err != nil {
+		return nil, MapPgOperationError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var operations []finmodels.Operation
+	for rows.Next() {
+		var op finmodels.Operation
+		var currencyID *int
+		err := rows.Scan(
+			&op.ID,
+			&op.AccountID,
+			&op.AccountToID,
+			&currencyID,
+			&op.Status,
+			&op.Type,
+			&op.Name,
+			&op.Sum,
+			&op.CreatedAt,
+			&op.Date,
+		)
+		if err != nil {
+			return nil, MapPgOperationError(err)
+		}
+		if currencyID != nil {
+			op.CurrencyID = *currencyID
+		}
+		operations = append(operations, op)
+	}
+
+	return operations, rows.Err()
+}
+
+// CreateOperation создает операцию и меняет баланс счета. Просматривающему счет участнику запрещено.
+func (r *PostgresRepository) CreateOperation(ctx context.Context, userID int, op finmodels.Operation) (finmodels.Operation, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return finmodels.Operation{}, err
 	}
 	defer tx.Rollback()
 
+	if err := requireAccountRole(ctx, tx, userID, op.AccountID, finmodels.SharingRole.CanEditOperations); err != nil {
+		return finmodels.Operation{}, err
+	}
+
 	sum := op.Sum
 	if op.Type == "income" {
 		sum = -1 * sum
@@ -214,6 +273,10 @@ func (r *PostgresRepository) UpdateOperation(ctx context.Context, req finmodels.
 		return finmodels.Operation{}, err
 	}
 	defer tx.Rollback()
+
+	if err := requireAccountRole(ctx, tx, req.UserID, accID, finmodels.SharingRole.CanEditOperations); err != nil {
+		return finmodels.Operation{}, err
+	}
 	query := `
 		WITH updated_operation AS (
 			UPDATE operation 
@@ -301,13 +364,17 @@ func (r *PostgresRepository) UpdateOperation(ctx context.Context, req finmodels.
 	return operationDBToModel(operation), nil
 }
 
-func (r *PostgresRepository) DeleteOperation(ctx context.Context, accID int, opID int) (finmodels.Operation, error) {
+func (r *PostgresRepository) DeleteOperation(ctx context.Context, userID, accID int, opID int) (finmodels.Operation, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return finmodels.Operation{}, err
 	}
 	defer tx.Rollback()
 
+	if err := requireAccountRole(ctx, tx, userID, accID, finmodels.SharingRole.CanEditOperations); err != nil {
+		return finmodels.Operation{}, err
+	}
+
 	var operationSum float64
 	var operationType string
 
@@ -379,6 +446,11 @@ func operationDBToModel(operationDB OperationDB) finmodels.Operation {
 		accountID = *operationDB.AccountToID
 	}
 
+	var accountToID int
+	if operationDB.AccountFromID != nil && operationDB.AccountToID != nil {
+		accountToID = *operationDB.AccountToID
+	}
+
 	var categoryID int
 	if operationDB.CategoryID != nil {
 		categoryID = *operationDB.CategoryID
@@ -392,6 +464,7 @@ func operationDBToModel(operationDB OperationDB) finmodels.Operation {
 	return finmodels.Operation{
 		ID:           operationDB.ID,
 		AccountID:    accountID,
+		AccountToID:  accountToID,
 		CategoryID:   categoryID,
 		CategoryName: operationDB.CategoryName,
 		Type:         operationDB.Type,
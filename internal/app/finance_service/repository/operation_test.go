@@ -10,6 +10,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
 	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	"github.com/lib/pq"
 
 	"github.com/stretchr/testify/require"
 )
@@ -48,13 +49,14 @@ func TestGetOperationsByAccount_Success(t *testing.T) {
 		FROM operation o
 		LEFT JOIN category c ON o.category_id = c._id
 		JOIN account a ON a._id = o.account_from_id
+		JOIN sharings s ON s.account_id = $1 AND s.user_id = $2
 		WHERE (o.account_from_id = $1 OR o.account_to_id = $1) AND o.operation_status != 'reverted'
 		ORDER BY o.created_at DESC
 	`)).
-		WithArgs(1).
+		WithArgs(1, 4).
 		WillReturnRows(rows)
 
-	ops, err := repo.GetOperationsByAccount(context.Background(), 1)
+	ops, err := repo.GetOperationsByAccount(context.Background(), 4, 1)
 	require.NoError(t, err)
 	require.Len(t, ops, 1)
 	require.Equal(t, 10, ops[0].ID)
@@ -69,10 +71,10 @@ func TestGetOperationsByAccount_DBError(t *testing.T) {
 	defer close()
 
 	mock.ExpectQuery("SELECT").
-		WithArgs(1).
+		WithArgs(1, 4).
 		WillReturnError(sql.ErrNoRows)
 
-	_, err := repo.GetOperationsByAccount(context.Background(), 1)
+	_, err := repo.GetOperationsByAccount(context.Background(), 4, 1)
 	require.ErrorIs(t, err, serviceerrors.ErrOperationNotFound)
 }
 
@@ -99,12 +101,13 @@ func TestGetOperationByID_Success(t *testing.T) {
 		       COALESCE(c.category_name, 'Без категории') as category_name
 		FROM operation o
 		LEFT JOIN category c ON o.category_id = c._id
+		JOIN sharings s ON s.account_id = $2 AND s.user_id = $3
 		WHERE o._id = $1 AND (o.account_from_id = $2 OR o.account_to_id = $2) AND o.operation_status != 'reverted'
 	`)).
-		WithArgs(10, 1).
+		WithArgs(10, 1, 4).
 		WillReturnRows(rows)
 
-	op, err := repo.GetOperationByID(context.Background(), 1, 10)
+	op, err := repo.GetOperationByID(context.Background(), 4, 1, 10)
 	require.NoError(t, err)
 	require.Equal(t, 200.5, op.Sum)
 	require.Equal(t, "Зарплата", op.CategoryName)
@@ -115,10 +118,10 @@ func TestGetOperationByID_NotFound(t *testing.T) {
 	defer close()
 
 	mock.ExpectQuery("SELECT").
-		WithArgs(10, 1).
+		WithArgs(10, 1, 4).
 		WillReturnError(sql.ErrNoRows)
 
-	_, err := repo.GetOperationByID(context.Background(), 1, 10)
+	_, err := repo.GetOperationByID(context.Background(), 4, 1, 10)
 	require.ErrorIs(t, err, serviceerrors.ErrOperationNotFound)
 }
 
@@ -142,6 +145,7 @@ func TestCreateOperation_Success(t *testing.T) {
 
 	// Начало транзакции
 	mock.ExpectBegin()
+	expectAccountRole(mock, 4, op.AccountID, "editor")
 
 	// Обновление баланса счета — теперь ExpectExec
 	mock.ExpectExec(regexp.QuoteMeta(`
@@ -196,7 +200,7 @@ func TestCreateOperation_Success(t *testing.T) {
 		WithArgs(op.AccountID).
 		WillReturnRows(sqlmock.NewRows([]string{"account_type"}).AddRow("cash"))
 
-	res, err := repo.CreateOperation(context.Background(), op)
+	res, err := repo.CreateOperation(context.Background(), 4, op)
 	require.NoError(t, err)
 	require.Equal(t, 100, res.ID)
 	require.Equal(t, "Категория", res.CategoryName)
@@ -210,13 +214,15 @@ func TestUpdateOperation_Success(t *testing.T) {
 	name := "Новое имя"
 	sum := 123.4
 	req := finmodels.UpdateOperationRequest{
-		Name: &name,
-		Sum:  &sum,
+		UserID: 4,
+		Name:   &name,
+		Sum:    &sum,
 	}
 
 	now := time.Now()
 
 	mock.ExpectBegin()
+	expectAccountRole(mock, 4, 1, "owner")
 
 	rows := sqlmock.NewRows([]string{
 		"_id", "account_from_id", "account_to_id", "category_id", "currency_id",
@@ -273,6 +279,7 @@ func TestDeleteOperation_Success(t *testing.T) {
 	now := time.Now()
 
 	mock.ExpectBegin()
+	expectAccountRole(mock, 4, 1, "editor")
 
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT sum, operation_type FROM operation WHERE _id = $1`)).
 		WithArgs(7).
@@ -312,8 +319,56 @@ func TestDeleteOperation_Success(t *testing.T) {
 
 	mock.ExpectCommit()
 
-	op, err := repo.DeleteOperation(context.Background(), 1, 7)
+	op, err := repo.DeleteOperation(context.Background(), 4, 1, 7)
 	require.NoError(t, err)
 	require.Equal(t, 7, op.ID)
 	require.Equal(t, "reverted", string(op.Status))
 }
+
+func TestCreateOperation_ViewerForbidden(t *testing.T) {
+	repo, mock, close := setupOperationDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	expectAccountRole(mock, 4, 1, "viewer")
+	mock.ExpectRollback()
+
+	_, err := repo.CreateOperation(context.Background(), 4, finmodels.Operation{AccountID: 1, Sum: 10, Type: "expense"})
+	require.ErrorIs(t, err, serviceerrors.ErrForbidden)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteOperation_NotMember(t *testing.T) {
+	repo, mock, close := setupOperationDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT sharing_role FROM sharings WHERE user_id = $1 AND account_id = $2`)).
+		WithArgs(4, 1).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.DeleteOperation(context.Background(), 4, 1, 7)
+	require.ErrorIs(t, err, serviceerrors.ErrAccountNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOperationsByIDs(t *testing.T) {
+	repo, mock, close := setupOperationDB(t)
+	defer close()
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM operation o\s+WHERE o._id = ANY\(\$2\) AND EXISTS`).
+		WithArgs(4, pq.Array([]int{7, 8})).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"_id", "account_from_id", "account_to_id", "currency_id",
+			"operation_status", "operation_type", "operation_name", "sum", "created_at", "operation_date",
+		}).AddRow(7, 1, 0, nil, "reverted", "expense", "Кофе", 150.0, now, now))
+
+	ops, err := repo.GetOperationsByIDs(context.Background(), 4, []int{7, 8})
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	require.Equal(t, finmodels.OperationReverted, ops[0].Status)
+	require.Zero(t, ops[0].CurrencyID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+const categoryRuleColumns = `
+	_id, user_id, category_id, priority, COALESCE(name_contains, ''),
+	COALESCE(operation_type::text, ''), amount_gt, amount_lt, created_at, updated_at
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCategoryRule(row rowScanner) (finmodels.CategoryRule, error) {
+	var rule finmodels.CategoryRule
+	var opType string
+	err := row.Scan(
+		&rule.ID,
+		&rule.UserID,
+		&rule.CategoryID,
+		&rule.Priority,
+		&rule.NameContains,
+		&opType,
+		&rule.AmountGT,
+		&rule.AmountLT,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+	)
+	rule.OperationType = finmodels.OperationType(opType)
+	return rule, err
+}
+
+func (r *PostgresRepository) CreateCategoryRule(ctx context.Context, rule finmodels.CategoryRule) (finmodels.CategoryRule, error) {
+	query := `
+		INSERT INTO category_rule (user_id, category_id, priority, name_contains, operation_type, amount_gt, amount_lt, created_at, updated_at)
+		VALUES (
+			$1, $2,
+			COALESCE((SELECT MAX(priority) FROM category_rule WHERE user_id = $1), 0) + 1,
+			NULLIF($3, ''), NULLIF($4, '')::operation_type, $5, $6, NOW(), NOW()
+		)
+		RETURNING ` + categoryRuleColumns
+
+	created, err := scanCategoryRule(r.db.QueryRowContext(ctx, query,
+		rule.UserID,
+		rule.CategoryID,
+		rule.NameContains,
+		string(rule.OperationType),
+		rule.AmountGT,
+		rule.AmountLT,
+	))
+	if err != nil {
+		return finmodels.CategoryRule{}, MapPgRuleError(err)
+	}
+
+	return created, nil
+}
+
+func (r *PostgresRepository) GetCategoryRulesByUser(ctx context.Context, userID int) ([]finmodels.CategoryRule, error) {
+	query := `SELECT ` + categoryRuleColumns + `
+		FROM category_rule
+		WHERE user_id = $1
+		ORDER BY priority, _id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, MapPgRuleError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var rules []finmodels.CategoryRule
+	for rows.Next() {
+		rule, err := scanCategoryRule(rows)
+		if err != nil {
+			return nil, MapPgRuleError(err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+func (r *PostgresRepository) UpdateCategoryRule(ctx context.Context, rule finmodels.CategoryRule) (finmodels.CategoryRule, error) {
+	query := `
+		UPDATE category_rule
+		SET category_id = $3,
+		    name_contains = NULLIF($4, ''),
+		    operation_type = NULLIF($5, '')::operation_type,
+		    amount_gt = $6,
+		    amount_lt = $7,
+		    updated_at = NOW()
+		WHERE _id = $2 AND user_id = $1
+		RETURNING ` + categoryRuleColumns
+
+	updated, err := scanCategoryRule(r.db.QueryRowContext(ctx, query,
+		rule.UserID,
+		rule.ID,
+		rule.CategoryID,
+		rule.NameContains,
+		string(rule.OperationType),
+		rule.AmountGT,
+		rule.AmountLT,
+	))
+	if err != nil {
+		return finmodels.CategoryRule{}, MapPgRuleError(err)
+	}
+
+	return updated, nil
+}
+
+func (r *PostgresRepository) DeleteCategoryRule(ctx context.Context, userID, ruleID int) (finmodels.CategoryRule, error) {
+	query := `
+		DELETE FROM category_rule
+		WHERE _id = $2 AND user_id = $1
+		RETURNING ` + categoryRuleColumns
+
+	deleted, err := scanCategoryRule(r.db.QueryRowContext(ctx, query, userID, ruleID))
+	if err != nil {
+		return finmodels.CategoryRule{}, MapPgRuleError(err)
+	}
+
+	return deleted, nil
+}
+
+func (r *PostgresRepository) ReorderCategoryRules(ctx context.Context, userID int, ruleIDs []int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for i, ruleID := range ruleIDs {
+		res, err := tx.ExecContext(ctx, `
+			UPDATE category_rule
+			SET priority = $3, updated_at = NOW()
+			WHERE _id = $2 AND user_id = $1
+		`, userID, ruleID, i+1)
+		if err != nil {
+			return MapPgRuleError(err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return serviceerrors.ErrRuleNotFound
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetOperationsForRules возвращает операции со счетов пользователя, которые можно
+// перекатегоризировать: без категории либо с категорией самого пользователя.
+// Счета, где пользователь только просматривает операции, не учитываются.
+func (r *PostgresRepository) GetOperationsForRules(ctx context.Context, userID int, onlyUncategorized bool) ([]finmodels.Operation, error) {
+	query := `
+		SELECT o._id, o.account_from_id, COALESCE(o.category_id, 0), o.currency_id,
+		       o.operation_status, o.operation_type, o.operation_name, COALESCE(o.operation_description, ''),
+		       COALESCE(o.receipt_url, ''), o.sum, o.created_at, o.operation_date,
+		       a.account_type
+		FROM operation o
+		LEFT JOIN category c ON o.category_id = c._id
+		JOIN account a ON a._id = o.account_from_id
+		JOIN sharings s ON s.account_id = a._id
+		WHERE s.user_id = $1 AND s.sharing_role IN ('owner', 'editor')
+		  AND o.operation_status != 'reverted'
+		  AND (o.category_id IS NULL OR (c.user_id = $1 AND NOT $2))
+		ORDER BY o.operation_date
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, onlyUncategorized)
+	if err != nil {
+		return nil, MapPgOperationError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var operations []finmodels.Operation
+	for rows.Next() {
+		var op finmodels.Operation
+		var currencyID *int
+		err := rows.Scan(
+			&op.ID,
+			&op.AccountID,
+			&op.CategoryID,
+			&currencyID,
+			&op.Status,
+			&op.Type,
+			&op.Name,
+			&op.Description,
+			&op.ReceiptURL,
+			&op.Sum,
+			&op.CreatedAt,
+			&op.Date,
+			&op.AccountType,
+		)
+		if err != nil {
+			return nil, MapPgOperationError(err)
+		}
+		if currencyID != nil {
+			op.CurrencyID = *currencyID
+		}
+		operations = append(operations, op)
+	}
+
+	return operations, rows.Err()
+}
+
+func (r *PostgresRepository) SetOperationsCategory(ctx context.Context, ops []finmodels.Operation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, op := range ops {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE operation SET category_id = $1 WHERE _id = $2
+		`, op.CategoryID, op.ID)
+		if err != nil {
+			return MapPgOperationError(err)
+		}
+	}
+
+	return tx.Commit()
+}
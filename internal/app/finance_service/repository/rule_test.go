@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	"github.com/stretchr/testify/require"
+)
+
+var categoryRuleRowColumns = []string{
+	"_id", "user_id", "category_id", "priority", "name_contains",
+	"operation_type", "amount_gt", "amount_lt", "created_at", "updated_at",
+}
+
+func TestCreateCategoryRule(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	amount := 50000.0
+	rule := finmodels.CategoryRule{UserID: 1, CategoryID: 5, OperationType: finmodels.OperationIncome, AmountGT: &amount}
+
+	mock.ExpectQuery(`INSERT INTO category_rule`).
+		WithArgs(1, 5, "", "income", &amount, nil).
+		WillReturnRows(sqlmock.NewRows(categoryRuleRowColumns).
+			AddRow(3, 1, 5, 2, "", "income", 50000.0, nil, time.Now(), time.Now()))
+
+	created, err := repo.CreateCategoryRule(context.Background(), rule)
+	require.NoError(t, err)
+	require.Equal(t, 3, created.ID)
+	require.Equal(t, 2, created.Priority)
+	require.Equal(t, finmodels.OperationIncome, created.OperationType)
+	require.NotNil(t, created.AmountGT)
+	require.Nil(t, created.AmountLT)
+}
+
+func TestGetCategoryRulesByUser(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`FROM category_rule`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(categoryRuleRowColumns).
+			AddRow(1, 1, 5, 1, "taxi", "", nil, nil, time.Now(), time.Now()).
+			AddRow(2, 1, 6, 2, "", "income", 1000.0, nil, time.Now(), time.Now()))
+
+	rules, err := repo.GetCategoryRulesByUser(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, "taxi", rules[0].NameContains)
+	require.Equal(t, finmodels.OperationIncome, rules[1].OperationType)
+}
+
+func TestDeleteCategoryRule_NotFound(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`DELETE FROM category_rule`).
+		WithArgs(1, 9).
+		WillReturnRows(sqlmock.NewRows(categoryRuleRowColumns))
+
+	_, err := repo.DeleteCategoryRule(context.Background(), 1, 9)
+	require.ErrorIs(t, err, serviceerrors.ErrRuleNotFound)
+}
+
+func TestReorderCategoryRules(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE category_rule`).WithArgs(1, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE category_rule`).WithArgs(1, 1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.ReorderCategoryRules(context.Background(), 1, []int{2, 1}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReorderCategoryRules_Foreign(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE category_rule`).WithArgs(1, 42, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := repo.ReorderCategoryRules(context.Background(), 1, []int{42})
+	require.ErrorIs(t, err, serviceerrors.ErrRuleNotFound)
+}
+
+func TestSetOperationsCategory(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE operation SET category_id`).WithArgs(5, 10).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.SetOperationsCategory(context.Background(), []finmodels.Operation{{ID: 10, CategoryID: 5}})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
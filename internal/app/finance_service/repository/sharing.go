@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+const sharingColumns = `s._id, s.account_id, s.user_id, u.user_login, s.sharing_role, s.created_at`
+
+// queryRower *sql.DB или *sql.Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// accountRole возвращает роль пользователя на счете.
+// Для пользователя, не участвующего в счете, счет не существует.
+func accountRole(ctx context.Context, q queryRower, userID, accountID int) (finmodels.SharingRole, error) {
+	var role string
+	err := q.QueryRowContext(ctx, `
+		SELECT sharing_role FROM sharings WHERE user_id = $1 AND account_id = $2
+	`, userID, accountID).Scan(&role)
+	if err != nil {
+		return "", MapPgAccountError(err)
+	}
+	return finmodels.SharingRole(role), nil
+}
+
+// requireAccountRole проверяет, что роль пользователя на счете разрешает действие
+func requireAccountRole(ctx context.Context, q queryRower, userID, accountID int, allowed func(finmodels.SharingRole) bool) error {
+	role, err := accountRole(ctx, q, userID, accountID)
+	if err != nil {
+		return err
+	}
+	if !allowed(role) {
+		return serviceerrors.ErrForbidden
+	}
+	return nil
+}
+
+func MapPgMemberError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return serviceerrors.ErrMemberNotFound
+	}
+	return MapPgAccountError(err)
+}
+
+func scanSharing(row rowScanner) (finmodels.SharingAccount, error) {
+	var sh finmodels.SharingAccount
+	var role string
+	err := row.Scan(&sh.ID, &sh.AccountID, &sh.UserID, &sh.UserLogin, &role, &sh.CreatedAt)
+	sh.Role = finmodels.SharingRole(role)
+	return sh, err
+}
+
+func (r *PostgresRepository) GetAccountMembers(ctx context.Context, userID, accountID int) ([]finmodels.SharingAccount, error) {
+	if _, err := accountRole(ctx, r.db, userID, accountID); err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + sharingColumns + `
+		FROM sharings s
+		JOIN "user" u ON u._id = s.user_id
+		WHERE s.account_id = $1
+		ORDER BY s.sharing_role = 'owner' DESC, s.created_at, s._id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, MapPgAccountError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var members []finmodels.SharingAccount
+	for rows.Next() {
+		sh, err := scanSharing(rows)
+		if err != nil {
+			return nil, MapPgAccountError(err)
+		}
+		members = append(members, sh)
+	}
+
+	return members, rows.Err()
+}
+
+// UpdateAccountMemberRole меняет роль участника счета. Владелец не меняется:
+// для этого есть TransferAccountOwnership.
+func (r *PostgresRepository) UpdateAccountMemberRole(ctx context.Context, req finmodels.AccountMemberRequest) (finmodels.SharingAccount, error) {
+	if err := requireAccountRole(ctx, r.db, req.UserID, req.AccountID, finmodels.SharingRole.CanManage); err != nil {
+		return finmodels.SharingAccount{}, err
+	}
+
+	query := `
+		WITH s AS (
+			UPDATE sharings
+			SET sharing_role = $3, updated_at = NOW()
+			WHERE account_id = $1 AND user_id = $2 AND sharing_role <> 'owner'
+			RETURNING _id, account_id, user_id, sharing_role, created_at
+		)
+		SELECT ` + sharingColumns + `
+		FROM s
+		JOIN "user" u ON u._id = s.user_id
+	`
+
+	sh, err := scanSharing(r.db.QueryRowContext(ctx, query, req.AccountID, req.MemberID, string(req.Role)))
+	if err != nil {
+		return finmodels.SharingAccount{}, MapPgMemberError(err)
+	}
+	return sh, nil
+}
+
+func (r *PostgresRepository) RemoveAccountMember(ctx context.Context, req finmodels.AccountMemberRequest) (finmodels.SharingAccount, error) {
+	if err := requireAccountRole(ctx, r.db, req.UserID, req.AccountID, finmodels.SharingRole.CanManage); err != nil {
+		return finmodels.SharingAccount{}, err
+	}
+	return r.deleteSharing(ctx, req.AccountID, req.MemberID)
+}
+
+// LeaveAccount удаляет пользователя из участников счета.
+// Владелец должен сначала передать счет другому участнику.
+func (r *PostgresRepository) LeaveAccount(ctx context.Context, userID, accountID int) (finmodels.SharingAccount, error) {
+	role, err := accountRole(ctx, r.db, userID, accountID)
+	if err != nil {
+		return finmodels.SharingAccount{}, err
+	}
+	if role == finmodels.RoleOwner {
+		return finmodels.SharingAccount{}, serviceerrors.ErrOwnerCannotLeave
+	}
+	return r.deleteSharing(ctx, accountID, userID)
+}
+
+func (r *PostgresRepository) deleteSharing(ctx context.Context, accountID, userID int) (finmodels.SharingAccount, error) {
+	query := `
+		WITH s AS (
+			DELETE FROM sharings
+			WHERE account_id = $1 AND user_id = $2 AND sharing_role <> 'owner'
+			RETURNING _id, account_id, user_id, sharing_role, created_at
+		)
+		SELECT ` + sharingColumns + `
+		FROM s
+		JOIN "user" u ON u._id = s.user_id
+	`
+
+	sh, err := scanSharing(r.db.QueryRowContext(ctx, query, accountID, userID))
+	if err != nil {
+		return finmodels.SharingAccount{}, MapPgMemberError(err)
+	}
+	return sh, nil
+}
+
+// GetAccountUserIDs возвращает пользователей, у которых есть доступ к счету.
+func (r *PostgresRepository) GetAccountUserIDs(ctx context.Context, accountID int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_id FROM sharings WHERE account_id = $1 ORDER BY user_id
+	`, accountID)
+	if err != nil {
+		return nil, MapPgAccountError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var users []int
+	for rows.Next() {
+		var userID int
+		if err := rows.Scan(&userID); err != nil {
+			return nil, MapPgAccountError(err)
+		}
+		users = append(users, userID)
+	}
+	return users, rows.Err()
+}
+
+// TransferAccountOwnership делает участника владельцем счета, прежний владелец становится редактором.
+// Прежний владелец понижается первым: у счета не может быть двух владельцев одновременно.
+func (r *PostgresRepository) TransferAccountOwnership(ctx context.Context, req finmodels.AccountMemberRequest) ([]finmodels.SharingAccount, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := requireAccountRole(ctx, tx, req.UserID, req.AccountID, finmodels.SharingRole.CanManage); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE sharings SET sharing_role = 'editor', updated_at = NOW()
+		WHERE account_id = $1 AND user_id = $2
+	`, req.AccountID, req.UserID)
+	if err != nil {
+		return nil, MapPgAccountError(err)
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE sharings SET sharing_role = 'owner', updated_at = NOW()
+		WHERE account_id = $1 AND user_id = $2
+	`, req.AccountID, req.MemberID)
+	if err != nil {
+		return nil, MapPgAccountError(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, serviceerrors.ErrMemberNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, MapPgAccountError(err)
+	}
+
+	return r.GetAccountMembers(ctx, req.UserID, req.AccountID)
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func expectAccountRole(mock sqlmock.Sqlmock, userID, accountID int, role string) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT sharing_role FROM sharings WHERE user_id = $1 AND account_id = $2`)).
+		WithArgs(userID, accountID).
+		WillReturnRows(sqlmock.NewRows([]string{"sharing_role"}).AddRow(role))
+}
+
+var sharingRowColumns = []string{"_id", "account_id", "user_id", "user_login", "sharing_role", "created_at"}
+
+func TestGetAccountMembers(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	expectAccountRole(mock, 2, 5, "viewer")
+	mock.ExpectQuery(`FROM sharings s\s+JOIN "user" u ON u._id = s.user_id\s+WHERE s.account_id = \$1`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(sharingRowColumns).
+			AddRow(1, 5, 1, "owner_login", "owner", now).
+			AddRow(2, 5, 2, "viewer_login", "viewer", now))
+
+	members, err := repo.GetAccountMembers(context.Background(), 2, 5)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	require.Equal(t, finmodels.RoleOwner, members[0].Role)
+	require.Equal(t, "viewer_login", members[1].UserLogin)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAccountMembers_NotMember(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT sharing_role FROM sharings`)).
+		WithArgs(2, 5).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetAccountMembers(context.Background(), 2, 5)
+	require.ErrorIs(t, err, serviceerrors.ErrAccountNotFound)
+}
+
+func TestGetAccountUserIDs(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT user_id FROM sharings WHERE account_id = $1`)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1).AddRow(2))
+
+	users, err := repo.GetAccountUserIDs(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, users)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAccountMemberRole(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	expectAccountRole(mock, 1, 5, "owner")
+	mock.ExpectQuery(`UPDATE sharings\s+SET sharing_role = \$3`).
+		WithArgs(5, 2, "viewer").
+		WillReturnRows(sqlmock.NewRows(sharingRowColumns).AddRow(2, 5, 2, "anna", "viewer", now))
+
+	sh, err := repo.UpdateAccountMemberRole(context.Background(), finmodels.AccountMemberRequest{
+		UserID: 1, AccountID: 5, MemberID: 2, Role: finmodels.RoleViewer,
+	})
+	require.NoError(t, err)
+	require.Equal(t, finmodels.RoleViewer, sh.Role)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAccountMemberRole_Errors(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	req := finmodels.AccountMemberRequest{UserID: 1, AccountID: 5, MemberID: 2, Role: finmodels.RoleViewer}
+
+	expectAccountRole(mock, 1, 5, "editor")
+	_, err := repo.UpdateAccountMemberRole(context.Background(), req)
+	require.ErrorIs(t, err, serviceerrors.ErrForbidden)
+
+	expectAccountRole(mock, 1, 5, "owner")
+	mock.ExpectQuery(`UPDATE sharings`).
+		WithArgs(5, 2, "viewer").
+		WillReturnError(sql.ErrNoRows)
+	_, err = repo.UpdateAccountMemberRole(context.Background(), req)
+	require.ErrorIs(t, err, serviceerrors.ErrMemberNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemoveAccountMember(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	expectAccountRole(mock, 1, 5, "owner")
+	mock.ExpectQuery(`DELETE FROM sharings\s+WHERE account_id = \$1 AND user_id = \$2 AND sharing_role <> 'owner'`).
+		WithArgs(5, 2).
+		WillReturnRows(sqlmock.NewRows(sharingRowColumns).AddRow(2, 5, 2, "anna", "editor", now))
+
+	sh, err := repo.RemoveAccountMember(context.Background(), finmodels.AccountMemberRequest{UserID: 1, AccountID: 5, MemberID: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, sh.UserID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeaveAccount(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	expectAccountRole(mock, 2, 5, "viewer")
+	mock.ExpectQuery(`DELETE FROM sharings`).
+		WithArgs(5, 2).
+		WillReturnRows(sqlmock.NewRows(sharingRowColumns).AddRow(2, 5, 2, "anna", "viewer", now))
+
+	sh, err := repo.LeaveAccount(context.Background(), 2, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, sh.AccountID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeaveAccount_Owner(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	expectAccountRole(mock, 1, 5, "owner")
+
+	_, err := repo.LeaveAccount(context.Background(), 1, 5)
+	require.ErrorIs(t, err, serviceerrors.ErrOwnerCannotLeave)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransferAccountOwnership(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	expectAccountRole(mock, 1, 5, "owner")
+	mock.ExpectExec(`UPDATE sharings SET sharing_role = 'editor'`).
+		WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE sharings SET sharing_role = 'owner'`).
+		WithArgs(5, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	expectAccountRole(mock, 1, 5, "editor")
+	mock.ExpectQuery(`FROM sharings s`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(sharingRowColumns).
+			AddRow(2, 5, 2, "anna", "owner", now).
+			AddRow(1, 5, 1, "ivan", "editor", now))
+
+	members, err := repo.TransferAccountOwnership(context.Background(), finmodels.AccountMemberRequest{UserID: 1, AccountID: 5, MemberID: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, members[0].UserID)
+	require.Equal(t, finmodels.RoleOwner, members[0].Role)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransferAccountOwnership_MemberNotFound(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	expectAccountRole(mock, 1, 5, "owner")
+	mock.ExpectExec(`UPDATE sharings SET sharing_role = 'editor'`).
+		WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE sharings SET sharing_role = 'owner'`).
+		WithArgs(5, 9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err := repo.TransferAccountOwnership(context.Background(), finmodels.AccountMemberRequest{UserID: 1, AccountID: 5, MemberID: 9})
+	require.ErrorIs(t, err, serviceerrors.ErrMemberNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+// matchedExpenses отбирает завершенные расходы пользователя в валюте $3 начиная с даты $5
+// по дату $6 включительно. $2 — категории (с подкатегориями), $4 — счета; пустой массив — все.
+// root_id в scope — запрошенная категория, к которой относится подкатегория.
+// На этом отборе построены и фактические расходы бюджетов, и их прогноз.
+const matchedExpenses = `
+	WITH RECURSIVE scope AS (
+		SELECT _id, _id AS root_id FROM category WHERE user_id = $1 AND _id = ANY($2)
+		UNION
+		SELECT c._id, s.root_id FROM category c JOIN scope s ON c.parent_id = s._id
+	),
+	matched AS (
+		SELECT o.operation_name, o.category_id, o.sum, o.operation_date
+		FROM operation o
+		JOIN sharings sh ON sh.account_id = o.account_from_id AND sh.user_id = $1
+		WHERE o.operation_type = 'expense'
+		  AND o.operation_status != 'reverted'
+		  AND COALESCE(o.currency_id, $3) = $3
+		  AND (cardinality($4::int[]) = 0 OR o.account_from_id = ANY($4))
+		  AND (cardinality($2::int[]) = 0 OR o.category_id IN (SELECT _id FROM scope))
+		  AND o.operation_date >= $5
+		  AND o.operation_date < $6::date + 1
+	)`
+
+func spendingArgs(req finmodels.SpendingStatsRequest, from time.Time) []any {
+	return []any{
+		req.UserID,
+		intArray(req.CategoryIDs),
+		req.CurrencyID,
+		intArray(req.AccountIDs),
+		from,
+		req.End,
+	}
+}
+
+func intArray(ids []int) pq.Int64Array {
+	arr := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		arr = append(arr, int64(id))
+	}
+	return arr
+}
+
+// totalSpendingRoot — root_id строки с общей суммой расходов
+const totalSpendingRoot = 0
+
+// GetSpendingTotals возвращает сумму расходов периода запроса и суммы по запрошенным категориям.
+func (r *PostgresRepository) GetSpendingTotals(ctx context.Context, req finmodels.SpendingStatsRequest) (finmodels.SpendingTotals, error) {
+	query := matchedExpenses + `
+		SELECT 0, COALESCE(SUM(sum), 0) FROM matched
+		UNION ALL
+		SELECT s.root_id, COALESCE(SUM(m.sum), 0)
+		FROM scope s
+		LEFT JOIN matched m ON m.category_id = s._id
+		GROUP BY s.root_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, spendingArgs(req, req.Start)...)
+	if err != nil {
+		return finmodels.SpendingTotals{}, fmt.Errorf("failed to get spending totals: %w", err)
+	}
+	defer rows.Close()
+
+	totals := finmodels.SpendingTotals{ByCategory: make(map[int]float64)}
+	for rows.Next() {
+		var rootID int
+		var sum float64
+		if err := rows.Scan(&rootID, &sum); err != nil {
+			return finmodels.SpendingTotals{}, fmt.Errorf("failed to scan spending totals: %w", err)
+		}
+		if rootID == totalSpendingRoot {
+			totals.Total = sum
+			continue
+		}
+		totals.ByCategory[rootID] = sum
+	}
+	if err := rows.Err(); err != nil {
+		return finmodels.SpendingTotals{}, fmt.Errorf("failed to get spending totals: %w", err)
+	}
+	return totals, nil
+}
+
+// GetDailySpending возвращает суммы расходов по дням периода запроса; дни без расходов пропускаются.
+func (r *PostgresRepository) GetDailySpending(ctx context.Context, req finmodels.SpendingStatsRequest) ([]finmodels.DailySpending, error) {
+	query := matchedExpenses + `
+		SELECT operation_date::date, SUM(sum)
+		FROM matched
+		GROUP BY 1
+		ORDER BY 1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, spendingArgs(req, req.Start)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily spending: %w", err)
+	}
+	defer rows.Close()
+
+	days := []finmodels.DailySpending{}
+	for rows.Next() {
+		var day finmodels.DailySpending
+		if err := rows.Scan(&day.Date, &day.Sum); err != nil {
+			return nil, fmt.Errorf("failed to scan daily spending: %w", err)
+		}
+		days = append(days, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get daily spending: %w", err)
+	}
+	return days, nil
+}
+
+// GetRecurringExpenses ищет с HistoryStart расходы с одинаковым названием и категорией,
+// которые встречались хотя бы в minMonths разных календарных месяцах.
+func (r *PostgresRepository) GetRecurringExpenses(ctx context.Context, req finmodels.SpendingStatsRequest, minMonths int) ([]finmodels.RecurringExpense, error) {
+	query := matchedExpenses + `
+		SELECT
+			MIN(operation_name),
+			COALESCE(category_id, 0),
+			COUNT(DISTINCT date_trunc('month', operation_date)),
+			ROUND(AVG(sum), 2),
+			percentile_disc(0.5) WITHIN GROUP (ORDER BY EXTRACT(DAY FROM operation_date)::int),
+			MAX(operation_date),
+			(ARRAY_AGG(sum ORDER BY operation_date DESC))[1]
+		FROM matched
+		GROUP BY lower(operation_name), category_id
+		HAVING COUNT(DISTINCT date_trunc('month', operation_date)) >= $7
+		ORDER BY 1
+	`
+
+	args := append(spendingArgs(req, req.HistoryStart), minMonths)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recurring expenses: %w", err)
+	}
+	defer rows.Close()
+
+	expenses := []finmodels.RecurringExpense{}
+	for rows.Next() {
+		var e finmodels.RecurringExpense
+		if err := rows.Scan(
+			&e.Name,
+			&e.CategoryID,
+			&e.Months,
+			&e.AvgSum,
+			&e.DayOfMonth,
+			&e.LastDate,
+			&e.LastSum,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan recurring expense: %w", err)
+		}
+		expenses = append(expenses, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get recurring expenses: %w", err)
+	}
+	return expenses, nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func spendingRequest() finmodels.SpendingStatsRequest {
+	return finmodels.SpendingStatsRequest{
+		UserID:       1,
+		CurrencyID:   2,
+		CategoryIDs:  []int{10},
+		Start:        time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		End:          time.Date(2030, 6, 10, 0, 0, 0, 0, time.UTC),
+		HistoryStart: time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestGetSpendingTotals(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	req := spendingRequest()
+	req.CategoryIDs = []int{3, 5}
+	req.AccountIDs = []int{10}
+	mock.ExpectQuery(`LEFT JOIN matched m ON m.category_id = s._id`).
+		WithArgs(1, pq.Int64Array{3, 5}, 2, pq.Int64Array{10}, req.Start, req.End).
+		WillReturnRows(sqlmock.NewRows([]string{"root_id", "sum"}).
+			AddRow(0, 150.5).
+			AddRow(3, 100.5).
+			AddRow(5, 50.0))
+
+	totals, err := repo.GetSpendingTotals(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 150.5, totals.Total)
+	require.Equal(t, map[int]float64{3: 100.5, 5: 50}, totals.ByCategory)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSpendingTotals_QueryError(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`WITH RECURSIVE scope`).WillReturnError(errors.New("db down"))
+
+	_, err := repo.GetSpendingTotals(context.Background(), spendingRequest())
+	require.ErrorContains(t, err, "failed to get spending totals")
+}
+
+func TestGetDailySpending(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	req := spendingRequest()
+	mock.ExpectQuery(`GROUP BY 1`).
+		WithArgs(1, pq.Int64Array{10}, 2, pq.Int64Array{}, req.Start, req.End).
+		WillReturnRows(sqlmock.NewRows([]string{"date", "sum"}).
+			AddRow(time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC), 540.0).
+			AddRow(time.Date(2030, 6, 5, 0, 0, 0, 0, time.UTC), 1200.5))
+
+	days, err := repo.GetDailySpending(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, days, 2)
+	require.Equal(t, 540.0, days[0].Sum)
+	require.Equal(t, 5, days[1].Date.Day())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDailySpending_QueryError(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`GROUP BY 1`).WillReturnError(errors.New("db down"))
+
+	_, err := repo.GetDailySpending(context.Background(), spendingRequest())
+	require.Error(t, err)
+}
+
+func TestGetRecurringExpenses(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	req := spendingRequest()
+	last := time.Date(2030, 5, 15, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`HAVING COUNT\(DISTINCT date_trunc`).
+		WithArgs(1, pq.Int64Array{10}, 2, pq.Int64Array{}, req.HistoryStart, req.End, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "category_id", "months", "avg", "day", "last_date", "last_sum"}).
+			AddRow("Аренда", 10, 3, 30000.0, 15, last, 30000.0))
+
+	expenses, err := repo.GetRecurringExpenses(context.Background(), req, 2)
+	require.NoError(t, err)
+	require.Equal(t, []finmodels.RecurringExpense{{
+		Name:       "Аренда",
+		CategoryID: 10,
+		Months:     3,
+		AvgSum:     30000,
+		DayOfMonth: 15,
+		LastDate:   last,
+		LastSum:    30000,
+	}}, expenses)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRecurringExpenses_QueryError(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`HAVING COUNT\(DISTINCT date_trunc`).WillReturnError(errors.New("db down"))
+
+	_, err := repo.GetRecurringExpenses(context.Background(), spendingRequest(), 2)
+	require.Error(t, err)
+}
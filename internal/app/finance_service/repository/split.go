@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+// Строка на каждую долю; разделение собирается из подряд идущих строк
+const splitSelect = `
+	SELECT sp._id, sp.operation_id, sp.account_id, sp.payer_id, payer.user_login,
+	       sp.split_method, o.sum, sp.created_at,
+	       sh.user_id, u.user_login, sh.share_value
+	FROM operation_split sp
+	JOIN operation o ON o._id = sp.operation_id
+	JOIN "user" payer ON payer._id = sp.payer_id
+	JOIN operation_split_share sh ON sh.split_id = sp._id
+	JOIN "user" u ON u._id = sh.user_id
+	JOIN sharings s ON s.account_id = sp.account_id
+`
+
+const settlementColumns = `
+	st._id, st.account_id, st.payer_id, payer.user_login, st.payee_id, payee.user_login,
+	st.amount, st.settlement_description, COALESCE(st.created_by, 0), st.created_at
+`
+
+const settlementJoins = `
+	JOIN "user" payer ON payer._id = st.payer_id
+	JOIN "user" payee ON payee._id = st.payee_id
+`
+
+func MapPgSplitError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return serviceerrors.ErrSplitNotFound
+	}
+	return MapPgOperationError(err)
+}
+
+func scanSettlement(row rowScanner) (finmodels.Settlement, error) {
+	var st finmodels.Settlement
+	err := row.Scan(
+		&st.ID,
+		&st.AccountID,
+		&st.PayerID,
+		&st.PayerLogin,
+		&st.PayeeID,
+		&st.PayeeLogin,
+		&st.Amount,
+		&st.Description,
+		&st.CreatedBy,
+		&st.CreatedAt,
+	)
+	return st, err
+}
+
+func (r *PostgresRepository) querySplits(ctx context.Context, query string, args ...any) ([]finmodels.OperationSplit, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, MapPgOperationError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var splits []finmodels.OperationSplit
+	for rows.Next() {
+		var split finmodels.OperationSplit
+		var method string
+		var share finmodels.SplitShare
+		err := rows.Scan(
+			&split.ID,
+			&split.OperationID,
+			&split.AccountID,
+			&split.PayerID,
+			&split.PayerLogin,
+			&method,
+			&split.Total,
+			&split.CreatedAt,
+			&share.UserID,
+			&share.UserLogin,
+			&share.Value,
+		)
+		if err != nil {
+			return nil, MapPgOperationError(err)
+		}
+		split.Method = finmodels.SplitMethod(method)
+
+		if n := len(splits); n > 0 && splits[n-1].ID == split.ID {
+			splits[n-1].Shares = append(splits[n-1].Shares, share)
+			continue
+		}
+		split.Shares = []finmodels.SplitShare{share}
+		splits = append(splits, split)
+	}
+
+	return splits, rows.Err()
+}
+
+// GetOperationSplit возвращает разделение операции. Суммы долей не вычисляются.
+func (r *PostgresRepository) GetOperationSplit(ctx context.Context, userID, accID, opID int) (finmodels.OperationSplit, error) {
+	splits, err := r.querySplits(ctx, splitSelect+`
+		WHERE s.user_id = $1 AND sp.account_id = $2 AND sp.operation_id = $3
+		ORDER BY sp._id, sh.user_id
+	`, userID, accID, opID)
+	if err != nil {
+		return finmodels.OperationSplit{}, err
+	}
+	if len(splits) == 0 {
+		return finmodels.OperationSplit{}, serviceerrors.ErrSplitNotFound
+	}
+	return splits[0], nil
+}
+
+// GetAccountSplits возвращает разделения неотмененных операций счета
+func (r *PostgresRepository) GetAccountSplits(ctx context.Context, userID, accountID int) ([]finmodels.OperationSplit, error) {
+	return r.querySplits(ctx, splitSelect+`
+		WHERE s.user_id = $1 AND sp.account_id = $2 AND o.operation_status != 'reverted'
+		ORDER BY sp._id, sh.user_id
+	`, userID, accountID)
+}
+
+// SaveOperationSplit сохраняет разделение операции, заменяя прежнее.
+// Разделять операции может участник, которому разрешено их вести.
+func (r *PostgresRepository) SaveOperationSplit(ctx context.Context, userID int, split finmodels.OperationSplit) (finmodels.OperationSplit, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return finmodels.OperationSplit{}, err
+	}
+	defer tx.Rollback()
+
+	if err := requireAccountRole(ctx, tx, userID, split.AccountID, finmodels.SharingRole.CanEditOperations); err != nil {
+		return finmodels.OperationSplit{}, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM operation_split WHERE operation_id = $1`, split.OperationID); err != nil {
+		return finmodels.OperationSplit{}, MapPgOperationError(err)
+	}
+
+	var splitID int
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO operation_split (operation_id, account_id, payer_id, split_method)
+		VALUES ($1, $2, $3, $4)
+		RETURNING _id
+	`, split.OperationID, split.AccountID, split.PayerID, string(split.Method)).Scan(&splitID)
+	if err != nil {
+		return finmodels.OperationSplit{}, MapPgOperationError(err)
+	}
+
+	for _, share := range split.Shares {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO operation_split_share (split_id, user_id, share_value)
+			VALUES ($1, $2, $3)
+		`, splitID, share.UserID, share.Value)
+		if err != nil {
+			return finmodels.OperationSplit{}, MapPgOperationError(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return finmodels.OperationSplit{}, MapPgOperationError(err)
+	}
+
+	return r.GetOperationSplit(ctx, userID, split.AccountID, split.OperationID)
+}
+
+func (r *PostgresRepository) DeleteOperationSplit(ctx context.Context, userID, accID, opID int) (finmodels.OperationSplit, error) {
+	split, err := r.GetOperationSplit(ctx, userID, accID, opID)
+	if err != nil {
+		return finmodels.OperationSplit{}, err
+	}
+
+	if err := requireAccountRole(ctx, r.db, userID, accID, finmodels.SharingRole.CanEditOperations); err != nil {
+		return finmodels.OperationSplit{}, err
+	}
+
+	_, err = r.db.ExecContext(ctx, `DELETE FROM operation_split WHERE _id = $1`, split.ID)
+	if err != nil {
+		return finmodels.OperationSplit{}, MapPgOperationError(err)
+	}
+	return split, nil
+}
+
+// CreateSettlement записывает погашение долга между участниками счета
+func (r *PostgresRepository) CreateSettlement(ctx context.Context, st finmodels.Settlement) (finmodels.Settlement, error) {
+	query := `
+		WITH st AS (
+			INSERT INTO settlement
+			(account_id, payer_id, payee_id, amount, settlement_description, created_by)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING *
+		)
+		SELECT ` + settlementColumns + `
+		FROM st
+	` + settlementJoins
+
+	created, err := scanSettlement(r.db.QueryRowContext(ctx, query,
+		st.AccountID, st.PayerID, st.PayeeID, st.Amount, st.Description, st.CreatedBy))
+	if err != nil {
+		return finmodels.Settlement{}, MapPgOperationError(err)
+	}
+	return created, nil
+}
+
+func (r *PostgresRepository) GetSettlements(ctx context.Context, userID, accountID int) ([]finmodels.Settlement, error) {
+	query := `SELECT ` + settlementColumns + `
+		FROM settlement st
+	` + settlementJoins + `
+		JOIN sharings s ON s.account_id = st.account_id
+		WHERE s.user_id = $1 AND st.account_id = $2
+		ORDER BY st.created_at DESC, st._id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, accountID)
+	if err != nil {
+		return nil, MapPgOperationError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var settlements []finmodels.Settlement
+	for rows.Next() {
+		st, err := scanSettlement(rows)
+		if err != nil {
+			return nil, MapPgOperationError(err)
+		}
+		settlements = append(settlements, st)
+	}
+
+	return settlements, rows.Err()
+}
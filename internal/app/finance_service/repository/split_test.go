@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+var splitRowColumns = []string{
+	"_id", "operation_id", "account_id", "payer_id", "payer_login",
+	"split_method", "sum", "created_at", "user_id", "user_login", "share_value",
+}
+
+func TestGetAccountSplits_GroupsShares(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	mock.ExpectQuery(`WHERE s.user_id = \$1 AND sp.account_id = \$2 AND o.operation_status != 'reverted'`).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows(splitRowColumns).
+			AddRow(1, 10, 3, 1, "ivan", "equal", 90.0, now, 1, "ivan", 1.0).
+			AddRow(1, 10, 3, 1, "ivan", "equal", 90.0, now, 2, "anna", 1.0).
+			AddRow(2, 11, 3, 2, "anna", "exact", 50.0, now, 1, "ivan", 50.0))
+
+	splits, err := repo.GetAccountSplits(context.Background(), 1, 3)
+	require.NoError(t, err)
+	require.Len(t, splits, 2)
+	require.Len(t, splits[0].Shares, 2)
+	require.Equal(t, finmodels.SplitExact, splits[1].Method)
+	require.Equal(t, "ivan", splits[1].Shares[0].UserLogin)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOperationSplit_NotFound(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectQuery(`sp.operation_id = \$3`).
+		WithArgs(1, 3, 10).
+		WillReturnRows(sqlmock.NewRows(splitRowColumns))
+
+	_, err := repo.GetOperationSplit(context.Background(), 1, 3, 10)
+	require.ErrorIs(t, err, serviceerrors.ErrSplitNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveOperationSplit(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	expectAccountRole(mock, 1, 3, "editor")
+	mock.ExpectExec(`DELETE FROM operation_split WHERE operation_id = \$1`).
+		WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO operation_split`).
+		WithArgs(10, 3, 1, "percent").
+		WillReturnRows(sqlmock.NewRows([]string{"_id"}).AddRow(4))
+	mock.ExpectExec(`INSERT INTO operation_split_share`).
+		WithArgs(4, 1, 25.0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO operation_split_share`).
+		WithArgs(4, 2, 75.0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`sp.operation_id = \$3`).
+		WithArgs(1, 3, 10).
+		WillReturnRows(sqlmock.NewRows(splitRowColumns).
+			AddRow(4, 10, 3, 1, "ivan", "percent", 200.0, now, 1, "ivan", 25.0).
+			AddRow(4, 10, 3, 1, "ivan", "percent", 200.0, now, 2, "anna", 75.0))
+
+	split, err := repo.SaveOperationSplit(context.Background(), 1, finmodels.OperationSplit{
+		OperationID: 10, AccountID: 3, PayerID: 1, Method: finmodels.SplitPercent,
+		Shares: []finmodels.SplitShare{{UserID: 1, Value: 25}, {UserID: 2, Value: 75}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, split.ID)
+	require.Equal(t, 200.0, split.Total)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveOperationSplit_Viewer(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectBegin()
+	expectAccountRole(mock, 1, 3, "viewer")
+	mock.ExpectRollback()
+
+	_, err := repo.SaveOperationSplit(context.Background(), 1, finmodels.OperationSplit{OperationID: 10, AccountID: 3})
+	require.ErrorIs(t, err, serviceerrors.ErrForbidden)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateSettlement(t *testing.T) {
+	repo, mock, close := setupDB(t)
+	defer close()
+
+	mock.ExpectQuery(`INSERT INTO settlement`).
+		WithArgs(3, 2, 1, 30.0, "за ужин", 2).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"_id", "account_id", "payer_id", "payer_login", "payee_id", "payee_login",
+			"amount", "settlement_description", "created_by", "created_at",
+		}).AddRow(5, 3, 2, "anna", 1, "ivan", 30.0, "за ужин", 2, time.Now()))
+
+	st, err := repo.CreateSettlement(context.Background(), finmodels.Settlement{
+		AccountID: 3, PayerID: 2, PayeeID: 1, Amount: 30, Description: "за ужин", CreatedBy: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, st.ID)
+	require.Equal(t, "ivan", st.PayeeLogin)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
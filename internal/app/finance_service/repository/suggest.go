@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+// GetCategorizedOperations возвращает последние операции пользователя с его категориями —
+// обучающую выборку для подсказки категорий.
+func (r *PostgresRepository) GetCategorizedOperations(ctx context.Context, userID, limit int) ([]finmodels.Operation, error) {
+	query := `
+		SELECT o.operation_name, o.sum, o.category_id
+		FROM operation o
+		JOIN category c ON o.category_id = c._id
+		JOIN sharings s ON s.account_id = o.account_from_id
+		WHERE s.user_id = $1
+		  AND c.user_id = $1
+		  AND o.operation_status != 'reverted'
+		ORDER BY o.operation_date DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return nil, MapPgOperationError(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var operations []finmodels.Operation
+	for rows.Next() {
+		var op finmodels.Operation
+		if err := rows.Scan(&op.Name, &op.Sum, &op.CategoryID); err != nil {
+			return nil, MapPgOperationError(err)
+		}
+		operations = append(operations, op)
+	}
+
+	return operations, rows.Err()
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCategorizedOperations(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`FROM operation o`).
+		WithArgs(1, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"operation_name", "sum", "category_id"}).
+			AddRow("Пятерочка", 540.0, 3).
+			AddRow("Яндекс Такси", 320.0, 4))
+
+	ops, err := repo.GetCategorizedOperations(context.Background(), 1, 100)
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	require.Equal(t, "Пятерочка", ops[0].Name)
+	require.Equal(t, 4, ops[1].CategoryID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCategorizedOperations_QueryError(t *testing.T) {
+	repo, mock, close := setupPostgresDB(t)
+	defer close()
+
+	mock.ExpectQuery(`FROM operation o`).
+		WithArgs(1, 100).
+		WillReturnError(errors.New("db down"))
+
+	_, err := repo.GetCategorizedOperations(context.Background(), 1, 100)
+	require.Error(t, err)
+}
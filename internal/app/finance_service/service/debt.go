@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+const (
+	DebtStatusOpen   = "open"
+	DebtStatusClosed = "closed"
+
+	maxReceiverNameLen    = 60
+	maxDebtDescriptionLen = 100
+	maxOperationNameLen   = 50
+)
+
+// debtOperationName название операции по долгу, например "Возврат долга: Иван".
+// Обрезается до допустимой длины названия операции.
+func debtOperationName(debt finmodels.Debt, kind finmodels.DebtPaymentKind) string {
+	var prefix string
+	switch {
+	case kind == finmodels.DebtPaymentPrincipal && debt.Direction == finmodels.DebtLent:
+		prefix = "Выдан долг: "
+	case kind == finmodels.DebtPaymentPrincipal:
+		prefix = "Получен долг: "
+	case debt.Direction == finmodels.DebtLent:
+		prefix = "Возврат долга: "
+	default:
+		prefix = "Погашение долга: "
+	}
+
+	name := []rune(prefix + debt.ReceiverName)
+	if len(name) > maxOperationNameLen {
+		name = name[:maxOperationNameLen]
+	}
+	return string(name)
+}
+
+// CounterpartyBalances суммирует непогашенные долги по контрагентам и валютам.
+func CounterpartyBalances(debts []finmodels.Debt) map[int][]finmodels.CounterpartyBalance {
+	type key struct{ receiverID, currencyID int }
+	totals := make(map[key]*finmodels.CounterpartyBalance)
+	var order []key
+
+	for _, debt := range debts {
+		outstanding := debt.Outstanding()
+		if outstanding == 0 {
+			continue
+		}
+		k := key{debt.ReceiverID, debt.CurrencyID}
+		balance, ok := totals[k]
+		if !ok {
+			balance = &finmodels.CounterpartyBalance{CurrencyID: debt.CurrencyID}
+			totals[k] = balance
+			order = append(order, k)
+		}
+		if debt.Direction == finmodels.DebtLent {
+			balance.Lent += outstanding
+		} else {
+			balance.Borrowed += outstanding
+		}
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		if order[i].receiverID != order[j].receiverID {
+			return order[i].receiverID < order[j].receiverID
+		}
+		return order[i].currencyID < order[j].currencyID
+	})
+
+	res := make(map[int][]finmodels.CounterpartyBalance)
+	for _, k := range order {
+		res[k.receiverID] = append(res[k.receiverID], *totals[k])
+	}
+	return res
+}
+
+func validateCreateDebt(req finmodels.CreateDebtRequest) error {
+	if req.Direction != finmodels.DebtLent && req.Direction != finmodels.DebtBorrowed {
+		return serviceerrors.ErrInvalidData
+	}
+	if req.Principal <= 0 || req.AccountID <= 0 || utf8.RuneCountInString(req.Description) > maxDebtDescriptionLen {
+		return serviceerrors.ErrInvalidData
+	}
+
+	counterparties := 0
+	if req.ReceiverID > 0 {
+		counterparties++
+	}
+	if strings.TrimSpace(req.ReceiverName) != "" {
+		counterparties++
+	}
+	if req.CounterpartyLogin != "" {
+		counterparties++
+	}
+	if counterparties != 1 {
+		return serviceerrors.ErrInvalidData
+	}
+	return nil
+}
+
+// resolveCounterparty находит получателя долга. Для зарегистрированного пользователя
+// используется получатель с его логином, связанный с этим пользователем.
+func (s *Service) resolveCounterparty(ctx context.Context, req finmodels.CreateDebtRequest) (finmodels.Receiver, error) {
+	if req.ReceiverID > 0 {
+		return s.repo.GetReceiver(ctx, req.UserID, req.ReceiverID)
+	}
+
+	if req.CounterpartyLogin != "" {
+		counterpartyID, err := s.repo.GetUserIDByLogin(ctx, req.CounterpartyLogin)
+		if err != nil {
+			return finmodels.Receiver{}, err
+		}
+		if counterpartyID == req.UserID {
+			return finmodels.Receiver{}, serviceerrors.ErrInvalidData
+		}
+		return s.repo.UpsertReceiver(ctx, finmodels.Receiver{
+			UserID:             req.UserID,
+			Name:               req.CounterpartyLogin,
+			CounterpartyUserID: counterpartyID,
+		})
+	}
+
+	name := strings.TrimSpace(req.ReceiverName)
+	if utf8.RuneCountInString(name) > maxReceiverNameLen {
+		return finmodels.Receiver{}, serviceerrors.ErrInvalidData
+	}
+	return s.repo.UpsertReceiver(ctx, finmodels.Receiver{UserID: req.UserID, Name: name})
+}
+
+func (s *Service) GetCounterparties(ctx context.Context, userID int) (*finpb.ListCounterpartiesResponse, error) {
+	receivers, err := s.repo.GetCounterparties(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	debts, err := s.repo.GetDebtsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := CounterpartyBalances(debts)
+	counterparties := make([]finmodels.Counterparty, 0, len(receivers))
+	for _, rcv := range receivers {
+		counterparties = append(counterparties, finmodels.Counterparty{Receiver: rcv, Balances: balances[rcv.ID]})
+	}
+	return CounterpartiesToProto(counterparties), nil
+}
+
+func (s *Service) GetDebts(ctx context.Context, userID int, status string) (*finpb.ListDebtsResponse, error) {
+	if status != "" && status != DebtStatusOpen && status != DebtStatusClosed {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	debts, err := s.repo.GetDebtsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]finmodels.Debt, 0, len(debts))
+	for _, debt := range debts {
+		if status == DebtStatusOpen && debt.Closed() || status == DebtStatusClosed && !debt.Closed() {
+			continue
+		}
+		filtered = append(filtered, debt)
+	}
+	return DebtsToProto(filtered, s.clock.Now()), nil
+}
+
+func (s *Service) GetDebt(ctx context.Context, userID, debtID int) (*finpb.Debt, error) {
+	debt, err := s.repo.GetDebt(ctx, userID, debtID)
+	if err != nil {
+		return nil, err
+	}
+	return DebtToProto(debt, s.clock.Now()), nil
+}
+
+// CreateDebt создает операцию выдачи (получения) долга обычным путем создания операции,
+// затем сохраняет долг. Если долг сохранить не удалось, операция отменяется.
+func (s *Service) CreateDebt(ctx context.Context, req finmodels.CreateDebtRequest) (*finpb.Debt, error) {
+	if err := validateCreateDebt(req); err != nil {
+		return nil, err
+	}
+
+	account, err := s.repo.GetAccountByID(ctx, req.UserID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := s.resolveCounterparty(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	debt := finmodels.Debt{
+		UserID:       req.UserID,
+		ReceiverID:   receiver.ID,
+		ReceiverName: receiver.Name,
+		Direction:    req.Direction,
+		AccountID:    account.ID,
+		CurrencyID:   account.CurrencyID,
+		Principal:    req.Principal,
+		Description:  req.Description,
+		DueDate:      req.DueDate,
+	}
+
+	op, err := s.CreateOperation(ctx, finmodels.CreateOperationRequest{
+		UserID:      req.UserID,
+		AccountID:   account.ID,
+		Type:        debt.PrincipalType(),
+		Name:        debtOperationName(debt, finmodels.DebtPaymentPrincipal),
+		Description: req.Description,
+		Sum:         req.Principal,
+		Date:        req.Date,
+	}, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.CreateDebt(ctx, debt, int(op.Id))
+	if err != nil {
+		_, _ = s.repo.DeleteOperation(ctx, req.UserID, account.ID, int(op.Id))
+		return nil, err
+	}
+	return DebtToProto(created, s.clock.Now()), nil
+}
+
+func (s *Service) DeleteDebt(ctx context.Context, userID, debtID int) (*finpb.Debt, error) {
+	debt, err := s.repo.GetDebt(ctx, userID, debtID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteDebt(ctx, userID, debtID); err != nil {
+		return nil, err
+	}
+	return DebtToProto(debt, s.clock.Now()), nil
+}
+
+// AddDebtRepayment создает операцию возврата на счете долга (или другом счете в той же валюте)
+// и связывает ее с долгом. Сумма возврата не может превышать остаток долга: здесь остаток
+// проверяется заранее, чтобы не создавать лишнюю операцию, а окончательно — в репозитории
+// под блокировкой долга. Если возврат не прошел, операция удаляется.
+func (s *Service) AddDebtRepayment(ctx context.Context, req finmodels.CreateDebtRepaymentRequest) (*finpb.DebtPayment, error) {
+	debt, err := s.repo.GetDebt(ctx, req.UserID, req.DebtID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Amount <= 0 || req.Amount > debt.Outstanding() {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	accountID := req.AccountID
+	if accountID == 0 {
+		accountID = debt.AccountID
+	}
+	if accountID <= 0 {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	account, err := s.repo.GetAccountByID(ctx, req.UserID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.CurrencyID != debt.CurrencyID {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	op, err := s.CreateOperation(ctx, finmodels.CreateOperationRequest{
+		UserID:      req.UserID,
+		AccountID:   account.ID,
+		Type:        debt.RepaymentType(),
+		Name:        debtOperationName(debt, finmodels.DebtPaymentRepayment),
+		Description: debt.Description,
+		Sum:         req.Amount,
+		Date:        req.Date,
+	}, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := s.repo.AddDebtPayment(ctx, finmodels.DebtPayment{
+		DebtID:      debt.ID,
+		OperationID: int(op.Id),
+		Kind:        finmodels.DebtPaymentRepayment,
+		Amount:      req.Amount,
+	})
+	if err != nil {
+		_, _ = s.repo.DeleteOperation(ctx, req.UserID, account.ID, int(op.Id))
+		return nil, err
+	}
+	return DebtPaymentToProto(payment), nil
+}
+
+func (s *Service) GetDebtPayments(ctx context.Context, userID, debtID int) (*finpb.ListDebtPaymentsResponse, error) {
+	if _, err := s.repo.GetDebt(ctx, userID, debtID); err != nil {
+		return nil, err
+	}
+
+	payments, err := s.repo.GetDebtPayments(ctx, debtID)
+	if err != nil {
+		return nil, err
+	}
+	return DebtPaymentsToProto(payments), nil
+}
+
+// debtOverdue просрочен ли непогашенный долг на дату today
+func debtOverdue(debt finmodels.Debt, today time.Time) bool {
+	if debt.DueDate == nil || debt.Closed() {
+		return false
+	}
+	y, m, d := today.Date()
+	return debt.DueDate.Before(time.Date(y, m, d, 0, 0, 0, 0, debt.DueDate.Location()))
+}
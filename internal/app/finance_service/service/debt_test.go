@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	finerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func testDebt() models.Debt {
+	return models.Debt{
+		ID:           5,
+		UserID:       1,
+		ReceiverID:   3,
+		ReceiverName: "Иван",
+		Direction:    models.DebtLent,
+		AccountID:    7,
+		CurrencyID:   1,
+		Principal:    10000,
+		Repaid:       2500,
+	}
+}
+
+func TestCounterpartyBalances(t *testing.T) {
+	debts := []models.Debt{
+		{ReceiverID: 3, CurrencyID: 1, Direction: models.DebtLent, Principal: 10000, Repaid: 2500},
+		{ReceiverID: 3, CurrencyID: 1, Direction: models.DebtBorrowed, Principal: 3000},
+		{ReceiverID: 3, CurrencyID: 2, Direction: models.DebtLent, Principal: 100},
+		{ReceiverID: 4, CurrencyID: 1, Direction: models.DebtLent, Principal: 500, Repaid: 500},
+	}
+
+	balances := CounterpartyBalances(debts)
+	require.Len(t, balances[3], 2)
+	require.Equal(t, models.CounterpartyBalance{CurrencyID: 1, Lent: 7500, Borrowed: 3000}, balances[3][0])
+	require.Equal(t, 4500.0, balances[3][0].Net())
+	require.Equal(t, 2, balances[3][1].CurrencyID)
+	require.Empty(t, balances[4], "погашенные долги не попадают в баланс")
+}
+
+func TestDebtOperationName(t *testing.T) {
+	debt := testDebt()
+	require.Equal(t, "Выдан долг: Иван", debtOperationName(debt, models.DebtPaymentPrincipal))
+	require.Equal(t, "Возврат долга: Иван", debtOperationName(debt, models.DebtPaymentRepayment))
+
+	debt.Direction = models.DebtBorrowed
+	require.Equal(t, "Получен долг: Иван", debtOperationName(debt, models.DebtPaymentPrincipal))
+	require.Equal(t, "Погашение долга: Иван", debtOperationName(debt, models.DebtPaymentRepayment))
+
+	debt.ReceiverName = strings.Repeat("я", 60)
+	require.Equal(t, maxOperationNameLen, utf8.RuneCountInString(debtOperationName(debt, models.DebtPaymentPrincipal)))
+}
+
+func TestDebtOverdue(t *testing.T) {
+	today := time.Date(2025, 10, 22, 19, 0, 0, 0, time.UTC)
+	debt := testDebt()
+	require.False(t, debtOverdue(debt, today))
+
+	due := time.Date(2025, 10, 22, 0, 0, 0, 0, time.UTC)
+	debt.DueDate = &due
+	require.False(t, debtOverdue(debt, today), "в день срока долг еще не просрочен")
+
+	due = time.Date(2025, 10, 21, 0, 0, 0, 0, time.UTC)
+	require.True(t, debtOverdue(debt, today))
+
+	debt.Repaid = debt.Principal
+	require.False(t, debtOverdue(debt, today))
+}
+
+func TestCreateDebt_Lent(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetAccountByID(ctx, 1, 7).Return(models.Account{ID: 7, CurrencyID: 2}, nil)
+	mockRepo.EXPECT().UpsertReceiver(ctx, models.Receiver{UserID: 1, Name: "Иван"}).
+		Return(models.Receiver{ID: 3, UserID: 1, Name: "Иван"}, nil)
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil)
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, op models.Operation) (models.Operation, error) {
+		require.Equal(t, models.OperationExpense, op.Type)
+		require.Equal(t, "Выдан долг: Иван", op.Name)
+		require.Equal(t, 10000.0, op.Sum)
+		require.Equal(t, 7, op.AccountID)
+		op.ID = 40
+		return op, nil
+	})
+	mockRepo.EXPECT().CreateDebt(ctx, gomock.Any(), 40).DoAndReturn(func(_ context.Context, debt models.Debt, _ int) (models.Debt, error) {
+		require.Equal(t, 3, debt.ReceiverID)
+		require.Equal(t, 2, debt.CurrencyID)
+		debt.ID = 5
+		return debt, nil
+	})
+
+	res, err := svc.CreateDebt(ctx, models.CreateDebtRequest{
+		UserID:       1,
+		ReceiverName: " Иван ",
+		Direction:    models.DebtLent,
+		AccountID:    7,
+		Principal:    10000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), res.Id)
+	require.Equal(t, 10000.0, res.Outstanding)
+	require.False(t, res.Closed)
+}
+
+func TestCreateDebt_RegisteredUser(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetAccountByID(ctx, 1, 7).Return(models.Account{ID: 7, CurrencyID: 1}, nil)
+	mockRepo.EXPECT().GetUserIDByLogin(ctx, "petr").Return(2, nil)
+	mockRepo.EXPECT().UpsertReceiver(ctx, models.Receiver{UserID: 1, Name: "petr", CounterpartyUserID: 2}).
+		Return(models.Receiver{ID: 8, UserID: 1, Name: "petr", CounterpartyUserID: 2}, nil)
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil)
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, op models.Operation) (models.Operation, error) {
+		require.Equal(t, models.OperationIncome, op.Type)
+		op.ID = 41
+		return op, nil
+	})
+	mockRepo.EXPECT().CreateDebt(ctx, gomock.Any(), 41).DoAndReturn(func(_ context.Context, debt models.Debt, _ int) (models.Debt, error) {
+		debt.ID = 6
+		debt.CounterpartyUserID = 2
+		return debt, nil
+	})
+
+	res, err := svc.CreateDebt(ctx, models.CreateDebtRequest{
+		UserID:            1,
+		CounterpartyLogin: "petr",
+		Direction:         models.DebtBorrowed,
+		AccountID:         7,
+		Principal:         500,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), res.CounterpartyUserId)
+}
+
+func TestCreateDebt_Validation(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	base := models.CreateDebtRequest{UserID: 1, ReceiverName: "Иван", Direction: models.DebtLent, AccountID: 7, Principal: 100}
+
+	twoCounterparties := base
+	twoCounterparties.ReceiverID = 3
+	_, err := svc.CreateDebt(ctx, twoCounterparties)
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+
+	badDirection := base
+	badDirection.Direction = "gift"
+	_, err = svc.CreateDebt(ctx, badDirection)
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+
+	self := base
+	self.ReceiverName = ""
+	self.CounterpartyLogin = "me"
+	mockRepo.EXPECT().GetAccountByID(ctx, 1, 7).Return(models.Account{ID: 7, CurrencyID: 1}, nil)
+	mockRepo.EXPECT().GetUserIDByLogin(ctx, "me").Return(1, nil)
+	_, err = svc.CreateDebt(ctx, self)
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+}
+
+func TestCreateDebt_RevertsOperationOnFailure(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+	dbErr := errors.New("db down")
+
+	mockRepo.EXPECT().GetAccountByID(ctx, 1, 7).Return(models.Account{ID: 7, CurrencyID: 1}, nil)
+	mockRepo.EXPECT().GetReceiver(ctx, 1, 3).Return(models.Receiver{ID: 3, Name: "Иван"}, nil)
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil)
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).Return(models.Operation{ID: 40}, nil)
+	mockRepo.EXPECT().CreateDebt(ctx, gomock.Any(), 40).Return(models.Debt{}, dbErr)
+	mockRepo.EXPECT().DeleteOperation(ctx, 1, 7, 40).Return(models.Operation{}, nil)
+
+	_, err := svc.CreateDebt(ctx, models.CreateDebtRequest{UserID: 1, ReceiverID: 3, Direction: models.DebtLent, AccountID: 7, Principal: 100})
+	require.ErrorIs(t, err, dbErr)
+}
+
+func TestAddDebtRepayment(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetDebt(ctx, 1, 5).Return(testDebt(), nil)
+	mockRepo.EXPECT().GetAccountByID(ctx, 1, 7).Return(models.Account{ID: 7, CurrencyID: 1}, nil)
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil)
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, op models.Operation) (models.Operation, error) {
+		require.Equal(t, models.OperationIncome, op.Type)
+		require.Equal(t, "Возврат долга: Иван", op.Name)
+		op.ID = 42
+		return op, nil
+	})
+	mockRepo.EXPECT().AddDebtPayment(ctx, models.DebtPayment{DebtID: 5, OperationID: 42, Kind: models.DebtPaymentRepayment, Amount: 7500}).
+		Return(models.DebtPayment{ID: 9, DebtID: 5, OperationID: 42, Kind: models.DebtPaymentRepayment, Amount: 7500, AccountID: 7}, nil)
+
+	res, err := svc.AddDebtRepayment(ctx, models.CreateDebtRepaymentRequest{UserID: 1, DebtID: 5, Amount: 7500})
+	require.NoError(t, err)
+	require.Equal(t, "repayment", res.Kind)
+	require.Equal(t, int32(7), res.AccountId)
+}
+
+func TestAddDebtRepayment_ConcurrentOverpayment(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	// Остаток уже погашен параллельным возвратом: репозиторий отклоняет платеж, операция удаляется
+	mockRepo.EXPECT().GetDebt(ctx, 1, 5).Return(testDebt(), nil)
+	mockRepo.EXPECT().GetAccountByID(ctx, 1, 7).Return(models.Account{ID: 7, CurrencyID: 1}, nil)
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil)
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).Return(models.Operation{ID: 42}, nil)
+	mockRepo.EXPECT().AddDebtPayment(ctx, gomock.Any()).Return(models.DebtPayment{}, finerrors.ErrInvalidData)
+	mockRepo.EXPECT().DeleteOperation(ctx, 1, 7, 42).Return(models.Operation{}, nil)
+
+	_, err := svc.AddDebtRepayment(ctx, models.CreateDebtRepaymentRequest{UserID: 1, DebtID: 5, Amount: 7500})
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+}
+
+func TestAddDebtRepayment_Invalid(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetDebt(ctx, 1, 5).Return(testDebt(), nil).Times(2)
+
+	_, err := svc.AddDebtRepayment(ctx, models.CreateDebtRepaymentRequest{UserID: 1, DebtID: 5, Amount: 7500.01})
+	require.ErrorIs(t, err, finerrors.ErrInvalidData, "возврат больше остатка")
+
+	mockRepo.EXPECT().GetAccountByID(ctx, 1, 8).Return(models.Account{ID: 8, CurrencyID: 2}, nil)
+	_, err = svc.AddDebtRepayment(ctx, models.CreateDebtRepaymentRequest{UserID: 1, DebtID: 5, AccountID: 8, Amount: 100})
+	require.ErrorIs(t, err, finerrors.ErrInvalidData, "счет в другой валюте")
+}
+
+func TestGetDebts_FilterByStatus(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	closed := testDebt()
+	closed.ID = 6
+	closed.Repaid = closed.Principal
+	mockRepo.EXPECT().GetDebtsByUser(ctx, 1).Return([]models.Debt{testDebt(), closed}, nil).Times(2)
+
+	open, err := svc.GetDebts(ctx, 1, DebtStatusOpen)
+	require.NoError(t, err)
+	require.Len(t, open.Debts, 1)
+	require.Equal(t, int32(5), open.Debts[0].Id)
+
+	all, err := svc.GetDebts(ctx, 1, "")
+	require.NoError(t, err)
+	require.Len(t, all.Debts, 2)
+	require.True(t, all.Debts[1].Closed)
+
+	_, err = svc.GetDebts(ctx, 1, "paid")
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+}
+
+func TestGetCounterparties(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCounterparties(ctx, 1).Return([]models.Receiver{{ID: 3, Name: "Иван"}, {ID: 4, Name: "Магазин"}}, nil)
+	mockRepo.EXPECT().GetDebtsByUser(ctx, 1).Return([]models.Debt{testDebt()}, nil)
+
+	res, err := svc.GetCounterparties(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, res.Counterparties, 2)
+	require.Equal(t, 7500.0, res.Counterparties[0].Balances[0].Net)
+	require.Empty(t, res.Counterparties[1].Balances)
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/defaults"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+// SetDefaultCategoryPack заменяет встроенный набор категорий по умолчанию.
+func (s *Service) SetDefaultCategoryPack(pack *defaults.Pack) {
+	s.defaultCategories = pack
+}
+
+func (s *Service) ProvisionDefaultCategories(ctx context.Context, userID int, locale string, overwrite bool) (*finpb.ListCategoriesResponse, error) {
+	pack := s.defaultCategories.Categories(locale)
+
+	categories := make([]finmodels.Category, 0, len(pack))
+	for _, ctg := range pack {
+		categories = append(categories, finmodels.Category{
+			UserID:       userID,
+			Name:         ctg.Name,
+			Description:  ctg.Description,
+			LogoHashedID: ctg.LogoHashedID,
+		})
+	}
+
+	provisioned, err := s.repo.ProvisionCategories(ctx, userID, categories, overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &finpb.ListCategoriesResponse{Categories: make([]*finpb.Category, 0, len(provisioned))}
+	for _, ctg := range provisioned {
+		resp.Categories = append(resp.Categories, CategoryToProto(ctg))
+	}
+	return resp, nil
+}
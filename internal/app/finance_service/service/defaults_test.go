@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/defaults"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func testDefaultPack(t *testing.T) *defaults.Pack {
+	t.Helper()
+	pack, err := defaults.Parse(fstest.MapFS{
+		"pack.json": {Data: []byte(`{"default_locale":"ru","categories":[
+			{"name":{"ru":"Продукты","en":"Groceries"},"description":{"ru":"Магазины","en":"Stores"},"icon":"food.svg"},
+			{"name":{"ru":"Транспорт"}}
+		]}`)},
+		"food.svg": {Data: []byte("<svg/>")},
+	}, "pack.json")
+	require.NoError(t, err)
+	return pack
+}
+
+func TestProvisionDefaultCategories(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	svc.SetDefaultCategoryPack(testDefaultPack(t))
+	ctx := context.Background()
+
+	iconID := defaults.ImageID([]byte("<svg/>"))
+	mockRepo.EXPECT().
+		ProvisionCategories(ctx, 1, []models.Category{
+			{UserID: 1, Name: "Groceries", Description: "Stores", LogoHashedID: iconID},
+			{UserID: 1, Name: "Транспорт"},
+		}, false).
+		Return([]models.Category{{ID: 5, UserID: 1, Name: "Groceries", LogoHashedID: iconID}}, nil)
+
+	res, err := svc.ProvisionDefaultCategories(ctx, 1, "en-US", false)
+	require.NoError(t, err)
+	require.Len(t, res.Categories, 1)
+	require.Equal(t, int32(5), res.Categories[0].Id)
+	require.Equal(t, iconID, res.Categories[0].LogoHashedId)
+}
+
+func TestProvisionDefaultCategories_Overwrite(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().
+		ProvisionCategories(ctx, 1, gomock.Len(len(defaults.Builtin().Categories(""))), true).
+		Return(nil, errors.New("db down"))
+
+	_, err := svc.ProvisionDefaultCategories(ctx, 1, "", true)
+	require.Error(t, err)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+// DeleteUserData удаляет данные пользователя при удалении аккаунта.
+// Операции удаляемых счетов убираются из индекса до удаления из базы:
+// если индекс недоступен, повторная попытка найдет те же счета.
+func (s *Service) DeleteUserData(ctx context.Context, userID int) (*finpb.UserDataDeletion, error) {
+	sole, err := s.repo.GetSoleAccountIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.deleteAccountDocuments(ctx, sole); err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.DeleteUserData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// остальные участники могли выйти из счета уже после первой выборки
+	var late []int
+	for _, id := range res.DeletedAccountIDs {
+		if !slices.Contains(sole, id) {
+			late = append(late, id)
+		}
+	}
+	if err := s.deleteAccountDocuments(ctx, late); err != nil {
+		return nil, err
+	}
+
+	return UserDataDeletionToProto(res), nil
+}
+
+// deleteAccountDocuments удаляет из индекса операции счетов
+func (s *Service) deleteAccountDocuments(ctx context.Context, accountIDs []int) error {
+	if len(accountIDs) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"query": map[string]any{
+			"terms": map[string]any{"account_id": accountIDs},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := s.es.DeleteByQuery(
+		[]string{"transactions"},
+		bytes.NewReader(body),
+		s.es.DeleteByQuery.WithContext(ctx),
+		s.es.DeleteByQuery.WithConflicts("proceed"),
+		s.es.DeleteByQuery.WithRefresh(true),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// индекса еще нет — удалять нечего
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete operations from index: %s", res.Status())
+	}
+	return nil
+}
+
+func (s *Service) FilterUsedImages(ctx context.Context, ids []string) (*finpb.ImageIDs, error) {
+	if len(ids) == 0 {
+		return &finpb.ImageIDs{}, nil
+	}
+	used, err := s.repo.FilterUsedImages(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return &finpb.ImageIDs{Ids: used}, nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	mock_repo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+// newDeletionTestService сервис с индексом, который записывает тела запросов на удаление
+func newDeletionTestService(t *testing.T, status int) (*Service, *mock_repo.MockFinanceRepository, *[]string) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, r.URL.Path+" "+string(body))
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	es, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{srv.URL}})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	return NewService(mockRepo, es, clock.FixedClock{FixedTime: time.Now()}), mockRepo, &bodies
+}
+
+func TestDeleteUserData(t *testing.T) {
+	svc, mockRepo, bodies := newDeletionTestService(t, http.StatusOK)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetSoleAccountIDs(ctx, 1).Return([]int{3}, nil)
+	// счет 4 остался без других участников уже после выборки
+	mockRepo.EXPECT().DeleteUserData(ctx, 1).
+		Return(models.UserDataDeletion{DeletedAccountIDs: []int{3, 4}, LeftAccountIDs: []int{5}}, nil)
+
+	res, err := svc.DeleteUserData(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []int32{3, 4}, res.GetDeletedAccountIds())
+	require.Equal(t, []int32{5}, res.GetLeftAccountIds())
+	require.Equal(t, []string{
+		`/transactions/_delete_by_query {"query":{"terms":{"account_id":[3]}}}`,
+		`/transactions/_delete_by_query {"query":{"terms":{"account_id":[4]}}}`,
+	}, *bodies)
+}
+
+func TestDeleteUserData_IndexUnavailable(t *testing.T) {
+	svc, mockRepo, _ := newDeletionTestService(t, http.StatusServiceUnavailable)
+	ctx := context.Background()
+
+	// из базы ничего не удаляется, пока операции остаются в индексе
+	mockRepo.EXPECT().GetSoleAccountIDs(ctx, 1).Return([]int{3}, nil)
+
+	_, err := svc.DeleteUserData(ctx, 1)
+	require.Error(t, err)
+}
+
+func TestDeleteUserData_NoIndex(t *testing.T) {
+	svc, mockRepo, _ := newDeletionTestService(t, http.StatusNotFound)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetSoleAccountIDs(ctx, 1).Return([]int{3}, nil)
+	mockRepo.EXPECT().DeleteUserData(ctx, 1).Return(models.UserDataDeletion{DeletedAccountIDs: []int{3}}, nil)
+
+	_, err := svc.DeleteUserData(ctx, 1)
+	require.NoError(t, err)
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func (s *Service) checkParentExists(ctx context.Context, userID, parentID int) error {
+	if _, err := s.repo.GetCategoryByID(ctx, userID, parentID); err != nil {
+		if errors.Is(err, serviceerrors.ErrCategoryNotFound) {
+			return serviceerrors.ErrParentNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// checkCategoryParent проверяет, что родитель принадлежит пользователю
+// и перенос категории не замыкает иерархию в цикл.
+func (s *Service) checkCategoryParent(ctx context.Context, userID, categoryID, parentID int) error {
+	categories, err := s.repo.GetCategoriesByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	parents := finmodels.CategoryParents(categories)
+	if _, ok := parents[parentID]; !ok {
+		return serviceerrors.ErrParentNotFound
+	}
+	if finmodels.CreatesCategoryCycle(parents, categoryID, parentID) {
+		return serviceerrors.ErrCategoryCycle
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	finerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	mock_repo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// Транспорт(1) → Такси(2) → Бизнес(4); Транспорт(1) → Метро(3); Еда(5)
+func testCategoryTree() []models.Category {
+	return []models.Category{
+		{ID: 1, UserID: 1, Name: "Транспорт"},
+		{ID: 2, UserID: 1, Name: "Такси", ParentID: intPtr(1)},
+		{ID: 3, UserID: 1, Name: "Метро", ParentID: intPtr(1)},
+		{ID: 4, UserID: 1, Name: "Бизнес", ParentID: intPtr(2)},
+		{ID: 5, UserID: 1, Name: "Еда"},
+	}
+}
+
+func newHierarchyTestService(t *testing.T) (*Service, *mock_repo.MockFinanceRepository) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	return NewService(mockRepo, nil, clock.FixedClock{FixedTime: time.Date(2025, 10, 22, 19, 0, 0, 0, time.UTC)}), mockRepo
+}
+
+func TestCreatesCategoryCycle(t *testing.T) {
+	parents := models.CategoryParents(testCategoryTree())
+
+	require.True(t, models.CreatesCategoryCycle(parents, 1, 1))
+	require.True(t, models.CreatesCategoryCycle(parents, 1, 4))
+	require.True(t, models.CreatesCategoryCycle(parents, 2, 4))
+	require.False(t, models.CreatesCategoryCycle(parents, 4, 3))
+	require.False(t, models.CreatesCategoryCycle(parents, 5, 1))
+	require.Equal(t, []int{2, 1}, models.CategoryAncestors(parents, 4))
+}
+
+func TestCategoryAncestors_BrokenCycle(t *testing.T) {
+	parents := map[int]int{1: 2, 2: 1}
+	require.Equal(t, []int{2}, models.CategoryAncestors(parents, 1))
+}
+
+func TestCreateCategory_WithParent(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 1).Return(testCategoryTree()[0], nil)
+	mockRepo.EXPECT().CreateCategory(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c models.Category) (models.Category, error) {
+		require.Equal(t, 1, *c.ParentID)
+		c.ID = 6
+		return c, nil
+	})
+
+	res, err := svc.CreateCategory(ctx, models.CreateCategoryRequest{UserID: 1, Name: "Электрички", ParentID: intPtr(1)})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res.ParentId)
+}
+
+func TestCreateCategory_ParentNotFound(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 42).Return(models.Category{}, finerrors.ErrCategoryNotFound)
+
+	_, err := svc.CreateCategory(ctx, models.CreateCategoryRequest{UserID: 1, Name: "Электрички", ParentID: intPtr(42)})
+	require.ErrorIs(t, err, finerrors.ErrParentNotFound)
+}
+
+func TestUpdateCategory_RejectsCycle(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoriesByUser(ctx, 1).Return(testCategoryTree(), nil)
+
+	_, err := svc.UpdateCategory(ctx, models.Category{ID: 1, UserID: 1, ParentID: intPtr(4)})
+	require.ErrorIs(t, err, finerrors.ErrCategoryCycle)
+}
+
+func TestUpdateCategory_MoveToRootSkipsChecks(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+	category := models.Category{ID: 2, UserID: 1, ParentID: intPtr(0)}
+
+	mockRepo.EXPECT().UpdateCategory(ctx, category).Return(nil)
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 2).Return(models.Category{ID: 2, UserID: 1, Name: "Такси"}, nil)
+
+	res, err := svc.UpdateCategory(ctx, category)
+	require.NoError(t, err)
+	require.Zero(t, res.ParentId)
+}
+
+func TestGetCategoriesWithStatsByUser_RollsUp(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	tree := testCategoryTree()
+	counts := []int{1, 4, 2, 3, 7}
+	stats := make([]models.CategoryWithStats, 0, len(tree))
+	for i, ctg := range tree {
+		stats = append(stats, models.CategoryWithStats{Category: ctg, OperationsCount: counts[i]})
+	}
+
+	mockRepo.EXPECT().GetCategoriesWithStatsByUser(ctx, 1).Return(stats, nil)
+
+	res, err := svc.GetCategoriesWithStatsByUser(ctx, 1)
+	require.NoError(t, err)
+
+	got := map[int32][2]int32{}
+	for _, c := range res.Categories {
+		got[c.Category.Id] = [2]int32{c.OperationsCount, c.OwnOperationsCount}
+	}
+	require.Equal(t, [2]int32{10, 1}, got[1])
+	require.Equal(t, [2]int32{7, 4}, got[2])
+	require.Equal(t, [2]int32{2, 2}, got[3])
+	require.Equal(t, [2]int32{3, 3}, got[4])
+	require.Equal(t, [2]int32{7, 7}, got[5])
+}
+
+func TestGetCategoriesReport_RollsUp(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+	start, end := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().GetCategoriesReport(ctx, 1, start, end).Return([]models.CategoryInReport{
+		{CategoryID: 1, CategoryName: "Транспорт", OperationCount: 1, TotalSum: 100},
+		{CategoryID: 2, ParentID: 1, CategoryName: "Такси", OperationCount: 2, TotalSum: 900},
+		{CategoryID: 3, ParentID: 1, CategoryName: "Метро", OperationCount: 10, TotalSum: 600},
+	}, nil)
+
+	res, err := svc.GetCategoriesReport(ctx, models.CategoryReportRequest{UserID: 1, Start: start, End: end})
+	require.NoError(t, err)
+	require.Len(t, res.Categories, 3)
+
+	root := res.Categories[0]
+	require.Equal(t, int32(13), root.OperationsCount)
+	require.Equal(t, 1600.0, root.TotalSum)
+	require.Equal(t, int32(1), root.OwnOperationsCount)
+	require.Equal(t, 100.0, root.OwnSum)
+
+	taxi := res.Categories[1]
+	require.Equal(t, int32(1), taxi.ParentId)
+	require.Equal(t, 900.0, taxi.TotalSum)
+	require.Equal(t, 900.0, taxi.OwnSum)
+}
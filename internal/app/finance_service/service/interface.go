@@ -15,14 +15,39 @@ type FinanceRepository interface {
 	UpdateAccount(ctx context.Context, req finmodels.UpdateAccountRequest) (finmodels.Account, error)
 	DeleteAccount(ctx context.Context, userID, accID int) (finmodels.Account, error)
 	UpdateAccountBalance(ctx context.Context, accountID int, newBalance float64) error
-	AddUserToAccount(ctx context.Context, userLogin string, accountID int) (finmodels.SharingAccount, error)
+
+	// Account member methods
+	GetAccountMembers(ctx context.Context, userID, accountID int) ([]finmodels.SharingAccount, error)
+	UpdateAccountMemberRole(ctx context.Context, req finmodels.AccountMemberRequest) (finmodels.SharingAccount, error)
+	RemoveAccountMember(ctx context.Context, req finmodels.AccountMemberRequest) (finmodels.SharingAccount, error)
+	LeaveAccount(ctx context.Context, userID, accountID int) (finmodels.SharingAccount, error)
+	TransferAccountOwnership(ctx context.Context, req finmodels.AccountMemberRequest) ([]finmodels.SharingAccount, error)
+	GetAccountUserIDs(ctx context.Context, accountID int) ([]int, error)
+
+	// Account invitation methods
+	CreateAccountInvitation(ctx context.Context, inv finmodels.AccountInvitation) (finmodels.AccountInvitation, error)
+	GetPendingInvitations(ctx context.Context, userID int) ([]finmodels.AccountInvitation, error)
+	GetAccountInvitations(ctx context.Context, userID, accountID int) ([]finmodels.AccountInvitation, error)
+	AcceptAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (finmodels.SharingAccount, error)
+	AcceptInvitationByToken(ctx context.Context, userID int, tokenHash string) (finmodels.SharingAccount, error)
+	DeclineAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (finmodels.AccountInvitation, error)
+	RevokeAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (finmodels.AccountInvitation, error)
+
+	// Expense splitting methods
+	GetOperationSplit(ctx context.Context, userID, accID, opID int) (finmodels.OperationSplit, error)
+	GetAccountSplits(ctx context.Context, userID, accountID int) ([]finmodels.OperationSplit, error)
+	SaveOperationSplit(ctx context.Context, userID int, split finmodels.OperationSplit) (finmodels.OperationSplit, error)
+	DeleteOperationSplit(ctx context.Context, userID, accID, opID int) (finmodels.OperationSplit, error)
+	CreateSettlement(ctx context.Context, st finmodels.Settlement) (finmodels.Settlement, error)
+	GetSettlements(ctx context.Context, userID, accountID int) ([]finmodels.Settlement, error)
 
 	// Operation methods
-	GetOperationsByAccount(ctx context.Context, accountID int) ([]finmodels.OperationInList, error)
-	GetOperationByID(ctx context.Context, accID int, opID int) (finmodels.Operation, error)
-	CreateOperation(ctx context.Context, op finmodels.Operation) (finmodels.Operation, error)
+	GetOperationsByAccount(ctx context.Context, userID, accountID int) ([]finmodels.OperationInList, error)
+	GetOperationByID(ctx context.Context, userID, accID int, opID int) (finmodels.Operation, error)
+	CreateOperation(ctx context.Context, userID int, op finmodels.Operation) (finmodels.Operation, error)
 	UpdateOperation(ctx context.Context, req finmodels.UpdateOperationRequest, accID int, opID int) (finmodels.Operation, error)
-	DeleteOperation(ctx context.Context, accID int, opID int) (finmodels.Operation, error)
+	DeleteOperation(ctx context.Context, userID, accID int, opID int) (finmodels.Operation, error)
+	GetOperationsByIDs(ctx context.Context, userID int, opIDs []int) ([]finmodels.Operation, error)
 
 	// Category methods
 	CreateCategory(ctx context.Context, category finmodels.Category) (finmodels.Category, error)
@@ -34,4 +59,45 @@ type FinanceRepository interface {
 	DeleteCategory(ctx context.Context, userID, categoryID int) error
 	GetCategoryStats(ctx context.Context, userID, categoryID int) (int, error)
 	GetCategoriesReport(ctx context.Context, userID int, start, end time.Time) ([]finmodels.CategoryInReport, error)
+
+	// Backup methods
+	GetOperationsByUser(ctx context.Context, userID int) ([]finmodels.Operation, error)
+	GetReceiversByUser(ctx context.Context, userID int) ([]finmodels.Receiver, error)
+	GetSharingsByOwner(ctx context.Context, userID int) ([]finmodels.SharingAccount, error)
+	ImportUserData(ctx context.Context, req finmodels.ImportUserDataRequest) (finmodels.ImportUserDataResult, error)
+	GetSoleAccountIDs(ctx context.Context, userID int) ([]int, error)
+	DeleteUserData(ctx context.Context, userID int) (finmodels.UserDataDeletion, error)
+	FilterUsedImages(ctx context.Context, ids []string) ([]string, error)
+
+	// Category rule methods
+	CreateCategoryRule(ctx context.Context, rule finmodels.CategoryRule) (finmodels.CategoryRule, error)
+	GetCategoryRulesByUser(ctx context.Context, userID int) ([]finmodels.CategoryRule, error)
+	UpdateCategoryRule(ctx context.Context, rule finmodels.CategoryRule) (finmodels.CategoryRule, error)
+	DeleteCategoryRule(ctx context.Context, userID, ruleID int) (finmodels.CategoryRule, error)
+	ReorderCategoryRules(ctx context.Context, userID int, ruleIDs []int) error
+	GetOperationsForRules(ctx context.Context, userID int, onlyUncategorized bool) ([]finmodels.Operation, error)
+	SetOperationsCategory(ctx context.Context, ops []finmodels.Operation) error
+
+	// Category suggestion methods
+	GetCategorizedOperations(ctx context.Context, userID, limit int) ([]finmodels.Operation, error)
+
+	MergeCategories(ctx context.Context, req finmodels.MergeCategoriesRequest) (int, error)
+	ProvisionCategories(ctx context.Context, userID int, categories []finmodels.Category, overwrite bool) ([]finmodels.Category, error)
+
+	// Statistics methods
+	GetDailySpending(ctx context.Context, req finmodels.SpendingStatsRequest) ([]finmodels.DailySpending, error)
+	GetSpendingTotals(ctx context.Context, req finmodels.SpendingStatsRequest) (finmodels.SpendingTotals, error)
+	GetRecurringExpenses(ctx context.Context, req finmodels.SpendingStatsRequest, minMonths int) ([]finmodels.RecurringExpense, error)
+
+	// Debt methods
+	GetUserIDByLogin(ctx context.Context, login string) (int, error)
+	GetCounterparties(ctx context.Context, userID int) ([]finmodels.Receiver, error)
+	GetReceiver(ctx context.Context, userID, receiverID int) (finmodels.Receiver, error)
+	UpsertReceiver(ctx context.Context, rcv finmodels.Receiver) (finmodels.Receiver, error)
+	GetDebtsByUser(ctx context.Context, userID int) ([]finmodels.Debt, error)
+	GetDebt(ctx context.Context, userID, debtID int) (finmodels.Debt, error)
+	CreateDebt(ctx context.Context, debt finmodels.Debt, operationID int) (finmodels.Debt, error)
+	DeleteDebt(ctx context.Context, userID, debtID int) error
+	AddDebtPayment(ctx context.Context, payment finmodels.DebtPayment) (finmodels.DebtPayment, error)
+	GetDebtPayments(ctx context.Context, debtID int) ([]finmodels.DebtPayment, error)
 }
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+const (
+	invitationTTL        = 7 * 24 * time.Hour
+	invitationTokenBytes = 32
+)
+
+// newInvitationToken возвращает токен ссылки-приглашения и его хеш для хранения в базе
+func newInvitationToken() (string, string, error) {
+	b := make([]byte, invitationTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashInvitationToken(token), nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateAccountInvitation приглашает пользователя по логину или, если логин пуст,
+// создает одноразовую ссылку. Роль приглашенного — editor (по умолчанию) или viewer.
+func (s *Service) CreateAccountInvitation(ctx context.Context, req finmodels.CreateInvitationRequest) (*finpb.AccountInvitation, error) {
+	if req.Role == "" {
+		req.Role = finmodels.RoleEditor
+	}
+	if req.Role != finmodels.RoleEditor && req.Role != finmodels.RoleViewer {
+		return nil, serviceerrors.ErrInvalidData
+	}
+	if req.AccountID <= 0 {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	inv := finmodels.AccountInvitation{
+		AccountID: req.AccountID,
+		InviterID: req.UserID,
+		Role:      req.Role,
+		ExpiresAt: s.clock.Now().Add(invitationTTL),
+	}
+
+	if login := strings.TrimSpace(req.UserLogin); login != "" {
+		inviteeID, err := s.repo.GetUserIDByLogin(ctx, login)
+		if err != nil {
+			return nil, err
+		}
+		inv.InviteeID = inviteeID
+	} else {
+		token, hash, err := newInvitationToken()
+		if err != nil {
+			return nil, err
+		}
+		inv.Token = token
+		inv.TokenHash = hash
+	}
+
+	created, err := s.repo.CreateAccountInvitation(ctx, inv)
+	if err != nil {
+		return nil, err
+	}
+	return InvitationToProto(created), nil
+}
+
+func (s *Service) GetPendingInvitations(ctx context.Context, userID int) (*finpb.ListAccountInvitationsResponse, error) {
+	invitations, err := s.repo.GetPendingInvitations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return InvitationsToProto(invitations), nil
+}
+
+func (s *Service) GetAccountInvitations(ctx context.Context, userID, accountID int) (*finpb.ListAccountInvitationsResponse, error) {
+	invitations, err := s.repo.GetAccountInvitations(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return InvitationsToProto(invitations), nil
+}
+
+func (s *Service) AcceptAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.SharingsResponse, error) {
+	sh, err := s.repo.AcceptAccountInvitation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return SharingToProto(sh), nil
+}
+
+func (s *Service) AcceptInvitationLink(ctx context.Context, userID int, token string) (*finpb.SharingsResponse, error) {
+	if token == "" {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	sh, err := s.repo.AcceptInvitationByToken(ctx, userID, hashInvitationToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return SharingToProto(sh), nil
+}
+
+func (s *Service) DeclineAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.AccountInvitation, error) {
+	inv, err := s.repo.DeclineAccountInvitation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return InvitationToProto(inv), nil
+}
+
+func (s *Service) RevokeAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.AccountInvitation, error) {
+	inv, err := s.repo.RevokeAccountInvitation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return InvitationToProto(inv), nil
+}
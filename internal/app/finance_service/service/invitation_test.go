@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func TestCreateAccountInvitation_ByLogin(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+	expires := time.Date(2025, 10, 29, 19, 0, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().GetUserIDByLogin(ctx, "anna").Return(8, nil)
+	mockRepo.EXPECT().CreateAccountInvitation(ctx, models.AccountInvitation{
+		AccountID: 3, InviterID: 1, InviteeID: 8, Role: models.RoleEditor, ExpiresAt: expires,
+	}).Return(models.AccountInvitation{
+		ID: 5, AccountID: 3, InviterID: 1, InviteeID: 8, InviteeLogin: "anna",
+		Role: models.RoleEditor, Status: models.InvitationPending, ExpiresAt: expires,
+	}, nil)
+
+	resp, err := svc.CreateAccountInvitation(ctx, models.CreateInvitationRequest{UserID: 1, AccountID: 3, UserLogin: " anna "})
+	require.NoError(t, err)
+	require.Equal(t, "editor", resp.Role)
+	require.Empty(t, resp.Token)
+	require.Equal(t, expires, resp.ExpiresAt.AsTime())
+}
+
+func TestCreateAccountInvitation_Link(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	var stored models.AccountInvitation
+	mockRepo.EXPECT().CreateAccountInvitation(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, inv models.AccountInvitation) (models.AccountInvitation, error) {
+			stored = inv
+			return inv, nil
+		})
+
+	resp, err := svc.CreateAccountInvitation(ctx, models.CreateInvitationRequest{UserID: 1, AccountID: 3, Role: models.RoleViewer})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Token)
+	require.Zero(t, stored.InviteeID)
+	// в базу попадает только хеш токена
+	require.Equal(t, hashInvitationToken(resp.Token), stored.TokenHash)
+	require.NotEqual(t, resp.Token, stored.TokenHash)
+}
+
+func TestCreateAccountInvitation_InvalidRole(t *testing.T) {
+	svc, _ := newSuggestTestService(t)
+
+	_, err := svc.CreateAccountInvitation(context.Background(), models.CreateInvitationRequest{
+		UserID: 1, AccountID: 3, UserLogin: "anna", Role: models.RoleOwner,
+	})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+}
+
+func TestAcceptInvitationLink(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	_, err := svc.AcceptInvitationLink(ctx, 8, "")
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+
+	mockRepo.EXPECT().AcceptInvitationByToken(ctx, 8, hashInvitationToken("raw")).
+		Return(models.SharingAccount{ID: 12, UserID: 8, AccountID: 3, Role: models.RoleViewer}, nil)
+
+	resp, err := svc.AcceptInvitationLink(ctx, 8, "raw")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), resp.AccountId)
+}
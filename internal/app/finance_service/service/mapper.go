@@ -22,6 +22,7 @@ func accountToProto(account finmodels.Account) *finpb.Account {
 		CurrencyId:  int32(account.CurrencyID),
 		CreatedAt:   timestamppb.New(account.CreatedAt),
 		UpdatedAt:   timestamppb.New(account.UpdatedAt),
+		Role:        string(account.Role),
 	}
 }
 
@@ -29,6 +30,7 @@ func operationToProto(operation finmodels.Operation) *finpb.Operation {
 	return &finpb.Operation{
 		Id:           int32(operation.ID),
 		AccountId:    int32(operation.AccountID),
+		AccountToId:  int32(operation.AccountToID),
 		CategoryId:   int32(operation.CategoryID),
 		CategoryName: operation.CategoryName,
 		Type:         string(operation.Type),
@@ -45,6 +47,11 @@ func operationToProto(operation finmodels.Operation) *finpb.Operation {
 }
 
 func CategoryToProto(category finmodels.Category) *finpb.Category {
+	var parentID int32
+	if category.ParentID != nil {
+		parentID = int32(*category.ParentID)
+	}
+
 	return &finpb.Category{
 		Id:           int32(category.ID),
 		UserId:       int32(category.UserID),
@@ -54,13 +61,23 @@ func CategoryToProto(category finmodels.Category) *finpb.Category {
 		LogoUrl:      category.LogoURL,
 		CreatedAt:    timestamppb.New(category.CreatedAt),
 		UpdatedAt:    timestamppb.New(category.UpdatedAt),
+		ParentId:     parentID,
 	}
 }
 
 func CategoryWithStatsToProto(category finmodels.Category, operationsCount int) *finpb.CategoryWithStats {
 	return &finpb.CategoryWithStats{
-		Category:        CategoryToProto(category),
-		OperationsCount: int32(operationsCount),
+		Category:           CategoryToProto(category),
+		OperationsCount:    int32(operationsCount),
+		OwnOperationsCount: int32(operationsCount),
+	}
+}
+
+func RolledUpCategoryWithStatsToProto(category finmodels.CategoryWithStats) *finpb.CategoryWithStats {
+	return &finpb.CategoryWithStats{
+		Category:           CategoryToProto(category.Category),
+		OperationsCount:    int32(category.OperationsCount),
+		OwnOperationsCount: int32(category.OwnOperationsCount),
 	}
 }
 
@@ -89,6 +106,109 @@ func SharingToProto(sh finmodels.SharingAccount) *finpb.SharingsResponse {
 		UserId:    int32(sh.UserID),
 		AccountId: int32(sh.AccountID),
 		CreatedAt: timestamppb.New(sh.CreatedAt),
+		Role:      string(sh.Role),
+		UserLogin: sh.UserLogin,
+	}
+}
+
+func AccountMembersToProto(members []finmodels.SharingAccount) *finpb.ListAccountMembersResponse {
+	resp := &finpb.ListAccountMembersResponse{
+		Members: make([]*finpb.SharingsResponse, 0, len(members)),
+	}
+	for _, sh := range members {
+		resp.Members = append(resp.Members, SharingToProto(sh))
+	}
+	return resp
+}
+
+func InvitationToProto(inv finmodels.AccountInvitation) *finpb.AccountInvitation {
+	return &finpb.AccountInvitation{
+		Id:           int32(inv.ID),
+		AccountId:    int32(inv.AccountID),
+		AccountName:  inv.AccountName,
+		InviterId:    int32(inv.InviterID),
+		InviterLogin: inv.InviterLogin,
+		InviteeId:    int32(inv.InviteeID),
+		InviteeLogin: inv.InviteeLogin,
+		Role:         string(inv.Role),
+		Status:       string(inv.Status),
+		Token:        inv.Token,
+		ExpiresAt:    timestamppb.New(inv.ExpiresAt),
+		CreatedAt:    timestamppb.New(inv.CreatedAt),
+	}
+}
+
+func InvitationsToProto(invitations []finmodels.AccountInvitation) *finpb.ListAccountInvitationsResponse {
+	resp := &finpb.ListAccountInvitationsResponse{
+		Invitations: make([]*finpb.AccountInvitation, 0, len(invitations)),
+	}
+	for _, inv := range invitations {
+		resp.Invitations = append(resp.Invitations, InvitationToProto(inv))
+	}
+	return resp
+}
+
+func OperationSplitToProto(split finmodels.OperationSplit) *finpb.OperationSplit {
+	shares := make([]*finpb.SplitShare, 0, len(split.Shares))
+	for _, share := range split.Shares {
+		shares = append(shares, &finpb.SplitShare{
+			UserId:    int32(share.UserID),
+			UserLogin: share.UserLogin,
+			Value:     share.Value,
+			Amount:    share.Amount,
+		})
+	}
+	return &finpb.OperationSplit{
+		Id:          int32(split.ID),
+		OperationId: int32(split.OperationID),
+		AccountId:   int32(split.AccountID),
+		PayerId:     int32(split.PayerID),
+		PayerLogin:  split.PayerLogin,
+		Method:      string(split.Method),
+		Total:       split.Total,
+		Shares:      shares,
+		CreatedAt:   timestamppb.New(split.CreatedAt),
+	}
+}
+
+func AccountBalancesToProto(balances finmodels.AccountBalances) *finpb.AccountBalances {
+	resp := &finpb.AccountBalances{
+		AccountId:  int32(balances.AccountID),
+		CurrencyId: int32(balances.CurrencyID),
+		Members:    make([]*finpb.MemberBalance, 0, len(balances.Members)),
+		Debts:      make([]*finpb.PairBalance, 0, len(balances.Debts)),
+	}
+	for _, m := range balances.Members {
+		resp.Members = append(resp.Members, &finpb.MemberBalance{
+			UserId:    int32(m.UserID),
+			UserLogin: m.UserLogin,
+			Net:       m.Net,
+		})
+	}
+	for _, d := range balances.Debts {
+		resp.Debts = append(resp.Debts, &finpb.PairBalance{
+			DebtorId:      int32(d.DebtorID),
+			DebtorLogin:   d.DebtorLogin,
+			CreditorId:    int32(d.CreditorID),
+			CreditorLogin: d.CreditorLogin,
+			Amount:        d.Amount,
+		})
+	}
+	return resp
+}
+
+func SettlementToProto(st finmodels.Settlement) *finpb.Settlement {
+	return &finpb.Settlement{
+		Id:          int32(st.ID),
+		AccountId:   int32(st.AccountID),
+		PayerId:     int32(st.PayerID),
+		PayerLogin:  st.PayerLogin,
+		PayeeId:     int32(st.PayeeID),
+		PayeeLogin:  st.PayeeLogin,
+		Amount:      st.Amount,
+		Description: st.Description,
+		CreatedBy:   int32(st.CreatedBy),
+		CreatedAt:   timestamppb.New(st.CreatedAt),
 	}
 }
 
@@ -109,12 +229,224 @@ func ReportToProto(ctgs []finmodels.CategoryInReport, start, end time.Time) *fin
 
 	for _, c := range ctgs {
 		resp.Categories = append(resp.Categories, &finpb.CategoryInReport{
-			CategoryId:      int32(c.CategoryID),
-			CategoryName:    c.CategoryName,
-			OperationsCount: int32(c.OperationCount),
-			TotalSum:        c.TotalSum,
+			CategoryId:         int32(c.CategoryID),
+			ParentId:           int32(c.ParentID),
+			CategoryName:       c.CategoryName,
+			OperationsCount:    int32(c.OperationCount),
+			TotalSum:           c.TotalSum,
+			OwnOperationsCount: int32(c.OwnOperationCount),
+			OwnSum:             c.OwnSum,
 		})
 	}
 
 	return resp
 }
+
+func receiverToProto(rcv finmodels.Receiver) *finpb.Receiver {
+	return &finpb.Receiver{
+		Id:                 int32(rcv.ID),
+		Name:               rcv.Name,
+		LogoHashedId:       rcv.LogoHashedID,
+		CreatedAt:          timestamppb.New(rcv.CreatedAt),
+		CounterpartyUserId: int32(rcv.CounterpartyUserID),
+	}
+}
+
+func UserDataToProto(data finmodels.UserData) *finpb.UserDataExport {
+	resp := &finpb.UserDataExport{
+		Accounts:   make([]*finpb.Account, 0, len(data.Accounts)),
+		Categories: make([]*finpb.Category, 0, len(data.Categories)),
+		Operations: make([]*finpb.Operation, 0, len(data.Operations)),
+		Receivers:  make([]*finpb.Receiver, 0, len(data.Receivers)),
+	}
+
+	for _, acc := range data.Accounts {
+		resp.Accounts = append(resp.Accounts, accountToProto(acc))
+	}
+	for _, ctg := range data.Categories {
+		resp.Categories = append(resp.Categories, CategoryToProto(ctg))
+	}
+	for _, op := range data.Operations {
+		resp.Operations = append(resp.Operations, operationToProto(op))
+	}
+	for _, rcv := range data.Receivers {
+		resp.Receivers = append(resp.Receivers, receiverToProto(rcv))
+	}
+	for _, sh := range data.Sharings {
+		resp.Sharings = append(resp.Sharings, SharingToProto(sh))
+	}
+
+	return resp
+}
+
+func ImportResultToProto(res finmodels.ImportUserDataResult) *finpb.ImportUserDataResponse {
+	ops := make([]*finpb.Operation, 0, len(res.RestoredOperations))
+	for _, op := range res.RestoredOperations {
+		ops = append(ops, operationToProto(op))
+	}
+
+	return &finpb.ImportUserDataResponse{
+		AccountsRestored:   int32(res.AccountsRestored),
+		CategoriesRestored: int32(res.CategoriesRestored),
+		OperationsRestored: int32(res.OperationsRestored),
+		ReceiversRestored:  int32(res.ReceiversRestored),
+		RestoredOperations: ops,
+	}
+}
+
+func int32IDs(ids []int) []int32 {
+	res := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int32(id))
+	}
+	return res
+}
+
+func UserDataDeletionToProto(res finmodels.UserDataDeletion) *finpb.UserDataDeletion {
+	deletion := &finpb.UserDataDeletion{
+		DeletedAccountIds:       int32IDs(res.DeletedAccountIDs),
+		LeftAccountIds:          int32IDs(res.LeftAccountIDs),
+		UncategorizedOperations: make([]*finpb.Operation, 0, len(res.UncategorizedOperations)),
+	}
+	for _, op := range res.UncategorizedOperations {
+		deletion.UncategorizedOperations = append(deletion.UncategorizedOperations, operationToProto(op))
+	}
+	return deletion
+}
+
+func CategoryRuleToProto(rule finmodels.CategoryRule) *finpb.CategoryRule {
+	return &finpb.CategoryRule{
+		Id:            int32(rule.ID),
+		UserId:        int32(rule.UserID),
+		CategoryId:    int32(rule.CategoryID),
+		Priority:      int32(rule.Priority),
+		NameContains:  rule.NameContains,
+		OperationType: string(rule.OperationType),
+		AmountGt:      rule.AmountGT,
+		AmountLt:      rule.AmountLT,
+		CreatedAt:     timestamppb.New(rule.CreatedAt),
+		UpdatedAt:     timestamppb.New(rule.UpdatedAt),
+	}
+}
+
+func CategoryRulesToProto(rules []finmodels.CategoryRule) *finpb.ListCategoryRulesResponse {
+	protoRules := make([]*finpb.CategoryRule, 0, len(rules))
+	for _, rule := range rules {
+		protoRules = append(protoRules, CategoryRuleToProto(rule))
+	}
+	return &finpb.ListCategoryRulesResponse{Rules: protoRules}
+}
+
+func SpendingStatsToProto(stats finmodels.SpendingStats) *finpb.SpendingStatsResponse {
+	resp := &finpb.SpendingStatsResponse{
+		Days:      make([]*finpb.DailySpending, 0, len(stats.Days)),
+		Recurring: make([]*finpb.RecurringExpense, 0, len(stats.Recurring)),
+	}
+	for _, day := range stats.Days {
+		resp.Days = append(resp.Days, &finpb.DailySpending{
+			Date: timestamppb.New(day.Date),
+			Sum:  day.Sum,
+		})
+	}
+	for _, e := range stats.Recurring {
+		resp.Recurring = append(resp.Recurring, &finpb.RecurringExpense{
+			Name:       e.Name,
+			CategoryId: int32(e.CategoryID),
+			Months:     int32(e.Months),
+			AvgSum:     e.AvgSum,
+			DayOfMonth: int32(e.DayOfMonth),
+			LastDate:   timestamppb.New(e.LastDate),
+			LastSum:    e.LastSum,
+		})
+	}
+	return resp
+}
+
+func SpendingTotalsToProto(totals finmodels.SpendingTotals, categoryIDs []int) *finpb.SpendingTotals {
+	resp := &finpb.SpendingTotals{
+		Total:      totals.Total,
+		ByCategory: make([]*finpb.CategorySpending, 0, len(categoryIDs)),
+	}
+	for _, id := range categoryIDs {
+		resp.ByCategory = append(resp.ByCategory, &finpb.CategorySpending{
+			CategoryId: int32(id),
+			Sum:        totals.ByCategory[id],
+		})
+	}
+	return resp
+}
+
+func CounterpartiesToProto(counterparties []finmodels.Counterparty) *finpb.ListCounterpartiesResponse {
+	resp := &finpb.ListCounterpartiesResponse{Counterparties: make([]*finpb.Counterparty, 0, len(counterparties))}
+	for _, cp := range counterparties {
+		balances := make([]*finpb.CounterpartyBalance, 0, len(cp.Balances))
+		for _, b := range cp.Balances {
+			balances = append(balances, &finpb.CounterpartyBalance{
+				CurrencyId: int32(b.CurrencyID),
+				Lent:       b.Lent,
+				Borrowed:   b.Borrowed,
+				Net:        b.Net(),
+			})
+		}
+		resp.Counterparties = append(resp.Counterparties, &finpb.Counterparty{
+			Receiver: receiverToProto(cp.Receiver),
+			Balances: balances,
+		})
+	}
+	return resp
+}
+
+func DebtToProto(debt finmodels.Debt, today time.Time) *finpb.Debt {
+	res := &finpb.Debt{
+		Id:                 int32(debt.ID),
+		UserId:             int32(debt.UserID),
+		ReceiverId:         int32(debt.ReceiverID),
+		ReceiverName:       debt.ReceiverName,
+		CounterpartyUserId: int32(debt.CounterpartyUserID),
+		Direction:          string(debt.Direction),
+		AccountId:          int32(debt.AccountID),
+		CurrencyId:         int32(debt.CurrencyID),
+		Principal:          debt.Principal,
+		Repaid:             debt.Repaid,
+		Outstanding:        debt.Outstanding(),
+		Description:        debt.Description,
+		Closed:             debt.Closed(),
+		Overdue:            debtOverdue(debt, today),
+		CreatedAt:          timestamppb.New(debt.CreatedAt),
+		UpdatedAt:          timestamppb.New(debt.UpdatedAt),
+	}
+	if debt.DueDate != nil {
+		res.DueDate = timestamppb.New(*debt.DueDate)
+	}
+	return res
+}
+
+func DebtsToProto(debts []finmodels.Debt, today time.Time) *finpb.ListDebtsResponse {
+	resp := &finpb.ListDebtsResponse{Debts: make([]*finpb.Debt, 0, len(debts))}
+	for _, debt := range debts {
+		resp.Debts = append(resp.Debts, DebtToProto(debt, today))
+	}
+	return resp
+}
+
+func DebtPaymentToProto(payment finmodels.DebtPayment) *finpb.DebtPayment {
+	return &finpb.DebtPayment{
+		Id:          int32(payment.ID),
+		DebtId:      int32(payment.DebtID),
+		OperationId: int32(payment.OperationID),
+		Kind:        string(payment.Kind),
+		Amount:      payment.Amount,
+		AccountId:   int32(payment.AccountID),
+		Reverted:    payment.Reverted,
+		Date:        timestamppb.New(payment.Date),
+		CreatedAt:   timestamppb.New(payment.CreatedAt),
+	}
+}
+
+func DebtPaymentsToProto(payments []finmodels.DebtPayment) *finpb.ListDebtPaymentsResponse {
+	resp := &finpb.ListDebtPaymentsResponse{Payments: make([]*finpb.DebtPayment, 0, len(payments))}
+	for _, payment := range payments {
+		resp.Payments = append(resp.Payments, DebtPaymentToProto(payment))
+	}
+	return resp
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+func (s *Service) MergeCategories(ctx context.Context, userID, sourceID, targetID int) (*finpb.MergeCategoriesResponse, error) {
+	if sourceID == targetID {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	categories, err := s.repo.GetCategoriesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	parents := finmodels.CategoryParents(categories)
+	if _, ok := parents[sourceID]; !ok {
+		return nil, serviceerrors.ErrCategoryNotFound
+	}
+	if _, ok := parents[targetID]; !ok {
+		return nil, serviceerrors.ErrCategoryNotFound
+	}
+
+	detach := false
+	for _, ancestor := range finmodels.CategoryAncestors(parents, targetID) {
+		if ancestor == sourceID {
+			detach = true
+			break
+		}
+	}
+
+	moved, err := s.repo.MergeCategories(ctx, finmodels.MergeCategoriesRequest{
+		UserID:       userID,
+		SourceID:     sourceID,
+		TargetID:     targetID,
+		DetachTarget: detach,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// модель подсказок могла выучить удаленную категорию
+	s.suggestions.Invalidate(userID)
+
+	target, err := s.repo.GetCategoryByID(ctx, userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &finpb.MergeCategoriesResponse{
+		Target:          CategoryToProto(target),
+		MovedOperations: int32(moved),
+	}, nil
+}
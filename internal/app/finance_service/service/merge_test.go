@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	finerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeCategories_Siblings(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoriesByUser(ctx, 1).Return(testCategoryTree(), nil)
+	mockRepo.EXPECT().MergeCategories(ctx, models.MergeCategoriesRequest{UserID: 1, SourceID: 3, TargetID: 2}).Return(8, nil)
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 2).Return(testCategoryTree()[1], nil)
+
+	res, err := svc.MergeCategories(ctx, 1, 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, int32(8), res.MovedOperations)
+	require.Equal(t, int32(2), res.Target.Id)
+}
+
+func TestMergeCategories_TargetInsideSource(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoriesByUser(ctx, 1).Return(testCategoryTree(), nil)
+	mockRepo.EXPECT().MergeCategories(ctx, models.MergeCategoriesRequest{UserID: 1, SourceID: 1, TargetID: 4, DetachTarget: true}).Return(3, nil)
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 4).Return(testCategoryTree()[3], nil)
+
+	_, err := svc.MergeCategories(ctx, 1, 1, 4)
+	require.NoError(t, err)
+}
+
+func TestMergeCategories_Validation(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	_, err := svc.MergeCategories(ctx, 1, 2, 2)
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+
+	mockRepo.EXPECT().GetCategoriesByUser(ctx, 1).Return(testCategoryTree(), nil)
+	_, err = svc.MergeCategories(ctx, 1, 2, 99)
+	require.ErrorIs(t, err, finerrors.ErrCategoryNotFound)
+}
+
+func TestDeleteCategory_Reassign(t *testing.T) {
+	svc, mockRepo := newHierarchyTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoriesByUser(ctx, 1).Return(testCategoryTree(), nil)
+	mockRepo.EXPECT().MergeCategories(ctx, models.MergeCategoriesRequest{UserID: 1, SourceID: 5, TargetID: 1}).Return(2, nil)
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 1).Return(testCategoryTree()[0], nil)
+
+	res, err := svc.DeleteCategory(ctx, 1, 5, 1)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res.Id)
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+func validateCategoryRule(rule finmodels.CategoryRule) error {
+	if !rule.HasConditions() {
+		return errors.ErrInvalidData
+	}
+	switch rule.OperationType {
+	case "", finmodels.OperationIncome, finmodels.OperationExpense:
+	default:
+		return errors.ErrInvalidData
+	}
+	if rule.AmountGT != nil && rule.AmountLT != nil && *rule.AmountGT >= *rule.AmountLT {
+		return errors.ErrInvalidData
+	}
+	return nil
+}
+
+func (s *Service) CreateCategoryRule(ctx context.Context, req finmodels.CreateCategoryRuleRequest) (*finpb.CategoryRule, error) {
+	rule := finmodels.CategoryRule{
+		UserID:        req.UserID,
+		CategoryID:    req.CategoryID,
+		NameContains:  req.NameContains,
+		OperationType: req.OperationType,
+		AmountGT:      req.AmountGT,
+		AmountLT:      req.AmountLT,
+	}
+	if err := validateCategoryRule(rule); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetCategoryByID(ctx, req.UserID, req.CategoryID); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.CreateCategoryRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	return CategoryRuleToProto(created), nil
+}
+
+func (s *Service) GetCategoryRules(ctx context.Context, userID int) (*finpb.ListCategoryRulesResponse, error) {
+	rules, err := s.repo.GetCategoryRulesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return CategoryRulesToProto(rules), nil
+}
+
+func (s *Service) UpdateCategoryRule(ctx context.Context, req finmodels.UpdateCategoryRuleRequest) (*finpb.CategoryRule, error) {
+	rule := finmodels.CategoryRule{
+		ID:            req.RuleID,
+		UserID:        req.UserID,
+		CategoryID:    req.CategoryID,
+		NameContains:  req.NameContains,
+		OperationType: req.OperationType,
+		AmountGT:      req.AmountGT,
+		AmountLT:      req.AmountLT,
+	}
+	if err := validateCategoryRule(rule); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetCategoryByID(ctx, req.UserID, req.CategoryID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.UpdateCategoryRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	return CategoryRuleToProto(updated), nil
+}
+
+func (s *Service) DeleteCategoryRule(ctx context.Context, userID, ruleID int) (*finpb.CategoryRule, error) {
+	deleted, err := s.repo.DeleteCategoryRule(ctx, userID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	return CategoryRuleToProto(deleted), nil
+}
+
+func (s *Service) ReorderCategoryRules(ctx context.Context, userID int, ruleIDs []int) (*finpb.ListCategoryRulesResponse, error) {
+	rules, err := s.repo.GetCategoryRulesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Новый порядок должен быть перестановкой текущих правил, иначе приоритеты разъедутся
+	if len(ruleIDs) != len(rules) {
+		return nil, errors.ErrInvalidData
+	}
+	known := make(map[int]bool, len(rules))
+	for _, rule := range rules {
+		known[rule.ID] = true
+	}
+	for _, id := range ruleIDs {
+		if !known[id] {
+			return nil, errors.ErrInvalidData
+		}
+		delete(known, id)
+	}
+
+	if err := s.repo.ReorderCategoryRules(ctx, userID, ruleIDs); err != nil {
+		return nil, err
+	}
+
+	return s.GetCategoryRules(ctx, userID)
+}
+
+func (s *Service) TestCategoryRules(ctx context.Context, userID int, name string, sum float64, opType finmodels.OperationType) (*finpb.TestCategoryRulesResponse, error) {
+	rules, err := s.repo.GetCategoryRulesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, ok := finmodels.MatchCategoryRule(rules, name, sum, opType)
+	if !ok {
+		return &finpb.TestCategoryRulesResponse{Matched: false}, nil
+	}
+	return &finpb.TestCategoryRulesResponse{Matched: true, Rule: CategoryRuleToProto(rule)}, nil
+}
+
+func (s *Service) ApplyCategoryRules(ctx context.Context, userID int, overwrite bool) (*finpb.ApplyCategoryRulesResponse, error) {
+	rules, err := s.repo.GetCategoryRulesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return &finpb.ApplyCategoryRulesResponse{}, nil
+	}
+
+	operations, err := s.repo.GetOperationsForRules(ctx, userID, !overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []finmodels.Operation
+	for _, op := range operations {
+		rule, ok := finmodels.MatchCategoryRule(rules, op.Name, op.Sum, op.Type)
+		if !ok || rule.CategoryID == op.CategoryID {
+			continue
+		}
+		op.CategoryID = rule.CategoryID
+		changed = append(changed, op)
+	}
+
+	if len(changed) > 0 {
+		if err := s.repo.SetOperationsCategory(ctx, changed); err != nil {
+			return nil, err
+		}
+	}
+
+	categories, err := s.repo.GetCategoriesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(categories))
+	for _, ctg := range categories {
+		names[ctg.ID] = ctg.Name
+	}
+
+	resp := &finpb.ApplyCategoryRulesResponse{
+		Checked:       int32(len(operations)),
+		Recategorized: make([]*finpb.Operation, 0, len(changed)),
+	}
+	for _, op := range changed {
+		op.CategoryName = names[op.CategoryID]
+		resp.Recategorized = append(resp.Recategorized, operationToProto(op))
+	}
+	return resp, nil
+}
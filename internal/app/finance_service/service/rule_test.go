@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	finerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	mock_repo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func testRules() []models.CategoryRule {
+	return []models.CategoryRule{
+		{ID: 1, UserID: 1, CategoryID: 10, Priority: 1, NameContains: "пятёрочка"},
+		{ID: 2, UserID: 1, CategoryID: 20, Priority: 2, OperationType: models.OperationIncome, AmountGT: floatPtr(50000)},
+	}
+}
+
+func TestMatchCategoryRule(t *testing.T) {
+	rules := testRules()
+
+	rule, ok := models.MatchCategoryRule(rules, "Магазин Пятёрочка", 300, models.OperationExpense)
+	require.True(t, ok)
+	require.Equal(t, 1, rule.ID)
+
+	rule, ok = models.MatchCategoryRule(rules, "ПЯТЕРОЧКА  Москва", 300, models.OperationExpense)
+	require.True(t, ok)
+	require.Equal(t, 1, rule.ID)
+
+	rule, ok = models.MatchCategoryRule([]models.CategoryRule{{ID: 4, CategoryID: 40, NameContains: " Пятерочка   Москва "}}, "Магазин пятёрочка москва", 300, models.OperationExpense)
+	require.True(t, ok)
+	require.Equal(t, 4, rule.ID)
+
+	rule, ok = models.MatchCategoryRule(rules, "Перевод", 60000, models.OperationIncome)
+	require.True(t, ok)
+	require.Equal(t, 2, rule.ID)
+
+	_, ok = models.MatchCategoryRule(rules, "Перевод", 50000, models.OperationIncome)
+	require.False(t, ok)
+
+	_, ok = models.MatchCategoryRule(rules, "Перевод", 60000, models.OperationExpense)
+	require.False(t, ok)
+
+	_, ok = models.MatchCategoryRule([]models.CategoryRule{{ID: 3, CategoryID: 30}}, "anything", 1, models.OperationExpense)
+	require.False(t, ok)
+}
+
+func TestCreateOperation_AppliesRule(t *testing.T) {
+	fixedClock := clock.FixedClock{FixedTime: time.Date(2025, 10, 22, 19, 0, 0, 0, time.UTC)}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, fixedClock)
+
+	ctx := context.Background()
+	req := models.CreateOperationRequest{UserID: 1, AccountID: 2, Type: models.OperationExpense, Name: "ПЯТЁРОЧКА 123", Sum: 500}
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(testRules(), nil)
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, op models.Operation) (models.Operation, error) {
+		require.Equal(t, 10, op.CategoryID)
+		return op, nil
+	})
+
+	resp, err := svc.CreateOperation(ctx, req, req.AccountID)
+	require.NoError(t, err)
+	require.Equal(t, int32(10), resp.CategoryId)
+}
+
+func TestCreateOperation_ExplicitCategorySkipsRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.RealClock{})
+
+	ctx := context.Background()
+	ctgID := 7
+	req := models.CreateOperationRequest{UserID: 1, AccountID: 2, CategoryID: &ctgID, Type: models.OperationExpense, Name: "Пятёрочка", Sum: 500}
+
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, op models.Operation) (models.Operation, error) {
+		require.Equal(t, 7, op.CategoryID)
+		return op, nil
+	})
+
+	_, err := svc.CreateOperation(ctx, req, req.AccountID)
+	require.NoError(t, err)
+}
+
+func TestCreateCategoryRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.RealClock{})
+
+	ctx := context.Background()
+
+	_, err := svc.CreateCategoryRule(ctx, models.CreateCategoryRuleRequest{UserID: 1, CategoryID: 10})
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+
+	_, err = svc.CreateCategoryRule(ctx, models.CreateCategoryRuleRequest{UserID: 1, CategoryID: 10, AmountGT: floatPtr(10), AmountLT: floatPtr(5)})
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 99).Return(models.Category{}, finerrors.ErrCategoryNotFound)
+	_, err = svc.CreateCategoryRule(ctx, models.CreateCategoryRuleRequest{UserID: 1, CategoryID: 99, NameContains: "taxi"})
+	require.ErrorIs(t, err, finerrors.ErrCategoryNotFound)
+
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 10).Return(models.Category{ID: 10}, nil)
+	mockRepo.EXPECT().CreateCategoryRule(ctx, gomock.Any()).Return(models.CategoryRule{ID: 5, UserID: 1, CategoryID: 10, Priority: 3, NameContains: "taxi"}, nil)
+	rule, err := svc.CreateCategoryRule(ctx, models.CreateCategoryRuleRequest{UserID: 1, CategoryID: 10, NameContains: "taxi"})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), rule.Priority)
+}
+
+func TestReorderCategoryRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.RealClock{})
+
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(testRules(), nil)
+	_, err := svc.ReorderCategoryRules(ctx, 1, []int{2, 2})
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(testRules(), nil)
+	mockRepo.EXPECT().ReorderCategoryRules(ctx, 1, []int{2, 1}).Return(nil)
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(testRules(), nil)
+	resp, err := svc.ReorderCategoryRules(ctx, 1, []int{2, 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Rules, 2)
+}
+
+func TestTestCategoryRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.RealClock{})
+
+	ctx := context.Background()
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(testRules(), nil).Times(2)
+
+	resp, err := svc.TestCategoryRules(ctx, 1, "Зарплата", 100000, models.OperationIncome)
+	require.NoError(t, err)
+	require.True(t, resp.Matched)
+	require.Equal(t, int32(2), resp.Rule.Id)
+
+	resp, err = svc.TestCategoryRules(ctx, 1, "Кафе", 100, models.OperationExpense)
+	require.NoError(t, err)
+	require.False(t, resp.Matched)
+	require.Nil(t, resp.Rule)
+}
+
+func TestApplyCategoryRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.RealClock{})
+
+	ctx := context.Background()
+	ops := []models.Operation{
+		{ID: 1, Name: "Пятёрочка", Sum: 100, Type: models.OperationExpense},
+		{ID: 2, Name: "Пятёрочка", Sum: 100, Type: models.OperationExpense, CategoryID: 10},
+		{ID: 3, Name: "Кафе", Sum: 100, Type: models.OperationExpense},
+	}
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(testRules(), nil)
+	mockRepo.EXPECT().GetOperationsForRules(ctx, 1, false).Return(ops, nil)
+	mockRepo.EXPECT().SetOperationsCategory(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, changed []models.Operation) error {
+		require.Len(t, changed, 1)
+		require.Equal(t, 1, changed[0].ID)
+		require.Equal(t, 10, changed[0].CategoryID)
+		return nil
+	})
+	mockRepo.EXPECT().GetCategoriesByUser(ctx, 1).Return([]models.Category{{ID: 10, Name: "Продукты"}}, nil)
+
+	resp, err := svc.ApplyCategoryRules(ctx, 1, true)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), resp.Checked)
+	require.Len(t, resp.Recategorized, 1)
+	require.Equal(t, "Продукты", resp.Recategorized[0].CategoryName)
+}
@@ -7,23 +7,33 @@ import (
 	"io"
 
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/classifier"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/defaults"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
 	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
 )
 
 type Service struct {
-	repo  FinanceRepository
-	es    *elasticsearch.Client
-	clock clock.Clock
+	repo        FinanceRepository
+	es          *elasticsearch.Client
+	clock       clock.Clock
+	suggestions *classifier.Store
+
+	defaultCategories *defaults.Pack
 }
 
 func NewService(repo FinanceRepository, es *elasticsearch.Client, clck clock.Clock) *Service {
-	return &Service{
+	s := &Service{
 		repo:  repo,
 		es:    es,
 		clock: clck,
+
+		defaultCategories: defaults.Builtin(),
 	}
+	s.suggestions = classifier.NewStore(s.loadSuggestionSamples, clck, suggestionModelMaxAge, suggestionModelIdleTTL)
+	return s
 }
 
 // Account methods
@@ -86,16 +96,15 @@ func (s *Service) DeleteAccount(ctx context.Context, userID, accountID int) (*fi
 	return accountToProto(deletedAcc), nil
 }
 
-func (s *Service) AddUserToAccount(ctx context.Context, userLogin string, accountID int) (*finpb.SharingsResponse, error) {
-	sh, err := s.repo.AddUserToAccount(ctx, userLogin, accountID)
-	if err != nil {
+// Operation methods
+
+// GetOperationsByAccount ищет операции счета в Elasticsearch.
+// Индекс не знает об участниках счета, поэтому доступ проверяется по базе.
+func (s *Service) GetOperationsByAccount(ctx context.Context, userID, accountID int, req []byte) (*finpb.ListOperationsResponse, error) {
+	if _, err := s.repo.GetAccountByID(ctx, userID, accountID); err != nil {
 		return nil, err
 	}
-	return SharingToProto(sh), nil
-}
 
-// Operation methods
-func (s *Service) GetOperationsByAccount(ctx context.Context, req []byte) (*finpb.ListOperationsResponse, error) {
 	res, err := s.es.Search(
 		s.es.Search.WithContext(context.Background()),
 		s.es.Search.WithIndex("transactions"),
@@ -128,8 +137,8 @@ func (s *Service) GetOperationsByAccount(ctx context.Context, req []byte) (*finp
 	return result, nil
 }
 
-func (s *Service) GetOperationByID(ctx context.Context, accID, opID int) (*finpb.Operation, error) {
-	operation, err := s.repo.GetOperationByID(ctx, accID, opID)
+func (s *Service) GetOperationByID(ctx context.Context, userID, accID, opID int) (*finpb.Operation, error) {
+	operation, err := s.repo.GetOperationByID(ctx, userID, accID, opID)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +151,16 @@ func (s *Service) CreateOperation(ctx context.Context, req finmodels.CreateOpera
 		categoryID = *req.CategoryID
 	}
 
+	if categoryID == 0 && req.UserID != 0 {
+		rules, err := s.repo.GetCategoryRulesByUser(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if rule, ok := finmodels.MatchCategoryRule(rules, req.Name, req.Sum, req.Type); ok {
+			categoryID = rule.CategoryID
+		}
+	}
+
 	operationDate := s.clock.Now()
 	if req.Date != nil {
 		operationDate = *req.Date
@@ -159,7 +178,7 @@ func (s *Service) CreateOperation(ctx context.Context, req finmodels.CreateOpera
 		Date:        operationDate,
 	}
 
-	createdOp, err := s.repo.CreateOperation(ctx, op)
+	createdOp, err := s.repo.CreateOperation(ctx, req.UserID, op)
 	if err != nil {
 		return nil, err
 	}
@@ -192,14 +211,31 @@ func (s *Service) UpdateOperation(ctx context.Context, req finmodels.UpdateOpera
 	return operationToProto(updatedOp), nil
 }
 
-func (s *Service) DeleteOperation(ctx context.Context, accID, opID int) (*finpb.Operation, error) {
-	deletedOp, err := s.repo.DeleteOperation(ctx, accID, opID)
+func (s *Service) DeleteOperation(ctx context.Context, userID, accID, opID int) (*finpb.Operation, error) {
+	deletedOp, err := s.repo.DeleteOperation(ctx, userID, accID, opID)
 	if err != nil {
 		return nil, err
 	}
 	return operationToProto(deletedOp), nil
 }
 
+// GetOperationsByIDs операции из списка, которые пользователь может видеть.
+// Нужна другим сервисам, которые хранят ссылки на операции.
+func (s *Service) GetOperationsByIDs(ctx context.Context, userID int, opIDs []int) (*finpb.OperationsByIDsResponse, error) {
+	if len(opIDs) == 0 {
+		return &finpb.OperationsByIDsResponse{}, nil
+	}
+	operations, err := s.repo.GetOperationsByIDs(ctx, userID, opIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &finpb.OperationsByIDsResponse{Operations: make([]*finpb.Operation, 0, len(operations))}
+	for _, op := range operations {
+		resp.Operations = append(resp.Operations, operationToProto(op))
+	}
+	return resp, nil
+}
+
 // Category methods
 func (s *Service) CreateCategory(ctx context.Context, req finmodels.CreateCategoryRequest) (*finpb.Category, error) {
 	logoHashedID := req.LogoHashedID
@@ -207,8 +243,15 @@ func (s *Service) CreateCategory(ctx context.Context, req finmodels.CreateCatego
 		logoHashedID = "c1dfd96eea8cc2b62785275bca38ac261256e278"
 	}
 
+	if req.ParentID != nil {
+		if err := s.checkParentExists(ctx, req.UserID, *req.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
 	category := finmodels.Category{
 		UserID:       req.UserID,
+		ParentID:     req.ParentID,
 		Name:         req.Name,
 		Description:  req.Description,
 		LogoHashedID: logoHashedID,
@@ -247,8 +290,8 @@ func (s *Service) GetCategoriesWithStatsByUser(ctx context.Context, userID int)
 	}
 
 	protoCats := make([]*finpb.CategoryWithStats, 0, len(categories))
-	for _, cat := range categories {
-		protoCats = append(protoCats, CategoryWithStatsToProto(cat.Category, cat.OperationsCount))
+	for _, cat := range finmodels.RollUpCategoryStats(categories) {
+		protoCats = append(protoCats, RolledUpCategoryWithStatsToProto(cat))
 	}
 
 	return &finpb.ListCategoriesWithStatsResponse{
@@ -283,6 +326,12 @@ func (s *Service) GetCategoryByName(ctx context.Context, userID int, categoryNam
 }
 
 func (s *Service) UpdateCategory(ctx context.Context, category finmodels.Category) (*finpb.Category, error) {
+	if category.ParentID != nil && *category.ParentID != 0 {
+		if err := s.checkCategoryParent(ctx, category.UserID, category.ID, *category.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.repo.UpdateCategory(ctx, category); err != nil {
 		return nil, err
 	}
@@ -295,8 +344,21 @@ func (s *Service) UpdateCategory(ctx context.Context, category finmodels.Categor
 	return CategoryToProto(updatedCat), nil
 }
 
-func (s *Service) DeleteCategory(ctx context.Context, userID, categoryID int) error {
-	return s.repo.DeleteCategory(ctx, userID, categoryID)
+// DeleteCategory удаляет категорию. Если задан reassignTo, операции переносятся
+// в эту категорию, иначе остаются без категории.
+func (s *Service) DeleteCategory(ctx context.Context, userID, categoryID, reassignTo int) (*finpb.Category, error) {
+	if reassignTo != 0 {
+		res, err := s.MergeCategories(ctx, userID, categoryID, reassignTo)
+		if err != nil {
+			return nil, err
+		}
+		return res.Target, nil
+	}
+
+	if err := s.repo.DeleteCategory(ctx, userID, categoryID); err != nil {
+		return nil, err
+	}
+	return &finpb.Category{}, nil
 }
 
 func (s *Service) GetCategoriesReport(ctx context.Context, req finmodels.CategoryReportRequest) (*finpb.CategoryReportResponse, error) {
@@ -304,5 +366,58 @@ func (s *Service) GetCategoriesReport(ctx context.Context, req finmodels.Categor
 	if err != nil {
 		return nil, err
 	}
-	return ReportToProto(ctgs, req.Start, req.End), nil
+	return ReportToProto(finmodels.RollUpCategoryReport(ctgs), req.Start, req.End), nil
+}
+
+// Backup methods
+
+// ExportUserData выгружает счета, которыми владеет пользователь, вместе с их
+// участниками и операциями. Чужие счета, где он только участник, не выгружаются.
+func (s *Service) ExportUserData(ctx context.Context, userID int) (*finpb.UserDataExport, error) {
+	all, err := s.repo.GetAccountsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]finmodels.Account, 0, len(all))
+	for _, acc := range all {
+		if acc.Role == finmodels.RoleOwner {
+			accounts = append(accounts, acc)
+		}
+	}
+	sharings, err := s.repo.GetSharingsByOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.repo.GetCategoriesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	operations, err := s.repo.GetOperationsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	receivers, err := s.repo.GetReceiversByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return UserDataToProto(finmodels.UserData{
+		Accounts:   accounts,
+		Categories: categories,
+		Operations: operations,
+		Receivers:  receivers,
+		Sharings:   sharings,
+	}), nil
+}
+
+func (s *Service) ImportUserData(ctx context.Context, req finmodels.ImportUserDataRequest) (*finpb.ImportUserDataResponse, error) {
+	if req.BackupID == "" {
+		return nil, errors.ErrInvalidData
+	}
+
+	res, err := s.repo.ImportUserData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return ImportResultToProto(res), nil
 }
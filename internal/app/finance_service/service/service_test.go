@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	finerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
 	mock_repo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
@@ -109,7 +110,8 @@ func TestCreateOperation_UpdateAccountBalance(t *testing.T) {
 		UserID: 1, AccountID: 2, Type: models.OperationIncome, Sum: 100,
 	}
 
-	mockRepo.EXPECT().CreateOperation(ctx, gomock.Any()).Return(models.Operation{ID: 1, AccountID: 2, Sum: 100, Type: models.OperationIncome}, nil)
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, req.UserID).Return(nil, nil)
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).Return(models.Operation{ID: 1, AccountID: 2, Sum: 100, Type: models.OperationIncome}, nil)
 
 	op, err := svc.CreateOperation(ctx, req, req.AccountID)
 	require.NoError(t, err)
@@ -192,7 +194,7 @@ func TestDeleteCategory(t *testing.T) {
 	userID, categoryID := 1, 2
 	mockRepo.EXPECT().DeleteCategory(ctx, userID, categoryID).Return(nil)
 
-	err := svc.DeleteCategory(ctx, userID, categoryID)
+	_, err := svc.DeleteCategory(ctx, userID, categoryID, 0)
 	require.NoError(t, err)
 }
 
@@ -263,13 +265,35 @@ func TestDeleteOperation(t *testing.T) {
 
 	ctx := context.Background()
 	op := models.Operation{ID: 4, AccountID: 2}
-	mockRepo.EXPECT().DeleteOperation(ctx, 2, 4).Return(op, nil)
+	mockRepo.EXPECT().DeleteOperation(ctx, 1, 2, 4).Return(op, nil)
 
-	resp, err := svc.DeleteOperation(ctx, 2, 4)
+	resp, err := svc.DeleteOperation(ctx, 1, 2, 4)
 	require.NoError(t, err)
 	require.Equal(t, int32(4), resp.Id)
 }
 
+func TestGetOperationsByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.FixedClock{})
+
+	ctx := context.Background()
+	resp, err := svc.GetOperationsByIDs(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Empty(t, resp.Operations)
+
+	mockRepo.EXPECT().GetOperationsByIDs(ctx, 1, []int{4, 9}).Return([]models.Operation{
+		{ID: 4, AccountID: 2, Status: models.OperationReverted, Sum: 150},
+	}, nil)
+
+	resp, err = svc.GetOperationsByIDs(ctx, 1, []int{4, 9})
+	require.NoError(t, err)
+	require.Len(t, resp.Operations, 1)
+	require.Equal(t, "reverted", resp.Operations[0].Status)
+	require.Equal(t, 150.0, resp.Operations[0].Sum)
+}
+
 func TestCreateOperationDefaults(t *testing.T) {
 	fixedClock := clock.FixedClock{
 		FixedTime: time.Date(2025, 10, 22, 19, 0, 0, 0, time.UTC),
@@ -287,7 +311,8 @@ func TestCreateOperationDefaults(t *testing.T) {
 		Sum:       75,
 	}
 
-	mockRepo.EXPECT().CreateOperation(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, op models.Operation) (models.Operation, error) {
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, req.UserID).Return(nil, nil)
+	mockRepo.EXPECT().CreateOperation(ctx, 1, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, op models.Operation) (models.Operation, error) {
 		require.Equal(t, 0, op.CategoryID)
 		require.Equal(t, fixedClock.FixedTime, op.Date)
 		return op, nil
@@ -297,3 +322,58 @@ func TestCreateOperationDefaults(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, float64(75), resp.Sum)
 }
+
+func TestExportUserData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.RealClock{})
+
+	ctx := context.Background()
+	mockRepo.EXPECT().GetAccountsByUser(ctx, 1).Return([]models.Account{
+		{ID: 1, Role: models.RoleOwner},
+		{ID: 5, Role: models.RoleEditor},
+	}, nil)
+	mockRepo.EXPECT().GetSharingsByOwner(ctx, 1).Return([]models.SharingAccount{
+		{UserID: 2, UserLogin: "bob", AccountID: 1, Role: models.RoleViewer},
+	}, nil)
+	mockRepo.EXPECT().GetCategoriesByUser(ctx, 1).Return([]models.Category{{ID: 2, Name: "Food"}}, nil)
+	mockRepo.EXPECT().GetOperationsByUser(ctx, 1).Return([]models.Operation{{ID: 3, AccountID: 1, CategoryID: 2}}, nil)
+	mockRepo.EXPECT().GetReceiversByUser(ctx, 1).Return([]models.Receiver{{ID: 4, Name: "Shop"}}, nil)
+
+	res, err := svc.ExportUserData(ctx, 1)
+	require.NoError(t, err)
+	// счет, где пользователь только участник, не выгружается
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, int32(1), res.Accounts[0].Id)
+	require.Len(t, res.Sharings, 1)
+	require.Equal(t, "bob", res.Sharings[0].UserLogin)
+	require.Len(t, res.Categories, 1)
+	require.Len(t, res.Operations, 1)
+	require.Len(t, res.Receivers, 1)
+	require.Equal(t, "Shop", res.Receivers[0].Name)
+}
+
+func TestImportUserData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	svc := NewService(mockRepo, nil, clock.RealClock{})
+
+	ctx := context.Background()
+
+	_, err := svc.ImportUserData(ctx, models.ImportUserDataRequest{UserID: 1})
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+
+	req := models.ImportUserDataRequest{UserID: 1, BackupID: "backup-1"}
+	mockRepo.EXPECT().ImportUserData(ctx, req).Return(models.ImportUserDataResult{
+		AccountsRestored:   1,
+		OperationsRestored: 1,
+		RestoredOperations: []models.Operation{{ID: 10, AccountID: 1}},
+	}, nil)
+
+	res, err := svc.ImportUserData(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res.AccountsRestored)
+	require.Len(t, res.RestoredOperations, 1)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+// validateMemberRequest владелец не может действовать над самим собой:
+// свою роль он передает через TransferAccountOwnership.
+func validateMemberRequest(req finmodels.AccountMemberRequest) error {
+	if req.MemberID <= 0 || req.MemberID == req.UserID {
+		return serviceerrors.ErrInvalidData
+	}
+	return nil
+}
+
+func (s *Service) GetAccountMembers(ctx context.Context, userID, accountID int) (*finpb.ListAccountMembersResponse, error) {
+	members, err := s.repo.GetAccountMembers(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return AccountMembersToProto(members), nil
+}
+
+// GetAccountUserIDs пользователи с доступом к счету. Вызывается другими сервисами
+// по событиям счета, поэтому участие вызывающего в счете не проверяется.
+func (s *Service) GetAccountUserIDs(ctx context.Context, accountID int) (*finpb.UserIDs, error) {
+	if accountID <= 0 {
+		return nil, serviceerrors.ErrInvalidData
+	}
+	users, err := s.repo.GetAccountUserIDs(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &finpb.UserIDs{UserIds: make([]int32, 0, len(users))}
+	for _, userID := range users {
+		resp.UserIds = append(resp.UserIds, int32(userID))
+	}
+	return resp, nil
+}
+
+// UpdateAccountMemberRole назначает участнику роль editor или viewer.
+func (s *Service) UpdateAccountMemberRole(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.SharingsResponse, error) {
+	if err := validateMemberRequest(req); err != nil {
+		return nil, err
+	}
+	if req.Role != finmodels.RoleEditor && req.Role != finmodels.RoleViewer {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	sh, err := s.repo.UpdateAccountMemberRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return SharingToProto(sh), nil
+}
+
+func (s *Service) RemoveAccountMember(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.SharingsResponse, error) {
+	if err := validateMemberRequest(req); err != nil {
+		return nil, err
+	}
+
+	sh, err := s.repo.RemoveAccountMember(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return SharingToProto(sh), nil
+}
+
+func (s *Service) LeaveAccount(ctx context.Context, userID, accountID int) (*finpb.SharingsResponse, error) {
+	sh, err := s.repo.LeaveAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return SharingToProto(sh), nil
+}
+
+func (s *Service) TransferAccountOwnership(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.ListAccountMembersResponse, error) {
+	if err := validateMemberRequest(req); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.TransferAccountOwnership(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return AccountMembersToProto(members), nil
+}
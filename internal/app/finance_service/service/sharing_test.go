@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func TestUpdateAccountMemberRole_Validation(t *testing.T) {
+	svc, _ := newSuggestTestService(t)
+	ctx := context.Background()
+
+	// владелец передает права только через TransferAccountOwnership
+	_, err := svc.UpdateAccountMemberRole(ctx, models.AccountMemberRequest{UserID: 1, AccountID: 5, MemberID: 2, Role: models.RoleOwner})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+
+	_, err = svc.UpdateAccountMemberRole(ctx, models.AccountMemberRequest{UserID: 1, AccountID: 5, MemberID: 1, Role: models.RoleViewer})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+
+	_, err = svc.RemoveAccountMember(ctx, models.AccountMemberRequest{UserID: 1, AccountID: 5, MemberID: 1})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+
+	_, err = svc.TransferAccountOwnership(ctx, models.AccountMemberRequest{UserID: 1, AccountID: 5})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+}
+
+func TestTransferAccountOwnership(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+	req := models.AccountMemberRequest{UserID: 1, AccountID: 5, MemberID: 2}
+
+	mockRepo.EXPECT().TransferAccountOwnership(ctx, req).Return([]models.SharingAccount{
+		{UserID: 2, AccountID: 5, Role: models.RoleOwner},
+		{UserID: 1, AccountID: 5, Role: models.RoleEditor},
+	}, nil)
+
+	resp, err := svc.TransferAccountOwnership(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Members, 2)
+	require.Equal(t, "owner", resp.Members[0].Role)
+}
+
+func TestGetAccountUserIDs(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetAccountUserIDs(ctx, 5).Return([]int{1, 2}, nil)
+
+	resp, err := svc.GetAccountUserIDs(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 2}, resp.UserIds)
+
+	_, err = svc.GetAccountUserIDs(ctx, 0)
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+}
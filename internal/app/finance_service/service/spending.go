@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+// minRecurringMonths в скольких разных месяцах должен встретиться расход, чтобы считаться регулярным
+const minRecurringMonths = 2
+
+// GetSpendingStats возвращает расходы по дням периода и регулярные расходы за период истории.
+func (s *Service) GetSpendingStats(ctx context.Context, req finmodels.SpendingStatsRequest) (*finpb.SpendingStatsResponse, error) {
+	if req.UserID <= 0 || req.End.Before(req.Start) || req.HistoryStart.After(req.Start) {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	days, err := s.repo.GetDailySpending(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get daily spending")
+	}
+
+	recurring, err := s.repo.GetRecurringExpenses(ctx, req, minRecurringMonths)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get recurring expenses")
+	}
+
+	return SpendingStatsToProto(finmodels.SpendingStats{Days: days, Recurring: recurring}), nil
+}
+
+// GetSpendingTotals возвращает расходы за период: всего и по запрошенным категориям.
+// По ним бюджеты считают фактические расходы.
+func (s *Service) GetSpendingTotals(ctx context.Context, req finmodels.SpendingStatsRequest) (*finpb.SpendingTotals, error) {
+	if req.UserID <= 0 || req.End.Before(req.Start) {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	totals, err := s.repo.GetSpendingTotals(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get spending totals")
+	}
+
+	return SpendingTotalsToProto(totals, req.CategoryIDs), nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	finerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func spendingStatsRequest() models.SpendingStatsRequest {
+	return models.SpendingStatsRequest{
+		UserID:       1,
+		CurrencyID:   2,
+		Start:        time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		End:          time.Date(2030, 6, 10, 0, 0, 0, 0, time.UTC),
+		HistoryStart: time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestGetSpendingStats(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+	req := spendingStatsRequest()
+
+	mockRepo.EXPECT().GetDailySpending(ctx, req).Return([]models.DailySpending{
+		{Date: time.Date(2030, 6, 2, 0, 0, 0, 0, time.UTC), Sum: 540},
+	}, nil)
+	mockRepo.EXPECT().GetRecurringExpenses(ctx, req, minRecurringMonths).Return([]models.RecurringExpense{
+		{Name: "Аренда", CategoryID: 10, Months: 3, AvgSum: 30000, DayOfMonth: 15, LastDate: time.Date(2030, 5, 15, 0, 0, 0, 0, time.UTC), LastSum: 30000},
+	}, nil)
+
+	res, err := svc.GetSpendingStats(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Days, 1)
+	require.Equal(t, 540.0, res.Days[0].Sum)
+	require.Len(t, res.Recurring, 1)
+	require.Equal(t, "Аренда", res.Recurring[0].Name)
+	require.Equal(t, int32(15), res.Recurring[0].DayOfMonth)
+}
+
+func TestGetSpendingStats_InvalidPeriod(t *testing.T) {
+	svc, _ := newSuggestTestService(t)
+
+	req := spendingStatsRequest()
+	req.End = req.Start.AddDate(0, 0, -1)
+	_, err := svc.GetSpendingStats(context.Background(), req)
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+
+	req = spendingStatsRequest()
+	req.HistoryStart = req.Start.AddDate(0, 0, 1)
+	_, err = svc.GetSpendingStats(context.Background(), req)
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+}
+
+func TestGetSpendingStats_RepoError(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+	req := spendingStatsRequest()
+
+	mockRepo.EXPECT().GetDailySpending(ctx, req).Return(nil, errors.New("db down"))
+
+	_, err := svc.GetSpendingStats(ctx, req)
+	require.Error(t, err)
+}
+
+func TestGetSpendingTotals(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+	req := spendingStatsRequest()
+	req.CategoryIDs = []int{10, 11}
+
+	mockRepo.EXPECT().GetSpendingTotals(ctx, req).Return(models.SpendingTotals{
+		Total:      700,
+		ByCategory: map[int]float64{10: 540},
+	}, nil)
+
+	res, err := svc.GetSpendingTotals(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, 700.0, res.Total)
+	// категории без расходов тоже возвращаются, в порядке запроса
+	require.Len(t, res.ByCategory, 2)
+	require.Equal(t, int32(10), res.ByCategory[0].CategoryId)
+	require.Equal(t, 540.0, res.ByCategory[0].Sum)
+	require.Zero(t, res.ByCategory[1].Sum)
+}
+
+func TestGetSpendingTotals_InvalidPeriod(t *testing.T) {
+	svc, _ := newSuggestTestService(t)
+
+	req := spendingStatsRequest()
+	req.End = req.Start.AddDate(0, 0, -1)
+	_, err := svc.GetSpendingTotals(context.Background(), req)
+	require.ErrorIs(t, err, finerrors.ErrInvalidData)
+}
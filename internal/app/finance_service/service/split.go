@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+const maxSettlementDescriptionLen = 100
+
+func toCents(v float64) int64 {
+	return int64(math.Round(v * 100))
+}
+
+func fromCents(c int64) float64 {
+	return float64(c) / 100
+}
+
+// allocateCents делит total копеек пропорционально весам методом наибольшего остатка:
+// сумма долей всегда равна total, лишние копейки достаются долям с большим остатком,
+// при равных остатках — идущим раньше.
+func allocateCents(total int64, weights []int64) []int64 {
+	res := make([]int64, len(weights))
+	var sum int64
+	for _, w := range weights {
+		sum += w
+	}
+	if sum <= 0 {
+		return res
+	}
+
+	bigTotal := big.NewInt(total)
+	bigSum := big.NewInt(sum)
+	remainders := make([]*big.Int, len(weights))
+	var allocated int64
+	for i, w := range weights {
+		num := new(big.Int).Mul(bigTotal, big.NewInt(w))
+		q, rem := new(big.Int).QuoRem(num, bigSum, new(big.Int))
+		res[i] = q.Int64()
+		remainders[i] = rem
+		allocated += res[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	for i := int64(0); i < total-allocated; i++ {
+		res[order[i]]++
+	}
+	return res
+}
+
+// shareWeight вес доли: 1 при делении поровну, сумма или процент в сотых
+func shareWeight(method finmodels.SplitMethod, share finmodels.SplitShare) int64 {
+	if method == finmodels.SplitEqual {
+		return 1
+	}
+	return toCents(share.Value)
+}
+
+// withShareAmounts вычисляет суммы долей от текущей суммы операции.
+// Если операцию изменили после разделения, доли пересчитываются пропорционально.
+func withShareAmounts(split finmodels.OperationSplit) finmodels.OperationSplit {
+	weights := make([]int64, len(split.Shares))
+	for i, share := range split.Shares {
+		weights[i] = shareWeight(split.Method, share)
+	}
+	amounts := allocateCents(toCents(split.Total), weights)
+
+	shares := make([]finmodels.SplitShare, len(split.Shares))
+	for i, share := range split.Shares {
+		share.Amount = fromCents(amounts[i])
+		shares[i] = share
+	}
+	split.Shares = shares
+	return split
+}
+
+func memberIDs(members []finmodels.SharingAccount) map[int]bool {
+	ids := make(map[int]bool, len(members))
+	for _, m := range members {
+		ids[m.UserID] = true
+	}
+	return ids
+}
+
+// participantIDs участники счета вместе со всеми, кто встречается в разделенных расходах
+// и погашениях, — так же, как их учитывает ComputeAccountBalances.
+func participantIDs(members []finmodels.SharingAccount, splits []finmodels.OperationSplit, settlements []finmodels.Settlement) map[int]bool {
+	ids := memberIDs(members)
+	for _, split := range splits {
+		ids[split.PayerID] = true
+		for _, share := range split.Shares {
+			ids[share.UserID] = true
+		}
+	}
+	for _, st := range settlements {
+		ids[st.PayerID] = true
+		ids[st.PayeeID] = true
+	}
+	return ids
+}
+
+// validateSplitShares проверяет доли: участники счета без повторов,
+// точные суммы в сумме дают сумму операции, проценты — 100.
+func validateSplitShares(method finmodels.SplitMethod, total float64, shares []finmodels.SplitShare, members map[int]bool) error {
+	if len(shares) == 0 {
+		return serviceerrors.ErrInvalidData
+	}
+
+	seen := make(map[int]bool, len(shares))
+	var sum int64
+	for _, share := range shares {
+		if !members[share.UserID] || seen[share.UserID] {
+			return serviceerrors.ErrInvalidData
+		}
+		seen[share.UserID] = true
+
+		if method == finmodels.SplitEqual {
+			continue
+		}
+		value := toCents(share.Value)
+		if value <= 0 {
+			return serviceerrors.ErrInvalidData
+		}
+		sum += value
+	}
+
+	switch method {
+	case finmodels.SplitExact:
+		if sum != toCents(total) {
+			return serviceerrors.ErrInvalidData
+		}
+	case finmodels.SplitPercent:
+		if sum != 100*100 {
+			return serviceerrors.ErrInvalidData
+		}
+	}
+	return nil
+}
+
+// SplitOperation делит расход между участниками счета. Без долей при делении
+// поровну расход делится на всех участников. Плательщик по умолчанию — автор запроса.
+func (s *Service) SplitOperation(ctx context.Context, req finmodels.SplitOperationRequest) (*finpb.OperationSplit, error) {
+	if !req.Method.Valid() || req.AccountID <= 0 || req.OperationID <= 0 {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	op, err := s.repo.GetOperationByID(ctx, req.UserID, req.AccountID, req.OperationID)
+	if err != nil {
+		return nil, err
+	}
+	if op.Type != finmodels.OperationExpense {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	members, err := s.repo.GetAccountMembers(ctx, req.UserID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	ids := memberIDs(members)
+
+	if req.PayerID == 0 {
+		req.PayerID = req.UserID
+	}
+	if !ids[req.PayerID] {
+		return nil, serviceerrors.ErrInvalidData
+	}
+
+	shares := req.Shares
+	if len(shares) == 0 && req.Method == finmodels.SplitEqual {
+		for _, m := range members {
+			shares = append(shares, finmodels.SplitShare{UserID: m.UserID})
+		}
+	}
+	if err := validateSplitShares(req.Method, op.Sum, shares, ids); err != nil {
+		return nil, err
+	}
+
+	stored := make([]finmodels.SplitShare, 0, len(shares))
+	for _, share := range shares {
+		value := share.Value
+		if req.Method == finmodels.SplitEqual {
+			value = 1
+		}
+		stored = append(stored, finmodels.SplitShare{UserID: share.UserID, Value: value})
+	}
+	sort.Slice(stored, func(i, j int) bool { return stored[i].UserID < stored[j].UserID })
+
+	split, err := s.repo.SaveOperationSplit(ctx, req.UserID, finmodels.OperationSplit{
+		OperationID: req.OperationID,
+		AccountID:   req.AccountID,
+		PayerID:     req.PayerID,
+		Method:      req.Method,
+		Shares:      stored,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return OperationSplitToProto(withShareAmounts(split)), nil
+}
+
+func (s *Service) GetOperationSplit(ctx context.Context, userID, accID, opID int) (*finpb.OperationSplit, error) {
+	split, err := s.repo.GetOperationSplit(ctx, userID, accID, opID)
+	if err != nil {
+		return nil, err
+	}
+	return OperationSplitToProto(withShareAmounts(split)), nil
+}
+
+func (s *Service) DeleteOperationSplit(ctx context.Context, userID, accID, opID int) (*finpb.OperationSplit, error) {
+	split, err := s.repo.DeleteOperationSplit(ctx, userID, accID, opID)
+	if err != nil {
+		return nil, err
+	}
+	return OperationSplitToProto(withShareAmounts(split)), nil
+}
+
+type memberPair struct{ lo, hi int }
+
+// ComputeAccountBalances сводит разделенные расходы и погашения в чистый долг
+// между каждой парой участников. Участник, покинувший счет, остается в балансах,
+// пока с ним не рассчитались.
+func ComputeAccountBalances(members []finmodels.SharingAccount, splits []finmodels.OperationSplit, settlements []finmodels.Settlement) ([]finmodels.MemberBalance, []finmodels.PairBalance) {
+	logins := make(map[int]string)
+	net := make(map[int]int64)
+	// owed[{lo, hi}] — сколько hi должен lo; отрицательное значение — lo должен hi
+	owed := make(map[memberPair]int64)
+
+	addDebt := func(debtor, creditor int, cents int64) {
+		if debtor == creditor || cents == 0 {
+			return
+		}
+		net[creditor] += cents
+		net[debtor] -= cents
+		if debtor > creditor {
+			owed[memberPair{creditor, debtor}] += cents
+		} else {
+			owed[memberPair{debtor, creditor}] -= cents
+		}
+	}
+
+	for _, split := range splits {
+		split = withShareAmounts(split)
+		logins[split.PayerID] = split.PayerLogin
+		for _, share := range split.Shares {
+			logins[share.UserID] = share.UserLogin
+			addDebt(share.UserID, split.PayerID, toCents(share.Amount))
+		}
+	}
+	for _, st := range settlements {
+		logins[st.PayerID] = st.PayerLogin
+		logins[st.PayeeID] = st.PayeeLogin
+		// Погашение уменьшает долг плательщика получателю
+		addDebt(st.PayeeID, st.PayerID, toCents(st.Amount))
+	}
+
+	balances := make([]finmodels.MemberBalance, 0, len(members))
+	listed := make(map[int]bool, len(members))
+	for _, m := range members {
+		listed[m.UserID] = true
+		balances = append(balances, finmodels.MemberBalance{UserID: m.UserID, UserLogin: m.UserLogin, Net: fromCents(net[m.UserID])})
+	}
+	var former []int
+	for userID, cents := range net {
+		if !listed[userID] && cents != 0 {
+			former = append(former, userID)
+		}
+	}
+	sort.Ints(former)
+	for _, userID := range former {
+		balances = append(balances, finmodels.MemberBalance{UserID: userID, UserLogin: logins[userID], Net: fromCents(net[userID])})
+	}
+
+	debts := make([]finmodels.PairBalance, 0, len(owed))
+	for pair, cents := range owed {
+		debtor, creditor := pair.hi, pair.lo
+		if cents < 0 {
+			debtor, creditor, cents = pair.lo, pair.hi, -cents
+		}
+		if cents == 0 {
+			continue
+		}
+		debts = append(debts, finmodels.PairBalance{
+			DebtorID:      debtor,
+			DebtorLogin:   logins[debtor],
+			CreditorID:    creditor,
+			CreditorLogin: logins[creditor],
+			Amount:        fromCents(cents),
+		})
+	}
+	sort.Slice(debts, func(i, j int) bool {
+		if debts[i].DebtorID != debts[j].DebtorID {
+			return debts[i].DebtorID < debts[j].DebtorID
+		}
+		return debts[i].CreditorID < debts[j].CreditorID
+	})
+
+	return balances, debts
+}
+
+func (s *Service) GetAccountBalances(ctx context.Context, userID, accountID int) (*finpb.AccountBalances, error) {
+	account, err := s.repo.GetAccountByID(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repo.GetAccountMembers(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	splits, err := s.repo.GetAccountSplits(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	settlements, err := s.repo.GetSettlements(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, debts := ComputeAccountBalances(members, splits, settlements)
+	return AccountBalancesToProto(finmodels.AccountBalances{
+		AccountID:  accountID,
+		CurrencyID: account.CurrencyID,
+		Members:    balances,
+		Debts:      debts,
+	}), nil
+}
+
+// CreateSettlement записывает погашение долга. Записать его может любая из сторон,
+// плательщик по умолчанию — автор запроса. Сторонами могут быть и бывшие участники счета,
+// которые остались в балансах после выхода.
+func (s *Service) CreateSettlement(ctx context.Context, req finmodels.CreateSettlementRequest) (*finpb.Settlement, error) {
+	if req.PayerID == 0 {
+		req.PayerID = req.UserID
+	}
+	req.Description = strings.TrimSpace(req.Description)
+	if req.AccountID <= 0 || req.PayeeID <= 0 || req.PayerID == req.PayeeID || toCents(req.Amount) <= 0 ||
+		utf8.RuneCountInString(req.Description) > maxSettlementDescriptionLen {
+		return nil, serviceerrors.ErrInvalidData
+	}
+	if req.UserID != req.PayerID && req.UserID != req.PayeeID {
+		return nil, serviceerrors.ErrForbidden
+	}
+
+	members, err := s.repo.GetAccountMembers(ctx, req.UserID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	splits, err := s.repo.GetAccountSplits(ctx, req.UserID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	settlements, err := s.repo.GetSettlements(ctx, req.UserID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	ids := participantIDs(members, splits, settlements)
+	if !ids[req.PayerID] || !ids[req.PayeeID] {
+		return nil, serviceerrors.ErrMemberNotFound
+	}
+
+	st, err := s.repo.CreateSettlement(ctx, finmodels.Settlement{
+		AccountID:   req.AccountID,
+		PayerID:     req.PayerID,
+		PayeeID:     req.PayeeID,
+		Amount:      fromCents(toCents(req.Amount)),
+		Description: req.Description,
+		CreatedBy:   req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return SettlementToProto(st), nil
+}
+
+func (s *Service) GetSettlements(ctx context.Context, userID, accountID int) (*finpb.ListSettlementsResponse, error) {
+	if _, err := s.repo.GetAccountByID(ctx, userID, accountID); err != nil {
+		return nil, err
+	}
+	settlements, err := s.repo.GetSettlements(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &finpb.ListSettlementsResponse{
+		Settlements: make([]*finpb.Settlement, 0, len(settlements)),
+	}
+	for _, st := range settlements {
+		resp.Settlements = append(resp.Settlements, SettlementToProto(st))
+	}
+	return resp, nil
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+)
+
+func TestAllocateCents(t *testing.T) {
+	// 100.00 на троих: лишняя копейка достается первому по остатку
+	require.Equal(t, []int64{3334, 3333, 3333}, allocateCents(10000, []int64{1, 1, 1}))
+	require.Equal(t, []int64{2500, 7500}, allocateCents(10000, []int64{2500, 7500}))
+	require.Equal(t, []int64{0, 0}, allocateCents(10000, []int64{0, 0}))
+
+	parts := allocateCents(1001, []int64{3333, 3333, 3334})
+	require.Equal(t, int64(1001), parts[0]+parts[1]+parts[2])
+}
+
+func TestValidateSplitShares(t *testing.T) {
+	members := map[int]bool{1: true, 2: true}
+
+	require.NoError(t, validateSplitShares(models.SplitEqual, 100, []models.SplitShare{{UserID: 1}, {UserID: 2}}, members))
+	require.NoError(t, validateSplitShares(models.SplitExact, 100, []models.SplitShare{{UserID: 1, Value: 30}, {UserID: 2, Value: 70}}, members))
+	require.NoError(t, validateSplitShares(models.SplitPercent, 100, []models.SplitShare{{UserID: 1, Value: 33.33}, {UserID: 2, Value: 66.67}}, members))
+
+	cases := map[string]struct {
+		method models.SplitMethod
+		shares []models.SplitShare
+	}{
+		"empty":          {models.SplitEqual, nil},
+		"not a member":   {models.SplitEqual, []models.SplitShare{{UserID: 3}}},
+		"duplicate":      {models.SplitEqual, []models.SplitShare{{UserID: 1}, {UserID: 1}}},
+		"exact mismatch": {models.SplitExact, []models.SplitShare{{UserID: 1, Value: 30}, {UserID: 2, Value: 60}}},
+		"percent over":   {models.SplitPercent, []models.SplitShare{{UserID: 1, Value: 50}, {UserID: 2, Value: 60}}},
+		"zero share":     {models.SplitExact, []models.SplitShare{{UserID: 1, Value: 100}, {UserID: 2, Value: 0}}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, validateSplitShares(tc.method, 100, tc.shares, members), serviceerrors.ErrInvalidData)
+		})
+	}
+}
+
+func TestComputeAccountBalances(t *testing.T) {
+	members := []models.SharingAccount{
+		{UserID: 1, UserLogin: "ivan"},
+		{UserID: 2, UserLogin: "anna"},
+		{UserID: 3, UserLogin: "oleg"},
+	}
+	splits := []models.OperationSplit{
+		{
+			PayerID: 1, PayerLogin: "ivan", Method: models.SplitEqual, Total: 90,
+			Shares: []models.SplitShare{
+				{UserID: 1, UserLogin: "ivan", Value: 1},
+				{UserID: 2, UserLogin: "anna", Value: 1},
+				{UserID: 3, UserLogin: "oleg", Value: 1},
+			},
+		},
+		{
+			PayerID: 2, PayerLogin: "anna", Method: models.SplitExact, Total: 50,
+			Shares: []models.SplitShare{
+				{UserID: 1, UserLogin: "ivan", Value: 40},
+				{UserID: 2, UserLogin: "anna", Value: 10},
+			},
+		},
+	}
+	settlements := []models.Settlement{
+		{PayerID: 3, PayerLogin: "oleg", PayeeID: 1, PayeeLogin: "ivan", Amount: 30},
+	}
+
+	balances, debts := ComputeAccountBalances(members, splits, settlements)
+
+	// ivan: +60 за ужин, -40 анне, -30 получено от олега
+	require.Equal(t, []models.MemberBalance{
+		{UserID: 1, UserLogin: "ivan", Net: -10},
+		{UserID: 2, UserLogin: "anna", Net: 10},
+		{UserID: 3, UserLogin: "oleg", Net: 0},
+	}, balances)
+	require.Equal(t, []models.PairBalance{
+		{DebtorID: 1, DebtorLogin: "ivan", CreditorID: 2, CreditorLogin: "anna", Amount: 10},
+	}, debts)
+}
+
+func TestComputeAccountBalances_FormerMember(t *testing.T) {
+	members := []models.SharingAccount{{UserID: 1, UserLogin: "ivan"}}
+	splits := []models.OperationSplit{{
+		PayerID: 1, PayerLogin: "ivan", Method: models.SplitPercent, Total: 200,
+		Shares: []models.SplitShare{
+			{UserID: 1, UserLogin: "ivan", Value: 25},
+			{UserID: 4, UserLogin: "petr", Value: 75},
+		},
+	}}
+
+	balances, debts := ComputeAccountBalances(members, splits, nil)
+	require.Len(t, balances, 2)
+	require.Equal(t, models.MemberBalance{UserID: 4, UserLogin: "petr", Net: -150}, balances[1])
+	require.Equal(t, 150.0, debts[0].Amount)
+}
+
+func TestSplitOperation_EqualAllMembers(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetOperationByID(ctx, 1, 5, 9).Return(models.Operation{ID: 9, Type: models.OperationExpense, Sum: 100}, nil)
+	mockRepo.EXPECT().GetAccountMembers(ctx, 1, 5).Return([]models.SharingAccount{
+		{UserID: 2, UserLogin: "anna"},
+		{UserID: 1, UserLogin: "ivan"},
+		{UserID: 3, UserLogin: "oleg"},
+	}, nil)
+	mockRepo.EXPECT().SaveOperationSplit(ctx, 1, models.OperationSplit{
+		OperationID: 9, AccountID: 5, PayerID: 1, Method: models.SplitEqual,
+		Shares: []models.SplitShare{{UserID: 1, Value: 1}, {UserID: 2, Value: 1}, {UserID: 3, Value: 1}},
+	}).DoAndReturn(func(_ context.Context, _ int, split models.OperationSplit) (models.OperationSplit, error) {
+		split.Total = 100
+		return split, nil
+	})
+
+	resp, err := svc.SplitOperation(ctx, models.SplitOperationRequest{UserID: 1, AccountID: 5, OperationID: 9, Method: models.SplitEqual})
+	require.NoError(t, err)
+	require.Len(t, resp.Shares, 3)
+	require.Equal(t, 33.34, resp.Shares[0].Amount)
+	require.Equal(t, 33.33, resp.Shares[2].Amount)
+}
+
+func TestSplitOperation_IncomeRejected(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetOperationByID(ctx, 1, 5, 9).Return(models.Operation{ID: 9, Type: models.OperationIncome, Sum: 100}, nil)
+	mockRepo.EXPECT().SaveOperationSplit(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := svc.SplitOperation(ctx, models.SplitOperationRequest{UserID: 1, AccountID: 5, OperationID: 9, Method: models.SplitEqual})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+}
+
+func TestCreateSettlement_Validation(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	_, err := svc.CreateSettlement(ctx, models.CreateSettlementRequest{UserID: 1, AccountID: 5, PayeeID: 1, Amount: 10})
+	require.ErrorIs(t, err, serviceerrors.ErrInvalidData)
+
+	// записать погашение чужих участников нельзя
+	_, err = svc.CreateSettlement(ctx, models.CreateSettlementRequest{UserID: 1, AccountID: 5, PayerID: 2, PayeeID: 3, Amount: 10})
+	require.ErrorIs(t, err, serviceerrors.ErrForbidden)
+
+	mockRepo.EXPECT().GetAccountMembers(ctx, 1, 5).Return([]models.SharingAccount{{UserID: 1}}, nil)
+	mockRepo.EXPECT().GetAccountSplits(ctx, 1, 5).Return(nil, nil)
+	mockRepo.EXPECT().GetSettlements(ctx, 1, 5).Return(nil, nil)
+	_, err = svc.CreateSettlement(ctx, models.CreateSettlementRequest{UserID: 1, AccountID: 5, PayeeID: 2, Amount: 10})
+	require.ErrorIs(t, err, serviceerrors.ErrMemberNotFound)
+}
+
+func TestCreateSettlement_FormerMember(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	// Пользователь 2 покинул счет, но должен пользователю 1 по разделенному расходу
+	mockRepo.EXPECT().GetAccountMembers(ctx, 1, 5).Return([]models.SharingAccount{{UserID: 1}}, nil)
+	mockRepo.EXPECT().GetAccountSplits(ctx, 1, 5).Return([]models.OperationSplit{{
+		PayerID: 1,
+		Method:  models.SplitEqual,
+		Total:   100,
+		Shares:  []models.SplitShare{{UserID: 1}, {UserID: 2}},
+	}}, nil)
+	mockRepo.EXPECT().GetSettlements(ctx, 1, 5).Return(nil, nil)
+	mockRepo.EXPECT().CreateSettlement(ctx, models.Settlement{AccountID: 5, PayerID: 2, PayeeID: 1, Amount: 50, CreatedBy: 1}).
+		Return(models.Settlement{ID: 3, AccountID: 5, PayerID: 2, PayeeID: 1, Amount: 50, CreatedBy: 1}, nil)
+
+	res, err := svc.CreateSettlement(ctx, models.CreateSettlementRequest{UserID: 1, AccountID: 5, PayerID: 2, PayeeID: 1, Amount: 50})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.Id)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/classifier"
+	serviceerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	finmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+)
+
+const (
+	SuggestionSourceRule    = "rule"
+	SuggestionSourceHistory = "history"
+
+	// suggestionHistoryLimit ограничивает обучающую выборку последними операциями,
+	// чтобы модель следовала за изменением привычек пользователя.
+	suggestionHistoryLimit = 2000
+	suggestionModelMaxAge  = 6 * time.Hour
+	// модели пользователей, давно не запрашивавших подсказки, выгружаются из памяти
+	suggestionModelIdleTTL = 24 * time.Hour
+)
+
+func (s *Service) loadSuggestionSamples(ctx context.Context, userID int) ([]classifier.Sample, error) {
+	operations, err := s.repo.GetCategorizedOperations(ctx, userID, suggestionHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]classifier.Sample, 0, len(operations))
+	for _, op := range operations {
+		samples = append(samples, classifier.Sample{
+			CategoryID: op.CategoryID,
+			Name:       op.Name,
+			Sum:        op.Sum,
+		})
+	}
+	return samples, nil
+}
+
+// SuggestCategory подбирает категорию для новой операции. Правила пользователя
+// имеют приоритет; если ни одно не сработало, используется модель по истории операций.
+func (s *Service) SuggestCategory(ctx context.Context, userID int, name string, sum float64, opType finmodels.OperationType) (*finpb.SuggestCategoryResponse, error) {
+	rules, err := s.repo.GetCategoryRulesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if rule, ok := finmodels.MatchCategoryRule(rules, name, sum, opType); ok {
+		return s.suggestionResponse(ctx, userID, rule.CategoryID, 1, SuggestionSourceRule)
+	}
+
+	model, err := s.suggestions.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	prediction, ok := model.Predict(name, sum)
+	if !ok {
+		return &finpb.SuggestCategoryResponse{Found: false}, nil
+	}
+
+	return s.suggestionResponse(ctx, userID, prediction.CategoryID, prediction.Confidence, SuggestionSourceHistory)
+}
+
+func (s *Service) suggestionResponse(ctx context.Context, userID, categoryID int, confidence float64, source string) (*finpb.SuggestCategoryResponse, error) {
+	category, err := s.repo.GetCategoryByID(ctx, userID, categoryID)
+	if err != nil {
+		if errors.Is(err, serviceerrors.ErrCategoryNotFound) {
+			// категория удалена после обучения модели
+			s.suggestions.Invalidate(userID)
+			return &finpb.SuggestCategoryResponse{Found: false}, nil
+		}
+		return nil, err
+	}
+
+	return &finpb.SuggestCategoryResponse{
+		Found:        true,
+		CategoryId:   int32(category.ID),
+		CategoryName: category.Name,
+		Confidence:   confidence,
+		Source:       source,
+	}, nil
+}
+
+// RunSuggestionRetraining периодически переобучает модели подсказок, пока не отменен ctx.
+func (s *Service) RunSuggestionRetraining(ctx context.Context, interval time.Duration, onError func(error)) {
+	s.suggestions.Run(ctx, interval, onError)
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	finerrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/errors"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/models"
+	mock_repo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func suggestionHistory() []models.Operation {
+	return []models.Operation{
+		{Name: "Пятерочка", Sum: 540, CategoryID: 10},
+		{Name: "Пятерочка №12", Sum: 820, CategoryID: 10},
+		{Name: "Магнит", Sum: 610, CategoryID: 10},
+		{Name: "Яндекс Такси", Sum: 350, CategoryID: 30},
+		{Name: "Такси домой", Sum: 410, CategoryID: 30},
+		{Name: "Ситимобил такси", Sum: 290, CategoryID: 30},
+	}
+}
+
+func newSuggestTestService(t *testing.T) (*Service, *mock_repo.MockFinanceRepository) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockRepo := mock_repo.NewMockFinanceRepository(ctrl)
+	fixedClock := clock.FixedClock{FixedTime: time.Date(2025, 10, 22, 19, 0, 0, 0, time.UTC)}
+	return NewService(mockRepo, nil, fixedClock), mockRepo
+}
+
+func TestSuggestCategory_RuleWins(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(testRules(), nil)
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 10).Return(models.Category{ID: 10, Name: "Продукты"}, nil)
+
+	res, err := svc.SuggestCategory(ctx, 1, "Пятёрочка у дома", 300, models.OperationExpense)
+	require.NoError(t, err)
+	require.True(t, res.Found)
+	require.Equal(t, int32(10), res.CategoryId)
+	require.Equal(t, SuggestionSourceRule, res.Source)
+	require.Equal(t, 1.0, res.Confidence)
+}
+
+func TestSuggestCategory_FromHistory(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil).Times(2)
+	mockRepo.EXPECT().GetCategorizedOperations(ctx, 1, suggestionHistoryLimit).Return(suggestionHistory(), nil).Times(1)
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 30).Return(models.Category{ID: 30, Name: "Транспорт"}, nil).Times(2)
+
+	res, err := svc.SuggestCategory(ctx, 1, "Такси в аэропорт", 380, models.OperationExpense)
+	require.NoError(t, err)
+	require.True(t, res.Found)
+	require.Equal(t, int32(30), res.CategoryId)
+	require.Equal(t, "Транспорт", res.CategoryName)
+	require.Equal(t, SuggestionSourceHistory, res.Source)
+
+	// модель берется из кеша без повторного обучения
+	_, err = svc.SuggestCategory(ctx, 1, "такси", 300, models.OperationExpense)
+	require.NoError(t, err)
+}
+
+func TestSuggestCategory_NothingFound(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil)
+	mockRepo.EXPECT().GetCategorizedOperations(ctx, 1, suggestionHistoryLimit).Return(suggestionHistory(), nil)
+
+	res, err := svc.SuggestCategory(ctx, 1, "Что-то новое", 100, models.OperationExpense)
+	require.NoError(t, err)
+	require.False(t, res.Found)
+}
+
+func TestSuggestCategory_CategoryDeleted(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil)
+	mockRepo.EXPECT().GetCategorizedOperations(ctx, 1, suggestionHistoryLimit).Return(suggestionHistory(), nil)
+	mockRepo.EXPECT().GetCategoryByID(ctx, 1, 30).Return(models.Category{}, finerrors.ErrCategoryNotFound)
+
+	res, err := svc.SuggestCategory(ctx, 1, "Такси", 380, models.OperationExpense)
+	require.NoError(t, err)
+	require.False(t, res.Found)
+}
+
+func TestSuggestCategory_RepoError(t *testing.T) {
+	svc, mockRepo := newSuggestTestService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetCategoryRulesByUser(ctx, 1).Return(nil, nil)
+	mockRepo.EXPECT().GetCategorizedOperations(ctx, 1, suggestionHistoryLimit).Return(nil, errors.New("db down"))
+
+	_, err := svc.SuggestCategory(ctx, 1, "Такси", 380, models.OperationExpense)
+	require.Error(t, err)
+}
@@ -14,15 +14,40 @@ type FinanceService interface {
 	CreateAccount(ctx context.Context, req finmodels.CreateAccountRequest) (*finpb.Account, error)
 	UpdateAccount(ctx context.Context, req finmodels.UpdateAccountRequest) (*finpb.Account, error)
 	DeleteAccount(ctx context.Context, userID, accountID int) (*finpb.Account, error)
-	AddUserToAccount(ctx context.Context, userLogin string, accountID int) (*finpb.SharingsResponse, error)
+
+	// Account member methods
+	GetAccountMembers(ctx context.Context, userID, accountID int) (*finpb.ListAccountMembersResponse, error)
+	UpdateAccountMemberRole(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.SharingsResponse, error)
+	RemoveAccountMember(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.SharingsResponse, error)
+	LeaveAccount(ctx context.Context, userID, accountID int) (*finpb.SharingsResponse, error)
+	TransferAccountOwnership(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.ListAccountMembersResponse, error)
+	GetAccountUserIDs(ctx context.Context, accountID int) (*finpb.UserIDs, error)
+
+	// Account invitation methods
+	CreateAccountInvitation(ctx context.Context, req finmodels.CreateInvitationRequest) (*finpb.AccountInvitation, error)
+	GetPendingInvitations(ctx context.Context, userID int) (*finpb.ListAccountInvitationsResponse, error)
+	GetAccountInvitations(ctx context.Context, userID, accountID int) (*finpb.ListAccountInvitationsResponse, error)
+	AcceptAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.SharingsResponse, error)
+	AcceptInvitationLink(ctx context.Context, userID int, token string) (*finpb.SharingsResponse, error)
+	DeclineAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.AccountInvitation, error)
+	RevokeAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.AccountInvitation, error)
+
+	// Expense splitting methods
+	SplitOperation(ctx context.Context, req finmodels.SplitOperationRequest) (*finpb.OperationSplit, error)
+	GetOperationSplit(ctx context.Context, userID, accID, opID int) (*finpb.OperationSplit, error)
+	DeleteOperationSplit(ctx context.Context, userID, accID, opID int) (*finpb.OperationSplit, error)
+	GetAccountBalances(ctx context.Context, userID, accountID int) (*finpb.AccountBalances, error)
+	CreateSettlement(ctx context.Context, req finmodels.CreateSettlementRequest) (*finpb.Settlement, error)
+	GetSettlements(ctx context.Context, userID, accountID int) (*finpb.ListSettlementsResponse, error)
 
 	// Operation methods
 	// GetOperationsByAccount(ctx context.Context, accountID, categoryID int, opName string) (*finpb.ListOperationsResponse, error)
-	GetOperationsByAccount(ctx context.Context, req []byte) (*finpb.ListOperationsResponse, error)
-	GetOperationByID(ctx context.Context, accID, opID int) (*finpb.Operation, error)
+	GetOperationsByAccount(ctx context.Context, userID, accountID int, req []byte) (*finpb.ListOperationsResponse, error)
+	GetOperationByID(ctx context.Context, userID, accID, opID int) (*finpb.Operation, error)
 	CreateOperation(ctx context.Context, req finmodels.CreateOperationRequest, accountID int) (*finpb.Operation, error)
 	UpdateOperation(ctx context.Context, req finmodels.UpdateOperationRequest) (*finpb.Operation, error)
-	DeleteOperation(ctx context.Context, accID, opID int) (*finpb.Operation, error)
+	DeleteOperation(ctx context.Context, userID, accID, opID int) (*finpb.Operation, error)
+	GetOperationsByIDs(ctx context.Context, userID int, opIDs []int) (*finpb.OperationsByIDsResponse, error)
 
 	// Category methods
 	CreateCategory(ctx context.Context, req finmodels.CreateCategoryRequest) (*finpb.Category, error)
@@ -31,6 +56,33 @@ type FinanceService interface {
 	GetCategoryByID(ctx context.Context, userID, categoryID int) (*finpb.CategoryWithStats, error)
 	GetCategoryByName(ctx context.Context, userID int, categoryName string) (*finpb.CategoryWithStats, error)
 	UpdateCategory(ctx context.Context, category finmodels.Category) (*finpb.Category, error)
-	DeleteCategory(ctx context.Context, userID, categoryID int) error
+	DeleteCategory(ctx context.Context, userID, categoryID, reassignTo int) (*finpb.Category, error)
+	MergeCategories(ctx context.Context, userID, sourceID, targetID int) (*finpb.MergeCategoriesResponse, error)
+	ProvisionDefaultCategories(ctx context.Context, userID int, locale string, overwrite bool) (*finpb.ListCategoriesResponse, error)
 	GetCategoriesReport(ctx context.Context, req finmodels.CategoryReportRequest) (*finpb.CategoryReportResponse, error)
+
+	// Backup methods
+	ExportUserData(ctx context.Context, userID int) (*finpb.UserDataExport, error)
+	ImportUserData(ctx context.Context, req finmodels.ImportUserDataRequest) (*finpb.ImportUserDataResponse, error)
+	DeleteUserData(ctx context.Context, userID int) (*finpb.UserDataDeletion, error)
+	FilterUsedImages(ctx context.Context, ids []string) (*finpb.ImageIDs, error)
+
+	// Category rule methods
+	CreateCategoryRule(ctx context.Context, req finmodels.CreateCategoryRuleRequest) (*finpb.CategoryRule, error)
+	GetCategoryRules(ctx context.Context, userID int) (*finpb.ListCategoryRulesResponse, error)
+	UpdateCategoryRule(ctx context.Context, req finmodels.UpdateCategoryRuleRequest) (*finpb.CategoryRule, error)
+	DeleteCategoryRule(ctx context.Context, userID, ruleID int) (*finpb.CategoryRule, error)
+	ReorderCategoryRules(ctx context.Context, userID int, ruleIDs []int) (*finpb.ListCategoryRulesResponse, error)
+	TestCategoryRules(ctx context.Context, userID int, name string, sum float64, opType finmodels.OperationType) (*finpb.TestCategoryRulesResponse, error)
+	ApplyCategoryRules(ctx context.Context, userID int, overwrite bool) (*finpb.ApplyCategoryRulesResponse, error)
+	SuggestCategory(ctx context.Context, userID int, name string, sum float64, opType finmodels.OperationType) (*finpb.SuggestCategoryResponse, error)
+	GetSpendingStats(ctx context.Context, req finmodels.SpendingStatsRequest) (*finpb.SpendingStatsResponse, error)
+	GetSpendingTotals(ctx context.Context, req finmodels.SpendingStatsRequest) (*finpb.SpendingTotals, error)
+	GetCounterparties(ctx context.Context, userID int) (*finpb.ListCounterpartiesResponse, error)
+	CreateDebt(ctx context.Context, req finmodels.CreateDebtRequest) (*finpb.Debt, error)
+	GetDebts(ctx context.Context, userID int, status string) (*finpb.ListDebtsResponse, error)
+	GetDebt(ctx context.Context, userID, debtID int) (*finpb.Debt, error)
+	DeleteDebt(ctx context.Context, userID, debtID int) (*finpb.Debt, error)
+	AddDebtRepayment(ctx context.Context, req finmodels.CreateDebtRepaymentRequest) (*finpb.DebtPayment, error)
+	GetDebtPayments(ctx context.Context, userID, debtID int) (*finpb.ListDebtPaymentsResponse, error)
 }
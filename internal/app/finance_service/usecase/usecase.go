@@ -84,21 +84,167 @@ func (uc *UseCase) DeleteAccount(ctx context.Context, userID, accountID int) (*f
 	return account, nil
 }
 
-func (uc *UseCase) AddUserToAccount(ctx context.Context, userLogin string, accountID int) (*finpb.SharingsResponse, error) {
+// Account member methods
+func (uc *UseCase) GetAccountMembers(ctx context.Context, userID, accountID int) (*finpb.ListAccountMembersResponse, error) {
 	log := logger.FromContext(ctx)
-	sharing, err := uc.financeService.AddUserToAccount(ctx, userLogin, accountID)
+	members, err := uc.financeService.GetAccountMembers(ctx, userID, accountID)
 	if err != nil {
 		if log != nil {
-			log.Error("Failed to add user to account", "error", err, "user_login", userLogin, "account_id", accountID)
+			log.Error("Failed to get account members", "error", err, "user_id", userID, "account_id", accountID)
 		}
-		return nil, pkgerrors.Wrap(err, "finance.AddUserToAccounnt")
+		return nil, pkgerrors.Wrap(err, "finance.GetAccountMembers")
+	}
+	return members, nil
+}
+
+func (uc *UseCase) UpdateAccountMemberRole(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.SharingsResponse, error) {
+	log := logger.FromContext(ctx)
+	sharing, err := uc.financeService.UpdateAccountMemberRole(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to update account member role", "error", err, "user_id", req.UserID, "account_id", req.AccountID, "member_id", req.MemberID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.UpdateAccountMemberRole")
+	}
+	return sharing, nil
+}
+
+func (uc *UseCase) RemoveAccountMember(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.SharingsResponse, error) {
+	log := logger.FromContext(ctx)
+	sharing, err := uc.financeService.RemoveAccountMember(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to remove account member", "error", err, "user_id", req.UserID, "account_id", req.AccountID, "member_id", req.MemberID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.RemoveAccountMember")
+	}
+	return sharing, nil
+}
+
+func (uc *UseCase) LeaveAccount(ctx context.Context, userID, accountID int) (*finpb.SharingsResponse, error) {
+	log := logger.FromContext(ctx)
+	sharing, err := uc.financeService.LeaveAccount(ctx, userID, accountID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to leave account", "error", err, "user_id", userID, "account_id", accountID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.LeaveAccount")
+	}
+	return sharing, nil
+}
+
+func (uc *UseCase) TransferAccountOwnership(ctx context.Context, req finmodels.AccountMemberRequest) (*finpb.ListAccountMembersResponse, error) {
+	log := logger.FromContext(ctx)
+	members, err := uc.financeService.TransferAccountOwnership(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to transfer account ownership", "error", err, "user_id", req.UserID, "account_id", req.AccountID, "member_id", req.MemberID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.TransferAccountOwnership")
+	}
+	return members, nil
+}
+
+func (uc *UseCase) GetAccountUserIDs(ctx context.Context, accountID int) (*finpb.UserIDs, error) {
+	log := logger.FromContext(ctx)
+	users, err := uc.financeService.GetAccountUserIDs(ctx, accountID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get account users", "error", err, "account_id", accountID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetAccountUserIDs")
+	}
+	return users, nil
+}
+
+// Account invitation methods
+func (uc *UseCase) CreateAccountInvitation(ctx context.Context, req finmodels.CreateInvitationRequest) (*finpb.AccountInvitation, error) {
+	log := logger.FromContext(ctx)
+	invitation, err := uc.financeService.CreateAccountInvitation(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to create account invitation", "error", err, "user_id", req.UserID, "user_login", req.UserLogin, "account_id", req.AccountID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.CreateAccountInvitation")
+	}
+	return invitation, nil
+}
+
+func (uc *UseCase) GetPendingInvitations(ctx context.Context, userID int) (*finpb.ListAccountInvitationsResponse, error) {
+	log := logger.FromContext(ctx)
+	invitations, err := uc.financeService.GetPendingInvitations(ctx, userID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get pending invitations", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetPendingInvitations")
+	}
+	return invitations, nil
+}
+
+func (uc *UseCase) GetAccountInvitations(ctx context.Context, userID, accountID int) (*finpb.ListAccountInvitationsResponse, error) {
+	log := logger.FromContext(ctx)
+	invitations, err := uc.financeService.GetAccountInvitations(ctx, userID, accountID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get account invitations", "error", err, "user_id", userID, "account_id", accountID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetAccountInvitations")
+	}
+	return invitations, nil
+}
+
+func (uc *UseCase) AcceptAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.SharingsResponse, error) {
+	log := logger.FromContext(ctx)
+	sharing, err := uc.financeService.AcceptAccountInvitation(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to accept account invitation", "error", err, "user_id", req.UserID, "invitation_id", req.InvitationID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.AcceptAccountInvitation")
 	}
 	return sharing, nil
 }
 
+func (uc *UseCase) AcceptInvitationLink(ctx context.Context, userID int, token string) (*finpb.SharingsResponse, error) {
+	log := logger.FromContext(ctx)
+	sharing, err := uc.financeService.AcceptInvitationLink(ctx, userID, token)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to accept invitation link", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.AcceptInvitationLink")
+	}
+	return sharing, nil
+}
+
+func (uc *UseCase) DeclineAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.AccountInvitation, error) {
+	log := logger.FromContext(ctx)
+	invitation, err := uc.financeService.DeclineAccountInvitation(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to decline account invitation", "error", err, "user_id", req.UserID, "invitation_id", req.InvitationID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.DeclineAccountInvitation")
+	}
+	return invitation, nil
+}
+
+func (uc *UseCase) RevokeAccountInvitation(ctx context.Context, req finmodels.InvitationRequest) (*finpb.AccountInvitation, error) {
+	log := logger.FromContext(ctx)
+	invitation, err := uc.financeService.RevokeAccountInvitation(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to revoke account invitation", "error", err, "user_id", req.UserID, "invitation_id", req.InvitationID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.RevokeAccountInvitation")
+	}
+	return invitation, nil
+}
+
 func (uc *UseCase) GetOperationsByAccount(
 	ctx context.Context,
-	accountID int, categoryIDs []int,
+	userID, accountID int, categoryIDs []int,
 	opName, opType, accType, date string,
 ) (*finpb.ListOperationsResponse, error) {
 
@@ -210,10 +356,10 @@ func (uc *UseCase) GetOperationsByAccount(
 	}
 
 	body, _ := json.Marshal(query)
-	operations, err := uc.financeService.GetOperationsByAccount(ctx, body)
+	operations, err := uc.financeService.GetOperationsByAccount(ctx, userID, accountID, body)
 	if err != nil {
 		if log != nil {
-			log.Error("Failed to get operations", "error", err, "account_id", accountID)
+			log.Error("Failed to get operations", "error", err, "user_id", userID, "account_id", accountID)
 		}
 		return nil, pkgerrors.Wrap(err, "finance.GetOperationsByAccount")
 	}
@@ -221,12 +367,12 @@ func (uc *UseCase) GetOperationsByAccount(
 	return operations, nil
 }
 
-func (uc *UseCase) GetOperationByID(ctx context.Context, accID, opID int) (*finpb.Operation, error) {
+func (uc *UseCase) GetOperationByID(ctx context.Context, userID, accID, opID int) (*finpb.Operation, error) {
 	log := logger.FromContext(ctx)
-	operation, err := uc.financeService.GetOperationByID(ctx, accID, opID)
+	operation, err := uc.financeService.GetOperationByID(ctx, userID, accID, opID)
 	if err != nil {
 		if log != nil {
-			log.Error("Failed to get operation by ID", "error", err, "account_id", accID, "operation_id", opID)
+			log.Error("Failed to get operation by ID", "error", err, "user_id", userID, "account_id", accID, "operation_id", opID)
 		}
 		return nil, pkgerrors.Wrap(err, "finance.GetOperationByID")
 	}
@@ -257,18 +403,30 @@ func (uc *UseCase) UpdateOperation(ctx context.Context, req finmodels.UpdateOper
 	return operation, nil
 }
 
-func (uc *UseCase) DeleteOperation(ctx context.Context, accID, opID int) (*finpb.Operation, error) {
+func (uc *UseCase) DeleteOperation(ctx context.Context, userID, accID, opID int) (*finpb.Operation, error) {
 	log := logger.FromContext(ctx)
-	operation, err := uc.financeService.DeleteOperation(ctx, accID, opID)
+	operation, err := uc.financeService.DeleteOperation(ctx, userID, accID, opID)
 	if err != nil {
 		if log != nil {
-			log.Error("Failed to delete operation", "error", err, "account_id", accID, "operation_id", opID)
+			log.Error("Failed to delete operation", "error", err, "user_id", userID, "account_id", accID, "operation_id", opID)
 		}
 		return nil, pkgerrors.Wrap(err, "finance.DeleteOperation")
 	}
 	return operation, nil
 }
 
+func (uc *UseCase) GetOperationsByIDs(ctx context.Context, userID int, opIDs []int) (*finpb.OperationsByIDsResponse, error) {
+	log := logger.FromContext(ctx)
+	operations, err := uc.financeService.GetOperationsByIDs(ctx, userID, opIDs)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get operations by ids", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetOperationsByIDs")
+	}
+	return operations, nil
+}
+
 // Category methods
 func (uc *UseCase) CreateCategory(ctx context.Context, req finmodels.CreateCategoryRequest) (*finpb.Category, error) {
 	log := logger.FromContext(ctx)
@@ -342,16 +500,40 @@ func (uc *UseCase) UpdateCategory(ctx context.Context, category finmodels.Catego
 	return updatedCategory, nil
 }
 
-func (uc *UseCase) DeleteCategory(ctx context.Context, userID, categoryID int) error {
+func (uc *UseCase) DeleteCategory(ctx context.Context, userID, categoryID, reassignTo int) (*finpb.Category, error) {
 	log := logger.FromContext(ctx)
-	err := uc.financeService.DeleteCategory(ctx, userID, categoryID)
+	res, err := uc.financeService.DeleteCategory(ctx, userID, categoryID, reassignTo)
 	if err != nil {
 		if log != nil {
 			log.Error("Failed to delete category", "error", err, "user_id", userID, "category_id", categoryID)
 		}
-		return pkgerrors.Wrap(err, "finance.DeleteCategory")
+		return nil, pkgerrors.Wrap(err, "finance.DeleteCategory")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) MergeCategories(ctx context.Context, userID, sourceID, targetID int) (*finpb.MergeCategoriesResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.MergeCategories(ctx, userID, sourceID, targetID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to merge categories", "error", err, "user_id", userID, "source_id", sourceID, "target_id", targetID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.MergeCategories")
 	}
-	return nil
+	return res, nil
+}
+
+func (uc *UseCase) ProvisionDefaultCategories(ctx context.Context, userID int, locale string, overwrite bool) (*finpb.ListCategoriesResponse, error) {
+	log := logger.FromContext(ctx)
+	categories, err := uc.financeService.ProvisionDefaultCategories(ctx, userID, locale, overwrite)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to provision default categories", "error", err, "user_id", userID, "overwrite", overwrite)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.ProvisionDefaultCategories")
+	}
+	return categories, nil
 }
 
 func (uc *UseCase) GetCategoriesReport(ctx context.Context, req finmodels.CategoryReportRequest) (*finpb.CategoryReportResponse, error) {
@@ -365,3 +547,334 @@ func (uc *UseCase) GetCategoriesReport(ctx context.Context, req finmodels.Catego
 	}
 	return report, nil
 }
+
+// Backup methods
+func (uc *UseCase) ExportUserData(ctx context.Context, userID int) (*finpb.UserDataExport, error) {
+	log := logger.FromContext(ctx)
+	data, err := uc.financeService.ExportUserData(ctx, userID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to export user data", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.ExportUserData")
+	}
+	return data, nil
+}
+
+func (uc *UseCase) ImportUserData(ctx context.Context, req finmodels.ImportUserDataRequest) (*finpb.ImportUserDataResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.ImportUserData(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to import user data", "error", err, "user_id", req.UserID, "backup_id", req.BackupID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.ImportUserData")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) DeleteUserData(ctx context.Context, userID int) (*finpb.UserDataDeletion, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.DeleteUserData(ctx, userID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to delete user data", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.DeleteUserData")
+	}
+	if log != nil {
+		log.Info("User data deleted", "user_id", userID,
+			"deleted_accounts", len(res.DeletedAccountIds), "left_accounts", len(res.LeftAccountIds))
+	}
+	return res, nil
+}
+
+func (uc *UseCase) FilterUsedImages(ctx context.Context, ids []string) (*finpb.ImageIDs, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.FilterUsedImages(ctx, ids)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to filter used images", "error", err)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.FilterUsedImages")
+	}
+	return res, nil
+}
+
+// Category rule methods
+func (uc *UseCase) CreateCategoryRule(ctx context.Context, req finmodels.CreateCategoryRuleRequest) (*finpb.CategoryRule, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.CreateCategoryRule(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to create category rule", "error", err, "user_id", req.UserID, "category_id", req.CategoryID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.CreateCategoryRule")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetCategoryRules(ctx context.Context, userID int) (*finpb.ListCategoryRulesResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.GetCategoryRules(ctx, userID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get category rules", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetCategoryRules")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) UpdateCategoryRule(ctx context.Context, req finmodels.UpdateCategoryRuleRequest) (*finpb.CategoryRule, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.UpdateCategoryRule(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to update category rule", "error", err, "user_id", req.UserID, "rule_id", req.RuleID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.UpdateCategoryRule")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) DeleteCategoryRule(ctx context.Context, userID, ruleID int) (*finpb.CategoryRule, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.DeleteCategoryRule(ctx, userID, ruleID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to delete category rule", "error", err, "user_id", userID, "rule_id", ruleID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.DeleteCategoryRule")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) ReorderCategoryRules(ctx context.Context, userID int, ruleIDs []int) (*finpb.ListCategoryRulesResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.ReorderCategoryRules(ctx, userID, ruleIDs)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to reorder category rules", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.ReorderCategoryRules")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) TestCategoryRules(ctx context.Context, userID int, name string, sum float64, opType finmodels.OperationType) (*finpb.TestCategoryRulesResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.TestCategoryRules(ctx, userID, name, sum, opType)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to test category rules", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.TestCategoryRules")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) ApplyCategoryRules(ctx context.Context, userID int, overwrite bool) (*finpb.ApplyCategoryRulesResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.ApplyCategoryRules(ctx, userID, overwrite)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to apply category rules", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.ApplyCategoryRules")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) SuggestCategory(ctx context.Context, userID int, name string, sum float64, opType finmodels.OperationType) (*finpb.SuggestCategoryResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.SuggestCategory(ctx, userID, name, sum, opType)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to suggest category", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.SuggestCategory")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetSpendingStats(ctx context.Context, req finmodels.SpendingStatsRequest) (*finpb.SpendingStatsResponse, error) {
+	log := logger.FromContext(ctx)
+	stats, err := uc.financeService.GetSpendingStats(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get spending stats", "error", err, "user_id", req.UserID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetSpendingStats")
+	}
+	return stats, nil
+}
+
+func (uc *UseCase) GetSpendingTotals(ctx context.Context, req finmodels.SpendingStatsRequest) (*finpb.SpendingTotals, error) {
+	log := logger.FromContext(ctx)
+	totals, err := uc.financeService.GetSpendingTotals(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get spending totals", "error", err, "user_id", req.UserID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetSpendingTotals")
+	}
+	return totals, nil
+}
+
+func (uc *UseCase) GetCounterparties(ctx context.Context, userID int) (*finpb.ListCounterpartiesResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.GetCounterparties(ctx, userID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get counterparties", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetCounterparties")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) CreateDebt(ctx context.Context, req finmodels.CreateDebtRequest) (*finpb.Debt, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.CreateDebt(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to create debt", "error", err, "user_id", req.UserID, "account_id", req.AccountID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.CreateDebt")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetDebts(ctx context.Context, userID int, status string) (*finpb.ListDebtsResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.GetDebts(ctx, userID, status)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get debts", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetDebts")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetDebt(ctx context.Context, userID, debtID int) (*finpb.Debt, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.GetDebt(ctx, userID, debtID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get debt", "error", err, "user_id", userID, "debt_id", debtID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetDebt")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) DeleteDebt(ctx context.Context, userID, debtID int) (*finpb.Debt, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.DeleteDebt(ctx, userID, debtID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to delete debt", "error", err, "user_id", userID, "debt_id", debtID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.DeleteDebt")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) AddDebtRepayment(ctx context.Context, req finmodels.CreateDebtRepaymentRequest) (*finpb.DebtPayment, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.AddDebtRepayment(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to add debt repayment", "error", err, "user_id", req.UserID, "debt_id", req.DebtID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.AddDebtRepayment")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetDebtPayments(ctx context.Context, userID, debtID int) (*finpb.ListDebtPaymentsResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.GetDebtPayments(ctx, userID, debtID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get debt payments", "error", err, "user_id", userID, "debt_id", debtID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetDebtPayments")
+	}
+	return res, nil
+}
+
+// Expense splitting methods
+func (uc *UseCase) SplitOperation(ctx context.Context, req finmodels.SplitOperationRequest) (*finpb.OperationSplit, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.SplitOperation(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to split operation", "error", err, "user_id", req.UserID, "account_id", req.AccountID, "operation_id", req.OperationID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.SplitOperation")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetOperationSplit(ctx context.Context, userID, accID, opID int) (*finpb.OperationSplit, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.GetOperationSplit(ctx, userID, accID, opID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get operation split", "error", err, "user_id", userID, "account_id", accID, "operation_id", opID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetOperationSplit")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) DeleteOperationSplit(ctx context.Context, userID, accID, opID int) (*finpb.OperationSplit, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.DeleteOperationSplit(ctx, userID, accID, opID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to delete operation split", "error", err, "user_id", userID, "account_id", accID, "operation_id", opID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.DeleteOperationSplit")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetAccountBalances(ctx context.Context, userID, accountID int) (*finpb.AccountBalances, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.GetAccountBalances(ctx, userID, accountID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get account balances", "error", err, "user_id", userID, "account_id", accountID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetAccountBalances")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) CreateSettlement(ctx context.Context, req finmodels.CreateSettlementRequest) (*finpb.Settlement, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.CreateSettlement(ctx, req)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to create settlement", "error", err, "user_id", req.UserID, "account_id", req.AccountID, "payee_id", req.PayeeID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.CreateSettlement")
+	}
+	return res, nil
+}
+
+func (uc *UseCase) GetSettlements(ctx context.Context, userID, accountID int) (*finpb.ListSettlementsResponse, error) {
+	log := logger.FromContext(ctx)
+	res, err := uc.financeService.GetSettlements(ctx, userID, accountID)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get settlements", "error", err, "user_id", userID, "account_id", accountID)
+		}
+		return nil, pkgerrors.Wrap(err, "finance.GetSettlements")
+	}
+	return res, nil
+}
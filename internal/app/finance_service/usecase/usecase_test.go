@@ -64,10 +64,10 @@ func TestGetOperationsByAccount(t *testing.T) {
 	expected := &finpb.ListOperationsResponse{}
 
 	mockSvc.EXPECT().
-		GetOperationsByAccount(ctx, gomock.Any()).
+		GetOperationsByAccount(ctx, 5, accountID, gomock.Any()).
 		Return(expected, nil)
 
-	res, err := uc.GetOperationsByAccount(ctx, accountID, ctgID, opName, opType, accType, date)
+	res, err := uc.GetOperationsByAccount(ctx, 5, accountID, ctgID, opName, opType, accType, date)
 	require.NoError(t, err)
 	require.Equal(t, expected, res)
 }
@@ -82,9 +82,9 @@ func TestGetOperationByID(t *testing.T) {
 	accID, opID := 1, 2
 	expected := &finpb.Operation{}
 
-	mockSvc.EXPECT().GetOperationByID(ctx, accID, opID).Return(expected, nil)
+	mockSvc.EXPECT().GetOperationByID(ctx, 5, accID, opID).Return(expected, nil)
 
-	res, err := uc.GetOperationByID(ctx, accID, opID)
+	res, err := uc.GetOperationByID(ctx, 5, accID, opID)
 	require.NoError(t, err)
 	require.Equal(t, expected, res)
 }
@@ -251,9 +251,9 @@ func TestDeleteOperation(t *testing.T) {
 	ctx := context.Background()
 	expected := &finpb.Operation{}
 
-	mockSvc.EXPECT().DeleteOperation(ctx, 2, 3).Return(expected, nil)
+	mockSvc.EXPECT().DeleteOperation(ctx, 1, 2, 3).Return(expected, nil)
 
-	res, err := uc.DeleteOperation(ctx, 2, 3)
+	res, err := uc.DeleteOperation(ctx, 1, 2, 3)
 	require.NoError(t, err)
 	require.Equal(t, expected, res)
 }
@@ -282,9 +282,9 @@ func TestDeleteCategoryError(t *testing.T) {
 	uc := NewFinanceUseCase(mockSvc)
 
 	ctx := context.Background()
-	mockSvc.EXPECT().DeleteCategory(ctx, 1, 2).Return(errors.New("boom"))
+	mockSvc.EXPECT().DeleteCategory(ctx, 1, 2, 0).Return(nil, errors.New("boom"))
 
-	err := uc.DeleteCategory(ctx, 1, 2)
+	_, err := uc.DeleteCategory(ctx, 1, 2, 0)
 	require.Error(t, err)
 	require.ErrorContains(t, err, "finance.DeleteCategory")
 }
@@ -54,6 +54,10 @@ func (f *fakeImageUseCase) ImageExists(ctx context.Context, imageID string) (boo
 	return true, nil
 }
 
+func (f *fakeImageUseCase) GetImage(ctx context.Context, imageID string) ([]byte, string, error) {
+	return nil, "", nil
+}
+
 func withUser(req *http.Request, userID int) *http.Request {
 	ctx := context.WithValue(req.Context(), middleware.UserIDKey, userID)
 	return req.WithContext(ctx)
@@ -10,4 +10,5 @@ type ImageStorage interface {
 	GetImageURL(ctx context.Context, objectName string) (string, error)
 	DeleteImage(ctx context.Context, objectName string) error
 	ImageExists(ctx context.Context, objectName string) (bool, error)
+	GetImage(ctx context.Context, objectName string) ([]byte, string, error)
 }
@@ -86,3 +86,23 @@ func (s *MinIOStorage) ImageExists(ctx context.Context, objectName string) (bool
 
 	return true, nil
 }
+
+func (s *MinIOStorage) GetImage(ctx context.Context, objectName string) ([]byte, string, error) {
+	obj, err := s.client.GetObject(ctx, s.bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get image: %w", err)
+	}
+	defer func() { _ = obj.Close() }()
+
+	info, err := obj.Stat()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to stat image: %w", err)
+	}
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read image: %w", err)
+	}
+
+	return data, info.ContentType, nil
+}
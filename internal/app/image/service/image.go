@@ -67,3 +67,13 @@ func (s *Service) ImageExists(ctx context.Context, imageID string) (bool, error)
 
 	return exists, nil
 }
+
+func (s *Service) GetImage(ctx context.Context, imageID string) ([]byte, string, error) {
+	objectName := fmt.Sprintf("%s/%s", imageID[:2], imageID)
+	data, contentType, err := s.storage.GetImage(ctx, objectName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get image: %w", err)
+	}
+
+	return data, contentType, nil
+}
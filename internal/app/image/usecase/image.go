@@ -63,3 +63,15 @@ func (uc *UseCase) ImageExists(ctx context.Context, imageID string) (bool, error
 
 	return exists, nil
 }
+
+func (uc *UseCase) GetImage(ctx context.Context, imageID string) ([]byte, string, error) {
+	data, contentType, err := uc.imageSvc.GetImage(ctx, imageID)
+	if err != nil {
+		if log := logger.FromContext(ctx); log != nil {
+			log.Error("Failed to get image", "error", err, "image_id", imageID)
+		}
+		return nil, "", fmt.Errorf("image.GetImage: %w", err)
+	}
+
+	return data, contentType, nil
+}
@@ -10,4 +10,5 @@ type ImageUseCase interface {
 	GetImageURL(ctx context.Context, imageID string) (string, error)
 	DeleteImage(ctx context.Context, imageID string) error
 	ImageExists(ctx context.Context, imageID string) (bool, error)
+	GetImage(ctx context.Context, imageID string) ([]byte, string, error)
 }
@@ -0,0 +1,126 @@
+package ntfservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	config "github.com/go-park-mail-ru/2025_2_VKarmane/cmd/api/app"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/delivery"
+	ntf "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/grpc"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	ntfrepo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/repository"
+	ntfsvc "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/service"
+	ntfusecase "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/usecase"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/pkg/interceptors"
+)
+
+func Run() error {
+	config := config.LoadConfig()
+
+	appLogger, err := logger.NewSlogLoggerWithFileAndConsole("logs/app.log", slog.LevelInfo)
+	if err != nil {
+		appLogger = logger.NewSlogLogger()
+	}
+
+	thresholds, err := ntfsvc.ParseThresholds(config.Notification.BudgetThresholds)
+	if err != nil {
+		appLogger.Error("NotificationService got invalid budget alert thresholds", "error", err)
+		return err
+	}
+
+	lis, err := net.Listen("tcp", ":8120")
+	if err != nil {
+		appLogger.Error("failed to start NotificationService %w", err)
+		return err
+	}
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpc_prometheus.UnaryServerInterceptor,
+			interceptors.LoggerInterceptor(appLogger),
+		),
+	)
+	grpc_prometheus.Register(srv)
+	grpc_prometheus.EnableHandlingTimeHistogram()
+
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		appLogger.Info("Metrics server started on :8820")
+		if err := http.ListenAndServe(":8820", mux); err != nil {
+			appLogger.Error("Metrics server failed", err)
+		}
+	}()
+
+	db, err := ntfrepo.NewDBConnection(config.GetDatabaseDSN())
+	if err != nil {
+		appLogger.Error("NotificationService failed to connect to DB %w", err)
+		return err
+	}
+	store := ntfrepo.NewPostgresRepository(db)
+
+	bdgGrpcConn, err := grpc.NewClient(
+		fmt.Sprintf("%s:%s", config.BudgetServiceHost, config.BudgetServicePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		appLogger.Error("Failed to connect to budget gRPC service", "error", err)
+		return err
+	}
+	defer bdgGrpcConn.Close()
+	bdgClient := bdgpb.NewBudgetServiceClient(bdgGrpcConn)
+
+	finGrpcConn, err := grpc.NewClient(
+		fmt.Sprintf("%s:%s", config.FinanceServiceHost, config.FinanceServicePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		appLogger.Error("Failed to connect to finance gRPC service", "error", err)
+		return err
+	}
+	defer finGrpcConn.Close()
+	finClient := finpb.NewFinanceServiceClient(finGrpcConn)
+
+	// без SMTP-сервера письма складываются в локальный ящик, чтобы их можно было проверить без сети
+	var mail ntfsvc.MailSender = delivery.NewMailboxSender(config.Notification.MailboxDir, config.Notification.SMTPFrom)
+	if config.Notification.SMTPAddr != "" {
+		mail = delivery.NewSMTPSender(
+			config.Notification.SMTPAddr,
+			config.Notification.SMTPFrom,
+			config.Notification.SMTPUser,
+			config.Notification.SMTPPassword,
+		)
+	}
+
+	svc := ntfsvc.NewService(store, bdgClient, finClient, mail, thresholds)
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: []string{fmt.Sprintf("%s:%s", config.KafkaProducerHost, config.KafkaProducerPort)},
+		Topic:   "transactions",
+		GroupID: "notification-workers",
+	})
+	defer reader.Close()
+
+	go consumeTransactions(logger.WithLogger(context.Background(), appLogger), reader, svc, appLogger)
+
+	uc := ntfusecase.NewNotificationUseCase(svc)
+	ntfService := ntf.NewNotificationServer(uc)
+
+	ntfpb.RegisterNotificationServiceServer(srv, ntfService)
+
+	srv.Serve(lis)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package ntfservice
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+	ntfsvc "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/service"
+	swmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/search_worker/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+)
+
+// consumeTransactions проверяет бюджеты после каждой операции из топика transactions.
+// Ошибочные сообщения пропускаются, чтобы не останавливать обработку остальных.
+func consumeTransactions(ctx context.Context, reader *kafka.Reader, svc *ntfsvc.Service, log logger.Logger) {
+	for {
+		m, err := reader.ReadMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Error("Kafka read error", "error", err)
+			time.Sleep(3 * time.Second)
+			continue
+		}
+
+		var wrapper swmodels.KafkaMessageWrapper
+		if err := json.Unmarshal(m.Value, &wrapper); err != nil {
+			log.Error("Failed to unmarshal kafka message", "error", err)
+			continue
+		}
+		if wrapper.Type != swmodels.TRANSACTIONS {
+			continue
+		}
+
+		var event ntfmodels.TransactionEvent
+		if err := json.Unmarshal(wrapper.Payload, &event); err != nil {
+			log.Error("Failed to unmarshal transaction", "error", err)
+			continue
+		}
+
+		created, err := svc.HandleTransaction(ctx, event)
+		if err != nil {
+			log.Error("Failed to check budgets after transaction", "error", err, "transaction_id", event.ID)
+		}
+		if created > 0 {
+			log.Info("Budget notifications created", "count", created, "transaction_id", event.ID)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"time"
+
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+)
+
+// SMTPSender отправляет письма через SMTP-сервер. Авторизация используется,
+// только если задан пользователь: локальные заглушки SMTP обычно работают без нее.
+type SMTPSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPSender(addr, from, user, password string) *SMTPSender {
+	sender := &SMTPSender{addr: addr, from: from}
+	if user != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		sender.auth = smtp.PlainAuth("", user, password, host)
+	}
+	return sender
+}
+
+func (s *SMTPSender) Send(ctx context.Context, mail ntfmodels.Mail) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	msg := buildMessage(s.from, mail, time.Now())
+	if err := smtp.SendMail(s.addr, s.auth, s.from, []string{mail.To}, msg); err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", mail.To, err)
+	}
+	return nil
+}
+
+// MailboxSender сохраняет каждое письмо отдельным .eml-файлом.
+type MailboxSender struct {
+	dir  string
+	from string
+}
+
+func NewMailboxSender(dir, from string) *MailboxSender {
+	return &MailboxSender{dir: dir, from: from}
+}
+
+func (s *MailboxSender) Send(ctx context.Context, mail ntfmodels.Mail) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create mailbox: %w", err)
+	}
+
+	now := time.Now()
+	f, err := os.CreateTemp(s.dir, now.Format("20060102T150405")+"-*.eml")
+	if err != nil {
+		return fmt.Errorf("failed to create mail file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(buildMessage(s.from, mail, now)); err != nil {
+		return fmt.Errorf("failed to write mail file %s: %w", filepath.Base(f.Name()), err)
+	}
+	return nil
+}
+
+func buildMessage(from string, mail ntfmodels.Mail, date time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mail.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(mail.Body)
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,125 @@
+package delivery
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+)
+
+func testMail() ntfmodels.Mail {
+	return ntfmodels.Mail{
+		To:      "ivan@example.com",
+		Subject: "Бюджет превышен",
+		Body:    "Расходы по бюджету «Еда» составили 130.00 при лимите 100.00",
+	}
+}
+
+func TestMailboxSender_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	sender := NewMailboxSender(dir, "noreply@vkarmane.local")
+
+	require.NoError(t, sender.Send(context.Background(), testMail()))
+	require.NoError(t, sender.Send(context.Background(), testMail()))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	msg := string(data)
+	require.Contains(t, msg, "From: noreply@vkarmane.local\r\n")
+	require.Contains(t, msg, "To: ivan@example.com\r\n")
+	require.Contains(t, msg, "Subject: =?utf-8?q?")
+	require.Contains(t, msg, "«Еда»")
+}
+
+// fakeSMTPServer принимает одно письмо и возвращает его текст вместе с адресатом.
+func fakeSMTPServer(t *testing.T) (string, <-chan string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		reply("220 localhost ESMTP")
+		var rcpt string
+		var data strings.Builder
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM"):
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO"):
+				rcpt = strings.TrimSpace(line[len("RCPT TO:"):])
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				reply("250 OK")
+			case cmd == "QUIT":
+				reply("221 Bye")
+				received <- rcpt + "\n" + data.String()
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+
+	return lis.Addr().String(), received
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	addr, received := fakeSMTPServer(t)
+	sender := NewSMTPSender(addr, "noreply@vkarmane.local", "", "")
+
+	require.NoError(t, sender.Send(context.Background(), testMail()))
+
+	msg := <-received
+	require.True(t, strings.HasPrefix(msg, "<ivan@example.com>\n"))
+	require.Contains(t, msg, "To: ivan@example.com\r\n")
+	require.Contains(t, msg, "«Еда»")
+}
+
+func TestSMTPSender_SendUnavailable(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	lis.Close()
+
+	sender := NewSMTPSender(addr, "noreply@vkarmane.local", "", "")
+	require.Error(t, sender.Send(context.Background(), testMail()))
+}
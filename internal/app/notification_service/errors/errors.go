@@ -0,0 +1,9 @@
+package errors
+
+import "errors"
+
+var (
+	ErrNotificationNotFound = errors.New("NOTIFICATION_NOT_FOUND")
+	ErrUserNotFound         = errors.New("USER_NOT_FOUND")
+	ErrInvalidData          = errors.New("INVALID_DATA")
+)
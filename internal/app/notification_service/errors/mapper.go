@@ -0,0 +1,15 @@
+package errors
+
+import (
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"google.golang.org/grpc/codes"
+)
+
+var ErrorMap = map[error]struct {
+	Code codes.Code
+	Msg  string
+}{
+	ErrNotificationNotFound: {Code: codes.NotFound, Msg: string(models.ErrCodeNotificationNotFound)},
+	ErrUserNotFound:         {Code: codes.NotFound, Msg: string(models.ErrCodeUserNotFound)},
+	ErrInvalidData:          {Code: codes.InvalidArgument, Msg: string(models.ErrCodeInvalidData)},
+}
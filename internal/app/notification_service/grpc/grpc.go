@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ntferrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/errors"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+type NotificationServiceServer struct {
+	ntfUC NotificationUseCase
+	ntfpb.UnimplementedNotificationServiceServer
+}
+
+func NewNotificationServer(ntfUC NotificationUseCase) *NotificationServiceServer {
+	return &NotificationServiceServer{ntfUC: ntfUC}
+}
+
+func (s *NotificationServiceServer) ListNotifications(ctx context.Context, req *ntfpb.ListNotificationsRequest) (*ntfpb.ListNotificationsResponse, error) {
+	notifications, err := s.ntfUC.ListNotifications(ctx, int(req.UserId), req.UnreadOnly)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range ntferrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to get notifications", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to get notifications, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return notifications, nil
+}
+
+func (s *NotificationServiceServer) MarkAsRead(ctx context.Context, req *ntfpb.MarkAsReadRequest) (*ntfpb.MarkAsReadResponse, error) {
+	userID, ids := ProtoMarkAsReadToInts(req)
+	resp, err := s.ntfUC.MarkAsRead(ctx, userID, ids)
+	if err != nil {
+		logger := logger.FromContext(ctx)
+		for targetErr, resp := range ntferrors.ErrorMap {
+			if errors.Is(err, targetErr) {
+				if logger != nil {
+					logger.Error("Failed to mark notifications as read", "error", err)
+				}
+				return nil, status.Error(resp.Code, resp.Msg)
+			}
+		}
+		if logger != nil {
+			logger.Error("Failed to mark notifications as read, internal error", "error", err)
+		}
+		return nil, status.Error(codes.Internal, string(models.ErrCodeInternalError))
+	}
+	return resp, nil
+}
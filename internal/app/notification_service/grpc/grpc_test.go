@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ntferrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/errors"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+)
+
+func TestNotificationServiceServer_ListNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockNotificationUseCase(ctrl)
+	server := NewNotificationServer(uc)
+	expected := &ntfpb.ListNotificationsResponse{Unread: 2}
+
+	uc.EXPECT().ListNotifications(gomock.Any(), 1, true).Return(expected, nil)
+
+	resp, err := server.ListNotifications(context.Background(), &ntfpb.ListNotificationsRequest{UserId: 1, UnreadOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, expected, resp)
+}
+
+func TestNotificationServiceServer_MarkAsReadKnownError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockNotificationUseCase(ctrl)
+	server := NewNotificationServer(uc)
+
+	uc.EXPECT().MarkAsRead(gomock.Any(), 1, []int{3}).Return(nil, ntferrors.ErrNotificationNotFound)
+
+	_, err := server.MarkAsRead(context.Background(), &ntfpb.MarkAsReadRequest{UserId: 1, NotificationIds: []int32{3}})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestNotificationServiceServer_MarkAsReadInternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockNotificationUseCase(ctrl)
+	server := NewNotificationServer(uc)
+
+	uc.EXPECT().MarkAsRead(gomock.Any(), 1, []int{}).Return(nil, errors.New("db down"))
+
+	_, err := server.MarkAsRead(context.Background(), &ntfpb.MarkAsReadRequest{UserId: 1})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.Internal, st.Code())
+}
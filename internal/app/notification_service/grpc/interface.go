@@ -0,0 +1,12 @@
+package grpc
+
+import (
+	"context"
+
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+)
+
+type NotificationUseCase interface {
+	ListNotifications(ctx context.Context, userID int, unreadOnly bool) (*ntfpb.ListNotificationsResponse, error)
+	MarkAsRead(ctx context.Context, userID int, ids []int) (*ntfpb.MarkAsReadResponse, error)
+}
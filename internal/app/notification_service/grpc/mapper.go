@@ -0,0 +1,13 @@
+package grpc
+
+import (
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+)
+
+func ProtoMarkAsReadToInts(req *ntfpb.MarkAsReadRequest) (int, []int) {
+	ids := make([]int, 0, len(req.NotificationIds))
+	for _, id := range req.NotificationIds {
+		ids = append(ids, int(id))
+	}
+	return int(req.UserId), ids
+}
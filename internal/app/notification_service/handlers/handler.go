@@ -0,0 +1,137 @@
+package notification
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	httputils "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+type Handler struct {
+	notificationClient ntfpb.NotificationServiceClient
+}
+
+func NewHandler(notificationClient ntfpb.NotificationServiceClient) *Handler {
+	return &Handler{notificationClient: notificationClient}
+}
+
+func (h *Handler) getUserID(r *http.Request) (int, bool) {
+	return middleware.GetUserIDFromContext(r.Context())
+}
+
+// GetNotifications godoc
+// @Summary Входящие уведомления
+// @Description Возвращает уведомления пользователя, начиная с новых, и число непрочитанных
+// @Tags notifications
+// @Produce json
+// @Security ApiKeyAuth
+// @Param unread query bool false "Только непрочитанные"
+// @Success 200 {object} models.NotificationsResponse "Уведомления пользователя"
+// @Failure 400 {object} models.ErrorResponse "Некорректный параметр unread (INVALID_REQUEST)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /notifications [get]
+func (h *Handler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	unreadOnly := false
+	if value := r.URL.Query().Get("unread"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			httputils.ValidationError(w, r, "Некорректное значение unread", "unread")
+			return
+		}
+		unreadOnly = parsed
+	}
+
+	notifications, err := h.notificationClient.ListNotifications(r.Context(), &ntfpb.ListNotificationsRequest{
+		UserId:     int32(userID),
+		UnreadOnly: unreadOnly,
+	})
+	if err != nil {
+		log := logger.FromContext(r.Context())
+		if log != nil {
+			log.Error("grpc ListNotifications error", "error", err)
+		}
+		httputils.InternalError(w, r, "failed to get notifications")
+		return
+	}
+
+	httputils.Success(w, r, NotificationsToAPI(notifications))
+}
+
+// MarkAsRead godoc
+// @Summary Отметить уведомления прочитанными
+// @Description Отмечает прочитанными уведомления с указанными ID; без ID или с пустым телом — все уведомления пользователя
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.MarkNotificationsReadRequest false "ID уведомлений"
+// @Success 200 {object} models.MarkNotificationsReadResponse "Число отмеченных уведомлений"
+// @Failure 400 {object} models.ErrorResponse "Некорректные данные (INVALID_REQUEST, INVALID_DATA)"
+// @Failure 401 {object} models.ErrorResponse "Требуется аутентификация (UNAUTHORIZED, TOKEN_MISSING, TOKEN_INVALID, TOKEN_EXPIRED)"
+// @Failure 404 {object} models.ErrorResponse "Уведомление не найдено (NOTIFICATION_NOT_FOUND)"
+// @Failure 500 {object} models.ErrorResponse "Внутренняя ошибка сервера (INTERNAL_ERROR, DATABASE_ERROR)"
+// @Router /notifications/read [post]
+func (h *Handler) MarkAsRead(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getUserID(r)
+	if !ok {
+		httputils.Error(w, r, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	var req models.MarkNotificationsReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		httputils.ValidationError(w, r, "Некорректный формат данных", "body")
+		return
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) > 0 {
+		httputils.ValidationErrors(w, r, validationErrors)
+		return
+	}
+
+	resp, err := h.notificationClient.MarkAsRead(r.Context(), MarkAsReadRequestToProto(req, userID))
+	if err != nil {
+		st, ok := status.FromError(err)
+		log := logger.FromContext(r.Context())
+		if !ok {
+			if log != nil {
+				log.Error("grpc MarkAsRead unknown error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to mark notifications as read")
+			return
+		}
+		switch st.Code() {
+		case codes.InvalidArgument:
+			httputils.Error(w, r, "failed to mark notifications as read", http.StatusBadRequest)
+		case codes.NotFound:
+			httputils.NotFoundError(w, r, "Уведомление не найдено")
+		default:
+			if log != nil {
+				log.Error("grpc MarkAsRead error", "error", err)
+			}
+			httputils.InternalError(w, r, "failed to mark notifications as read")
+		}
+		return
+	}
+
+	httputils.Success(w, r, models.MarkNotificationsReadResponse{Updated: int(resp.Updated)})
+}
@@ -0,0 +1,157 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/middleware"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func withUser(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 1))
+}
+
+func TestGetNotifications_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockNotificationServiceClient(ctrl)
+	h := NewHandler(mockClient)
+
+	readAt := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockClient.EXPECT().
+		ListNotifications(gomock.Any(), &ntfpb.ListNotificationsRequest{UserId: 1, UnreadOnly: true}).
+		Return(&ntfpb.ListNotificationsResponse{
+			Notifications: []*ntfpb.Notification{
+				{Id: 2, Kind: "budget_exceeded", BudgetId: 5, Threshold: 100, Title: "Бюджет превышен", CreatedAt: timestamppb.Now()},
+				{Id: 1, Kind: "budget_threshold", BudgetId: 5, Threshold: 80, CreatedAt: timestamppb.Now(), ReadAt: timestamppb.New(readAt)},
+			},
+			Unread: 1,
+		}, nil)
+
+	req := withUser(httptest.NewRequest(http.MethodGet, "/api/v1/notifications?unread=true", nil))
+	rr := httptest.NewRecorder()
+
+	h.GetNotifications(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.NotificationsResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 1, resp.Unread)
+	require.Len(t, resp.Notifications, 2)
+	require.Nil(t, resp.Notifications[0].ReadAt)
+	require.Equal(t, "Бюджет превышен", resp.Notifications[0].Title)
+	require.Equal(t, readAt, *resp.Notifications[1].ReadAt)
+}
+
+func TestGetNotifications_InvalidUnread(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewHandler(mocks.NewMockNotificationServiceClient(ctrl))
+
+	req := withUser(httptest.NewRequest(http.MethodGet, "/api/v1/notifications?unread=maybe", nil))
+	rr := httptest.NewRecorder()
+
+	h.GetNotifications(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetNotifications_Unauthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewHandler(mocks.NewMockNotificationServiceClient(ctrl))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/notifications", nil)
+	rr := httptest.NewRecorder()
+
+	h.GetNotifications(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestMarkAsRead_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockNotificationServiceClient(ctrl)
+	h := NewHandler(mockClient)
+
+	mockClient.EXPECT().
+		MarkAsRead(gomock.Any(), &ntfpb.MarkAsReadRequest{UserId: 1, NotificationIds: []int32{3, 4}}).
+		Return(&ntfpb.MarkAsReadResponse{Updated: 2}, nil)
+
+	body, _ := json.Marshal(models.MarkNotificationsReadRequest{IDs: []int{3, 4}})
+	req := withUser(httptest.NewRequest(http.MethodPost, "/api/v1/notifications/read", bytes.NewReader(body)))
+	rr := httptest.NewRecorder()
+
+	h.MarkAsRead(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp models.MarkNotificationsReadResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 2, resp.Updated)
+}
+
+func TestMarkAsRead_EmptyBodyMarksAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockNotificationServiceClient(ctrl)
+	h := NewHandler(mockClient)
+
+	mockClient.EXPECT().
+		MarkAsRead(gomock.Any(), &ntfpb.MarkAsReadRequest{UserId: 1, NotificationIds: []int32{}}).
+		Return(&ntfpb.MarkAsReadResponse{Updated: 5}, nil)
+
+	req := withUser(httptest.NewRequest(http.MethodPost, "/api/v1/notifications/read", nil))
+	rr := httptest.NewRecorder()
+
+	h.MarkAsRead(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestMarkAsRead_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockNotificationServiceClient(ctrl)
+	h := NewHandler(mockClient)
+
+	mockClient.EXPECT().
+		MarkAsRead(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "not found"))
+
+	req := withUser(httptest.NewRequest(http.MethodPost, "/api/v1/notifications/read", bytes.NewReader([]byte(`{"ids":[9]}`))))
+	rr := httptest.NewRecorder()
+
+	h.MarkAsRead(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestMarkAsRead_InvalidIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewHandler(mocks.NewMockNotificationServiceClient(ctrl))
+
+	req := withUser(httptest.NewRequest(http.MethodPost, "/api/v1/notifications/read", bytes.NewReader([]byte(`{"ids":[0]}`))))
+	rr := httptest.NewRecorder()
+
+	h.MarkAsRead(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
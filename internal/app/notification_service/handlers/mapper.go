@@ -0,0 +1,45 @@
+package notification
+
+import (
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+func NotificationToAPI(n *ntfpb.Notification) models.Notification {
+	notification := models.Notification{
+		ID:        int(n.Id),
+		Kind:      n.Kind,
+		BudgetID:  int(n.BudgetId),
+		Threshold: int(n.Threshold),
+		Title:     n.Title,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt.AsTime(),
+	}
+	if n.ReadAt != nil {
+		readAt := n.ReadAt.AsTime()
+		notification.ReadAt = &readAt
+	}
+	return notification
+}
+
+func NotificationsToAPI(resp *ntfpb.ListNotificationsResponse) models.NotificationsResponse {
+	notifications := make([]models.Notification, 0, len(resp.Notifications))
+	for _, n := range resp.Notifications {
+		notifications = append(notifications, NotificationToAPI(n))
+	}
+	return models.NotificationsResponse{
+		Notifications: notifications,
+		Unread:        int(resp.Unread),
+	}
+}
+
+func MarkAsReadRequestToProto(req models.MarkNotificationsReadRequest, userID int) *ntfpb.MarkAsReadRequest {
+	ids := make([]int32, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		ids = append(ids, int32(id))
+	}
+	return &ntfpb.MarkAsReadRequest{
+		UserId:          int32(userID),
+		NotificationIds: ids,
+	}
+}
@@ -0,0 +1,16 @@
+package notification
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+)
+
+func Register(r *mux.Router, notificationClient ntfpb.NotificationServiceClient) {
+	handler := NewHandler(notificationClient)
+
+	r.HandleFunc("/notifications", handler.GetNotifications).Methods(http.MethodGet)
+	r.HandleFunc("/notifications/read", handler.MarkAsRead).Methods(http.MethodPost)
+}
@@ -0,0 +1,10 @@
+package models
+
+// TransactionEvent поля сообщения об операции из топика transactions, по которым
+// проверяются бюджеты. Остальные поля сообщения сервису уведомлений не нужны.
+type TransactionEvent struct {
+	ID        int    `json:"id"`
+	AccountID int    `json:"account_id"`
+	Type      string `json:"type"`
+	Action    string `json:"action"`
+}
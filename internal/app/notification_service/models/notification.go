@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	// KindBudgetThreshold расходы по бюджету достигли Threshold процентов суммы
+	KindBudgetThreshold = "budget_threshold"
+	// KindBudgetExceeded расходы по бюджету превысили его сумму
+	KindBudgetExceeded = "budget_exceeded"
+)
+
+type Notification struct {
+	ID        int
+	UserID    int
+	Kind      string
+	BudgetID  int
+	Threshold int
+	Title     string
+	Body      string
+	CreatedAt time.Time
+	ReadAt    time.Time
+}
+
+func (n Notification) IsRead() bool {
+	return !n.ReadAt.IsZero()
+}
+
+// Threshold уровень расходов по бюджету, о котором нужно уведомить пользователя.
+type Threshold struct {
+	Kind    string
+	Percent int
+}
+
+// Recipient адрес, на который дублируются уведомления пользователя.
+type Recipient struct {
+	UserID int
+	Name   string
+	Email  string
+}
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
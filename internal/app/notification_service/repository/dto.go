@@ -0,0 +1,18 @@
+package notification
+
+import (
+	"database/sql"
+	"time"
+)
+
+type NotificationDB struct {
+	ID        int
+	UserID    int
+	Kind      string
+	BudgetID  sql.NullInt64
+	Threshold int
+	Title     string
+	Body      string
+	CreatedAt time.Time
+	ReadAt    *time.Time
+}
@@ -0,0 +1,22 @@
+package notification
+
+import (
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+)
+
+func NotificationDBToModel(n NotificationDB) ntfmodels.Notification {
+	notification := ntfmodels.Notification{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Kind:      n.Kind,
+		BudgetID:  int(n.BudgetID.Int64),
+		Threshold: n.Threshold,
+		Title:     n.Title,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+	}
+	if n.ReadAt != nil {
+		notification.ReadAt = *n.ReadAt
+	}
+	return notification
+}
@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+)
+
+const notificationColumns = `_id, user_id, kind, budget_id, threshold, title, body, created_at, read_at`
+
+type PostgresRepository struct {
+	db *sql.DB
+}
+
+func NewDBConnection(dsn string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return db, nil
+}
+
+func NewPostgresRepository(db *sql.DB) *PostgresRepository {
+	return &PostgresRepository{db: db}
+}
+
+// CreateNotification сохраняет уведомление, если такого же уведомления о бюджете еще нет.
+// Для уже существующего уведомления возвращает пустую модель (ID == 0).
+func (r *PostgresRepository) CreateNotification(ctx context.Context, n ntfmodels.Notification) (ntfmodels.Notification, error) {
+	query := `
+		INSERT INTO notification (user_id, kind, budget_id, threshold, title, body, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (user_id, budget_id, kind, threshold) DO NOTHING
+		RETURNING _id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		n.UserID,
+		n.Kind,
+		n.BudgetID,
+		n.Threshold,
+		n.Title,
+		n.Body,
+	).Scan(&n.ID, &n.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ntfmodels.Notification{}, nil
+	}
+	if err != nil {
+		return ntfmodels.Notification{}, fmt.Errorf("failed to create notification: %w", err)
+	}
+	return n, nil
+}
+
+// GetNotifications возвращает уведомления пользователя, начиная с новых.
+func (r *PostgresRepository) GetNotifications(ctx context.Context, userID int, unreadOnly bool) ([]ntfmodels.Notification, error) {
+	query := `
+		SELECT ` + notificationColumns + `
+		FROM notification
+		WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL)
+		ORDER BY created_at DESC, _id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, unreadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []ntfmodels.Notification
+	for rows.Next() {
+		var n NotificationDB
+		if err := rows.Scan(
+			&n.ID,
+			&n.UserID,
+			&n.Kind,
+			&n.BudgetID,
+			&n.Threshold,
+			&n.Title,
+			&n.Body,
+			&n.CreatedAt,
+			&n.ReadAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, NotificationDBToModel(n))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (r *PostgresRepository) CountUnread(ctx context.Context, userID int) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM notification WHERE user_id = $1 AND read_at IS NULL
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkAsRead отмечает прочитанными уведомления ids пользователя или все его уведомления, если ids пуст.
+// Возвращает число уведомлений, которые были непрочитанными.
+func (r *PostgresRepository) MarkAsRead(ctx context.Context, userID int, ids []int) (int, error) {
+	idsArr := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		idsArr = append(idsArr, int64(id))
+	}
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE notification SET read_at = NOW()
+		WHERE user_id = $1 AND read_at IS NULL
+		  AND (cardinality($2::int[]) = 0 OR _id = ANY($2))
+	`, userID, idsArr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications as read: %w", err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(updated), nil
+}
+
+// CountUserNotifications возвращает, сколько уведомлений из ids принадлежит пользователю.
+func (r *PostgresRepository) CountUserNotifications(ctx context.Context, userID int, ids []int) (int, error) {
+	idsArr := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		idsArr = append(idsArr, int64(id))
+	}
+
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM notification WHERE user_id = $1 AND _id = ANY($2)
+	`, userID, idsArr).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,131 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	ntferrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/errors"
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+)
+
+func TestPostgresRepository_CreateNotification(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery("INSERT INTO notification").
+		WithArgs(1, ntfmodels.KindBudgetThreshold, 5, 80, "title", "body").
+		WillReturnRows(sqlmock.NewRows([]string{"_id", "created_at"}).AddRow(9, now))
+
+	created, err := repo.CreateNotification(context.Background(), ntfmodels.Notification{
+		UserID:    1,
+		Kind:      ntfmodels.KindBudgetThreshold,
+		BudgetID:  5,
+		Threshold: 80,
+		Title:     "title",
+		Body:      "body",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 9, created.ID)
+	require.Equal(t, now, created.CreatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_CreateNotification_AlreadySent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery("INSERT INTO notification").
+		WillReturnError(sql.ErrNoRows)
+
+	created, err := repo.CreateNotification(context.Background(), ntfmodels.Notification{UserID: 1, BudgetID: 5})
+	require.NoError(t, err)
+	require.Zero(t, created.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_GetNotifications(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"_id", "user_id", "kind", "budget_id", "threshold", "title", "body", "created_at", "read_at"}).
+		AddRow(2, 1, ntfmodels.KindBudgetExceeded, 5, 100, "t2", "b2", now, nil).
+		AddRow(1, 1, ntfmodels.KindBudgetThreshold, 5, 80, "t1", "b1", now, now)
+	mock.ExpectQuery("FROM notification").
+		WithArgs(1, false).
+		WillReturnRows(rows)
+
+	notifications, err := repo.GetNotifications(context.Background(), 1, false)
+	require.NoError(t, err)
+	require.Len(t, notifications, 2)
+	require.False(t, notifications[0].IsRead())
+	require.Equal(t, 5, notifications[0].BudgetID)
+	require.True(t, notifications[1].IsRead())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_MarkAsRead(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec("UPDATE notification SET read_at").
+		WithArgs(1, pq.Int64Array{3, 4}).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	updated, err := repo.MarkAsRead(context.Background(), 1, []int{3, 4})
+	require.NoError(t, err)
+	require.Equal(t, 2, updated)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_MarkAsRead_All(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectExec("UPDATE notification SET read_at").
+		WithArgs(1, pq.Int64Array{}).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+
+	updated, err := repo.MarkAsRead(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, 5, updated)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresRepository_GetRecipient_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresRepository(db)
+
+	mock.ExpectQuery(`SELECT user_name, email FROM "user"`).
+		WithArgs(1).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetRecipient(context.Background(), 1)
+	require.ErrorIs(t, err, ntferrors.ErrUserNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,25 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	ntferrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/errors"
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+)
+
+func (r *PostgresRepository) GetRecipient(ctx context.Context, userID int) (ntfmodels.Recipient, error) {
+	recipient := ntfmodels.Recipient{UserID: userID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_name, email FROM "user" WHERE _id = $1
+	`, userID).Scan(&recipient.Name, &recipient.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ntfmodels.Recipient{}, ntferrors.ErrUserNotFound
+	}
+	if err != nil {
+		return ntfmodels.Recipient{}, fmt.Errorf("failed to get recipient: %w", err)
+	}
+	return recipient, nil
+}
@@ -0,0 +1,174 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	ntferrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/errors"
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+)
+
+// exceededThreshold значение порога в настройке, означающее превышение суммы бюджета
+const exceededThreshold = "exceeded"
+
+// ParseThresholds разбирает пороги уведомлений вида "80,100,exceeded":
+// числа — проценты от суммы бюджета, exceeded — превышение суммы.
+// Пороги возвращаются по возрастанию, превышение считается выше 100%.
+func ParseThresholds(value string) ([]ntfmodels.Threshold, error) {
+	var thresholds []ntfmodels.Threshold
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		threshold := ntfmodels.Threshold{Kind: ntfmodels.KindBudgetExceeded, Percent: 100}
+		if part != exceededThreshold {
+			percent, err := strconv.Atoi(strings.TrimSuffix(part, "%"))
+			if err != nil || percent <= 0 || percent > 1000 {
+				return nil, pkgerrors.Wrapf(ntferrors.ErrInvalidData, "invalid budget alert threshold %q", part)
+			}
+			threshold = ntfmodels.Threshold{Kind: ntfmodels.KindBudgetThreshold, Percent: percent}
+		}
+		if !slices.Contains(thresholds, threshold) {
+			thresholds = append(thresholds, threshold)
+		}
+	}
+
+	slices.SortFunc(thresholds, func(a, b ntfmodels.Threshold) int {
+		if a.Percent != b.Percent {
+			return a.Percent - b.Percent
+		}
+		// при равном проценте превышение выше достижения порога
+		return strings.Compare(b.Kind, a.Kind)
+	})
+	return thresholds, nil
+}
+
+// HandleTransaction проверяет бюджеты всех пользователей счета операции.
+// Удаление операции и доходы не увеличивают расходы, поэтому не проверяются.
+// Возвращает число созданных уведомлений.
+func (s *Service) HandleTransaction(ctx context.Context, event ntfmodels.TransactionEvent) (int, error) {
+	if event.Action == models.DELETE || event.Type != string(models.OperationExpense) || event.AccountID == 0 {
+		return 0, nil
+	}
+
+	users, err := s.finance.GetAccountUserIDs(ctx, &finpb.AccountID{AccountId: int32(event.AccountID)})
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "Failed to get account users")
+	}
+
+	created := 0
+	var firstErr error
+	for _, userID := range users.GetUserIds() {
+		n, err := s.CheckBudgets(ctx, int(userID))
+		created += n
+		if err != nil && firstErr == nil {
+			firstErr = pkgerrors.Wrapf(err, "Failed to check budgets of user %d", userID)
+		}
+	}
+	return created, firstErr
+}
+
+// CheckBudgets создает уведомления по открытым бюджетам пользователя. По каждому бюджету
+// уведомление создается только для самого высокого из пройденных порогов; уведомление
+// о пороге, о котором пользователь уже знает, повторно не создается.
+func (s *Service) CheckBudgets(ctx context.Context, userID int) (int, error) {
+	budgets, err := s.budgets.GetListBudgets(ctx, &bdgpb.UserID{UserID: int32(userID)})
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "Failed to get budgets")
+	}
+
+	created := 0
+	for _, budget := range budgets.GetBudgets() {
+		threshold, ok := s.highestCrossed(budget)
+		if !ok {
+			continue
+		}
+
+		notification, err := s.repo.CreateNotification(ctx, budgetNotification(userID, budget, threshold))
+		if err != nil {
+			return created, pkgerrors.Wrap(err, "Failed to create notification")
+		}
+		if notification.ID == 0 {
+			continue
+		}
+		created++
+		s.deliver(ctx, notification)
+	}
+	return created, nil
+}
+
+func (s *Service) highestCrossed(budget *bdgpb.Budget) (ntfmodels.Threshold, bool) {
+	if budget.GetSum() <= 0 || budget.GetClosedAt() != nil {
+		return ntfmodels.Threshold{}, false
+	}
+	for i := len(s.thresholds) - 1; i >= 0; i-- {
+		if crossed(budget, s.thresholds[i]) {
+			return s.thresholds[i], true
+		}
+	}
+	return ntfmodels.Threshold{}, false
+}
+
+func crossed(budget *bdgpb.Budget, threshold ntfmodels.Threshold) bool {
+	if threshold.Kind == ntfmodels.KindBudgetExceeded {
+		return budget.GetActual() > budget.GetSum()
+	}
+	return budget.GetActual()*100 >= budget.GetSum()*float64(threshold.Percent)
+}
+
+func budgetNotification(userID int, budget *bdgpb.Budget, threshold ntfmodels.Threshold) ntfmodels.Notification {
+	name := budget.GetDescription()
+	if name == "" {
+		name = fmt.Sprintf("#%d", budget.GetId())
+	}
+	period := fmt.Sprintf("%s – %s",
+		budget.GetPeriodStart().AsTime().Format("02.01.2006"),
+		budget.GetPeriodEnd().AsTime().Format("02.01.2006"),
+	)
+
+	notification := ntfmodels.Notification{
+		UserID:    userID,
+		Kind:      threshold.Kind,
+		BudgetID:  int(budget.GetId()),
+		Threshold: threshold.Percent,
+	}
+	if threshold.Kind == ntfmodels.KindBudgetExceeded {
+		notification.Title = "Бюджет превышен"
+		notification.Body = fmt.Sprintf("Расходы по бюджету «%s» за период %s составили %.2f при лимите %.2f: превышение на %.2f.",
+			name, period, budget.GetActual(), budget.GetSum(), budget.GetActual()-budget.GetSum())
+		return notification
+	}
+	notification.Title = fmt.Sprintf("Бюджет израсходован на %d%%", threshold.Percent)
+	notification.Body = fmt.Sprintf("Расходы по бюджету «%s» за период %s составили %.2f из %.2f.",
+		name, period, budget.GetActual(), budget.GetSum())
+	return notification
+}
+
+// deliver дублирует уведомление письмом. Ошибка доставки только логируется:
+// уведомление уже сохранено и доступно во входящих.
+func (s *Service) deliver(ctx context.Context, notification ntfmodels.Notification) {
+	log := logger.FromContext(ctx)
+
+	recipient, err := s.repo.GetRecipient(ctx, notification.UserID)
+	if err == nil {
+		err = s.mail.Send(ctx, ntfmodels.Mail{
+			To:      recipient.Email,
+			Subject: notification.Title,
+			Body:    fmt.Sprintf("Здравствуйте, %s!\r\n\r\n%s", recipient.Name, notification.Body),
+		})
+	}
+	if err != nil && log != nil {
+		log.Error("Failed to deliver notification", "error", err, "notification_id", notification.ID, "user_id", notification.UserID)
+	}
+}
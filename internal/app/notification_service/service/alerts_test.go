@@ -0,0 +1,177 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	ntferrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/errors"
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+)
+
+func defaultThresholds(t *testing.T) []ntfmodels.Threshold {
+	thresholds, err := ParseThresholds("80,100,exceeded")
+	require.NoError(t, err)
+	return thresholds
+}
+
+func TestParseThresholds(t *testing.T) {
+	thresholds, err := ParseThresholds(" exceeded, 100,80%,80 ")
+	require.NoError(t, err)
+	require.Equal(t, []ntfmodels.Threshold{
+		{Kind: ntfmodels.KindBudgetThreshold, Percent: 80},
+		{Kind: ntfmodels.KindBudgetThreshold, Percent: 100},
+		{Kind: ntfmodels.KindBudgetExceeded, Percent: 100},
+	}, thresholds)
+
+	for _, value := range []string{"abc", "0", "-10", "80,over"} {
+		_, err := ParseThresholds(value)
+		require.ErrorIs(t, err, ntferrors.ErrInvalidData, value)
+	}
+}
+
+func TestService_CheckBudgets_HighestThresholdOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockNotificationRepository(ctrl)
+	budgets := mocks.NewMockBudgetServiceClient(ctrl)
+	mail := mocks.NewMockMailSender(ctrl)
+	svc := NewService(repo, budgets, nil, mail, defaultThresholds(t))
+
+	budgets.EXPECT().
+		GetListBudgets(gomock.Any(), &bdgpb.UserID{UserID: 1}).
+		Return(&bdgpb.ListBudgetsResponse{Budgets: []*bdgpb.Budget{
+			{Id: 1, Sum: 100, Actual: 50},
+			{Id: 2, Sum: 100, Actual: 85, Description: "Еда"},
+			{Id: 3, Sum: 100, Actual: 100},
+			{Id: 4, Sum: 100, Actual: 130},
+			{Id: 5, Sum: 0, Actual: 10},
+		}}, nil)
+
+	var created []ntfmodels.Notification
+	repo.EXPECT().
+		CreateNotification(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, n ntfmodels.Notification) (ntfmodels.Notification, error) {
+			created = append(created, n)
+			n.ID = len(created)
+			return n, nil
+		}).Times(3)
+	repo.EXPECT().
+		GetRecipient(gomock.Any(), 1).
+		Return(ntfmodels.Recipient{UserID: 1, Name: "Иван", Email: "ivan@example.com"}, nil).Times(3)
+	mail.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	n, err := svc.CheckBudgets(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	require.Equal(t, 2, created[0].BudgetID)
+	require.Equal(t, ntfmodels.KindBudgetThreshold, created[0].Kind)
+	require.Equal(t, 80, created[0].Threshold)
+	require.Equal(t, "Бюджет израсходован на 80%", created[0].Title)
+	require.Contains(t, created[0].Body, "«Еда»")
+
+	require.Equal(t, 3, created[1].BudgetID)
+	require.Equal(t, 100, created[1].Threshold)
+	require.Equal(t, ntfmodels.KindBudgetThreshold, created[1].Kind)
+
+	require.Equal(t, 4, created[2].BudgetID)
+	require.Equal(t, ntfmodels.KindBudgetExceeded, created[2].Kind)
+	require.Equal(t, "Бюджет превышен", created[2].Title)
+}
+
+func TestService_CheckBudgets_AlreadyNotified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockNotificationRepository(ctrl)
+	budgets := mocks.NewMockBudgetServiceClient(ctrl)
+	mail := mocks.NewMockMailSender(ctrl)
+	svc := NewService(repo, budgets, nil, mail, defaultThresholds(t))
+
+	budgets.EXPECT().
+		GetListBudgets(gomock.Any(), gomock.Any()).
+		Return(&bdgpb.ListBudgetsResponse{Budgets: []*bdgpb.Budget{{Id: 2, Sum: 100, Actual: 90}}}, nil)
+	repo.EXPECT().
+		CreateNotification(gomock.Any(), gomock.Any()).
+		Return(ntfmodels.Notification{}, nil)
+
+	n, err := svc.CheckBudgets(context.Background(), 1)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestService_CheckBudgets_DeliveryFailureIgnored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockNotificationRepository(ctrl)
+	budgets := mocks.NewMockBudgetServiceClient(ctrl)
+	mail := mocks.NewMockMailSender(ctrl)
+	svc := NewService(repo, budgets, nil, mail, defaultThresholds(t))
+
+	budgets.EXPECT().
+		GetListBudgets(gomock.Any(), gomock.Any()).
+		Return(&bdgpb.ListBudgetsResponse{Budgets: []*bdgpb.Budget{{Id: 2, Sum: 100, Actual: 120}}}, nil)
+	repo.EXPECT().
+		CreateNotification(gomock.Any(), gomock.Any()).
+		Return(ntfmodels.Notification{ID: 1, UserID: 1}, nil)
+	repo.EXPECT().
+		GetRecipient(gomock.Any(), 1).
+		Return(ntfmodels.Recipient{Email: "ivan@example.com"}, nil)
+	mail.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("smtp down"))
+
+	n, err := svc.CheckBudgets(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestService_HandleTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockNotificationRepository(ctrl)
+	budgets := mocks.NewMockBudgetServiceClient(ctrl)
+	finance := mocks.NewMockFinanceServiceClient(ctrl)
+	svc := NewService(repo, budgets, finance, mocks.NewMockMailSender(ctrl), defaultThresholds(t))
+
+	finance.EXPECT().
+		GetAccountUserIDs(gomock.Any(), &finpb.AccountID{AccountId: 7}).
+		Return(&finpb.UserIDs{UserIds: []int32{1, 2}}, nil)
+	budgets.EXPECT().
+		GetListBudgets(gomock.Any(), &bdgpb.UserID{UserID: 1}).
+		Return(&bdgpb.ListBudgetsResponse{}, nil)
+	budgets.EXPECT().
+		GetListBudgets(gomock.Any(), &bdgpb.UserID{UserID: 2}).
+		Return(nil, errors.New("unavailable"))
+
+	n, err := svc.HandleTransaction(context.Background(), ntfmodels.TransactionEvent{
+		ID: 10, AccountID: 7, Type: "expense", Action: "create",
+	})
+	require.Error(t, err)
+	require.Zero(t, n)
+}
+
+func TestService_HandleTransaction_Skipped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := NewService(mocks.NewMockNotificationRepository(ctrl), mocks.NewMockBudgetServiceClient(ctrl),
+		mocks.NewMockFinanceServiceClient(ctrl), mocks.NewMockMailSender(ctrl), defaultThresholds(t))
+
+	for _, event := range []ntfmodels.TransactionEvent{
+		{ID: 1, AccountID: 7, Type: "income", Action: "create"},
+		{ID: 1, Action: "delete"},
+	} {
+		n, err := svc.HandleTransaction(context.Background(), event)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	}
+}
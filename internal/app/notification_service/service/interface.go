@@ -0,0 +1,20 @@
+package notification
+
+import (
+	"context"
+
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+)
+
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, n ntfmodels.Notification) (ntfmodels.Notification, error)
+	GetNotifications(ctx context.Context, userID int, unreadOnly bool) ([]ntfmodels.Notification, error)
+	CountUnread(ctx context.Context, userID int) (int, error)
+	CountUserNotifications(ctx context.Context, userID int, ids []int) (int, error)
+	MarkAsRead(ctx context.Context, userID int, ids []int) (int, error)
+	GetRecipient(ctx context.Context, userID int) (ntfmodels.Recipient, error)
+}
+
+type MailSender interface {
+	Send(ctx context.Context, mail ntfmodels.Mail) error
+}
@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+)
+
+func ModelNotificationToProto(n ntfmodels.Notification) *ntfpb.Notification {
+	var readAt *timestamppb.Timestamp
+	if n.IsRead() {
+		readAt = timestamppb.New(n.ReadAt)
+	}
+	return &ntfpb.Notification{
+		Id:        int32(n.ID),
+		UserId:    int32(n.UserID),
+		Kind:      n.Kind,
+		BudgetId:  int32(n.BudgetID),
+		Threshold: int32(n.Threshold),
+		Title:     n.Title,
+		Body:      n.Body,
+		CreatedAt: timestamppb.New(n.CreatedAt),
+		ReadAt:    readAt,
+	}
+}
+
+func ModelListToProto(notifications []ntfmodels.Notification, unread int) *ntfpb.ListNotificationsResponse {
+	resp := &ntfpb.ListNotificationsResponse{
+		Notifications: make([]*ntfpb.Notification, 0, len(notifications)),
+		Unread:        int32(unread),
+	}
+	for _, n := range notifications {
+		resp.Notifications = append(resp.Notifications, ModelNotificationToProto(n))
+	}
+	return resp
+}
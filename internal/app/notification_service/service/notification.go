@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"context"
+	"slices"
+
+	pkgerrors "github.com/pkg/errors"
+
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	ntferrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/errors"
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+)
+
+type Service struct {
+	repo       NotificationRepository
+	budgets    bdgpb.BudgetServiceClient
+	finance    finpb.FinanceServiceClient
+	mail       MailSender
+	thresholds []ntfmodels.Threshold
+}
+
+func NewService(repo NotificationRepository, budgetClient bdgpb.BudgetServiceClient, financeClient finpb.FinanceServiceClient, mail MailSender, thresholds []ntfmodels.Threshold) *Service {
+	return &Service{
+		repo:       repo,
+		budgets:    budgetClient,
+		finance:    financeClient,
+		mail:       mail,
+		thresholds: thresholds,
+	}
+}
+
+func (s *Service) ListNotifications(ctx context.Context, userID int, unreadOnly bool) (*ntfpb.ListNotificationsResponse, error) {
+	notifications, err := s.repo.GetNotifications(ctx, userID, unreadOnly)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to get notifications")
+	}
+
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to count unread notifications")
+	}
+
+	return ModelListToProto(notifications, unread), nil
+}
+
+// MarkAsRead отмечает прочитанными уведомления ids, а при пустом ids — все уведомления пользователя.
+// Если хотя бы одно из ids не принадлежит пользователю, ничего не меняется.
+func (s *Service) MarkAsRead(ctx context.Context, userID int, ids []int) (*ntfpb.MarkAsReadResponse, error) {
+	if len(ids) > 0 {
+		ids = slices.Clone(ids)
+		slices.Sort(ids)
+		ids = slices.Compact(ids)
+		if ids[0] <= 0 {
+			return nil, ntferrors.ErrInvalidData
+		}
+
+		owned, err := s.repo.CountUserNotifications(ctx, userID, ids)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "Failed to check notifications")
+		}
+		if owned != len(ids) {
+			return nil, ntferrors.ErrNotificationNotFound
+		}
+	}
+
+	updated, err := s.repo.MarkAsRead(ctx, userID, ids)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Failed to mark notifications as read")
+	}
+
+	return &ntfpb.MarkAsReadResponse{Updated: int32(updated)}, nil
+}
@@ -0,0 +1,76 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	ntferrors "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/errors"
+	ntfmodels "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+)
+
+func TestService_ListNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockNotificationRepository(ctrl)
+	svc := NewService(repo, nil, nil, nil, nil)
+
+	repo.EXPECT().GetNotifications(gomock.Any(), 1, true).
+		Return([]ntfmodels.Notification{{ID: 3, UserID: 1, Title: "t"}}, nil)
+	repo.EXPECT().CountUnread(gomock.Any(), 1).Return(1, nil)
+
+	resp, err := svc.ListNotifications(context.Background(), 1, true)
+	require.NoError(t, err)
+	require.Len(t, resp.Notifications, 1)
+	require.Nil(t, resp.Notifications[0].ReadAt)
+	require.EqualValues(t, 1, resp.Unread)
+}
+
+func TestService_MarkAsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockNotificationRepository(ctrl)
+	svc := NewService(repo, nil, nil, nil, nil)
+
+	repo.EXPECT().CountUserNotifications(gomock.Any(), 1, []int{3, 4}).Return(2, nil)
+	repo.EXPECT().MarkAsRead(gomock.Any(), 1, []int{3, 4}).Return(1, nil)
+
+	resp, err := svc.MarkAsRead(context.Background(), 1, []int{4, 3, 4})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.Updated)
+}
+
+func TestService_MarkAsRead_All(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockNotificationRepository(ctrl)
+	svc := NewService(repo, nil, nil, nil, nil)
+
+	repo.EXPECT().MarkAsRead(gomock.Any(), 1, nil).Return(5, nil)
+
+	resp, err := svc.MarkAsRead(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, resp.Updated)
+}
+
+func TestService_MarkAsRead_Foreign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockNotificationRepository(ctrl)
+	svc := NewService(repo, nil, nil, nil, nil)
+
+	repo.EXPECT().CountUserNotifications(gomock.Any(), 1, []int{3, 9}).Return(1, nil)
+
+	_, err := svc.MarkAsRead(context.Background(), 1, []int{3, 9})
+	require.ErrorIs(t, err, ntferrors.ErrNotificationNotFound)
+
+	_, err = svc.MarkAsRead(context.Background(), 1, []int{0})
+	require.ErrorIs(t, err, ntferrors.ErrInvalidData)
+}
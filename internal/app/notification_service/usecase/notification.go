@@ -0,0 +1,42 @@
+package notification
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+)
+
+type UseCase struct {
+	notificationSvc NotificationService
+}
+
+func NewNotificationUseCase(notificationService NotificationService) *UseCase {
+	return &UseCase{notificationSvc: notificationService}
+}
+
+func (uc *UseCase) ListNotifications(ctx context.Context, userID int, unreadOnly bool) (*ntfpb.ListNotificationsResponse, error) {
+	log := logger.FromContext(ctx)
+	notifications, err := uc.notificationSvc.ListNotifications(ctx, userID, unreadOnly)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to get notifications for user", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "notification.ListNotifications")
+	}
+	return notifications, nil
+}
+
+func (uc *UseCase) MarkAsRead(ctx context.Context, userID int, ids []int) (*ntfpb.MarkAsReadResponse, error) {
+	log := logger.FromContext(ctx)
+	resp, err := uc.notificationSvc.MarkAsRead(ctx, userID, ids)
+	if err != nil {
+		if log != nil {
+			log.Error("Failed to mark notifications as read", "error", err, "user_id", userID)
+		}
+		return nil, pkgerrors.Wrap(err, "notification.MarkAsRead")
+	}
+	return resp, nil
+}
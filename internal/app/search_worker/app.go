@@ -76,6 +76,14 @@ func Run() error {
 				if err := handlers.UpdateCategoryInTransaction(es, ctg); err != nil {
 					log.Fatal("Elasticsearch index error:", err)
 				}
+			case "merge":
+				if err := handlers.MergeCategoryInTransactions(es, ctg); err != nil {
+					log.Fatal("Elasticsearch index error:", err)
+				}
+			case "delete":
+				if err := handlers.ClearCategoryInTransactions(es, ctg); err != nil {
+					log.Fatal("Elasticsearch index error:", err)
+				}
 			default:
 				log.Fatal("Unknown action:", ctg.Action)
 			}
@@ -38,6 +38,58 @@ func UpdateCategoryInTransaction(es *elasticsearch.Client, ctg models.Category)
 		return nil
 	}
 
+	if err := updateTransactionsByCategory(es, ctg.CategoryID, scriptLines, params); err != nil {
+		return err
+	}
+
+	log.Printf("Updated operations for category_id=%d\n", ctg.CategoryID)
+	return nil
+}
+
+// MergeCategoryInTransactions переносит операции исходной категории на целевую.
+func MergeCategoryInTransactions(es *elasticsearch.Client, ctg models.Category) error {
+	scriptLines := []string{
+		"ctx._source.category_id = params.category_id;",
+		"ctx._source.category_name = params.category_name;",
+		"ctx._source.category_logo_hashed_id = params.category_logo_hashed_id;",
+		"ctx._source.category_logo = params.category_logo;",
+	}
+	params := map[string]interface{}{
+		"category_id":             ctg.CategoryID,
+		"category_name":           ctg.CategoryName,
+		"category_logo_hashed_id": ctg.CategoryLogoHashedID,
+		"category_logo":           ctg.CategoryLogo,
+	}
+
+	if err := updateTransactionsByCategory(es, ctg.SourceCategoryID, scriptLines, params); err != nil {
+		return err
+	}
+
+	log.Printf("Moved operations from category_id=%d to category_id=%d\n", ctg.SourceCategoryID, ctg.CategoryID)
+	return nil
+}
+
+// ClearCategoryInTransactions оставляет операции удаленной категории без категории.
+func ClearCategoryInTransactions(es *elasticsearch.Client, ctg models.Category) error {
+	scriptLines := []string{
+		"ctx._source.category_id = 0;",
+		"ctx._source.category_name = params.category_name;",
+		"ctx._source.category_logo_hashed_id = '';",
+		"ctx._source.category_logo = '';",
+	}
+	params := map[string]interface{}{
+		"category_name": "Без категории",
+	}
+
+	if err := updateTransactionsByCategory(es, ctg.CategoryID, scriptLines, params); err != nil {
+		return err
+	}
+
+	log.Printf("Cleared category_id=%d in operations\n", ctg.CategoryID)
+	return nil
+}
+
+func updateTransactionsByCategory(es *elasticsearch.Client, categoryID int, scriptLines []string, params map[string]interface{}) error {
 	script := map[string]interface{}{
 		"source": strings.Join(scriptLines, " "),
 		"params": params,
@@ -47,7 +99,7 @@ func UpdateCategoryInTransaction(es *elasticsearch.Client, ctg models.Category)
 		"script": script,
 		"query": map[string]interface{}{
 			"term": map[string]interface{}{
-				"category_id": ctg.CategoryID,
+				"category_id": categoryID,
 			},
 		},
 	}
@@ -64,6 +116,5 @@ func UpdateCategoryInTransaction(es *elasticsearch.Client, ctg models.Category)
 	}
 	defer res.Body.Close()
 
-	log.Printf("Updated operations for category_id=%d\n", ctg.CategoryID)
 	return nil
 }
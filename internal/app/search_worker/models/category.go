@@ -4,6 +4,7 @@ package models
 
 type Category struct {
 	CategoryID           int    `json:"category_id"`
+	SourceCategoryID     int    `json:"source_category_id,omitempty"`
 	CategoryName         string `json:"category_name"`
 	CategoryLogoHashedID string `json:"category_logo_hashed_id"`
 	CategoryLogo         string `json:"category_logo"`
@@ -4,6 +4,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/handlers/auth"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/handlers/backup"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/handlers/profile"
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
 	budget "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/handlers"
@@ -12,6 +13,8 @@ import (
 	category "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/category"
 	operation "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/operation"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	notification "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/handlers"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/usecase"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
@@ -19,30 +22,34 @@ import (
 )
 
 type Handler struct {
-	balanceHandler  *balance.Handler
-	budgetHandler   *budget.Handler
-	authHandler     *auth.Handler
-	opHandler       *operation.Handler
-	categoryHandler *category.Handler
-	profileHandler  *profile.Handler
-	logger          logger.Logger
-	registrator     *Registrator
+	balanceHandler      *balance.Handler
+	budgetHandler       *budget.Handler
+	authHandler         *auth.Handler
+	opHandler           *operation.Handler
+	categoryHandler     *category.Handler
+	profileHandler      *profile.Handler
+	backupHandler       *backup.Handler
+	notificationHandler *notification.Handler
+	logger              logger.Logger
+	registrator         *Registrator
 }
 
-func NewHandler(uc *usecase.UseCase, logger logger.Logger, authClient authpb.AuthServiceClient, budgetClient bdgpb.BudgetServiceClient, finClient finpb.FinanceServiceClient, kafkaProducer kafkautils.KafkaProducer) *Handler {
+func NewHandler(uc *usecase.UseCase, logger logger.Logger, authClient authpb.AuthServiceClient, budgetClient bdgpb.BudgetServiceClient, finClient finpb.FinanceServiceClient, notificationClient ntfpb.NotificationServiceClient, kafkaProducer kafkautils.KafkaProducer) *Handler {
 	realClock := clock.RealClock{}
 	return &Handler{
-		balanceHandler:  balance.NewHandler(finClient, realClock),
-		budgetHandler:   budget.NewHandler(realClock, budgetClient),
-		authHandler:     auth.NewHandler(realClock, logger, authClient),
-		opHandler:       operation.NewHandler(finClient, uc.ImageUC, kafkaProducer, realClock),
-		categoryHandler: category.NewHandler(finClient, uc.ImageUC, kafkaProducer),
-		profileHandler:  profile.NewHandler(uc.ImageUC, authClient),
-		logger:          logger,
-		registrator:     NewRegistrator(uc, logger),
+		balanceHandler:      balance.NewHandler(finClient, realClock),
+		budgetHandler:       budget.NewHandler(realClock, budgetClient),
+		authHandler:         auth.NewHandler(realClock, logger, authClient, finClient),
+		opHandler:           operation.NewHandler(finClient, uc.ImageUC, kafkaProducer, realClock),
+		categoryHandler:     category.NewHandler(finClient, budgetClient, uc.ImageUC, kafkaProducer),
+		profileHandler:      profile.NewHandler(uc.ImageUC, authClient),
+		backupHandler:       backup.NewHandler(uc.ImageUC, authClient, finClient, budgetClient, kafkaProducer, realClock),
+		notificationHandler: notification.NewHandler(notificationClient),
+		logger:              logger,
+		registrator:         NewRegistrator(uc, logger),
 	}
 }
 
-func (h *Handler) Register(publicRouter *mux.Router, protectedRouter *mux.Router, authCleint authpb.AuthServiceClient, budgetClient bdgpb.BudgetServiceClient, finClient finpb.FinanceServiceClient, kafkaProducer kafkautils.KafkaProducer) {
-	h.registrator.RegisterAll(publicRouter, protectedRouter, h.registrator.uc, h.logger, authCleint, budgetClient, finClient, kafkaProducer)
+func (h *Handler) Register(publicRouter *mux.Router, protectedRouter *mux.Router, authCleint authpb.AuthServiceClient, budgetClient bdgpb.BudgetServiceClient, finClient finpb.FinanceServiceClient, notificationClient ntfpb.NotificationServiceClient, kafkaProducer kafkautils.KafkaProducer) {
+	h.registrator.RegisterAll(publicRouter, protectedRouter, h.registrator.uc, h.logger, authCleint, budgetClient, finClient, notificationClient, kafkaProducer)
 }
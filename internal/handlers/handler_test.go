@@ -11,6 +11,7 @@ import (
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
 	budgetpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
 	imagerepo "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/repository"
 	imageservice "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/service"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
@@ -36,10 +37,14 @@ func (testImageStorage) ImageExists(context.Context, string) (bool, error) {
 	return true, nil
 }
 
+func (testImageStorage) GetImage(context.Context, string) ([]byte, string, error) {
+	return nil, "", nil
+}
+
 func newTestUseCase() *usecase.UseCase {
 	imageService := imageservice.NewService(testImageStorage{})
 	svc := &service.Service{ImageUC: imageService}
-	return usecase.NewUseCase(svc, "secret")
+	return usecase.NewUseCase(svc)
 }
 
 func closeLogger(t *testing.T, l logger.Logger) {
@@ -54,7 +59,7 @@ func TestNewHandlerInitializesDependencies(t *testing.T) {
 	l := logger.NewSlogLogger()
 	defer closeLogger(t, l)
 
-	h := NewHandler(uc, l, nil, nil, nil, nil)
+	h := NewHandler(uc, l, nil, nil, nil, nil, nil)
 	require.NotNil(t, h.balanceHandler)
 	require.NotNil(t, h.budgetHandler)
 	require.NotNil(t, h.authHandler)
@@ -69,11 +74,11 @@ func TestHandlerRegisterAddsRoutes(t *testing.T) {
 	l := logger.NewSlogLogger()
 	defer closeLogger(t, l)
 
-	h := NewHandler(uc, l, nil, nil, nil, nil)
+	h := NewHandler(uc, l, nil, nil, nil, nil, nil)
 	publicRouter := mux.NewRouter()
 	protectedRouter := mux.NewRouter()
 
-	h.Register(publicRouter, protectedRouter, nil, nil, nil, nil)
+	h.Register(publicRouter, protectedRouter, nil, nil, nil, nil, nil)
 
 	publicCount := countRoutes(publicRouter)
 	protectedCount := countRoutes(protectedRouter)
@@ -94,8 +99,9 @@ func TestRegistratorRegisterAll(t *testing.T) {
 	var dummyAuth authpb.AuthServiceClient
 	var dummyBudget budgetpb.BudgetServiceClient
 	var dummyFin finpb.FinanceServiceClient
+	var dummyNotification ntfpb.NotificationServiceClient
 
-	reg.RegisterAll(publicRouter, protectedRouter, uc, l, dummyAuth, dummyBudget, dummyFin, nil)
+	reg.RegisterAll(publicRouter, protectedRouter, uc, l, dummyAuth, dummyBudget, dummyFin, dummyNotification, nil)
 
 	require.Greater(t, countRoutes(publicRouter), 0)
 	require.Greater(t, countRoutes(protectedRouter), 0)
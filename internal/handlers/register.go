@@ -4,15 +4,20 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/handlers/auth"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/handlers/backup"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/handlers/profile"
 	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
 	budget "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/handlers"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/handlers/goal"
 	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
 	balance "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/account"
 	category "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/category"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/debt"
 	operation "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/handlers/operation"
 	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
 	image "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/handlers"
+	notification "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/handlers"
+	ntfpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/usecase"
 	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
@@ -31,12 +36,16 @@ func NewRegistrator(uc *usecase.UseCase, log logger.Logger) *Registrator {
 
 }
 
-func (r *Registrator) RegisterAll(publicRouter *mux.Router, protectedRouter *mux.Router, uc *usecase.UseCase, log logger.Logger, authClient authpb.AuthServiceClient, budgetClient bdgpb.BudgetServiceClient, finClient finpb.FinanceServiceClient, kafkaProducer kafkautils.KafkaProducer) {
-	auth.Register(publicRouter, protectedRouter, log, authClient)
+func (r *Registrator) RegisterAll(publicRouter *mux.Router, protectedRouter *mux.Router, uc *usecase.UseCase, log logger.Logger, authClient authpb.AuthServiceClient, budgetClient bdgpb.BudgetServiceClient, finClient finpb.FinanceServiceClient, notificationClient ntfpb.NotificationServiceClient, kafkaProducer kafkautils.KafkaProducer) {
+	auth.Register(publicRouter, protectedRouter, log, authClient, finClient)
 	balance.Register(protectedRouter, finClient)
 	budget.Register(protectedRouter, budgetClient)
+	goal.Register(protectedRouter, budgetClient, uc.ImageUC)
 	operation.Register(protectedRouter, finClient, uc.ImageUC, kafkaProducer)
-	category.Register(protectedRouter, finClient, uc.ImageUC, kafkaProducer)
+	category.Register(protectedRouter, finClient, budgetClient, uc.ImageUC, kafkaProducer)
+	debt.Register(protectedRouter, finClient, uc.ImageUC, kafkaProducer)
 	profile.Register(protectedRouter, uc.ImageUC, authClient)
+	backup.Register(protectedRouter, uc.ImageUC, authClient, finClient, budgetClient, kafkaProducer)
 	image.Register(protectedRouter, uc.ImageUC)
+	notification.Register(protectedRouter, notificationClient)
 }
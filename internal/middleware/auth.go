@@ -3,23 +3,37 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"strings"
 
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/jwks"
 )
 
-type Claims struct {
-	UserID int    `json:"user_id"`
-	Login  string `json:"login"`
-	jwt.RegisteredClaims
-}
-
 type contextKey string
 
-const UserIDKey contextKey = "user_id"
+const (
+	UserIDKey    contextKey = "user_id"
+	SessionIDKey contextKey = "session_id"
+
+	TokenScopesKey contextKey = "token_scopes"
+)
+
+// SessionChecker проверяет, что сессия access-токена не отозвана
+type SessionChecker interface {
+	IsSessionActive(ctx context.Context, userID, sessionID int) (bool, error)
+}
 
-func AuthMiddleware(jwtSecret string) func(http.Handler) http.Handler {
+// AuthMiddleware проверяет access-токен из cookie по открытым ключам
+// auth_service, а персональный токен из заголовка Authorization — через
+// tokens. Если заголовок передан, cookie не проверяется.
+func AuthMiddleware(keys jwks.Resolver, sessions SessionChecker, tokens PersonalTokenAuthenticator) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token, ok := bearerToken(r); ok {
+				authenticatePersonalToken(w, r, next, tokens, token)
+				return
+			}
+
 			cookie, err := r.Cookie("auth_token")
 			if err != nil {
 				http.Error(w, "Authentication required", http.StatusUnauthorized)
@@ -32,27 +46,30 @@ func AuthMiddleware(jwtSecret string) func(http.Handler) http.Handler {
 				return
 			}
 
-			token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-				return []byte(jwtSecret), nil
-			})
-
+			claims, err := utils.ValidateJWT(r.Context(), tokenString, keys)
 			if err != nil {
 				http.Error(w, "Invalid token", http.StatusUnauthorized)
 				return
 			}
 
-			if !token.Valid {
+			// токены без сессии выдавались до появления refresh-токенов
+			if claims.SessionID == 0 {
 				http.Error(w, "Invalid token", http.StatusUnauthorized)
 				return
 			}
 
-			claims, ok := token.Claims.(*Claims)
-			if !ok {
-				http.Error(w, "Invalid token claims", http.StatusUnauthorized)
+			active, err := sessions.IsSessionActive(r.Context(), claims.UserID, claims.SessionID)
+			if err != nil {
+				http.Error(w, "Failed to check session", http.StatusServiceUnavailable)
+				return
+			}
+			if !active {
+				http.Error(w, "Session revoked", http.StatusUnauthorized)
 				return
 			}
 
 			ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
+			ctx = context.WithValue(ctx, SessionIDKey, claims.SessionID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -62,3 +79,29 @@ func GetUserIDFromContext(ctx context.Context) (int, bool) {
 	userID, ok := ctx.Value(UserIDKey).(int)
 	return userID, ok
 }
+
+func GetSessionIDFromContext(ctx context.Context) (int, bool) {
+	sessionID, ok := ctx.Value(SessionIDKey).(int)
+	return sessionID, ok
+}
+
+// GetTokenScopesFromContext области доступа персонального токена;
+// для запросов с cookie ok == false
+func GetTokenScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(TokenScopesKey).([]string)
+	return scopes, ok
+}
+
+// bearerToken токен из заголовка "Authorization: Bearer <token>"; при
+// другой схеме токен пустой, но ok == true — такой запрос отклоняется
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return "", false
+	}
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", true
+	}
+	return strings.TrimSpace(token), true
+}
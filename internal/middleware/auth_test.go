@@ -1,16 +1,30 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/jwks"
 	"github.com/stretchr/testify/require"
 )
 
+func testKeys(t *testing.T) *jwks.KeySet {
+	keys, err := jwks.Generate("test")
+	require.NoError(t, err)
+	return keys
+}
+
+type sessionsStub map[int]bool
+
+func (s sessionsStub) IsSessionActive(_ context.Context, _, sessionID int) (bool, error) {
+	return s[sessionID], nil
+}
+
 func TestAuthMiddleware_NoCookie(t *testing.T) {
-	mw := AuthMiddleware("secret")
+	mw := AuthMiddleware(testKeys(t), sessionsStub{3: true}, nil)
 	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
 	rr := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -19,7 +33,7 @@ func TestAuthMiddleware_NoCookie(t *testing.T) {
 }
 
 func TestAuthMiddleware_InvalidToken(t *testing.T) {
-	mw := AuthMiddleware("secret")
+	mw := AuthMiddleware(testKeys(t), sessionsStub{3: true}, nil)
 	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
 	rr := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -29,15 +43,17 @@ func TestAuthMiddleware_InvalidToken(t *testing.T) {
 }
 
 func TestAuthMiddleware_ValidTokenSetsContext(t *testing.T) {
-	mw := AuthMiddleware("secret")
-	token, err := utils.GenerateJWT(12, "u", "secret")
+	keys := testKeys(t)
+	mw := AuthMiddleware(keys, sessionsStub{3: true}, nil)
+	token, err := utils.GenerateJWT(12, "u", 3, keys)
 	require.NoError(t, err)
 
-	var gotUserID int
+	var gotUserID, gotSessionID int
 	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		id, ok := GetUserIDFromContext(r.Context())
 		require.True(t, ok)
 		gotUserID = id
+		gotSessionID, _ = GetSessionIDFromContext(r.Context())
 		w.WriteHeader(http.StatusOK)
 	})
 	rr := httptest.NewRecorder()
@@ -46,4 +62,47 @@ func TestAuthMiddleware_ValidTokenSetsContext(t *testing.T) {
 	mw(next).ServeHTTP(rr, req)
 	require.Equal(t, http.StatusOK, rr.Code)
 	require.Equal(t, 12, gotUserID)
+	require.Equal(t, 3, gotSessionID)
+}
+
+func TestAuthMiddleware_RevokedSession(t *testing.T) {
+	keys := testKeys(t)
+	mw := AuthMiddleware(keys, sessionsStub{3: false}, nil)
+	token, err := utils.GenerateJWT(12, "u", 3, keys)
+	require.NoError(t, err)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: "auth_token", Value: token})
+	mw(next).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestAuthMiddleware_TokenWithoutSession(t *testing.T) {
+	keys := testKeys(t)
+	mw := AuthMiddleware(keys, sessionsStub{0: true}, nil)
+	token, err := utils.GenerateJWT(12, "u", 0, keys)
+	require.NoError(t, err)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: "auth_token", Value: token})
+	mw(next).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestAuthMiddleware_ForeignKey(t *testing.T) {
+	mw := AuthMiddleware(testKeys(t), sessionsStub{3: true}, nil)
+	// чужой ключ с тем же kid: подпись не сходится
+	token, err := utils.GenerateJWT(12, "u", 3, testKeys(t))
+	require.NoError(t, err)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: "auth_token", Value: token})
+	mw(next).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
 }
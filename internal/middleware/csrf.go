@@ -18,10 +18,12 @@ func IsSafeMethod(method string) bool {
 	return safeMethods[method]
 }
 
-func CSRFMiddleware(jwtSecret string) func(http.Handler) http.Handler {
+func CSRFMiddleware(csrfSecret string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if !IsSafeMethod(r.Method) {
+			// персональный токен не отправляется браузером сам, подделать такой запрос нельзя
+			_, hasToken := bearerToken(r)
+			if !IsSafeMethod(r.Method) && !hasToken {
 				cookie, err := r.Cookie("csrf_token")
 				if err != nil {
 					http.Error(w, "CSRF token was not provided in cookies", http.StatusForbidden)
@@ -39,12 +41,12 @@ func CSRFMiddleware(jwtSecret string) func(http.Handler) http.Handler {
 					return
 				}
 
-				if _, err := utils.ValidateCSRF(headerToken, jwtSecret); err != nil {
+				if _, err := utils.ValidateCSRF(headerToken, csrfSecret); err != nil {
 					http.Error(w, "Invalid CSRF token", http.StatusForbidden)
 					return
 				}
 			}
-			ctx := context.WithValue(r.Context(), "csrf_secret", jwtSecret)
+			ctx := context.WithValue(r.Context(), "csrf_secret", csrfSecret)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
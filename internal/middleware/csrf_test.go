@@ -25,3 +25,19 @@ func TestCSRFMiddleware_GET(t *testing.T) {
 	require.True(t, called)
 	require.Equal(t, http.StatusOK, rr.Code)
 }
+
+func TestCSRFMiddleware_SkipsBearer(t *testing.T) {
+	mw := CSRFMiddleware("test-secret")
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodPost, "/test", nil)
+	rr := httptest.NewRecorder()
+	mw(next).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusForbidden, rr.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/test", nil)
+	req.Header.Set("Authorization", "Bearer vkp_token")
+	rr = httptest.NewRecorder()
+	mw(next).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+}
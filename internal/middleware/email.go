@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputil "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// EmailVerificationChecker сообщает, подтвердил ли пользователь email
+type EmailVerificationChecker interface {
+	IsEmailVerified(ctx context.Context, userID int) (bool, error)
+}
+
+// EmailVerificationFunc позволяет использовать функцию как EmailVerificationChecker
+type EmailVerificationFunc func(ctx context.Context, userID int) (bool, error)
+
+func (f EmailVerificationFunc) IsEmailVerified(ctx context.Context, userID int) (bool, error) {
+	return f(ctx, userID)
+}
+
+// RequireVerifiedEmail закрывает маршруты из restricted для пользователей
+// с неподтвержденным email. Маршрут задается как "METHOD /path/{template}",
+// например "POST /api/v1/accounts/invitations". Ставится после AuthMiddleware.
+func RequireVerifiedEmail(checker EmailVerificationChecker, restricted []string) func(http.Handler) http.Handler {
+	routes := make(map[string]struct{}, len(restricted))
+	for _, route := range restricted {
+		routes[route] = struct{}{}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := mux.CurrentRoute(r)
+			if route == nil || len(routes) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			template, err := route.GetPathTemplate()
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if _, ok := routes[r.Method+" "+template]; !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			userID, ok := GetUserIDFromContext(r.Context())
+			if !ok {
+				httputil.UnauthorizedError(w, r, "Требуется аутентификация", models.ErrCodeUnauthorized)
+				return
+			}
+
+			verified, err := checker.IsEmailVerified(r.Context(), userID)
+			if err != nil {
+				httputil.InternalError(w, r, "Failed to check email verification")
+				return
+			}
+			if !verified {
+				httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+					models.ErrCodeEmailNotVerified.GetErrorMessage(), "", "", models.ErrCodeEmailNotVerified,
+				), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
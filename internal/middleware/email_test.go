@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func newEmailTestRouter(verified bool) (*mux.Router, *int) {
+	checks := 0
+	checker := EmailVerificationFunc(func(ctx context.Context, userID int) (bool, error) {
+		checks++
+		return verified, nil
+	})
+
+	r := mux.NewRouter()
+	api := r.PathPrefix("/api/v1").Subrouter()
+	api.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), UserIDKey, 1)))
+		})
+	})
+	api.Use(RequireVerifiedEmail(checker, []string{"POST /api/v1/accounts/invitations"}))
+
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+	api.HandleFunc("/accounts/invitations", ok).Methods(http.MethodPost)
+	api.HandleFunc("/accounts/invitations", ok).Methods(http.MethodGet)
+	return r, &checks
+}
+
+func TestRequireVerifiedEmail_BlocksRestrictedRoute(t *testing.T) {
+	r, checks := newEmailTestRouter(false)
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/v1/accounts/invitations", nil))
+
+	require.Equal(t, http.StatusForbidden, rr.Code)
+	require.Contains(t, rr.Body.String(), "EMAIL_NOT_VERIFIED")
+	require.Equal(t, 1, *checks)
+}
+
+func TestRequireVerifiedEmail_AllowsVerified(t *testing.T) {
+	r, _ := newEmailTestRouter(true)
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/v1/accounts/invitations", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestRequireVerifiedEmail_SkipsOtherRoutes(t *testing.T) {
+	r, checks := newEmailTestRouter(false)
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/accounts/invitations", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Zero(t, *checks)
+}
@@ -1,7 +1,6 @@
 package middleware
 
 import (
-	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -14,7 +13,7 @@ func RequestLoggerMiddleware(log logger.Logger) func(http.Handler) http.Handler
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
 
-			clientIP := getClientIP(r)
+			clientIP := GetClientIP(r)
 			userID, isAuthenticated := GetUserIDFromContext(r.Context())
 			wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 
@@ -48,30 +47,6 @@ func RequestLoggerMiddleware(log logger.Logger) func(http.Handler) http.Handler
 	}
 }
 
-func getClientIP(r *http.Request) string {
-	if ip := r.Header.Get("X-Forwarded-For"); ip != "" {
-		ips := strings.Split(ip, ",")
-		if len(ips) > 0 {
-			return strings.TrimSpace(ips[0])
-		}
-	}
-
-	if ip := r.Header.Get("X-Real-IP"); ip != "" {
-		return ip
-	}
-
-	if ip := r.Header.Get("X-Forwarded"); ip != "" {
-		return ip
-	}
-
-	ip, _, err := net.SplitHostPort(r.RemoteAddr)
-	if err != nil {
-		return r.RemoteAddr
-	}
-
-	return ip
-}
-
 type responseWriter struct {
 	http.ResponseWriter
 	statusCode int
@@ -92,7 +67,7 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 func SecurityLoggerMiddleware(log logger.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			clientIP := getClientIP(r)
+			clientIP := GetClientIP(r)
 
 			if strings.HasPrefix(r.URL.Path, "/api/v1/") && r.URL.Path != "/api/v1/auth/register" && r.URL.Path != "/api/v1/auth/login" {
 				userID, isAuthenticated := GetUserIDFromContext(r.Context())
@@ -114,7 +89,26 @@ func SecurityLoggerMiddleware(log logger.Logger) func(http.Handler) http.Handler
 				}
 			}
 
-			next.ServeHTTP(w, r)
+			if r.URL.Path != "/api/v1/auth/login" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(wrapped, r)
+
+			switch wrapped.statusCode {
+			case http.StatusUnauthorized:
+				log.Warn("Failed login attempt",
+					"ip", clientIP,
+					"user_agent", r.UserAgent(),
+				)
+			case http.StatusTooManyRequests:
+				log.Warn("Login blocked after repeated failures",
+					"ip", clientIP,
+					"user_agent", r.UserAgent(),
+				)
+			}
 		})
 	}
 }
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestRequestLoggerMiddleware(t *testing.T) {
@@ -46,3 +48,24 @@ func TestRequestLoggerMiddleware_POST(t *testing.T) {
 	require.True(t, called)
 	require.Equal(t, http.StatusCreated, rr.Code)
 }
+
+func TestSecurityLoggerMiddleware_LoginLocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := mocks.NewMockLogger(ctrl)
+	log.EXPECT().Warn("Login blocked after repeated failures", "ip", "10.0.0.1", "user_agent", "test-agent")
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+	req.RemoteAddr = "10.0.0.1:54321"
+	req.Header.Set("User-Agent", "test-agent")
+	rr := httptest.NewRecorder()
+
+	SecurityLoggerMiddleware(log)(next).ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusTooManyRequests, rr.Code)
+}
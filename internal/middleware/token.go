@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	httputil "github.com/go-park-mail-ru/2025_2_VKarmane/pkg/http"
+)
+
+// ErrPersonalTokenInvalid неизвестный, отозванный или истекший персональный токен
+var ErrPersonalTokenInvalid = errors.New("personal token is invalid")
+
+// PersonalTokenAuthenticator возвращает владельца и области доступа
+// персонального токена либо ErrPersonalTokenInvalid
+type PersonalTokenAuthenticator interface {
+	AuthenticatePersonalToken(ctx context.Context, token string) (userID int, scopes []string, err error)
+}
+
+// PersonalTokenFunc позволяет использовать функцию как PersonalTokenAuthenticator
+type PersonalTokenFunc func(ctx context.Context, token string) (int, []string, error)
+
+func (f PersonalTokenFunc) AuthenticatePersonalToken(ctx context.Context, token string) (int, []string, error) {
+	return f(ctx, token)
+}
+
+func authenticatePersonalToken(w http.ResponseWriter, r *http.Request, next http.Handler, tokens PersonalTokenAuthenticator, token string) {
+	if token == "" || tokens == nil {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	userID, scopes, err := tokens.AuthenticatePersonalToken(r.Context(), token)
+	if errors.Is(err, ErrPersonalTokenInvalid) {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to check token", http.StatusServiceUnavailable)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), UserIDKey, userID)
+	ctx = context.WithValue(ctx, TokenScopesKey, scopes)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// scopeRule сопоставляет префикс маршрута с группой областей доступа
+type scopeRule struct {
+	prefix string
+	exact  bool
+	group  string
+}
+
+// scopeRules проверяются по порядку, срабатывает первое совпадение.
+// Маршруты без правила (управление токенами, сессии, пароль, изображения)
+// персональным токенам недоступны.
+var scopeRules = []scopeRule{
+	{prefix: "/account/{acc_id}/operations", group: "operations"},
+	{prefix: "/operations", group: "operations"},
+	{prefix: "/accounts", group: "accounts"},
+	{prefix: "/account/", group: "accounts"},
+	{prefix: "/invitations", group: "accounts"},
+	{prefix: "/budgets", group: "budgets"},
+	{prefix: "/goals", group: "budgets"},
+	{prefix: "/categories", group: "categories"},
+	{prefix: "/debts", group: "debts"},
+	{prefix: "/counterparties", group: "debts"},
+	{prefix: "/notifications", group: "notifications"},
+	// profile:write не выдается: изменить или удалить аккаунт токеном нельзя
+	{prefix: "/profile", exact: true, group: "profile"},
+}
+
+// requiredScope область доступа, нужная для маршрута template; false, если
+// маршрут персональным токенам закрыт
+func requiredScope(method, template string) (string, bool) {
+	path := strings.TrimPrefix(template, "/api/v1")
+	for _, rule := range scopeRules {
+		matched := path == rule.prefix
+		if !rule.exact && !matched {
+			matched = strings.HasPrefix(path, rule.prefix)
+		}
+		if !matched {
+			continue
+		}
+		if method == http.MethodGet || method == http.MethodHead {
+			return rule.group + ":read", true
+		}
+		return rule.group + ":write", true
+	}
+	return "", false
+}
+
+// RequireTokenScope пропускает запрос с персональным токеном, только если
+// у токена есть область доступа маршрута. Запросы с cookie не проверяются.
+// Ставится после AuthMiddleware.
+func RequireTokenScope() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scopes, ok := GetTokenScopesFromContext(r.Context())
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed := false
+			if route := mux.CurrentRoute(r); route != nil {
+				if template, err := route.GetPathTemplate(); err == nil {
+					if scope, ok := requiredScope(r.Method, template); ok {
+						for _, s := range scopes {
+							if s == scope {
+								allowed = true
+								break
+							}
+						}
+					}
+				}
+			}
+			if !allowed {
+				httputil.ErrorWithCode(w, r, models.NewErrorResponse(
+					models.ErrCodeInsufficientScope.GetErrorMessage(), "", "", models.ErrCodeInsufficientScope,
+				), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+var tokensStub = PersonalTokenFunc(func(_ context.Context, token string) (int, []string, error) {
+	switch token {
+	case "vkp_reader":
+		return 7, []string{"budgets:read", "operations:read"}, nil
+	case "vkp_down":
+		return 0, nil, errors.New("auth_service unavailable")
+	}
+	return 0, nil, ErrPersonalTokenInvalid
+})
+
+func serveBearer(t *testing.T, header string) (*httptest.ResponseRecorder, context.Context) {
+	t.Helper()
+	mw := AuthMiddleware(testKeys(t), sessionsStub{3: true}, tokensStub)
+
+	var ctx context.Context
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+		w.WriteHeader(http.StatusOK)
+	})
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", header)
+	mw(next).ServeHTTP(rr, req)
+	return rr, ctx
+}
+
+func TestAuthMiddleware_PersonalToken(t *testing.T) {
+	rr, ctx := serveBearer(t, "Bearer vkp_reader")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	userID, ok := GetUserIDFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, 7, userID)
+	scopes, ok := GetTokenScopesFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, []string{"budgets:read", "operations:read"}, scopes)
+	_, ok = GetSessionIDFromContext(ctx)
+	require.False(t, ok)
+}
+
+func TestAuthMiddleware_PersonalTokenRejected(t *testing.T) {
+	for header, code := range map[string]int{
+		"Bearer vkp_unknown": http.StatusUnauthorized,
+		"Bearer ":            http.StatusUnauthorized,
+		"Basic dXNlcjpwYXNz": http.StatusUnauthorized,
+		"Bearer vkp_down":    http.StatusServiceUnavailable,
+	} {
+		rr, _ := serveBearer(t, header)
+		require.Equal(t, code, rr.Code, header)
+	}
+}
+
+func TestAuthMiddleware_BearerDoesNotFallBackToCookie(t *testing.T) {
+	keys := testKeys(t)
+	mw := AuthMiddleware(keys, sessionsStub{3: true}, tokensStub)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer vkp_unknown")
+	req.AddCookie(&http.Cookie{Name: "auth_token", Value: "any"})
+	mw(next).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func newScopeTestRouter(scopes []string) *mux.Router {
+	r := mux.NewRouter()
+	api := r.PathPrefix("/api/v1").Subrouter()
+	api.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), UserIDKey, 1)
+			if scopes != nil {
+				ctx = context.WithValue(ctx, TokenScopesKey, scopes)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	api.Use(RequireTokenScope())
+
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+	api.HandleFunc("/budgets/{id}", ok).Methods(http.MethodGet, http.MethodPut)
+	api.HandleFunc("/account/{acc_id}/operations", ok).Methods(http.MethodGet, http.MethodPost)
+	api.HandleFunc("/account/{id}", ok).Methods(http.MethodGet)
+	api.HandleFunc("/profile", ok).Methods(http.MethodGet, http.MethodDelete)
+	api.HandleFunc("/profile/tokens", ok).Methods(http.MethodGet)
+	return r
+}
+
+func TestRequireTokenScope(t *testing.T) {
+	r := newScopeTestRouter([]string{"budgets:read", "operations:write", "profile:read"})
+
+	for _, tc := range []struct {
+		method, path string
+		code         int
+	}{
+		{http.MethodGet, "/api/v1/budgets/1", http.StatusOK},
+		{http.MethodPut, "/api/v1/budgets/1", http.StatusForbidden},
+		{http.MethodPost, "/api/v1/account/1/operations", http.StatusOK},
+		{http.MethodGet, "/api/v1/account/1/operations", http.StatusForbidden},
+		// операции счета не дают доступа к самому счету
+		{http.MethodGet, "/api/v1/account/1", http.StatusForbidden},
+		{http.MethodGet, "/api/v1/profile", http.StatusOK},
+		// удалить аккаунт токеном нельзя
+		{http.MethodDelete, "/api/v1/profile", http.StatusForbidden},
+		// токенами нельзя управлять токеном
+		{http.MethodGet, "/api/v1/profile/tokens", http.StatusForbidden},
+	} {
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(tc.method, tc.path, nil))
+		require.Equal(t, tc.code, rr.Code, tc.method+" "+tc.path)
+		if tc.code == http.StatusForbidden {
+			require.Contains(t, rr.Body.String(), "INSUFFICIENT_SCOPE")
+		}
+	}
+}
+
+func TestRequireTokenScope_SkipsCookieSessions(t *testing.T) {
+	r := newScopeTestRouter(nil)
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/profile/tokens", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+}
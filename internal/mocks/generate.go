@@ -37,4 +37,9 @@ package mocks
 
 //go:generate go run go.uber.org/mock/mockgen -destination=mock_clock.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock Clock
 //go:generate go run go.uber.org/mock/mockgen -destination=mock_logger.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger Logger
-//go:generate go run go.uber.org/mock/mockgen -destination=mock_image_storage.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/repository ImageStorage
\ No newline at end of file
+//go:generate go run go.uber.org/mock/mockgen -destination=mock_image_storage.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/image/repository ImageStorage
+//go:generate go run go.uber.org/mock/mockgen -destination=mock_notification_client.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto NotificationServiceClient
+//go:generate go run go.uber.org/mock/mockgen -destination=mock_notification_service.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/usecase NotificationService
+//go:generate go run go.uber.org/mock/mockgen -destination=mock_notification_usecase.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/grpc NotificationUseCase
+//go:generate go run go.uber.org/mock/mockgen -destination=mock_notification_repository.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/service NotificationRepository
+//go:generate go run go.uber.org/mock/mockgen -destination=mock_mail_sender.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/service MailSender
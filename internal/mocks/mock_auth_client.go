@@ -43,6 +43,306 @@ func (m *MockAuthServiceClient) EXPECT() *MockAuthServiceClientMockRecorder {
 	return m.recorder
 }
 
+// AdvanceAccountDeletion mocks base method.
+func (m *MockAuthServiceClient) AdvanceAccountDeletion(ctx context.Context, in *proto.AdvanceDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AdvanceAccountDeletion", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceAccountDeletion indicates an expected call of AdvanceAccountDeletion.
+func (mr *MockAuthServiceClientMockRecorder) AdvanceAccountDeletion(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceAccountDeletion", reflect.TypeOf((*MockAuthServiceClient)(nil).AdvanceAccountDeletion), varargs...)
+}
+
+// AuthenticatePersonalToken mocks base method.
+func (m *MockAuthServiceClient) AuthenticatePersonalToken(ctx context.Context, in *proto.AuthenticateTokenRequest, opts ...grpc.CallOption) (*proto.TokenPrincipal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AuthenticatePersonalToken", varargs...)
+	ret0, _ := ret[0].(*proto.TokenPrincipal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticatePersonalToken indicates an expected call of AuthenticatePersonalToken.
+func (mr *MockAuthServiceClientMockRecorder) AuthenticatePersonalToken(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticatePersonalToken", reflect.TypeOf((*MockAuthServiceClient)(nil).AuthenticatePersonalToken), varargs...)
+}
+
+// CancelAccountDeletion mocks base method.
+func (m *MockAuthServiceClient) CancelAccountDeletion(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelAccountDeletion", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelAccountDeletion indicates an expected call of CancelAccountDeletion.
+func (mr *MockAuthServiceClientMockRecorder) CancelAccountDeletion(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAccountDeletion", reflect.TypeOf((*MockAuthServiceClient)(nil).CancelAccountDeletion), varargs...)
+}
+
+// ChangePassword mocks base method.
+func (m *MockAuthServiceClient) ChangePassword(ctx context.Context, in *proto.ChangePasswordRequest, opts ...grpc.CallOption) (*proto.RevokedSessions, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(*proto.RevokedSessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceClientMockRecorder) ChangePassword(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthServiceClient)(nil).ChangePassword), varargs...)
+}
+
+// CheckSession mocks base method.
+func (m *MockAuthServiceClient) CheckSession(ctx context.Context, in *proto.SessionRequest, opts ...grpc.CallOption) (*proto.SessionStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckSession", varargs...)
+	ret0, _ := ret[0].(*proto.SessionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckSession indicates an expected call of CheckSession.
+func (mr *MockAuthServiceClientMockRecorder) CheckSession(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSession", reflect.TypeOf((*MockAuthServiceClient)(nil).CheckSession), varargs...)
+}
+
+// ClaimAccountDeletions mocks base method.
+func (m *MockAuthServiceClient) ClaimAccountDeletions(ctx context.Context, in *proto.ClaimDeletionsRequest, opts ...grpc.CallOption) (*proto.AccountDeletionList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClaimAccountDeletions", varargs...)
+	ret0, _ := ret[0].(*proto.AccountDeletionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimAccountDeletions indicates an expected call of ClaimAccountDeletions.
+func (mr *MockAuthServiceClientMockRecorder) ClaimAccountDeletions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAccountDeletions", reflect.TypeOf((*MockAuthServiceClient)(nil).ClaimAccountDeletions), varargs...)
+}
+
+// ConfirmEmail mocks base method.
+func (m *MockAuthServiceClient) ConfirmEmail(ctx context.Context, in *proto.EmailTokenRequest, opts ...grpc.CallOption) (*proto.EmailConfirmation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmEmail", varargs...)
+	ret0, _ := ret[0].(*proto.EmailConfirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmail indicates an expected call of ConfirmEmail.
+func (mr *MockAuthServiceClientMockRecorder) ConfirmEmail(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmail", reflect.TypeOf((*MockAuthServiceClient)(nil).ConfirmEmail), varargs...)
+}
+
+// ConfirmMFA mocks base method.
+func (m *MockAuthServiceClient) ConfirmMFA(ctx context.Context, in *proto.MFACodeRequest, opts ...grpc.CallOption) (*proto.RecoveryCodes, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmMFA", varargs...)
+	ret0, _ := ret[0].(*proto.RecoveryCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMFA indicates an expected call of ConfirmMFA.
+func (mr *MockAuthServiceClientMockRecorder) ConfirmMFA(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMFA", reflect.TypeOf((*MockAuthServiceClient)(nil).ConfirmMFA), varargs...)
+}
+
+// ConfirmPasswordReset mocks base method.
+func (m *MockAuthServiceClient) ConfirmPasswordReset(ctx context.Context, in *proto.ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmPasswordReset", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmPasswordReset indicates an expected call of ConfirmPasswordReset.
+func (mr *MockAuthServiceClientMockRecorder) ConfirmPasswordReset(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPasswordReset", reflect.TypeOf((*MockAuthServiceClient)(nil).ConfirmPasswordReset), varargs...)
+}
+
+// CreatePersonalToken mocks base method.
+func (m *MockAuthServiceClient) CreatePersonalToken(ctx context.Context, in *proto.CreatePersonalTokenRequest, opts ...grpc.CallOption) (*proto.CreatedPersonalToken, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePersonalToken", varargs...)
+	ret0, _ := ret[0].(*proto.CreatedPersonalToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonalToken indicates an expected call of CreatePersonalToken.
+func (mr *MockAuthServiceClientMockRecorder) CreatePersonalToken(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalToken", reflect.TypeOf((*MockAuthServiceClient)(nil).CreatePersonalToken), varargs...)
+}
+
+// DeleteUser mocks base method.
+func (m *MockAuthServiceClient) DeleteUser(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockAuthServiceClientMockRecorder) DeleteUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockAuthServiceClient)(nil).DeleteUser), varargs...)
+}
+
+// DisableMFA mocks base method.
+func (m *MockAuthServiceClient) DisableMFA(ctx context.Context, in *proto.MFACodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableMFA", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockAuthServiceClientMockRecorder) DisableMFA(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAuthServiceClient)(nil).DisableMFA), varargs...)
+}
+
+// EnrollMFA mocks base method.
+func (m *MockAuthServiceClient) EnrollMFA(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnrollMFA", varargs...)
+	ret0, _ := ret[0].(*proto.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockAuthServiceClientMockRecorder) EnrollMFA(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAuthServiceClient)(nil).EnrollMFA), varargs...)
+}
+
+// ExportProfile mocks base method.
+func (m *MockAuthServiceClient) ExportProfile(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportProfile", varargs...)
+	ret0, _ := ret[0].(*proto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportProfile indicates an expected call of ExportProfile.
+func (mr *MockAuthServiceClientMockRecorder) ExportProfile(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportProfile", reflect.TypeOf((*MockAuthServiceClient)(nil).ExportProfile), varargs...)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockAuthServiceClient) FilterUsedImages(ctx context.Context, in *proto.ImageIDs, opts ...grpc.CallOption) (*proto.ImageIDs, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FilterUsedImages", varargs...)
+	ret0, _ := ret[0].(*proto.ImageIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockAuthServiceClientMockRecorder) FilterUsedImages(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockAuthServiceClient)(nil).FilterUsedImages), varargs...)
+}
+
 // GetCSRF mocks base method.
 func (m *MockAuthServiceClient) GetCSRF(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*proto.CSRFTokenResponse, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +383,66 @@ func (mr *MockAuthServiceClientMockRecorder) GetProfile(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockAuthServiceClient)(nil).GetProfile), varargs...)
 }
 
+// ImportProfile mocks base method.
+func (m *MockAuthServiceClient) ImportProfile(ctx context.Context, in *proto.ImportProfileRequest, opts ...grpc.CallOption) (*proto.ProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportProfile", varargs...)
+	ret0, _ := ret[0].(*proto.ProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportProfile indicates an expected call of ImportProfile.
+func (mr *MockAuthServiceClientMockRecorder) ImportProfile(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportProfile", reflect.TypeOf((*MockAuthServiceClient)(nil).ImportProfile), varargs...)
+}
+
+// ListPersonalTokens mocks base method.
+func (m *MockAuthServiceClient) ListPersonalTokens(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.PersonalTokenList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPersonalTokens", varargs...)
+	ret0, _ := ret[0].(*proto.PersonalTokenList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalTokens indicates an expected call of ListPersonalTokens.
+func (mr *MockAuthServiceClientMockRecorder) ListPersonalTokens(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalTokens", reflect.TypeOf((*MockAuthServiceClient)(nil).ListPersonalTokens), varargs...)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthServiceClient) ListSessions(ctx context.Context, in *proto.SessionRequest, opts ...grpc.CallOption) (*proto.SessionList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSessions", varargs...)
+	ret0, _ := ret[0].(*proto.SessionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthServiceClientMockRecorder) ListSessions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthServiceClient)(nil).ListSessions), varargs...)
+}
+
 // Login mocks base method.
 func (m *MockAuthServiceClient) Login(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption) (*proto.AuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +463,26 @@ func (mr *MockAuthServiceClientMockRecorder) Login(ctx, in any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceClient)(nil).Login), varargs...)
 }
 
+// RefreshToken mocks base method.
+func (m *MockAuthServiceClient) RefreshToken(ctx context.Context, in *proto.RefreshTokenRequest, opts ...grpc.CallOption) (*proto.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshToken", varargs...)
+	ret0, _ := ret[0].(*proto.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthServiceClientMockRecorder) RefreshToken(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthServiceClient)(nil).RefreshToken), varargs...)
+}
+
 // Register mocks base method.
 func (m *MockAuthServiceClient) Register(ctx context.Context, in *proto.RegisterRequest, opts ...grpc.CallOption) (*proto.AuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +503,126 @@ func (mr *MockAuthServiceClientMockRecorder) Register(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthServiceClient)(nil).Register), varargs...)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockAuthServiceClient) RequestPasswordReset(ctx context.Context, in *proto.PasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestPasswordReset", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthServiceClientMockRecorder) RequestPasswordReset(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthServiceClient)(nil).RequestPasswordReset), varargs...)
+}
+
+// ResendEmailVerification mocks base method.
+func (m *MockAuthServiceClient) ResendEmailVerification(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResendEmailVerification", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendEmailVerification indicates an expected call of ResendEmailVerification.
+func (mr *MockAuthServiceClientMockRecorder) ResendEmailVerification(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendEmailVerification", reflect.TypeOf((*MockAuthServiceClient)(nil).ResendEmailVerification), varargs...)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockAuthServiceClient) RevokeOtherSessions(ctx context.Context, in *proto.SessionRequest, opts ...grpc.CallOption) (*proto.RevokedSessions, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", varargs...)
+	ret0, _ := ret[0].(*proto.RevokedSessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockAuthServiceClientMockRecorder) RevokeOtherSessions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokeOtherSessions), varargs...)
+}
+
+// RevokePersonalToken mocks base method.
+func (m *MockAuthServiceClient) RevokePersonalToken(ctx context.Context, in *proto.PersonalTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokePersonalToken", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokePersonalToken indicates an expected call of RevokePersonalToken.
+func (mr *MockAuthServiceClientMockRecorder) RevokePersonalToken(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalToken", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokePersonalToken), varargs...)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthServiceClient) RevokeSession(ctx context.Context, in *proto.SessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSession", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthServiceClientMockRecorder) RevokeSession(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokeSession), varargs...)
+}
+
+// ScheduleAccountDeletion mocks base method.
+func (m *MockAuthServiceClient) ScheduleAccountDeletion(ctx context.Context, in *proto.DeleteAccountRequest, opts ...grpc.CallOption) (*proto.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScheduleAccountDeletion", varargs...)
+	ret0, _ := ret[0].(*proto.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleAccountDeletion indicates an expected call of ScheduleAccountDeletion.
+func (mr *MockAuthServiceClientMockRecorder) ScheduleAccountDeletion(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleAccountDeletion", reflect.TypeOf((*MockAuthServiceClient)(nil).ScheduleAccountDeletion), varargs...)
+}
+
 // UpdateProfile mocks base method.
 func (m *MockAuthServiceClient) UpdateProfile(ctx context.Context, in *proto.UpdateProfileRequest, opts ...grpc.CallOption) (*proto.ProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -142,3 +642,23 @@ func (mr *MockAuthServiceClientMockRecorder) UpdateProfile(ctx, in any, opts ...
 	varargs := append([]any{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockAuthServiceClient)(nil).UpdateProfile), varargs...)
 }
+
+// VerifyMFA mocks base method.
+func (m *MockAuthServiceClient) VerifyMFA(ctx context.Context, in *proto.VerifyMFARequest, opts ...grpc.CallOption) (*proto.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyMFA", varargs...)
+	ret0, _ := ret[0].(*proto.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthServiceClientMockRecorder) VerifyMFA(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthServiceClient)(nil).VerifyMFA), varargs...)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	auth "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,137 @@ func (m *MockAuthRepository) EXPECT() *MockAuthRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AdvanceAccountDeletion mocks base method.
+func (m *MockAuthRepository) AdvanceAccountDeletion(ctx context.Context, userID int, step string, imageIDs []string, completedAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceAccountDeletion", ctx, userID, step, imageIDs, completedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdvanceAccountDeletion indicates an expected call of AdvanceAccountDeletion.
+func (mr *MockAuthRepositoryMockRecorder) AdvanceAccountDeletion(ctx, userID, step, imageIDs, completedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceAccountDeletion", reflect.TypeOf((*MockAuthRepository)(nil).AdvanceAccountDeletion), ctx, userID, step, imageIDs, completedAt)
+}
+
+// CancelAccountDeletion mocks base method.
+func (m *MockAuthRepository) CancelAccountDeletion(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAccountDeletion", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAccountDeletion indicates an expected call of CancelAccountDeletion.
+func (mr *MockAuthRepositoryMockRecorder) CancelAccountDeletion(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAccountDeletion", reflect.TypeOf((*MockAuthRepository)(nil).CancelAccountDeletion), ctx, userID)
+}
+
+// ChangePassword mocks base method.
+func (m *MockAuthRepository) ChangePassword(ctx context.Context, userID int, passwordHash string, keepSessionID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, passwordHash, keepSessionID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthRepositoryMockRecorder) ChangePassword(ctx, userID, passwordHash, keepSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthRepository)(nil).ChangePassword), ctx, userID, passwordHash, keepSessionID)
+}
+
+// ClaimAccountDeletions mocks base method.
+func (m *MockAuthRepository) ClaimAccountDeletions(ctx context.Context, now, lockedUntil time.Time, limit int) ([]auth.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimAccountDeletions", ctx, now, lockedUntil, limit)
+	ret0, _ := ret[0].([]auth.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimAccountDeletions indicates an expected call of ClaimAccountDeletions.
+func (mr *MockAuthRepositoryMockRecorder) ClaimAccountDeletions(ctx, now, lockedUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAccountDeletions", reflect.TypeOf((*MockAuthRepository)(nil).ClaimAccountDeletions), ctx, now, lockedUntil, limit)
+}
+
+// ConfirmEmailToken mocks base method.
+func (m *MockAuthRepository) ConfirmEmailToken(ctx context.Context, tokenHash string, now time.Time) (auth.EmailConfirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailToken", ctx, tokenHash, now)
+	ret0, _ := ret[0].(auth.EmailConfirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmailToken indicates an expected call of ConfirmEmailToken.
+func (mr *MockAuthRepositoryMockRecorder) ConfirmEmailToken(ctx, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailToken", reflect.TypeOf((*MockAuthRepository)(nil).ConfirmEmailToken), ctx, tokenHash, now)
+}
+
+// CreateEmailVerificationToken mocks base method.
+func (m *MockAuthRepository) CreateEmailVerificationToken(ctx context.Context, userID int, email, tokenHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailVerificationToken", ctx, userID, email, tokenHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmailVerificationToken indicates an expected call of CreateEmailVerificationToken.
+func (mr *MockAuthRepositoryMockRecorder) CreateEmailVerificationToken(ctx, userID, email, tokenHash, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailVerificationToken", reflect.TypeOf((*MockAuthRepository)(nil).CreateEmailVerificationToken), ctx, userID, email, tokenHash, expiresAt)
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockAuthRepository) CreatePasswordResetToken(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", ctx, userID, tokenHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockAuthRepositoryMockRecorder) CreatePasswordResetToken(ctx, userID, tokenHash, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockAuthRepository)(nil).CreatePasswordResetToken), ctx, userID, tokenHash, expiresAt)
+}
+
+// CreatePersonalToken mocks base method.
+func (m *MockAuthRepository) CreatePersonalToken(ctx context.Context, req auth.CreatePersonalTokenRequest, tokenHash string) (auth.PersonalToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalToken", ctx, req, tokenHash)
+	ret0, _ := ret[0].(auth.PersonalToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonalToken indicates an expected call of CreatePersonalToken.
+func (mr *MockAuthRepositoryMockRecorder) CreatePersonalToken(ctx, req, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalToken", reflect.TypeOf((*MockAuthRepository)(nil).CreatePersonalToken), ctx, req, tokenHash)
+}
+
+// CreateSession mocks base method.
+func (m *MockAuthRepository) CreateSession(ctx context.Context, userID int, tokenHash string, expiresAt time.Time, client auth.ClientInfo) (auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, tokenHash, expiresAt, client)
+	ret0, _ := ret[0].(auth.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockAuthRepositoryMockRecorder) CreateSession(ctx, userID, tokenHash, expiresAt, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockAuthRepository)(nil).CreateSession), ctx, userID, tokenHash, expiresAt, client)
+}
+
 // CreateUser mocks base method.
 func (m *MockAuthRepository) CreateUser(ctx context.Context, user auth.User) (auth.User, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +188,49 @@ func (mr *MockAuthRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockAuthRepository)(nil).CreateUser), ctx, user)
 }
 
+// DeletePersonalToken mocks base method.
+func (m *MockAuthRepository) DeletePersonalToken(ctx context.Context, userID, tokenID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePersonalToken", ctx, userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePersonalToken indicates an expected call of DeletePersonalToken.
+func (mr *MockAuthRepositoryMockRecorder) DeletePersonalToken(ctx, userID, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePersonalToken", reflect.TypeOf((*MockAuthRepository)(nil).DeletePersonalToken), ctx, userID, tokenID)
+}
+
+// DeleteUser mocks base method.
+func (m *MockAuthRepository) DeleteUser(ctx context.Context, userID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockAuthRepositoryMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockAuthRepository)(nil).DeleteUser), ctx, userID)
+}
+
+// DisableMFA mocks base method.
+func (m *MockAuthRepository) DisableMFA(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMFA", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockAuthRepositoryMockRecorder) DisableMFA(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAuthRepository)(nil).DisableMFA), ctx, userID)
+}
+
 // EditUserByID mocks base method.
 func (m *MockAuthRepository) EditUserByID(ctx context.Context, req auth.UpdateProfileRequest) (auth.User, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +246,125 @@ func (mr *MockAuthRepositoryMockRecorder) EditUserByID(ctx, req any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditUserByID", reflect.TypeOf((*MockAuthRepository)(nil).EditUserByID), ctx, req)
 }
 
+// EnableMFA mocks base method.
+func (m *MockAuthRepository) EnableMFA(ctx context.Context, userID int, step int64, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableMFA", ctx, userID, step, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableMFA indicates an expected call of EnableMFA.
+func (mr *MockAuthRepositoryMockRecorder) EnableMFA(ctx, userID, step, codeHashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableMFA", reflect.TypeOf((*MockAuthRepository)(nil).EnableMFA), ctx, userID, step, codeHashes)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockAuthRepository) FilterUsedImages(ctx context.Context, ids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterUsedImages", ctx, ids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockAuthRepositoryMockRecorder) FilterUsedImages(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockAuthRepository)(nil).FilterUsedImages), ctx, ids)
+}
+
+// GetLoginLockedUntil mocks base method.
+func (m *MockAuthRepository) GetLoginLockedUntil(ctx context.Context, key auth.LoginAttemptKey) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginLockedUntil", ctx, key)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginLockedUntil indicates an expected call of GetLoginLockedUntil.
+func (mr *MockAuthRepositoryMockRecorder) GetLoginLockedUntil(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginLockedUntil", reflect.TypeOf((*MockAuthRepository)(nil).GetLoginLockedUntil), ctx, key)
+}
+
+// GetMFA mocks base method.
+func (m *MockAuthRepository) GetMFA(ctx context.Context, userID int) (auth.MFA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMFA", ctx, userID)
+	ret0, _ := ret[0].(auth.MFA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMFA indicates an expected call of GetMFA.
+func (mr *MockAuthRepositoryMockRecorder) GetMFA(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMFA", reflect.TypeOf((*MockAuthRepository)(nil).GetMFA), ctx, userID)
+}
+
+// GetPendingEmail mocks base method.
+func (m *MockAuthRepository) GetPendingEmail(ctx context.Context, userID int, now time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingEmail", ctx, userID, now)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingEmail indicates an expected call of GetPendingEmail.
+func (mr *MockAuthRepositoryMockRecorder) GetPendingEmail(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingEmail", reflect.TypeOf((*MockAuthRepository)(nil).GetPendingEmail), ctx, userID, now)
+}
+
+// GetPersonalToken mocks base method.
+func (m *MockAuthRepository) GetPersonalToken(ctx context.Context, tokenHash string) (auth.PersonalToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalToken", ctx, tokenHash)
+	ret0, _ := ret[0].(auth.PersonalToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalToken indicates an expected call of GetPersonalToken.
+func (mr *MockAuthRepositoryMockRecorder) GetPersonalToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalToken", reflect.TypeOf((*MockAuthRepository)(nil).GetPersonalToken), ctx, tokenHash)
+}
+
+// GetRefreshToken mocks base method.
+func (m *MockAuthRepository) GetRefreshToken(ctx context.Context, tokenHash string) (auth.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshToken", ctx, tokenHash)
+	ret0, _ := ret[0].(auth.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshToken indicates an expected call of GetRefreshToken.
+func (mr *MockAuthRepositoryMockRecorder) GetRefreshToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshToken", reflect.TypeOf((*MockAuthRepository)(nil).GetRefreshToken), ctx, tokenHash)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockAuthRepository) GetUserByEmail(ctx context.Context, email string) (auth.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(auth.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockAuthRepositoryMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockAuthRepository)(nil).GetUserByEmail), ctx, email)
+}
+
 // GetUserByID mocks base method.
 func (m *MockAuthRepository) GetUserByID(ctx context.Context, id int) (auth.User, error) {
 	m.ctrl.T.Helper()
@@ -100,3 +394,265 @@ func (mr *MockAuthRepositoryMockRecorder) GetUserByLogin(ctx, login any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByLogin", reflect.TypeOf((*MockAuthRepository)(nil).GetUserByLogin), ctx, login)
 }
+
+// IsSessionActive mocks base method.
+func (m *MockAuthRepository) IsSessionActive(ctx context.Context, userID, sessionID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionActive", ctx, userID, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionActive indicates an expected call of IsSessionActive.
+func (mr *MockAuthRepositoryMockRecorder) IsSessionActive(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionActive", reflect.TypeOf((*MockAuthRepository)(nil).IsSessionActive), ctx, userID, sessionID)
+}
+
+// ListActiveSessions mocks base method.
+func (m *MockAuthRepository) ListActiveSessions(ctx context.Context, userID int) ([]auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveSessions", ctx, userID)
+	ret0, _ := ret[0].([]auth.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveSessions indicates an expected call of ListActiveSessions.
+func (mr *MockAuthRepositoryMockRecorder) ListActiveSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSessions", reflect.TypeOf((*MockAuthRepository)(nil).ListActiveSessions), ctx, userID)
+}
+
+// ListPersonalTokens mocks base method.
+func (m *MockAuthRepository) ListPersonalTokens(ctx context.Context, userID int) ([]auth.PersonalToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPersonalTokens", ctx, userID)
+	ret0, _ := ret[0].([]auth.PersonalToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalTokens indicates an expected call of ListPersonalTokens.
+func (mr *MockAuthRepositoryMockRecorder) ListPersonalTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalTokens", reflect.TypeOf((*MockAuthRepository)(nil).ListPersonalTokens), ctx, userID)
+}
+
+// LockLogin mocks base method.
+func (m *MockAuthRepository) LockLogin(ctx context.Context, key auth.LoginAttemptKey, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLogin", ctx, key, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockLogin indicates an expected call of LockLogin.
+func (mr *MockAuthRepositoryMockRecorder) LockLogin(ctx, key, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLogin", reflect.TypeOf((*MockAuthRepository)(nil).LockLogin), ctx, key, until)
+}
+
+// RecordLoginFailure mocks base method.
+func (m *MockAuthRepository) RecordLoginFailure(ctx context.Context, key auth.LoginAttemptKey, now, resetBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginFailure", ctx, key, now, resetBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordLoginFailure indicates an expected call of RecordLoginFailure.
+func (mr *MockAuthRepositoryMockRecorder) RecordLoginFailure(ctx, key, now, resetBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginFailure", reflect.TypeOf((*MockAuthRepository)(nil).RecordLoginFailure), ctx, key, now, resetBefore)
+}
+
+// ResetLoginFailures mocks base method.
+func (m *MockAuthRepository) ResetLoginFailures(ctx context.Context, key auth.LoginAttemptKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLoginFailures", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetLoginFailures indicates an expected call of ResetLoginFailures.
+func (mr *MockAuthRepositoryMockRecorder) ResetLoginFailures(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoginFailures", reflect.TypeOf((*MockAuthRepository)(nil).ResetLoginFailures), ctx, key)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthRepository) ResetPassword(ctx context.Context, tokenHash, passwordHash string, now time.Time) (int, []int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, tokenHash, passwordHash, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].([]int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthRepositoryMockRecorder) ResetPassword(ctx, tokenHash, passwordHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthRepository)(nil).ResetPassword), ctx, tokenHash, passwordHash, now)
+}
+
+// RestoreProfile mocks base method.
+func (m *MockAuthRepository) RestoreProfile(ctx context.Context, user auth.User) (auth.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProfile", ctx, user)
+	ret0, _ := ret[0].(auth.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProfile indicates an expected call of RestoreProfile.
+func (mr *MockAuthRepositoryMockRecorder) RestoreProfile(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProfile", reflect.TypeOf((*MockAuthRepository)(nil).RestoreProfile), ctx, user)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockAuthRepository) RevokeOtherSessions(ctx context.Context, userID, keepSessionID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", ctx, userID, keepSessionID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockAuthRepositoryMockRecorder) RevokeOtherSessions(ctx, userID, keepSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockAuthRepository)(nil).RevokeOtherSessions), ctx, userID, keepSessionID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthRepository) RevokeSession(ctx context.Context, userID, sessionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthRepositoryMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthRepository)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockAuthRepository) RotateRefreshToken(ctx context.Context, sessionID int, oldHash, newHash string, expiresAt time.Time, client auth.ClientInfo) (auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, sessionID, oldHash, newHash, expiresAt, client)
+	ret0, _ := ret[0].(auth.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockAuthRepositoryMockRecorder) RotateRefreshToken(ctx, sessionID, oldHash, newHash, expiresAt, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockAuthRepository)(nil).RotateRefreshToken), ctx, sessionID, oldHash, newHash, expiresAt, client)
+}
+
+// SaveMFASecret mocks base method.
+func (m *MockAuthRepository) SaveMFASecret(ctx context.Context, userID int, secret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMFASecret", ctx, userID, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMFASecret indicates an expected call of SaveMFASecret.
+func (mr *MockAuthRepositoryMockRecorder) SaveMFASecret(ctx, userID, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMFASecret", reflect.TypeOf((*MockAuthRepository)(nil).SaveMFASecret), ctx, userID, secret)
+}
+
+// ScheduleAccountDeletion mocks base method.
+func (m *MockAuthRepository) ScheduleAccountDeletion(ctx context.Context, userID int, scheduledAt time.Time) (auth.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleAccountDeletion", ctx, userID, scheduledAt)
+	ret0, _ := ret[0].(auth.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleAccountDeletion indicates an expected call of ScheduleAccountDeletion.
+func (mr *MockAuthRepositoryMockRecorder) ScheduleAccountDeletion(ctx, userID, scheduledAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleAccountDeletion", reflect.TypeOf((*MockAuthRepository)(nil).ScheduleAccountDeletion), ctx, userID, scheduledAt)
+}
+
+// StartEmailChange mocks base method.
+func (m *MockAuthRepository) StartEmailChange(ctx context.Context, change auth.EmailChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartEmailChange", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartEmailChange indicates an expected call of StartEmailChange.
+func (mr *MockAuthRepositoryMockRecorder) StartEmailChange(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartEmailChange", reflect.TypeOf((*MockAuthRepository)(nil).StartEmailChange), ctx, change)
+}
+
+// TouchPersonalToken mocks base method.
+func (m *MockAuthRepository) TouchPersonalToken(ctx context.Context, tokenID int, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchPersonalToken", ctx, tokenID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchPersonalToken indicates an expected call of TouchPersonalToken.
+func (mr *MockAuthRepositoryMockRecorder) TouchPersonalToken(ctx, tokenID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchPersonalToken", reflect.TypeOf((*MockAuthRepository)(nil).TouchPersonalToken), ctx, tokenID, now)
+}
+
+// UpdatePasswordHash mocks base method.
+func (m *MockAuthRepository) UpdatePasswordHash(ctx context.Context, userID int, oldHash, newHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePasswordHash", ctx, userID, oldHash, newHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePasswordHash indicates an expected call of UpdatePasswordHash.
+func (mr *MockAuthRepositoryMockRecorder) UpdatePasswordHash(ctx, userID, oldHash, newHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordHash", reflect.TypeOf((*MockAuthRepository)(nil).UpdatePasswordHash), ctx, userID, oldHash, newHash)
+}
+
+// UseMFAStep mocks base method.
+func (m *MockAuthRepository) UseMFAStep(ctx context.Context, userID int, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseMFAStep", ctx, userID, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseMFAStep indicates an expected call of UseMFAStep.
+func (mr *MockAuthRepositoryMockRecorder) UseMFAStep(ctx, userID, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseMFAStep", reflect.TypeOf((*MockAuthRepository)(nil).UseMFAStep), ctx, userID, step)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockAuthRepository) UseRecoveryCode(ctx context.Context, userID int, codeHash string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, userID, codeHash, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockAuthRepositoryMockRecorder) UseRecoveryCode(ctx, userID, codeHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockAuthRepository)(nil).UseRecoveryCode), ctx, userID, codeHash, now)
+}
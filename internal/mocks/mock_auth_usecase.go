@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	auth "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/models"
 	proto "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
@@ -42,6 +43,226 @@ func (m *MockAuthUseCase) EXPECT() *MockAuthUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AdvanceAccountDeletion mocks base method.
+func (m *MockAuthUseCase) AdvanceAccountDeletion(ctx context.Context, userID int, step string, imageIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceAccountDeletion", ctx, userID, step, imageIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdvanceAccountDeletion indicates an expected call of AdvanceAccountDeletion.
+func (mr *MockAuthUseCaseMockRecorder) AdvanceAccountDeletion(ctx, userID, step, imageIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceAccountDeletion", reflect.TypeOf((*MockAuthUseCase)(nil).AdvanceAccountDeletion), ctx, userID, step, imageIDs)
+}
+
+// AuthenticatePersonalToken mocks base method.
+func (m *MockAuthUseCase) AuthenticatePersonalToken(ctx context.Context, token string) (*proto.TokenPrincipal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticatePersonalToken", ctx, token)
+	ret0, _ := ret[0].(*proto.TokenPrincipal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticatePersonalToken indicates an expected call of AuthenticatePersonalToken.
+func (mr *MockAuthUseCaseMockRecorder) AuthenticatePersonalToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticatePersonalToken", reflect.TypeOf((*MockAuthUseCase)(nil).AuthenticatePersonalToken), ctx, token)
+}
+
+// CancelAccountDeletion mocks base method.
+func (m *MockAuthUseCase) CancelAccountDeletion(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAccountDeletion", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAccountDeletion indicates an expected call of CancelAccountDeletion.
+func (mr *MockAuthUseCaseMockRecorder) CancelAccountDeletion(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAccountDeletion", reflect.TypeOf((*MockAuthUseCase)(nil).CancelAccountDeletion), ctx, userID)
+}
+
+// ChangePassword mocks base method.
+func (m *MockAuthUseCase) ChangePassword(ctx context.Context, req auth.ChangePasswordRequest) (*proto.RevokedSessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, req)
+	ret0, _ := ret[0].(*proto.RevokedSessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthUseCaseMockRecorder) ChangePassword(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthUseCase)(nil).ChangePassword), ctx, req)
+}
+
+// CheckSession mocks base method.
+func (m *MockAuthUseCase) CheckSession(ctx context.Context, userID, sessionID int) (*proto.SessionStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(*proto.SessionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckSession indicates an expected call of CheckSession.
+func (mr *MockAuthUseCaseMockRecorder) CheckSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSession", reflect.TypeOf((*MockAuthUseCase)(nil).CheckSession), ctx, userID, sessionID)
+}
+
+// ClaimAccountDeletions mocks base method.
+func (m *MockAuthUseCase) ClaimAccountDeletions(ctx context.Context, limit int, lease time.Duration) (*proto.AccountDeletionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimAccountDeletions", ctx, limit, lease)
+	ret0, _ := ret[0].(*proto.AccountDeletionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimAccountDeletions indicates an expected call of ClaimAccountDeletions.
+func (mr *MockAuthUseCaseMockRecorder) ClaimAccountDeletions(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAccountDeletions", reflect.TypeOf((*MockAuthUseCase)(nil).ClaimAccountDeletions), ctx, limit, lease)
+}
+
+// ConfirmEmail mocks base method.
+func (m *MockAuthUseCase) ConfirmEmail(ctx context.Context, token string) (*proto.EmailConfirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmail", ctx, token)
+	ret0, _ := ret[0].(*proto.EmailConfirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmail indicates an expected call of ConfirmEmail.
+func (mr *MockAuthUseCaseMockRecorder) ConfirmEmail(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmail", reflect.TypeOf((*MockAuthUseCase)(nil).ConfirmEmail), ctx, token)
+}
+
+// ConfirmMFA mocks base method.
+func (m *MockAuthUseCase) ConfirmMFA(ctx context.Context, userID int, code string) (*proto.RecoveryCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmMFA", ctx, userID, code)
+	ret0, _ := ret[0].(*proto.RecoveryCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMFA indicates an expected call of ConfirmMFA.
+func (mr *MockAuthUseCaseMockRecorder) ConfirmMFA(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMFA", reflect.TypeOf((*MockAuthUseCase)(nil).ConfirmMFA), ctx, userID, code)
+}
+
+// ConfirmPasswordReset mocks base method.
+func (m *MockAuthUseCase) ConfirmPasswordReset(ctx context.Context, token, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPasswordReset", ctx, token, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmPasswordReset indicates an expected call of ConfirmPasswordReset.
+func (mr *MockAuthUseCaseMockRecorder) ConfirmPasswordReset(ctx, token, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPasswordReset", reflect.TypeOf((*MockAuthUseCase)(nil).ConfirmPasswordReset), ctx, token, newPassword)
+}
+
+// CreatePersonalToken mocks base method.
+func (m *MockAuthUseCase) CreatePersonalToken(ctx context.Context, req auth.CreatePersonalTokenRequest) (*proto.CreatedPersonalToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalToken", ctx, req)
+	ret0, _ := ret[0].(*proto.CreatedPersonalToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonalToken indicates an expected call of CreatePersonalToken.
+func (mr *MockAuthUseCaseMockRecorder) CreatePersonalToken(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalToken", reflect.TypeOf((*MockAuthUseCase)(nil).CreatePersonalToken), ctx, req)
+}
+
+// DeleteUser mocks base method.
+func (m *MockAuthUseCase) DeleteUser(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockAuthUseCaseMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockAuthUseCase)(nil).DeleteUser), ctx, userID)
+}
+
+// DisableMFA mocks base method.
+func (m *MockAuthUseCase) DisableMFA(ctx context.Context, userID int, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMFA", ctx, userID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockAuthUseCaseMockRecorder) DisableMFA(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAuthUseCase)(nil).DisableMFA), ctx, userID, code)
+}
+
+// EnrollMFA mocks base method.
+func (m *MockAuthUseCase) EnrollMFA(ctx context.Context, userID int) (*proto.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFA", ctx, userID)
+	ret0, _ := ret[0].(*proto.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockAuthUseCaseMockRecorder) EnrollMFA(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAuthUseCase)(nil).EnrollMFA), ctx, userID)
+}
+
+// ExportProfile mocks base method.
+func (m *MockAuthUseCase) ExportProfile(arg0 context.Context, arg1 int) (*proto.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportProfile", arg0, arg1)
+	ret0, _ := ret[0].(*proto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportProfile indicates an expected call of ExportProfile.
+func (mr *MockAuthUseCaseMockRecorder) ExportProfile(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportProfile", reflect.TypeOf((*MockAuthUseCase)(nil).ExportProfile), arg0, arg1)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockAuthUseCase) FilterUsedImages(ctx context.Context, ids []string) (*proto.ImageIDs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterUsedImages", ctx, ids)
+	ret0, _ := ret[0].(*proto.ImageIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockAuthUseCaseMockRecorder) FilterUsedImages(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockAuthUseCase)(nil).FilterUsedImages), ctx, ids)
+}
+
 // GetCSRFToken mocks base method.
 func (m *MockAuthUseCase) GetCSRFToken(arg0 context.Context) (*proto.CSRFTokenResponse, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +293,51 @@ func (mr *MockAuthUseCaseMockRecorder) GetProfile(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockAuthUseCase)(nil).GetProfile), arg0, arg1)
 }
 
+// ImportProfile mocks base method.
+func (m *MockAuthUseCase) ImportProfile(arg0 context.Context, arg1 auth.User) (*proto.ProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportProfile", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportProfile indicates an expected call of ImportProfile.
+func (mr *MockAuthUseCaseMockRecorder) ImportProfile(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportProfile", reflect.TypeOf((*MockAuthUseCase)(nil).ImportProfile), arg0, arg1)
+}
+
+// ListPersonalTokens mocks base method.
+func (m *MockAuthUseCase) ListPersonalTokens(ctx context.Context, userID int) (*proto.PersonalTokenList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPersonalTokens", ctx, userID)
+	ret0, _ := ret[0].(*proto.PersonalTokenList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalTokens indicates an expected call of ListPersonalTokens.
+func (mr *MockAuthUseCaseMockRecorder) ListPersonalTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalTokens", reflect.TypeOf((*MockAuthUseCase)(nil).ListPersonalTokens), ctx, userID)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthUseCase) ListSessions(ctx context.Context, userID, currentSessionID int) (*proto.SessionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID, currentSessionID)
+	ret0, _ := ret[0].(*proto.SessionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthUseCaseMockRecorder) ListSessions(ctx, userID, currentSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthUseCase)(nil).ListSessions), ctx, userID, currentSessionID)
+}
+
 // Login mocks base method.
 func (m *MockAuthUseCase) Login(arg0 context.Context, arg1 auth.LoginRequest) (*proto.AuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +353,21 @@ func (mr *MockAuthUseCaseMockRecorder) Login(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthUseCase)(nil).Login), arg0, arg1)
 }
 
+// RefreshToken mocks base method.
+func (m *MockAuthUseCase) RefreshToken(arg0 context.Context, arg1 string, arg2 auth.ClientInfo) (*proto.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*proto.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthUseCaseMockRecorder) RefreshToken(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthUseCase)(nil).RefreshToken), arg0, arg1, arg2)
+}
+
 // Register mocks base method.
 func (m *MockAuthUseCase) Register(arg0 context.Context, arg1 auth.RegisterRequest) (*proto.AuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +383,92 @@ func (mr *MockAuthUseCaseMockRecorder) Register(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthUseCase)(nil).Register), arg0, arg1)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockAuthUseCase) RequestPasswordReset(ctx context.Context, req auth.PasswordResetRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthUseCaseMockRecorder) RequestPasswordReset(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthUseCase)(nil).RequestPasswordReset), ctx, req)
+}
+
+// ResendEmailVerification mocks base method.
+func (m *MockAuthUseCase) ResendEmailVerification(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendEmailVerification", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendEmailVerification indicates an expected call of ResendEmailVerification.
+func (mr *MockAuthUseCaseMockRecorder) ResendEmailVerification(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendEmailVerification", reflect.TypeOf((*MockAuthUseCase)(nil).ResendEmailVerification), ctx, userID)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockAuthUseCase) RevokeOtherSessions(ctx context.Context, userID, currentSessionID int) (*proto.RevokedSessions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", ctx, userID, currentSessionID)
+	ret0, _ := ret[0].(*proto.RevokedSessions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockAuthUseCaseMockRecorder) RevokeOtherSessions(ctx, userID, currentSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockAuthUseCase)(nil).RevokeOtherSessions), ctx, userID, currentSessionID)
+}
+
+// RevokePersonalToken mocks base method.
+func (m *MockAuthUseCase) RevokePersonalToken(ctx context.Context, userID, tokenID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePersonalToken", ctx, userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokePersonalToken indicates an expected call of RevokePersonalToken.
+func (mr *MockAuthUseCaseMockRecorder) RevokePersonalToken(ctx, userID, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalToken", reflect.TypeOf((*MockAuthUseCase)(nil).RevokePersonalToken), ctx, userID, tokenID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthUseCase) RevokeSession(ctx context.Context, userID, sessionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthUseCaseMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthUseCase)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// ScheduleAccountDeletion mocks base method.
+func (m *MockAuthUseCase) ScheduleAccountDeletion(ctx context.Context, userID int, password, code string) (*proto.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleAccountDeletion", ctx, userID, password, code)
+	ret0, _ := ret[0].(*proto.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleAccountDeletion indicates an expected call of ScheduleAccountDeletion.
+func (mr *MockAuthUseCaseMockRecorder) ScheduleAccountDeletion(ctx, userID, password, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleAccountDeletion", reflect.TypeOf((*MockAuthUseCase)(nil).ScheduleAccountDeletion), ctx, userID, password, code)
+}
+
 // UpdateProfile mocks base method.
 func (m *MockAuthUseCase) UpdateProfile(arg0 context.Context, arg1 auth.UpdateProfileRequest) (*proto.ProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,3 +483,18 @@ func (mr *MockAuthUseCaseMockRecorder) UpdateProfile(arg0, arg1 any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockAuthUseCase)(nil).UpdateProfile), arg0, arg1)
 }
+
+// VerifyMFA mocks base method.
+func (m *MockAuthUseCase) VerifyMFA(ctx context.Context, req auth.VerifyMFARequest) (*proto.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFA", ctx, req)
+	ret0, _ := ret[0].(*proto.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthUseCaseMockRecorder) VerifyMFA(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthUseCase)(nil).VerifyMFA), ctx, req)
+}
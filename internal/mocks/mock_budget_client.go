@@ -42,6 +42,26 @@ func (m *MockBudgetServiceClient) EXPECT() *MockBudgetServiceClientMockRecorder
 	return m.recorder
 }
 
+// AddGoalContribution mocks base method.
+func (m *MockBudgetServiceClient) AddGoalContribution(ctx context.Context, in *proto.CreateContributionRequest, opts ...grpc.CallOption) (*proto.GoalContribution, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGoalContribution", varargs...)
+	ret0, _ := ret[0].(*proto.GoalContribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGoalContribution indicates an expected call of AddGoalContribution.
+func (mr *MockBudgetServiceClientMockRecorder) AddGoalContribution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGoalContribution", reflect.TypeOf((*MockBudgetServiceClient)(nil).AddGoalContribution), varargs...)
+}
+
 // CreateBudget mocks base method.
 func (m *MockBudgetServiceClient) CreateBudget(ctx context.Context, in *proto.CreateBudgetRequest, opts ...grpc.CallOption) (*proto.Budget, error) {
 	m.ctrl.T.Helper()
@@ -62,6 +82,26 @@ func (mr *MockBudgetServiceClientMockRecorder) CreateBudget(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudget", reflect.TypeOf((*MockBudgetServiceClient)(nil).CreateBudget), varargs...)
 }
 
+// CreateGoal mocks base method.
+func (m *MockBudgetServiceClient) CreateGoal(ctx context.Context, in *proto.CreateGoalRequest, opts ...grpc.CallOption) (*proto.Goal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateGoal", varargs...)
+	ret0, _ := ret[0].(*proto.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockBudgetServiceClientMockRecorder) CreateGoal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockBudgetServiceClient)(nil).CreateGoal), varargs...)
+}
+
 // DeleteBudget mocks base method.
 func (m *MockBudgetServiceClient) DeleteBudget(ctx context.Context, in *proto.BudgetRequest, opts ...grpc.CallOption) (*proto.Budget, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +122,86 @@ func (mr *MockBudgetServiceClientMockRecorder) DeleteBudget(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockBudgetServiceClient)(nil).DeleteBudget), varargs...)
 }
 
+// DeleteGoal mocks base method.
+func (m *MockBudgetServiceClient) DeleteGoal(ctx context.Context, in *proto.GoalRequest, opts ...grpc.CallOption) (*proto.Goal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteGoal", varargs...)
+	ret0, _ := ret[0].(*proto.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockBudgetServiceClientMockRecorder) DeleteGoal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockBudgetServiceClient)(nil).DeleteGoal), varargs...)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockBudgetServiceClient) DeleteUserData(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.UserDataDeletion, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserData", varargs...)
+	ret0, _ := ret[0].(*proto.UserDataDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockBudgetServiceClientMockRecorder) DeleteUserData(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockBudgetServiceClient)(nil).DeleteUserData), varargs...)
+}
+
+// ExportBudgets mocks base method.
+func (m *MockBudgetServiceClient) ExportBudgets(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.ListBudgetsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportBudgets", varargs...)
+	ret0, _ := ret[0].(*proto.ListBudgetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBudgets indicates an expected call of ExportBudgets.
+func (mr *MockBudgetServiceClientMockRecorder) ExportBudgets(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBudgets", reflect.TypeOf((*MockBudgetServiceClient)(nil).ExportBudgets), varargs...)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockBudgetServiceClient) FilterUsedImages(ctx context.Context, in *proto.ImageIDs, opts ...grpc.CallOption) (*proto.ImageIDs, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FilterUsedImages", varargs...)
+	ret0, _ := ret[0].(*proto.ImageIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockBudgetServiceClientMockRecorder) FilterUsedImages(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockBudgetServiceClient)(nil).FilterUsedImages), varargs...)
+}
+
 // GetBudget mocks base method.
 func (m *MockBudgetServiceClient) GetBudget(ctx context.Context, in *proto.BudgetRequest, opts ...grpc.CallOption) (*proto.Budget, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +222,106 @@ func (mr *MockBudgetServiceClientMockRecorder) GetBudget(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudget", reflect.TypeOf((*MockBudgetServiceClient)(nil).GetBudget), varargs...)
 }
 
+// GetBudgetForecast mocks base method.
+func (m *MockBudgetServiceClient) GetBudgetForecast(ctx context.Context, in *proto.BudgetRequest, opts ...grpc.CallOption) (*proto.BudgetForecast, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBudgetForecast", varargs...)
+	ret0, _ := ret[0].(*proto.BudgetForecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetForecast indicates an expected call of GetBudgetForecast.
+func (mr *MockBudgetServiceClientMockRecorder) GetBudgetForecast(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetForecast", reflect.TypeOf((*MockBudgetServiceClient)(nil).GetBudgetForecast), varargs...)
+}
+
+// GetBudgetHistory mocks base method.
+func (m *MockBudgetServiceClient) GetBudgetHistory(ctx context.Context, in *proto.BudgetRequest, opts ...grpc.CallOption) (*proto.ListBudgetsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBudgetHistory", varargs...)
+	ret0, _ := ret[0].(*proto.ListBudgetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetHistory indicates an expected call of GetBudgetHistory.
+func (mr *MockBudgetServiceClientMockRecorder) GetBudgetHistory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetHistory", reflect.TypeOf((*MockBudgetServiceClient)(nil).GetBudgetHistory), varargs...)
+}
+
+// GetGoal mocks base method.
+func (m *MockBudgetServiceClient) GetGoal(ctx context.Context, in *proto.GoalRequest, opts ...grpc.CallOption) (*proto.Goal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGoal", varargs...)
+	ret0, _ := ret[0].(*proto.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoal indicates an expected call of GetGoal.
+func (mr *MockBudgetServiceClientMockRecorder) GetGoal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockBudgetServiceClient)(nil).GetGoal), varargs...)
+}
+
+// GetGoalContributions mocks base method.
+func (m *MockBudgetServiceClient) GetGoalContributions(ctx context.Context, in *proto.GoalRequest, opts ...grpc.CallOption) (*proto.ListContributionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGoalContributions", varargs...)
+	ret0, _ := ret[0].(*proto.ListContributionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalContributions indicates an expected call of GetGoalContributions.
+func (mr *MockBudgetServiceClientMockRecorder) GetGoalContributions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalContributions", reflect.TypeOf((*MockBudgetServiceClient)(nil).GetGoalContributions), varargs...)
+}
+
+// GetGoals mocks base method.
+func (m *MockBudgetServiceClient) GetGoals(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.ListGoalsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGoals", varargs...)
+	ret0, _ := ret[0].(*proto.ListGoalsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoals indicates an expected call of GetGoals.
+func (mr *MockBudgetServiceClientMockRecorder) GetGoals(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoals", reflect.TypeOf((*MockBudgetServiceClient)(nil).GetGoals), varargs...)
+}
+
 // GetListBudgets mocks base method.
 func (m *MockBudgetServiceClient) GetListBudgets(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.ListBudgetsResponse, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +342,46 @@ func (mr *MockBudgetServiceClientMockRecorder) GetListBudgets(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListBudgets", reflect.TypeOf((*MockBudgetServiceClient)(nil).GetListBudgets), varargs...)
 }
 
+// ImportBudgets mocks base method.
+func (m *MockBudgetServiceClient) ImportBudgets(ctx context.Context, in *proto.ImportBudgetsRequest, opts ...grpc.CallOption) (*proto.ImportBudgetsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportBudgets", varargs...)
+	ret0, _ := ret[0].(*proto.ImportBudgetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBudgets indicates an expected call of ImportBudgets.
+func (mr *MockBudgetServiceClientMockRecorder) ImportBudgets(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBudgets", reflect.TypeOf((*MockBudgetServiceClient)(nil).ImportBudgets), varargs...)
+}
+
+// ReplaceBudgetCategory mocks base method.
+func (m *MockBudgetServiceClient) ReplaceBudgetCategory(ctx context.Context, in *proto.ReplaceCategoryRequest, opts ...grpc.CallOption) (*proto.ReplaceCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplaceBudgetCategory", varargs...)
+	ret0, _ := ret[0].(*proto.ReplaceCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceBudgetCategory indicates an expected call of ReplaceBudgetCategory.
+func (mr *MockBudgetServiceClientMockRecorder) ReplaceBudgetCategory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBudgetCategory", reflect.TypeOf((*MockBudgetServiceClient)(nil).ReplaceBudgetCategory), varargs...)
+}
+
 // UpdateBudget mocks base method.
 func (m *MockBudgetServiceClient) UpdateBudget(ctx context.Context, in *proto.UpdateBudgetRequest, opts ...grpc.CallOption) (*proto.Budget, error) {
 	m.ctrl.T.Helper()
@@ -141,3 +401,23 @@ func (mr *MockBudgetServiceClientMockRecorder) UpdateBudget(ctx, in any, opts ..
 	varargs := append([]any{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudget", reflect.TypeOf((*MockBudgetServiceClient)(nil).UpdateBudget), varargs...)
 }
+
+// UpdateGoal mocks base method.
+func (m *MockBudgetServiceClient) UpdateGoal(ctx context.Context, in *proto.UpdateGoalRequest, opts ...grpc.CallOption) (*proto.Goal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGoal", varargs...)
+	ret0, _ := ret[0].(*proto.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockBudgetServiceClientMockRecorder) UpdateGoal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockBudgetServiceClient)(nil).UpdateGoal), varargs...)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/models"
 	gomock "go.uber.org/mock/gomock"
@@ -56,6 +57,36 @@ func (mr *MockBudgetRepositoryMockRecorder) CreateBudget(ctx, budget any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudget", reflect.TypeOf((*MockBudgetRepository)(nil).CreateBudget), ctx, budget)
 }
 
+// CreateContribution mocks base method.
+func (m *MockBudgetRepository) CreateContribution(ctx context.Context, c models.GoalContribution) (models.GoalContribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContribution", ctx, c)
+	ret0, _ := ret[0].(models.GoalContribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContribution indicates an expected call of CreateContribution.
+func (mr *MockBudgetRepositoryMockRecorder) CreateContribution(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContribution", reflect.TypeOf((*MockBudgetRepository)(nil).CreateContribution), ctx, c)
+}
+
+// CreateGoal mocks base method.
+func (m *MockBudgetRepository) CreateGoal(ctx context.Context, goal models.Goal) (models.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoal", ctx, goal)
+	ret0, _ := ret[0].(models.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockBudgetRepositoryMockRecorder) CreateGoal(ctx, goal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockBudgetRepository)(nil).CreateGoal), ctx, goal)
+}
+
 // DeleteBudget mocks base method.
 func (m *MockBudgetRepository) DeleteBudget(ctx context.Context, budgetID int) (models.Budget, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +102,81 @@ func (mr *MockBudgetRepositoryMockRecorder) DeleteBudget(ctx, budgetID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockBudgetRepository)(nil).DeleteBudget), ctx, budgetID)
 }
 
+// DeleteGoal mocks base method.
+func (m *MockBudgetRepository) DeleteGoal(ctx context.Context, userID, goalID int) (models.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, userID, goalID)
+	ret0, _ := ret[0].(models.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockBudgetRepositoryMockRecorder) DeleteGoal(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockBudgetRepository)(nil).DeleteGoal), ctx, userID, goalID)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockBudgetRepository) DeleteUserData(ctx context.Context, userID int) (models.UserDataDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, userID)
+	ret0, _ := ret[0].(models.UserDataDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockBudgetRepositoryMockRecorder) DeleteUserData(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockBudgetRepository)(nil).DeleteUserData), ctx, userID)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockBudgetRepository) FilterUsedImages(ctx context.Context, ids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterUsedImages", ctx, ids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockBudgetRepositoryMockRecorder) FilterUsedImages(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockBudgetRepository)(nil).FilterUsedImages), ctx, ids)
+}
+
+// GetAllBudgetsByUser mocks base method.
+func (m *MockBudgetRepository) GetAllBudgetsByUser(ctx context.Context, userID int) ([]models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBudgetsByUser", ctx, userID)
+	ret0, _ := ret[0].([]models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllBudgetsByUser indicates an expected call of GetAllBudgetsByUser.
+func (mr *MockBudgetRepositoryMockRecorder) GetAllBudgetsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBudgetsByUser", reflect.TypeOf((*MockBudgetRepository)(nil).GetAllBudgetsByUser), ctx, userID)
+}
+
+// GetBudgetSeries mocks base method.
+func (m *MockBudgetRepository) GetBudgetSeries(ctx context.Context, userID, budgetID int) ([]models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetSeries", ctx, userID, budgetID)
+	ret0, _ := ret[0].([]models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetSeries indicates an expected call of GetBudgetSeries.
+func (mr *MockBudgetRepositoryMockRecorder) GetBudgetSeries(ctx, userID, budgetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetSeries", reflect.TypeOf((*MockBudgetRepository)(nil).GetBudgetSeries), ctx, userID, budgetID)
+}
+
 // GetBudgetsByUser mocks base method.
 func (m *MockBudgetRepository) GetBudgetsByUser(ctx context.Context, userID int) ([]models.Budget, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +192,111 @@ func (mr *MockBudgetRepositoryMockRecorder) GetBudgetsByUser(ctx, userID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetsByUser", reflect.TypeOf((*MockBudgetRepository)(nil).GetBudgetsByUser), ctx, userID)
 }
 
+// GetBudgetsDueForRollover mocks base method.
+func (m *MockBudgetRepository) GetBudgetsDueForRollover(ctx context.Context, today time.Time) ([]models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetsDueForRollover", ctx, today)
+	ret0, _ := ret[0].([]models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetsDueForRollover indicates an expected call of GetBudgetsDueForRollover.
+func (mr *MockBudgetRepositoryMockRecorder) GetBudgetsDueForRollover(ctx, today any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetsDueForRollover", reflect.TypeOf((*MockBudgetRepository)(nil).GetBudgetsDueForRollover), ctx, today)
+}
+
+// GetContributions mocks base method.
+func (m *MockBudgetRepository) GetContributions(ctx context.Context, goalIDs []int) ([]models.GoalContribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContributions", ctx, goalIDs)
+	ret0, _ := ret[0].([]models.GoalContribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContributions indicates an expected call of GetContributions.
+func (mr *MockBudgetRepositoryMockRecorder) GetContributions(ctx, goalIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContributions", reflect.TypeOf((*MockBudgetRepository)(nil).GetContributions), ctx, goalIDs)
+}
+
+// GetGoal mocks base method.
+func (m *MockBudgetRepository) GetGoal(ctx context.Context, userID, goalID int) (models.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoal", ctx, userID, goalID)
+	ret0, _ := ret[0].(models.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoal indicates an expected call of GetGoal.
+func (mr *MockBudgetRepositoryMockRecorder) GetGoal(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockBudgetRepository)(nil).GetGoal), ctx, userID, goalID)
+}
+
+// GetGoalsByUser mocks base method.
+func (m *MockBudgetRepository) GetGoalsByUser(ctx context.Context, userID int) ([]models.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoalsByUser", ctx, userID)
+	ret0, _ := ret[0].([]models.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalsByUser indicates an expected call of GetGoalsByUser.
+func (mr *MockBudgetRepositoryMockRecorder) GetGoalsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalsByUser", reflect.TypeOf((*MockBudgetRepository)(nil).GetGoalsByUser), ctx, userID)
+}
+
+// ImportBudgets mocks base method.
+func (m *MockBudgetRepository) ImportBudgets(ctx context.Context, req models.ImportBudgetsRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBudgets", ctx, req)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBudgets indicates an expected call of ImportBudgets.
+func (mr *MockBudgetRepositoryMockRecorder) ImportBudgets(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBudgets", reflect.TypeOf((*MockBudgetRepository)(nil).ImportBudgets), ctx, req)
+}
+
+// ReplaceBudgetCategory mocks base method.
+func (m *MockBudgetRepository) ReplaceBudgetCategory(ctx context.Context, userID, sourceID, targetID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceBudgetCategory", ctx, userID, sourceID, targetID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceBudgetCategory indicates an expected call of ReplaceBudgetCategory.
+func (mr *MockBudgetRepositoryMockRecorder) ReplaceBudgetCategory(ctx, userID, sourceID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBudgetCategory", reflect.TypeOf((*MockBudgetRepository)(nil).ReplaceBudgetCategory), ctx, userID, sourceID, targetID)
+}
+
+// RolloverBudget mocks base method.
+func (m *MockBudgetRepository) RolloverBudget(ctx context.Context, prevID int, next models.Budget) (models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RolloverBudget", ctx, prevID, next)
+	ret0, _ := ret[0].(models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RolloverBudget indicates an expected call of RolloverBudget.
+func (mr *MockBudgetRepositoryMockRecorder) RolloverBudget(ctx, prevID, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RolloverBudget", reflect.TypeOf((*MockBudgetRepository)(nil).RolloverBudget), ctx, prevID, next)
+}
+
 // UpdateBudget mocks base method.
 func (m *MockBudgetRepository) UpdateBudget(ctx context.Context, req models.UpdatedBudgetRequest) (models.Budget, error) {
 	m.ctrl.T.Helper()
@@ -100,3 +311,18 @@ func (mr *MockBudgetRepositoryMockRecorder) UpdateBudget(ctx, req any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudget", reflect.TypeOf((*MockBudgetRepository)(nil).UpdateBudget), ctx, req)
 }
+
+// UpdateGoal mocks base method.
+func (m *MockBudgetRepository) UpdateGoal(ctx context.Context, req models.UpdateGoalRequest) (models.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, req)
+	ret0, _ := ret[0].(models.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockBudgetRepositoryMockRecorder) UpdateGoal(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockBudgetRepository)(nil).UpdateGoal), ctx, req)
+}
@@ -42,6 +42,21 @@ func (m *MockBudgetUseCase) EXPECT() *MockBudgetUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddGoalContribution mocks base method.
+func (m *MockBudgetUseCase) AddGoalContribution(ctx context.Context, req models.CreateContributionRequest) (*proto.GoalContribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGoalContribution", ctx, req)
+	ret0, _ := ret[0].(*proto.GoalContribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGoalContribution indicates an expected call of AddGoalContribution.
+func (mr *MockBudgetUseCaseMockRecorder) AddGoalContribution(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGoalContribution", reflect.TypeOf((*MockBudgetUseCase)(nil).AddGoalContribution), ctx, req)
+}
+
 // CreateBudget mocks base method.
 func (m *MockBudgetUseCase) CreateBudget(arg0 context.Context, arg1 models.CreateBudgetRequest, arg2 int) (*proto.Budget, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +72,21 @@ func (mr *MockBudgetUseCaseMockRecorder) CreateBudget(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudget", reflect.TypeOf((*MockBudgetUseCase)(nil).CreateBudget), arg0, arg1, arg2)
 }
 
+// CreateGoal mocks base method.
+func (m *MockBudgetUseCase) CreateGoal(ctx context.Context, req models.CreateGoalRequest) (*proto.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoal", ctx, req)
+	ret0, _ := ret[0].(*proto.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockBudgetUseCaseMockRecorder) CreateGoal(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockBudgetUseCase)(nil).CreateGoal), ctx, req)
+}
+
 // DeleteBudget mocks base method.
 func (m *MockBudgetUseCase) DeleteBudget(ctx context.Context, budgetID, userID int) (*proto.Budget, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +102,66 @@ func (mr *MockBudgetUseCaseMockRecorder) DeleteBudget(ctx, budgetID, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockBudgetUseCase)(nil).DeleteBudget), ctx, budgetID, userID)
 }
 
+// DeleteGoal mocks base method.
+func (m *MockBudgetUseCase) DeleteGoal(ctx context.Context, goalID, userID int) (*proto.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, goalID, userID)
+	ret0, _ := ret[0].(*proto.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockBudgetUseCaseMockRecorder) DeleteGoal(ctx, goalID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockBudgetUseCase)(nil).DeleteGoal), ctx, goalID, userID)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockBudgetUseCase) DeleteUserData(ctx context.Context, userID int) (*proto.UserDataDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, userID)
+	ret0, _ := ret[0].(*proto.UserDataDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockBudgetUseCaseMockRecorder) DeleteUserData(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockBudgetUseCase)(nil).DeleteUserData), ctx, userID)
+}
+
+// ExportBudgets mocks base method.
+func (m *MockBudgetUseCase) ExportBudgets(ctx context.Context, userID int) (*proto.ListBudgetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBudgets", ctx, userID)
+	ret0, _ := ret[0].(*proto.ListBudgetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBudgets indicates an expected call of ExportBudgets.
+func (mr *MockBudgetUseCaseMockRecorder) ExportBudgets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBudgets", reflect.TypeOf((*MockBudgetUseCase)(nil).ExportBudgets), ctx, userID)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockBudgetUseCase) FilterUsedImages(ctx context.Context, ids []string) (*proto.ImageIDs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterUsedImages", ctx, ids)
+	ret0, _ := ret[0].(*proto.ImageIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockBudgetUseCaseMockRecorder) FilterUsedImages(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockBudgetUseCase)(nil).FilterUsedImages), ctx, ids)
+}
+
 // GetBudget mocks base method.
 func (m *MockBudgetUseCase) GetBudget(ctx context.Context, budgetID, userID int) (*proto.Budget, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +177,36 @@ func (mr *MockBudgetUseCaseMockRecorder) GetBudget(ctx, budgetID, userID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudget", reflect.TypeOf((*MockBudgetUseCase)(nil).GetBudget), ctx, budgetID, userID)
 }
 
+// GetBudgetForecast mocks base method.
+func (m *MockBudgetUseCase) GetBudgetForecast(ctx context.Context, budgetID, userID int) (*proto.BudgetForecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetForecast", ctx, budgetID, userID)
+	ret0, _ := ret[0].(*proto.BudgetForecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetForecast indicates an expected call of GetBudgetForecast.
+func (mr *MockBudgetUseCaseMockRecorder) GetBudgetForecast(ctx, budgetID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetForecast", reflect.TypeOf((*MockBudgetUseCase)(nil).GetBudgetForecast), ctx, budgetID, userID)
+}
+
+// GetBudgetHistory mocks base method.
+func (m *MockBudgetUseCase) GetBudgetHistory(ctx context.Context, budgetID, userID int) (*proto.ListBudgetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgetHistory", ctx, budgetID, userID)
+	ret0, _ := ret[0].(*proto.ListBudgetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgetHistory indicates an expected call of GetBudgetHistory.
+func (mr *MockBudgetUseCaseMockRecorder) GetBudgetHistory(ctx, budgetID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetHistory", reflect.TypeOf((*MockBudgetUseCase)(nil).GetBudgetHistory), ctx, budgetID, userID)
+}
+
 // GetBudgets mocks base method.
 func (m *MockBudgetUseCase) GetBudgets(arg0 context.Context, arg1 int) (*proto.ListBudgetsResponse, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +222,81 @@ func (mr *MockBudgetUseCaseMockRecorder) GetBudgets(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgets", reflect.TypeOf((*MockBudgetUseCase)(nil).GetBudgets), arg0, arg1)
 }
 
+// GetGoal mocks base method.
+func (m *MockBudgetUseCase) GetGoal(ctx context.Context, goalID, userID int) (*proto.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoal", ctx, goalID, userID)
+	ret0, _ := ret[0].(*proto.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoal indicates an expected call of GetGoal.
+func (mr *MockBudgetUseCaseMockRecorder) GetGoal(ctx, goalID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockBudgetUseCase)(nil).GetGoal), ctx, goalID, userID)
+}
+
+// GetGoalContributions mocks base method.
+func (m *MockBudgetUseCase) GetGoalContributions(ctx context.Context, goalID, userID int) (*proto.ListContributionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoalContributions", ctx, goalID, userID)
+	ret0, _ := ret[0].(*proto.ListContributionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalContributions indicates an expected call of GetGoalContributions.
+func (mr *MockBudgetUseCaseMockRecorder) GetGoalContributions(ctx, goalID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalContributions", reflect.TypeOf((*MockBudgetUseCase)(nil).GetGoalContributions), ctx, goalID, userID)
+}
+
+// GetGoals mocks base method.
+func (m *MockBudgetUseCase) GetGoals(ctx context.Context, userID int) (*proto.ListGoalsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoals", ctx, userID)
+	ret0, _ := ret[0].(*proto.ListGoalsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoals indicates an expected call of GetGoals.
+func (mr *MockBudgetUseCaseMockRecorder) GetGoals(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoals", reflect.TypeOf((*MockBudgetUseCase)(nil).GetGoals), ctx, userID)
+}
+
+// ImportBudgets mocks base method.
+func (m *MockBudgetUseCase) ImportBudgets(ctx context.Context, req models.ImportBudgetsRequest) (*proto.ImportBudgetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBudgets", ctx, req)
+	ret0, _ := ret[0].(*proto.ImportBudgetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBudgets indicates an expected call of ImportBudgets.
+func (mr *MockBudgetUseCaseMockRecorder) ImportBudgets(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBudgets", reflect.TypeOf((*MockBudgetUseCase)(nil).ImportBudgets), ctx, req)
+}
+
+// ReplaceBudgetCategory mocks base method.
+func (m *MockBudgetUseCase) ReplaceBudgetCategory(ctx context.Context, userID, sourceID, targetID int) (*proto.ReplaceCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceBudgetCategory", ctx, userID, sourceID, targetID)
+	ret0, _ := ret[0].(*proto.ReplaceCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceBudgetCategory indicates an expected call of ReplaceBudgetCategory.
+func (mr *MockBudgetUseCaseMockRecorder) ReplaceBudgetCategory(ctx, userID, sourceID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBudgetCategory", reflect.TypeOf((*MockBudgetUseCase)(nil).ReplaceBudgetCategory), ctx, userID, sourceID, targetID)
+}
+
 // UpdateBudget mocks base method.
 func (m *MockBudgetUseCase) UpdateBudget(arg0 context.Context, arg1 models.UpdatedBudgetRequest) (*proto.Budget, error) {
 	m.ctrl.T.Helper()
@@ -116,3 +311,18 @@ func (mr *MockBudgetUseCaseMockRecorder) UpdateBudget(arg0, arg1 any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudget", reflect.TypeOf((*MockBudgetUseCase)(nil).UpdateBudget), arg0, arg1)
 }
+
+// UpdateGoal mocks base method.
+func (m *MockBudgetUseCase) UpdateGoal(ctx context.Context, req models.UpdateGoalRequest) (*proto.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, req)
+	ret0, _ := ret[0].(*proto.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockBudgetUseCaseMockRecorder) UpdateGoal(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockBudgetUseCase)(nil).UpdateGoal), ctx, req)
+}
@@ -42,24 +42,84 @@ func (m *MockFinanceServiceClient) EXPECT() *MockFinanceServiceClientMockRecorde
 	return m.recorder
 }
 
-// AddUserToAccounnt mocks base method.
-func (m *MockFinanceServiceClient) AddUserToAccounnt(ctx context.Context, in *proto.AddToAccountReqeust, opts ...grpc.CallOption) (*proto.SharingsResponse, error) {
+// AcceptAccountInvitation mocks base method.
+func (m *MockFinanceServiceClient) AcceptAccountInvitation(ctx context.Context, in *proto.AccountInvitationRequest, opts ...grpc.CallOption) (*proto.SharingsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "AddUserToAccounnt", varargs...)
+	ret := m.ctrl.Call(m, "AcceptAccountInvitation", varargs...)
 	ret0, _ := ret[0].(*proto.SharingsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddUserToAccounnt indicates an expected call of AddUserToAccounnt.
-func (mr *MockFinanceServiceClientMockRecorder) AddUserToAccounnt(ctx, in any, opts ...any) *gomock.Call {
+// AcceptAccountInvitation indicates an expected call of AcceptAccountInvitation.
+func (mr *MockFinanceServiceClientMockRecorder) AcceptAccountInvitation(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToAccounnt", reflect.TypeOf((*MockFinanceServiceClient)(nil).AddUserToAccounnt), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptAccountInvitation", reflect.TypeOf((*MockFinanceServiceClient)(nil).AcceptAccountInvitation), varargs...)
+}
+
+// AcceptInvitationLink mocks base method.
+func (m *MockFinanceServiceClient) AcceptInvitationLink(ctx context.Context, in *proto.AcceptInvitationLinkRequest, opts ...grpc.CallOption) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptInvitationLink", varargs...)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitationLink indicates an expected call of AcceptInvitationLink.
+func (mr *MockFinanceServiceClientMockRecorder) AcceptInvitationLink(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitationLink", reflect.TypeOf((*MockFinanceServiceClient)(nil).AcceptInvitationLink), varargs...)
+}
+
+// AddDebtRepayment mocks base method.
+func (m *MockFinanceServiceClient) AddDebtRepayment(ctx context.Context, in *proto.CreateDebtRepaymentRequest, opts ...grpc.CallOption) (*proto.DebtPayment, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDebtRepayment", varargs...)
+	ret0, _ := ret[0].(*proto.DebtPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDebtRepayment indicates an expected call of AddDebtRepayment.
+func (mr *MockFinanceServiceClientMockRecorder) AddDebtRepayment(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDebtRepayment", reflect.TypeOf((*MockFinanceServiceClient)(nil).AddDebtRepayment), varargs...)
+}
+
+// ApplyCategoryRules mocks base method.
+func (m *MockFinanceServiceClient) ApplyCategoryRules(ctx context.Context, in *proto.ApplyCategoryRulesRequest, opts ...grpc.CallOption) (*proto.ApplyCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyCategoryRules", varargs...)
+	ret0, _ := ret[0].(*proto.ApplyCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyCategoryRules indicates an expected call of ApplyCategoryRules.
+func (mr *MockFinanceServiceClientMockRecorder) ApplyCategoryRules(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyCategoryRules", reflect.TypeOf((*MockFinanceServiceClient)(nil).ApplyCategoryRules), varargs...)
 }
 
 // CreateAccount mocks base method.
@@ -82,6 +142,26 @@ func (mr *MockFinanceServiceClientMockRecorder) CreateAccount(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockFinanceServiceClient)(nil).CreateAccount), varargs...)
 }
 
+// CreateAccountInvitation mocks base method.
+func (m *MockFinanceServiceClient) CreateAccountInvitation(ctx context.Context, in *proto.CreateAccountInvitationRequest, opts ...grpc.CallOption) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAccountInvitation", varargs...)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountInvitation indicates an expected call of CreateAccountInvitation.
+func (mr *MockFinanceServiceClientMockRecorder) CreateAccountInvitation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountInvitation", reflect.TypeOf((*MockFinanceServiceClient)(nil).CreateAccountInvitation), varargs...)
+}
+
 // CreateCategory mocks base method.
 func (m *MockFinanceServiceClient) CreateCategory(ctx context.Context, in *proto.CreateCategoryRequest, opts ...grpc.CallOption) (*proto.Category, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +182,46 @@ func (mr *MockFinanceServiceClientMockRecorder) CreateCategory(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockFinanceServiceClient)(nil).CreateCategory), varargs...)
 }
 
+// CreateCategoryRule mocks base method.
+func (m *MockFinanceServiceClient) CreateCategoryRule(ctx context.Context, in *proto.CreateCategoryRuleRequest, opts ...grpc.CallOption) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCategoryRule", varargs...)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockFinanceServiceClientMockRecorder) CreateCategoryRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockFinanceServiceClient)(nil).CreateCategoryRule), varargs...)
+}
+
+// CreateDebt mocks base method.
+func (m *MockFinanceServiceClient) CreateDebt(ctx context.Context, in *proto.CreateDebtRequest, opts ...grpc.CallOption) (*proto.Debt, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDebt", varargs...)
+	ret0, _ := ret[0].(*proto.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDebt indicates an expected call of CreateDebt.
+func (mr *MockFinanceServiceClientMockRecorder) CreateDebt(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDebt", reflect.TypeOf((*MockFinanceServiceClient)(nil).CreateDebt), varargs...)
+}
+
 // CreateOperation mocks base method.
 func (m *MockFinanceServiceClient) CreateOperation(ctx context.Context, in *proto.CreateOperationRequest, opts ...grpc.CallOption) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +242,46 @@ func (mr *MockFinanceServiceClientMockRecorder) CreateOperation(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperation", reflect.TypeOf((*MockFinanceServiceClient)(nil).CreateOperation), varargs...)
 }
 
+// CreateSettlement mocks base method.
+func (m *MockFinanceServiceClient) CreateSettlement(ctx context.Context, in *proto.CreateSettlementRequest, opts ...grpc.CallOption) (*proto.Settlement, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSettlement", varargs...)
+	ret0, _ := ret[0].(*proto.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSettlement indicates an expected call of CreateSettlement.
+func (mr *MockFinanceServiceClientMockRecorder) CreateSettlement(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSettlement", reflect.TypeOf((*MockFinanceServiceClient)(nil).CreateSettlement), varargs...)
+}
+
+// DeclineAccountInvitation mocks base method.
+func (m *MockFinanceServiceClient) DeclineAccountInvitation(ctx context.Context, in *proto.AccountInvitationRequest, opts ...grpc.CallOption) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeclineAccountInvitation", varargs...)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineAccountInvitation indicates an expected call of DeclineAccountInvitation.
+func (mr *MockFinanceServiceClientMockRecorder) DeclineAccountInvitation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineAccountInvitation", reflect.TypeOf((*MockFinanceServiceClient)(nil).DeclineAccountInvitation), varargs...)
+}
+
 // DeleteAccount mocks base method.
 func (m *MockFinanceServiceClient) DeleteAccount(ctx context.Context, in *proto.AccountRequest, opts ...grpc.CallOption) (*proto.Account, error) {
 	m.ctrl.T.Helper()
@@ -143,7 +303,7 @@ func (mr *MockFinanceServiceClientMockRecorder) DeleteAccount(ctx, in any, opts
 }
 
 // DeleteCategory mocks base method.
-func (m *MockFinanceServiceClient) DeleteCategory(ctx context.Context, in *proto.CategoryRequest, opts ...grpc.CallOption) (*proto.Category, error) {
+func (m *MockFinanceServiceClient) DeleteCategory(ctx context.Context, in *proto.DeleteCategoryRequest, opts ...grpc.CallOption) (*proto.Category, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
@@ -162,6 +322,46 @@ func (mr *MockFinanceServiceClientMockRecorder) DeleteCategory(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockFinanceServiceClient)(nil).DeleteCategory), varargs...)
 }
 
+// DeleteCategoryRule mocks base method.
+func (m *MockFinanceServiceClient) DeleteCategoryRule(ctx context.Context, in *proto.CategoryRuleRequest, opts ...grpc.CallOption) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", varargs...)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockFinanceServiceClientMockRecorder) DeleteCategoryRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockFinanceServiceClient)(nil).DeleteCategoryRule), varargs...)
+}
+
+// DeleteDebt mocks base method.
+func (m *MockFinanceServiceClient) DeleteDebt(ctx context.Context, in *proto.DebtRequest, opts ...grpc.CallOption) (*proto.Debt, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDebt", varargs...)
+	ret0, _ := ret[0].(*proto.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDebt indicates an expected call of DeleteDebt.
+func (mr *MockFinanceServiceClientMockRecorder) DeleteDebt(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDebt", reflect.TypeOf((*MockFinanceServiceClient)(nil).DeleteDebt), varargs...)
+}
+
 // DeleteOperation mocks base method.
 func (m *MockFinanceServiceClient) DeleteOperation(ctx context.Context, in *proto.OperationRequest, opts ...grpc.CallOption) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +382,86 @@ func (mr *MockFinanceServiceClientMockRecorder) DeleteOperation(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperation", reflect.TypeOf((*MockFinanceServiceClient)(nil).DeleteOperation), varargs...)
 }
 
+// DeleteOperationSplit mocks base method.
+func (m *MockFinanceServiceClient) DeleteOperationSplit(ctx context.Context, in *proto.OperationRequest, opts ...grpc.CallOption) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOperationSplit", varargs...)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOperationSplit indicates an expected call of DeleteOperationSplit.
+func (mr *MockFinanceServiceClientMockRecorder) DeleteOperationSplit(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperationSplit", reflect.TypeOf((*MockFinanceServiceClient)(nil).DeleteOperationSplit), varargs...)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockFinanceServiceClient) DeleteUserData(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.UserDataDeletion, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserData", varargs...)
+	ret0, _ := ret[0].(*proto.UserDataDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockFinanceServiceClientMockRecorder) DeleteUserData(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockFinanceServiceClient)(nil).DeleteUserData), varargs...)
+}
+
+// ExportUserData mocks base method.
+func (m *MockFinanceServiceClient) ExportUserData(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportUserData", varargs...)
+	ret0, _ := ret[0].(*proto.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockFinanceServiceClientMockRecorder) ExportUserData(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockFinanceServiceClient)(nil).ExportUserData), varargs...)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockFinanceServiceClient) FilterUsedImages(ctx context.Context, in *proto.ImageIDs, opts ...grpc.CallOption) (*proto.ImageIDs, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FilterUsedImages", varargs...)
+	ret0, _ := ret[0].(*proto.ImageIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockFinanceServiceClientMockRecorder) FilterUsedImages(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockFinanceServiceClient)(nil).FilterUsedImages), varargs...)
+}
+
 // GetAccount mocks base method.
 func (m *MockFinanceServiceClient) GetAccount(ctx context.Context, in *proto.AccountRequest, opts ...grpc.CallOption) (*proto.Account, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +482,86 @@ func (mr *MockFinanceServiceClientMockRecorder) GetAccount(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetAccount), varargs...)
 }
 
+// GetAccountBalances mocks base method.
+func (m *MockFinanceServiceClient) GetAccountBalances(ctx context.Context, in *proto.AccountRequest, opts ...grpc.CallOption) (*proto.AccountBalances, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountBalances", varargs...)
+	ret0, _ := ret[0].(*proto.AccountBalances)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalances indicates an expected call of GetAccountBalances.
+func (mr *MockFinanceServiceClientMockRecorder) GetAccountBalances(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalances", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetAccountBalances), varargs...)
+}
+
+// GetAccountInvitations mocks base method.
+func (m *MockFinanceServiceClient) GetAccountInvitations(ctx context.Context, in *proto.AccountRequest, opts ...grpc.CallOption) (*proto.ListAccountInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountInvitations", varargs...)
+	ret0, _ := ret[0].(*proto.ListAccountInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInvitations indicates an expected call of GetAccountInvitations.
+func (mr *MockFinanceServiceClientMockRecorder) GetAccountInvitations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInvitations", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetAccountInvitations), varargs...)
+}
+
+// GetAccountMembers mocks base method.
+func (m *MockFinanceServiceClient) GetAccountMembers(ctx context.Context, in *proto.AccountRequest, opts ...grpc.CallOption) (*proto.ListAccountMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountMembers", varargs...)
+	ret0, _ := ret[0].(*proto.ListAccountMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountMembers indicates an expected call of GetAccountMembers.
+func (mr *MockFinanceServiceClientMockRecorder) GetAccountMembers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountMembers", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetAccountMembers), varargs...)
+}
+
+// GetAccountUserIDs mocks base method.
+func (m *MockFinanceServiceClient) GetAccountUserIDs(ctx context.Context, in *proto.AccountID, opts ...grpc.CallOption) (*proto.UserIDs, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountUserIDs", varargs...)
+	ret0, _ := ret[0].(*proto.UserIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountUserIDs indicates an expected call of GetAccountUserIDs.
+func (mr *MockFinanceServiceClientMockRecorder) GetAccountUserIDs(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountUserIDs", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetAccountUserIDs), varargs...)
+}
+
 // GetAccountsByUser mocks base method.
 func (m *MockFinanceServiceClient) GetAccountsByUser(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.ListAccountsResponse, error) {
 	m.ctrl.T.Helper()
@@ -322,64 +682,524 @@ func (mr *MockFinanceServiceClientMockRecorder) GetCategoryByName(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByName", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetCategoryByName), varargs...)
 }
 
-// GetOperation mocks base method.
-func (m *MockFinanceServiceClient) GetOperation(ctx context.Context, in *proto.OperationRequest, opts ...grpc.CallOption) (*proto.Operation, error) {
+// GetCategoryRules mocks base method.
+func (m *MockFinanceServiceClient) GetCategoryRules(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.ListCategoryRulesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetOperation", varargs...)
-	ret0, _ := ret[0].(*proto.Operation)
+	ret := m.ctrl.Call(m, "GetCategoryRules", varargs...)
+	ret0, _ := ret[0].(*proto.ListCategoryRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOperation indicates an expected call of GetOperation.
-func (mr *MockFinanceServiceClientMockRecorder) GetOperation(ctx, in any, opts ...any) *gomock.Call {
+// GetCategoryRules indicates an expected call of GetCategoryRules.
+func (mr *MockFinanceServiceClientMockRecorder) GetCategoryRules(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperation", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetOperation), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryRules", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetCategoryRules), varargs...)
 }
 
-// GetOperationsByAccount mocks base method.
-func (m *MockFinanceServiceClient) GetOperationsByAccount(ctx context.Context, in *proto.OperationsByAccountAndFiltersRequest, opts ...grpc.CallOption) (*proto.ListOperationsResponse, error) {
+// GetCounterparties mocks base method.
+func (m *MockFinanceServiceClient) GetCounterparties(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.ListCounterpartiesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetOperationsByAccount", varargs...)
-	ret0, _ := ret[0].(*proto.ListOperationsResponse)
+	ret := m.ctrl.Call(m, "GetCounterparties", varargs...)
+	ret0, _ := ret[0].(*proto.ListCounterpartiesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOperationsByAccount indicates an expected call of GetOperationsByAccount.
-func (mr *MockFinanceServiceClientMockRecorder) GetOperationsByAccount(ctx, in any, opts ...any) *gomock.Call {
+// GetCounterparties indicates an expected call of GetCounterparties.
+func (mr *MockFinanceServiceClientMockRecorder) GetCounterparties(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByAccount", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetOperationsByAccount), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterparties", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetCounterparties), varargs...)
 }
 
-// UpdateAccount mocks base method.
-func (m *MockFinanceServiceClient) UpdateAccount(ctx context.Context, in *proto.UpdateAccountRequest, opts ...grpc.CallOption) (*proto.Account, error) {
+// GetDebt mocks base method.
+func (m *MockFinanceServiceClient) GetDebt(ctx context.Context, in *proto.DebtRequest, opts ...grpc.CallOption) (*proto.Debt, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateAccount", varargs...)
-	ret0, _ := ret[0].(*proto.Account)
+	ret := m.ctrl.Call(m, "GetDebt", varargs...)
+	ret0, _ := ret[0].(*proto.Debt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateAccount indicates an expected call of UpdateAccount.
-func (mr *MockFinanceServiceClientMockRecorder) UpdateAccount(ctx, in any, opts ...any) *gomock.Call {
+// GetDebt indicates an expected call of GetDebt.
+func (mr *MockFinanceServiceClientMockRecorder) GetDebt(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockFinanceServiceClient)(nil).UpdateAccount), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebt", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetDebt), varargs...)
+}
+
+// GetDebtPayments mocks base method.
+func (m *MockFinanceServiceClient) GetDebtPayments(ctx context.Context, in *proto.DebtRequest, opts ...grpc.CallOption) (*proto.ListDebtPaymentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDebtPayments", varargs...)
+	ret0, _ := ret[0].(*proto.ListDebtPaymentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtPayments indicates an expected call of GetDebtPayments.
+func (mr *MockFinanceServiceClientMockRecorder) GetDebtPayments(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtPayments", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetDebtPayments), varargs...)
+}
+
+// GetDebts mocks base method.
+func (m *MockFinanceServiceClient) GetDebts(ctx context.Context, in *proto.ListDebtsRequest, opts ...grpc.CallOption) (*proto.ListDebtsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDebts", varargs...)
+	ret0, _ := ret[0].(*proto.ListDebtsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebts indicates an expected call of GetDebts.
+func (mr *MockFinanceServiceClientMockRecorder) GetDebts(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebts", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetDebts), varargs...)
+}
+
+// GetOperation mocks base method.
+func (m *MockFinanceServiceClient) GetOperation(ctx context.Context, in *proto.OperationRequest, opts ...grpc.CallOption) (*proto.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOperation", varargs...)
+	ret0, _ := ret[0].(*proto.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperation indicates an expected call of GetOperation.
+func (mr *MockFinanceServiceClientMockRecorder) GetOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperation", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetOperation), varargs...)
+}
+
+// GetOperationSplit mocks base method.
+func (m *MockFinanceServiceClient) GetOperationSplit(ctx context.Context, in *proto.OperationRequest, opts ...grpc.CallOption) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOperationSplit", varargs...)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationSplit indicates an expected call of GetOperationSplit.
+func (mr *MockFinanceServiceClientMockRecorder) GetOperationSplit(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationSplit", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetOperationSplit), varargs...)
+}
+
+// GetOperationsByAccount mocks base method.
+func (m *MockFinanceServiceClient) GetOperationsByAccount(ctx context.Context, in *proto.OperationsByAccountAndFiltersRequest, opts ...grpc.CallOption) (*proto.ListOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOperationsByAccount", varargs...)
+	ret0, _ := ret[0].(*proto.ListOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationsByAccount indicates an expected call of GetOperationsByAccount.
+func (mr *MockFinanceServiceClientMockRecorder) GetOperationsByAccount(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByAccount", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetOperationsByAccount), varargs...)
+}
+
+// GetOperationsByIDs mocks base method.
+func (m *MockFinanceServiceClient) GetOperationsByIDs(ctx context.Context, in *proto.OperationsByIDsRequest, opts ...grpc.CallOption) (*proto.OperationsByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOperationsByIDs", varargs...)
+	ret0, _ := ret[0].(*proto.OperationsByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationsByIDs indicates an expected call of GetOperationsByIDs.
+func (mr *MockFinanceServiceClientMockRecorder) GetOperationsByIDs(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByIDs", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetOperationsByIDs), varargs...)
+}
+
+// GetPendingInvitations mocks base method.
+func (m *MockFinanceServiceClient) GetPendingInvitations(ctx context.Context, in *proto.UserID, opts ...grpc.CallOption) (*proto.ListAccountInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPendingInvitations", varargs...)
+	ret0, _ := ret[0].(*proto.ListAccountInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingInvitations indicates an expected call of GetPendingInvitations.
+func (mr *MockFinanceServiceClientMockRecorder) GetPendingInvitations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingInvitations", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetPendingInvitations), varargs...)
+}
+
+// GetSettlements mocks base method.
+func (m *MockFinanceServiceClient) GetSettlements(ctx context.Context, in *proto.AccountRequest, opts ...grpc.CallOption) (*proto.ListSettlementsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSettlements", varargs...)
+	ret0, _ := ret[0].(*proto.ListSettlementsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlements indicates an expected call of GetSettlements.
+func (mr *MockFinanceServiceClientMockRecorder) GetSettlements(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlements", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetSettlements), varargs...)
+}
+
+// GetSpendingStats mocks base method.
+func (m *MockFinanceServiceClient) GetSpendingStats(ctx context.Context, in *proto.SpendingStatsRequest, opts ...grpc.CallOption) (*proto.SpendingStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSpendingStats", varargs...)
+	ret0, _ := ret[0].(*proto.SpendingStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingStats indicates an expected call of GetSpendingStats.
+func (mr *MockFinanceServiceClientMockRecorder) GetSpendingStats(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingStats", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetSpendingStats), varargs...)
+}
+
+// GetSpendingTotals mocks base method.
+func (m *MockFinanceServiceClient) GetSpendingTotals(ctx context.Context, in *proto.SpendingStatsRequest, opts ...grpc.CallOption) (*proto.SpendingTotals, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSpendingTotals", varargs...)
+	ret0, _ := ret[0].(*proto.SpendingTotals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingTotals indicates an expected call of GetSpendingTotals.
+func (mr *MockFinanceServiceClientMockRecorder) GetSpendingTotals(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingTotals", reflect.TypeOf((*MockFinanceServiceClient)(nil).GetSpendingTotals), varargs...)
+}
+
+// ImportUserData mocks base method.
+func (m *MockFinanceServiceClient) ImportUserData(ctx context.Context, in *proto.ImportUserDataRequest, opts ...grpc.CallOption) (*proto.ImportUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportUserData", varargs...)
+	ret0, _ := ret[0].(*proto.ImportUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUserData indicates an expected call of ImportUserData.
+func (mr *MockFinanceServiceClientMockRecorder) ImportUserData(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUserData", reflect.TypeOf((*MockFinanceServiceClient)(nil).ImportUserData), varargs...)
+}
+
+// LeaveAccount mocks base method.
+func (m *MockFinanceServiceClient) LeaveAccount(ctx context.Context, in *proto.AccountRequest, opts ...grpc.CallOption) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveAccount", varargs...)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveAccount indicates an expected call of LeaveAccount.
+func (mr *MockFinanceServiceClientMockRecorder) LeaveAccount(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveAccount", reflect.TypeOf((*MockFinanceServiceClient)(nil).LeaveAccount), varargs...)
+}
+
+// MergeCategories mocks base method.
+func (m *MockFinanceServiceClient) MergeCategories(ctx context.Context, in *proto.MergeCategoriesRequest, opts ...grpc.CallOption) (*proto.MergeCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeCategories", varargs...)
+	ret0, _ := ret[0].(*proto.MergeCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeCategories indicates an expected call of MergeCategories.
+func (mr *MockFinanceServiceClientMockRecorder) MergeCategories(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCategories", reflect.TypeOf((*MockFinanceServiceClient)(nil).MergeCategories), varargs...)
+}
+
+// ProvisionDefaultCategories mocks base method.
+func (m *MockFinanceServiceClient) ProvisionDefaultCategories(ctx context.Context, in *proto.ProvisionDefaultCategoriesRequest, opts ...grpc.CallOption) (*proto.ListCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProvisionDefaultCategories", varargs...)
+	ret0, _ := ret[0].(*proto.ListCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionDefaultCategories indicates an expected call of ProvisionDefaultCategories.
+func (mr *MockFinanceServiceClientMockRecorder) ProvisionDefaultCategories(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionDefaultCategories", reflect.TypeOf((*MockFinanceServiceClient)(nil).ProvisionDefaultCategories), varargs...)
+}
+
+// RemoveAccountMember mocks base method.
+func (m *MockFinanceServiceClient) RemoveAccountMember(ctx context.Context, in *proto.AccountMemberRequest, opts ...grpc.CallOption) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveAccountMember", varargs...)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAccountMember indicates an expected call of RemoveAccountMember.
+func (mr *MockFinanceServiceClientMockRecorder) RemoveAccountMember(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccountMember", reflect.TypeOf((*MockFinanceServiceClient)(nil).RemoveAccountMember), varargs...)
+}
+
+// ReorderCategoryRules mocks base method.
+func (m *MockFinanceServiceClient) ReorderCategoryRules(ctx context.Context, in *proto.ReorderCategoryRulesRequest, opts ...grpc.CallOption) (*proto.ListCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReorderCategoryRules", varargs...)
+	ret0, _ := ret[0].(*proto.ListCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderCategoryRules indicates an expected call of ReorderCategoryRules.
+func (mr *MockFinanceServiceClientMockRecorder) ReorderCategoryRules(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategoryRules", reflect.TypeOf((*MockFinanceServiceClient)(nil).ReorderCategoryRules), varargs...)
+}
+
+// RevokeAccountInvitation mocks base method.
+func (m *MockFinanceServiceClient) RevokeAccountInvitation(ctx context.Context, in *proto.AccountInvitationRequest, opts ...grpc.CallOption) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAccountInvitation", varargs...)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccountInvitation indicates an expected call of RevokeAccountInvitation.
+func (mr *MockFinanceServiceClientMockRecorder) RevokeAccountInvitation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountInvitation", reflect.TypeOf((*MockFinanceServiceClient)(nil).RevokeAccountInvitation), varargs...)
+}
+
+// SplitOperation mocks base method.
+func (m *MockFinanceServiceClient) SplitOperation(ctx context.Context, in *proto.SplitOperationRequest, opts ...grpc.CallOption) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SplitOperation", varargs...)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitOperation indicates an expected call of SplitOperation.
+func (mr *MockFinanceServiceClientMockRecorder) SplitOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitOperation", reflect.TypeOf((*MockFinanceServiceClient)(nil).SplitOperation), varargs...)
+}
+
+// SuggestCategory mocks base method.
+func (m *MockFinanceServiceClient) SuggestCategory(ctx context.Context, in *proto.SuggestCategoryRequest, opts ...grpc.CallOption) (*proto.SuggestCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuggestCategory", varargs...)
+	ret0, _ := ret[0].(*proto.SuggestCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestCategory indicates an expected call of SuggestCategory.
+func (mr *MockFinanceServiceClientMockRecorder) SuggestCategory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestCategory", reflect.TypeOf((*MockFinanceServiceClient)(nil).SuggestCategory), varargs...)
+}
+
+// TestCategoryRules mocks base method.
+func (m *MockFinanceServiceClient) TestCategoryRules(ctx context.Context, in *proto.TestCategoryRulesRequest, opts ...grpc.CallOption) (*proto.TestCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TestCategoryRules", varargs...)
+	ret0, _ := ret[0].(*proto.TestCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestCategoryRules indicates an expected call of TestCategoryRules.
+func (mr *MockFinanceServiceClientMockRecorder) TestCategoryRules(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestCategoryRules", reflect.TypeOf((*MockFinanceServiceClient)(nil).TestCategoryRules), varargs...)
+}
+
+// TransferAccountOwnership mocks base method.
+func (m *MockFinanceServiceClient) TransferAccountOwnership(ctx context.Context, in *proto.AccountMemberRequest, opts ...grpc.CallOption) (*proto.ListAccountMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferAccountOwnership", varargs...)
+	ret0, _ := ret[0].(*proto.ListAccountMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferAccountOwnership indicates an expected call of TransferAccountOwnership.
+func (mr *MockFinanceServiceClientMockRecorder) TransferAccountOwnership(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAccountOwnership", reflect.TypeOf((*MockFinanceServiceClient)(nil).TransferAccountOwnership), varargs...)
+}
+
+// UpdateAccount mocks base method.
+func (m *MockFinanceServiceClient) UpdateAccount(ctx context.Context, in *proto.UpdateAccountRequest, opts ...grpc.CallOption) (*proto.Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAccount", varargs...)
+	ret0, _ := ret[0].(*proto.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccount indicates an expected call of UpdateAccount.
+func (mr *MockFinanceServiceClientMockRecorder) UpdateAccount(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockFinanceServiceClient)(nil).UpdateAccount), varargs...)
+}
+
+// UpdateAccountMemberRole mocks base method.
+func (m *MockFinanceServiceClient) UpdateAccountMemberRole(ctx context.Context, in *proto.AccountMemberRequest, opts ...grpc.CallOption) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAccountMemberRole", varargs...)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountMemberRole indicates an expected call of UpdateAccountMemberRole.
+func (mr *MockFinanceServiceClientMockRecorder) UpdateAccountMemberRole(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountMemberRole", reflect.TypeOf((*MockFinanceServiceClient)(nil).UpdateAccountMemberRole), varargs...)
 }
 
 // UpdateCategory mocks base method.
@@ -402,6 +1222,26 @@ func (mr *MockFinanceServiceClientMockRecorder) UpdateCategory(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockFinanceServiceClient)(nil).UpdateCategory), varargs...)
 }
 
+// UpdateCategoryRule mocks base method.
+func (m *MockFinanceServiceClient) UpdateCategoryRule(ctx context.Context, in *proto.UpdateCategoryRuleRequest, opts ...grpc.CallOption) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCategoryRule", varargs...)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategoryRule indicates an expected call of UpdateCategoryRule.
+func (mr *MockFinanceServiceClientMockRecorder) UpdateCategoryRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryRule", reflect.TypeOf((*MockFinanceServiceClient)(nil).UpdateCategoryRule), varargs...)
+}
+
 // UpdateOperation mocks base method.
 func (m *MockFinanceServiceClient) UpdateOperation(ctx context.Context, in *proto.UpdateOperationRequest, opts ...grpc.CallOption) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
@@ -42,19 +42,49 @@ func (m *MockFinanceRepository) EXPECT() *MockFinanceRepositoryMockRecorder {
 	return m.recorder
 }
 
-// AddUserToAccount mocks base method.
-func (m *MockFinanceRepository) AddUserToAccount(ctx context.Context, userLogin string, accountID int) (models.SharingAccount, error) {
+// AcceptAccountInvitation mocks base method.
+func (m *MockFinanceRepository) AcceptAccountInvitation(ctx context.Context, req models.InvitationRequest) (models.SharingAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUserToAccount", ctx, userLogin, accountID)
+	ret := m.ctrl.Call(m, "AcceptAccountInvitation", ctx, req)
 	ret0, _ := ret[0].(models.SharingAccount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddUserToAccount indicates an expected call of AddUserToAccount.
-func (mr *MockFinanceRepositoryMockRecorder) AddUserToAccount(ctx, userLogin, accountID any) *gomock.Call {
+// AcceptAccountInvitation indicates an expected call of AcceptAccountInvitation.
+func (mr *MockFinanceRepositoryMockRecorder) AcceptAccountInvitation(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToAccount", reflect.TypeOf((*MockFinanceRepository)(nil).AddUserToAccount), ctx, userLogin, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptAccountInvitation", reflect.TypeOf((*MockFinanceRepository)(nil).AcceptAccountInvitation), ctx, req)
+}
+
+// AcceptInvitationByToken mocks base method.
+func (m *MockFinanceRepository) AcceptInvitationByToken(ctx context.Context, userID int, tokenHash string) (models.SharingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitationByToken", ctx, userID, tokenHash)
+	ret0, _ := ret[0].(models.SharingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitationByToken indicates an expected call of AcceptInvitationByToken.
+func (mr *MockFinanceRepositoryMockRecorder) AcceptInvitationByToken(ctx, userID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitationByToken", reflect.TypeOf((*MockFinanceRepository)(nil).AcceptInvitationByToken), ctx, userID, tokenHash)
+}
+
+// AddDebtPayment mocks base method.
+func (m *MockFinanceRepository) AddDebtPayment(ctx context.Context, payment models.DebtPayment) (models.DebtPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDebtPayment", ctx, payment)
+	ret0, _ := ret[0].(models.DebtPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDebtPayment indicates an expected call of AddDebtPayment.
+func (mr *MockFinanceRepositoryMockRecorder) AddDebtPayment(ctx, payment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDebtPayment", reflect.TypeOf((*MockFinanceRepository)(nil).AddDebtPayment), ctx, payment)
 }
 
 // CreateAccount mocks base method.
@@ -72,6 +102,21 @@ func (mr *MockFinanceRepositoryMockRecorder) CreateAccount(ctx, account, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockFinanceRepository)(nil).CreateAccount), ctx, account, userID)
 }
 
+// CreateAccountInvitation mocks base method.
+func (m *MockFinanceRepository) CreateAccountInvitation(ctx context.Context, inv models.AccountInvitation) (models.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountInvitation", ctx, inv)
+	ret0, _ := ret[0].(models.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountInvitation indicates an expected call of CreateAccountInvitation.
+func (mr *MockFinanceRepositoryMockRecorder) CreateAccountInvitation(ctx, inv any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountInvitation", reflect.TypeOf((*MockFinanceRepository)(nil).CreateAccountInvitation), ctx, inv)
+}
+
 // CreateCategory mocks base method.
 func (m *MockFinanceRepository) CreateCategory(ctx context.Context, category models.Category) (models.Category, error) {
 	m.ctrl.T.Helper()
@@ -87,19 +132,79 @@ func (mr *MockFinanceRepositoryMockRecorder) CreateCategory(ctx, category any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockFinanceRepository)(nil).CreateCategory), ctx, category)
 }
 
+// CreateCategoryRule mocks base method.
+func (m *MockFinanceRepository) CreateCategoryRule(ctx context.Context, rule models.CategoryRule) (models.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryRule", ctx, rule)
+	ret0, _ := ret[0].(models.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockFinanceRepositoryMockRecorder) CreateCategoryRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockFinanceRepository)(nil).CreateCategoryRule), ctx, rule)
+}
+
+// CreateDebt mocks base method.
+func (m *MockFinanceRepository) CreateDebt(ctx context.Context, debt models.Debt, operationID int) (models.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDebt", ctx, debt, operationID)
+	ret0, _ := ret[0].(models.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDebt indicates an expected call of CreateDebt.
+func (mr *MockFinanceRepositoryMockRecorder) CreateDebt(ctx, debt, operationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDebt", reflect.TypeOf((*MockFinanceRepository)(nil).CreateDebt), ctx, debt, operationID)
+}
+
 // CreateOperation mocks base method.
-func (m *MockFinanceRepository) CreateOperation(ctx context.Context, op models.Operation) (models.Operation, error) {
+func (m *MockFinanceRepository) CreateOperation(ctx context.Context, userID int, op models.Operation) (models.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOperation", ctx, op)
+	ret := m.ctrl.Call(m, "CreateOperation", ctx, userID, op)
 	ret0, _ := ret[0].(models.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateOperation indicates an expected call of CreateOperation.
-func (mr *MockFinanceRepositoryMockRecorder) CreateOperation(ctx, op any) *gomock.Call {
+func (mr *MockFinanceRepositoryMockRecorder) CreateOperation(ctx, userID, op any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperation", reflect.TypeOf((*MockFinanceRepository)(nil).CreateOperation), ctx, userID, op)
+}
+
+// CreateSettlement mocks base method.
+func (m *MockFinanceRepository) CreateSettlement(ctx context.Context, st models.Settlement) (models.Settlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSettlement", ctx, st)
+	ret0, _ := ret[0].(models.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSettlement indicates an expected call of CreateSettlement.
+func (mr *MockFinanceRepositoryMockRecorder) CreateSettlement(ctx, st any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSettlement", reflect.TypeOf((*MockFinanceRepository)(nil).CreateSettlement), ctx, st)
+}
+
+// DeclineAccountInvitation mocks base method.
+func (m *MockFinanceRepository) DeclineAccountInvitation(ctx context.Context, req models.InvitationRequest) (models.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineAccountInvitation", ctx, req)
+	ret0, _ := ret[0].(models.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineAccountInvitation indicates an expected call of DeclineAccountInvitation.
+func (mr *MockFinanceRepositoryMockRecorder) DeclineAccountInvitation(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperation", reflect.TypeOf((*MockFinanceRepository)(nil).CreateOperation), ctx, op)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineAccountInvitation", reflect.TypeOf((*MockFinanceRepository)(nil).DeclineAccountInvitation), ctx, req)
 }
 
 // DeleteAccount mocks base method.
@@ -131,19 +236,93 @@ func (mr *MockFinanceRepositoryMockRecorder) DeleteCategory(ctx, userID, categor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockFinanceRepository)(nil).DeleteCategory), ctx, userID, categoryID)
 }
 
+// DeleteCategoryRule mocks base method.
+func (m *MockFinanceRepository) DeleteCategoryRule(ctx context.Context, userID, ruleID int) (models.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", ctx, userID, ruleID)
+	ret0, _ := ret[0].(models.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockFinanceRepositoryMockRecorder) DeleteCategoryRule(ctx, userID, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockFinanceRepository)(nil).DeleteCategoryRule), ctx, userID, ruleID)
+}
+
+// DeleteDebt mocks base method.
+func (m *MockFinanceRepository) DeleteDebt(ctx context.Context, userID, debtID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDebt", ctx, userID, debtID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDebt indicates an expected call of DeleteDebt.
+func (mr *MockFinanceRepositoryMockRecorder) DeleteDebt(ctx, userID, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDebt", reflect.TypeOf((*MockFinanceRepository)(nil).DeleteDebt), ctx, userID, debtID)
+}
+
 // DeleteOperation mocks base method.
-func (m *MockFinanceRepository) DeleteOperation(ctx context.Context, accID, opID int) (models.Operation, error) {
+func (m *MockFinanceRepository) DeleteOperation(ctx context.Context, userID, accID, opID int) (models.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOperation", ctx, accID, opID)
+	ret := m.ctrl.Call(m, "DeleteOperation", ctx, userID, accID, opID)
 	ret0, _ := ret[0].(models.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteOperation indicates an expected call of DeleteOperation.
-func (mr *MockFinanceRepositoryMockRecorder) DeleteOperation(ctx, accID, opID any) *gomock.Call {
+func (mr *MockFinanceRepositoryMockRecorder) DeleteOperation(ctx, userID, accID, opID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperation", reflect.TypeOf((*MockFinanceRepository)(nil).DeleteOperation), ctx, userID, accID, opID)
+}
+
+// DeleteOperationSplit mocks base method.
+func (m *MockFinanceRepository) DeleteOperationSplit(ctx context.Context, userID, accID, opID int) (models.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOperationSplit", ctx, userID, accID, opID)
+	ret0, _ := ret[0].(models.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOperationSplit indicates an expected call of DeleteOperationSplit.
+func (mr *MockFinanceRepositoryMockRecorder) DeleteOperationSplit(ctx, userID, accID, opID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperation", reflect.TypeOf((*MockFinanceRepository)(nil).DeleteOperation), ctx, accID, opID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperationSplit", reflect.TypeOf((*MockFinanceRepository)(nil).DeleteOperationSplit), ctx, userID, accID, opID)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockFinanceRepository) DeleteUserData(ctx context.Context, userID int) (models.UserDataDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, userID)
+	ret0, _ := ret[0].(models.UserDataDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockFinanceRepositoryMockRecorder) DeleteUserData(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockFinanceRepository)(nil).DeleteUserData), ctx, userID)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockFinanceRepository) FilterUsedImages(ctx context.Context, ids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterUsedImages", ctx, ids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockFinanceRepositoryMockRecorder) FilterUsedImages(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockFinanceRepository)(nil).FilterUsedImages), ctx, ids)
 }
 
 // GetAccountByID mocks base method.
@@ -161,6 +340,66 @@ func (mr *MockFinanceRepositoryMockRecorder) GetAccountByID(ctx, userID, account
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByID", reflect.TypeOf((*MockFinanceRepository)(nil).GetAccountByID), ctx, userID, accountID)
 }
 
+// GetAccountInvitations mocks base method.
+func (m *MockFinanceRepository) GetAccountInvitations(ctx context.Context, userID, accountID int) ([]models.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountInvitations", ctx, userID, accountID)
+	ret0, _ := ret[0].([]models.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInvitations indicates an expected call of GetAccountInvitations.
+func (mr *MockFinanceRepositoryMockRecorder) GetAccountInvitations(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInvitations", reflect.TypeOf((*MockFinanceRepository)(nil).GetAccountInvitations), ctx, userID, accountID)
+}
+
+// GetAccountMembers mocks base method.
+func (m *MockFinanceRepository) GetAccountMembers(ctx context.Context, userID, accountID int) ([]models.SharingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountMembers", ctx, userID, accountID)
+	ret0, _ := ret[0].([]models.SharingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountMembers indicates an expected call of GetAccountMembers.
+func (mr *MockFinanceRepositoryMockRecorder) GetAccountMembers(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountMembers", reflect.TypeOf((*MockFinanceRepository)(nil).GetAccountMembers), ctx, userID, accountID)
+}
+
+// GetAccountSplits mocks base method.
+func (m *MockFinanceRepository) GetAccountSplits(ctx context.Context, userID, accountID int) ([]models.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountSplits", ctx, userID, accountID)
+	ret0, _ := ret[0].([]models.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountSplits indicates an expected call of GetAccountSplits.
+func (mr *MockFinanceRepositoryMockRecorder) GetAccountSplits(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSplits", reflect.TypeOf((*MockFinanceRepository)(nil).GetAccountSplits), ctx, userID, accountID)
+}
+
+// GetAccountUserIDs mocks base method.
+func (m *MockFinanceRepository) GetAccountUserIDs(ctx context.Context, accountID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountUserIDs", ctx, accountID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountUserIDs indicates an expected call of GetAccountUserIDs.
+func (mr *MockFinanceRepositoryMockRecorder) GetAccountUserIDs(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountUserIDs", reflect.TypeOf((*MockFinanceRepository)(nil).GetAccountUserIDs), ctx, accountID)
+}
+
 // GetAccountsByUser mocks base method.
 func (m *MockFinanceRepository) GetAccountsByUser(ctx context.Context, userID int) ([]models.Account, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +460,21 @@ func (mr *MockFinanceRepositoryMockRecorder) GetCategoriesWithStatsByUser(ctx, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesWithStatsByUser", reflect.TypeOf((*MockFinanceRepository)(nil).GetCategoriesWithStatsByUser), ctx, userID)
 }
 
+// GetCategorizedOperations mocks base method.
+func (m *MockFinanceRepository) GetCategorizedOperations(ctx context.Context, userID, limit int) ([]models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategorizedOperations", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategorizedOperations indicates an expected call of GetCategorizedOperations.
+func (mr *MockFinanceRepositoryMockRecorder) GetCategorizedOperations(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategorizedOperations", reflect.TypeOf((*MockFinanceRepository)(nil).GetCategorizedOperations), ctx, userID, limit)
+}
+
 // GetCategoryByID mocks base method.
 func (m *MockFinanceRepository) GetCategoryByID(ctx context.Context, userID, categoryID int) (models.Category, error) {
 	m.ctrl.T.Helper()
@@ -251,6 +505,21 @@ func (mr *MockFinanceRepositoryMockRecorder) GetCategoryByName(ctx, userID, cate
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByName", reflect.TypeOf((*MockFinanceRepository)(nil).GetCategoryByName), ctx, userID, categoryName)
 }
 
+// GetCategoryRulesByUser mocks base method.
+func (m *MockFinanceRepository) GetCategoryRulesByUser(ctx context.Context, userID int) ([]models.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryRulesByUser", ctx, userID)
+	ret0, _ := ret[0].([]models.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryRulesByUser indicates an expected call of GetCategoryRulesByUser.
+func (mr *MockFinanceRepositoryMockRecorder) GetCategoryRulesByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryRulesByUser", reflect.TypeOf((*MockFinanceRepository)(nil).GetCategoryRulesByUser), ctx, userID)
+}
+
 // GetCategoryStats mocks base method.
 func (m *MockFinanceRepository) GetCategoryStats(ctx context.Context, userID, categoryID int) (int, error) {
 	m.ctrl.T.Helper()
@@ -266,34 +535,452 @@ func (mr *MockFinanceRepositoryMockRecorder) GetCategoryStats(ctx, userID, categ
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryStats", reflect.TypeOf((*MockFinanceRepository)(nil).GetCategoryStats), ctx, userID, categoryID)
 }
 
+// GetCounterparties mocks base method.
+func (m *MockFinanceRepository) GetCounterparties(ctx context.Context, userID int) ([]models.Receiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCounterparties", ctx, userID)
+	ret0, _ := ret[0].([]models.Receiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCounterparties indicates an expected call of GetCounterparties.
+func (mr *MockFinanceRepositoryMockRecorder) GetCounterparties(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterparties", reflect.TypeOf((*MockFinanceRepository)(nil).GetCounterparties), ctx, userID)
+}
+
+// GetDailySpending mocks base method.
+func (m *MockFinanceRepository) GetDailySpending(ctx context.Context, req models.SpendingStatsRequest) ([]models.DailySpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailySpending", ctx, req)
+	ret0, _ := ret[0].([]models.DailySpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailySpending indicates an expected call of GetDailySpending.
+func (mr *MockFinanceRepositoryMockRecorder) GetDailySpending(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailySpending", reflect.TypeOf((*MockFinanceRepository)(nil).GetDailySpending), ctx, req)
+}
+
+// GetDebt mocks base method.
+func (m *MockFinanceRepository) GetDebt(ctx context.Context, userID, debtID int) (models.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebt", ctx, userID, debtID)
+	ret0, _ := ret[0].(models.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebt indicates an expected call of GetDebt.
+func (mr *MockFinanceRepositoryMockRecorder) GetDebt(ctx, userID, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebt", reflect.TypeOf((*MockFinanceRepository)(nil).GetDebt), ctx, userID, debtID)
+}
+
+// GetDebtPayments mocks base method.
+func (m *MockFinanceRepository) GetDebtPayments(ctx context.Context, debtID int) ([]models.DebtPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebtPayments", ctx, debtID)
+	ret0, _ := ret[0].([]models.DebtPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtPayments indicates an expected call of GetDebtPayments.
+func (mr *MockFinanceRepositoryMockRecorder) GetDebtPayments(ctx, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtPayments", reflect.TypeOf((*MockFinanceRepository)(nil).GetDebtPayments), ctx, debtID)
+}
+
+// GetDebtsByUser mocks base method.
+func (m *MockFinanceRepository) GetDebtsByUser(ctx context.Context, userID int) ([]models.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebtsByUser", ctx, userID)
+	ret0, _ := ret[0].([]models.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtsByUser indicates an expected call of GetDebtsByUser.
+func (mr *MockFinanceRepositoryMockRecorder) GetDebtsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByUser", reflect.TypeOf((*MockFinanceRepository)(nil).GetDebtsByUser), ctx, userID)
+}
+
 // GetOperationByID mocks base method.
-func (m *MockFinanceRepository) GetOperationByID(ctx context.Context, accID, opID int) (models.Operation, error) {
+func (m *MockFinanceRepository) GetOperationByID(ctx context.Context, userID, accID, opID int) (models.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOperationByID", ctx, accID, opID)
+	ret := m.ctrl.Call(m, "GetOperationByID", ctx, userID, accID, opID)
 	ret0, _ := ret[0].(models.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOperationByID indicates an expected call of GetOperationByID.
-func (mr *MockFinanceRepositoryMockRecorder) GetOperationByID(ctx, accID, opID any) *gomock.Call {
+func (mr *MockFinanceRepositoryMockRecorder) GetOperationByID(ctx, userID, accID, opID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationByID", reflect.TypeOf((*MockFinanceRepository)(nil).GetOperationByID), ctx, accID, opID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationByID", reflect.TypeOf((*MockFinanceRepository)(nil).GetOperationByID), ctx, userID, accID, opID)
+}
+
+// GetOperationSplit mocks base method.
+func (m *MockFinanceRepository) GetOperationSplit(ctx context.Context, userID, accID, opID int) (models.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationSplit", ctx, userID, accID, opID)
+	ret0, _ := ret[0].(models.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationSplit indicates an expected call of GetOperationSplit.
+func (mr *MockFinanceRepositoryMockRecorder) GetOperationSplit(ctx, userID, accID, opID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationSplit", reflect.TypeOf((*MockFinanceRepository)(nil).GetOperationSplit), ctx, userID, accID, opID)
 }
 
 // GetOperationsByAccount mocks base method.
-func (m *MockFinanceRepository) GetOperationsByAccount(ctx context.Context, accountID int) ([]models.OperationInList, error) {
+func (m *MockFinanceRepository) GetOperationsByAccount(ctx context.Context, userID, accountID int) ([]models.OperationInList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOperationsByAccount", ctx, accountID)
+	ret := m.ctrl.Call(m, "GetOperationsByAccount", ctx, userID, accountID)
 	ret0, _ := ret[0].([]models.OperationInList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOperationsByAccount indicates an expected call of GetOperationsByAccount.
-func (mr *MockFinanceRepositoryMockRecorder) GetOperationsByAccount(ctx, accountID any) *gomock.Call {
+func (mr *MockFinanceRepositoryMockRecorder) GetOperationsByAccount(ctx, userID, accountID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByAccount", reflect.TypeOf((*MockFinanceRepository)(nil).GetOperationsByAccount), ctx, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByAccount", reflect.TypeOf((*MockFinanceRepository)(nil).GetOperationsByAccount), ctx, userID, accountID)
+}
+
+// GetOperationsByIDs mocks base method.
+func (m *MockFinanceRepository) GetOperationsByIDs(ctx context.Context, userID int, opIDs []int) ([]models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationsByIDs", ctx, userID, opIDs)
+	ret0, _ := ret[0].([]models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationsByIDs indicates an expected call of GetOperationsByIDs.
+func (mr *MockFinanceRepositoryMockRecorder) GetOperationsByIDs(ctx, userID, opIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByIDs", reflect.TypeOf((*MockFinanceRepository)(nil).GetOperationsByIDs), ctx, userID, opIDs)
+}
+
+// GetOperationsByUser mocks base method.
+func (m *MockFinanceRepository) GetOperationsByUser(ctx context.Context, userID int) ([]models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationsByUser", ctx, userID)
+	ret0, _ := ret[0].([]models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationsByUser indicates an expected call of GetOperationsByUser.
+func (mr *MockFinanceRepositoryMockRecorder) GetOperationsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByUser", reflect.TypeOf((*MockFinanceRepository)(nil).GetOperationsByUser), ctx, userID)
+}
+
+// GetOperationsForRules mocks base method.
+func (m *MockFinanceRepository) GetOperationsForRules(ctx context.Context, userID int, onlyUncategorized bool) ([]models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationsForRules", ctx, userID, onlyUncategorized)
+	ret0, _ := ret[0].([]models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationsForRules indicates an expected call of GetOperationsForRules.
+func (mr *MockFinanceRepositoryMockRecorder) GetOperationsForRules(ctx, userID, onlyUncategorized any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsForRules", reflect.TypeOf((*MockFinanceRepository)(nil).GetOperationsForRules), ctx, userID, onlyUncategorized)
+}
+
+// GetPendingInvitations mocks base method.
+func (m *MockFinanceRepository) GetPendingInvitations(ctx context.Context, userID int) ([]models.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingInvitations", ctx, userID)
+	ret0, _ := ret[0].([]models.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingInvitations indicates an expected call of GetPendingInvitations.
+func (mr *MockFinanceRepositoryMockRecorder) GetPendingInvitations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingInvitations", reflect.TypeOf((*MockFinanceRepository)(nil).GetPendingInvitations), ctx, userID)
+}
+
+// GetReceiver mocks base method.
+func (m *MockFinanceRepository) GetReceiver(ctx context.Context, userID, receiverID int) (models.Receiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceiver", ctx, userID, receiverID)
+	ret0, _ := ret[0].(models.Receiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiver indicates an expected call of GetReceiver.
+func (mr *MockFinanceRepositoryMockRecorder) GetReceiver(ctx, userID, receiverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiver", reflect.TypeOf((*MockFinanceRepository)(nil).GetReceiver), ctx, userID, receiverID)
+}
+
+// GetReceiversByUser mocks base method.
+func (m *MockFinanceRepository) GetReceiversByUser(ctx context.Context, userID int) ([]models.Receiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceiversByUser", ctx, userID)
+	ret0, _ := ret[0].([]models.Receiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiversByUser indicates an expected call of GetReceiversByUser.
+func (mr *MockFinanceRepositoryMockRecorder) GetReceiversByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiversByUser", reflect.TypeOf((*MockFinanceRepository)(nil).GetReceiversByUser), ctx, userID)
+}
+
+// GetRecurringExpenses mocks base method.
+func (m *MockFinanceRepository) GetRecurringExpenses(ctx context.Context, req models.SpendingStatsRequest, minMonths int) ([]models.RecurringExpense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringExpenses", ctx, req, minMonths)
+	ret0, _ := ret[0].([]models.RecurringExpense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurringExpenses indicates an expected call of GetRecurringExpenses.
+func (mr *MockFinanceRepositoryMockRecorder) GetRecurringExpenses(ctx, req, minMonths any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringExpenses", reflect.TypeOf((*MockFinanceRepository)(nil).GetRecurringExpenses), ctx, req, minMonths)
+}
+
+// GetSettlements mocks base method.
+func (m *MockFinanceRepository) GetSettlements(ctx context.Context, userID, accountID int) ([]models.Settlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlements", ctx, userID, accountID)
+	ret0, _ := ret[0].([]models.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlements indicates an expected call of GetSettlements.
+func (mr *MockFinanceRepositoryMockRecorder) GetSettlements(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlements", reflect.TypeOf((*MockFinanceRepository)(nil).GetSettlements), ctx, userID, accountID)
+}
+
+// GetSharingsByOwner mocks base method.
+func (m *MockFinanceRepository) GetSharingsByOwner(ctx context.Context, userID int) ([]models.SharingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharingsByOwner", ctx, userID)
+	ret0, _ := ret[0].([]models.SharingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharingsByOwner indicates an expected call of GetSharingsByOwner.
+func (mr *MockFinanceRepositoryMockRecorder) GetSharingsByOwner(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharingsByOwner", reflect.TypeOf((*MockFinanceRepository)(nil).GetSharingsByOwner), ctx, userID)
+}
+
+// GetSoleAccountIDs mocks base method.
+func (m *MockFinanceRepository) GetSoleAccountIDs(ctx context.Context, userID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSoleAccountIDs", ctx, userID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSoleAccountIDs indicates an expected call of GetSoleAccountIDs.
+func (mr *MockFinanceRepositoryMockRecorder) GetSoleAccountIDs(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSoleAccountIDs", reflect.TypeOf((*MockFinanceRepository)(nil).GetSoleAccountIDs), ctx, userID)
+}
+
+// GetSpendingTotals mocks base method.
+func (m *MockFinanceRepository) GetSpendingTotals(ctx context.Context, req models.SpendingStatsRequest) (models.SpendingTotals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingTotals", ctx, req)
+	ret0, _ := ret[0].(models.SpendingTotals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingTotals indicates an expected call of GetSpendingTotals.
+func (mr *MockFinanceRepositoryMockRecorder) GetSpendingTotals(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingTotals", reflect.TypeOf((*MockFinanceRepository)(nil).GetSpendingTotals), ctx, req)
+}
+
+// GetUserIDByLogin mocks base method.
+func (m *MockFinanceRepository) GetUserIDByLogin(ctx context.Context, login string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByLogin", ctx, login)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByLogin indicates an expected call of GetUserIDByLogin.
+func (mr *MockFinanceRepositoryMockRecorder) GetUserIDByLogin(ctx, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByLogin", reflect.TypeOf((*MockFinanceRepository)(nil).GetUserIDByLogin), ctx, login)
+}
+
+// ImportUserData mocks base method.
+func (m *MockFinanceRepository) ImportUserData(ctx context.Context, req models.ImportUserDataRequest) (models.ImportUserDataResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUserData", ctx, req)
+	ret0, _ := ret[0].(models.ImportUserDataResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUserData indicates an expected call of ImportUserData.
+func (mr *MockFinanceRepositoryMockRecorder) ImportUserData(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUserData", reflect.TypeOf((*MockFinanceRepository)(nil).ImportUserData), ctx, req)
+}
+
+// LeaveAccount mocks base method.
+func (m *MockFinanceRepository) LeaveAccount(ctx context.Context, userID, accountID int) (models.SharingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveAccount", ctx, userID, accountID)
+	ret0, _ := ret[0].(models.SharingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveAccount indicates an expected call of LeaveAccount.
+func (mr *MockFinanceRepositoryMockRecorder) LeaveAccount(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveAccount", reflect.TypeOf((*MockFinanceRepository)(nil).LeaveAccount), ctx, userID, accountID)
+}
+
+// MergeCategories mocks base method.
+func (m *MockFinanceRepository) MergeCategories(ctx context.Context, req models.MergeCategoriesRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCategories", ctx, req)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeCategories indicates an expected call of MergeCategories.
+func (mr *MockFinanceRepositoryMockRecorder) MergeCategories(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCategories", reflect.TypeOf((*MockFinanceRepository)(nil).MergeCategories), ctx, req)
+}
+
+// ProvisionCategories mocks base method.
+func (m *MockFinanceRepository) ProvisionCategories(ctx context.Context, userID int, categories []models.Category, overwrite bool) ([]models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionCategories", ctx, userID, categories, overwrite)
+	ret0, _ := ret[0].([]models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionCategories indicates an expected call of ProvisionCategories.
+func (mr *MockFinanceRepositoryMockRecorder) ProvisionCategories(ctx, userID, categories, overwrite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionCategories", reflect.TypeOf((*MockFinanceRepository)(nil).ProvisionCategories), ctx, userID, categories, overwrite)
+}
+
+// RemoveAccountMember mocks base method.
+func (m *MockFinanceRepository) RemoveAccountMember(ctx context.Context, req models.AccountMemberRequest) (models.SharingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAccountMember", ctx, req)
+	ret0, _ := ret[0].(models.SharingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAccountMember indicates an expected call of RemoveAccountMember.
+func (mr *MockFinanceRepositoryMockRecorder) RemoveAccountMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccountMember", reflect.TypeOf((*MockFinanceRepository)(nil).RemoveAccountMember), ctx, req)
+}
+
+// ReorderCategoryRules mocks base method.
+func (m *MockFinanceRepository) ReorderCategoryRules(ctx context.Context, userID int, ruleIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCategoryRules", ctx, userID, ruleIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCategoryRules indicates an expected call of ReorderCategoryRules.
+func (mr *MockFinanceRepositoryMockRecorder) ReorderCategoryRules(ctx, userID, ruleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategoryRules", reflect.TypeOf((*MockFinanceRepository)(nil).ReorderCategoryRules), ctx, userID, ruleIDs)
+}
+
+// RevokeAccountInvitation mocks base method.
+func (m *MockFinanceRepository) RevokeAccountInvitation(ctx context.Context, req models.InvitationRequest) (models.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountInvitation", ctx, req)
+	ret0, _ := ret[0].(models.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccountInvitation indicates an expected call of RevokeAccountInvitation.
+func (mr *MockFinanceRepositoryMockRecorder) RevokeAccountInvitation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountInvitation", reflect.TypeOf((*MockFinanceRepository)(nil).RevokeAccountInvitation), ctx, req)
+}
+
+// SaveOperationSplit mocks base method.
+func (m *MockFinanceRepository) SaveOperationSplit(ctx context.Context, userID int, split models.OperationSplit) (models.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOperationSplit", ctx, userID, split)
+	ret0, _ := ret[0].(models.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveOperationSplit indicates an expected call of SaveOperationSplit.
+func (mr *MockFinanceRepositoryMockRecorder) SaveOperationSplit(ctx, userID, split any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOperationSplit", reflect.TypeOf((*MockFinanceRepository)(nil).SaveOperationSplit), ctx, userID, split)
+}
+
+// SetOperationsCategory mocks base method.
+func (m *MockFinanceRepository) SetOperationsCategory(ctx context.Context, ops []models.Operation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOperationsCategory", ctx, ops)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOperationsCategory indicates an expected call of SetOperationsCategory.
+func (mr *MockFinanceRepositoryMockRecorder) SetOperationsCategory(ctx, ops any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOperationsCategory", reflect.TypeOf((*MockFinanceRepository)(nil).SetOperationsCategory), ctx, ops)
+}
+
+// TransferAccountOwnership mocks base method.
+func (m *MockFinanceRepository) TransferAccountOwnership(ctx context.Context, req models.AccountMemberRequest) ([]models.SharingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferAccountOwnership", ctx, req)
+	ret0, _ := ret[0].([]models.SharingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferAccountOwnership indicates an expected call of TransferAccountOwnership.
+func (mr *MockFinanceRepositoryMockRecorder) TransferAccountOwnership(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAccountOwnership", reflect.TypeOf((*MockFinanceRepository)(nil).TransferAccountOwnership), ctx, req)
 }
 
 // UpdateAccount mocks base method.
@@ -325,6 +1012,21 @@ func (mr *MockFinanceRepositoryMockRecorder) UpdateAccountBalance(ctx, accountID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountBalance", reflect.TypeOf((*MockFinanceRepository)(nil).UpdateAccountBalance), ctx, accountID, newBalance)
 }
 
+// UpdateAccountMemberRole mocks base method.
+func (m *MockFinanceRepository) UpdateAccountMemberRole(ctx context.Context, req models.AccountMemberRequest) (models.SharingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountMemberRole", ctx, req)
+	ret0, _ := ret[0].(models.SharingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountMemberRole indicates an expected call of UpdateAccountMemberRole.
+func (mr *MockFinanceRepositoryMockRecorder) UpdateAccountMemberRole(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountMemberRole", reflect.TypeOf((*MockFinanceRepository)(nil).UpdateAccountMemberRole), ctx, req)
+}
+
 // UpdateCategory mocks base method.
 func (m *MockFinanceRepository) UpdateCategory(ctx context.Context, category models.Category) error {
 	m.ctrl.T.Helper()
@@ -339,6 +1041,21 @@ func (mr *MockFinanceRepositoryMockRecorder) UpdateCategory(ctx, category any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockFinanceRepository)(nil).UpdateCategory), ctx, category)
 }
 
+// UpdateCategoryRule mocks base method.
+func (m *MockFinanceRepository) UpdateCategoryRule(ctx context.Context, rule models.CategoryRule) (models.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategoryRule", ctx, rule)
+	ret0, _ := ret[0].(models.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategoryRule indicates an expected call of UpdateCategoryRule.
+func (mr *MockFinanceRepositoryMockRecorder) UpdateCategoryRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryRule", reflect.TypeOf((*MockFinanceRepository)(nil).UpdateCategoryRule), ctx, rule)
+}
+
 // UpdateOperation mocks base method.
 func (m *MockFinanceRepository) UpdateOperation(ctx context.Context, req models.UpdateOperationRequest, accID, opID int) (models.Operation, error) {
 	m.ctrl.T.Helper()
@@ -353,3 +1070,18 @@ func (mr *MockFinanceRepositoryMockRecorder) UpdateOperation(ctx, req, accID, op
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOperation", reflect.TypeOf((*MockFinanceRepository)(nil).UpdateOperation), ctx, req, accID, opID)
 }
+
+// UpsertReceiver mocks base method.
+func (m *MockFinanceRepository) UpsertReceiver(ctx context.Context, rcv models.Receiver) (models.Receiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReceiver", ctx, rcv)
+	ret0, _ := ret[0].(models.Receiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertReceiver indicates an expected call of UpsertReceiver.
+func (mr *MockFinanceRepositoryMockRecorder) UpsertReceiver(ctx, rcv any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReceiver", reflect.TypeOf((*MockFinanceRepository)(nil).UpsertReceiver), ctx, rcv)
+}
@@ -42,19 +42,64 @@ func (m *MockFinanceService) EXPECT() *MockFinanceServiceMockRecorder {
 	return m.recorder
 }
 
-// AddUserToAccount mocks base method.
-func (m *MockFinanceService) AddUserToAccount(ctx context.Context, userLogin string, accountID int) (*proto.SharingsResponse, error) {
+// AcceptAccountInvitation mocks base method.
+func (m *MockFinanceService) AcceptAccountInvitation(ctx context.Context, req models.InvitationRequest) (*proto.SharingsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUserToAccount", ctx, userLogin, accountID)
+	ret := m.ctrl.Call(m, "AcceptAccountInvitation", ctx, req)
 	ret0, _ := ret[0].(*proto.SharingsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddUserToAccount indicates an expected call of AddUserToAccount.
-func (mr *MockFinanceServiceMockRecorder) AddUserToAccount(ctx, userLogin, accountID any) *gomock.Call {
+// AcceptAccountInvitation indicates an expected call of AcceptAccountInvitation.
+func (mr *MockFinanceServiceMockRecorder) AcceptAccountInvitation(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToAccount", reflect.TypeOf((*MockFinanceService)(nil).AddUserToAccount), ctx, userLogin, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptAccountInvitation", reflect.TypeOf((*MockFinanceService)(nil).AcceptAccountInvitation), ctx, req)
+}
+
+// AcceptInvitationLink mocks base method.
+func (m *MockFinanceService) AcceptInvitationLink(ctx context.Context, userID int, token string) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitationLink", ctx, userID, token)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitationLink indicates an expected call of AcceptInvitationLink.
+func (mr *MockFinanceServiceMockRecorder) AcceptInvitationLink(ctx, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitationLink", reflect.TypeOf((*MockFinanceService)(nil).AcceptInvitationLink), ctx, userID, token)
+}
+
+// AddDebtRepayment mocks base method.
+func (m *MockFinanceService) AddDebtRepayment(ctx context.Context, req models.CreateDebtRepaymentRequest) (*proto.DebtPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDebtRepayment", ctx, req)
+	ret0, _ := ret[0].(*proto.DebtPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDebtRepayment indicates an expected call of AddDebtRepayment.
+func (mr *MockFinanceServiceMockRecorder) AddDebtRepayment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDebtRepayment", reflect.TypeOf((*MockFinanceService)(nil).AddDebtRepayment), ctx, req)
+}
+
+// ApplyCategoryRules mocks base method.
+func (m *MockFinanceService) ApplyCategoryRules(ctx context.Context, userID int, overwrite bool) (*proto.ApplyCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyCategoryRules", ctx, userID, overwrite)
+	ret0, _ := ret[0].(*proto.ApplyCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyCategoryRules indicates an expected call of ApplyCategoryRules.
+func (mr *MockFinanceServiceMockRecorder) ApplyCategoryRules(ctx, userID, overwrite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyCategoryRules", reflect.TypeOf((*MockFinanceService)(nil).ApplyCategoryRules), ctx, userID, overwrite)
 }
 
 // CreateAccount mocks base method.
@@ -72,6 +117,21 @@ func (mr *MockFinanceServiceMockRecorder) CreateAccount(ctx, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockFinanceService)(nil).CreateAccount), ctx, req)
 }
 
+// CreateAccountInvitation mocks base method.
+func (m *MockFinanceService) CreateAccountInvitation(ctx context.Context, req models.CreateInvitationRequest) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountInvitation", ctx, req)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountInvitation indicates an expected call of CreateAccountInvitation.
+func (mr *MockFinanceServiceMockRecorder) CreateAccountInvitation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountInvitation", reflect.TypeOf((*MockFinanceService)(nil).CreateAccountInvitation), ctx, req)
+}
+
 // CreateCategory mocks base method.
 func (m *MockFinanceService) CreateCategory(ctx context.Context, req models.CreateCategoryRequest) (*proto.Category, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +147,36 @@ func (mr *MockFinanceServiceMockRecorder) CreateCategory(ctx, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockFinanceService)(nil).CreateCategory), ctx, req)
 }
 
+// CreateCategoryRule mocks base method.
+func (m *MockFinanceService) CreateCategoryRule(ctx context.Context, req models.CreateCategoryRuleRequest) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryRule", ctx, req)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockFinanceServiceMockRecorder) CreateCategoryRule(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockFinanceService)(nil).CreateCategoryRule), ctx, req)
+}
+
+// CreateDebt mocks base method.
+func (m *MockFinanceService) CreateDebt(ctx context.Context, req models.CreateDebtRequest) (*proto.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDebt", ctx, req)
+	ret0, _ := ret[0].(*proto.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDebt indicates an expected call of CreateDebt.
+func (mr *MockFinanceServiceMockRecorder) CreateDebt(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDebt", reflect.TypeOf((*MockFinanceService)(nil).CreateDebt), ctx, req)
+}
+
 // CreateOperation mocks base method.
 func (m *MockFinanceService) CreateOperation(ctx context.Context, req models.CreateOperationRequest, accountID int) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +192,36 @@ func (mr *MockFinanceServiceMockRecorder) CreateOperation(ctx, req, accountID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperation", reflect.TypeOf((*MockFinanceService)(nil).CreateOperation), ctx, req, accountID)
 }
 
+// CreateSettlement mocks base method.
+func (m *MockFinanceService) CreateSettlement(ctx context.Context, req models.CreateSettlementRequest) (*proto.Settlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSettlement", ctx, req)
+	ret0, _ := ret[0].(*proto.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSettlement indicates an expected call of CreateSettlement.
+func (mr *MockFinanceServiceMockRecorder) CreateSettlement(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSettlement", reflect.TypeOf((*MockFinanceService)(nil).CreateSettlement), ctx, req)
+}
+
+// DeclineAccountInvitation mocks base method.
+func (m *MockFinanceService) DeclineAccountInvitation(ctx context.Context, req models.InvitationRequest) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineAccountInvitation", ctx, req)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineAccountInvitation indicates an expected call of DeclineAccountInvitation.
+func (mr *MockFinanceServiceMockRecorder) DeclineAccountInvitation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineAccountInvitation", reflect.TypeOf((*MockFinanceService)(nil).DeclineAccountInvitation), ctx, req)
+}
+
 // DeleteAccount mocks base method.
 func (m *MockFinanceService) DeleteAccount(ctx context.Context, userID, accountID int) (*proto.Account, error) {
 	m.ctrl.T.Helper()
@@ -118,32 +238,138 @@ func (mr *MockFinanceServiceMockRecorder) DeleteAccount(ctx, userID, accountID a
 }
 
 // DeleteCategory mocks base method.
-func (m *MockFinanceService) DeleteCategory(ctx context.Context, userID, categoryID int) error {
+func (m *MockFinanceService) DeleteCategory(ctx context.Context, userID, categoryID, reassignTo int) (*proto.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCategory", ctx, userID, categoryID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, userID, categoryID, reassignTo)
+	ret0, _ := ret[0].(*proto.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteCategory indicates an expected call of DeleteCategory.
-func (mr *MockFinanceServiceMockRecorder) DeleteCategory(ctx, userID, categoryID any) *gomock.Call {
+func (mr *MockFinanceServiceMockRecorder) DeleteCategory(ctx, userID, categoryID, reassignTo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockFinanceService)(nil).DeleteCategory), ctx, userID, categoryID, reassignTo)
+}
+
+// DeleteCategoryRule mocks base method.
+func (m *MockFinanceService) DeleteCategoryRule(ctx context.Context, userID, ruleID int) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", ctx, userID, ruleID)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockFinanceServiceMockRecorder) DeleteCategoryRule(ctx, userID, ruleID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockFinanceService)(nil).DeleteCategory), ctx, userID, categoryID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockFinanceService)(nil).DeleteCategoryRule), ctx, userID, ruleID)
+}
+
+// DeleteDebt mocks base method.
+func (m *MockFinanceService) DeleteDebt(ctx context.Context, userID, debtID int) (*proto.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDebt", ctx, userID, debtID)
+	ret0, _ := ret[0].(*proto.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDebt indicates an expected call of DeleteDebt.
+func (mr *MockFinanceServiceMockRecorder) DeleteDebt(ctx, userID, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDebt", reflect.TypeOf((*MockFinanceService)(nil).DeleteDebt), ctx, userID, debtID)
 }
 
 // DeleteOperation mocks base method.
-func (m *MockFinanceService) DeleteOperation(ctx context.Context, accID, opID int) (*proto.Operation, error) {
+func (m *MockFinanceService) DeleteOperation(ctx context.Context, userID, accID, opID int) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOperation", ctx, accID, opID)
+	ret := m.ctrl.Call(m, "DeleteOperation", ctx, userID, accID, opID)
 	ret0, _ := ret[0].(*proto.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteOperation indicates an expected call of DeleteOperation.
-func (mr *MockFinanceServiceMockRecorder) DeleteOperation(ctx, accID, opID any) *gomock.Call {
+func (mr *MockFinanceServiceMockRecorder) DeleteOperation(ctx, userID, accID, opID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperation", reflect.TypeOf((*MockFinanceService)(nil).DeleteOperation), ctx, userID, accID, opID)
+}
+
+// DeleteOperationSplit mocks base method.
+func (m *MockFinanceService) DeleteOperationSplit(ctx context.Context, userID, accID, opID int) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOperationSplit", ctx, userID, accID, opID)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOperationSplit indicates an expected call of DeleteOperationSplit.
+func (mr *MockFinanceServiceMockRecorder) DeleteOperationSplit(ctx, userID, accID, opID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperationSplit", reflect.TypeOf((*MockFinanceService)(nil).DeleteOperationSplit), ctx, userID, accID, opID)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockFinanceService) DeleteUserData(ctx context.Context, userID int) (*proto.UserDataDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, userID)
+	ret0, _ := ret[0].(*proto.UserDataDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockFinanceServiceMockRecorder) DeleteUserData(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockFinanceService)(nil).DeleteUserData), ctx, userID)
+}
+
+// ExportUserData mocks base method.
+func (m *MockFinanceService) ExportUserData(ctx context.Context, userID int) (*proto.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, userID)
+	ret0, _ := ret[0].(*proto.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockFinanceServiceMockRecorder) ExportUserData(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperation", reflect.TypeOf((*MockFinanceService)(nil).DeleteOperation), ctx, accID, opID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockFinanceService)(nil).ExportUserData), ctx, userID)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockFinanceService) FilterUsedImages(ctx context.Context, ids []string) (*proto.ImageIDs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterUsedImages", ctx, ids)
+	ret0, _ := ret[0].(*proto.ImageIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockFinanceServiceMockRecorder) FilterUsedImages(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockFinanceService)(nil).FilterUsedImages), ctx, ids)
+}
+
+// GetAccountBalances mocks base method.
+func (m *MockFinanceService) GetAccountBalances(ctx context.Context, userID, accountID int) (*proto.AccountBalances, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalances", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.AccountBalances)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalances indicates an expected call of GetAccountBalances.
+func (mr *MockFinanceServiceMockRecorder) GetAccountBalances(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalances", reflect.TypeOf((*MockFinanceService)(nil).GetAccountBalances), ctx, userID, accountID)
 }
 
 // GetAccountByID mocks base method.
@@ -161,6 +387,51 @@ func (mr *MockFinanceServiceMockRecorder) GetAccountByID(ctx, userID, accountID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByID", reflect.TypeOf((*MockFinanceService)(nil).GetAccountByID), ctx, userID, accountID)
 }
 
+// GetAccountInvitations mocks base method.
+func (m *MockFinanceService) GetAccountInvitations(ctx context.Context, userID, accountID int) (*proto.ListAccountInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountInvitations", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.ListAccountInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInvitations indicates an expected call of GetAccountInvitations.
+func (mr *MockFinanceServiceMockRecorder) GetAccountInvitations(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInvitations", reflect.TypeOf((*MockFinanceService)(nil).GetAccountInvitations), ctx, userID, accountID)
+}
+
+// GetAccountMembers mocks base method.
+func (m *MockFinanceService) GetAccountMembers(ctx context.Context, userID, accountID int) (*proto.ListAccountMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountMembers", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.ListAccountMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountMembers indicates an expected call of GetAccountMembers.
+func (mr *MockFinanceServiceMockRecorder) GetAccountMembers(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountMembers", reflect.TypeOf((*MockFinanceService)(nil).GetAccountMembers), ctx, userID, accountID)
+}
+
+// GetAccountUserIDs mocks base method.
+func (m *MockFinanceService) GetAccountUserIDs(ctx context.Context, accountID int) (*proto.UserIDs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountUserIDs", ctx, accountID)
+	ret0, _ := ret[0].(*proto.UserIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountUserIDs indicates an expected call of GetAccountUserIDs.
+func (mr *MockFinanceServiceMockRecorder) GetAccountUserIDs(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountUserIDs", reflect.TypeOf((*MockFinanceService)(nil).GetAccountUserIDs), ctx, accountID)
+}
+
 // GetAccountsByUser mocks base method.
 func (m *MockFinanceService) GetAccountsByUser(ctx context.Context, userID int) (*proto.ListAccountsResponse, error) {
 	m.ctrl.T.Helper()
@@ -251,34 +522,364 @@ func (mr *MockFinanceServiceMockRecorder) GetCategoryByName(ctx, userID, categor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByName", reflect.TypeOf((*MockFinanceService)(nil).GetCategoryByName), ctx, userID, categoryName)
 }
 
+// GetCategoryRules mocks base method.
+func (m *MockFinanceService) GetCategoryRules(ctx context.Context, userID int) (*proto.ListCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryRules", ctx, userID)
+	ret0, _ := ret[0].(*proto.ListCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryRules indicates an expected call of GetCategoryRules.
+func (mr *MockFinanceServiceMockRecorder) GetCategoryRules(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryRules", reflect.TypeOf((*MockFinanceService)(nil).GetCategoryRules), ctx, userID)
+}
+
+// GetCounterparties mocks base method.
+func (m *MockFinanceService) GetCounterparties(ctx context.Context, userID int) (*proto.ListCounterpartiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCounterparties", ctx, userID)
+	ret0, _ := ret[0].(*proto.ListCounterpartiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCounterparties indicates an expected call of GetCounterparties.
+func (mr *MockFinanceServiceMockRecorder) GetCounterparties(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterparties", reflect.TypeOf((*MockFinanceService)(nil).GetCounterparties), ctx, userID)
+}
+
+// GetDebt mocks base method.
+func (m *MockFinanceService) GetDebt(ctx context.Context, userID, debtID int) (*proto.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebt", ctx, userID, debtID)
+	ret0, _ := ret[0].(*proto.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebt indicates an expected call of GetDebt.
+func (mr *MockFinanceServiceMockRecorder) GetDebt(ctx, userID, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebt", reflect.TypeOf((*MockFinanceService)(nil).GetDebt), ctx, userID, debtID)
+}
+
+// GetDebtPayments mocks base method.
+func (m *MockFinanceService) GetDebtPayments(ctx context.Context, userID, debtID int) (*proto.ListDebtPaymentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebtPayments", ctx, userID, debtID)
+	ret0, _ := ret[0].(*proto.ListDebtPaymentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtPayments indicates an expected call of GetDebtPayments.
+func (mr *MockFinanceServiceMockRecorder) GetDebtPayments(ctx, userID, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtPayments", reflect.TypeOf((*MockFinanceService)(nil).GetDebtPayments), ctx, userID, debtID)
+}
+
+// GetDebts mocks base method.
+func (m *MockFinanceService) GetDebts(ctx context.Context, userID int, status string) (*proto.ListDebtsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebts", ctx, userID, status)
+	ret0, _ := ret[0].(*proto.ListDebtsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebts indicates an expected call of GetDebts.
+func (mr *MockFinanceServiceMockRecorder) GetDebts(ctx, userID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebts", reflect.TypeOf((*MockFinanceService)(nil).GetDebts), ctx, userID, status)
+}
+
 // GetOperationByID mocks base method.
-func (m *MockFinanceService) GetOperationByID(ctx context.Context, accID, opID int) (*proto.Operation, error) {
+func (m *MockFinanceService) GetOperationByID(ctx context.Context, userID, accID, opID int) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOperationByID", ctx, accID, opID)
+	ret := m.ctrl.Call(m, "GetOperationByID", ctx, userID, accID, opID)
 	ret0, _ := ret[0].(*proto.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOperationByID indicates an expected call of GetOperationByID.
-func (mr *MockFinanceServiceMockRecorder) GetOperationByID(ctx, accID, opID any) *gomock.Call {
+func (mr *MockFinanceServiceMockRecorder) GetOperationByID(ctx, userID, accID, opID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationByID", reflect.TypeOf((*MockFinanceService)(nil).GetOperationByID), ctx, accID, opID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationByID", reflect.TypeOf((*MockFinanceService)(nil).GetOperationByID), ctx, userID, accID, opID)
+}
+
+// GetOperationSplit mocks base method.
+func (m *MockFinanceService) GetOperationSplit(ctx context.Context, userID, accID, opID int) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationSplit", ctx, userID, accID, opID)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationSplit indicates an expected call of GetOperationSplit.
+func (mr *MockFinanceServiceMockRecorder) GetOperationSplit(ctx, userID, accID, opID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationSplit", reflect.TypeOf((*MockFinanceService)(nil).GetOperationSplit), ctx, userID, accID, opID)
 }
 
 // GetOperationsByAccount mocks base method.
-func (m *MockFinanceService) GetOperationsByAccount(ctx context.Context, req []byte) (*proto.ListOperationsResponse, error) {
+func (m *MockFinanceService) GetOperationsByAccount(ctx context.Context, userID, accountID int, req []byte) (*proto.ListOperationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOperationsByAccount", ctx, req)
+	ret := m.ctrl.Call(m, "GetOperationsByAccount", ctx, userID, accountID, req)
 	ret0, _ := ret[0].(*proto.ListOperationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOperationsByAccount indicates an expected call of GetOperationsByAccount.
-func (mr *MockFinanceServiceMockRecorder) GetOperationsByAccount(ctx, req any) *gomock.Call {
+func (mr *MockFinanceServiceMockRecorder) GetOperationsByAccount(ctx, userID, accountID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByAccount", reflect.TypeOf((*MockFinanceService)(nil).GetOperationsByAccount), ctx, userID, accountID, req)
+}
+
+// GetOperationsByIDs mocks base method.
+func (m *MockFinanceService) GetOperationsByIDs(ctx context.Context, userID int, opIDs []int) (*proto.OperationsByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationsByIDs", ctx, userID, opIDs)
+	ret0, _ := ret[0].(*proto.OperationsByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationsByIDs indicates an expected call of GetOperationsByIDs.
+func (mr *MockFinanceServiceMockRecorder) GetOperationsByIDs(ctx, userID, opIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByIDs", reflect.TypeOf((*MockFinanceService)(nil).GetOperationsByIDs), ctx, userID, opIDs)
+}
+
+// GetPendingInvitations mocks base method.
+func (m *MockFinanceService) GetPendingInvitations(ctx context.Context, userID int) (*proto.ListAccountInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingInvitations", ctx, userID)
+	ret0, _ := ret[0].(*proto.ListAccountInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingInvitations indicates an expected call of GetPendingInvitations.
+func (mr *MockFinanceServiceMockRecorder) GetPendingInvitations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingInvitations", reflect.TypeOf((*MockFinanceService)(nil).GetPendingInvitations), ctx, userID)
+}
+
+// GetSettlements mocks base method.
+func (m *MockFinanceService) GetSettlements(ctx context.Context, userID, accountID int) (*proto.ListSettlementsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlements", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.ListSettlementsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlements indicates an expected call of GetSettlements.
+func (mr *MockFinanceServiceMockRecorder) GetSettlements(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlements", reflect.TypeOf((*MockFinanceService)(nil).GetSettlements), ctx, userID, accountID)
+}
+
+// GetSpendingStats mocks base method.
+func (m *MockFinanceService) GetSpendingStats(ctx context.Context, req models.SpendingStatsRequest) (*proto.SpendingStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingStats", ctx, req)
+	ret0, _ := ret[0].(*proto.SpendingStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingStats indicates an expected call of GetSpendingStats.
+func (mr *MockFinanceServiceMockRecorder) GetSpendingStats(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingStats", reflect.TypeOf((*MockFinanceService)(nil).GetSpendingStats), ctx, req)
+}
+
+// GetSpendingTotals mocks base method.
+func (m *MockFinanceService) GetSpendingTotals(ctx context.Context, req models.SpendingStatsRequest) (*proto.SpendingTotals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingTotals", ctx, req)
+	ret0, _ := ret[0].(*proto.SpendingTotals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingTotals indicates an expected call of GetSpendingTotals.
+func (mr *MockFinanceServiceMockRecorder) GetSpendingTotals(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingTotals", reflect.TypeOf((*MockFinanceService)(nil).GetSpendingTotals), ctx, req)
+}
+
+// ImportUserData mocks base method.
+func (m *MockFinanceService) ImportUserData(ctx context.Context, req models.ImportUserDataRequest) (*proto.ImportUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUserData", ctx, req)
+	ret0, _ := ret[0].(*proto.ImportUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUserData indicates an expected call of ImportUserData.
+func (mr *MockFinanceServiceMockRecorder) ImportUserData(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUserData", reflect.TypeOf((*MockFinanceService)(nil).ImportUserData), ctx, req)
+}
+
+// LeaveAccount mocks base method.
+func (m *MockFinanceService) LeaveAccount(ctx context.Context, userID, accountID int) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveAccount", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveAccount indicates an expected call of LeaveAccount.
+func (mr *MockFinanceServiceMockRecorder) LeaveAccount(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveAccount", reflect.TypeOf((*MockFinanceService)(nil).LeaveAccount), ctx, userID, accountID)
+}
+
+// MergeCategories mocks base method.
+func (m *MockFinanceService) MergeCategories(ctx context.Context, userID, sourceID, targetID int) (*proto.MergeCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCategories", ctx, userID, sourceID, targetID)
+	ret0, _ := ret[0].(*proto.MergeCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeCategories indicates an expected call of MergeCategories.
+func (mr *MockFinanceServiceMockRecorder) MergeCategories(ctx, userID, sourceID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCategories", reflect.TypeOf((*MockFinanceService)(nil).MergeCategories), ctx, userID, sourceID, targetID)
+}
+
+// ProvisionDefaultCategories mocks base method.
+func (m *MockFinanceService) ProvisionDefaultCategories(ctx context.Context, userID int, locale string, overwrite bool) (*proto.ListCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionDefaultCategories", ctx, userID, locale, overwrite)
+	ret0, _ := ret[0].(*proto.ListCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionDefaultCategories indicates an expected call of ProvisionDefaultCategories.
+func (mr *MockFinanceServiceMockRecorder) ProvisionDefaultCategories(ctx, userID, locale, overwrite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionDefaultCategories", reflect.TypeOf((*MockFinanceService)(nil).ProvisionDefaultCategories), ctx, userID, locale, overwrite)
+}
+
+// RemoveAccountMember mocks base method.
+func (m *MockFinanceService) RemoveAccountMember(ctx context.Context, req models.AccountMemberRequest) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAccountMember", ctx, req)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAccountMember indicates an expected call of RemoveAccountMember.
+func (mr *MockFinanceServiceMockRecorder) RemoveAccountMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccountMember", reflect.TypeOf((*MockFinanceService)(nil).RemoveAccountMember), ctx, req)
+}
+
+// ReorderCategoryRules mocks base method.
+func (m *MockFinanceService) ReorderCategoryRules(ctx context.Context, userID int, ruleIDs []int) (*proto.ListCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCategoryRules", ctx, userID, ruleIDs)
+	ret0, _ := ret[0].(*proto.ListCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderCategoryRules indicates an expected call of ReorderCategoryRules.
+func (mr *MockFinanceServiceMockRecorder) ReorderCategoryRules(ctx, userID, ruleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategoryRules", reflect.TypeOf((*MockFinanceService)(nil).ReorderCategoryRules), ctx, userID, ruleIDs)
+}
+
+// RevokeAccountInvitation mocks base method.
+func (m *MockFinanceService) RevokeAccountInvitation(ctx context.Context, req models.InvitationRequest) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountInvitation", ctx, req)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccountInvitation indicates an expected call of RevokeAccountInvitation.
+func (mr *MockFinanceServiceMockRecorder) RevokeAccountInvitation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountInvitation", reflect.TypeOf((*MockFinanceService)(nil).RevokeAccountInvitation), ctx, req)
+}
+
+// SplitOperation mocks base method.
+func (m *MockFinanceService) SplitOperation(ctx context.Context, req models.SplitOperationRequest) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitOperation", ctx, req)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitOperation indicates an expected call of SplitOperation.
+func (mr *MockFinanceServiceMockRecorder) SplitOperation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitOperation", reflect.TypeOf((*MockFinanceService)(nil).SplitOperation), ctx, req)
+}
+
+// SuggestCategory mocks base method.
+func (m *MockFinanceService) SuggestCategory(ctx context.Context, userID int, name string, sum float64, opType models.OperationType) (*proto.SuggestCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestCategory", ctx, userID, name, sum, opType)
+	ret0, _ := ret[0].(*proto.SuggestCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestCategory indicates an expected call of SuggestCategory.
+func (mr *MockFinanceServiceMockRecorder) SuggestCategory(ctx, userID, name, sum, opType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestCategory", reflect.TypeOf((*MockFinanceService)(nil).SuggestCategory), ctx, userID, name, sum, opType)
+}
+
+// TestCategoryRules mocks base method.
+func (m *MockFinanceService) TestCategoryRules(ctx context.Context, userID int, name string, sum float64, opType models.OperationType) (*proto.TestCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestCategoryRules", ctx, userID, name, sum, opType)
+	ret0, _ := ret[0].(*proto.TestCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestCategoryRules indicates an expected call of TestCategoryRules.
+func (mr *MockFinanceServiceMockRecorder) TestCategoryRules(ctx, userID, name, sum, opType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByAccount", reflect.TypeOf((*MockFinanceService)(nil).GetOperationsByAccount), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestCategoryRules", reflect.TypeOf((*MockFinanceService)(nil).TestCategoryRules), ctx, userID, name, sum, opType)
+}
+
+// TransferAccountOwnership mocks base method.
+func (m *MockFinanceService) TransferAccountOwnership(ctx context.Context, req models.AccountMemberRequest) (*proto.ListAccountMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferAccountOwnership", ctx, req)
+	ret0, _ := ret[0].(*proto.ListAccountMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferAccountOwnership indicates an expected call of TransferAccountOwnership.
+func (mr *MockFinanceServiceMockRecorder) TransferAccountOwnership(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAccountOwnership", reflect.TypeOf((*MockFinanceService)(nil).TransferAccountOwnership), ctx, req)
 }
 
 // UpdateAccount mocks base method.
@@ -296,6 +897,21 @@ func (mr *MockFinanceServiceMockRecorder) UpdateAccount(ctx, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockFinanceService)(nil).UpdateAccount), ctx, req)
 }
 
+// UpdateAccountMemberRole mocks base method.
+func (m *MockFinanceService) UpdateAccountMemberRole(ctx context.Context, req models.AccountMemberRequest) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountMemberRole", ctx, req)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountMemberRole indicates an expected call of UpdateAccountMemberRole.
+func (mr *MockFinanceServiceMockRecorder) UpdateAccountMemberRole(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountMemberRole", reflect.TypeOf((*MockFinanceService)(nil).UpdateAccountMemberRole), ctx, req)
+}
+
 // UpdateCategory mocks base method.
 func (m *MockFinanceService) UpdateCategory(ctx context.Context, category models.Category) (*proto.Category, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +927,21 @@ func (mr *MockFinanceServiceMockRecorder) UpdateCategory(ctx, category any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockFinanceService)(nil).UpdateCategory), ctx, category)
 }
 
+// UpdateCategoryRule mocks base method.
+func (m *MockFinanceService) UpdateCategoryRule(ctx context.Context, req models.UpdateCategoryRuleRequest) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategoryRule", ctx, req)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategoryRule indicates an expected call of UpdateCategoryRule.
+func (mr *MockFinanceServiceMockRecorder) UpdateCategoryRule(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryRule", reflect.TypeOf((*MockFinanceService)(nil).UpdateCategoryRule), ctx, req)
+}
+
 // UpdateOperation mocks base method.
 func (m *MockFinanceService) UpdateOperation(ctx context.Context, req models.UpdateOperationRequest) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
@@ -42,19 +42,64 @@ func (m *MockFinanceUseCase) EXPECT() *MockFinanceUseCaseMockRecorder {
 	return m.recorder
 }
 
-// AddUserToAccount mocks base method.
-func (m *MockFinanceUseCase) AddUserToAccount(ctx context.Context, userLogin string, accountID int) (*proto.SharingsResponse, error) {
+// AcceptAccountInvitation mocks base method.
+func (m *MockFinanceUseCase) AcceptAccountInvitation(ctx context.Context, req models.InvitationRequest) (*proto.SharingsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUserToAccount", ctx, userLogin, accountID)
+	ret := m.ctrl.Call(m, "AcceptAccountInvitation", ctx, req)
 	ret0, _ := ret[0].(*proto.SharingsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddUserToAccount indicates an expected call of AddUserToAccount.
-func (mr *MockFinanceUseCaseMockRecorder) AddUserToAccount(ctx, userLogin, accountID any) *gomock.Call {
+// AcceptAccountInvitation indicates an expected call of AcceptAccountInvitation.
+func (mr *MockFinanceUseCaseMockRecorder) AcceptAccountInvitation(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToAccount", reflect.TypeOf((*MockFinanceUseCase)(nil).AddUserToAccount), ctx, userLogin, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptAccountInvitation", reflect.TypeOf((*MockFinanceUseCase)(nil).AcceptAccountInvitation), ctx, req)
+}
+
+// AcceptInvitationLink mocks base method.
+func (m *MockFinanceUseCase) AcceptInvitationLink(ctx context.Context, userID int, token string) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitationLink", ctx, userID, token)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitationLink indicates an expected call of AcceptInvitationLink.
+func (mr *MockFinanceUseCaseMockRecorder) AcceptInvitationLink(ctx, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitationLink", reflect.TypeOf((*MockFinanceUseCase)(nil).AcceptInvitationLink), ctx, userID, token)
+}
+
+// AddDebtRepayment mocks base method.
+func (m *MockFinanceUseCase) AddDebtRepayment(ctx context.Context, req models.CreateDebtRepaymentRequest) (*proto.DebtPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDebtRepayment", ctx, req)
+	ret0, _ := ret[0].(*proto.DebtPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDebtRepayment indicates an expected call of AddDebtRepayment.
+func (mr *MockFinanceUseCaseMockRecorder) AddDebtRepayment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDebtRepayment", reflect.TypeOf((*MockFinanceUseCase)(nil).AddDebtRepayment), ctx, req)
+}
+
+// ApplyCategoryRules mocks base method.
+func (m *MockFinanceUseCase) ApplyCategoryRules(ctx context.Context, userID int, overwrite bool) (*proto.ApplyCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyCategoryRules", ctx, userID, overwrite)
+	ret0, _ := ret[0].(*proto.ApplyCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyCategoryRules indicates an expected call of ApplyCategoryRules.
+func (mr *MockFinanceUseCaseMockRecorder) ApplyCategoryRules(ctx, userID, overwrite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyCategoryRules", reflect.TypeOf((*MockFinanceUseCase)(nil).ApplyCategoryRules), ctx, userID, overwrite)
 }
 
 // CreateAccount mocks base method.
@@ -72,6 +117,21 @@ func (mr *MockFinanceUseCaseMockRecorder) CreateAccount(ctx, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockFinanceUseCase)(nil).CreateAccount), ctx, req)
 }
 
+// CreateAccountInvitation mocks base method.
+func (m *MockFinanceUseCase) CreateAccountInvitation(ctx context.Context, req models.CreateInvitationRequest) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountInvitation", ctx, req)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountInvitation indicates an expected call of CreateAccountInvitation.
+func (mr *MockFinanceUseCaseMockRecorder) CreateAccountInvitation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountInvitation", reflect.TypeOf((*MockFinanceUseCase)(nil).CreateAccountInvitation), ctx, req)
+}
+
 // CreateCategory mocks base method.
 func (m *MockFinanceUseCase) CreateCategory(ctx context.Context, req models.CreateCategoryRequest) (*proto.Category, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +147,36 @@ func (mr *MockFinanceUseCaseMockRecorder) CreateCategory(ctx, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockFinanceUseCase)(nil).CreateCategory), ctx, req)
 }
 
+// CreateCategoryRule mocks base method.
+func (m *MockFinanceUseCase) CreateCategoryRule(ctx context.Context, req models.CreateCategoryRuleRequest) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryRule", ctx, req)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockFinanceUseCaseMockRecorder) CreateCategoryRule(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockFinanceUseCase)(nil).CreateCategoryRule), ctx, req)
+}
+
+// CreateDebt mocks base method.
+func (m *MockFinanceUseCase) CreateDebt(ctx context.Context, req models.CreateDebtRequest) (*proto.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDebt", ctx, req)
+	ret0, _ := ret[0].(*proto.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDebt indicates an expected call of CreateDebt.
+func (mr *MockFinanceUseCaseMockRecorder) CreateDebt(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDebt", reflect.TypeOf((*MockFinanceUseCase)(nil).CreateDebt), ctx, req)
+}
+
 // CreateOperation mocks base method.
 func (m *MockFinanceUseCase) CreateOperation(ctx context.Context, req models.CreateOperationRequest, accountID int) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +192,36 @@ func (mr *MockFinanceUseCaseMockRecorder) CreateOperation(ctx, req, accountID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperation", reflect.TypeOf((*MockFinanceUseCase)(nil).CreateOperation), ctx, req, accountID)
 }
 
+// CreateSettlement mocks base method.
+func (m *MockFinanceUseCase) CreateSettlement(ctx context.Context, req models.CreateSettlementRequest) (*proto.Settlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSettlement", ctx, req)
+	ret0, _ := ret[0].(*proto.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSettlement indicates an expected call of CreateSettlement.
+func (mr *MockFinanceUseCaseMockRecorder) CreateSettlement(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSettlement", reflect.TypeOf((*MockFinanceUseCase)(nil).CreateSettlement), ctx, req)
+}
+
+// DeclineAccountInvitation mocks base method.
+func (m *MockFinanceUseCase) DeclineAccountInvitation(ctx context.Context, req models.InvitationRequest) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineAccountInvitation", ctx, req)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineAccountInvitation indicates an expected call of DeclineAccountInvitation.
+func (mr *MockFinanceUseCaseMockRecorder) DeclineAccountInvitation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineAccountInvitation", reflect.TypeOf((*MockFinanceUseCase)(nil).DeclineAccountInvitation), ctx, req)
+}
+
 // DeleteAccount mocks base method.
 func (m *MockFinanceUseCase) DeleteAccount(ctx context.Context, userID, accountID int) (*proto.Account, error) {
 	m.ctrl.T.Helper()
@@ -118,32 +238,138 @@ func (mr *MockFinanceUseCaseMockRecorder) DeleteAccount(ctx, userID, accountID a
 }
 
 // DeleteCategory mocks base method.
-func (m *MockFinanceUseCase) DeleteCategory(ctx context.Context, userID, categoryID int) error {
+func (m *MockFinanceUseCase) DeleteCategory(ctx context.Context, userID, categoryID, reassignTo int) (*proto.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCategory", ctx, userID, categoryID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, userID, categoryID, reassignTo)
+	ret0, _ := ret[0].(*proto.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteCategory indicates an expected call of DeleteCategory.
-func (mr *MockFinanceUseCaseMockRecorder) DeleteCategory(ctx, userID, categoryID any) *gomock.Call {
+func (mr *MockFinanceUseCaseMockRecorder) DeleteCategory(ctx, userID, categoryID, reassignTo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockFinanceUseCase)(nil).DeleteCategory), ctx, userID, categoryID, reassignTo)
+}
+
+// DeleteCategoryRule mocks base method.
+func (m *MockFinanceUseCase) DeleteCategoryRule(ctx context.Context, userID, ruleID int) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", ctx, userID, ruleID)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockFinanceUseCaseMockRecorder) DeleteCategoryRule(ctx, userID, ruleID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockFinanceUseCase)(nil).DeleteCategory), ctx, userID, categoryID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockFinanceUseCase)(nil).DeleteCategoryRule), ctx, userID, ruleID)
+}
+
+// DeleteDebt mocks base method.
+func (m *MockFinanceUseCase) DeleteDebt(ctx context.Context, userID, debtID int) (*proto.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDebt", ctx, userID, debtID)
+	ret0, _ := ret[0].(*proto.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDebt indicates an expected call of DeleteDebt.
+func (mr *MockFinanceUseCaseMockRecorder) DeleteDebt(ctx, userID, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDebt", reflect.TypeOf((*MockFinanceUseCase)(nil).DeleteDebt), ctx, userID, debtID)
 }
 
 // DeleteOperation mocks base method.
-func (m *MockFinanceUseCase) DeleteOperation(ctx context.Context, accID, opID int) (*proto.Operation, error) {
+func (m *MockFinanceUseCase) DeleteOperation(ctx context.Context, userID, accID, opID int) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOperation", ctx, accID, opID)
+	ret := m.ctrl.Call(m, "DeleteOperation", ctx, userID, accID, opID)
 	ret0, _ := ret[0].(*proto.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteOperation indicates an expected call of DeleteOperation.
-func (mr *MockFinanceUseCaseMockRecorder) DeleteOperation(ctx, accID, opID any) *gomock.Call {
+func (mr *MockFinanceUseCaseMockRecorder) DeleteOperation(ctx, userID, accID, opID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperation", reflect.TypeOf((*MockFinanceUseCase)(nil).DeleteOperation), ctx, userID, accID, opID)
+}
+
+// DeleteOperationSplit mocks base method.
+func (m *MockFinanceUseCase) DeleteOperationSplit(ctx context.Context, userID, accID, opID int) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOperationSplit", ctx, userID, accID, opID)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOperationSplit indicates an expected call of DeleteOperationSplit.
+func (mr *MockFinanceUseCaseMockRecorder) DeleteOperationSplit(ctx, userID, accID, opID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperationSplit", reflect.TypeOf((*MockFinanceUseCase)(nil).DeleteOperationSplit), ctx, userID, accID, opID)
+}
+
+// DeleteUserData mocks base method.
+func (m *MockFinanceUseCase) DeleteUserData(ctx context.Context, userID int) (*proto.UserDataDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, userID)
+	ret0, _ := ret[0].(*proto.UserDataDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockFinanceUseCaseMockRecorder) DeleteUserData(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockFinanceUseCase)(nil).DeleteUserData), ctx, userID)
+}
+
+// ExportUserData mocks base method.
+func (m *MockFinanceUseCase) ExportUserData(ctx context.Context, userID int) (*proto.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, userID)
+	ret0, _ := ret[0].(*proto.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockFinanceUseCaseMockRecorder) ExportUserData(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOperation", reflect.TypeOf((*MockFinanceUseCase)(nil).DeleteOperation), ctx, accID, opID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockFinanceUseCase)(nil).ExportUserData), ctx, userID)
+}
+
+// FilterUsedImages mocks base method.
+func (m *MockFinanceUseCase) FilterUsedImages(ctx context.Context, ids []string) (*proto.ImageIDs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterUsedImages", ctx, ids)
+	ret0, _ := ret[0].(*proto.ImageIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterUsedImages indicates an expected call of FilterUsedImages.
+func (mr *MockFinanceUseCaseMockRecorder) FilterUsedImages(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterUsedImages", reflect.TypeOf((*MockFinanceUseCase)(nil).FilterUsedImages), ctx, ids)
+}
+
+// GetAccountBalances mocks base method.
+func (m *MockFinanceUseCase) GetAccountBalances(ctx context.Context, userID, accountID int) (*proto.AccountBalances, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalances", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.AccountBalances)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalances indicates an expected call of GetAccountBalances.
+func (mr *MockFinanceUseCaseMockRecorder) GetAccountBalances(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalances", reflect.TypeOf((*MockFinanceUseCase)(nil).GetAccountBalances), ctx, userID, accountID)
 }
 
 // GetAccountByID mocks base method.
@@ -161,6 +387,51 @@ func (mr *MockFinanceUseCaseMockRecorder) GetAccountByID(ctx, userID, accountID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByID", reflect.TypeOf((*MockFinanceUseCase)(nil).GetAccountByID), ctx, userID, accountID)
 }
 
+// GetAccountInvitations mocks base method.
+func (m *MockFinanceUseCase) GetAccountInvitations(ctx context.Context, userID, accountID int) (*proto.ListAccountInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountInvitations", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.ListAccountInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInvitations indicates an expected call of GetAccountInvitations.
+func (mr *MockFinanceUseCaseMockRecorder) GetAccountInvitations(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInvitations", reflect.TypeOf((*MockFinanceUseCase)(nil).GetAccountInvitations), ctx, userID, accountID)
+}
+
+// GetAccountMembers mocks base method.
+func (m *MockFinanceUseCase) GetAccountMembers(ctx context.Context, userID, accountID int) (*proto.ListAccountMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountMembers", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.ListAccountMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountMembers indicates an expected call of GetAccountMembers.
+func (mr *MockFinanceUseCaseMockRecorder) GetAccountMembers(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountMembers", reflect.TypeOf((*MockFinanceUseCase)(nil).GetAccountMembers), ctx, userID, accountID)
+}
+
+// GetAccountUserIDs mocks base method.
+func (m *MockFinanceUseCase) GetAccountUserIDs(ctx context.Context, accountID int) (*proto.UserIDs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountUserIDs", ctx, accountID)
+	ret0, _ := ret[0].(*proto.UserIDs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountUserIDs indicates an expected call of GetAccountUserIDs.
+func (mr *MockFinanceUseCaseMockRecorder) GetAccountUserIDs(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountUserIDs", reflect.TypeOf((*MockFinanceUseCase)(nil).GetAccountUserIDs), ctx, accountID)
+}
+
 // GetAccountsByUser mocks base method.
 func (m *MockFinanceUseCase) GetAccountsByUser(ctx context.Context, userID int) (*proto.ListAccountsResponse, error) {
 	m.ctrl.T.Helper()
@@ -251,34 +522,364 @@ func (mr *MockFinanceUseCaseMockRecorder) GetCategoryByName(ctx, userID, categor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByName", reflect.TypeOf((*MockFinanceUseCase)(nil).GetCategoryByName), ctx, userID, categoryName)
 }
 
+// GetCategoryRules mocks base method.
+func (m *MockFinanceUseCase) GetCategoryRules(ctx context.Context, userID int) (*proto.ListCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryRules", ctx, userID)
+	ret0, _ := ret[0].(*proto.ListCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryRules indicates an expected call of GetCategoryRules.
+func (mr *MockFinanceUseCaseMockRecorder) GetCategoryRules(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryRules", reflect.TypeOf((*MockFinanceUseCase)(nil).GetCategoryRules), ctx, userID)
+}
+
+// GetCounterparties mocks base method.
+func (m *MockFinanceUseCase) GetCounterparties(ctx context.Context, userID int) (*proto.ListCounterpartiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCounterparties", ctx, userID)
+	ret0, _ := ret[0].(*proto.ListCounterpartiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCounterparties indicates an expected call of GetCounterparties.
+func (mr *MockFinanceUseCaseMockRecorder) GetCounterparties(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterparties", reflect.TypeOf((*MockFinanceUseCase)(nil).GetCounterparties), ctx, userID)
+}
+
+// GetDebt mocks base method.
+func (m *MockFinanceUseCase) GetDebt(ctx context.Context, userID, debtID int) (*proto.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebt", ctx, userID, debtID)
+	ret0, _ := ret[0].(*proto.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebt indicates an expected call of GetDebt.
+func (mr *MockFinanceUseCaseMockRecorder) GetDebt(ctx, userID, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebt", reflect.TypeOf((*MockFinanceUseCase)(nil).GetDebt), ctx, userID, debtID)
+}
+
+// GetDebtPayments mocks base method.
+func (m *MockFinanceUseCase) GetDebtPayments(ctx context.Context, userID, debtID int) (*proto.ListDebtPaymentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebtPayments", ctx, userID, debtID)
+	ret0, _ := ret[0].(*proto.ListDebtPaymentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtPayments indicates an expected call of GetDebtPayments.
+func (mr *MockFinanceUseCaseMockRecorder) GetDebtPayments(ctx, userID, debtID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtPayments", reflect.TypeOf((*MockFinanceUseCase)(nil).GetDebtPayments), ctx, userID, debtID)
+}
+
+// GetDebts mocks base method.
+func (m *MockFinanceUseCase) GetDebts(ctx context.Context, userID int, status string) (*proto.ListDebtsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebts", ctx, userID, status)
+	ret0, _ := ret[0].(*proto.ListDebtsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebts indicates an expected call of GetDebts.
+func (mr *MockFinanceUseCaseMockRecorder) GetDebts(ctx, userID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebts", reflect.TypeOf((*MockFinanceUseCase)(nil).GetDebts), ctx, userID, status)
+}
+
 // GetOperationByID mocks base method.
-func (m *MockFinanceUseCase) GetOperationByID(ctx context.Context, accID, opID int) (*proto.Operation, error) {
+func (m *MockFinanceUseCase) GetOperationByID(ctx context.Context, userID, accID, opID int) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOperationByID", ctx, accID, opID)
+	ret := m.ctrl.Call(m, "GetOperationByID", ctx, userID, accID, opID)
 	ret0, _ := ret[0].(*proto.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOperationByID indicates an expected call of GetOperationByID.
-func (mr *MockFinanceUseCaseMockRecorder) GetOperationByID(ctx, accID, opID any) *gomock.Call {
+func (mr *MockFinanceUseCaseMockRecorder) GetOperationByID(ctx, userID, accID, opID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationByID", reflect.TypeOf((*MockFinanceUseCase)(nil).GetOperationByID), ctx, accID, opID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationByID", reflect.TypeOf((*MockFinanceUseCase)(nil).GetOperationByID), ctx, userID, accID, opID)
+}
+
+// GetOperationSplit mocks base method.
+func (m *MockFinanceUseCase) GetOperationSplit(ctx context.Context, userID, accID, opID int) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationSplit", ctx, userID, accID, opID)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationSplit indicates an expected call of GetOperationSplit.
+func (mr *MockFinanceUseCaseMockRecorder) GetOperationSplit(ctx, userID, accID, opID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationSplit", reflect.TypeOf((*MockFinanceUseCase)(nil).GetOperationSplit), ctx, userID, accID, opID)
 }
 
 // GetOperationsByAccount mocks base method.
-func (m *MockFinanceUseCase) GetOperationsByAccount(ctx context.Context, accountID int, categoryIDs []int, opName, opType, accType, date string) (*proto.ListOperationsResponse, error) {
+func (m *MockFinanceUseCase) GetOperationsByAccount(ctx context.Context, userID, accountID int, categoryIDs []int, opName, opType, accType, date string) (*proto.ListOperationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOperationsByAccount", ctx, accountID, categoryIDs, opName, opType, accType, date)
+	ret := m.ctrl.Call(m, "GetOperationsByAccount", ctx, userID, accountID, categoryIDs, opName, opType, accType, date)
 	ret0, _ := ret[0].(*proto.ListOperationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOperationsByAccount indicates an expected call of GetOperationsByAccount.
-func (mr *MockFinanceUseCaseMockRecorder) GetOperationsByAccount(ctx, accountID, categoryIDs, opName, opType, accType, date any) *gomock.Call {
+func (mr *MockFinanceUseCaseMockRecorder) GetOperationsByAccount(ctx, userID, accountID, categoryIDs, opName, opType, accType, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByAccount", reflect.TypeOf((*MockFinanceUseCase)(nil).GetOperationsByAccount), ctx, userID, accountID, categoryIDs, opName, opType, accType, date)
+}
+
+// GetOperationsByIDs mocks base method.
+func (m *MockFinanceUseCase) GetOperationsByIDs(ctx context.Context, userID int, opIDs []int) (*proto.OperationsByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationsByIDs", ctx, userID, opIDs)
+	ret0, _ := ret[0].(*proto.OperationsByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationsByIDs indicates an expected call of GetOperationsByIDs.
+func (mr *MockFinanceUseCaseMockRecorder) GetOperationsByIDs(ctx, userID, opIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByIDs", reflect.TypeOf((*MockFinanceUseCase)(nil).GetOperationsByIDs), ctx, userID, opIDs)
+}
+
+// GetPendingInvitations mocks base method.
+func (m *MockFinanceUseCase) GetPendingInvitations(ctx context.Context, userID int) (*proto.ListAccountInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingInvitations", ctx, userID)
+	ret0, _ := ret[0].(*proto.ListAccountInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingInvitations indicates an expected call of GetPendingInvitations.
+func (mr *MockFinanceUseCaseMockRecorder) GetPendingInvitations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingInvitations", reflect.TypeOf((*MockFinanceUseCase)(nil).GetPendingInvitations), ctx, userID)
+}
+
+// GetSettlements mocks base method.
+func (m *MockFinanceUseCase) GetSettlements(ctx context.Context, userID, accountID int) (*proto.ListSettlementsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlements", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.ListSettlementsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlements indicates an expected call of GetSettlements.
+func (mr *MockFinanceUseCaseMockRecorder) GetSettlements(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlements", reflect.TypeOf((*MockFinanceUseCase)(nil).GetSettlements), ctx, userID, accountID)
+}
+
+// GetSpendingStats mocks base method.
+func (m *MockFinanceUseCase) GetSpendingStats(ctx context.Context, req models.SpendingStatsRequest) (*proto.SpendingStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingStats", ctx, req)
+	ret0, _ := ret[0].(*proto.SpendingStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingStats indicates an expected call of GetSpendingStats.
+func (mr *MockFinanceUseCaseMockRecorder) GetSpendingStats(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingStats", reflect.TypeOf((*MockFinanceUseCase)(nil).GetSpendingStats), ctx, req)
+}
+
+// GetSpendingTotals mocks base method.
+func (m *MockFinanceUseCase) GetSpendingTotals(ctx context.Context, req models.SpendingStatsRequest) (*proto.SpendingTotals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingTotals", ctx, req)
+	ret0, _ := ret[0].(*proto.SpendingTotals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingTotals indicates an expected call of GetSpendingTotals.
+func (mr *MockFinanceUseCaseMockRecorder) GetSpendingTotals(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingTotals", reflect.TypeOf((*MockFinanceUseCase)(nil).GetSpendingTotals), ctx, req)
+}
+
+// ImportUserData mocks base method.
+func (m *MockFinanceUseCase) ImportUserData(ctx context.Context, req models.ImportUserDataRequest) (*proto.ImportUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUserData", ctx, req)
+	ret0, _ := ret[0].(*proto.ImportUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUserData indicates an expected call of ImportUserData.
+func (mr *MockFinanceUseCaseMockRecorder) ImportUserData(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUserData", reflect.TypeOf((*MockFinanceUseCase)(nil).ImportUserData), ctx, req)
+}
+
+// LeaveAccount mocks base method.
+func (m *MockFinanceUseCase) LeaveAccount(ctx context.Context, userID, accountID int) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveAccount", ctx, userID, accountID)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveAccount indicates an expected call of LeaveAccount.
+func (mr *MockFinanceUseCaseMockRecorder) LeaveAccount(ctx, userID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveAccount", reflect.TypeOf((*MockFinanceUseCase)(nil).LeaveAccount), ctx, userID, accountID)
+}
+
+// MergeCategories mocks base method.
+func (m *MockFinanceUseCase) MergeCategories(ctx context.Context, userID, sourceID, targetID int) (*proto.MergeCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCategories", ctx, userID, sourceID, targetID)
+	ret0, _ := ret[0].(*proto.MergeCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeCategories indicates an expected call of MergeCategories.
+func (mr *MockFinanceUseCaseMockRecorder) MergeCategories(ctx, userID, sourceID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCategories", reflect.TypeOf((*MockFinanceUseCase)(nil).MergeCategories), ctx, userID, sourceID, targetID)
+}
+
+// ProvisionDefaultCategories mocks base method.
+func (m *MockFinanceUseCase) ProvisionDefaultCategories(ctx context.Context, userID int, locale string, overwrite bool) (*proto.ListCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionDefaultCategories", ctx, userID, locale, overwrite)
+	ret0, _ := ret[0].(*proto.ListCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionDefaultCategories indicates an expected call of ProvisionDefaultCategories.
+func (mr *MockFinanceUseCaseMockRecorder) ProvisionDefaultCategories(ctx, userID, locale, overwrite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionDefaultCategories", reflect.TypeOf((*MockFinanceUseCase)(nil).ProvisionDefaultCategories), ctx, userID, locale, overwrite)
+}
+
+// RemoveAccountMember mocks base method.
+func (m *MockFinanceUseCase) RemoveAccountMember(ctx context.Context, req models.AccountMemberRequest) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAccountMember", ctx, req)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAccountMember indicates an expected call of RemoveAccountMember.
+func (mr *MockFinanceUseCaseMockRecorder) RemoveAccountMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAccountMember", reflect.TypeOf((*MockFinanceUseCase)(nil).RemoveAccountMember), ctx, req)
+}
+
+// ReorderCategoryRules mocks base method.
+func (m *MockFinanceUseCase) ReorderCategoryRules(ctx context.Context, userID int, ruleIDs []int) (*proto.ListCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCategoryRules", ctx, userID, ruleIDs)
+	ret0, _ := ret[0].(*proto.ListCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderCategoryRules indicates an expected call of ReorderCategoryRules.
+func (mr *MockFinanceUseCaseMockRecorder) ReorderCategoryRules(ctx, userID, ruleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategoryRules", reflect.TypeOf((*MockFinanceUseCase)(nil).ReorderCategoryRules), ctx, userID, ruleIDs)
+}
+
+// RevokeAccountInvitation mocks base method.
+func (m *MockFinanceUseCase) RevokeAccountInvitation(ctx context.Context, req models.InvitationRequest) (*proto.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountInvitation", ctx, req)
+	ret0, _ := ret[0].(*proto.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccountInvitation indicates an expected call of RevokeAccountInvitation.
+func (mr *MockFinanceUseCaseMockRecorder) RevokeAccountInvitation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountInvitation", reflect.TypeOf((*MockFinanceUseCase)(nil).RevokeAccountInvitation), ctx, req)
+}
+
+// SplitOperation mocks base method.
+func (m *MockFinanceUseCase) SplitOperation(ctx context.Context, req models.SplitOperationRequest) (*proto.OperationSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitOperation", ctx, req)
+	ret0, _ := ret[0].(*proto.OperationSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitOperation indicates an expected call of SplitOperation.
+func (mr *MockFinanceUseCaseMockRecorder) SplitOperation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitOperation", reflect.TypeOf((*MockFinanceUseCase)(nil).SplitOperation), ctx, req)
+}
+
+// SuggestCategory mocks base method.
+func (m *MockFinanceUseCase) SuggestCategory(ctx context.Context, userID int, name string, sum float64, opType models.OperationType) (*proto.SuggestCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestCategory", ctx, userID, name, sum, opType)
+	ret0, _ := ret[0].(*proto.SuggestCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestCategory indicates an expected call of SuggestCategory.
+func (mr *MockFinanceUseCaseMockRecorder) SuggestCategory(ctx, userID, name, sum, opType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestCategory", reflect.TypeOf((*MockFinanceUseCase)(nil).SuggestCategory), ctx, userID, name, sum, opType)
+}
+
+// TestCategoryRules mocks base method.
+func (m *MockFinanceUseCase) TestCategoryRules(ctx context.Context, userID int, name string, sum float64, opType models.OperationType) (*proto.TestCategoryRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestCategoryRules", ctx, userID, name, sum, opType)
+	ret0, _ := ret[0].(*proto.TestCategoryRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestCategoryRules indicates an expected call of TestCategoryRules.
+func (mr *MockFinanceUseCaseMockRecorder) TestCategoryRules(ctx, userID, name, sum, opType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByAccount", reflect.TypeOf((*MockFinanceUseCase)(nil).GetOperationsByAccount), ctx, accountID, categoryIDs, opName, opType, accType, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestCategoryRules", reflect.TypeOf((*MockFinanceUseCase)(nil).TestCategoryRules), ctx, userID, name, sum, opType)
+}
+
+// TransferAccountOwnership mocks base method.
+func (m *MockFinanceUseCase) TransferAccountOwnership(ctx context.Context, req models.AccountMemberRequest) (*proto.ListAccountMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferAccountOwnership", ctx, req)
+	ret0, _ := ret[0].(*proto.ListAccountMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferAccountOwnership indicates an expected call of TransferAccountOwnership.
+func (mr *MockFinanceUseCaseMockRecorder) TransferAccountOwnership(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAccountOwnership", reflect.TypeOf((*MockFinanceUseCase)(nil).TransferAccountOwnership), ctx, req)
 }
 
 // UpdateAccount mocks base method.
@@ -296,6 +897,21 @@ func (mr *MockFinanceUseCaseMockRecorder) UpdateAccount(ctx, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockFinanceUseCase)(nil).UpdateAccount), ctx, req)
 }
 
+// UpdateAccountMemberRole mocks base method.
+func (m *MockFinanceUseCase) UpdateAccountMemberRole(ctx context.Context, req models.AccountMemberRequest) (*proto.SharingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountMemberRole", ctx, req)
+	ret0, _ := ret[0].(*proto.SharingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountMemberRole indicates an expected call of UpdateAccountMemberRole.
+func (mr *MockFinanceUseCaseMockRecorder) UpdateAccountMemberRole(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountMemberRole", reflect.TypeOf((*MockFinanceUseCase)(nil).UpdateAccountMemberRole), ctx, req)
+}
+
 // UpdateCategory mocks base method.
 func (m *MockFinanceUseCase) UpdateCategory(ctx context.Context, category models.Category) (*proto.Category, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +927,21 @@ func (mr *MockFinanceUseCaseMockRecorder) UpdateCategory(ctx, category any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockFinanceUseCase)(nil).UpdateCategory), ctx, category)
 }
 
+// UpdateCategoryRule mocks base method.
+func (m *MockFinanceUseCase) UpdateCategoryRule(ctx context.Context, req models.UpdateCategoryRuleRequest) (*proto.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategoryRule", ctx, req)
+	ret0, _ := ret[0].(*proto.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategoryRule indicates an expected call of UpdateCategoryRule.
+func (mr *MockFinanceUseCaseMockRecorder) UpdateCategoryRule(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryRule", reflect.TypeOf((*MockFinanceUseCase)(nil).UpdateCategoryRule), ctx, req)
+}
+
 // UpdateOperation mocks base method.
 func (m *MockFinanceUseCase) UpdateOperation(ctx context.Context, req models.UpdateOperationRequest) (*proto.Operation, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +55,22 @@ func (mr *MockImageStorageMockRecorder) DeleteImage(ctx, objectName any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockImageStorage)(nil).DeleteImage), ctx, objectName)
 }
 
+// GetImage mocks base method.
+func (m *MockImageStorage) GetImage(ctx context.Context, objectName string) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", ctx, objectName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockImageStorageMockRecorder) GetImage(ctx, objectName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockImageStorage)(nil).GetImage), ctx, objectName)
+}
+
 // GetImageURL mocks base method.
 func (m *MockImageStorage) GetImageURL(ctx context.Context, objectName string) (string, error) {
 	m.ctrl.T.Helper()
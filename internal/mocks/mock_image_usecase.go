@@ -55,6 +55,22 @@ func (mr *MockImageUseCaseMockRecorder) DeleteImage(ctx, imageID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockImageUseCase)(nil).DeleteImage), ctx, imageID)
 }
 
+// GetImage mocks base method.
+func (m *MockImageUseCase) GetImage(ctx context.Context, imageID string) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", ctx, imageID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockImageUseCaseMockRecorder) GetImage(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockImageUseCase)(nil).GetImage), ctx, imageID)
+}
+
 // GetImageURL mocks base method.
 func (m *MockImageUseCase) GetImageURL(ctx context.Context, imageID string) (string, error) {
 	m.ctrl.T.Helper()
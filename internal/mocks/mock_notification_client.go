@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto (interfaces: NotificationServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_notification_client.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto NotificationServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	proto "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockNotificationServiceClient is a mock of NotificationServiceClient interface.
+type MockNotificationServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationServiceClientMockRecorder is the mock recorder for MockNotificationServiceClient.
+type MockNotificationServiceClientMockRecorder struct {
+	mock *MockNotificationServiceClient
+}
+
+// NewMockNotificationServiceClient creates a new mock instance.
+func NewMockNotificationServiceClient(ctrl *gomock.Controller) *MockNotificationServiceClient {
+	mock := &MockNotificationServiceClient{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationServiceClient) EXPECT() *MockNotificationServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ListNotifications mocks base method.
+func (m *MockNotificationServiceClient) ListNotifications(ctx context.Context, in *proto.ListNotificationsRequest, opts ...grpc.CallOption) (*proto.ListNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
+	ret0, _ := ret[0].(*proto.ListNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockNotificationServiceClientMockRecorder) ListNotifications(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockNotificationServiceClient)(nil).ListNotifications), varargs...)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockNotificationServiceClient) MarkAsRead(ctx context.Context, in *proto.MarkAsReadRequest, opts ...grpc.CallOption) (*proto.MarkAsReadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAsRead", varargs...)
+	ret0, _ := ret[0].(*proto.MarkAsReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockNotificationServiceClientMockRecorder) MarkAsRead(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockNotificationServiceClient)(nil).MarkAsRead), varargs...)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/grpc (interfaces: NotificationUseCase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_notification_usecase.go -package=mocks github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/grpc NotificationUseCase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	proto "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/notification_service/proto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationUseCase is a mock of NotificationUseCase interface.
+type MockNotificationUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationUseCaseMockRecorder is the mock recorder for MockNotificationUseCase.
+type MockNotificationUseCaseMockRecorder struct {
+	mock *MockNotificationUseCase
+}
+
+// NewMockNotificationUseCase creates a new mock instance.
+func NewMockNotificationUseCase(ctrl *gomock.Controller) *MockNotificationUseCase {
+	mock := &MockNotificationUseCase{ctrl: ctrl}
+	mock.recorder = &MockNotificationUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationUseCase) EXPECT() *MockNotificationUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListNotifications mocks base method.
+func (m *MockNotificationUseCase) ListNotifications(ctx context.Context, userID int, unreadOnly bool) (*proto.ListNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotifications", ctx, userID, unreadOnly)
+	ret0, _ := ret[0].(*proto.ListNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockNotificationUseCaseMockRecorder) ListNotifications(ctx, userID, unreadOnly any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).ListNotifications), ctx, userID, unreadOnly)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockNotificationUseCase) MarkAsRead(ctx context.Context, userID int, ids []int) (*proto.MarkAsReadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsRead", ctx, userID, ids)
+	ret0, _ := ret[0].(*proto.MarkAsReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockNotificationUseCaseMockRecorder) MarkAsRead(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockNotificationUseCase)(nil).MarkAsRead), ctx, userID, ids)
+}
@@ -42,7 +42,26 @@ type UpdateAccountRequest struct {
 	Balance     *float64 `json:"balance,omitempty" validate:"min=0"`
 }
 
-type AddUserToAccountRequest struct {
-	UserLogin string `json:"user_login"`
+// CreateAccountInvitationRequest без user_login создается одноразовая ссылка-приглашение.
+// role — editor (по умолчанию) или viewer
+type CreateAccountInvitationRequest struct {
 	AccountID int    `json:"account_id"`
+	UserLogin string `json:"user_login,omitempty"`
+	Role      string `json:"role,omitempty"`
+}
+
+type AcceptInvitationLinkRequest struct {
+	Token string `json:"token"`
+}
+
+// AccountMemberRequest участник счета задается по user_id.
+// Role нужна только при смене роли: editor или viewer.
+type AccountMemberRequest struct {
+	AccountID int    `json:"account_id"`
+	UserID    int    `json:"user_id"`
+	Role      string `json:"role,omitempty"`
+}
+
+type LeaveAccountRequest struct {
+	AccountID int `json:"account_id"`
 }
@@ -0,0 +1,121 @@
+package models
+
+import "time"
+
+// BackupFormatVersion версия формата архива резервной копии.
+// Увеличивается при несовместимых изменениях структуры файлов архива.
+const BackupFormatVersion = 1
+
+type BackupManifest struct {
+	Version   int       `json:"version"`
+	BackupID  string    `json:"backup_id"`
+	UserID    int       `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BackupProfile struct {
+	FirstName    string    `json:"first_name"`
+	LastName     string    `json:"last_name"`
+	Email        string    `json:"email"`
+	Login        string    `json:"login"`
+	Description  string    `json:"description,omitempty"`
+	LogoHashedID string    `json:"logo_hashed_id,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type BackupAccount struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Balance     float64   `json:"balance"`
+	Type        string    `json:"type"`
+	CurrencyID  int       `json:"currency_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type BackupCategory struct {
+	ID           int       `json:"id"`
+	ParentID     int       `json:"parent_id,omitempty"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	LogoHashedID string    `json:"logo_hashed_id,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type BackupOperation struct {
+	ID           int       `json:"id"`
+	AccountID    int       `json:"account_id"`
+	AccountToID  int       `json:"account_to_id,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	CategoryID   int       `json:"category_id,omitempty"`
+	CategoryName string    `json:"category_name,omitempty"`
+	Type         string    `json:"type"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	ReceiptURL   string    `json:"receipt_url,omitempty"`
+	Sum          float64   `json:"sum"`
+	CurrencyID   int       `json:"currency_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	Date         time.Time `json:"date"`
+}
+
+// BackupSharing участник счета пользователя. При восстановлении находится по
+// логину, незарегистрированные на инстансе участники пропускаются.
+type BackupSharing struct {
+	AccountID int       `json:"account_id"`
+	UserLogin string    `json:"user_login"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BackupReceiver struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	LogoHashedID string    `json:"logo_hashed_id,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type BackupFinance struct {
+	Accounts   []BackupAccount   `json:"accounts"`
+	Categories []BackupCategory  `json:"categories"`
+	Operations []BackupOperation `json:"operations"`
+	Receivers  []BackupReceiver  `json:"receivers"`
+	Sharings   []BackupSharing   `json:"sharings"`
+}
+
+type BackupBudget struct {
+	ID            int        `json:"id"`
+	Amount        float64    `json:"sum"`
+	CurrencyID    int        `json:"currency_id"`
+	Description   string     `json:"description,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	ClosedAt      *time.Time `json:"closed_at,omitempty"`
+	PeriodStart   time.Time  `json:"period_start"`
+	PeriodEnd     time.Time  `json:"period_end"`
+	CategoryIDs   []int      `json:"category_ids,omitempty"`
+	AccountIDs    []int      `json:"account_ids,omitempty"`
+	Recurrence    string     `json:"recurrence,omitempty"`
+	RecurrenceDay int        `json:"recurrence_day,omitempty"`
+	CarryOver     bool       `json:"carry_over,omitempty"`
+	CarriedOver   float64    `json:"carried_over,omitempty"`
+}
+
+// Backup содержимое архива резервной копии, разложенное по файлам.
+type Backup struct {
+	Manifest BackupManifest
+	Profile  BackupProfile
+	Finance  BackupFinance
+	Budgets  []BackupBudget
+	Logos    map[string][]byte
+}
+
+type RestoreResponse struct {
+	BackupID           string `json:"backup_id"`
+	ProfileRestored    bool   `json:"profile_restored"`
+	AccountsRestored   int    `json:"accounts_restored"`
+	CategoriesRestored int    `json:"categories_restored"`
+	OperationsRestored int    `json:"operations_restored"`
+	ReceiversRestored  int    `json:"receivers_restored"`
+	BudgetsRestored    int    `json:"budgets_restored"`
+	LogosRestored      int    `json:"logos_restored"`
+}
@@ -9,7 +9,7 @@ type Budget struct {
 	ID          int       `json:"id"`
 	UserID      int       `json:"user_id"`
 	Amount      float64   `json:"sum"`
-	Actual      float64   // Фактические расходы (вычисляемое поле)
+	Actual      float64   `json:"actual"` // Фактические расходы (вычисляемое поле)
 	CurrencyID  int       `json:"currency_id"`
 	Description string    `json:"description"`
 	CreatedAt   time.Time `json:"created_at"`
@@ -17,6 +17,22 @@ type Budget struct {
 	ClosedAt    time.Time `json:"closed_at"`
 	PeriodStart time.Time `json:"period_start"`
 	PeriodEnd   time.Time `json:"period_end"`
+	// Пустые списки — бюджет по всем категориям и счетам
+	CategoryIDs []int                    `json:"category_ids,omitempty"`
+	AccountIDs  []int                    `json:"account_ids,omitempty"`
+	Categories  []BudgetCategoryProgress `json:"categories,omitempty"`
+	// Повторение: none, weekly или monthly
+	Recurrence    string  `json:"recurrence,omitempty"`
+	RecurrenceDay int     `json:"recurrence_day,omitempty"`
+	CarryOver     bool    `json:"carry_over,omitempty"`
+	CarriedOver   float64 `json:"carried_over,omitempty"`
+	SeriesID      int     `json:"series_id,omitempty"`
+}
+
+// BudgetCategoryProgress расходы по категории бюджета вместе с ее подкатегориями.
+type BudgetCategoryProgress struct {
+	CategoryID int     `json:"category_id"`
+	Actual     float64 `json:"actual"`
 }
 
 type CreateBudgetRequest struct {
@@ -25,6 +41,55 @@ type CreateBudgetRequest struct {
 	CreatedAt   time.Time `json:"created_at"`
 	PeriodStart time.Time `json:"period_start"`
 	PeriodEnd   time.Time `json:"period_end"`
+	CategoryIDs []int     `json:"category_ids,omitempty" validate:"omitempty,dive,gt=0"`
+	AccountIDs  []int     `json:"account_ids,omitempty" validate:"omitempty,dive,gt=0"`
+	// Recurrence включает автоматическое создание следующего периода.
+	// RecurrenceDay — день начала периода: день недели (1 — понедельник) для weekly
+	// и число месяца для monthly; по умолчанию берется из period_start.
+	Recurrence    string `json:"recurrence,omitempty" validate:"omitempty,oneof=none weekly monthly"`
+	RecurrenceDay int    `json:"recurrence_day,omitempty" validate:"min=0,max=31"`
+	// CarryOver переносит неизрасходованный остаток в следующий период
+	CarryOver bool `json:"carry_over,omitempty"`
+}
+
+// BudgetPeriod план и факт одного периода повторяющегося бюджета.
+type BudgetPeriod struct {
+	BudgetID    int        `json:"budget_id"`
+	PeriodStart time.Time  `json:"period_start"`
+	PeriodEnd   time.Time  `json:"period_end"`
+	Planned     float64    `json:"planned"`
+	Actual      float64    `json:"actual"`
+	Remaining   float64    `json:"remaining"`
+	CarriedOver float64    `json:"carried_over"`
+	ClosedAt    *time.Time `json:"closed_at,omitempty"`
+}
+
+type BudgetHistoryResponse struct {
+	Periods []BudgetPeriod `json:"periods"`
+}
+
+// BudgetForecastItem регулярный расход, который ожидается до конца периода.
+type BudgetForecastItem struct {
+	Name       string    `json:"name"`
+	CategoryID int       `json:"category_id,omitempty"`
+	Sum        float64   `json:"sum"`
+	Date       time.Time `json:"date"`
+}
+
+// BudgetForecast прогноз расходов бюджета на конец текущего периода.
+// ExceedDate не задан, если бюджет не будет превышен до конца периода.
+type BudgetForecast struct {
+	BudgetID    int                  `json:"budget_id"`
+	PeriodStart time.Time            `json:"period_start"`
+	PeriodEnd   time.Time            `json:"period_end"`
+	AsOf        time.Time            `json:"as_of"`
+	Planned     float64              `json:"planned"`
+	Actual      float64              `json:"actual"`
+	Projected   float64              `json:"projected"`
+	DailyPace   float64              `json:"daily_pace"`
+	WillExceed  bool                 `json:"will_exceed"`
+	ExceedDate  *time.Time           `json:"exceed_date,omitempty"`
+	Recurring   []BudgetForecastItem `json:"recurring"`
 }
 
 type UpdatedBudgetRequest struct {
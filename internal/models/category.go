@@ -5,6 +5,7 @@ import "time"
 type Category struct {
 	ID           int       `json:"id"`
 	UserID       int       `json:"user_id"`
+	ParentID     int       `json:"parent_id,omitempty"`
 	Name         string    `json:"name"`
 	Description  string    `json:"description,omitempty"`
 	LogoHashedID string    `json:"logo_hashed_id,omitempty"`
@@ -14,12 +15,14 @@ type Category struct {
 }
 
 type CreateCategoryRequest struct {
+	ParentID     int    `json:"parent_id,omitempty" validate:"gte=0"`
 	Name         string `json:"name" validate:"required,max=30"`
 	Description  string `json:"description,omitempty" validate:"max=60"`
 	LogoHashedID string `json:"logo_hashed_id,omitempty"`
 }
 
 type UpdateCategoryRequest struct {
+	ParentID     *int    `json:"parent_id,omitempty" validate:"omitempty,gte=0"`
 	Name         *string `json:"name,omitempty" validate:"omitempty,max=30"`
 	Description  *string `json:"description,omitempty" validate:"omitempty,max=60"`
 	LogoHashedID *string `json:"logo_hashed_id,omitempty"`
@@ -27,7 +30,8 @@ type UpdateCategoryRequest struct {
 
 type CategoryWithStats struct {
 	Category
-	OperationsCount int `json:"operations_count"`
+	OperationsCount    int `json:"operations_count"`
+	OwnOperationsCount int `json:"own_operations_count"`
 }
 
 type CreateCategoryReportRequest struct {
@@ -36,10 +40,13 @@ type CreateCategoryReportRequest struct {
 }
 
 type CategoryInReport struct {
-	CategoryID     int     `json:"category_id"`
-	CategoryName   string  `json:"category_name"`
-	OperationCount int     `json:"operation_count"`
-	TotalSum       float64 `json:"total_sum"`
+	CategoryID        int     `json:"category_id"`
+	ParentID          int     `json:"parent_id,omitempty"`
+	CategoryName      string  `json:"category_name"`
+	OperationCount    int     `json:"operation_count"`
+	TotalSum          float64 `json:"total_sum"`
+	OwnOperationCount int     `json:"own_operation_count"`
+	OwnSum            float64 `json:"own_sum"`
 }
 
 type CategoryReport struct {
@@ -47,3 +54,16 @@ type CategoryReport struct {
 	Start      time.Time          `json:"start_period"`
 	End        time.Time          `json:"end_period"`
 }
+
+type MergeCategoriesRequest struct {
+	TargetID int `json:"target_id" validate:"required,gt=0"`
+}
+
+type MergeCategoriesResponse struct {
+	Target          Category `json:"target"`
+	MovedOperations int      `json:"moved_operations"`
+}
+
+type ResetDefaultCategoriesResponse struct {
+	Categories []Category `json:"categories"`
+}
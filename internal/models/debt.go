@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+// Debt долг. Direction: lent — пользователь дал в долг, borrowed — взял в долг.
+type Debt struct {
+	ID                 int        `json:"id"`
+	CounterpartyID     int        `json:"counterparty_id"`
+	CounterpartyName   string     `json:"counterparty_name"`
+	CounterpartyUserID int        `json:"counterparty_user_id,omitempty"`
+	Direction          string     `json:"direction"`
+	AccountID          int        `json:"account_id,omitempty"`
+	CurrencyID         int        `json:"currency_id"`
+	Principal          float64    `json:"principal"`
+	Repaid             float64    `json:"repaid"`
+	Outstanding        float64    `json:"outstanding"`
+	Description        string     `json:"description,omitempty"`
+	DueDate            *time.Time `json:"due_date,omitempty"`
+	Closed             bool       `json:"closed"`
+	Overdue            bool       `json:"overdue"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+type DebtsResponse struct {
+	Debts []Debt `json:"debts"`
+}
+
+// CreateDebtRequest контрагент задается ровно одним из полей: counterparty_id,
+// counterparty_name (создается, если его нет) или counterparty_login (зарегистрированный пользователь).
+type CreateDebtRequest struct {
+	CounterpartyID    int        `json:"counterparty_id,omitempty" validate:"min=0"`
+	CounterpartyName  string     `json:"counterparty_name,omitempty" validate:"max=60"`
+	CounterpartyLogin string     `json:"counterparty_login,omitempty"`
+	Direction         string     `json:"direction" validate:"required,oneof=lent borrowed"`
+	AccountID         int        `json:"account_id" validate:"required,gt=0"`
+	Principal         float64    `json:"principal" validate:"gt=0"`
+	Description       string     `json:"description,omitempty" validate:"max=100"`
+	DueDate           *time.Time `json:"due_date,omitempty"`
+	// Date — дата операции выдачи долга, по умолчанию текущая
+	Date *time.Time `json:"date,omitempty"`
+}
+
+// CreateDebtRepaymentRequest возврат долга. По умолчанию операция создается на счете долга.
+type CreateDebtRepaymentRequest struct {
+	AccountID int        `json:"account_id,omitempty" validate:"min=0"`
+	Amount    float64    `json:"amount" validate:"gt=0"`
+	Date      *time.Time `json:"date,omitempty"`
+}
+
+// DebtPayment операция по долгу: выдача (principal) или возврат (repayment).
+type DebtPayment struct {
+	ID          int       `json:"id"`
+	DebtID      int       `json:"debt_id"`
+	OperationID int       `json:"operation_id"`
+	Kind        string    `json:"kind"`
+	Amount      float64   `json:"amount"`
+	AccountID   int       `json:"account_id,omitempty"`
+	Reverted    bool      `json:"reverted"`
+	Date        time.Time `json:"date"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type DebtPaymentsResponse struct {
+	Payments []DebtPayment `json:"payments"`
+}
+
+// CounterpartyBalance непогашенные долги в одной валюте.
+// Net > 0 — контрагент должен пользователю, Net < 0 — пользователь контрагенту.
+type CounterpartyBalance struct {
+	CurrencyID int     `json:"currency_id"`
+	Lent       float64 `json:"lent"`
+	Borrowed   float64 `json:"borrowed"`
+	Net        float64 `json:"net"`
+}
+
+type Counterparty struct {
+	ID       int                   `json:"id"`
+	Name     string                `json:"name"`
+	UserID   int                   `json:"user_id,omitempty"`
+	LogoURL  string                `json:"logo_url,omitempty"`
+	Balances []CounterpartyBalance `json:"balances"`
+}
+
+type CounterpartiesResponse struct {
+	Counterparties []Counterparty `json:"counterparties"`
+}
@@ -0,0 +1,24 @@
+package models
+
+// Шаги удаления аккаунта в порядке выполнения. В auth_service хранится
+// последний завершенный шаг, с него удаление продолжается после сбоя.
+const (
+	// DeletionStepCollect изображения пользователя собраны до удаления данных
+	DeletionStepCollect = "collect"
+	DeletionStepBudgets = "budgets"
+	DeletionStepFinance = "finance"
+	DeletionStepAuth    = "auth"
+	DeletionStepImages  = "images"
+	// DeletionStepDone удаление завершено
+	DeletionStepDone = "done"
+)
+
+// DeletionSteps шаги, которые выполняет шлюз, по порядку
+var DeletionSteps = []string{
+	DeletionStepCollect,
+	DeletionStepBudgets,
+	DeletionStepFinance,
+	DeletionStepAuth,
+	DeletionStepImages,
+	DeletionStepDone,
+}
@@ -18,33 +18,65 @@ const (
 	ErrCodeCategoryExists   ErrorCode = "CATEGORY_EXISTS"
 	ErrCodeSharingExists    ErrorCode = "SHARING_EXISTS"
 	ErrCodeCategoryNotFound ErrorCode = "CATEGORY_NOT_FOUND"
+	ErrCodeParentNotFound   ErrorCode = "PARENT_CATEGORY_NOT_FOUND"
+	ErrCodeCategoryCycle    ErrorCode = "CATEGORY_CYCLE"
 
 	ErrCodeUserNotFound       ErrorCode = "USER_NOT_FOUND"
 	ErrCodeInvalidCredentials ErrorCode = "INVALID_CREDENTIALS"
 	ErrCodeAccountLocked      ErrorCode = "ACCOUNT_LOCKED"
 
+	ErrCodeEmailNotVerified     ErrorCode = "EMAIL_NOT_VERIFIED"
+	ErrCodeEmailAlreadyVerified ErrorCode = "EMAIL_ALREADY_VERIFIED"
+
+	ErrCodeMFANotEnabled     ErrorCode = "MFA_NOT_ENABLED"
+	ErrCodeMFAAlreadyEnabled ErrorCode = "MFA_ALREADY_ENABLED"
+	ErrCodeMFACodeInvalid    ErrorCode = "MFA_CODE_INVALID"
+
 	ErrCodeTokenExpired ErrorCode = "TOKEN_EXPIRED"
 	ErrCodeTokenInvalid ErrorCode = "TOKEN_INVALID"
 	ErrCodeTokenMissing ErrorCode = "TOKEN_MISSING"
 
+	ErrCodePersonalTokenNotFound ErrorCode = "PERSONAL_TOKEN_NOT_FOUND"
+	ErrCodeInvalidScope          ErrorCode = "INVALID_SCOPE"
+	ErrCodeInsufficientScope     ErrorCode = "INSUFFICIENT_SCOPE"
+
+	ErrCodeAccountDeletionNotFound ErrorCode = "ACCOUNT_DELETION_NOT_FOUND"
+	ErrCodeAccountDeletionStarted  ErrorCode = "ACCOUNT_DELETION_STARTED"
+
+	ErrCodeSessionRevoked  ErrorCode = "SESSION_REVOKED"
+	ErrCodeSessionNotFound ErrorCode = "SESSION_NOT_FOUND"
+
 	ErrCodeUnauthorized ErrorCode = "UNAUTHORIZED"
 	ErrCodeForbidden    ErrorCode = "FORBIDDEN"
 	ErrCodeAccessDenied ErrorCode = "ACCESS_DENIED"
 
-	ErrCodeResourceNotFound    ErrorCode = "RESOURCE_NOT_FOUND"
-	ErrCodeResourceExists      ErrorCode = "RESOURCE_EXISTS"
-	ErrCodeResourceConflict    ErrorCode = "RESOURCE_CONFLICT"
-	ErrCodeBudgetNotFound      ErrorCode = "BUDGET_NOT_FOUND"
-	ErrCodeAccountNotFound     ErrorCode = "ACCOUNT_NOT_FOUND"
-	ErrCodeTransactionNotFound ErrorCode = "OPERATION_NOT_FOUND"
-	ErrCodeNegaticeBalance     ErrorCode = "NEGATIVE_BALANCE"
-	ErrCodePrivateAccount      ErrorCode = "PRIVATE_ACCOUNT"
+	ErrCodeResourceNotFound     ErrorCode = "RESOURCE_NOT_FOUND"
+	ErrCodeResourceExists       ErrorCode = "RESOURCE_EXISTS"
+	ErrCodeResourceConflict     ErrorCode = "RESOURCE_CONFLICT"
+	ErrCodeBudgetNotFound       ErrorCode = "BUDGET_NOT_FOUND"
+	ErrCodeAccountNotFound      ErrorCode = "ACCOUNT_NOT_FOUND"
+	ErrCodeTransactionNotFound  ErrorCode = "OPERATION_NOT_FOUND"
+	ErrCodeNegaticeBalance      ErrorCode = "NEGATIVE_BALANCE"
+	ErrCodePrivateAccount       ErrorCode = "PRIVATE_ACCOUNT"
+	ErrCodeRuleNotFound         ErrorCode = "RULE_NOT_FOUND"
+	ErrCodeNotificationNotFound ErrorCode = "NOTIFICATION_NOT_FOUND"
+	ErrCodeGoalNotFound         ErrorCode = "GOAL_NOT_FOUND"
+	ErrCodeContributionExists   ErrorCode = "CONTRIBUTION_EXISTS"
+	ErrCodeDebtNotFound         ErrorCode = "DEBT_NOT_FOUND"
+	ErrCodeReceiverNotFound     ErrorCode = "RECEIVER_NOT_FOUND"
+	ErrCodeMemberNotFound       ErrorCode = "MEMBER_NOT_FOUND"
+	ErrCodeOwnerCannotLeave     ErrorCode = "OWNER_CANNOT_LEAVE"
+	ErrCodeInvitationNotFound   ErrorCode = "INVITATION_NOT_FOUND"
+	ErrCodeInvitationExpired    ErrorCode = "INVITATION_EXPIRED"
+	ErrCodeInvitationExists     ErrorCode = "INVITATION_EXISTS"
+	ErrCodeSplitNotFound        ErrorCode = "SPLIT_NOT_FOUND"
 
 	ErrCodeInvalidAmount   ErrorCode = "INVALID_AMOUNT"
 	ErrCodeInvalidCurrency ErrorCode = "INVALID_CURRENCY"
 	ErrCodeInvalidDate     ErrorCode = "INVALID_DATE"
 	ErrCodeInvalidPeriod   ErrorCode = "INVALID_PERIOD"
 	ErrCodeInvalidData     ErrorCode = "INVALID_DATA"
+	ErrCodeInvalidBackup   ErrorCode = "INVALID_BACKUP"
 
 	ErrCodeInternalError      ErrorCode = "INTERNAL_ERROR"
 	ErrCodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
@@ -86,10 +118,27 @@ func (e ErrorCode) GetErrorMessage() string {
 		ErrCodeInvalidCredentials: "Неверные учетные данные",
 		ErrCodeAccountLocked:      "Аккаунт заблокирован",
 
+		ErrCodeEmailNotVerified:     "Подтвердите email, чтобы выполнить это действие",
+		ErrCodeEmailAlreadyVerified: "Email уже подтвержден",
+
+		ErrCodeMFANotEnabled:     "Двухфакторная аутентификация не включена",
+		ErrCodeMFAAlreadyEnabled: "Двухфакторная аутентификация уже включена",
+		ErrCodeMFACodeInvalid:    "Неверный код подтверждения",
+
 		ErrCodeTokenExpired: "Токен истек",
 		ErrCodeTokenInvalid: "Недействительный токен",
 		ErrCodeTokenMissing: "Токен отсутствует",
 
+		ErrCodePersonalTokenNotFound: "Токен доступа не найден",
+		ErrCodeInvalidScope:          "Неизвестная область доступа",
+		ErrCodeInsufficientScope:     "У токена нет доступа к этому действию",
+
+		ErrCodeAccountDeletionNotFound: "Удаление аккаунта не запланировано",
+		ErrCodeAccountDeletionStarted:  "Удаление аккаунта уже началось, отменить его нельзя",
+
+		ErrCodeSessionRevoked:  "Сессия завершена, войдите снова",
+		ErrCodeSessionNotFound: "Сессия не найдена",
+
 		ErrCodeUnauthorized: "Требуется авторизация",
 		ErrCodeForbidden:    "Доступ запрещен",
 		ErrCodeAccessDenied: "Доступ отклонен",
@@ -100,11 +149,27 @@ func (e ErrorCode) GetErrorMessage() string {
 		ErrCodeBudgetNotFound:      "Бюджет не найден",
 		ErrCodeAccountNotFound:     "Счет не найден",
 		ErrCodeTransactionNotFound: "Операция не найдена",
+		ErrCodeRuleNotFound:        "Правило категоризации не найдено",
+		ErrCodeParentNotFound:      "Родительская категория не найдена",
+		ErrCodeCategoryCycle:       "Категория не может быть вложена в свою подкатегорию",
+		ErrCodeGoalNotFound:        "Цель не найдена",
+		ErrCodeContributionExists:  "Операция уже учтена во взносах цели",
+		ErrCodeDebtNotFound:        "Долг не найден",
+		ErrCodeReceiverNotFound:    "Контрагент не найден",
+		ErrCodeMemberNotFound:      "Участник счета не найден",
+		ErrCodeOwnerCannotLeave:    "Владелец не может покинуть счет, сначала передайте права другому участнику",
+		ErrCodeInvitationNotFound:  "Приглашение не найдено",
+		ErrCodeInvitationExpired:   "Срок действия приглашения истек",
+		ErrCodeInvitationExists:    "Пользователь уже приглашен к счету",
+		ErrCodeSharingExists:       "Пользователь уже участвует в счете",
+		ErrCodeSplitNotFound:       "Операция не разделена между участниками",
+		ErrCodePrivateAccount:      "К приватному счету нельзя приглашать участников",
 
 		ErrCodeInvalidAmount:   "Некорректная сумма",
 		ErrCodeInvalidCurrency: "Некорректная валюта",
 		ErrCodeInvalidDate:     "Некорректная дата",
 		ErrCodeInvalidPeriod:   "Некорректный период",
+		ErrCodeInvalidBackup:   "Некорректный архив резервной копии",
 
 		ErrCodeInternalError:      "Внутренняя ошибка сервера",
 		ErrCodeServiceUnavailable: "Сервис недоступен",
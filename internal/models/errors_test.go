@@ -90,11 +90,21 @@ func TestErrorCode_GetErrorMessage(t *testing.T) {
 		{"UserNotFound", ErrCodeUserNotFound, "Пользователь не найден"},
 		{"InvalidCredentials", ErrCodeInvalidCredentials, "Неверные учетные данные"},
 		{"AccountLocked", ErrCodeAccountLocked, "Аккаунт заблокирован"},
+		{"EmailNotVerified", ErrCodeEmailNotVerified, "Подтвердите email, чтобы выполнить это действие"},
+		{"EmailAlreadyVerified", ErrCodeEmailAlreadyVerified, "Email уже подтвержден"},
+		{"MFANotEnabled", ErrCodeMFANotEnabled, "Двухфакторная аутентификация не включена"},
+		{"MFAAlreadyEnabled", ErrCodeMFAAlreadyEnabled, "Двухфакторная аутентификация уже включена"},
+		{"MFACodeInvalid", ErrCodeMFACodeInvalid, "Неверный код подтверждения"},
 
 		// Token errors
 		{"TokenExpired", ErrCodeTokenExpired, "Токен истек"},
 		{"TokenInvalid", ErrCodeTokenInvalid, "Недействительный токен"},
 		{"TokenMissing", ErrCodeTokenMissing, "Токен отсутствует"},
+		{"PersonalTokenNotFound", ErrCodePersonalTokenNotFound, "Токен доступа не найден"},
+		{"InvalidScope", ErrCodeInvalidScope, "Неизвестная область доступа"},
+		{"InsufficientScope", ErrCodeInsufficientScope, "У токена нет доступа к этому действию"},
+		{"AccountDeletionNotFound", ErrCodeAccountDeletionNotFound, "Удаление аккаунта не запланировано"},
+		{"AccountDeletionStarted", ErrCodeAccountDeletionStarted, "Удаление аккаунта уже началось, отменить его нельзя"},
 
 		// Authorization errors
 		{"Unauthorized", ErrCodeUnauthorized, "Требуется авторизация"},
@@ -148,9 +158,19 @@ func TestErrorCode_GetErrorMessage_AllCodes(t *testing.T) {
 		ErrCodeUserNotFound,
 		ErrCodeInvalidCredentials,
 		ErrCodeAccountLocked,
+		ErrCodeEmailNotVerified,
+		ErrCodeEmailAlreadyVerified,
+		ErrCodeMFANotEnabled,
+		ErrCodeMFAAlreadyEnabled,
+		ErrCodeMFACodeInvalid,
 		ErrCodeTokenExpired,
 		ErrCodeTokenInvalid,
 		ErrCodeTokenMissing,
+		ErrCodePersonalTokenNotFound,
+		ErrCodeInvalidScope,
+		ErrCodeInsufficientScope,
+		ErrCodeAccountDeletionNotFound,
+		ErrCodeAccountDeletionStarted,
 		ErrCodeUnauthorized,
 		ErrCodeForbidden,
 		ErrCodeAccessDenied,
@@ -0,0 +1,78 @@
+package models
+
+import "time"
+
+// Goal цель накоплений. Если указан AccountID, накопленная сумма — баланс этого счета,
+// иначе — сумма взносов.
+type Goal struct {
+	ID           int        `json:"id"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	TargetAmount float64    `json:"target_amount"`
+	CurrencyID   int        `json:"currency_id"`
+	Deadline     *time.Time `json:"deadline,omitempty"`
+	AccountID    int        `json:"account_id,omitempty"`
+	LogoHashedID string     `json:"logo_hashed_id,omitempty"`
+	LogoURL      string     `json:"logo_url,omitempty"`
+	Saved        float64    `json:"saved"`
+	Remaining    float64    `json:"remaining"`
+	Percent      float64    `json:"percent"`
+	Achieved     bool       `json:"achieved"`
+	Overdue      bool       `json:"overdue"`
+	// MonthsLeft — сколько ежемесячных взносов осталось до срока, включая текущий месяц
+	MonthsLeft          int       `json:"months_left"`
+	MonthlyContribution float64   `json:"monthly_contribution"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+type GoalsResponse struct {
+	Goals []Goal `json:"goals"`
+}
+
+type CreateGoalRequest struct {
+	Name         string     `json:"name" validate:"required,max=60"`
+	Description  string     `json:"description,omitempty" validate:"max=250"`
+	TargetAmount float64    `json:"target_amount" validate:"gt=0"`
+	CurrencyID   int        `json:"currency_id" validate:"required,gt=0"`
+	Deadline     *time.Time `json:"deadline,omitempty"`
+	AccountID    int        `json:"account_id,omitempty" validate:"min=0"`
+	// LogoHashedID — ID картинки, загруженной через /images/upload
+	LogoHashedID string `json:"logo_hashed_id,omitempty"`
+}
+
+// UpdateGoalRequest изменяет только переданные поля.
+// ClearDeadline снимает срок, ClearAccount переводит цель на ручные взносы.
+type UpdateGoalRequest struct {
+	Name          *string    `json:"name,omitempty" validate:"omitempty,min=1,max=60"`
+	Description   *string    `json:"description,omitempty" validate:"omitempty,max=250"`
+	TargetAmount  *float64   `json:"target_amount,omitempty" validate:"omitempty,gt=0"`
+	Deadline      *time.Time `json:"deadline,omitempty"`
+	ClearDeadline bool       `json:"clear_deadline,omitempty"`
+	AccountID     *int       `json:"account_id,omitempty" validate:"omitempty,gt=0"`
+	ClearAccount  bool       `json:"clear_account,omitempty"`
+	LogoHashedID  *string    `json:"logo_hashed_id,omitempty"`
+}
+
+type GoalContribution struct {
+	ID            int       `json:"id"`
+	GoalID        int       `json:"goal_id"`
+	OperationID   int       `json:"operation_id,omitempty"`
+	Amount        float64   `json:"amount"`
+	Note          string    `json:"note,omitempty"`
+	ContributedAt time.Time `json:"contributed_at"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type GoalContributionsResponse struct {
+	Contributions []GoalContribution `json:"contributions"`
+}
+
+// CreateGoalContributionRequest взнос в цель. Если указана операция, по умолчанию
+// взнос равен ее сумме и датируется днем операции; отрицательная сумма — снятие накоплений.
+type CreateGoalContributionRequest struct {
+	OperationID   int        `json:"operation_id,omitempty" validate:"min=0"`
+	Amount        float64    `json:"amount,omitempty"`
+	Note          string     `json:"note,omitempty" validate:"max=120"`
+	ContributedAt *time.Time `json:"contributed_at,omitempty"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Notification уведомление пользователя о расходах по бюджету.
+type Notification struct {
+	ID        int        `json:"id"`
+	Kind      string     `json:"kind"`
+	BudgetID  int        `json:"budget_id,omitempty"`
+	Threshold int        `json:"threshold"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+}
+
+type NotificationsResponse struct {
+	Notifications []Notification `json:"notifications"`
+	Unread        int            `json:"unread"`
+}
+
+// MarkNotificationsReadRequest пустой IDs — отметить прочитанными все уведомления.
+type MarkNotificationsReadRequest struct {
+	IDs []int `json:"ids" validate:"omitempty,dive,gt=0"`
+}
+
+type MarkNotificationsReadResponse struct {
+	Updated int `json:"updated"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+type CategoryRule struct {
+	ID            int       `json:"id"`
+	CategoryID    int       `json:"category_id"`
+	Priority      int       `json:"priority"`
+	NameContains  string    `json:"name_contains,omitempty"`
+	OperationType string    `json:"operation_type,omitempty"`
+	AmountGT      *float64  `json:"amount_gt,omitempty"`
+	AmountLT      *float64  `json:"amount_lt,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// CategoryRuleRequest используется и при создании, и при обновлении правила:
+// обновление полностью заменяет условия.
+type CategoryRuleRequest struct {
+	CategoryID    int      `json:"category_id" validate:"required,gt=0"`
+	NameContains  string   `json:"name_contains,omitempty" validate:"max=50"`
+	OperationType string   `json:"operation_type,omitempty" validate:"omitempty,oneof=income expense"`
+	AmountGT      *float64 `json:"amount_gt,omitempty" validate:"omitempty,gte=0"`
+	AmountLT      *float64 `json:"amount_lt,omitempty" validate:"omitempty,gt=0"`
+}
+
+type ReorderCategoryRulesRequest struct {
+	RuleIDs []int `json:"rule_ids" validate:"required,min=1"`
+}
+
+type TestCategoryRulesRequest struct {
+	Name string  `json:"name" validate:"required,max=50"`
+	Sum  float64 `json:"sum" validate:"gt=0"`
+	Type string  `json:"type" validate:"required,oneof=income expense"`
+}
+
+type TestCategoryRulesResponse struct {
+	Matched bool          `json:"matched"`
+	Rule    *CategoryRule `json:"rule,omitempty"`
+}
+
+type ApplyCategoryRulesRequest struct {
+	Overwrite bool `json:"overwrite"`
+}
+
+type ApplyCategoryRulesResponse struct {
+	Checked       int `json:"checked"`
+	Recategorized int `json:"recategorized"`
+}
+
+type CategorySuggestion struct {
+	Found        bool    `json:"found"`
+	CategoryID   int     `json:"category_id,omitempty"`
+	CategoryName string  `json:"category_name,omitempty"`
+	Confidence   float64 `json:"confidence,omitempty"`
+	Source       string  `json:"source,omitempty"`
+}
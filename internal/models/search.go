@@ -8,6 +8,7 @@ const (
 	WRITE  string = "create"
 	DELETE string = "delete"
 	UPDATE string = "update"
+	MERGE  string = "merge"
 )
 
 const (
@@ -36,6 +37,7 @@ type TransactionSearch struct {
 
 type UpdateCategoryInOperationSearch struct {
 	CategoryID           int    `json:"category_id"`
+	SourceCategoryID     int    `json:"source_category_id,omitempty"`
 	CategoryName         string `json:"category_name"`
 	CategoryLogoHashedID string `json:"category_logo_hashed_id"`
 	CategoryLogo         string `json:"category_logo"`
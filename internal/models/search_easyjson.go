@@ -38,6 +38,8 @@ func easyjsonD4176298DecodeGithubComGoParkMailRu20252VKarmaneInternalModels(in *
 		switch key {
 		case "category_id":
 			out.CategoryID = int(in.Int())
+		case "source_category_id":
+			out.SourceCategoryID = int(in.Int())
 		case "category_name":
 			out.CategoryName = string(in.String())
 		case "category_logo_hashed_id":
@@ -65,6 +67,11 @@ func easyjsonD4176298EncodeGithubComGoParkMailRu20252VKarmaneInternalModels(out
 		out.RawString(prefix[1:])
 		out.Int(int(in.CategoryID))
 	}
+	if in.SourceCategoryID != 0 {
+		const prefix string = ",\"source_category_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.SourceCategoryID))
+	}
 	{
 		const prefix string = ",\"category_name\":"
 		out.RawString(prefix)
@@ -0,0 +1,13 @@
+package models
+
+// События сессий публикует auth_service, читает каждый экземпляр API,
+// чтобы сбросить локальный кеш сессий
+const (
+	SESSIONS        string = "sessions"
+	SESSION_REVOKED string = "session_revoked"
+)
+
+type SessionRevokedEvent struct {
+	UserID     int   `json:"user_id"`
+	SessionIDs []int `json:"session_ids"`
+}
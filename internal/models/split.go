@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// SplitShareRequest value — сумма доли для exact, процент для percent, для equal не нужна
+type SplitShareRequest struct {
+	UserID int     `json:"user_id"`
+	Value  float64 `json:"value,omitempty"`
+}
+
+// SplitOperationRequest method — equal, exact или percent.
+// Без shares при делении поровну расход делится на всех участников счета,
+// без payer_id плательщиком считается текущий пользователь.
+type SplitOperationRequest struct {
+	PayerID int                 `json:"payer_id,omitempty"`
+	Method  string              `json:"method"`
+	Shares  []SplitShareRequest `json:"shares,omitempty"`
+}
+
+type SplitShareResponse struct {
+	UserID    int     `json:"user_id"`
+	UserLogin string  `json:"user_login"`
+	Value     float64 `json:"value,omitempty"`
+	Amount    float64 `json:"amount"`
+}
+
+type OperationSplitResponse struct {
+	OperationID int                  `json:"operation_id"`
+	AccountID   int                  `json:"account_id"`
+	PayerID     int                  `json:"payer_id"`
+	PayerLogin  string               `json:"payer_login"`
+	Method      string               `json:"method"`
+	Total       float64              `json:"total"`
+	Shares      []SplitShareResponse `json:"shares"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+// CreateSettlementRequest без payer_id плательщиком считается текущий пользователь
+type CreateSettlementRequest struct {
+	PayerID     int     `json:"payer_id,omitempty"`
+	PayeeID     int     `json:"payee_id"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description,omitempty"`
+}
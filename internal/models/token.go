@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// Области доступа персональных токенов: <ресурс>:<read|write>.
+// Запросы с cookie-сессией ограничений по областям не имеют.
+const (
+	ScopeOperationsRead    = "operations:read"
+	ScopeOperationsWrite   = "operations:write"
+	ScopeAccountsRead      = "accounts:read"
+	ScopeAccountsWrite     = "accounts:write"
+	ScopeBudgetsRead       = "budgets:read"
+	ScopeBudgetsWrite      = "budgets:write"
+	ScopeCategoriesRead    = "categories:read"
+	ScopeCategoriesWrite   = "categories:write"
+	ScopeDebtsRead         = "debts:read"
+	ScopeDebtsWrite        = "debts:write"
+	ScopeNotificationsRead = "notifications:read"
+	ScopeProfileRead       = "profile:read"
+)
+
+// TokenScopes все области, которые можно выдать токену
+var TokenScopes = []string{
+	ScopeOperationsRead,
+	ScopeOperationsWrite,
+	ScopeAccountsRead,
+	ScopeAccountsWrite,
+	ScopeBudgetsRead,
+	ScopeBudgetsWrite,
+	ScopeCategoriesRead,
+	ScopeCategoriesWrite,
+	ScopeDebtsRead,
+	ScopeDebtsWrite,
+	ScopeNotificationsRead,
+	ScopeProfileRead,
+}
+
+func IsTokenScope(scope string) bool {
+	for _, s := range TokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type CreatePersonalTokenRequest struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1"`
+	// ExpiresAt пустой — токен бессрочный
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
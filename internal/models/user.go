@@ -2,6 +2,9 @@ package models
 
 import "time"
 
+// DefaultAvatarID аватар, который пользователь получает при регистрации; он общий для всех
+const DefaultAvatarID = "6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b"
+
 type User struct {
 	ID           int       `json:"id"`
 	FirstName    string    `json:"first_name"`
@@ -26,20 +29,69 @@ type RegisterRequest struct {
 	Password string `json:"password" validate:"required,min=6,max=100"`
 }
 
+type PasswordResetRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ConfirmEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ConfirmPasswordResetRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+// ChangePasswordRequest код 2FA обязателен, если она включена
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+	Code        string `json:"code,omitempty" validate:"max=32"`
+}
+
+// DeleteProfileRequest пароль и код 2FA, если она включена, подтверждают удаление аккаунта
+type DeleteProfileRequest struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code,omitempty" validate:"max=32"`
+}
+
+// AccountDeletionResponse срок удаления аккаунта и ссылка на выгрузку данных,
+// которой можно воспользоваться до удаления
+type AccountDeletionResponse struct {
+	ScheduledAt time.Time `json:"scheduled_at"`
+	ExportURL   string    `json:"export_url"`
+}
+
+// MFACodeRequest код из приложения-аутентификатора или резервный код
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required,max=32"`
+}
+
+type VerifyMFARequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required,max=32"`
+}
+
 type AuthResponse struct {
 	Token string `json:"token"`
 	User  User   `json:"user"`
+	// MFARequired пароль верный, но нужен второй фактор: токена и cookie
+	// еще нет, MFAToken обменивается на сессию в /auth/login/mfa
+	MFARequired bool   `json:"mfa_required,omitempty"`
+	MFAToken    string `json:"mfa_token,omitempty"`
 }
 
 type ProfileResponse struct {
-	ID           int       `json:"id"`
-	FirstName    string    `json:"first_name"`
-	LastName     string    `json:"last_name"`
-	Login        string    `json:"login"`
-	Email        string    `json:"email"`
-	LogoHashedID string    `json:"logo_hashed_id"`
-	LogoURL      string    `json:"logo_url,omitempty"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID            int       `json:"id"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	Login         string    `json:"login"`
+	Email         string    `json:"email"`
+	LogoHashedID  string    `json:"logo_hashed_id"`
+	LogoURL       string    `json:"logo_url,omitempty"`
+	EmailVerified bool      `json:"email_verified"`
+	PendingEmail  string    `json:"pending_email,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 type UpdateProfileRequest struct {
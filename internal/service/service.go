@@ -9,7 +9,7 @@ type Service struct {
 	ImageUC imageservice.ImageService
 }
 
-func NewService(jwtSecret string, imageStorage image.ImageStorage) *Service {
+func NewService(imageStorage image.ImageStorage) *Service {
 	imageService := imageservice.NewService(imageStorage)
 
 	return &Service{
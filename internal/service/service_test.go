@@ -28,8 +28,12 @@ func (stubStorage) ImageExists(ctx context.Context, objectName string) (bool, er
 	return true, nil
 }
 
+func (stubStorage) GetImage(ctx context.Context, objectName string) ([]byte, string, error) {
+	return nil, "", nil
+}
+
 func TestNewService(t *testing.T) {
-	service := NewService("secret", stubStorage{})
+	service := NewService(stubStorage{})
 	require.NotNil(t, service)
 	require.NotNil(t, service.ImageUC)
 }
@@ -12,7 +12,7 @@ type UseCase struct {
 	ImageUC *image.UseCase
 }
 
-func NewUseCase(service *service.Service, jwtSecret string) *UseCase {
+func NewUseCase(service *service.Service) *UseCase {
 	imageUC := image.NewUseCase(service.ImageUC)
 
 	return &UseCase{
@@ -30,12 +30,16 @@ func (fakeStorage) ImageExists(ctx context.Context, objectName string) (bool, er
 	return true, nil
 }
 
+func (fakeStorage) GetImage(ctx context.Context, objectName string) ([]byte, string, error) {
+	return nil, "", nil
+}
+
 func TestNewUseCase(t *testing.T) {
 	svc := &service.Service{
 		ImageUC: imageservice.NewService(fakeStorage{}),
 	}
 
-	uc := NewUseCase(svc, "secret")
+	uc := NewUseCase(svc)
 
 	require.Equal(t, svc, uc.service)
 	require.NotNil(t, uc.ImageUC)
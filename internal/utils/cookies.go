@@ -43,6 +43,45 @@ func GetAuthCookie(r *http.Request) (string, error) {
 	return cookie.Value, nil
 }
 
+// SetRefreshCookie refresh-токен нужен только эндпоинтам /auth
+func SetRefreshCookie(w http.ResponseWriter, token string, isProduction bool) {
+	cookie := &http.Cookie{
+		Name:     "refresh_token",
+		Value:    token,
+		Path:     "/api/v1/auth",
+		HttpOnly: true,
+		Secure:   isProduction,
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   int(RefreshTokenTTL.Seconds()),
+		Expires:  time.Now().Add(RefreshTokenTTL),
+	}
+
+	http.SetCookie(w, cookie)
+}
+
+func ClearRefreshCookie(w http.ResponseWriter, isProduction bool) {
+	cookie := &http.Cookie{
+		Name:     "refresh_token",
+		Value:    "",
+		Path:     "/api/v1/auth",
+		HttpOnly: true,
+		Secure:   isProduction,
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   -1,
+		Expires:  time.Unix(0, 0),
+	}
+
+	http.SetCookie(w, cookie)
+}
+
+func GetRefreshCookie(r *http.Request) (string, error) {
+	cookie, err := r.Cookie("refresh_token")
+	if err != nil {
+		return "", err
+	}
+	return cookie.Value, nil
+}
+
 func SetCSRFCookie(w http.ResponseWriter, token string, isProduction bool) {
 	cookie := &http.Cookie{
 		Name:     "csrf_token",
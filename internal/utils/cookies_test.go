@@ -29,3 +29,23 @@ func TestSetAndGetAndClearAuthCookie(t *testing.T) {
 	res2 := rr2.Result()
 	assert.NotEmpty(t, res2.Cookies())
 }
+
+func TestSetAndClearRefreshCookie(t *testing.T) {
+	rr := httptest.NewRecorder()
+	SetRefreshCookie(rr, "refresh", true)
+	c := rr.Result().Cookies()
+	require.NotEmpty(t, c)
+	assert.Equal(t, "refresh_token", c[0].Name)
+	assert.Equal(t, "/api/v1/auth", c[0].Path)
+	assert.True(t, c[0].Secure)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", nil)
+	req.AddCookie(c[0])
+	val, err := GetRefreshCookie(req)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh", val)
+
+	rr2 := httptest.NewRecorder()
+	ClearRefreshCookie(rr2, true)
+	assert.Equal(t, -1, rr2.Result().Cookies()[0].MaxAge)
+}
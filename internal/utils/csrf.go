@@ -38,7 +38,7 @@ func ValidateCSRF(tokenString string, secret string) (*ClaimsCSRF, error) {
 	claims := &ClaimsCSRF{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		return []byte(secret), nil
-	})
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,289 @@
+package deletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+const (
+	// DefaultInterval как часто шлюз забирает наступившие удаления
+	DefaultInterval = time.Minute
+	// lease сколько удаление закреплено за экземпляром шлюза; если он
+	// упал посреди удаления, после аренды удаление продолжит другой
+	lease     = 10 * time.Minute
+	batchSize = 10
+)
+
+// imageIDRe загруженные изображения; прочие значения logo_hashed_id —
+// заглушки из старых миграций, в хранилище их нет
+var imageIDRe = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+type ImageDeleter interface {
+	DeleteImage(ctx context.Context, imageID string) error
+}
+
+// Workflow удаляет аккаунты, у которых истек срок на отмену. Каждый шаг
+// удаляет данные в одном сервисе и повторяется без вреда, пройденные шаги
+// сохраняются в auth_service, поэтому после сбоя удаление продолжается
+// с прерванного шага.
+type Workflow struct {
+	auth    authpb.AuthServiceClient
+	finance finpb.FinanceServiceClient
+	budget  bdgpb.BudgetServiceClient
+	images  ImageDeleter
+	search  kafkautils.KafkaProducer
+	// protected изображения, общие для всех пользователей: аватар и иконки
+	// категорий по умолчанию
+	protected map[string]struct{}
+}
+
+func NewWorkflow(auth authpb.AuthServiceClient, finance finpb.FinanceServiceClient, budget bdgpb.BudgetServiceClient,
+	images ImageDeleter, search kafkautils.KafkaProducer, protected []string) *Workflow {
+	w := &Workflow{
+		auth:      auth,
+		finance:   finance,
+		budget:    budget,
+		images:    images,
+		search:    search,
+		protected: map[string]struct{}{models.DefaultAvatarID: {}},
+	}
+	for _, id := range protected {
+		w.protected[id] = struct{}{}
+	}
+	return w
+}
+
+// Run обрабатывает наступившие удаления, пока не отменен ctx
+func (w *Workflow) Run(ctx context.Context, interval time.Duration, log logger.Logger) {
+	ctx = logger.WithLogger(ctx, log)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		completed, err := w.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error("Failed to delete accounts", "error", err)
+		}
+		if completed > 0 {
+			log.Info("Accounts deleted", "count", completed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce забирает пачку удалений и доводит каждое до конца. Ошибка одного
+// удаления не мешает остальным; оно повторится после истечения аренды.
+func (w *Workflow) RunOnce(ctx context.Context) (int, error) {
+	claimed, err := w.auth.ClaimAccountDeletions(ctx, &authpb.ClaimDeletionsRequest{
+		Limit:        batchSize,
+		LeaseSeconds: int32(lease / time.Second),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim account deletions: %w", err)
+	}
+
+	completed := 0
+	var errs []error
+	for _, d := range claimed.GetDeletions() {
+		done, err := w.process(ctx, d)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", d.GetUserId(), err))
+			continue
+		}
+		if done {
+			completed++
+		}
+	}
+	return completed, errors.Join(errs...)
+}
+
+// process выполняет шаги после последнего сохраненного. false без ошибки —
+// пользователь успел отменить удаление.
+func (w *Workflow) process(ctx context.Context, d *authpb.AccountDeletion) (bool, error) {
+	userID := d.GetUserId()
+	imageIDs := d.GetImageIds()
+
+	start := 0
+	if d.GetStep() != "" {
+		start = slices.Index(models.DeletionSteps, d.GetStep()) + 1
+		if start == 0 {
+			return false, fmt.Errorf("unknown deletion step %q", d.GetStep())
+		}
+	}
+
+	for _, step := range models.DeletionSteps[start:] {
+		var err error
+		switch step {
+		case models.DeletionStepCollect:
+			imageIDs, err = w.collectImages(ctx, userID)
+		case models.DeletionStepBudgets:
+			_, err = w.budget.DeleteUserData(ctx, &bdgpb.UserID{UserID: userID})
+		case models.DeletionStepFinance:
+			var res *finpb.UserDataDeletion
+			res, err = w.finance.DeleteUserData(ctx, &finpb.UserID{UserId: userID})
+			if err == nil {
+				w.reindexUncategorized(ctx, res.GetUncategorizedOperations())
+			}
+		case models.DeletionStepAuth:
+			_, err = w.auth.DeleteUser(ctx, &authpb.UserID{UserID: userID})
+		case models.DeletionStepImages:
+			err = w.deleteImages(ctx, imageIDs)
+		}
+		if err != nil {
+			return false, fmt.Errorf("step %s: %w", step, err)
+		}
+
+		req := &authpb.AdvanceDeletionRequest{UserId: userID, Step: step}
+		if step == models.DeletionStepCollect {
+			req.ImageIds = imageIDs
+		}
+		_, err = w.auth.AdvanceAccountDeletion(ctx, req)
+		// отменить можно только до первого шага, а он ничего не удаляет
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to save step %s: %w", step, err)
+		}
+	}
+	return true, nil
+}
+
+// reindexUncategorized обновляет в поиске операции совместных счетов,
+// с которых сняты категории удаленного пользователя
+func (w *Workflow) reindexUncategorized(ctx context.Context, ops []*finpb.Operation) {
+	if len(ops) == 0 {
+		return
+	}
+
+	msgs := make([]kafkautils.KafkaMessage, 0, len(ops))
+	for _, op := range ops {
+		searchObj := models.TransactionSearch{
+			ID:           int(op.GetId()),
+			AccountID:    int(op.GetAccountId()),
+			CategoryName: op.GetCategoryName(),
+			Type:         op.GetType(),
+			Description:  op.GetDescription(),
+			Status:       op.GetStatus(),
+			Name:         op.GetName(),
+			Sum:          op.GetSum(),
+			AccountType:  op.GetAccountType(),
+			CurrencyID:   int(op.GetCurrencyId()),
+			CreatedAt:    op.GetCreatedAt().AsTime(),
+			Date:         op.GetDate().AsTime(),
+			Action:       models.UPDATE,
+		}
+		data, _ := searchObj.MarshalJSON()
+		msgs = append(msgs, kafkautils.KafkaMessage{Payload: data, Type: models.TRANSACTIONS})
+	}
+
+	if err := w.search.WriteMessages(ctx, msgs...); err != nil {
+		if log := logger.FromContext(ctx); log != nil {
+			log.Error("kafka DeleteUserData error", "operations", len(ops), "error", err)
+		}
+	}
+}
+
+// collectImages изображения, которые загружал пользователь. Их собирают до
+// удаления данных: после удаления узнать их будет неоткуда.
+func (w *Workflow) collectImages(ctx context.Context, userID int32) ([]string, error) {
+	var ids []string
+
+	profile, err := w.auth.ExportProfile(ctx, &authpb.UserID{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	ids = append(ids, profile.GetLogoHashedId())
+
+	data, err := w.finance.ExportUserData(ctx, &finpb.UserID{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range data.GetCategories() {
+		ids = append(ids, c.GetLogoHashedId())
+	}
+	for _, r := range data.GetReceivers() {
+		ids = append(ids, r.GetLogoHashedId())
+	}
+
+	goals, err := w.budget.GetGoals(ctx, &bdgpb.UserID{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range goals.GetGoals() {
+		ids = append(ids, g.GetLogoHashedId())
+	}
+
+	ids = slices.DeleteFunc(ids, func(id string) bool {
+		_, protected := w.protected[id]
+		return protected || !imageIDRe.MatchString(id)
+	})
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// deleteImages удаляет изображения, которые больше никто не использует.
+// Изображения адресуются по содержимому, поэтому то же изображение могло
+// быть загружено и другими пользователями.
+func (w *Workflow) deleteImages(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	used := make(map[string]struct{})
+	filters := []func() ([]string, error){
+		func() ([]string, error) {
+			res, err := w.auth.FilterUsedImages(ctx, &authpb.ImageIDs{Ids: ids})
+			return res.GetIds(), err
+		},
+		func() ([]string, error) {
+			res, err := w.finance.FilterUsedImages(ctx, &finpb.ImageIDs{Ids: ids})
+			return res.GetIds(), err
+		},
+		func() ([]string, error) {
+			res, err := w.budget.FilterUsedImages(ctx, &bdgpb.ImageIDs{Ids: ids})
+			return res.GetIds(), err
+		},
+	}
+	for _, filter := range filters {
+		inUse, err := filter()
+		if err != nil {
+			return err
+		}
+		for _, id := range inUse {
+			used[id] = struct{}{}
+		}
+	}
+
+	for _, id := range ids {
+		if _, ok := used[id]; ok {
+			continue
+		}
+		if _, ok := w.protected[id]; ok {
+			continue
+		}
+		if err := w.images.DeleteImage(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package deletion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	bdgpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/budget_service/proto"
+	finpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/finance_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+var (
+	avatar   = strings.Repeat("a", 64)
+	logo     = strings.Repeat("b", 64)
+	shared   = strings.Repeat("c", 64)
+	goalLogo = strings.Repeat("d", 64)
+	icon     = strings.Repeat("e", 64)
+)
+
+type fakeImages struct {
+	deleted []string
+}
+
+func (f *fakeImages) DeleteImage(_ context.Context, imageID string) error {
+	f.deleted = append(f.deleted, imageID)
+	return nil
+}
+
+func protoEq(m proto.Message) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		msg, ok := x.(proto.Message)
+		return ok && proto.Equal(m, msg)
+	})
+}
+
+type testWorkflow struct {
+	*Workflow
+	auth    *mocks.MockAuthServiceClient
+	finance *mocks.MockFinanceServiceClient
+	budget  *mocks.MockBudgetServiceClient
+	search  *mocks.MockKafkaProducer
+	images  *fakeImages
+}
+
+func newTestWorkflow(t *testing.T) testWorkflow {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tw := testWorkflow{
+		auth:    mocks.NewMockAuthServiceClient(ctrl),
+		finance: mocks.NewMockFinanceServiceClient(ctrl),
+		budget:  mocks.NewMockBudgetServiceClient(ctrl),
+		search:  mocks.NewMockKafkaProducer(ctrl),
+		images:  &fakeImages{},
+	}
+	tw.Workflow = NewWorkflow(tw.auth, tw.finance, tw.budget, tw.images, tw.search, []string{icon})
+	return tw
+}
+
+func (tw testWorkflow) expectAdvance(step string, imageIDs ...string) *gomock.Call {
+	return tw.auth.EXPECT().
+		AdvanceAccountDeletion(gomock.Any(), protoEq(&authpb.AdvanceDeletionRequest{UserId: 1, Step: step, ImageIds: imageIDs})).
+		Return(nil, nil)
+}
+
+func TestWorkflow_RunOnce(t *testing.T) {
+	tw := newTestWorkflow(t)
+	ctx := context.Background()
+
+	tw.auth.EXPECT().ClaimAccountDeletions(gomock.Any(), protoEq(&authpb.ClaimDeletionsRequest{Limit: batchSize, LeaseSeconds: 600})).
+		Return(&authpb.AccountDeletionList{Deletions: []*authpb.AccountDeletion{{UserId: 1}}}, nil)
+
+	// общие изображения и заглушки из миграций не собираются
+	tw.auth.EXPECT().ExportProfile(gomock.Any(), protoEq(&authpb.UserID{UserID: 1})).
+		Return(&authpb.User{LogoHashedId: avatar}, nil)
+	tw.finance.EXPECT().ExportUserData(gomock.Any(), protoEq(&finpb.UserID{UserId: 1})).
+		Return(&finpb.UserDataExport{
+			Categories: []*finpb.Category{{LogoHashedId: logo}, {LogoHashedId: icon}, {LogoHashedId: logo}},
+			Receivers:  []*finpb.Receiver{{LogoHashedId: "b6d81b360a5672d80c27430f39153e2c"}, {LogoHashedId: shared}},
+		}, nil)
+	tw.budget.EXPECT().GetGoals(gomock.Any(), protoEq(&bdgpb.UserID{UserID: 1})).
+		Return(&bdgpb.ListGoalsResponse{Goals: []*bdgpb.Goal{{LogoHashedId: goalLogo}, {LogoHashedId: models.DefaultAvatarID}}}, nil)
+
+	collected := []string{avatar, logo, shared, goalLogo}
+	gomock.InOrder(
+		tw.expectAdvance(models.DeletionStepCollect, collected...),
+		tw.budget.EXPECT().DeleteUserData(gomock.Any(), protoEq(&bdgpb.UserID{UserID: 1})).Return(&bdgpb.UserDataDeletion{}, nil),
+		tw.expectAdvance(models.DeletionStepBudgets),
+		tw.finance.EXPECT().DeleteUserData(gomock.Any(), protoEq(&finpb.UserID{UserId: 1})).
+			Return(&finpb.UserDataDeletion{UncategorizedOperations: []*finpb.Operation{
+				{Id: 10, AccountId: 6, CategoryName: "Без категории", Type: "expense", Status: "finished", Sum: 300},
+			}}, nil),
+		// в поиске у операции совместного счета снимается удаленная категория
+		tw.search.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, msgs ...kafkautils.KafkaMessage) error {
+				require.Len(t, msgs, 1)
+				require.Equal(t, models.TRANSACTIONS, msgs[0].Type)
+				var search models.TransactionSearch
+				require.NoError(t, json.Unmarshal(msgs[0].Payload, &search))
+				require.Equal(t, 10, search.ID)
+				require.Equal(t, 6, search.AccountID)
+				require.Zero(t, search.CategoryID)
+				require.Equal(t, models.UPDATE, search.Action)
+				return nil
+			}),
+		tw.expectAdvance(models.DeletionStepFinance),
+		tw.auth.EXPECT().DeleteUser(gomock.Any(), protoEq(&authpb.UserID{UserID: 1})).Return(nil, nil),
+		tw.expectAdvance(models.DeletionStepAuth),
+	)
+
+	// изображение shared загрузил и другой пользователь
+	tw.auth.EXPECT().FilterUsedImages(gomock.Any(), protoEq(&authpb.ImageIDs{Ids: collected})).Return(&authpb.ImageIDs{}, nil)
+	tw.finance.EXPECT().FilterUsedImages(gomock.Any(), protoEq(&finpb.ImageIDs{Ids: collected})).Return(&finpb.ImageIDs{Ids: []string{shared}}, nil)
+	tw.budget.EXPECT().FilterUsedImages(gomock.Any(), protoEq(&bdgpb.ImageIDs{Ids: collected})).Return(&bdgpb.ImageIDs{}, nil)
+	tw.expectAdvance(models.DeletionStepImages)
+	tw.expectAdvance(models.DeletionStepDone)
+
+	completed, err := tw.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, completed)
+	require.Equal(t, []string{avatar, logo, goalLogo}, tw.images.deleted)
+}
+
+func TestWorkflow_ResumesAfterLastStep(t *testing.T) {
+	tw := newTestWorkflow(t)
+
+	// прошлая попытка упала после удаления данных в finance
+	tw.auth.EXPECT().ClaimAccountDeletions(gomock.Any(), gomock.Any()).
+		Return(&authpb.AccountDeletionList{Deletions: []*authpb.AccountDeletion{
+			{UserId: 1, Step: models.DeletionStepFinance, ImageIds: []string{logo}, Attempts: 2},
+		}}, nil)
+	tw.auth.EXPECT().DeleteUser(gomock.Any(), gomock.Any()).Return(nil, nil)
+	tw.expectAdvance(models.DeletionStepAuth)
+	tw.auth.EXPECT().FilterUsedImages(gomock.Any(), gomock.Any()).Return(&authpb.ImageIDs{}, nil)
+	tw.finance.EXPECT().FilterUsedImages(gomock.Any(), gomock.Any()).Return(&finpb.ImageIDs{}, nil)
+	tw.budget.EXPECT().FilterUsedImages(gomock.Any(), gomock.Any()).Return(&bdgpb.ImageIDs{}, nil)
+	tw.expectAdvance(models.DeletionStepImages)
+	tw.expectAdvance(models.DeletionStepDone)
+
+	completed, err := tw.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, completed)
+	require.Equal(t, []string{logo}, tw.images.deleted)
+}
+
+func TestWorkflow_StepFailureIsRetriedLater(t *testing.T) {
+	tw := newTestWorkflow(t)
+
+	tw.auth.EXPECT().ClaimAccountDeletions(gomock.Any(), gomock.Any()).
+		Return(&authpb.AccountDeletionList{Deletions: []*authpb.AccountDeletion{
+			{UserId: 1, Step: models.DeletionStepBudgets},
+		}}, nil)
+	tw.finance.EXPECT().DeleteUserData(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+
+	// шаг не сохранен: следующая попытка начнет с него же
+	completed, err := tw.RunOnce(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 0, completed)
+}
+
+func TestWorkflow_CancelledBeforeStart(t *testing.T) {
+	tw := newTestWorkflow(t)
+
+	tw.auth.EXPECT().ClaimAccountDeletions(gomock.Any(), gomock.Any()).
+		Return(&authpb.AccountDeletionList{Deletions: []*authpb.AccountDeletion{{UserId: 1}}}, nil)
+	tw.auth.EXPECT().ExportProfile(gomock.Any(), gomock.Any()).Return(&authpb.User{}, nil)
+	tw.finance.EXPECT().ExportUserData(gomock.Any(), gomock.Any()).Return(&finpb.UserDataExport{}, nil)
+	tw.budget.EXPECT().GetGoals(gomock.Any(), gomock.Any()).Return(&bdgpb.ListGoalsResponse{}, nil)
+	// пользователь отменил удаление, пока собирались изображения
+	tw.auth.EXPECT().AdvanceAccountDeletion(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, string(models.ErrCodeAccountDeletionNotFound)))
+
+	completed, err := tw.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, completed)
+}
+
+func TestWorkflow_ClaimFailed(t *testing.T) {
+	tw := newTestWorkflow(t)
+
+	tw.auth.EXPECT().ClaimAccountDeletions(gomock.Any(), gomock.Any()).Return(nil, errors.New("auth down"))
+
+	_, err := tw.RunOnce(context.Background())
+	require.Error(t, err)
+}
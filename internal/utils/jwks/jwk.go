@@ -0,0 +1,105 @@
+package jwks
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+)
+
+// JWK открытый ключ в формате RFC 7517 (OKP для Ed25519, RSA для RS256)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// Document тело /.well-known/jwks.json
+type Document struct {
+	Keys []JWK `json:"keys"`
+}
+
+var b64 = base64.RawURLEncoding
+
+// JWK открытая часть ключа для публикации
+func (k Key) JWK() JWK {
+	jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Algorithm}
+	switch pub := k.Public.(type) {
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = b64.EncodeToString(pub)
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = b64.EncodeToString(pub.N.Bytes())
+		jwk.E = b64.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	}
+	return jwk
+}
+
+// Key разбирает опубликованный ключ; алгоритм ключа должен совпадать с типом
+func (j JWK) Key() (Key, error) {
+	var public interface{}
+	switch j.Kty {
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return Key{}, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, j.Crv)
+		}
+		x, err := b64.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return Key{}, fmt.Errorf("%w: bad Ed25519 key %s", ErrUnsupportedKey, j.Kid)
+		}
+		public = ed25519.PublicKey(x)
+	case "RSA":
+		n, err := b64.DecodeString(j.N)
+		if err != nil {
+			return Key{}, fmt.Errorf("%w: bad RSA modulus %s", ErrUnsupportedKey, j.Kid)
+		}
+		e, err := b64.DecodeString(j.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return Key{}, fmt.Errorf("%w: bad RSA exponent %s", ErrUnsupportedKey, j.Kid)
+		}
+		public = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	default:
+		return Key{}, fmt.Errorf("%w: kty %s", ErrUnsupportedKey, j.Kty)
+	}
+
+	key, err := NewPublicKey(j.Kid, public)
+	if err != nil {
+		return Key{}, err
+	}
+	if j.Alg != "" && j.Alg != key.Algorithm {
+		return Key{}, fmt.Errorf("%w: alg %s for %s key %s", ErrUnsupportedKey, j.Alg, j.Kty, j.Kid)
+	}
+	return key, nil
+}
+
+// JWKS открытые части всех ключей набора, включая выведенные из оборота
+func (ks *KeySet) JWKS() Document {
+	doc := Document{Keys: make([]JWK, 0, len(ks.order))}
+	for _, id := range ks.order {
+		doc.Keys = append(doc.Keys, ks.keys[id].JWK())
+	}
+	return doc
+}
+
+// ServeHTTP отдает JWKS; кэш короче интервала ротации, чтобы новый ключ
+// успевал разойтись до того, как им начнут подписывать
+func (ks *KeySet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_ = json.NewEncoder(w).Encode(ks.JWKS())
+}
@@ -0,0 +1,246 @@
+// Package jwks ключи подписи access-токенов. auth_service подписывает токены
+// приватным ключом с kid в заголовке и публикует открытые ключи в формате
+// JWKS (RFC 7517); остальные сервисы проверяют подпись по kid. Поддерживаются
+// только асимметричные EdDSA (Ed25519) и RS256: проверять токены может кто
+// угодно, выпускать — только владелец приватного ключа.
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgEdDSA = "EdDSA"
+	AlgRS256 = "RS256"
+
+	minRSABits = 2048
+)
+
+// Algorithms единственные алгоритмы, с которыми принимаются токены
+var Algorithms = []string{AlgEdDSA, AlgRS256}
+
+var (
+	ErrKeyNotFound    = errors.New("jwks: key not found")
+	ErrNoKeys         = errors.New("jwks: no signing keys")
+	ErrUnsupportedKey = errors.New("jwks: unsupported key")
+)
+
+// Key ключ с идентификатором и закрепленным за ним алгоритмом.
+// Без приватной части ключ годится только для проверки подписи.
+type Key struct {
+	ID        string
+	Algorithm string
+	Public    crypto.PublicKey
+	signer    crypto.Signer
+}
+
+// NewKey ключ подписи; алгоритм определяется типом ключа
+func NewKey(id string, private crypto.Signer) (Key, error) {
+	key, err := NewPublicKey(id, private.Public())
+	if err != nil {
+		return Key{}, err
+	}
+	key.signer = private
+	return key, nil
+}
+
+// NewPublicKey ключ только для проверки подписи
+func NewPublicKey(id string, public crypto.PublicKey) (Key, error) {
+	if id == "" {
+		return Key{}, fmt.Errorf("%w: empty kid", ErrUnsupportedKey)
+	}
+	switch pub := public.(type) {
+	case ed25519.PublicKey:
+		return Key{ID: id, Algorithm: AlgEdDSA, Public: pub}, nil
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < minRSABits {
+			return Key{}, fmt.Errorf("%w: RSA key %s is shorter than %d bits", ErrUnsupportedKey, id, minRSABits)
+		}
+		return Key{ID: id, Algorithm: AlgRS256, Public: pub}, nil
+	default:
+		return Key{}, fmt.Errorf("%w: %T", ErrUnsupportedKey, public)
+	}
+}
+
+func (k Key) method() jwt.SigningMethod {
+	if k.Algorithm == AlgRS256 {
+		return jwt.SigningMethodRS256
+	}
+	return jwt.SigningMethodEdDSA
+}
+
+// Resolver находит ключ проверки подписи по kid
+type Resolver interface {
+	Key(ctx context.Context, kid string) (Key, error)
+}
+
+// Keyfunc для jwt.Parse: ключ выбирается по kid, и алгоритм токена обязан
+// совпадать с алгоритмом ключа. Вместе с jwt.WithValidMethods(Algorithms)
+// это исключает подмену алгоритма (none, HS256 с открытым ключом и т.п.).
+func Keyfunc(ctx context.Context, keys Resolver) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, fmt.Errorf("%w: token has no kid", ErrKeyNotFound)
+		}
+		key, err := keys.Key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("jwks: token algorithm %s does not match key %s (%s)", token.Method.Alg(), kid, key.Algorithm)
+		}
+		return key.Public, nil
+	}
+}
+
+// KeySet ключи auth_service: активный подписывает новые токены, остальные
+// остаются для проверки уже выданных, пока не истекут
+type KeySet struct {
+	active string
+	keys   map[string]Key
+	order  []string
+}
+
+// NewKeySet набор ключей; activeID должен быть среди них и иметь приватную часть
+func NewKeySet(activeID string, keys ...Key) (*KeySet, error) {
+	ks := &KeySet{active: activeID, keys: make(map[string]Key, len(keys))}
+	for _, key := range keys {
+		if _, ok := ks.keys[key.ID]; ok {
+			return nil, fmt.Errorf("jwks: duplicate kid %s", key.ID)
+		}
+		ks.keys[key.ID] = key
+		ks.order = append(ks.order, key.ID)
+	}
+	active, ok := ks.keys[activeID]
+	if !ok || active.signer == nil {
+		return nil, fmt.Errorf("%w: active key %q", ErrNoKeys, activeID)
+	}
+	return ks, nil
+}
+
+// Generate набор из одного нового ключа Ed25519; для разработки и тестов
+func Generate(id string) (*KeySet, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	key, err := NewKey(id, private)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySet(id, key)
+}
+
+// LoadDir читает ключи из файлов <kid>.pem: приватные в PKCS#8 и открытые
+// в PKIX (ключи, выведенные из оборота, чьи токены еще действуют).
+// Пустой activeID — активным становится последний по имени приватный ключ,
+// поэтому kid удобно называть датой выпуска. Нет каталога или приватных
+// ключей — ErrNoKeys.
+func LoadDir(dir, activeID string) (*KeySet, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s does not exist", ErrNoKeys, dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".pem") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var keys []Key
+	for _, name := range names {
+		raw, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParsePEM(strings.TrimSuffix(name, ".pem"), raw)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: %s: %w", name, err)
+		}
+		keys = append(keys, key)
+	}
+
+	if activeID == "" {
+		for i := len(keys) - 1; i >= 0; i-- {
+			if keys[i].signer != nil {
+				activeID = keys[i].ID
+				break
+			}
+		}
+	}
+	if activeID == "" {
+		return nil, fmt.Errorf("%w: no private keys in %s", ErrNoKeys, dir)
+	}
+	return NewKeySet(activeID, keys...)
+}
+
+// ParsePEM ключ из PEM: PRIVATE KEY (PKCS#8) или PUBLIC KEY (PKIX)
+func ParsePEM(id string, raw []byte) (Key, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return Key{}, fmt.Errorf("%w: no PEM block", ErrUnsupportedKey)
+	}
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return Key{}, err
+		}
+		signer, ok := private.(crypto.Signer)
+		if !ok {
+			return Key{}, fmt.Errorf("%w: %T", ErrUnsupportedKey, private)
+		}
+		return NewKey(id, signer)
+	case "PUBLIC KEY":
+		public, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return Key{}, err
+		}
+		return NewPublicKey(id, public)
+	default:
+		return Key{}, fmt.Errorf("%w: PEM block %s", ErrUnsupportedKey, block.Type)
+	}
+}
+
+// ActiveID kid, которым подписываются новые токены
+func (ks *KeySet) ActiveID() string {
+	return ks.active
+}
+
+func (ks *KeySet) Key(_ context.Context, kid string) (Key, error) {
+	key, ok := ks.keys[kid]
+	if !ok {
+		return Key{}, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+	return key, nil
+}
+
+// Sign подписывает claims активным ключом и ставит kid в заголовок
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	key := ks.keys[ks.active]
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.signer)
+}
@@ -0,0 +1,206 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+var testRSAKey = func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+func parse(t *testing.T, token string, keys Resolver) error {
+	t.Helper()
+	_, err := jwt.ParseWithClaims(token, &jwt.RegisteredClaims{}, Keyfunc(context.Background(), keys),
+		jwt.WithValidMethods(Algorithms),
+	)
+	return err
+}
+
+func claims() jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{Subject: "42", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))}
+}
+
+func TestKeySet_SignAndVerify(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name   string
+		signer crypto.Signer
+		alg    string
+	}{
+		{"ed25519", edKey, AlgEdDSA},
+		{"rsa", testRSAKey, AlgRS256},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := NewKey("k1", tc.signer)
+			require.NoError(t, err)
+			require.Equal(t, tc.alg, key.Algorithm)
+
+			keys, err := NewKeySet("k1", key)
+			require.NoError(t, err)
+			token, err := keys.Sign(claims())
+			require.NoError(t, err)
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.RegisteredClaims{})
+			require.NoError(t, err)
+			require.Equal(t, "k1", parsed.Header["kid"])
+			require.Equal(t, tc.alg, parsed.Header["alg"])
+
+			require.NoError(t, parse(t, token, keys))
+		})
+	}
+}
+
+func TestKeySet_RetiredKeyStillVerifies(t *testing.T) {
+	old, err := Generate("2025-01")
+	require.NoError(t, err)
+	token, err := old.Sign(claims())
+	require.NoError(t, err)
+
+	oldKey, err := old.Key(context.Background(), "2025-01")
+	require.NoError(t, err)
+	retired, err := NewPublicKey("2025-01", oldKey.Public)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	active, err := NewKey("2025-02", edKey)
+	require.NoError(t, err)
+
+	rotated, err := NewKeySet("2025-02", retired, active)
+	require.NoError(t, err)
+	require.NoError(t, parse(t, token, rotated))
+
+	// выведенным из оборота ключом подписывать нельзя
+	_, err = NewKeySet("2025-01", retired, active)
+	require.ErrorIs(t, err, ErrNoKeys)
+}
+
+func TestKeyfunc_RejectsForgedTokens(t *testing.T) {
+	keys, err := Generate("k1")
+	require.NoError(t, err)
+	rsaKey, err := NewKey("rsa", testRSAKey)
+	require.NoError(t, err)
+	mixed, err := NewKeySet("k1", mustKey(t, keys, "k1"), rsaKey)
+	require.NoError(t, err)
+
+	t.Run("no kid", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims()).SignedString(mustKey(t, keys, "k1").signer)
+		require.NoError(t, err)
+		require.ErrorIs(t, parse(t, token, keys), ErrKeyNotFound)
+	})
+
+	t.Run("unknown kid", func(t *testing.T) {
+		other, err := Generate("k2")
+		require.NoError(t, err)
+		token, err := other.Sign(claims())
+		require.NoError(t, err)
+		require.ErrorIs(t, parse(t, token, keys), ErrKeyNotFound)
+	})
+
+	t.Run("hmac with public key", func(t *testing.T) {
+		public := mustKey(t, keys, "k1").Public.(ed25519.PublicKey)
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, claims())
+		forged.Header["kid"] = "k1"
+		token, err := forged.SignedString([]byte(public))
+		require.NoError(t, err)
+		require.Error(t, parse(t, token, keys))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		forged := jwt.NewWithClaims(jwt.SigningMethodNone, claims())
+		forged.Header["kid"] = "k1"
+		token, err := forged.SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+		require.Error(t, parse(t, token, keys))
+	})
+
+	t.Run("algorithm of another key", func(t *testing.T) {
+		// RS256-подпись с kid ключа EdDSA
+		forged := jwt.NewWithClaims(jwt.SigningMethodRS256, claims())
+		forged.Header["kid"] = "k1"
+		token, err := forged.SignedString(testRSAKey)
+		require.NoError(t, err)
+		require.Error(t, parse(t, token, mixed))
+	})
+}
+
+func TestNewKey_RejectsWeakRSA(t *testing.T) {
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = NewKey("weak", weak)
+	require.ErrorIs(t, err, ErrUnsupportedKey)
+}
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) {
+	t.Helper()
+	raw := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), raw, 0o600))
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	writePEM(t, dir, "2025-02.pem", "PRIVATE KEY", der)
+
+	der, err = x509.MarshalPKCS8PrivateKey(testRSAKey)
+	require.NoError(t, err)
+	writePEM(t, dir, "2025-01.pem", "PRIVATE KEY", der)
+
+	der, err = x509.MarshalPKIXPublicKey(testRSAKey.Public())
+	require.NoError(t, err)
+	writePEM(t, dir, "2024-12.pem", "PUBLIC KEY", der)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("ignored"), 0o600))
+
+	keys, err := LoadDir(dir, "")
+	require.NoError(t, err)
+	require.Equal(t, "2025-02", keys.ActiveID())
+	require.Len(t, keys.JWKS().Keys, 3)
+
+	keys, err = LoadDir(dir, "2025-01")
+	require.NoError(t, err)
+	require.Equal(t, "2025-01", keys.ActiveID())
+	token, err := keys.Sign(claims())
+	require.NoError(t, err)
+	require.NoError(t, parse(t, token, keys))
+
+	_, err = LoadDir(dir, "2024-12")
+	require.ErrorIs(t, err, ErrNoKeys)
+}
+
+func TestLoadDir_NoKeys(t *testing.T) {
+	_, err := LoadDir(filepath.Join(t.TempDir(), "missing"), "")
+	require.ErrorIs(t, err, ErrNoKeys)
+
+	_, err = LoadDir(t.TempDir(), "")
+	require.ErrorIs(t, err, ErrNoKeys)
+}
+
+func mustKey(t *testing.T, keys *KeySet, kid string) Key {
+	t.Helper()
+	key, err := keys.Key(context.Background(), kid)
+	require.NoError(t, err)
+	return key
+}
@@ -0,0 +1,150 @@
+package jwks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+const (
+	// RemoteTTL как часто перечитывать JWKS без повода
+	RemoteTTL = 5 * time.Minute
+	// RemoteMinRefresh не чаще этого на неизвестный kid: токены с
+	// выдуманным kid не должны превращаться в поток запросов к auth_service
+	RemoteMinRefresh = 30 * time.Second
+)
+
+// Remote ключи проверки, опубликованные auth_service. Неизвестный kid —
+// повод перечитать JWKS (ключ могли только что выпустить); при недоступности
+// auth_service продолжают действовать последние полученные ключи.
+//
+// Запрос к auth_service идет без блокировки: набор ключей не меняется после
+// публикации, а заменяется целиком, поэтому остальные запросы тем временем
+// проверяются кешированными ключами. Ждут обновления только те, кому нужен
+// неизвестный kid.
+type Remote struct {
+	url    string
+	client *http.Client
+	clck   clock.Clock
+
+	mu        sync.Mutex
+	keys      map[string]Key
+	fetchedAt time.Time
+	triedAt   time.Time
+	// inflight незавершенное обновление, nil если его нет
+	inflight *refreshCall
+}
+
+type refreshCall struct {
+	done chan struct{}
+	err  error
+}
+
+func NewRemote(url string, client *http.Client, clck clock.Clock) *Remote {
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
+	return &Remote{url: url, client: client, clck: clck}
+}
+
+func (r *Remote) Key(ctx context.Context, kid string) (Key, error) {
+	now := r.clck.Now()
+
+	r.mu.Lock()
+	key, ok := r.keys[kid]
+	stale := r.keys == nil || now.Sub(r.fetchedAt) >= RemoteTTL
+	call, started := r.inflight, false
+	if call == nil && (stale || !ok) && now.Sub(r.triedAt) >= RemoteMinRefresh {
+		r.triedAt = now
+		call, started = &refreshCall{done: make(chan struct{})}, true
+		r.inflight = call
+	}
+	r.mu.Unlock()
+
+	switch {
+	case started:
+		keys, err := r.refresh(ctx)
+		r.finishRefresh(call, keys, err, now)
+	case call != nil && !ok:
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return Key{}, ctx.Err()
+		}
+	default:
+		if !ok {
+			return Key{}, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+		}
+		return key, nil
+	}
+
+	r.mu.Lock()
+	keys := r.keys
+	r.mu.Unlock()
+	if keys == nil {
+		return Key{}, call.err
+	}
+	key, ok = keys[kid]
+	if !ok {
+		return Key{}, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+	return key, nil
+}
+
+// finishRefresh публикует результат обновления и будит ожидающих
+func (r *Remote) finishRefresh(call *refreshCall, keys map[string]Key, err error, now time.Time) {
+	r.mu.Lock()
+	if err == nil {
+		r.keys = keys
+		r.fetchedAt = now
+	}
+	r.inflight = nil
+	r.mu.Unlock()
+
+	call.err = err
+	close(call.done)
+}
+
+func (r *Remote) refresh(ctx context.Context) (map[string]Key, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("jwks: fetch %s: %w", r.url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks: fetch %s: status %d", r.url, resp.StatusCode)
+	}
+
+	var doc Document
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("jwks: decode %s: %w", r.url, err)
+	}
+
+	// ключи неподдерживаемых типов пропускаются, а не ломают весь набор
+	keys := make(map[string]Key, len(doc.Keys))
+	for _, jwk := range doc.Keys {
+		key, err := jwk.Key()
+		if errors.Is(err, ErrUnsupportedKey) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys[key.ID] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: %s has no usable keys", ErrNoKeys, r.url)
+	}
+
+	return keys, nil
+}
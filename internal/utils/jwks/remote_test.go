@@ -0,0 +1,185 @@
+package jwks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+type jwksServer struct {
+	keys     atomic.Pointer[KeySet]
+	requests atomic.Int32
+	fail     atomic.Bool
+}
+
+func newJWKSServer(t *testing.T, keys *KeySet) (*jwksServer, *httptest.Server) {
+	s := &jwksServer{}
+	s.keys.Store(keys)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		if s.fail.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		s.keys.Load().ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return s, srv
+}
+
+type movingClock struct{ now *time.Time }
+
+func (c movingClock) Now() time.Time { return *c.now }
+
+func TestKeySet_ServeHTTP(t *testing.T) {
+	keys, err := Generate("k1")
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	keys.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	var doc Document
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &doc))
+	require.Len(t, doc.Keys, 1)
+	require.Equal(t, JWK{Kty: "OKP", Kid: "k1", Use: "sig", Alg: AlgEdDSA, Crv: "Ed25519", X: doc.Keys[0].X}, doc.Keys[0])
+	require.NotContains(t, rr.Body.String(), `"d"`)
+
+	rr = httptest.NewRecorder()
+	keys.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/.well-known/jwks.json", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+}
+
+func TestJWK_RoundTrip(t *testing.T) {
+	rsaKey, err := NewKey("rsa", testRSAKey)
+	require.NoError(t, err)
+	edKeys, err := Generate("ed")
+	require.NoError(t, err)
+
+	for _, key := range []Key{rsaKey, mustKey(t, edKeys, "ed")} {
+		parsed, err := key.JWK().Key()
+		require.NoError(t, err)
+		require.Equal(t, key.ID, parsed.ID)
+		require.Equal(t, key.Algorithm, parsed.Algorithm)
+		require.Equal(t, key.Public, parsed.Public)
+	}
+
+	// алгоритм в JWK не может расходиться с типом ключа
+	jwk := rsaKey.JWK()
+	jwk.Alg = "HS256"
+	_, err = jwk.Key()
+	require.ErrorIs(t, err, ErrUnsupportedKey)
+}
+
+func TestRemote_VerifiesPublishedKeys(t *testing.T) {
+	keys, err := Generate("k1")
+	require.NoError(t, err)
+	_, srv := newJWKSServer(t, keys)
+
+	remote := NewRemote(srv.URL, srv.Client(), clock.RealClock{})
+	token, err := keys.Sign(claims())
+	require.NoError(t, err)
+	require.NoError(t, parse(t, token, remote))
+}
+
+func TestRemote_Rotation(t *testing.T) {
+	first, err := Generate("k1")
+	require.NoError(t, err)
+	server, srv := newJWKSServer(t, first)
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	remote := NewRemote(srv.URL, srv.Client(), movingClock{&now})
+	_, err = remote.Key(context.Background(), "k1")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, server.requests.Load())
+
+	// новый ключ подхватывается по неизвестному kid, но не чаще RemoteMinRefresh
+	second, err := Generate("k2")
+	require.NoError(t, err)
+	server.keys.Store(second)
+	now = now.Add(time.Second)
+	_, err = remote.Key(context.Background(), "k2")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+	require.EqualValues(t, 1, server.requests.Load())
+
+	now = now.Add(RemoteMinRefresh)
+	_, err = remote.Key(context.Background(), "k2")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, server.requests.Load())
+
+	// auth_service недоступен: работают последние полученные ключи
+	server.fail.Store(true)
+	now = now.Add(RemoteTTL)
+	_, err = remote.Key(context.Background(), "k2")
+	require.NoError(t, err)
+	require.EqualValues(t, 3, server.requests.Load())
+}
+
+func TestRemote_Unavailable(t *testing.T) {
+	keys, err := Generate("k1")
+	require.NoError(t, err)
+	server, srv := newJWKSServer(t, keys)
+	server.fail.Store(true)
+
+	remote := NewRemote(srv.URL, srv.Client(), clock.RealClock{})
+	_, err = remote.Key(context.Background(), "k1")
+	require.Error(t, err)
+}
+
+func TestRemote_RefreshDoesNotBlockCachedKeys(t *testing.T) {
+	first, err := Generate("k1")
+	require.NoError(t, err)
+	second, err := Generate("k2")
+	require.NoError(t, err)
+	server, srv := newJWKSServer(t, first)
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	remote := NewRemote(srv.URL, srv.Client(), movingClock{&now})
+	_, err = remote.Key(context.Background(), "k1")
+	require.NoError(t, err)
+
+	// следующий запрос к auth_service зависает, пока его не отпустят
+	release := make(chan struct{})
+	fetching := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(fetching)
+		<-release
+		second.ServeHTTP(w, r)
+	}))
+	t.Cleanup(slow.Close)
+	remote.url = slow.URL
+	server.keys.Store(second)
+
+	now = now.Add(RemoteTTL)
+	refreshed := make(chan error, 1)
+	go func() {
+		_, err := remote.Key(context.Background(), "k1")
+		refreshed <- err
+	}()
+	<-fetching
+
+	// пока JWKS загружается, известный kid проверяется по кешу без ожидания
+	key, err := remote.Key(context.Background(), "k1")
+	require.NoError(t, err)
+	require.Equal(t, "k1", key.ID)
+
+	// неизвестный kid дожидается загрузки
+	waited := make(chan error, 1)
+	go func() {
+		_, err := remote.Key(context.Background(), "k2")
+		waited <- err
+	}()
+
+	close(release)
+	require.ErrorIs(t, <-refreshed, ErrKeyNotFound)
+	require.NoError(t, <-waited)
+}
@@ -1,37 +1,51 @@
 package utils
 
 import (
+	"context"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/jwks"
+)
+
+const (
+	// AccessTokenTTL access-токен живет недолго, дальше его обновляют по refresh-токену
+	AccessTokenTTL = 15 * time.Minute
+	// RefreshTokenTTL сессия без обновлений завершается через этот срок
+	RefreshTokenTTL = 30 * 24 * time.Hour
 )
 
 type ClaimsJWT struct {
-	UserID int    `json:"user_id"`
-	Login  string `json:"login"`
+	UserID    int    `json:"user_id"`
+	Login     string `json:"login"`
+	SessionID int    `json:"sid"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWT(userID int, login, secret string) (string, error) {
+// GenerateJWT подписывает access-токен активным ключом набора
+func GenerateJWT(userID int, login string, sessionID int, keys *jwks.KeySet) (string, error) {
 	claims := ClaimsJWT{
-		UserID: userID,
-		Login:  login,
+		UserID:    userID,
+		Login:     login,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+	return keys.Sign(claims)
 }
 
-func ValidateJWT(tokenString, secret string) (*ClaimsJWT, error) {
+// ValidateJWT проверяет access-токен ключом из заголовка kid; принимаются
+// только асимметричные алгоритмы, и алгоритм токена должен совпадать с ключом
+func ValidateJWT(ctx context.Context, tokenString string, keys jwks.Resolver) (*ClaimsJWT, error) {
 	claims := &ClaimsJWT{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, jwks.Keyfunc(ctx, keys),
+		jwt.WithValidMethods(jwks.Algorithms),
+	)
 
 	if err != nil {
 		return nil, err
@@ -43,3 +57,56 @@ func ValidateJWT(tokenString, secret string) (*ClaimsJWT, error) {
 
 	return claims, nil
 }
+
+// MFATokenTTL за это время после ввода пароля нужно ввести код второго фактора
+const MFATokenTTL = 5 * time.Minute
+
+const mfaTokenPurpose = "mfa_pending"
+
+// MFAClaims промежуточный токен входа: пароль проверен, второй фактор еще нет
+type MFAClaims struct {
+	UserID  int    `json:"user_id"`
+	Purpose string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// mfaKey отдельный ключ, чтобы промежуточный токен нельзя было
+// предъявить вместо access-токена
+func mfaKey(secret string) []byte {
+	return []byte(secret + ":" + mfaTokenPurpose)
+}
+
+func GenerateMFAToken(userID int, now time.Time, secret string) (string, error) {
+	claims := MFAClaims{
+		UserID:  userID,
+		Purpose: mfaTokenPurpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(MFATokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(mfaKey(secret))
+}
+
+// ValidateMFAToken проверяет промежуточный токен на момент now и возвращает пользователя
+func ValidateMFAToken(tokenString string, now time.Time, secret string) (int, error) {
+	claims := &MFAClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return mfaKey(secret), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if !token.Valid || claims.Purpose != mfaTokenPurpose {
+		return 0, jwt.ErrTokenMalformed
+	}
+
+	return claims.UserID, nil
+}
@@ -1,22 +1,72 @@
 package utils
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/jwks"
 )
 
 func TestGenerateAndValidateJWT(t *testing.T) {
-	token, err := GenerateJWT(42, "tester", "secret")
+	keys, err := jwks.Generate("k1")
+	require.NoError(t, err)
+	token, err := GenerateJWT(42, "tester", 7, keys)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 
-	claims, err := ValidateJWT(token, "secret")
+	claims, err := ValidateJWT(context.Background(), token, keys)
 	require.NoError(t, err)
 	assert.Equal(t, 42, claims.UserID)
 	assert.Equal(t, "tester", claims.Login)
+	assert.Equal(t, 7, claims.SessionID)
+
+	// тот же kid, но другой ключ
+	other, err := jwks.Generate("k1")
+	require.NoError(t, err)
+	_, err = ValidateJWT(context.Background(), token, other)
+	assert.Error(t, err)
+}
+
+func TestValidateJWT_RejectsHMAC(t *testing.T) {
+	keys, err := jwks.Generate("k1")
+	require.NoError(t, err)
+
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, ClaimsJWT{UserID: 42, SessionID: 7})
+	hmac.Header["kid"] = "k1"
+	token, err := hmac.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = ValidateJWT(context.Background(), token, keys)
+	assert.Error(t, err)
+}
+
+func TestGenerateAndValidateMFAToken(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	token, err := GenerateMFAToken(42, now, "secret")
+	require.NoError(t, err)
+
+	userID, err := ValidateMFAToken(token, now.Add(time.Minute), "secret")
+	require.NoError(t, err)
+	assert.Equal(t, 42, userID)
+
+	_, err = ValidateMFAToken(token, now.Add(MFATokenTTL+time.Second), "secret")
+	assert.Error(t, err)
+
+	_, err = ValidateMFAToken(token, now, "wrong")
+	assert.Error(t, err)
 
-	_, err = ValidateJWT(token, "wrong")
+	// промежуточный токен не годится как access-токен и наоборот
+	keys, err := jwks.Generate("k1")
+	require.NoError(t, err)
+	_, err = ValidateJWT(context.Background(), token, keys)
+	assert.Error(t, err)
+	access, err := GenerateJWT(42, "tester", 7, keys)
+	require.NoError(t, err)
+	_, err = ValidateMFAToken(access, time.Now(), "secret")
 	assert.Error(t, err)
 }
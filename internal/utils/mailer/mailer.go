@@ -0,0 +1,15 @@
+package mailer
+
+import "context"
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer отправляет письма пользователям. Реализации: SMTPMailer для
+// продакшена и MemoryMailer для тестов и окружений без почты.
+type Mailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
@@ -0,0 +1,32 @@
+package mailer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryMailer(t *testing.T) {
+	m := NewMemoryMailer()
+	mail := Mail{To: "ivan@example.com", Subject: "Сброс пароля", Body: "ссылка"}
+
+	require.NoError(t, m.Send(context.Background(), mail))
+	require.Equal(t, []Mail{mail}, m.Sent())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, m.Send(ctx, mail))
+	require.Len(t, m.Sent(), 1)
+}
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	msg := string(buildMessage("noreply@vkarmane.local", Mail{To: "ivan@example.com", Subject: "Сброс пароля", Body: "ссылка"}, date))
+
+	require.Contains(t, msg, "From: noreply@vkarmane.local\r\n")
+	require.Contains(t, msg, "To: ivan@example.com\r\n")
+	require.Contains(t, msg, "Subject: =?utf-8?q?")
+	require.Contains(t, msg, "\r\n\r\nссылка\r\n")
+}
@@ -56,6 +56,16 @@ func VerifyPassword(password, encodedHash string) (bool, error) {
 	return false, nil
 }
 
+// NeedsRehash сообщает, что хеш посчитан с параметрами, отличными от
+// defaultParams, и его стоит пересчитать, пока известен пароль
+func NeedsRehash(encodedHash string) bool {
+	p, _, _, err := decodeHash(encodedHash)
+	if err != nil {
+		return true
+	}
+	return *p != *defaultParams
+}
+
 func generateRandomBytes(n uint32) ([]byte, error) {
 	b := make([]byte, n)
 	_, err := rand.Read(b)
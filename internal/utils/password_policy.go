@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 100
+)
+
+var (
+	ErrPasswordTooShort  = errors.New("пароль должен быть не короче 8 символов")
+	ErrPasswordTooLong   = errors.New("пароль должен быть не длиннее 100 символов")
+	ErrPasswordTooSimple = errors.New("пароль должен содержать символы хотя бы двух видов: буквы разного регистра, цифры или спецсимволы")
+	ErrPasswordCommon    = errors.New("пароль слишком распространенный")
+	ErrPasswordPersonal  = errors.New("пароль не должен содержать логин или email")
+)
+
+// commonPasswords пароли, которые перебирают первыми
+var commonPasswords = map[string]struct{}{
+	"password": {}, "password1": {}, "password123": {}, "passw0rd": {},
+	"12345678": {}, "123456789": {}, "1234567890": {}, "87654321": {},
+	"qwerty123": {}, "qwertyuiop": {}, "1q2w3e4r": {}, "1q2w3e4r5t": {},
+	"11111111": {}, "00000000": {}, "iloveyou": {}, "abc12345": {},
+	"admin123": {}, "letmein1": {}, "welcome1": {}, "zaq12wsx": {},
+	"йцукенгш": {}, "пароль123": {},
+}
+
+// CheckPasswordStrength проверяет пароль по парольной политике. personal —
+// логин, email и прочие данные пользователя, которые нельзя включать в пароль.
+func CheckPasswordStrength(password string, personal ...string) error {
+	length := utf8.RuneCountInString(password)
+	if length < MinPasswordLength {
+		return ErrPasswordTooShort
+	}
+	if length > MaxPasswordLength {
+		return ErrPasswordTooLong
+	}
+
+	lower := strings.ToLower(password)
+	if _, ok := commonPasswords[lower]; ok {
+		return ErrPasswordCommon
+	}
+
+	for _, value := range personal {
+		value = strings.ToLower(value)
+		if at := strings.IndexByte(value, '@'); at >= 0 {
+			value = value[:at]
+		}
+		if len(value) >= 3 && strings.Contains(lower, value) {
+			return ErrPasswordPersonal
+		}
+	}
+
+	if passwordCharClasses(password) < 2 {
+		return ErrPasswordTooSimple
+	}
+	return nil
+}
+
+func passwordCharClasses(password string) int {
+	var hasLower, hasUpper, hasDigit, hasOther bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		default:
+			hasOther = true
+		}
+	}
+
+	classes := 0
+	for _, has := range []bool{hasLower, hasUpper, hasDigit, hasOther} {
+		if has {
+			classes++
+		}
+	}
+	return classes
+}
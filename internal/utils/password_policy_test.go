@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPasswordStrength(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		personal []string
+		want     error
+	}{
+		{"ok", "Tr0ub4dor&3", nil, nil},
+		{"letters and symbols", "correct-horse", nil, nil},
+		{"too short", "Ab1!", nil, ErrPasswordTooShort},
+		{"too long", strings.Repeat("Ab1", 40), nil, ErrPasswordTooLong},
+		{"single class", "abcdefghij", nil, ErrPasswordTooSimple},
+		{"common", "Password123", nil, ErrPasswordCommon},
+		{"contains login", "ivanov-2024", []string{"ivanov"}, ErrPasswordPersonal},
+		{"contains email name", "Petrov_1990", []string{"ivanov", "petrov@example.com"}, ErrPasswordPersonal},
+		{"cyrillic", "Секретный пароль", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, CheckPasswordStrength(tt.password, tt.personal...), tt.want)
+		})
+	}
+}
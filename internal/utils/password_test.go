@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,3 +29,15 @@ func TestVerifyPasswordInvalidHash(t *testing.T) {
 	_, err := VerifyPassword("pass", "invalid-hash-format")
 	assert.Error(t, err)
 }
+
+func TestNeedsRehash(t *testing.T) {
+	hash, err := HashPassword("SuperSecret123!")
+	require.NoError(t, err)
+	assert.False(t, NeedsRehash(hash))
+
+	// тот же формат, но с меньшим числом итераций, как до смены defaultParams
+	old := strings.Replace(hash, fmt.Sprintf("t=%d", defaultParams.iterations), "t=1", 1)
+	assert.True(t, NeedsRehash(old))
+
+	assert.True(t, NeedsRehash("invalid-hash-format"))
+}
@@ -0,0 +1,189 @@
+package qr
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRSRemainder(t *testing.T) {
+	// пример 1-M из приложения I стандарта
+	data := []byte{0x10, 0x20, 0x0C, 0x56, 0x61, 0x80, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11}
+
+	ec := rsRemainder(data, rsDivisor(10))
+	assert.Equal(t, []byte{0xA5, 0x24, 0xD4, 0xC1, 0xED, 0x36, 0xC7, 0x87, 0x2C, 0x55}, ec)
+}
+
+func TestFormatAndVersionBits(t *testing.T) {
+	assert.Equal(t, 0x5412, formatBits(0))
+	assert.Equal(t, 0x40CE, formatBits(5))
+	assert.Equal(t, 0x07C94, versionBits(7))
+	assert.Equal(t, 0x0A4D3, versionBits(10))
+}
+
+func TestEncodeData(t *testing.T) {
+	got := encodeData(1, []byte("ab"))
+	require.Len(t, got, 16)
+	assert.Equal(t, []byte{0x40, 0x26, 0x16, 0x20, 0xEC, 0x11, 0xEC}, got[:7])
+}
+
+func TestEncode_Version(t *testing.T) {
+	tests := []struct {
+		length  int
+		version int
+	}{
+		{1, 1},
+		{14, 1},
+		{15, 2},
+		{106, 6},
+		{213, 10},
+	}
+	for _, tt := range tests {
+		code, err := Encode(bytes.Repeat([]byte("a"), tt.length))
+		require.NoError(t, err)
+		assert.Equal(t, tt.version, code.Version, "length %d", tt.length)
+		assert.Equal(t, 17+4*tt.version, code.Size)
+	}
+
+	_, err := Encode(bytes.Repeat([]byte("a"), 214))
+	assert.ErrorIs(t, err, ErrTooLong)
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	for _, text := range []string{
+		"hello",
+		"otpauth://totp/VKarmane:user@example.com?secret=JBSWY3DPEHPK3PXP&issuer=VKarmane",
+		strings.Repeat("x", 200),
+	} {
+		code, err := Encode([]byte(text))
+		require.NoError(t, err)
+		assert.Equal(t, text, string(decode(t, code)))
+	}
+}
+
+func TestPNG(t *testing.T) {
+	raw, err := PNG([]byte("otpauth://totp/x?secret=AAAA"), 4)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(raw))
+	require.NoError(t, err)
+	code, err := Encode([]byte("otpauth://totp/x?secret=AAAA"))
+	require.NoError(t, err)
+	assert.Equal(t, (code.Size+8)*4, img.Bounds().Dx())
+
+	// левый верхний угол поискового узора темный, рамка светлая
+	r, _, _, _ := img.At(16, 16).RGBA()
+	assert.Zero(t, r)
+	r, _, _, _ = img.At(0, 0).RGBA()
+	assert.NotZero(t, r)
+}
+
+// decode читает матрицу обратно: формат, снятие маски, кодовые слова,
+// сверка кодов коррекции и разбор байтового сегмента
+func decode(t *testing.T, code *Code) []byte {
+	t.Helper()
+
+	for x := 0; x < 7; x++ {
+		assert.True(t, code.Black(x, 0))
+		assert.True(t, code.Black(code.Size-1-x, 0))
+		assert.True(t, code.Black(0, code.Size-1-x))
+	}
+
+	format := 0
+	for i := 0; i <= 5; i++ {
+		format |= b2i(code.Black(8, i)) << i
+	}
+	format |= b2i(code.Black(8, 7))<<6 | b2i(code.Black(8, 8))<<7 | b2i(code.Black(7, 8))<<8
+	for i := 9; i < 15; i++ {
+		format |= b2i(code.Black(14-i, 8)) << i
+	}
+	mask := -1
+	for m := 0; m < 8; m++ {
+		if formatBits(m) == format {
+			mask = m
+		}
+	}
+	require.NotEqual(t, -1, mask, "format bits %015b", format)
+
+	ref := newMatrix(code.Version)
+	ref.drawFunctionPatterns()
+	var stream []byte
+	var cur byte
+	n := 0
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vert := 0; vert < code.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if upward {
+					y = code.Size - 1 - vert
+				}
+				if ref.function[y][x] {
+					continue
+				}
+				cur = cur<<1 | byte(b2i(code.Black(x, y) != maskBit(mask, x, y)))
+				if n++; n%8 == 0 {
+					stream = append(stream, cur)
+					cur = 0
+				}
+			}
+		}
+	}
+
+	layout := layoutsM[code.Version]
+	var blocks [][]byte
+	for _, g := range layout.groups {
+		for i := 0; i < g[0]; i++ {
+			blocks = append(blocks, make([]byte, 0, g[1]))
+		}
+	}
+	pos := 0
+	for i := 0; pos < layout.dataCodewords(); i++ {
+		for b := range blocks {
+			if i < cap(blocks[b]) {
+				blocks[b] = append(blocks[b], stream[pos])
+				pos++
+			}
+		}
+	}
+	divisor := rsDivisor(layout.ecPerBlock)
+	var data []byte
+	for b, block := range blocks {
+		ec := make([]byte, layout.ecPerBlock)
+		for i := range ec {
+			ec[i] = stream[pos+i*len(blocks)+b]
+		}
+		assert.Equal(t, rsRemainder(block, divisor), ec, "block %d", b)
+		data = append(data, block...)
+	}
+
+	bits := func(from, n int) int {
+		v := 0
+		for i := from; i < from+n; i++ {
+			v = v<<1 | int(data[i/8]>>(7-i%8)&1)
+		}
+		return v
+	}
+	require.Equal(t, 0b0100, bits(0, 4))
+	cb := countBits(code.Version)
+	length := bits(4, cb)
+	out := make([]byte, length)
+	for i := range out {
+		out[i] = byte(bits(4+cb+8*i, 8))
+	}
+	return out
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
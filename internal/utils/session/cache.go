@@ -0,0 +1,133 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/logger"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+// DefaultTTL сколько шлюз доверяет закешированной активной сессии,
+// если событие об отзыве до него не дошло
+const DefaultTTL = time.Minute
+
+type entry struct {
+	active    bool
+	expiresAt time.Time
+}
+
+// Cache локальный кеш состояния сессий API-шлюза. Промах проверяется
+// в auth_service через CheckSession, отозванные сессии приходят событиями.
+type Cache struct {
+	client    authpb.AuthServiceClient
+	clock     clock.Clock
+	ttl       time.Duration
+	mu        sync.Mutex
+	entries   map[int]entry
+	lastSweep time.Time
+}
+
+func NewCache(client authpb.AuthServiceClient, clck clock.Clock, ttl time.Duration) *Cache {
+	return &Cache{
+		client:    client,
+		clock:     clck,
+		ttl:       ttl,
+		entries:   make(map[int]entry),
+		lastSweep: clck.Now(),
+	}
+}
+
+func (c *Cache) IsSessionActive(ctx context.Context, userID, sessionID int) (bool, error) {
+	now := c.clock.Now()
+
+	c.mu.Lock()
+	e, ok := c.entries[sessionID]
+	c.mu.Unlock()
+	if ok && now.Before(e.expiresAt) {
+		return e.active, nil
+	}
+
+	status, err := c.client.CheckSession(ctx, &authpb.SessionRequest{UserId: int32(userID), SessionId: int32(sessionID)})
+	if err != nil {
+		return false, err
+	}
+
+	c.set(sessionID, status.GetActive(), now)
+	return status.GetActive(), nil
+}
+
+// Invalidate помечает сессии отозванными. Запись живет, пока могут
+// действовать выданные сессии access-токены.
+func (c *Cache) Invalidate(sessionIDs ...int) {
+	now := c.clock.Now()
+	for _, id := range sessionIDs {
+		c.set(id, false, now)
+	}
+}
+
+func (c *Cache) set(sessionID int, active bool, now time.Time) {
+	ttl := c.ttl
+	if !active {
+		ttl = utils.AccessTokenTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[sessionID] = entry{active: active, expiresAt: now.Add(ttl)}
+	if now.Sub(c.lastSweep) < c.ttl {
+		return
+	}
+	for id, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+	c.lastSweep = now
+}
+
+// HandleMessage применяет событие из топика сессий
+func (c *Cache) HandleMessage(value []byte) error {
+	var wrapper kafkautils.KafkaMessageWrapper
+	if err := json.Unmarshal(value, &wrapper); err != nil {
+		return err
+	}
+	if wrapper.Type != models.SESSION_REVOKED {
+		return nil
+	}
+
+	var event models.SessionRevokedEvent
+	if err := json.Unmarshal(wrapper.Payload, &event); err != nil {
+		return err
+	}
+	c.Invalidate(event.SessionIDs...)
+	return nil
+}
+
+// Listen читает события сессий, пока не отменен ctx. Каждый экземпляр
+// шлюза должен получить все события, поэтому reader создается без группы.
+func (c *Cache) Listen(ctx context.Context, reader *kafka.Reader, log logger.Logger) {
+	for {
+		m, err := reader.ReadMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Error("Failed to read session event", "error", err)
+			time.Sleep(3 * time.Second)
+			continue
+		}
+		if err := c.HandleMessage(m.Value); err != nil {
+			log.Error("Failed to handle session event", "error", err)
+		}
+	}
+}
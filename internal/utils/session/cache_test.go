@@ -0,0 +1,60 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	authpb "github.com/go-park-mail-ru/2025_2_VKarmane/internal/app/auth_service/proto"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/mocks"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/models"
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+	kafkautils "github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/kafka"
+)
+
+func TestCache_IsSessionActive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockAuthServiceClient(ctrl)
+	cache := NewCache(client, clock.FixedClock{FixedTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}, DefaultTTL)
+
+	// второй вызов обслуживается из кеша
+	client.EXPECT().
+		CheckSession(gomock.Any(), &authpb.SessionRequest{UserId: 1, SessionId: 5}).
+		Return(&authpb.SessionStatus{Active: true}, nil).
+		Times(1)
+
+	for i := 0; i < 2; i++ {
+		active, err := cache.IsSessionActive(context.Background(), 1, 5)
+		require.NoError(t, err)
+		require.True(t, active)
+	}
+}
+
+func TestCache_HandleMessageRevokes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockAuthServiceClient(ctrl)
+	cache := NewCache(client, clock.FixedClock{FixedTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}, DefaultTTL)
+
+	client.EXPECT().CheckSession(gomock.Any(), gomock.Any()).Return(&authpb.SessionStatus{Active: true}, nil)
+	active, err := cache.IsSessionActive(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.True(t, active)
+
+	payload, err := json.Marshal(models.SessionRevokedEvent{UserID: 1, SessionIDs: []int{5}})
+	require.NoError(t, err)
+	msg, err := json.Marshal(kafkautils.KafkaMessageWrapper{Type: models.SESSION_REVOKED, Payload: payload})
+	require.NoError(t, err)
+	require.NoError(t, cache.HandleMessage(msg))
+
+	active, err = cache.IsSessionActive(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.False(t, active)
+}
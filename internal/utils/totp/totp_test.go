@@ -0,0 +1,90 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2025_2_VKarmane/internal/utils/clock"
+)
+
+// секрет из приложения B RFC 6238 для SHA1
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFC6238(t *testing.T) {
+	// в RFC коды 8-значные, здесь сравниваются последние 6 цифр
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		code, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, tt.code, code, "time %d", tt.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	clck := clock.FixedClock{FixedTime: time.Unix(1111111109, 0)}
+	now := clck.Now()
+	current := Step(now)
+
+	step, ok := Validate(rfcSecret, "081804", now, 0)
+	assert.True(t, ok)
+	assert.Equal(t, current, step)
+
+	prev, err := Code(rfcSecret, current-1)
+	require.NoError(t, err)
+	step, ok = Validate(rfcSecret, prev, now, 0)
+	assert.True(t, ok)
+	assert.Equal(t, current-1, step)
+
+	tooOld, err := Code(rfcSecret, current-2)
+	require.NoError(t, err)
+	_, ok = Validate(rfcSecret, tooOld, now, 0)
+	assert.False(t, ok)
+
+	_, ok = Validate(rfcSecret, "081804", now, current)
+	assert.False(t, ok, "повторное использование шага")
+
+	_, ok = Validate(rfcSecret, "12345", now, 0)
+	assert.False(t, ok)
+	_, ok = Validate("not base32!", "081804", now, 0)
+	assert.False(t, ok)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	require.NoError(t, err)
+	b, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, a, 32)
+	assert.NotEqual(t, a, b)
+
+	_, err = Code(a, 1)
+	assert.NoError(t, err)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("VKarmane", "user@example.com", "JBSWY3DPEHPK3PXP")
+
+	u, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/VKarmane:user@example.com", u.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", u.Query().Get("secret"))
+	assert.Equal(t, "VKarmane", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+}
@@ -0,0 +1,15 @@
+-- ========================================================
+-- Таблица RESTORE_MAPPING
+-- Соответствие идентификаторов из резервной копии и созданных при восстановлении записей.
+-- Позволяет повторно применять один и тот же архив без дублирования данных.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS restore_mapping (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    backup_id TEXT NOT NULL CHECK (LENGTH(backup_id) <= 64),
+    entity_type TEXT NOT NULL CHECK (LENGTH(entity_type) <= 30),
+    source_id INT NOT NULL,
+    target_id INT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, backup_id, entity_type, source_id)
+);
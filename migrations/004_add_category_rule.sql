@@ -0,0 +1,19 @@
+-- ========================================================
+-- Таблица CATEGORY_RULE
+-- Пользовательские правила автоматической категоризации операций.
+-- Правила проверяются по возрастанию priority, срабатывает первое подходящее.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS category_rule (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    category_id INT NOT NULL REFERENCES category(_id) ON DELETE CASCADE,
+    priority INT NOT NULL DEFAULT 0,
+    name_contains TEXT CHECK (LENGTH(name_contains) <= 50),
+    operation_type OPERATION_TYPE,
+    amount_gt DECIMAL(11,2),
+    amount_lt DECIMAL(11,2),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    -- правило без единого условия совпадало бы со всеми операциями
+    CHECK (name_contains IS NOT NULL OR operation_type IS NOT NULL OR amount_gt IS NOT NULL OR amount_lt IS NOT NULL)
+);
@@ -0,0 +1,11 @@
+-- ========================================================
+-- Иерархия категорий
+-- parent_id ссылается на родительскую категорию того же пользователя.
+-- При удалении родителя дочерние категории становятся корневыми.
+-- Отсутствие циклов проверяется в FinanceService.
+-- ========================================================
+ALTER TABLE category
+    ADD COLUMN IF NOT EXISTS parent_id INT REFERENCES category(_id) ON DELETE SET NULL;
+
+ALTER TABLE category
+    ADD CONSTRAINT category_parent_not_self CHECK (parent_id IS NULL OR parent_id <> _id);
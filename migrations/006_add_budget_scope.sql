@@ -0,0 +1,17 @@
+-- ========================================================
+-- Бюджеты по категориям и счетам
+-- Пустой массив означает отсутствие ограничения: бюджет учитывает
+-- расходы по всем категориям (счетам) пользователя.
+-- Идентификаторы хранятся отсортированными, поэтому один и тот же набор
+-- категорий и счетов за период может быть только у одного бюджета.
+-- ========================================================
+ALTER TABLE budget
+    ADD COLUMN IF NOT EXISTS category_ids INT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS account_ids INT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE budget
+    DROP CONSTRAINT IF EXISTS budget_user_id_currency_id_period_start_period_end_key;
+
+ALTER TABLE budget
+    ADD CONSTRAINT budget_scope_period_key
+    UNIQUE (user_id, currency_id, period_start, period_end, category_ids, account_ids);
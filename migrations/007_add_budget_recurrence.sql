@@ -0,0 +1,22 @@
+-- ========================================================
+-- Повторяющиеся бюджеты
+-- recurrence задает длину периода, recurrence_day — день его начала:
+-- день недели (1 — понедельник) для weekly и число месяца для monthly.
+-- По окончании периода бюджет закрывается и создается следующий период
+-- той же серии (series_id указывает на первый бюджет серии).
+-- carried_over — часть лимита, перенесенная из неизрасходованного остатка
+-- предыдущего периода.
+-- ========================================================
+ALTER TABLE budget
+    ADD COLUMN IF NOT EXISTS recurrence TEXT NOT NULL DEFAULT 'none'
+        CHECK (recurrence IN ('none', 'weekly', 'monthly')),
+    ADD COLUMN IF NOT EXISTS recurrence_day INT NOT NULL DEFAULT 0
+        CHECK (recurrence_day BETWEEN 0 AND 31),
+    ADD COLUMN IF NOT EXISTS carry_over BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS carried_over DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (carried_over >= 0),
+    ADD COLUMN IF NOT EXISTS series_id INT REFERENCES budget(_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS budget_series_idx ON budget (series_id);
+
+CREATE INDEX IF NOT EXISTS budget_rollover_idx ON budget (period_end)
+    WHERE closed_at IS NULL AND recurrence <> 'none';
@@ -0,0 +1,21 @@
+-- ========================================================
+-- Уведомления пользователя
+-- Уведомление о бюджете создается один раз на каждый порог расходов
+-- (threshold — процент от суммы бюджета), поэтому повторная обработка
+-- операций не дублирует уведомления. read_at заполняется, когда
+-- пользователь прочитал уведомление.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS notification (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('budget_threshold', 'budget_exceeded')),
+    budget_id INT REFERENCES budget(_id) ON DELETE CASCADE,
+    threshold INT NOT NULL DEFAULT 0 CHECK (threshold >= 0),
+    title TEXT NOT NULL CHECK (LENGTH(title) <= 120),
+    body TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    read_at TIMESTAMPTZ,
+    UNIQUE (user_id, budget_id, kind, threshold)
+);
+
+CREATE INDEX IF NOT EXISTS notification_user_created_idx ON notification (user_id, created_at DESC);
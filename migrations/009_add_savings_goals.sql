@@ -0,0 +1,41 @@
+-- ========================================================
+-- Цели накоплений
+-- Прогресс цели со счетом (account_id) — текущий баланс этого счета,
+-- прогресс цели без счета — сумма взносов из goal_contribution.
+-- Взнос может быть привязан к операции (operation_id): отмененная
+-- операция перестает учитываться в прогрессе.
+-- Если привязанный счет удален, цель переходит на ручные взносы.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS savings_goal (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    goal_name TEXT NOT NULL CHECK (LENGTH(goal_name) BETWEEN 1 AND 60),
+    goal_description TEXT NOT NULL DEFAULT '' CHECK (LENGTH(goal_description) <= 250),
+    target_amount DECIMAL(12,2) NOT NULL CHECK (target_amount > 0),
+    currency_id INT NOT NULL REFERENCES currency(_id),
+    deadline DATE,
+    account_id INT REFERENCES account(_id) ON DELETE SET NULL,
+    logo_hashed_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS savings_goal_user_idx ON savings_goal (user_id);
+
+CREATE TRIGGER modify_savings_goal_updated_at
+    BEFORE UPDATE ON savings_goal
+    FOR EACH ROW
+    EXECUTE PROCEDURE public.moddatetime();
+
+CREATE TABLE IF NOT EXISTS goal_contribution (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    goal_id INT NOT NULL REFERENCES savings_goal(_id) ON DELETE CASCADE,
+    operation_id INT REFERENCES operation(_id) ON DELETE CASCADE,
+    amount DECIMAL(12,2) NOT NULL CHECK (amount <> 0),
+    note TEXT NOT NULL DEFAULT '' CHECK (LENGTH(note) <= 120),
+    contributed_at DATE NOT NULL DEFAULT CURRENT_DATE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (goal_id, operation_id)
+);
+
+CREATE INDEX IF NOT EXISTS goal_contribution_goal_idx ON goal_contribution (goal_id, contributed_at DESC);
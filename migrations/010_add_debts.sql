@@ -0,0 +1,44 @@
+-- ========================================================
+-- Долги и займы
+-- Контрагентом долга является получатель (receiver). Получатель может
+-- быть связан с зарегистрированным пользователем (counterparty_user_id).
+-- direction: lent — пользователь дал в долг, borrowed — взял в долг.
+-- Выдача долга (principal) и возвраты (repayment) — обычные операции
+-- по счетам, связь с долгом хранится в debt_payment. Отмененные
+-- операции не учитываются в остатке долга.
+-- ========================================================
+ALTER TABLE receiver
+    ADD COLUMN IF NOT EXISTS counterparty_user_id INT REFERENCES "user"(_id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS debt (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    receiver_id INT NOT NULL REFERENCES receiver(_id) ON DELETE CASCADE,
+    direction TEXT NOT NULL CHECK (direction IN ('lent', 'borrowed')),
+    account_id INT REFERENCES account(_id) ON DELETE SET NULL,
+    currency_id INT NOT NULL REFERENCES currency(_id),
+    principal DECIMAL(11,2) NOT NULL CHECK (principal > 0),
+    debt_description TEXT NOT NULL DEFAULT '' CHECK (LENGTH(debt_description) <= 100),
+    due_date DATE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS debt_user_idx ON debt (user_id);
+CREATE INDEX IF NOT EXISTS debt_receiver_idx ON debt (receiver_id);
+
+CREATE TRIGGER modify_debt_updated_at
+    BEFORE UPDATE ON debt
+    FOR EACH ROW
+    EXECUTE PROCEDURE public.moddatetime();
+
+CREATE TABLE IF NOT EXISTS debt_payment (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    debt_id INT NOT NULL REFERENCES debt(_id) ON DELETE CASCADE,
+    operation_id INT NOT NULL UNIQUE REFERENCES operation(_id) ON DELETE CASCADE,
+    payment_kind TEXT NOT NULL CHECK (payment_kind IN ('principal', 'repayment')),
+    amount DECIMAL(11,2) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS debt_payment_debt_idx ON debt_payment (debt_id);
@@ -0,0 +1,17 @@
+-- ========================================================
+-- Роли участников совместных счетов
+-- owner — единственный владелец счета: изменяет и удаляет счет,
+-- управляет участниками; editor — ведет операции; viewer — только
+-- просматривает. Владельцем существующих счетов становится участник,
+-- добавленный первым (создатель счета).
+-- ========================================================
+ALTER TABLE sharings
+    ADD COLUMN IF NOT EXISTS sharing_role TEXT NOT NULL DEFAULT 'editor'
+        CHECK (sharing_role IN ('owner', 'editor', 'viewer'));
+
+UPDATE sharings s
+SET sharing_role = 'owner'
+WHERE s._id = (SELECT MIN(first._id) FROM sharings first WHERE first.account_id = s.account_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS sharings_account_owner_idx
+    ON sharings (account_id) WHERE sharing_role = 'owner';
@@ -0,0 +1,35 @@
+-- ========================================================
+-- Приглашения в совместные счета
+-- Участник добавляется только после того, как примет приглашение.
+-- Приглашение по логину адресовано конкретному пользователю (invitee_id),
+-- приглашение по ссылке принимает любой, у кого есть токен; хранится
+-- только sha256-хеш токена. Ссылка одноразовая: после принятия
+-- invitee_id заполняется принявшим пользователем.
+-- status: pending — ждет ответа, accepted, declined, revoked — отозвано
+-- владельцем. Просроченное приглашение остается pending, но принять
+-- его нельзя.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS account_invitation (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    account_id INT NOT NULL REFERENCES account(_id) ON DELETE CASCADE,
+    inviter_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    invitee_id INT REFERENCES "user"(_id) ON DELETE CASCADE,
+    token_hash TEXT UNIQUE,
+    sharing_role TEXT NOT NULL CHECK (sharing_role IN ('editor', 'viewer')),
+    invitation_status TEXT NOT NULL DEFAULT 'pending'
+        CHECK (invitation_status IN ('pending', 'accepted', 'declined', 'revoked')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    responded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK (invitee_id IS NOT NULL OR token_hash IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS account_invitation_account_idx ON account_invitation (account_id);
+CREATE INDEX IF NOT EXISTS account_invitation_invitee_idx
+    ON account_invitation (invitee_id) WHERE invitation_status = 'pending';
+
+CREATE TRIGGER modify_account_invitation_updated_at
+    BEFORE UPDATE ON account_invitation
+    FOR EACH ROW
+    EXECUTE PROCEDURE public.moddatetime();
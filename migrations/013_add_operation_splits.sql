@@ -0,0 +1,42 @@
+-- ========================================================
+-- Разделение расходов между участниками совместного счета
+-- Расход оплачивает payer_id, доли участников задаются способом
+-- split_method: equal — поровну, exact — точными суммами,
+-- percent — процентами. share_value хранит введенное значение доли
+-- (сумму для exact, процент для percent, 1 для equal); суммы долей
+-- вычисляются от текущей суммы операции, поэтому после изменения
+-- операции разделение пересчитывается пропорционально.
+-- settlement — погашение долга между участниками (settle-up), не
+-- является операцией по счету.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS operation_split (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    operation_id INT NOT NULL UNIQUE REFERENCES operation(_id) ON DELETE CASCADE,
+    account_id INT NOT NULL REFERENCES account(_id) ON DELETE CASCADE,
+    payer_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    split_method TEXT NOT NULL CHECK (split_method IN ('equal', 'exact', 'percent')),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS operation_split_account_idx ON operation_split (account_id);
+
+CREATE TABLE IF NOT EXISTS operation_split_share (
+    split_id INT NOT NULL REFERENCES operation_split(_id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    share_value DECIMAL(11,2) NOT NULL CHECK (share_value > 0),
+    PRIMARY KEY (split_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS settlement (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    account_id INT NOT NULL REFERENCES account(_id) ON DELETE CASCADE,
+    payer_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    payee_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    amount DECIMAL(11,2) NOT NULL CHECK (amount > 0),
+    settlement_description TEXT NOT NULL DEFAULT '' CHECK (LENGTH(settlement_description) <= 100),
+    created_by INT REFERENCES "user"(_id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK (payer_id <> payee_id)
+);
+
+CREATE INDEX IF NOT EXISTS settlement_account_idx ON settlement (account_id);
@@ -0,0 +1,28 @@
+-- ========================================================
+-- Сессии пользователей
+-- Сессия создается при входе или регистрации. Короткоживущий access-токен
+-- (JWT) ссылается на сессию, сессия продлевается refresh-токенами.
+-- Refresh-токен одноразовый: при обновлении выдается новый, старый
+-- помечается использованным (used_at). Повторное предъявление
+-- использованного токена означает, что он украден, и сессия отзывается.
+-- Хранятся только sha256-хеши токенов.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS session (
+    _id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS session_user_idx ON session (user_id) WHERE revoked_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS session_refresh_token (
+    token_hash TEXT PRIMARY KEY,
+    session_id INT NOT NULL REFERENCES session(_id) ON DELETE CASCADE,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS session_refresh_token_session_idx ON session_refresh_token (session_id);
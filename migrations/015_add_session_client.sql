@@ -0,0 +1,7 @@
+-- ========================================================
+-- Клиент сессии для списка активных сессий
+-- user_agent и ip запоминаются при входе и обновляются
+-- при каждом обмене refresh-токена вместе с last_seen_at
+-- ========================================================
+ALTER TABLE session ADD COLUMN IF NOT EXISTS user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE session ADD COLUMN IF NOT EXISTS ip TEXT NOT NULL DEFAULT '';
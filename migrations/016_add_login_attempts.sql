@@ -0,0 +1,15 @@
+-- ========================================================
+-- Неудачные попытки входа
+-- Счетчик ведется отдельно по логину (kind = 'login') и по IP
+-- (kind = 'ip'). После порога вход блокируется до locked_until,
+-- каждая следующая ошибка удваивает блокировку. Успешный вход
+-- сбрасывает счетчик логина.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS login_attempt (
+    kind TEXT NOT NULL CHECK (kind IN ('login', 'ip')),
+    key TEXT NOT NULL,
+    failures INT NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ,
+    PRIMARY KEY (kind, key)
+);
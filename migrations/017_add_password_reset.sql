@@ -0,0 +1,16 @@
+-- ========================================================
+-- Токены сброса пароля
+-- Токен приходит пользователю ссылкой в письме, хранится только
+-- его sha256-хеш. Токен одноразовый и действует ограниченное время;
+-- новый запрос сброса не отменяет старые токены, но успешный сброс
+-- гасит все оставшиеся токены пользователя.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS password_reset_token (
+    token_hash TEXT PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS password_reset_token_user_idx ON password_reset_token (user_id);
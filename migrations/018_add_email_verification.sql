@@ -0,0 +1,47 @@
+-- ========================================================
+-- Подтверждение email
+-- email_verified_at пуст, пока пользователь не перешел по ссылке из
+-- письма. Уже зарегистрированные пользователи считаются подтвержденными,
+-- чтобы миграция не отключила им приглашения и прочие ограничения.
+-- ========================================================
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMPTZ;
+
+UPDATE "user" SET email_verified_at = created_at WHERE email_verified_at IS NULL;
+
+-- ========================================================
+-- Смена email
+-- Новый адрес попадает в "user" только когда подтверждены обе стороны:
+-- старый адрес (владелец согласен на смену) и новый (адрес рабочий).
+-- У пользователя одновременно не больше одной незавершенной смены.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS email_change (
+    _id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    new_email TEXT NOT NULL,
+    old_confirmed_at TIMESTAMPTZ,
+    new_confirmed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS email_change_user_idx ON email_change (user_id);
+
+-- ========================================================
+-- Токены подтверждения email
+-- Как и токены сброса пароля, хранятся sha256-хешем и одноразовые.
+-- verify — подтверждение адреса после регистрации,
+-- change_old / change_new — две стороны смены адреса.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS email_token (
+    token_hash TEXT PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL CHECK (purpose IN ('verify', 'change_old', 'change_new')),
+    email TEXT NOT NULL,
+    change_id INT REFERENCES email_change(_id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS email_token_user_idx ON email_token (user_id);
@@ -0,0 +1,28 @@
+-- ========================================================
+-- Двухфакторная аутентификация (TOTP)
+-- Строка появляется при начале подключения; пока enabled_at пуст,
+-- второй фактор при входе не запрашивается. secret зашифрован
+-- (AES-GCM, ключ выводится из секрета JWT). last_used_step — последний
+-- принятый шаг TOTP, коды с шагом не новее него отвергаются.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id INT PRIMARY KEY REFERENCES "user"(_id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    enabled_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ========================================================
+-- Резервные коды
+-- Выдаются один раз при включении 2FA, хранятся sha256-хешем,
+-- каждый срабатывает однократно. Удаляются вместе с user_mfa.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS mfa_recovery_code (
+    _id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES user_mfa(user_id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, code_hash)
+);
@@ -0,0 +1,19 @@
+-- ========================================================
+-- Персональные токены доступа для скриптов и интеграций
+-- Токен показывается один раз при создании, хранится sha256-хешем.
+-- scopes — области доступа через пробел (operations:read budgets:write ...).
+-- expires_at пустой — токен бессрочный. last_used_at обновляется
+-- не чаще раза в минуту, чтобы не писать в базу на каждый запрос.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS personal_token (
+    _id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES "user"(_id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS personal_token_user_idx ON personal_token (user_id);
@@ -0,0 +1,23 @@
+-- ========================================================
+-- Удаление аккаунта
+-- Строка появляется, когда пользователь запрашивает удаление; до
+-- scheduled_at его можно отменить. Затем шлюз по шагам удаляет данные
+-- во всех сервисах: step — последний завершенный шаг, с него удаление
+-- продолжается после сбоя. image_ids — изображения пользователя,
+-- собранные до удаления данных. locked_until не дает двум шлюзам взять
+-- одно удаление. Внешнего ключа на "user" нет: строка переживает
+-- пользователя и остается с completed_at.
+-- ========================================================
+CREATE TABLE IF NOT EXISTS account_deletion (
+    user_id INT PRIMARY KEY,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    step TEXT NOT NULL DEFAULT '',
+    image_ids TEXT NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS account_deletion_due_idx
+    ON account_deletion (scheduled_at) WHERE completed_at IS NULL;
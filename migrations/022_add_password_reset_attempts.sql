@@ -0,0 +1,8 @@
+-- ========================================================
+-- Ограничение запросов сброса пароля
+-- Запросы считаются в login_attempt по email (kind = 'reset_email')
+-- и по IP (kind = 'reset_ip') тем же счетчиком, что и ошибки входа.
+-- ========================================================
+ALTER TABLE login_attempt DROP CONSTRAINT IF EXISTS login_attempt_kind_check;
+ALTER TABLE login_attempt ADD CONSTRAINT login_attempt_kind_check
+    CHECK (kind IN ('login', 'ip', 'reset_email', 'reset_ip'));
@@ -0,0 +1,9 @@
+-- ========================================================
+-- Идентификатор содержимого в RESTORE_MAPPING
+-- Хеш содержимого записи из резервной копии: по нему повторное
+-- восстановление другой выгрузки тех же данных находит уже созданные записи.
+-- ========================================================
+ALTER TABLE restore_mapping ADD COLUMN IF NOT EXISTS content_key TEXT CHECK (LENGTH(content_key) <= 64);
+
+CREATE INDEX IF NOT EXISTS restore_mapping_content_key_idx
+    ON restore_mapping (user_id, entity_type, content_key);